@@ -8,6 +8,7 @@ import { postAdded } from "./ui"
 import { incrementPostCount } from "./page"
 import { posterName } from "./options"
 import { OverlayNotification } from "./ui"
+import lang from "./lang"
 
 // Message for splicing the contents of the current line
 export type SpliceResponse = {
@@ -102,6 +103,13 @@ export default () => {
 			m.insertImage(msg)
 		})
 
+	handlers[message.insertAttachment] = (msg: ImageMessage) =>
+		handle(msg.id, m => {
+			delete msg.id
+			incrementPostCount(false, true)
+			m.insertAttachment(msg)
+		})
+
 	handlers[message.spoiler] = (id: number) =>
 		handle(id, m =>
 			m.spoilerImage())
@@ -139,4 +147,8 @@ export default () => {
 
 	handlers[message.notification] = (text: string) =>
 		new OverlayNotification(text)
+
+	handlers[message.closeWarning] = (deadline: number) =>
+		new OverlayNotification(lang.ui["closeWarning"] + " "
+			+ new Date(deadline * 1000).toLocaleTimeString())
 }
@@ -51,6 +51,7 @@ export interface PostData {
 	sticky: boolean
 	locked: boolean
 	image?: ImageData
+	attachments?: ImageData[] // Files attached after image
 	time: number
 	id: number
 	op: number
@@ -125,6 +126,7 @@ export interface ImageData {
 	md5: string
 	sha1: string
 	name: string
+	position?: number // Position among the post's files, if attached
 
 	// Added client-side
 	expanded: boolean           // Thumbnail is expanded
@@ -135,7 +137,7 @@ export interface ImageData {
 // Possible file types of a post image
 export enum fileTypes {
 	jpg, png, gif, webm, pdf, svg, mp4, mp3, ogg, zip, "7z", "tar.gz", "tar.xz",
-	flac, noFile, txt, webp, rar, cbz, cbr,
+	flac, noFile, txt, webp, rar, cbz, cbr, avif, jxl,
 }
 
 // Return, if source file type can be expanded
@@ -152,6 +154,7 @@ export function isExpandable(t: fileTypes): boolean {
 		case fileTypes.rar:
 		case fileTypes.cbr:
 		case fileTypes.cbz:
+		case fileTypes.jxl: // Not supported by most browsers
 			return false;
 		default:
 			return true;
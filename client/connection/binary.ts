@@ -0,0 +1,101 @@
+// Decoding of binary websocket frames. See common/binary.go for the encoding.
+//
+// Each binary frame starts with the message type as a single byte followed by
+// the payload. All integers are encoded as unsigned varints. Text is UTF-8
+// encoded and always the last field of a message.
+
+import { message, handlers } from "./messages"
+
+// Lowest websocket protocol version, that supports binary message frames
+export const binaryProtocolVersion = 2
+
+const decoder = new TextDecoder()
+
+// Reads unsigned varints from a buffer
+class Reader {
+	private i = 0
+
+	constructor(private buf: Uint8Array) { }
+
+	// Read the next unsigned varint. Multiplication is used instead of bit
+	// shifts to not overflow 32 bit integers.
+	public uvarint(): number {
+		let n = 0,
+			mul = 1
+		while (this.i < this.buf.length) {
+			const b = this.buf[this.i++]
+			n += (b & 0x7f) * mul
+			if (b < 0x80) {
+				return n
+			}
+			mul *= 0x80
+		}
+		throw new Error("malformed binary message")
+	}
+
+	// Read all remaining bytes as UTF-8 text
+	public text(): string {
+		return decoder.decode(this.buf.subarray(this.i))
+	}
+
+	// Reports, if the entire buffer has been read
+	public done(): boolean {
+		return this.i >= this.buf.length
+	}
+
+	// Read the next n bytes
+	public bytes(n: number): Uint8Array {
+		if (this.i + n > this.buf.length) {
+			throw new Error("malformed binary message")
+		}
+		const b = this.buf.subarray(this.i, this.i + n)
+		this.i += n
+		return b
+	}
+}
+
+// Decode a binary frame and pass the payload to the respective message
+// handler in the same form as its JSON encoding. Text messages contained in
+// concatenated frames are passed to onText.
+export function onBinaryMessage(
+	buf: Uint8Array,
+	onText: (data: string) => void,
+) {
+	const r = new Reader(buf.subarray(1))
+	let msg: any
+	switch (buf[0]) {
+		case message.append:
+			msg = [r.uvarint(), r.uvarint()]
+			break
+		case message.backspace:
+			msg = r.uvarint()
+			break
+		case message.splice:
+			msg = {
+				id: r.uvarint(),
+				start: r.uvarint(),
+				len: r.uvarint(),
+				text: r.text(),
+			}
+			break
+		case message.concat:
+			// Length prefixed messages. Messages without a binary encoding
+			// are in their text form and start with an ASCII digit.
+			while (!r.done()) {
+				const m = r.bytes(r.uvarint())
+				if (m[0] >= 0x30 && m[0] <= 0x39) {
+					onText(decoder.decode(m))
+				} else {
+					onBinaryMessage(m, onText)
+				}
+			}
+			return
+		default:
+			return
+	}
+
+	const handler = handlers[buf[0]]
+	if (handler) {
+		handler(msg)
+	}
+}
@@ -12,6 +12,9 @@ export const enum message {
 	spoiler,
 	moderatePost,
 
+	// Attach a file after the image of an open post
+	insertAttachment,
+
 	// >= 30 are miscellaneous and do not write to post models
 	synchronise = 30,
 	reclaim,
@@ -47,6 +50,25 @@ export const enum message {
 	// Used by the client to send it's protocol version and by the server to
 	// send server and board configurations
 	configs,
+
+	// Sequence number of a flushed thread feed message batch
+	feedSeq,
+
+	// Confirms a resumed synchronisation, after any missed messages have been
+	// replayed
+	resume,
+	// Thread creation, bump, sticky, lock or deletion on the board page the
+	// client is synced to
+	boardFeed,
+
+	// Transmit count of unique IPs currently typing a post in the thread
+	typingCount,
+
+	// Warns the open post is about to be closed automatically
+	closeWarning,
+
+	// Status of a finished asynchronous upload job
+	uploadStatus,
 }
 
 export type MessageHandler = (msg: {}) => void
@@ -3,6 +3,7 @@ import { debug, page } from "../state"
 import { message, handlers } from "./messages"
 import { renderStatus } from "./ui"
 import { synchronise } from "./synchronization"
+import { onBinaryMessage } from "./binary"
 
 const path =
 	(location.protocol === 'https:' ? 'wss' : 'ws')
@@ -40,8 +41,18 @@ function connect() {
 	socket.onopen = connSM.feeder(connEvent.open)
 	socket.onclose = connSM.feeder(connEvent.close)
 	socket.onerror = connSM.feeder(connEvent.close)
-	socket.onmessage = ({ data }) =>
-		onMessage(data, false)
+	socket.binaryType = "arraybuffer"
+	socket.onmessage = ({ data }) => {
+		if (typeof data === "string") {
+			onMessage(data, false)
+		} else {
+			if (debug) {
+				console.log(">", new Uint8Array(data))
+			}
+			onBinaryMessage(new Uint8Array(data), msg =>
+				onMessage(msg, true))
+		}
+	}
 	if (debug) {
 		(window as any).socket = socket
 	}
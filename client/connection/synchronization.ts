@@ -1,5 +1,5 @@
 import { handlers, message } from "./messages"
-import { connSM, connEvent, send } from "./state"
+import { connSM, connEvent, connState, send } from "./state"
 import {
 	postSM, postEvent, postState, identity, FormModel, Post
 } from "../posts"
@@ -7,10 +7,13 @@ import { page, posts, displayLoading } from "../state"
 import { trigger, extend } from "../util"
 import { PostData, ModerationEntry } from "../common"
 import { insertPost } from "../client"
+import { binaryProtocolVersion } from "./binary"
 
 // Passed from the server to allow the client to synchronise state, before
 // consuming any incoming update messages.
 type SyncData = {
+	epoch: string // Identifies the feed instance, seq belongs to
+	seq: number // Sequence number of the last flushed feed message batch
 	recent: PostState[] // Posts created within the last 15 minutes
 	moderation: { [id: number]: ModerationEntry[] }
 }
@@ -23,27 +26,47 @@ type PostState = {
 	body: string
 }
 
+// Sequence number of the last thread feed message batch received, the thread
+// it belongs to and the epoch of the thread's feed
+let lastSeq = 0,
+	lastSeqThread = 0,
+	epoch = ""
+
 // Send a requests to the server to synchronise to the current page and
 // subscribe to the appropriate event feeds
 export function synchronise() {
-	send(message.synchronise, {
+	const req: { [key: string]: any } = {
 		board: page.board,
 		thread: page.thread,
-	})
+	}
+
+	// Negotiate binary frames for open post body updates. Only done on
+	// thread pages, as the server otherwise also sends the board's post data
+	// that the client already has.
+	if (page.thread) {
+		req.protocolVersion = binaryProtocolVersion
+	}
+
+	// Only replay messages missed since the connection dropped
+	if (connSM.state === connState.reconnecting
+		&& page.thread
+		&& page.thread === lastSeqThread
+	) {
+		req.epoch = epoch
+		req.lastSeq = lastSeq
+	}
+	send(message.synchronise, req)
 
 	// Reclaim a post lost after disconnecting, going on standby, resuming
-	// browser tab, etc.
+	// browser tab, server restarts, etc. The server decides, if the post can
+	// still be reclaimed, and applies any text typed while disconnected.
 	if (page.thread && postSM.state === postState.halted) {
-		// No older than 15 minutes
 		const m = trigger("getPostModel") as FormModel
-		if (m.time > (Date.now() / 1000 - 15 * 60)) {
-			send(message.reclaim, {
-				id: m.id,
-				password: identity.postPassword,
-			})
-		} else {
-			postSM.feed(postEvent.abandon)
-		}
+		send(message.reclaim, {
+			id: m.id,
+			password: identity.postPassword,
+			body: m.inputBody,
+		})
 	}
 }
 
@@ -100,7 +123,7 @@ async function fetchPost(id: number): Promise<PostData> {
 }
 
 // Handle response to a open post reclaim request
-handlers[message.reclaim] = (code: number) => {
+handlers[message.reclaim] = async (code: number) => {
 	switch (code) {
 		case 0:
 			postSM.feed(postEvent.reclaim)
@@ -108,15 +131,42 @@ handlers[message.reclaim] = (code: number) => {
 		case 1:
 			postSM.feed(postEvent.abandon)
 			break
+		case 2: {
+			// Text typed while disconnected was rejected. Continue from the
+			// body stored on the server.
+			const m = trigger("getPostModel") as FormModel
+			const { body } = await fetchPost(m.id)
+			m.inputBody = m.body = body
+			m.view.onInput()
+			postSM.feed(postEvent.reclaim)
+			break
+		}
 	}
 }
 
+// Record the sequence number of the last received feed message batch
+function setLastSeq(seq: number) {
+	lastSeq = seq
+	lastSeqThread = page.thread
+}
+
+handlers[message.feedSeq] = setLastSeq
+
+// All messages missed while disconnected have been replayed
+handlers[message.resume] = (seq: number) => {
+	setLastSeq(seq)
+	displayLoading(false)
+	connSM.feed(connEvent.sync)
+}
+
 // Synchronise to the server and start receiving updates on the appropriate
 // channel. If there are any missed messages, fetch them.
 handlers[message.synchronise] = async (data: SyncData) => {
 	if (!page.thread) {
 		return
 	}
+	epoch = data.epoch
+	setLastSeq(data.seq)
 
 	// Skip posts before the first post in a shortened thread
 	let minID = 0
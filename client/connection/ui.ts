@@ -10,6 +10,10 @@ type syncNum = {
 const syncEl = document.getElementById('sync'),
 syncedCount = document.getElementById("sync-counter")
 
+// Last received counts. Rendered together.
+let lastSync: syncNum,
+	typing = 0
+
 // Render connection status indicator
 export function renderStatus(status: syncStatus) {
 	syncEl.textContent = lang.sync[status]
@@ -17,8 +21,26 @@ export function renderStatus(status: syncStatus) {
 
 // Set synced IP active and total count to sync
 export function renderSyncCount(sync: syncNum) {
-	syncedCount.textContent = sync ?
-		`${sync.active.toString()} / ${sync.total.toString()}` : ''
+	lastSync = sync
+	if (!sync) {
+		typing = 0
+		syncedCount.textContent = ''
+		return
+	}
+	let text = `${sync.active.toString()} / ${sync.total.toString()}`
+	if (typing) {
+		text += ` | ${typing.toString()} ${lang.ui["typing"]}`
+	}
+	syncedCount.textContent = text
+}
+
+// Set count of unique IPs typing a post in the thread
+function renderTypingCount(count: number) {
+	typing = count
+	if (lastSync) {
+		renderSyncCount(lastSync)
+	}
 }
 
 handlers[message.syncCount] = renderSyncCount
+handlers[message.typingCount] = renderTypingCount
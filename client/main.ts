@@ -1,13 +1,16 @@
 // Client entry point
 
-import { loadFromDB, page, posts, storeMine } from './state'
+import {
+	loadFromDB, page, posts, storeMine, displayLoading,
+} from './state'
 import { start as connect, connSM, connState } from './connection'
 import { open } from './db'
 import { initOptions } from "./options"
 import initPosts from "./posts"
 import { postSM, postEvent, FormModel } from "./posts"
 import {
-	renderBoard, extractConfigs, renderThread, init as initPage
+	renderBoard, renderSearch, extractConfigs, renderThread,
+	init as initPage,
 } from './page'
 import * as thread from "./page/thread";
 import initUI from "./ui"
@@ -72,6 +75,11 @@ async function start() {
 			watchThread(id, 1, thread.subject);
 			deleteCookie("addMine");
 		}
+	} else if (page.search) {
+		await renderSearch()
+	} else if (page.archive) {
+		await loadFromDB()
+		displayLoading(false)
 	} else {
 		await renderBoard()
 	}
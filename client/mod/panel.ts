@@ -18,6 +18,7 @@ export default class ModPanel extends View<null> {
 		new BanForm()
 		new NotificationForm()
 		new PostPurgeForm();
+		new ImageDeletionForm()
 
 		this.el.querySelector("form").addEventListener("submit", e =>
 			this.onSubmit(e))
@@ -86,7 +87,10 @@ export default class ModPanel extends View<null> {
 				break;
 			case "deleteImage":
 				if (checked.length) {
-					await this.postJSON("/api/delete-image",
+					const url = HidableForm.forms["deleteImage"].vals()
+						? "/api/blocklist-image"
+						: "/api/delete-image"
+					await this.postJSON(url,
 						mapToIDs(models.filter(m => !!m.image)));
 				}
 				break;
@@ -221,6 +225,18 @@ class PostPurgeForm extends HidableForm {
 	}
 }
 
+// Image deletion options
+class ImageDeletionForm extends HidableForm {
+	constructor() {
+		super("deleteImage")
+	}
+
+	// Returns, if the image should be added to the blocklist
+	public vals(): boolean {
+		return this.inputElement("blocklist").checked
+	}
+}
+
 // Form for sending notifications to all connected clients
 class NotificationForm extends HidableForm {
 	constructor() {
@@ -7,9 +7,20 @@ import {
 	extractConfigs, extractPost, reparseOpenPosts, extractPageData, hidePosts,
 } from "./common"
 import { BoardData, ThreadData } from "../common"
+import { handlers, message } from "../connection"
 
 type SortFunction = (a: Post, b: Post) => number
 
+// Thread event on the synced board page
+type BoardFeedEvent = {
+	type: "create" | "bump" | "update" | "delete"
+	id: number
+	board: string
+	bump_time?: number
+	sticky?: boolean
+	locked?: boolean
+}
+
 // Thread sort functions
 const sorts: { [name: string]: SortFunction } = {
 	bump: subtract("bump_time"),
@@ -100,25 +111,30 @@ export async function render() {
 
 // Sort all threads on a board
 export function sortThreads(initial: boolean) {
-	// Index pages are paginated, so it does not make a lot of sense to sort
-	// them
-	if (!page.catalog) {
-		return
-	}
-
 	const [cont, threads] = getThreads()
-
-	// Index board pages use the same localization functions as threads
-	if (page.catalog && (options.hideThumbs || options.workModeToggle)) {
-		for (let el of cont.querySelectorAll("img.catalog") as NodeListOf<HTMLElement>) {
-			el.style.display = "none"
+	let sort: SortFunction
+	if (page.catalog) {
+		// Index board pages use the same localization functions as threads
+		if (options.hideThumbs || options.workModeToggle) {
+			for (let el of cont.querySelectorAll("img.catalog") as NodeListOf<HTMLElement>) {
+				el.style.display = "none"
+			}
 		}
-	}
 
-	const sortMode = localStorage.getItem("catalogSort") || "bump"
-	// Already sorted as needed
-	if (initial && sortMode === "bump") {
-		return
+		const sortMode = localStorage.getItem("catalogSort") || "bump"
+		// Already sorted as needed
+		if (initial && sortMode === "bump") {
+			return
+		}
+		sort = sorts[sortMode]
+	} else {
+		// Index pages are rendered in bump order with stickies first and only
+		// need resorting on live thread events
+		if (initial) {
+			return
+		}
+		sort = (a, b) =>
+			(+!!b.sticky - +!!a.sticky) || sorts.bump(a, b)
 	}
 
 	// Sort threads by model properties
@@ -130,7 +146,7 @@ export function sortThreads(initial: boolean) {
 			el.remove()
 			return posts.get(parseInt(id))
 		})
-		.sort(sorts[sortMode])
+		.sort(sort)
 		.map(({ id }) =>
 			els[id])
 	)
@@ -212,7 +228,7 @@ async function refreshBoard() {
 // Update refresh timer or refresh board, if document hidden, each minute
 // TODO: Replace with SSE
 setInterval(() => {
-	if (page.thread || isBanned()) {
+	if (page.thread || page.search || page.archive || isBanned()) {
 		return
 	}
 	if (document.hidden) {
@@ -222,6 +238,50 @@ setInterval(() => {
 	}
 }, 600000)
 
+// Apply live thread events to the catalog or board index page
+handlers[message.boardFeed] = (e: BoardFeedEvent) => {
+	if (page.thread || page.search || page.archive || isBanned()) {
+		return
+	}
+	// Only the first index page shows new and newly bumped threads
+	const firstPage = page.catalog || !page.page
+	switch (e.type) {
+		case "create":
+			if (firstPage) {
+				refreshBoard()
+			}
+			break
+		case "bump":
+		case "update":
+			const model = posts.get(e.id) as any
+			if (!model) {
+				// Thread bumped from a later index page
+				if (e.type === "bump" && firstPage) {
+					refreshBoard()
+				}
+				break
+			}
+			const markersChanged = model.sticky !== e.sticky
+				|| model.locked !== e.locked
+			model.bump_time = e.bump_time
+			model.sticky = e.sticky
+			model.locked = e.locked
+			if (!page.catalog && markersChanged) {
+				model.view.renderSticky()
+				model.view.renderLocked()
+			}
+			sortThreads(false)
+			break
+		case "delete":
+			const tag = page.catalog ? "article" : "section",
+				el = threadsEl.querySelector(`${tag}[data-id="${e.id}"]`)
+			if (el) {
+				el.remove()
+			}
+			break
+	}
+}
+
 on(threadsEl, "input", onSortChange, {
 	passive: true,
 	selector: "select[name=sortMode]",
@@ -6,6 +6,7 @@ export {
 	incrementPostCount, default as renderThread,
 } from "./thread"
 export { render as renderBoard } from "./board"
+export { render as renderSearch } from "./search"
 export { watchCurrentThread } from "./thread_watcher";
 
 
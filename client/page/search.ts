@@ -0,0 +1,16 @@
+import { loadFromDB, displayLoading } from '../state'
+import { extractJSON } from '../util'
+import { extractPost, hidePosts } from "./common"
+import { PostData } from "../common"
+
+// Apply client-side modifications to a search results page's HTML
+export async function render() {
+	const results: PostData[] = extractJSON("post-data") || []
+	await loadFromDB(...new Set(results.map(p =>
+		p.op)))
+	for (let p of results) {
+		extractPost(p, p.op, p.board, {})
+	}
+	hidePosts()
+	displayLoading(false)
+}
@@ -0,0 +1,98 @@
+import { View } from "../base"
+import { postJSON } from "../util"
+import lang from "../lang"
+
+const overlay = document.getElementById("modal-overlay")
+
+// Types of recorded edit operations
+const enum editType { append, backspace, splice }
+
+// Single recorded modification of an open post's body
+type EditOp = {
+	type: editType
+	time: number // Unix milliseconds
+	start?: number
+	len?: number
+	text?: string
+}
+
+// Replays the recorded edit log of a post in a floating modal
+class EditLogView extends View<null> {
+	private ops: EditOp[]
+	private body: HTMLElement
+	private time: HTMLElement
+	private slider: HTMLInputElement
+
+	constructor(ops: EditOp[]) {
+		super({
+			tag: "div",
+			class: "modal edit-log",
+		})
+		this.ops = ops
+
+		// Close button
+		const closer = document.createElement("a")
+		closer.textContent = `[X]`
+		closer.style.cssFloat = "right"
+		this.el.append(closer)
+		closer.addEventListener("click", this.remove.bind(this), {
+			passive: true,
+		})
+
+		this.slider = document.createElement("input")
+		this.slider.type = "range"
+		this.slider.min = "0"
+		this.slider.max = ops.length.toString()
+		this.slider.value = this.slider.max
+		this.slider.classList.add("full-width")
+		this.slider.addEventListener("input", () => this.render(), {
+			passive: true,
+		})
+
+		this.time = document.createElement("div")
+		this.body = document.createElement("blockquote")
+		this.body.style.whiteSpace = "pre-wrap"
+		this.el.append(this.slider, this.time, this.body)
+
+		this.render()
+		overlay.append(this.el)
+		this.el.style.display = "block"
+	}
+
+	// Render the post body as it was after the selected amount of operations
+	private render() {
+		const n = parseInt(this.slider.value)
+		let body: string[] = []
+		for (let i = 0; i < n; i++) {
+			const { type, start, len, text } = this.ops[i]
+			switch (type) {
+				case editType.append:
+					body.push(text)
+					break
+				case editType.backspace:
+					body.pop()
+					break
+				case editType.splice:
+					body.splice(start || 0, len || 0, ...Array.from(text || ""))
+					break
+			}
+		}
+		this.body.textContent = body.join("")
+		this.time.textContent = n
+			? new Date(this.ops[n - 1].time).toLocaleString()
+			: ""
+	}
+}
+
+// Fetch and display the edit log of a post
+export default async function showEditLog(id: number) {
+	const res = await postJSON(`/api/edit-log/${id}`, null)
+	if (res.status !== 200) {
+		return alert(await res.text())
+	}
+	const ops: EditOp[] = await res.json()
+	if (!ops.length) {
+		return alert(lang.ui["noEditLog"])
+	}
+	new EditLogView(ops)
+}
@@ -46,6 +46,49 @@ export default class ImageHandler extends View<Post> {
 			ch.tagName === "FIGCAPTION")
 	}
 
+	// Render thumbnails of files attached after the image
+	public renderAttachments() {
+		let el = this.getAttachments()
+		if (!el) {
+			el = document.createElement("div")
+			el.classList.add("post-attachments")
+			const fig = this.getFigure()
+			if (fig) {
+				fig.after(el)
+			} else {
+				this.el.querySelector(".post-container").prepend(el)
+			}
+		}
+
+		let html = ""
+		for (let { sha1, file_type, thumb_type, dims, spoiler, name }
+			of this.model.attachments || []) {
+			let thumb: string,
+				[, , w, h] = dims
+			if (thumb_type === fileTypes.noFile) {
+				thumb = "/assets/file.png"
+				w = h = 150
+			} else if (spoiler && options.spoilers) {
+				thumb = '/assets/spoil/default.jpg'
+				w = h = 150
+			} else {
+				thumb = thumbPath(sha1, thumb_type)
+			}
+			html += `<figure><a target="_blank" `
+				+ `href="${sourcePath(sha1, file_type)}" `
+				+ `title="${escape(name)}.${fileTypes[file_type]}">`
+				+ `<img src="${thumb}" width="${w}" height="${h}">`
+				+ `</a></figure>`
+		}
+		el.innerHTML = html
+	}
+
+	// Need to find direct descendant, otherwise inlined posts might match
+	private getAttachments(): HTMLElement {
+		return firstChild(this.el.querySelector(".post-container"), ch =>
+			ch.classList.contains("post-attachments"))
+	}
+
 	public removeImage() {
 		this.el.classList.remove("media")
 		let el = this.getFigure()
@@ -56,6 +99,10 @@ export default class ImageHandler extends View<Post> {
 		if (el) {
 			el.remove()
 		}
+		el = this.getAttachments()
+		if (el) {
+			el.remove()
+		}
 		this.uncheckModerationBox()
 	}
 
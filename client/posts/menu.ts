@@ -9,6 +9,7 @@ import { position } from "../mod"
 import CollectionView from "./collectionView"
 import { PostData, ModerationLevel } from "../common"
 import ReportForm from "./report"
+import showEditLog from "./editLog"
 
 interface ControlButton extends Element {
 	_popup_menu: MenuView
@@ -141,6 +142,15 @@ const actions: { [key: string]: ItemSpec } = {
 			new CollectionView(await getSameIPPosts(m))
 		},
 	},
+	viewEditLog: {
+		text: lang.posts["viewEditLog"],
+		shouldRender(m) {
+			return position >= ModerationLevel.moderator
+		},
+		handler(m) {
+			return showEditLog(m.id)
+		},
+	},
 	deleteSameIP: {
 		text: lang.posts["deleteBySameIP"],
 		shouldRender: canModerateIP,
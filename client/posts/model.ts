@@ -25,6 +25,7 @@ export class Post extends Model implements PostData {
 	public seenOnce: boolean
 	public hidden: boolean
 	public image: ImageData
+	public attachments: ImageData[]
 	public time: number
 	public body: string
 	public name: string
@@ -196,12 +197,31 @@ export class Post extends Model implements PostData {
 		this.view.autoExpandImage()
 	}
 
+	// Attach a file after the image of an existing post
+	public insertAttachment(img: ImageData) {
+		if (!this.attachments) {
+			this.attachments = []
+		}
+		this.attachments.push(img)
+		this.view.renderAttachments()
+	}
+
 	// Spoiler an already allocated imageThreadData
 	public spoilerImage() {
 		this.image.spoiler = true
 		this.view.renderImage(false)
 	}
 
+	// Spoiler all files attached after the image
+	private spoilerAttachments() {
+		if (this.attachments) {
+			for (let a of this.attachments) {
+				a.spoiler = true
+			}
+			this.view.renderAttachments()
+		}
+	}
+
 	// Close an open post and reparse its last line
 	public closePost() {
 		this.editing = false
@@ -225,6 +245,7 @@ export class Post extends Model implements PostData {
 			case ModerationAction.deleteImage:
 				if (this.image) {
 					this.image = null;
+					this.attachments = null;
 					this.view.removeImage();
 				}
 				break;
@@ -233,6 +254,7 @@ export class Post extends Model implements PostData {
 					this.image.spoiler = true;
 					this.view.renderImage(false);
 				}
+				this.spoilerAttachments();
 				break;
 			case ModerationAction.lockThread:
 				this.locked = data === 'true';
@@ -240,6 +262,7 @@ export class Post extends Model implements PostData {
 			case ModerationAction.purgePost:
 				if (this.image) {
 					this.image = null;
+					this.attachments = null;
 					this.view.removeImage();
 				}
 				this.body = "";
@@ -286,9 +286,18 @@ export default class FormModel extends Post {
 		}
 	}
 
+	// Returns, if another file can be attached to the post
+	public canAttach(): boolean {
+		if (!this.image) {
+			return true
+		}
+		const n = 1 + (this.attachments ? this.attachments.length : 0)
+		return n < (boardConfig.maxAttachments || 1)
+	}
+
 	// Upload the file and request its allocation
 	public async uploadFile(file: File) {
-		if (!boardConfig.textOnly && !this.image) {
+		if (!boardConfig.textOnly && this.canAttach()) {
 			const pr = this.view.upload.uploadFile(file);
 			this.view.input.focus();
 			this.handleUploadResponse(await pr);
@@ -297,7 +306,7 @@ export default class FormModel extends Post {
 
 	private handleUploadResponse(data: FileData | null) {
 		// Upload failed, canceled or image added while thumbnailing
-		if (!data || this.image || this.allocatingImage) {
+		if (!data || !this.canAttach() || this.allocatingImage) {
 			return
 		}
 
@@ -329,9 +338,17 @@ export default class FormModel extends Post {
 	// Insert the uploaded image into the model
 	public insertImage(img: ImageData) {
 		this.image = img
+		this.allocatingImage = false
 		this.view.insertImage()
 	}
 
+	// Insert an uploaded file attached after the image into the model
+	public insertAttachment(img: ImageData) {
+		super.insertAttachment(img)
+		this.allocatingImage = false
+		this.view.insertAttachment()
+	}
+
 	// Spoiler an already allocated image
 	public commitSpoiler() {
 		this.send(message.spoiler, null)
@@ -2,8 +2,13 @@ import lang from '../../lang';
 import { load, trigger } from '../../util';
 import { Post } from "../model";
 import { View } from "../../base";
-import { config } from "../../state";
+import { config, page } from "../../state";
 import { postSM, postEvent, postState } from ".";
+import { handlers, message, send } from "../../connection";
+
+// Interval of polling the status of an upload job, in case the websocket
+// notification about its completion is missed
+const pollInterval = 2000;
 
 // Uploaded file data to be embedded in thread and reply creation or file
 // insertion requests
@@ -18,6 +23,26 @@ interface LoadProgress {
     loaded: number
 }
 
+// Status of an asynchronous upload job
+type UploadStatus = {
+    id: string
+    state: "queued" | "processing" | "done" | "failed"
+    position?: number
+    token?: string
+    error?: string
+    code?: number
+}
+
+// Handlers of upload job status updates by job ID
+const jobHandlers: { [id: string]: (s: UploadStatus) => void } = {};
+
+handlers[message.uploadStatus] = (s: UploadStatus) => {
+    const fn = jobHandlers[s.id];
+    if (fn) {
+        fn(s);
+    }
+};
+
 // View for handling file uploads
 export default class UploadForm extends View<Post> {
     private isUploading: boolean;
@@ -87,7 +112,7 @@ export default class UploadForm extends View<Post> {
             r.readAsArrayBuffer(file);
             const { target: { result } }
                 = await load(r) as ArrayBufferLoadEvent;
-            const res = await fetch("/api/upload-hash", {
+            const res = await fetch(`/api/upload-hash?board=${page.board}`, {
                 method: "POST",
                 body: bufferToHex(await crypto.subtle.digest("SHA-1", result)),
             });
@@ -162,10 +187,11 @@ export default class UploadForm extends View<Post> {
     private async upload(file: File): Promise<string> {
         const formData = new FormData();
         formData.append("image", file);
+        formData.append("board", page.board);
 
         // Not using fetch, because no ProgressEvent support
         this.xhr = new XMLHttpRequest();
-        this.xhr.open("POST", "/api/upload");
+        this.xhr.open("POST", "/api/upload-async");
         this.xhr.upload.onprogress = e =>
             this.renderProgress(e);
         this.xhr.onabort = () =>
@@ -176,14 +202,82 @@ export default class UploadForm extends View<Post> {
         if (!this.isUploading) { // Cancelled while uploading
             return "";
         }
-        this.isUploading = false;
         const text = this.xhr.responseText;
-        if (this.handleResponse(this.xhr.status, text)) {
-            this.xhr = null;
-            this.button.hidden = true;
-            return text;
+        if (!this.handleResponse(this.xhr.status, text)) {
+            return "";
+        }
+        this.xhr = null;
+
+        const token = await this.awaitJob(text);
+        if (!token) {
+            return "";
         }
-        return "";
+        this.isUploading = false;
+        this.button.hidden = true;
+        return token;
+    }
+
+    // Wait for the server to process an uploaded file and return the file
+    // allocation token or an empty string, if processing failed or was
+    // cancelled
+    private awaitJob(id: string): Promise<string> {
+        return new Promise<string>(resolve => {
+            let timer: number;
+
+            const finish = (token: string) => {
+                clearTimeout(timer);
+                delete jobHandlers[id];
+                resolve(token);
+            };
+
+            const handle = (s: UploadStatus) => {
+                if (!this.isUploading) { // Cancelled while processing
+                    return finish("");
+                }
+                switch (s.state) {
+                    case "queued":
+                        this.displayStatus(
+                            `${lang.ui["uploadQueued"]} (${s.position || 0})`,
+                            lang.ui["clickToCancel"],
+                        );
+                        break;
+                    case "processing":
+                        this.displayStatus(lang.ui["thumbnailing"],
+                            lang.ui["clickToCancel"]);
+                        break;
+                    case "done":
+                        finish(s.token);
+                        break;
+                    case "failed":
+                        this.handleResponse(s.code, s.error);
+                        finish("");
+                        break;
+                }
+            };
+
+            const poll = async () => {
+                try {
+                    const res = await fetch(`/api/upload/${id}`);
+                    if (res.status !== 200) {
+                        this.handleResponse(res.status, await res.text());
+                        return finish("");
+                    }
+                    const s = await res.json() as UploadStatus;
+                    if (jobHandlers[id]) { // Not already finished by a push
+                        handle(s);
+                    }
+                } catch (err) {
+                    // Retry on connectivity problems
+                }
+                if (jobHandlers[id]) {
+                    timer = setTimeout(poll, pollInterval);
+                }
+            };
+
+            jobHandlers[id] = handle;
+            send(message.uploadStatus, id);
+            poll();
+        });
     }
 
     // Cancel any ongoing upload
@@ -246,7 +246,11 @@ export default class FormView extends PostView {
     public insertImage() {
         this.renderImage(false);
         this.resizeInput();
-        this.upload.hideButton();
+        if (this.model.canAttach()) {
+            this.upload.reset();
+        } else {
+            this.upload.hideButton();
+        }
 
         if (postSM.state !== postState.alloc) {
             return;
@@ -259,6 +263,13 @@ export default class FormView extends PostView {
         }
     }
 
+    // Reset the upload form after a file has been attached after the image
+    public insertAttachment() {
+        this.resizeInput();
+        this.upload.reset();
+        this.upload.hideSpoilerToggle();
+    }
+
     // Update the display of the Done button according to postSM state
     public updateDoneButton() {
         const el = this.inputElement("done");
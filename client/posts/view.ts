@@ -74,6 +74,9 @@ export default class PostView extends ImageHandler {
         if (this.model.image) {
             this.renderImage(false)
         }
+        if (this.model.attachments) {
+            this.renderAttachments()
+        }
     }
 
     // Get the current Element for text to be written to
@@ -27,6 +27,7 @@ export interface BoardConfigs {
 	forcedAnon: boolean
 	rbText: boolean
 	pyu: boolean
+	maxAttachments: number
 	title: string
 	notice: string
 	rules: string
@@ -36,6 +37,8 @@ export interface BoardConfigs {
 // The current state of a board or thread page
 export type PageState = {
 	catalog: boolean
+	search: boolean
+	archive: boolean
 	thread: number
 	lastN: number
 	page: number
@@ -89,6 +92,8 @@ function read(href: string): PageState {
 		lastN: /[&\?]last=100/.test(u.search) ? 100 : 0,
 		page: page ? parseInt(page[1]) : 0,
 		catalog: /^\/\w+\/catalog/.test(u.pathname),
+		search: /^\/\w+\/search/.test(u.pathname),
+		archive: /^\/\w+\/archive/.test(u.pathname),
 		thread: parseInt(thread && thread[1]) || 0,
 	} as PageState
 }
@@ -0,0 +1,117 @@
+package common
+
+import (
+	"encoding/binary"
+	"unicode/utf8"
+)
+
+// Binary websocket message encoding for high frequency post body updates.
+//
+// Each binary frame starts with the MessageType as a single byte followed by
+// the payload. All integers are encoded as unsigned varints. Text is UTF-8
+// encoded and always the last field of a message, so needs no length prefix.
+
+// BinaryProtocolVersion is the lowest websocket protocol version, that
+// supports binary message frames. Binary frames are only encoded, while
+// clients negotiating this version are connected.
+const BinaryProtocolVersion = 2
+
+// ErrInvalidBinaryMessage is returned on malformed binary message payloads
+var ErrInvalidBinaryMessage = ErrInvalidInput("malformed binary message")
+
+// Appends unsigned varints to a binary message buffer
+func appendUvarints(buf []byte, ints ...uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	for _, i := range ints {
+		n := binary.PutUvarint(tmp[:], i)
+		buf = append(buf, tmp[:n]...)
+	}
+	return buf
+}
+
+// Reads an unsigned varint from the start of data and returns the remainder
+func readUvarint(data []byte) (uint64, []byte, error) {
+	i, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, nil, ErrInvalidBinaryMessage
+	}
+	return i, data[n:], nil
+}
+
+// EncodeBinaryAppend encodes a rune append to an open post
+func EncodeBinaryAppend(id uint64, char rune) []byte {
+	buf := make([]byte, 1, 16)
+	buf[0] = byte(MessageAppend)
+	return appendUvarints(buf, id, uint64(char))
+}
+
+// EncodeBinaryBackspace encodes a backspace in an open post
+func EncodeBinaryBackspace(id uint64) []byte {
+	buf := make([]byte, 1, 11)
+	buf[0] = byte(MessageBackspace)
+	return appendUvarints(buf, id)
+}
+
+// EncodeBinarySplice encodes a splice of an open post's text
+func EncodeBinarySplice(id uint64, start, length uint, text string) []byte {
+	buf := make([]byte, 1, 16+len(text))
+	buf[0] = byte(MessageSplice)
+	buf = appendUvarints(buf, id, uint64(start), uint64(length))
+	return append(buf, text...)
+}
+
+// EncodeBinaryConcat concatenates multiple messages into a single binary
+// frame. Each message is prefixed with its length. Messages without a binary
+// encoding can be passed in their text form and are distinguishable by their
+// first byte being an ASCII digit.
+func EncodeBinaryConcat(msgs [][]byte) []byte {
+	size := 1
+	for _, m := range msgs {
+		size += len(m) + binary.MaxVarintLen64
+	}
+	buf := make([]byte, 1, size)
+	buf[0] = byte(MessageConcat)
+	for _, m := range msgs {
+		buf = appendUvarints(buf, uint64(len(m)))
+		buf = append(buf, m...)
+	}
+	return buf
+}
+
+// DecodeBinaryAppend decodes the payload of a client's binary append request
+func DecodeBinaryAppend(data []byte) (char rune, err error) {
+	i, rest, err := readUvarint(data)
+	switch {
+	case err != nil:
+		return
+	case len(rest) != 0, i > utf8.MaxRune:
+		err = ErrInvalidBinaryMessage
+		return
+	}
+	char = rune(i)
+	return
+}
+
+// DecodeBinarySplice decodes the payload of a client's binary splice request
+func DecodeBinarySplice(data []byte) (
+	start, length uint, text string, err error,
+) {
+	var i uint64
+	i, data, err = readUvarint(data)
+	if err != nil {
+		return
+	}
+	start = uint(i)
+	i, data, err = readUvarint(data)
+	if err != nil {
+		return
+	}
+	length = uint(i)
+
+	if !utf8.Valid(data) {
+		err = ErrInvalidBinaryMessage
+		return
+	}
+	text = string(data)
+	return
+}
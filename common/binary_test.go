@@ -0,0 +1,98 @@
+package common
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestEncodeBinaryMessages(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name     string
+		msg, std []byte
+	}{
+		{
+			"append",
+			EncodeBinaryAppend(2, 'a'),
+			[]byte{byte(MessageAppend), 2, 'a'},
+		},
+		{
+			"append multibyte varint",
+			EncodeBinaryAppend(300, 'あ'),
+			[]byte{byte(MessageAppend), 0xac, 0x02, 0xc2, 0x60},
+		},
+		{
+			"backspace",
+			EncodeBinaryBackspace(2),
+			[]byte{byte(MessageBackspace), 2},
+		},
+		{
+			"splice",
+			EncodeBinarySplice(2, 1, 3, "foo"),
+			[]byte{byte(MessageSplice), 2, 1, 3, 'f', 'o', 'o'},
+		},
+		{
+			"concat",
+			EncodeBinaryConcat([][]byte{
+				{byte(MessageBackspace), 2},
+				[]byte("07"),
+			}),
+			[]byte{
+				byte(MessageConcat),
+				2, byte(MessageBackspace), 2,
+				2, '0', '7',
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			AssertBufferEquals(t, c.msg, c.std)
+		})
+	}
+}
+
+func TestDecodeBinaryAppend(t *testing.T) {
+	t.Parallel()
+
+	char, err := DecodeBinaryAppend(EncodeBinaryAppend(1, 'あ')[2:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, char, 'あ')
+
+	for _, buf := range [...][]byte{
+		nil,
+		{0x80},
+		{'a', 'b'},
+		{0xff, 0xff, 0xff, 0xff, 0x0f},
+	} {
+		_, err := DecodeBinaryAppend(buf)
+		if err != ErrInvalidBinaryMessage {
+			LogUnexpected(t, ErrInvalidBinaryMessage, err)
+		}
+	}
+}
+
+func TestDecodeBinarySplice(t *testing.T) {
+	t.Parallel()
+
+	start, length, text, err := DecodeBinarySplice(
+		EncodeBinarySplice(1, 2, 3, "foo")[2:],
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, start, uint(2))
+	AssertEquals(t, length, uint(3))
+	AssertEquals(t, text, "foo")
+
+	_, _, _, err = DecodeBinarySplice([]byte{1, 2, 0xff})
+	if err != ErrInvalidBinaryMessage {
+		LogUnexpected(t, ErrInvalidBinaryMessage, err)
+	}
+}
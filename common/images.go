@@ -22,6 +22,8 @@ const (
 	RAR
 	CBZ
 	CBR
+	AVIF
+	JXL
 )
 
 // Extensions maps internal file types to their canonical file extensions
@@ -44,6 +46,8 @@ var Extensions = map[uint8]string{
 	RAR:      "rar",
 	CBZ:      "cbz",
 	CBR:      "cbr",
+	AVIF:     "avif",
+	JXL:      "jxl",
 }
 
 // Image contains a post's image and thumbnail data
@@ -67,4 +71,21 @@ type ImageCommon struct {
 	Title     string    `json:"title"`
 	MD5       string    `json:"md5"`
 	SHA1      string    `json:"sha1"`
+
+	// Perceptual hash of the thumbnail. Zero, if the file has no thumbnail or
+	// the hash carries no information. Not exposed to clients.
+	PHash uint64 `json:"-"`
+}
+
+// UploadStatus is the status of an asynchronous upload job
+type UploadStatus struct {
+	ID    string `json:"id"`
+	State string `json:"state"`
+	// Number of jobs queued before this one
+	Position int `json:"position,omitempty"`
+	// Image allocation token, if succeeded
+	Token string `json:"token,omitempty"`
+	// Error message and HTTP status code, if failed
+	Error string `json:"error,omitempty"`
+	Code  int    `json:"code,omitempty"`
 }
@@ -33,6 +33,7 @@ type Thread struct {
 	Abbrev     bool   `json:"abbrev"`
 	Sticky     bool   `json:"sticky"`
 	Locked     bool   `json:"locked"`
+	Archived   bool   `json:"archived,omitempty"`
 	PostCount  uint32 `json:"post_count"`
 	ImageCount uint32 `json:"image_count"`
 	UpdateTime int64  `json:"update_time"`
@@ -60,6 +61,18 @@ type Post struct {
 	Links      []Link            `json:"links"`
 	Commands   []Command         `json:"commands"`
 	Moderation []ModerationEntry `json:"moderation"`
+
+	// Files attached after Image in attachment order. Image is always the
+	// first attached file and kept separate for API compatibility.
+	Attachments []Image `json:"attachments,omitempty"`
+}
+
+// Files returns all files attached to the post in attachment order
+func (p *Post) Files() []Image {
+	if p.Image == nil {
+		return p.Attachments
+	}
+	return append([]Image{*p.Image}, p.Attachments...)
 }
 
 // Return if post has been deleted by staff
@@ -87,3 +100,12 @@ type StandalonePost struct {
 	OP    uint64 `json:"op"`
 	Board string `json:"board"`
 }
+
+// ReplyTree is a post and all posts replying to it, recursively
+type ReplyTree struct {
+	StandalonePost
+	Replies []ReplyTree `json:"replies"`
+	// IDs of posts replying to this post, that are included elsewhere in the
+	// tree
+	References []uint64 `json:"references,omitempty"`
+}
@@ -0,0 +1,12 @@
+package common
+
+// BoardStats contains the activity of a board aggregated over one hour
+type BoardStats struct {
+	// Unix time of the start of the hour
+	Hour          int64 `json:"hour"`
+	Posts         uint  `json:"posts"`
+	Threads       uint  `json:"threads"`
+	Images        uint  `json:"images"`
+	UniquePosters uint  `json:"uniquePosters"`
+	PeakViewers   uint  `json:"peakViewers"`
+}
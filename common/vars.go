@@ -30,12 +30,24 @@ const (
 	MaxLenRules        = 5000
 	MaxLenEightball    = 2000
 	MaxLenReason       = 100
+	MaxLenSearch       = 200
 	MaxNumBanners      = 2000
 	MaxAssetSize       = 100 << 10
 	MaxDiceSides       = 10000
 	BumpLimit          = 1000
 )
 
+// MaxOpenPostTime is the maximum configurable open and idle post time of a
+// board in minutes
+const MaxOpenPostTime = 24 * 60
+
+// MaxSearchResults is the maximum number of posts returned by a single search
+const MaxSearchResults = 100
+
+// MaxAttachments is the maximum configurable number of files attached to a
+// single post
+const MaxAttachments = 10
+
 // Various cryptographic token exact lengths
 const (
 	LenSession    = 171
@@ -23,6 +23,9 @@ const (
 	MessageInsertImage
 	MessageSpoiler
 	MessageModeratePost
+
+	// Attach a file after the image of an open post
+	MessageInsertAttachment
 )
 
 // >= 30 are miscellaneous and do not write to post models
@@ -62,6 +65,28 @@ const (
 	// Used by the client to send it's protocol version and by the server to
 	// send server and board configurations
 	MessageConfigs
+
+	// Sequence number of a flushed thread feed message batch. Clients send
+	// back the last one received to resume synchronisation after reconnecting.
+	MessageFeedSeq
+
+	// Confirms a resumed synchronisation, after any missed messages have
+	// been replayed
+	MessageResume
+	// Thread creation, bump, sticky, lock or deletion on the board page the
+	// client is synced to
+	MessageBoardFeed
+
+	// Transmit count of unique IPs currently typing a post in the thread
+	MessageTypingCount
+
+	// Warn the client its open post is about to be closed automatically.
+	// Carries the Unix time of the closure.
+	MessageCloseWarning
+
+	// Notify the client, an asynchronous upload job it created has finished.
+	// Clients send the ID of the job to subscribe to this notification.
+	MessageUploadStatus
 )
 
 // Forwarded functions from "github.com/bakape/megucawebsockets/feeds" to avoid circular imports
@@ -89,6 +114,25 @@ type Client interface {
 	Close(error)
 }
 
+// BinaryClient is a Client capable of receiving binary encoded messages
+type BinaryClient interface {
+	Client
+
+	// Returns, if the client has negotiated the binary message protocol
+	BinaryProtocol() bool
+
+	// Send a binary encoded message
+	SendBinary([]byte)
+}
+
+// PresenceClient is a Client, that can report composing a post
+type PresenceClient interface {
+	Client
+
+	// Returns, if the client has an open post, that has been edited recently
+	Typing() bool
+}
+
 // EncodeMessage encodes a message for sending through websockets or writing to
 // the replication log.
 func EncodeMessage(typ MessageType, msg interface{}) ([]byte, error) {
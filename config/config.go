@@ -29,6 +29,10 @@ const (
 // ImagerMode is imager functionality setting for this meguca process
 var ImagerMode ImagerModeType
 
+// DefaultMaxOpenTime is the number of minutes a post can be kept open, if the
+// board does not override it
+const DefaultMaxOpenTime = 30
+
 var (
 	// Ensures no reads happen, while the configuration is reloading
 	globalMu, boardMu sync.RWMutex
@@ -46,8 +50,9 @@ var (
 	// metaboard. Constant.
 	AllBoardConfigs = BoardConfContainer{
 		BoardConfigs: BoardConfigs{
-			ID:        "all",
-			Eightball: EightballDefaults,
+			ID:            "all",
+			StripMetadata: true,
+			Eightball:     EightballDefaults,
 			BoardPublic: BoardPublic{
 				DefaultCSS: Defaults.DefaultCSS,
 				Title:      "Aggregator metaboard",
@@ -84,6 +89,12 @@ var (
 		CaptchaTags: []string{"patchouli_knowledge", "cirno",
 			"hakurei_reimu"},
 		OverrideCaptchaTags: map[string]string{},
+		IPRetention:         7,
+		ModLogRetention:     7,
+		ReportRetention:     7,
+		EngagementRetention: 365,
+		PHashThreshold:      8,
+		CorrectOrientation:  true,
 		Public: Public{
 			DefaultCSS:      "tea",
 			DefaultLang:     "en_GB",
@@ -106,7 +117,7 @@ var (
 )
 
 // Default string for the FAQ panel
-const defaultFAQ = `Supported upload file types are JPEG, PNG, APNG, WEBM, MP3, FLAC, MP4, OGG, PDF, ZIP, 7Z, TAR.GZ, TAR.XZ, RAR, CBZ, CBR.
+const defaultFAQ = `Supported upload file types are JPEG, PNG, APNG, WEBM, MP3, FLAC, MP4, OGG, PDF, ZIP, 7Z, TAR.GZ, TAR.XZ, RAR, CBZ, CBR, AVIF, JPEG XL. HEIC images are converted to JPEG.
 <hr>Encase text in:
   ** for spoilers
   @@ for bold
@@ -181,3 +181,54 @@ func TestGetBoardTitles(t *testing.T) {
 		},
 	})
 }
+
+func TestOpenPostDeadline(t *testing.T) {
+	const created, lastEdit = 1000, 1300
+
+	cases := [...]struct {
+		name       string
+		open, idle uint
+		deadline   int64
+	}{
+		{"defaults", 0, 0, created + DefaultMaxOpenTime*60},
+		{"max open time", 10, 0, created + 10*60},
+		{"idle time", 0, 5, lastEdit + 5*60},
+		{"open time sooner than idle", 6, 5, created + 6*60},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			conf := BoardConfigs{
+				MaxOpenTime: c.open,
+				MaxIdleTime: c.idle,
+			}
+			AssertEquals(t, conf.OpenPostDeadline(created, lastEdit),
+				c.deadline)
+		})
+	}
+}
+
+func TestAttachmentLimit(t *testing.T) {
+	cases := [...]struct {
+		name  string
+		max   uint
+		limit int
+	}{
+		{"unset", 0, 1},
+		{"single", 1, 1},
+		{"multiple", 4, 4},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			conf := BoardPublic{
+				MaxAttachments: c.max,
+			}
+			AssertEquals(t, conf.AttachmentLimit(), c.limit)
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package config
 
+import "time"
+
 // Configs stores the global server configuration
 type Configs struct {
 	Public
 	PruneBoards         bool   `json:"pruneBoards"`
 	HideNSFW            bool   `json:"hideNSFW"`
 	EmailErr            bool   `json:"emailErr"`
+	CorrectOrientation  bool   `json:"correctOrientation"`
 	MaxWidth            uint16 `json:"maxWidth"`
 	MaxHeight           uint16 `json:"maxHeight"`
 	BoardExpiry         uint   `json:"boardExpiry"`
@@ -14,6 +17,11 @@ type Configs struct {
 	CharScore           uint   `json:"charScore"`
 	PostCreationScore   uint   `json:"postCreationScore"`
 	ImageScore          uint   `json:"imageScore"`
+	PHashThreshold      uint   `json:"phashThreshold"`
+	IPRetention         uint   `json:"ipRetention"`
+	ModLogRetention     uint   `json:"modLogRetention"`
+	ReportRetention     uint   `json:"reportRetention"`
+	EngagementRetention uint   `json:"engagementRetention"`
 	RootURL             string `json:"rootURL"`
 	Salt                string `json:"salt"`
 	EmailErrMail        string `json:"emailErrMail"`
@@ -43,9 +51,35 @@ type Public struct {
 // BoardConfigs stores board-specific configuration
 type BoardConfigs struct {
 	BoardPublic
-	DisableRobots bool     `json:"disableRobots"`
-	ID            string   `json:"id"`
-	Eightball     []string `json:"eightball"`
+	DisableRobots      bool     `json:"disableRobots"`
+	EditLog            bool     `json:"editLog"`
+	Archive            bool     `json:"archive"`
+	ArchiveDropSources bool     `json:"archiveDropSources"`
+	StripMetadata      bool     `json:"stripMetadata"`
+	MaxOpenTime        uint     `json:"maxOpenTime"`
+	MaxIdleTime        uint     `json:"maxIdleTime"`
+	ID                 string   `json:"id"`
+	Eightball          []string `json:"eightball"`
+}
+
+// OpenPostDeadline returns the Unix time, at which a post created at created
+// and last edited at lastEdit is closed automatically. MaxOpenTime and
+// MaxIdleTime are in minutes. A zero MaxOpenTime defaults to
+// DefaultMaxOpenTime and a zero MaxIdleTime disables the idle limit.
+func (c BoardConfigs) OpenPostDeadline(created, lastEdit int64) int64 {
+	open := c.MaxOpenTime
+	if open == 0 {
+		open = DefaultMaxOpenTime
+	}
+	deadline := created + int64(time.Duration(open)*time.Minute/time.Second)
+	if c.MaxIdleTime != 0 {
+		idle := lastEdit +
+			int64(time.Duration(c.MaxIdleTime)*time.Minute/time.Second)
+		if idle < deadline {
+			deadline = idle
+		}
+	}
+	return deadline
 }
 
 // BoardPublic contains publically accessible board-specific configurations
@@ -64,6 +98,18 @@ type BoardPublic struct {
 
 	// Can't use []uint8, because it marshals to string
 	Banners []uint16 `json:"banners"`
+
+	// Maximum number of files attached to a post. Values below 2 allow a
+	// single file.
+	MaxAttachments uint `json:"maxAttachments"`
+}
+
+// AttachmentLimit returns the maximum number of files attached to a post
+func (c BoardPublic) AttachmentLimit() int {
+	if c.MaxAttachments < 2 {
+		return 1
+	}
+	return int(c.MaxAttachments)
 }
 
 // BoardConfContainer contains configurations for an individual board as well
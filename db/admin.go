@@ -29,9 +29,9 @@ func PurgePost(id uint64, by, reason string) (err error) {
 	if err != nil {
 		return
 	}
+	markWritten(post.OP, post.Board)
 	return InTransaction(false, func(tx *sql.Tx) (err error) {
-		if post.Image != nil {
-			img := post.Image
+		for _, img := range post.Files() {
 			_, err = sq.
 				Delete("images").
 				Where("sha1 = ?", img.SHA1).
@@ -73,10 +73,11 @@ func PurgePost(id uint64, by, reason string) (err error) {
 func moderatePost(id uint64, entry common.ModerationEntry,
 	query *squirrel.UpdateBuilder,
 ) (err error) {
-	board, err := GetPostBoard(id)
+	board, op, err := GetPostParenthood(id)
 	if err != nil {
 		return
 	}
+	markWritten(op, board)
 
 	return InTransaction(false, func(tx *sql.Tx) (err error) {
 		if query != nil {
@@ -95,11 +96,27 @@ func moderatePost(id uint64, entry common.ModerationEntry,
 	})
 }
 
-// DeleteImage permanently deletes an image from a post
+// DeleteImage permanently deletes all files attached to posts
 func DeleteImages(ids []uint64, by string) (err error) {
-	_, err = db.Exec("select delete_images($1::bigint[], $2::text)",
+	return InTransaction(false, func(tx *sql.Tx) error {
+		return deleteImages(tx, ids, by)
+	})
+}
+
+// Attachments can only be added to posts with an image, so the moderation
+// rights checked by delete_images cover them as well
+func deleteImages(tx *sql.Tx, ids []uint64, by string) (err error) {
+	markPostsWritten("id = any(?)", encodeUint64Array(ids))
+	_, err = tx.Exec("select delete_images($1::bigint[], $2::text)",
 		encodeUint64Array(ids), by)
-	castPermissionError(&err)
+	if err != nil {
+		castPermissionError(&err)
+		return
+	}
+	_, err = sq.Delete("post_attachments").
+		Where("post_id = any(?)", encodeUint64Array(ids)).
+		RunWith(tx).
+		Exec()
 	return
 }
 
@@ -108,18 +125,30 @@ func DeleteBoard(board, by string) error {
 	if board == "all" {
 		return common.ErrInvalidInput("can not delete /all/")
 	}
+	markWritten(0, board)
 	return InTransaction(false, func(tx *sql.Tx) error {
 		return deleteBoard(tx, board, by,
 			fmt.Sprintf("board %s deleted by user", board))
 	})
 }
 
-// ModSpoilerImage spoilers image as a moderator
+// ModSpoilerImage spoilers all files attached to posts as a moderator
 func ModSpoilerImages(ids []uint64, by string) (err error) {
-	_, err = db.Exec("select spoiler_images($1::bigint[], $2::text)",
-		encodeUint64Array(ids), by)
-	castPermissionError(&err)
-	return
+	markPostsWritten("id = any(?)", encodeUint64Array(ids))
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec("select spoiler_images($1::bigint[], $2::text)",
+			encodeUint64Array(ids), by)
+		if err != nil {
+			castPermissionError(&err)
+			return
+		}
+		_, err = sq.Update("post_attachments").
+			Set("spoiler", true).
+			Where("post_id = any(?)", encodeUint64Array(ids)).
+			RunWith(tx).
+			Exec()
+		return
+	})
 }
 
 // WriteStaff writes staff positions of a specific board. Old rows are
@@ -261,6 +290,7 @@ func DeletePostsByIP(id uint64, account string, keepDeleting time.Duration,
 	if keepDeleting != 0 {
 		seconds = int(keepDeleting / time.Second)
 	}
+	markPostsWritten("ip = (select ip from posts where id = ?)", id)
 	_, err = db.Exec(
 		"select delete_posts_by_ip($1::bigint, $2::text, $3::bigint, $4::text)",
 		id, account, seconds, reason)
@@ -279,6 +309,7 @@ func DeletePosts(ids []uint64, by string) (err error) {
 
 	os.Stdout.WriteString("\n deleting from DB...\n")
 
+	markPostsWritten("id = any(?)", encodeUint64Array(ids))
 	_, err = db.Exec("select delete_posts($1::bigint[], $2::text)",
 		encodeUint64Array(ids), by)
 	castPermissionError(&err)
@@ -291,7 +322,11 @@ func SetThreadSticky(id uint64, sticky bool) error {
 		Set("sticky", sticky).
 		Where("id = ?", id).
 		Exec()
-	return err
+	if err != nil {
+		return err
+	}
+	markPostsWritten("id = ?", id)
+	return nil
 }
 
 // SetThreadLock sets the ability of users to post in a specific thread
@@ -43,6 +43,7 @@ func writeAdminAccount(t *testing.T) {
 
 func TestDeleteImages(t *testing.T) {
 	prepareForModeration(t)
+	insertSampleAttachment(t)
 
 	p, err := GetPost(1)
 	if err != nil {
@@ -51,6 +52,9 @@ func TestDeleteImages(t *testing.T) {
 	if p.Image == nil {
 		t.Fatal("no image")
 	}
+	if len(p.Attachments) != 1 {
+		t.Fatal("no attachment")
+	}
 
 	err = DeleteImages([]uint64{1}, "admin")
 	if err != nil {
@@ -64,10 +68,14 @@ func TestDeleteImages(t *testing.T) {
 	if p.Image != nil {
 		t.Fatal("image not deleted")
 	}
+	if len(p.Attachments) != 0 {
+		t.Fatal("attachment not deleted")
+	}
 }
 
 func TestSpoilerImages(t *testing.T) {
 	prepareForModeration(t)
+	insertSampleAttachment(t)
 
 	p, err := GetPost(1)
 	if err != nil {
@@ -89,6 +97,9 @@ func TestSpoilerImages(t *testing.T) {
 	if !p.Image.Spoiler {
 		t.Fatal("no spoiler")
 	}
+	if !p.Attachments[0].Spoiler {
+		t.Fatal("attachment not spoilered")
+	}
 }
 
 func TestDeletePostsByIP(t *testing.T) {
@@ -84,6 +84,7 @@ func Ban(board, reason, by string, length time.Duration, id uint64,
 	}
 
 	// Write ban messages to posts and ban table
+	markPostsWritten("id = ?", id)
 	err = InTransaction(false, func(tx *sql.Tx) (err error) {
 		return writeBan(tx, ip, auth.ModLogEntry{
 			ModerationEntry: common.ModerationEntry{
@@ -105,6 +106,7 @@ func Ban(board, reason, by string, length time.Duration, id uint64,
 
 // Unban lifts a ban from a specific post on a specific board
 func Unban(board string, id uint64, by string) error {
+	markPostsWritten("id = ?", id)
 	return InTransaction(false, func(tx *sql.Tx) (err error) {
 		_, err = sq.Delete("bans").
 			Where("board = ? and forPost = ?", board, id).
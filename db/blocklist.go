@@ -0,0 +1,154 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+// Matches perceptual hashes within the configured Hamming distance of any
+// blocklisted hash. $1 is the hash and $2 the maximum distance.
+const matchBlocklistSQL = `select exists (
+	select 1
+	from phash_blocklist as b
+	where length(replace((b.hash # $1::bigint)::bit(64)::text, '0', ''))
+		<= $2
+)`
+
+// Matches images of posts and their attachments. Both placeholders are the
+// post ID array.
+const postImagesSQL = `(i.sha1 in (select sha1 from posts where id = any(?))
+	or i.sha1 in (select sha1 from post_attachments where post_id = any(?)))`
+
+// Perceptual hashes are unsigned, but stored as signed bigint. Zero hashes
+// carry no information and are stored as null.
+func encodePHash(hash uint64) interface{} {
+	if hash == 0 {
+		return nil
+	}
+	return int64(hash)
+}
+
+// IsBlockedPHash returns, if a perceptual hash matches the image blocklist
+func IsBlockedPHash(tx *sql.Tx, hash uint64) (blocked bool, err error) {
+	if hash == 0 {
+		return
+	}
+	err = tx.QueryRow(matchBlocklistSQL, int64(hash),
+		config.Get().PHashThreshold).
+		Scan(&blocked)
+	return
+}
+
+// IsBlockedImage returns, if an already thumbnailed image matches the image
+// blocklist
+func IsBlockedImage(tx *sql.Tx, sha1 string) (blocked bool, err error) {
+	var hash sql.NullInt64
+	err = sq.Select("phash").
+		From("images").
+		Where("sha1 = ?", sha1).
+		RunWith(tx).
+		QueryRow().
+		Scan(&hash)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil || !hash.Valid:
+		return
+	}
+	return IsBlockedPHash(tx, uint64(hash.Int64))
+}
+
+// GetUnhashedImages retrieves images attached to posts, that have a thumbnail,
+// but no perceptual hash. Images processed before perceptual hashing was added
+// have none.
+func GetUnhashedImages(ids []uint64) (imgs []common.ImageCommon, err error) {
+	arr := encodeUint64Array(ids)
+	err = queryAll(
+		sq.Select("i.sha1", "i.file_type", "i.thumb_type").
+			From("images as i").
+			Where("i.phash is null and i.thumb_type != ?", common.NoFile).
+			Where(postImagesSQL, arr, arr),
+		func(r *sql.Rows) (err error) {
+			var img common.ImageCommon
+			err = r.Scan(&img.SHA1, &img.FileType, &img.ThumbType)
+			if err != nil {
+				return
+			}
+			imgs = append(imgs, img)
+			return
+		},
+	)
+	return
+}
+
+// SetPHash sets the perceptual hash of an image
+func SetPHash(sha1 string, hash uint64) (err error) {
+	_, err = sq.Update("images").
+		Set("phash", encodePHash(hash)).
+		Where("sha1 = ?", sha1).
+		Exec()
+	return
+}
+
+// BlocklistImages permanently deletes all files attached to posts and adds
+// their perceptual hashes to the image blocklist. Files without a perceptual
+// hash are deleted without being blocklisted. Use GetUnhashedImages and
+// SetPHash to compute missing hashes beforehand.
+func BlocklistImages(ids []uint64, by string) error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		// Read hashes before the images are unlinked from the posts
+		type entry struct {
+			hash int64
+			sha1 string
+		}
+		var (
+			entries []entry
+			arr     = encodeUint64Array(ids)
+		)
+		err = queryAll(
+			sq.Select("i.phash", "i.sha1").
+				From("images as i").
+				Where("i.phash is not null").
+				Where(postImagesSQL, arr, arr).
+				RunWith(tx),
+			func(r *sql.Rows) (err error) {
+				var e entry
+				err = r.Scan(&e.hash, &e.sha1)
+				if err != nil {
+					return
+				}
+				entries = append(entries, e)
+				return
+			},
+		)
+		if err != nil {
+			return
+		}
+
+		err = deleteImages(tx, ids, by)
+		if err != nil {
+			return
+		}
+
+		for _, e := range entries {
+			err = BlocklistPHash(tx, uint64(e.hash), e.sha1, by)
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+}
+
+// BlocklistPHash adds a perceptual hash of an image to the image blocklist
+func BlocklistPHash(tx *sql.Tx, hash uint64, sha1, by string) (err error) {
+	_, err = sq.Insert("phash_blocklist").
+		Columns("hash", "sha1", `"by"`).
+		Values(int64(hash), sha1, by).
+		Suffix("on conflict do nothing").
+		RunWith(tx).
+		Exec()
+	return
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestBlocklistImages(t *testing.T) {
+	const hash = 0xf0f0f0f0f0f0f0f0
+
+	prepareForModeration(t)
+	assertTableClear(t, "phash_blocklist")
+	assertExec(t, `update images set phash = $1`, encodePHash(hash))
+	config.Set(config.Configs{
+		PHashThreshold: 1,
+	})
+
+	err := BlocklistImages([]uint64{1}, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := GetPost(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Image != nil {
+		t.Fatal("image not deleted")
+	}
+
+	cases := [...]struct {
+		name    string
+		hash    uint64
+		blocked bool
+	}{
+		{"exact match", hash, true},
+		{"within threshold", hash ^ 1, true},
+		{"outside threshold", hash ^ 3, false},
+		{"no hash", 0, false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var blocked bool
+			err := InTransaction(true, func(tx *sql.Tx) (err error) {
+				blocked, err = IsBlockedPHash(tx, c.hash)
+				return
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, blocked, c.blocked)
+		})
+	}
+}
+
+func TestGetUnhashedImages(t *testing.T) {
+	prepareForModeration(t)
+
+	imgs, err := GetUnhashedImages([]uint64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(imgs), 1)
+	AssertEquals(t, imgs[0].SHA1, assets.StdJPEG.SHA1)
+	AssertEquals(t, imgs[0].ThumbType, assets.StdJPEG.ThumbType)
+
+	err = SetPHash(assets.StdJPEG.SHA1, 0xf0f0f0f0f0f0f0f0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imgs, err = GetUnhashedImages([]uint64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(imgs), 0)
+}
+
+func TestIsBlockedImage(t *testing.T) {
+	const hash = 0x0123456789abcdef
+
+	assertTableClear(t, "images", "phash_blocklist")
+	std := assets.StdJPEG.ImageCommon
+	std.PHash = hash
+	err := WriteImage(std)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertBlocked := func(t *testing.T, sha1 string, std bool) {
+		t.Helper()
+
+		var blocked bool
+		err := InTransaction(false, func(tx *sql.Tx) (err error) {
+			blocked, err = IsBlockedImage(tx, sha1)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, blocked, std)
+	}
+
+	assertBlocked(t, std.SHA1, false)
+
+	err = InTransaction(false, func(tx *sql.Tx) error {
+		return BlocklistPHash(tx, hash, std.SHA1, "admin")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("blocked", func(t *testing.T) {
+		assertBlocked(t, std.SHA1, true)
+	})
+	t.Run("missing image", func(t *testing.T) {
+		assertBlocked(t, GenString(40), false)
+	})
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"strconv"
+)
+
+// Maximum payload size of a Postgres notification
+const maxNotifyPayload = 8000 - 1
+
+// Notify sends a notification with the payload to all processes listening on
+// the channel. Payloads exceeding the Postgres notification size limit are
+// stored in a table and only their ID is sent prefixed with '#'. Use
+// ReadNotifyPayload on the receiving end to resolve those.
+func Notify(channel, payload string) (err error) {
+	if len(payload) > maxNotifyPayload {
+		var id uint64
+		err = sq.Insert("notify_overflow").
+			Columns("data").
+			Values(payload).
+			Suffix("returning id").
+			QueryRow().
+			Scan(&id)
+		if err != nil {
+			return
+		}
+		payload = "#" + strconv.FormatUint(id, 10)
+	}
+	_, err = db.Exec("select pg_notify($1, $2)", channel, payload)
+	return
+}
+
+// ReadNotifyPayload resolves a notification payload sent with Notify
+func ReadNotifyPayload(msg string) (payload string, err error) {
+	if len(msg) == 0 || msg[0] != '#' {
+		return msg, nil
+	}
+	id, err := strconv.ParseUint(msg[1:], 10, 64)
+	if err != nil {
+		return
+	}
+	err = sq.Select("data").
+		From("notify_overflow").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&payload)
+	return
+}
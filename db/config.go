@@ -55,9 +55,10 @@ func decodeConfigs(data string) (c config.Configs, err error) {
 
 func getBoardConfigs() squirrel.SelectBuilder {
 	return sq.Select(
-		"readOnly", "textOnly", "forcedAnon", "disableRobots", "flags", "NSFW",
-		"rbText", "pyu", "id", "defaultCSS", "title", "notice",
-		"rules", "eightball",
+		"readOnly", "textOnly", "forcedAnon", "disableRobots", "editLog",
+		"archive", "archiveDropSources", "stripMetadata", "maxOpenTime",
+		"maxIdleTime", "maxAttachments", "flags", "NSFW", "rbText", "pyu",
+		"id", "defaultCSS", "title", "notice", "rules", "eightball",
 	).
 		From("boards")
 }
@@ -81,8 +82,9 @@ func loadBoardConfigs() (err error) {
 func scanBoardConfigs(r rowScanner) (c config.BoardConfigs, err error) {
 	var eightball pq.StringArray
 	err = r.Scan(
-		&c.ReadOnly, &c.TextOnly, &c.ForcedAnon, &c.DisableRobots, &c.Flags,
-		&c.NSFW, &c.RbText, &c.Pyu,
+		&c.ReadOnly, &c.TextOnly, &c.ForcedAnon, &c.DisableRobots, &c.EditLog,
+		&c.Archive, &c.ArchiveDropSources, &c.StripMetadata, &c.MaxOpenTime,
+		&c.MaxIdleTime, &c.MaxAttachments, &c.Flags, &c.NSFW, &c.RbText, &c.Pyu,
 		&c.ID, &c.DefaultCSS, &c.Title, &c.Notice, &c.Rules, &eightball,
 	)
 	c.Eightball = []string(eightball)
@@ -94,13 +96,16 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 	_, err := sq.Insert("boards").
 		Columns(
 			"id", "readOnly", "textOnly", "forcedAnon", "disableRobots",
-			"flags", "NSFW",
+			"editLog", "archive", "archiveDropSources", "stripMetadata",
+			"maxOpenTime", "maxIdleTime", "maxAttachments", "flags", "NSFW",
 			"rbText", "pyu", "created", "defaultCSS", "title",
 			"notice", "rules", "eightball",
 		).
 		Values(
 			c.ID, c.ReadOnly, c.TextOnly, c.ForcedAnon, c.DisableRobots,
-			c.Flags, c.NSFW, c.RbText, c.Pyu,
+			c.EditLog, c.Archive, c.ArchiveDropSources, c.StripMetadata,
+			c.MaxOpenTime, c.MaxIdleTime, c.MaxAttachments, c.Flags, c.NSFW,
+			c.RbText, c.Pyu,
 			c.Created, c.DefaultCSS, c.Title, c.Notice, c.Rules,
 			pq.StringArray(c.Eightball),
 		).
@@ -113,19 +118,26 @@ func WriteBoard(tx *sql.Tx, c BoardConfigs) error {
 func UpdateBoard(c config.BoardConfigs) (err error) {
 	_, err = sq.Update("boards").
 		SetMap(map[string]interface{}{
-			"readOnly":      c.ReadOnly,
-			"textOnly":      c.TextOnly,
-			"forcedAnon":    c.ForcedAnon,
-			"disableRobots": c.DisableRobots,
-			"flags":         c.Flags,
-			"NSFW":          c.NSFW,
-			"rbText":        c.RbText,
-			"pyu":           c.Pyu,
-			"defaultCSS":    c.DefaultCSS,
-			"title":         c.Title,
-			"notice":        c.Notice,
-			"rules":         c.Rules,
-			"eightball":     pq.StringArray(c.Eightball),
+			"readOnly":           c.ReadOnly,
+			"textOnly":           c.TextOnly,
+			"forcedAnon":         c.ForcedAnon,
+			"disableRobots":      c.DisableRobots,
+			"editLog":            c.EditLog,
+			"archive":            c.Archive,
+			"archiveDropSources": c.ArchiveDropSources,
+			"stripMetadata":      c.StripMetadata,
+			"maxOpenTime":        c.MaxOpenTime,
+			"maxIdleTime":        c.MaxIdleTime,
+			"maxAttachments":     c.MaxAttachments,
+			"flags":              c.Flags,
+			"NSFW":               c.NSFW,
+			"rbText":             c.RbText,
+			"pyu":                c.Pyu,
+			"defaultCSS":         c.DefaultCSS,
+			"title":              c.Title,
+			"notice":             c.Notice,
+			"rules":              c.Rules,
+			"eightball":          pq.StringArray(c.Eightball),
 		}).
 		Where("id = ?", c.ID).
 		Exec()
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"errors"
+)
+
+// Types of operations recorded in an open post's edit log
+const (
+	EditAppend uint8 = iota
+	EditBackspace
+	EditSplice
+)
+
+// Maximum size of an encoded edit log. Operations past this are not recorded.
+const maxEditLogSize = 1 << 20
+
+var errCorruptEditLog = errors.New("corrupt edit log")
+
+// EditOp is a single time-stamped modification of an open post's body
+type EditOp struct {
+	Type uint8 `json:"type"`
+	// Unix time in milliseconds
+	Time  int64  `json:"time"`
+	Start uint   `json:"start,omitempty"`
+	Len   uint   `json:"len,omitempty"`
+	Text  string `json:"text,omitempty"`
+}
+
+// Append the compact binary encoding of the operation to buf
+func (o EditOp) encode(buf []byte) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	putUvarint := func(i uint64) {
+		buf = append(buf, tmp[:binary.PutUvarint(tmp[:], i)]...)
+	}
+
+	buf = append(buf, o.Type)
+	putUvarint(uint64(o.Time))
+	switch o.Type {
+	case EditAppend:
+		putUvarint(uint64(len(o.Text)))
+		buf = append(buf, o.Text...)
+	case EditSplice:
+		putUvarint(uint64(o.Start))
+		putUvarint(uint64(o.Len))
+		putUvarint(uint64(len(o.Text)))
+		buf = append(buf, o.Text...)
+	}
+	return buf
+}
+
+// Decode a binary edit log produced by consecutive EditOp.encode() calls
+func decodeEditLog(buf []byte) (ops []EditOp, err error) {
+	readUvarint := func() uint64 {
+		if err != nil {
+			return 0
+		}
+		i, n := binary.Uvarint(buf)
+		if n <= 0 {
+			err = errCorruptEditLog
+			return 0
+		}
+		buf = buf[n:]
+		return i
+	}
+	readText := func() string {
+		l := readUvarint()
+		if err != nil {
+			return ""
+		}
+		if uint64(len(buf)) < l {
+			err = errCorruptEditLog
+			return ""
+		}
+		s := string(buf[:l])
+		buf = buf[l:]
+		return s
+	}
+
+	for len(buf) != 0 {
+		op := EditOp{Type: buf[0]}
+		buf = buf[1:]
+		op.Time = int64(readUvarint())
+		switch op.Type {
+		case EditAppend:
+			op.Text = readText()
+		case EditBackspace:
+		case EditSplice:
+			op.Start = uint(readUvarint())
+			op.Len = uint(readUvarint())
+			op.Text = readText()
+		default:
+			err = errCorruptEditLog
+		}
+		if err != nil {
+			return
+		}
+		ops = append(ops, op)
+	}
+	return
+}
+
+// AppendEditOp records an operation in the edit log of an open post
+func AppendEditOp(id uint64, op EditOp) error {
+	return openPosts.AppendEditLog(id,
+		op.encode(make([]byte, 0, 16+len(op.Text))), maxEditLogSize)
+}
+
+// Persist the edit log of a post being closed, if any was recorded
+func persistEditLog(tx *sql.Tx, id uint64) (err error) {
+	buf, err := openPosts.GetEditLog(id)
+	if err != nil || len(buf) == 0 {
+		return
+	}
+	_, err = sq.Insert("post_edit_logs").
+		Columns("post_id", "log").
+		Values(id, buf).
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// GetEditLog retrieves the recorded edit log of an open or closed post.
+// Returns nil, if no edits have been recorded.
+func GetEditLog(id uint64) (ops []EditOp, err error) {
+	var buf []byte
+	err = sq.Select("log").
+		From("post_edit_logs").
+		Where("post_id = ?", id).
+		QueryRow().
+		Scan(&buf)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		buf, err = openPosts.GetEditLog(id)
+		if err != nil {
+			return
+		}
+	default:
+		return
+	}
+	return decodeEditLog(buf)
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestEditLog(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return WritePost(tx, Post{
+			StandalonePost: common.StandalonePost{
+				OP:    1,
+				Board: "a",
+				Post: common.Post{
+					ID:      2,
+					Editing: true,
+				},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	std := []EditOp{
+		{
+			Type: EditAppend,
+			Time: 1,
+			Text: "ф",
+		},
+		{
+			Type: EditBackspace,
+			Time: 2,
+		},
+		{
+			Type:  EditSplice,
+			Time:  3,
+			Start: 0,
+			Len:   1,
+			Text:  "foo",
+		},
+	}
+	for _, op := range std {
+		err := AppendEditOp(2, op)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("open", func(t *testing.T) {
+		res, err := GetEditLog(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, res, std)
+	})
+
+	err = ClosePost(2, 1, "foo", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("closed", func(t *testing.T) {
+		buf, err := openPosts.GetEditLog(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if buf != nil {
+			t.Fatal("open edit log not deleted")
+		}
+
+		res, err := GetEditLog(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, res, std)
+	})
+
+	t.Run("no edits", func(t *testing.T) {
+		res, err := GetEditLog(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != nil {
+			t.Fatalf("unexpected edit log: %v", res)
+		}
+	})
+}
@@ -44,12 +44,12 @@ func writeImageTx(tx *sql.Tx, i common.ImageCommon) (err error) {
 		Insert("images").
 		Columns(
 			"audio", "video", "file_type", "thumb_type", "dims", "length",
-			"size", "MD5", "SHA1", "Title", "Artist",
+			"size", "MD5", "SHA1", "Title", "Artist", "phash",
 		).
 		Values(
 			i.Audio, i.Video, int(i.FileType), int(i.ThumbType),
 			pq.GenericArray{A: i.Dims}, i.Length, i.Size, i.MD5, i.SHA1,
-			i.Title, i.Artist,
+			i.Title, i.Artist, encodePHash(i.PHash),
 		).
 		RunWith(tx).
 		Exec()
@@ -85,11 +85,15 @@ func NewImageToken(tx *sql.Tx, SHA1 string) (token string, err error) {
 	}
 }
 
-// ImageExists returns, if image exists
+// ImageExists returns, if image exists and still has its source file
 func ImageExists(tx *sql.Tx, sha1 string) (exists bool, err error) {
 	err = sq.Select("1").
 		From("images").
-		Where("sha1 = ?", sha1).
+		Where(`sha1 = ?
+			and not exists (select 1
+				from dropped_sources as ds
+				where ds.sha1 = images.sha1)`,
+			sha1).
 		Scan(&exists)
 	if err == sql.ErrNoRows {
 		err = nil
@@ -97,12 +101,52 @@ func ImageExists(tx *sql.Tx, sha1 string) (exists bool, err error) {
 	return
 }
 
+// MarkImageStripped records, that an image contains no metadata to strip
+func MarkImageStripped(tx *sql.Tx, sha1 string) (err error) {
+	_, err = sq.Update("images").
+		Set("stripped", true).
+		Where("sha1 = ?", sha1).
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// IsStrippedImage returns, if an image is known to contain no metadata to
+// strip
+func IsStrippedImage(tx *sql.Tx, sha1 string) (stripped bool, err error) {
+	err = sq.Select("stripped").
+		From("images").
+		Where("sha1 = ?", sha1).
+		RunWith(tx).
+		Scan(&stripped)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return
+}
+
 // AllocateImage allocates an image's file resources to their respective served
 // directories and write its data to the database
 func AllocateImage(tx *sql.Tx, src, thumb io.ReadSeeker, img common.ImageCommon,
 ) (
 	err error,
 ) {
+	// Source file was deleted after thread archival. Only restore the source.
+	res, err := sq.Delete("dropped_sources").
+		Where("sha1 = ?", img.SHA1).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if n != 0 {
+		return assets.Write(img.SHA1, img.FileType, img.ThumbType, src, nil)
+	}
+
 	err = writeImageTx(tx, img)
 	if err != nil {
 		return err
@@ -143,6 +187,7 @@ func InsertImage(tx *sql.Tx, postID uint64, token, name string, spoiler bool,
 ) (
 	json []byte, err error,
 ) {
+	markPostsWritten("id = ?", postID)
 	err = tx.QueryRow(
 		`select insert_image($1::bigint,
 			$2::char(86),
@@ -156,14 +201,77 @@ func InsertImage(tx *sql.Tx, postID uint64, token, name string, spoiler bool,
 	return
 }
 
+// InsertAttachment attaches an additional file to an existing open post with
+// an image and returns the file's JSON. The JSON includes the position of the
+// file among the post's files, with the post's image being at position 0.
+func InsertAttachment(tx *sql.Tx, postID uint64, token, name string,
+	spoiler bool,
+) (
+	json []byte, err error,
+) {
+	var (
+		position int
+		sha1     string
+	)
+	markPostsWritten("id = ?", postID)
+	err = tx.QueryRow(
+		`insert into post_attachments (post_id, position, sha1, name, spoiler)
+		select p.id,
+			(select coalesce(max(a.position), 0) + 1
+				from post_attachments as a
+				where a.post_id = p.id),
+			use_image_token($2::char(86)),
+			$3::varchar(200),
+			$4::bool
+		from posts as p
+		where p.id = $1 and p.sha1 is not null
+		returning position, sha1`,
+		postID, token, name, spoiler).
+		Scan(&position, &sha1)
+	if err != nil {
+		if extractException(err) == "invalid image token" {
+			err = ErrInvalidToken
+		}
+		return
+	}
+
+	err = tx.QueryRow(
+		`select to_jsonb(i) - 'phash' || jsonb_build_object(
+				'id', $2::bigint,
+				'position', $3::int,
+				'spoiler', $4::bool,
+				'name', $5::text)
+		from images as i
+		where i.sha1 = $1`,
+		sha1, postID, position, spoiler, name).
+		Scan(&json)
+	return
+}
+
+// Write files attached to a post after its image in order
+func writeAttachments(tx *sql.Tx, postID uint64, files []common.Image,
+) (err error) {
+	for i, f := range files {
+		_, err = sq.Insert("post_attachments").
+			Columns("post_id", "position", "sha1", "name", "spoiler").
+			Values(postID, i+1, f.SHA1, f.Name, f.Spoiler).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
 // GetImage retrieves a thumbnailed image record from the DB.
 //
 // Only used in tests.
 func GetImage(sha1 string) (img common.ImageCommon, err error) {
 	var scanner imageScanner
-	err = sq.Select("*").
-		From("images").
-		Where("SHA1 = ?", sha1).
+	err = sq.Select(imageSelectsSQL).
+		From("images as i").
+		Where("i.SHA1 = ?", sha1).
 		QueryRow().
 		Scan(scanner.ScanArgs()...)
 	if err != nil {
@@ -178,7 +286,11 @@ func SpoilerImage(id, op uint64) error {
 		Set("spoiler", true).
 		Where("id = ?", id).
 		Exec()
-	return err
+	if err != nil {
+		return err
+	}
+	markWritten(op, "")
+	return nil
 }
 
 // VideoPlaylist returns a video playlist for a board
@@ -223,6 +335,7 @@ func deleteUnusedImages() (err error) {
 		delete from images
 		where (
 			(select count(*) from posts where SHA1 = images.SHA1)
+			+ (select count(*) from post_attachments where SHA1 = images.SHA1)
 			+ (select count(*) from image_tokens where SHA1 = images.SHA1)
 		) = 0
 		returning SHA1, file_type, thumb_type`)
@@ -248,3 +361,62 @@ func deleteUnusedImages() (err error) {
 
 	return r.Err()
 }
+
+// Delete source files of images only used in archived threads of boards, that
+// have ArchiveDropSources enabled. Thumbnails are kept.
+func dropArchivedSources() (err error) {
+	r, err := db.Query(`
+		with post_files as (
+			select op, SHA1
+			from posts
+			where SHA1 is not null
+			union all
+			select p.op, a.SHA1
+			from post_attachments as a
+			join posts as p on a.post_id = p.id
+		),
+		dropped as (
+			insert into dropped_sources (SHA1)
+			select i.SHA1
+			from images as i
+			where exists (select 1
+					from post_files as p
+					join threads as t on p.op = t.id
+					where p.SHA1 = i.SHA1 and t.archived)
+				and not exists (select 1
+					from post_files as p
+					join threads as t on p.op = t.id
+					join boards as b on t.board = b.id
+					where p.SHA1 = i.SHA1
+						and (not t.archived or not b.archiveDropSources))
+				and not exists (select 1
+					from image_tokens as it
+					where it.SHA1 = i.SHA1)
+			on conflict do nothing
+			returning SHA1
+		)
+		select d.SHA1, i.file_type
+		from dropped as d
+		join images as i on d.SHA1 = i.SHA1`)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	for r.Next() {
+		var (
+			sha1     string
+			fileType uint8
+		)
+		err = r.Scan(&sha1, &fileType)
+		if err != nil {
+			return
+		}
+		err = assets.DeleteSource(sha1, fileType)
+		if err != nil {
+			return
+		}
+	}
+
+	return r.Err()
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"sync"
@@ -228,6 +229,152 @@ func insertSampleImage(t *testing.T) {
 	}
 }
 
+func insertSampleAttachment(t *testing.T) {
+	t.Helper()
+
+	token := newImageToken(t, assets.StdJPEG.SHA1)
+	err := InTransaction(false, func(tx *sql.Tx) (err error) {
+		_, err = InsertAttachment(tx, 1, token, "attached", false)
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInsertAttachment(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	writeSampleImage(t)
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	token := newImageToken(t, assets.StdJPEG.SHA1)
+	var buf []byte
+	insert := func() error {
+		return InTransaction(false, func(tx *sql.Tx) (err error) {
+			buf, err = InsertAttachment(tx, 1, token, "attached", true)
+			return
+		})
+	}
+
+	t.Run("post without image", func(t *testing.T) {
+		err := insert()
+		if err != sql.ErrNoRows {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	insertSampleImage(t)
+
+	t.Run("attach", func(t *testing.T) {
+		token = newImageToken(t, assets.StdJPEG.SHA1)
+		err := insert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		type result struct {
+			common.Image
+			ID       uint64
+			Position int
+		}
+		var img result
+		err = json.Unmarshal(buf, &img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		std := assets.StdJPEG
+		std.Name = "attached"
+		std.Spoiler = true
+		test.AssertEquals(t, img, result{
+			ID:       1,
+			Position: 1,
+			Image:    std,
+		})
+
+		// Test token is properly expended
+		err = insert()
+		if err != ErrInvalidToken {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("read", func(t *testing.T) {
+		insertSampleAttachment(t)
+
+		p, err := GetPost(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, len(p.Attachments), 2)
+		test.AssertEquals(t, p.Attachments[0].Name, "attached")
+		test.AssertEquals(t, p.Attachments[0].Spoiler, true)
+		test.AssertEquals(t, p.Attachments[1].Spoiler, false)
+		test.AssertEquals(t, p.Attachments[1].SHA1, assets.StdJPEG.SHA1)
+		test.AssertEquals(t, len(p.Files()), 3)
+	})
+}
+
+func TestReadThreadAttachments(t *testing.T) {
+	assertTableClear(t, "images", "boards")
+	writeSampleImage(t)
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	err := InTransaction(false, func(tx *sql.Tx) (err error) {
+		// Replies are read with a reused scanner
+		for _, id := range [...]uint64{2, 3} {
+			err = InsertPost(tx, &Post{
+				StandalonePost: common.StandalonePost{
+					Post: common.Post{
+						ID:   id,
+						Time: time.Now().Unix(),
+					},
+					OP:    1,
+					Board: "a",
+				},
+				IP: "::1",
+			})
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// All posts have an image and an attachment with a distinct name
+	for _, id := range [...]uint64{1, 2, 3} {
+		name := fmt.Sprintf("attached %d", id)
+		err := InTransaction(false, func(tx *sql.Tx) (err error) {
+			std := assets.StdJPEG
+			_, err = InsertImage(tx, id,
+				newImageToken(t, std.SHA1), std.Name, false)
+			if err != nil {
+				return
+			}
+			_, err = InsertAttachment(tx, id,
+				newImageToken(t, std.SHA1), name, false)
+			return
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	thread, err := GetThread(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, len(thread.Posts), 2)
+	for _, p := range append(thread.Posts, thread.Post) {
+		test.AssertEquals(t, len(p.Attachments), 1)
+		test.AssertEquals(t, p.Attachments[0].Name,
+			fmt.Sprintf("attached %d", p.ID))
+	}
+}
+
 func TestSpoilerImage(t *testing.T) {
 	assertTableClear(t, "images", "boards")
 	writeSampleImage(t)
@@ -6,14 +6,12 @@ import (
 	"os"
 	"os/exec"
 	"os/user"
-	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/util"
-	"github.com/boltdb/bolt"
 	"github.com/go-playground/log"
 	_ "github.com/lib/pq" // Postgres driver
 )
@@ -32,9 +30,6 @@ var (
 
 	// Statement builder and cacher
 	sq squirrel.StatementBuilderType
-
-	// Embedded database for temporary storage
-	boltDB *bolt.DB
 )
 
 // Connects to PostgreSQL database and performs schema upgrades
@@ -80,11 +75,15 @@ func LoadTestDB(suffix string) (close func() error, err error) {
 	}
 
 	close = func() (err error) {
-		err = boltDB.Close()
+		err = openPosts.Close()
 		if err != nil {
 			return
 		}
-		return os.Remove(fmt.Sprintf("db%s.db", suffix))
+		err = os.Remove(fmt.Sprintf("db%s.db", suffix))
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
 	}
 
 	fmt.Println("creating test database:", name)
@@ -105,21 +104,19 @@ func LoadTestDB(suffix string) (close func() error, err error) {
 }
 
 func loadDB(dbSuffix string) (err error) {
-	db, err = sql.Open("postgres", ConnArgs)
+	err = Connect()
 	if err != nil {
 		return
 	}
 
-	sq = squirrel.StatementBuilder.
-		RunWith(squirrel.NewStmtCacheProxy(db)).
-		PlaceholderFormat(squirrel.Dollar)
+	if !common.IsTest {
+		err = openReplicas()
+		if err != nil {
+			return
+		}
+	}
 
-	var exists bool
-	const q = `select exists (
-			select 1 from information_schema.tables
-				where table_schema = 'public' and table_name = 'main'
-		)`
-	err = db.QueryRow(q).Scan(&exists)
+	exists, err := isInitialized()
 	if err != nil {
 		return
 	}
@@ -137,13 +134,20 @@ func loadDB(dbSuffix string) (err error) {
 		func() error {
 			tasks := []func() error{loadConfigs, loadBans, handleSpamScores}
 			if config.ImagerMode != config.ImagerOnly {
-				tasks = append(tasks, openBoltDB(dbSuffix), loadBanners,
+				tasks = append(tasks, loadOpenPostStore(dbSuffix), loadBanners,
 					loadLoadingAnimations, loadThreadPostCounts)
 			}
 			if err := util.Parallel(tasks...); err != nil {
 				return err
 			}
 
+			// Depends on loadOpenPostStore
+			if config.ImagerMode != config.ImagerOnly {
+				if err := markRecoverablePosts(); err != nil {
+					return err
+				}
+			}
+
 			// Depends on loadBanners and loadLoadingAnimations, so has to be
 			// sequential
 			return loadBoardConfigs()
@@ -163,42 +167,11 @@ func loadDB(dbSuffix string) (err error) {
 	return nil
 }
 
-func openBoltDB(dbSuffix string) func() error {
-	return func() (err error) {
-		boltDB, err = bolt.Open(
-			fmt.Sprintf("db%s.db", dbSuffix),
-			0600,
-			&bolt.Options{
-				Timeout: time.Second,
-			})
-		if err != nil {
-			return
-		}
-		return boltDB.Update(func(tx *bolt.Tx) error {
-			_, err := tx.CreateBucketIfNotExists([]byte("open_bodies"))
-			return err
-		})
-	}
-}
-
 // initDB initializes a database
 func initDB() (err error) {
 	log.Info("initializing database")
 
-	_, err = db.Exec(
-		`create table main (
-			id text primary key,
-			val text not null
-		)`,
-	)
-	if err != nil {
-		return
-	}
-	_, err = db.Exec(
-		`insert into main (id, val)
-		values ('version', '0'),
-				('pyu', '0')`,
-	)
+	err = InTransaction(false, createMainTable)
 	if err != nil {
 		return
 	}
@@ -231,21 +204,10 @@ func CreateSystemAccount(tx *sql.Tx) (err error) {
 // ClearTables deletes the contents of specified DB tables. Only used for tests.
 func ClearTables(tables ...string) error {
 	for _, t := range tables {
-		// Clear open post body bucket
+		// Clear open post data
 		switch t {
 		case "boards", "threads", "posts":
-			err := boltDB.Update(func(tx *bolt.Tx) error {
-				buc := tx.Bucket([]byte("open_bodies"))
-				c := buc.Cursor()
-				for k, _ := c.First(); k != nil; k, _ = c.Next() {
-					err := buc.Delete(k)
-					if err != nil {
-						return err
-					}
-				}
-				return nil
-			})
-			if err != nil {
+			if err := openPosts.Clear(); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,340 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bakape/meguca/common"
+	"github.com/go-playground/log"
+	"github.com/lib/pq"
+)
+
+// MigrationStatus describes a single schema migration
+type MigrationStatus struct {
+	// Schema version the migration upgrades the database to
+	Version int
+
+	// Migration has been applied to the database
+	Applied bool
+
+	// Migration has a down step and can be reverted
+	Reversible bool
+}
+
+// Connect connects to the PostgreSQL database without performing schema
+// upgrades or loading any data
+func Connect() (err error) {
+	db, err = sql.Open("postgres", ConnArgs)
+	if err != nil {
+		return
+	}
+	sq = squirrel.StatementBuilder.
+		RunWith(squirrel.NewStmtCacheProxy(db)).
+		PlaceholderFormat(squirrel.Dollar)
+	return
+}
+
+// SchemaVersion returns the database schema version required by the codebase
+func SchemaVersion() int {
+	return version
+}
+
+// GetMigrationStatus returns the current schema version of the database and
+// the status of all migrations known to the codebase
+func GetMigrationStatus() (current int, migs []MigrationStatus, err error) {
+	current, err = getSchemaVersion()
+	if err != nil {
+		return
+	}
+	migs = make([]MigrationStatus, len(migrations))
+	for i := range migs {
+		v := i + 1
+		_, rev := downMigrations[v]
+		migs[i] = MigrationStatus{
+			Version:    v,
+			Applied:    v <= current,
+			Reversible: rev,
+		}
+	}
+	return
+}
+
+// Migrate upgrades or downgrades the database schema to the target version.
+// If dryRun is set, the SQL statements of the migrations are written to w and
+// rolled back instead of being applied.
+func Migrate(target int, dryRun bool, w io.Writer) (err error) {
+	if target < 0 || target > version {
+		return fmt.Errorf("invalid schema version: %d", target)
+	}
+	current, err := getSchemaVersion()
+	if err != nil {
+		return
+	}
+	if current > version {
+		return errors.New("database version ahead of codebase")
+	}
+	for v := current; v > target; v-- {
+		if downMigrations[v] == nil {
+			return fmt.Errorf("migration to version %d is not reversible", v)
+		}
+	}
+
+	if dryRun {
+		return dryRunMigrations(current, target, w)
+	}
+	if current == 0 && target != 0 {
+		err = InTransaction(false, createMainTable)
+		if err != nil {
+			return
+		}
+	}
+	return migrate(target)
+}
+
+// Run migrations, till the DB version matches the code version
+func runMigrations() error {
+	return migrate(version)
+}
+
+// Run migrations one per transaction, till the DB version matches target
+func migrate(target int) (err error) {
+	for {
+		var (
+			currentVersion, nextVersion int
+			done                        bool
+		)
+		err = InTransaction(false, func(tx *sql.Tx) (err error) {
+			// Lock version column to ensure no migrations from other processes
+			// happen concurrently
+			err = sq.Select("val").
+				From("main").
+				Where("id = 'version'").
+				Suffix("for update").
+				RunWith(tx).
+				QueryRow().
+				Scan(&currentVersion)
+			if err != nil {
+				return
+			}
+			switch {
+			case currentVersion == target:
+				done = true
+				return
+			case currentVersion > version:
+				return errors.New("database version ahead of codebase")
+			case currentVersion < target:
+				nextVersion = currentVersion + 1
+				if !common.IsTest {
+					log.Infof("upgrading database to version %d", nextVersion)
+				}
+			default:
+				nextVersion = currentVersion - 1
+				if !common.IsTest {
+					log.Infof("downgrading database to version %d",
+						nextVersion)
+				}
+			}
+			return migrateStep(tx, currentVersion, nextVersion)
+		})
+		if err != nil {
+			return fmt.Errorf("migration error: %d -> %d: %s",
+				currentVersion, nextVersion, err)
+		}
+		if done {
+			return
+		}
+	}
+}
+
+// Run the migration between two adjacent versions and write the new version
+// number
+func migrateStep(tx *sql.Tx, from, to int) (err error) {
+	if to > from {
+		err = migrations[from](tx)
+	} else if fn := downMigrations[from]; fn != nil {
+		err = fn(tx)
+	} else {
+		err = fmt.Errorf("migration to version %d is not reversible", from)
+	}
+	if err != nil {
+		return
+	}
+
+	_, err = sq.Update("main").
+		Set("val", to).
+		Where("id = 'version'").
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// Run migrations from one version to another in a single transaction, that
+// is rolled back afterwards. All executed statements are written to w.
+func dryRunMigrations(from, to int, w io.Writer) (err error) {
+	conn, err := pq.NewConnector(ConnArgs)
+	if err != nil {
+		return
+	}
+	logDB := sql.OpenDB(statementLogger{conn, w})
+	defer logDB.Close()
+
+	tx, err := logDB.Begin()
+	if err != nil {
+		return
+	}
+	defer tx.Rollback()
+
+	if from == 0 && to != 0 {
+		fmt.Fprintln(w, "-- initialize database")
+		err = createMainTable(tx)
+		if err != nil {
+			return
+		}
+	}
+	for from != to {
+		next := from + 1
+		if to < from {
+			next = from - 1
+		}
+		fmt.Fprintf(w, "-- %d -> %d\n", from, next)
+		err = migrateStep(tx, from, next)
+		if err != nil {
+			return fmt.Errorf("migration error: %d -> %d: %s", from, next, err)
+		}
+		from = next
+	}
+	return
+}
+
+// Create the table holding the schema version and other global values
+func createMainTable(tx *sql.Tx) error {
+	return execAll(tx,
+		`create table main (
+			id text primary key,
+			val text not null
+		)`,
+		`insert into main (id, val)
+		values ('version', '0'),
+				('pyu', '0')`,
+	)
+}
+
+// Returns, if the database has been initialized
+func isInitialized() (exists bool, err error) {
+	const q = `select exists (
+			select 1 from information_schema.tables
+				where table_schema = 'public' and table_name = 'main'
+		)`
+	err = db.QueryRow(q).Scan(&exists)
+	return
+}
+
+// Read the schema version of the database. Returns 0, if the database has not
+// been initialized.
+func getSchemaVersion() (v int, err error) {
+	ok, err := isInitialized()
+	if err != nil || !ok {
+		return
+	}
+	err = db.QueryRow(`select val from main where id = 'version'`).Scan(&v)
+	return
+}
+
+// Wraps a driver.Connector to write all statements executed through its
+// connections to w
+type statementLogger struct {
+	driver.Connector
+	w io.Writer
+}
+
+func (s statementLogger) Connect(ctx context.Context) (driver.Conn, error) {
+	c, err := s.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return loggedConn{c, s.w}, nil
+}
+
+// Write a statement and its arguments, if any
+func logStatement(w io.Writer, query string, args interface{}) {
+	query = strings.TrimSpace(query)
+	if !strings.HasSuffix(query, ";") {
+		query += ";"
+	}
+	switch args := args.(type) {
+	case []driver.Value:
+		if len(args) != 0 {
+			fmt.Fprintf(w, "%s -- %v\n", query, args)
+			return
+		}
+	case []driver.NamedValue:
+		if len(args) != 0 {
+			vals := make([]driver.Value, len(args))
+			for i, a := range args {
+				vals[i] = a.Value
+			}
+			fmt.Fprintf(w, "%s -- %v\n", query, vals)
+			return
+		}
+	}
+	fmt.Fprintln(w, query)
+}
+
+// Connection, that logs all executed statements
+type loggedConn struct {
+	driver.Conn
+	w io.Writer
+}
+
+func (c loggedConn) Prepare(query string) (driver.Stmt, error) {
+	s, err := c.Conn.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return loggedStmt{s, query, c.w}, nil
+}
+
+func (c loggedConn) ExecContext(ctx context.Context, query string,
+	args []driver.NamedValue,
+) (driver.Result, error) {
+	e, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	logStatement(c.w, query, args)
+	return e.ExecContext(ctx, query, args)
+}
+
+func (c loggedConn) QueryContext(ctx context.Context, query string,
+	args []driver.NamedValue,
+) (driver.Rows, error) {
+	q, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	logStatement(c.w, query, args)
+	return q.QueryContext(ctx, query, args)
+}
+
+// Prepared statement, that logs each execution
+type loggedStmt struct {
+	driver.Stmt
+	query string
+	w     io.Writer
+}
+
+func (s loggedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	logStatement(s.w, s.query, args)
+	return s.Stmt.Exec(args)
+}
+
+func (s loggedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	logStatement(s.w, s.query, args)
+	return s.Stmt.Query(args)
+}
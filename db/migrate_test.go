@@ -0,0 +1,81 @@
+package db
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestDownMigrationVersions(t *testing.T) {
+	for v := range downMigrations {
+		if v < 1 || v > version {
+			t.Fatalf("down migration for unknown version: %d", v)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	const target = 104
+
+	assertVersion := func(t *testing.T, v int) {
+		t.Helper()
+		current, _, err := GetMigrationStatus()
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, current, v)
+	}
+
+	assertVersion(t, version)
+
+	t.Run("irreversible", func(t *testing.T) {
+		err := Migrate(target-1, false, nil)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertVersion(t, version)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		var w bytes.Buffer
+		err := Migrate(target, true, &w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(w.String(), "drop table open_post_data;") {
+			t.Fatalf("statement not logged:\n%s", w.String())
+		}
+		assertVersion(t, version)
+	})
+
+	t.Run("down", func(t *testing.T) {
+		err := Migrate(target, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertVersion(t, target)
+
+		_, migs, err := GetMigrationStatus()
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, migs[target-1], MigrationStatus{
+			Version: target,
+			Applied: true,
+		})
+		AssertEquals(t, migs[target], MigrationStatus{
+			Version:    target + 1,
+			Reversible: true,
+		})
+	})
+
+	t.Run("up", func(t *testing.T) {
+		err := Migrate(version, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertVersion(t, version)
+	})
+}
@@ -15,7 +15,6 @@ import (
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/static"
 	"github.com/bakape/meguca/util"
-	"github.com/go-playground/log"
 	"github.com/lib/pq"
 )
 
@@ -1445,6 +1444,253 @@ var migrations = []func(*sql.Tx) error{
 	func(tx *sql.Tx) (err error) {
 		return loadSQL(tx, "triggers/posts")
 	},
+	func(tx *sql.Tx) (err error) {
+		// Notification payloads exceeding the Postgres size limit
+		_, err = tx.Exec(
+			`create unlogged table notify_overflow (
+				id bigserial primary key,
+				data text not null,
+				expires timestamp not null
+					default now() at time zone 'utc' + interval '1 minute'
+			)`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		return loadSQL(tx, "triggers/threads")
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards add column editLog bool default false`,
+			`create table post_edit_logs (
+				post_id bigint primary key
+					references posts on delete cascade,
+				log bytea not null
+			)`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`alter table boards add column maxOpenTime int not null default 0`,
+			`alter table boards add column maxIdleTime int not null default 0`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		// Full-text search indexes
+		return execAll(tx,
+			`create index posts_body_fts on posts
+				using gin (to_tsvector('simple', body))`,
+			`create index threads_subject_fts on threads
+				using gin (to_tsvector('simple', subject))`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`alter table boards
+				add column archive bool not null default false`,
+			`alter table boards
+				add column archiveDropSources bool not null default false`,
+			`alter table threads
+				add column archived bool not null default false`,
+			createIndex("threads", "archived"),
+			// Images with source files deleted after thread archival
+			`create table dropped_sources (
+				SHA1 char(40) primary key references images on delete cascade
+			)`,
+		)
+		if err != nil {
+			return
+		}
+		return loadSQL(tx, "triggers/threads")
+	},
+	func(tx *sql.Tx) (err error) {
+		// Transient data of open posts for PostgresOpenPostStore. Not
+		// referencing posts, as bodies are written outside of the post
+		// insertion transaction.
+		_, err = tx.Exec(
+			`create unlogged table open_post_data (
+				id bigint primary key,
+				last_edit bigint,
+				recovery_deadline bigint,
+				body text,
+				edit_log bytea
+			)`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		// Hourly board activity statistics
+		return execAll(tx,
+			`create table board_stats (
+				board varchar(10) not null
+					references boards on delete cascade,
+				hour timestamp not null,
+				posts int not null default 0,
+				threads int not null default 0,
+				images int not null default 0,
+				unique_posters int not null default 0,
+				peak_viewers int not null default 0,
+				primary key (board, hour)
+			)`,
+			`insert into main (id, val) values ('stats_hour', '0')`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		// Retain the previously hard-coded retention periods
+		return patchConfigs(tx, func(conf *config.Configs) {
+			conf.IPRetention = config.Defaults.IPRetention
+			conf.ModLogRetention = config.Defaults.ModLogRetention
+			conf.ReportRetention = config.Defaults.ReportRetention
+			conf.EngagementRetention = config.Defaults.EngagementRetention
+		})
+	},
+	func(tx *sql.Tx) (err error) {
+		err = execAll(tx,
+			`alter table images add column phash bigint`,
+			`create table phash_blocklist (
+				hash bigint primary key,
+				sha1 char(40) not null,
+				"by" text not null,
+				created timestamp not null default now()
+			)`,
+		)
+		if err != nil {
+			return
+		}
+		err = dropFunctions(tx, "insert_image(bigint, char, varchar, bool)")
+		if err != nil {
+			return
+		}
+		err = registerFunctions(tx, "insert_image")
+		if err != nil {
+			return
+		}
+		return patchConfigs(tx, func(conf *config.Configs) {
+			conf.PHashThreshold = config.Defaults.PHashThreshold
+		})
+	},
+	func(tx *sql.Tx) (err error) {
+		// Files attached to a post after the one stored in the posts table
+		return execAll(tx,
+			`create table post_attachments (
+				post_id bigint not null references posts on delete cascade,
+				position smallint not null,
+				sha1 char(40) not null references images on delete cascade,
+				name varchar(200) not null,
+				spoiler boolean not null default false,
+				primary key (post_id, position)
+			)`,
+			createIndex("post_attachments", "sha1"),
+			`alter table boards
+				add column maxAttachments smallint not null default 1`,
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(
+			`alter table boards
+				add column stripMetadata bool not null default true`,
+		)
+		if err != nil {
+			return
+		}
+		return patchConfigs(tx, func(conf *config.Configs) {
+			conf.CorrectOrientation = config.Defaults.CorrectOrientation
+		})
+	},
+	func(tx *sql.Tx) (err error) {
+		// Uploaded files queued for asynchronous processing
+		return execAll(tx,
+			`create table upload_jobs (
+				id char(32) primary key,
+				state varchar(10) not null,
+				ip inet not null,
+				size bigint not null,
+				strip bool not null,
+				created timestamp not null,
+				expires timestamp not null,
+				token varchar(86) not null default '',
+				error text not null default '',
+				code smallint not null default 0
+			)`,
+			createIndex("upload_jobs", "state"),
+			createIndex("upload_jobs", "expires"),
+		)
+	},
+	func(tx *sql.Tx) (err error) {
+		// Images known to contain no metadata to strip
+		_, err = tx.Exec(
+			`alter table images
+				add column stripped bool not null default false`,
+		)
+		return
+	},
+	func(tx *sql.Tx) (err error) {
+		// Latest board viewer counts of each server instance
+		_, err = tx.Exec(
+			`create table board_viewers (
+				board varchar(10) not null
+					references boards on delete cascade,
+				node text not null,
+				viewers int not null,
+				updated timestamp not null,
+				primary key (board, node)
+			)`,
+		)
+		return
+	},
+}
+
+// Down steps reverting migrations, indexed by the schema version the migration
+// upgrades the database to. Add one for each new migration, where possible.
+var downMigrations = map[int]func(*sql.Tx) error{
+	103: func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`drop index posts_body_fts`,
+			`drop index threads_subject_fts`,
+		)
+	},
+	105: func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`drop table open_post_data`)
+		return
+	},
+	106: func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`drop table board_stats`,
+			`delete from main where id = 'stats_hour'`,
+		)
+	},
+	107: func(tx *sql.Tx) (err error) {
+		// Older versions ignore the retention configuration fields
+		return nil
+	},
+	108: func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`drop table phash_blocklist`,
+			`alter table images drop column phash`,
+		)
+	},
+	109: func(tx *sql.Tx) (err error) {
+		return execAll(tx,
+			`drop table post_attachments`,
+			`alter table boards drop column maxAttachments`,
+		)
+	},
+	110: func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`alter table boards drop column stripMetadata`)
+		return
+	},
+	111: func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`drop table upload_jobs`)
+		return
+	},
+	112: func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`alter table images drop column stripped`)
+		return
+	},
+	113: func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`drop table board_viewers`)
+		return
+	},
 }
 
 func createIndex(table string, columns ...string) string {
@@ -1503,61 +1749,6 @@ func loadSQL(tx *sql.Tx, paths ...string) (err error) {
 	return
 }
 
-// Run migrations, till the DB version matches the code version
-func runMigrations() (err error) {
-	for {
-		var (
-			currentVersion int
-			done           bool
-		)
-		err = InTransaction(false, func(tx *sql.Tx) (err error) {
-			// Lock version column to ensure no migrations from other processes
-			// happen concurrently
-			err = sq.Select("val").
-				From("main").
-				Where("id = 'version'").
-				Suffix("for update").
-				RunWith(tx).
-				QueryRow().
-				Scan(&currentVersion)
-			if err != nil {
-				return
-			}
-			if currentVersion == version {
-				done = true
-				return
-			}
-			if currentVersion > version {
-				log.Fatal("database version ahead of codebase")
-			}
-
-			if !common.IsTest {
-				log.Infof("upgrading database to version %d", currentVersion+1)
-			}
-
-			err = migrations[currentVersion](tx)
-			if err != nil {
-				return
-			}
-
-			// Write new version number
-			_, err = sq.Update("main").
-				Set("val", currentVersion+1).
-				Where("id = 'version'").
-				RunWith(tx).
-				Exec()
-			return
-		})
-		if err != nil {
-			return fmt.Errorf("migration error: %d -> %d: %s",
-				currentVersion, currentVersion+1, err)
-		}
-		if done {
-			return
-		}
-	}
-}
-
 // Patches server configuration during upgrades
 func patchConfigs(tx *sql.Tx, fn func(*config.Configs)) (err error) {
 	var s string
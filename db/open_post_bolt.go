@@ -0,0 +1,252 @@
+package db
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// Stores open post data in a local BoltDB file
+type boltStore struct {
+	db *bolt.DB
+}
+
+// Open or create a BoltDB open post store file
+func openBoltStore(path string) (s boltStore, err error) {
+	s.db, err = bolt.Open(path, 0600, &bolt.Options{
+		Timeout: time.Second,
+	})
+	if err != nil {
+		return
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [...]string{
+			"open_bodies",
+			"last_edits",
+			"recoverable_posts",
+			"edit_logs",
+		} {
+			_, err := tx.CreateBucketIfNotExists([]byte(b))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func bodyBucket(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket([]byte("open_bodies"))
+}
+
+func lastEditBucket(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket([]byte("last_edits"))
+}
+
+func recoveryBucket(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket([]byte("recoverable_posts"))
+}
+
+func editLogBucket(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket([]byte("edit_logs"))
+}
+
+// Returns all buckets storing data of individual open posts
+func openPostBuckets(tx *bolt.Tx) [4]*bolt.Bucket {
+	return [...]*bolt.Bucket{
+		bodyBucket(tx),
+		lastEditBucket(tx),
+		recoveryBucket(tx),
+		editLogBucket(tx),
+	}
+}
+
+// Encode uint64 for storage in BoltDB without heap allocations
+func encodeUint64(i uint64) [8]byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], i)
+	return buf
+}
+
+// Same as encodeUint64, but allocates on the heap. In some cases, where the
+// buffer must persist after the end of the transaction, this is needed.
+func encodeUint64Heap(i uint64) []byte {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, i)
+	return buf
+}
+
+// Read a uint64 value of a post from a bucket. Returns 0, if none.
+func (s boltStore) getUint64(buc func(*bolt.Tx) *bolt.Bucket, id uint64) (
+	i uint64, err error,
+) {
+	key := encodeUint64(id)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		if v := buc(tx).Get(key[:]); v != nil {
+			i = binary.LittleEndian.Uint64(v)
+		}
+		return nil
+	})
+	return
+}
+
+func (s boltStore) SetBody(id uint64, body []byte, lastEdit int64) error {
+	key := encodeUint64(id)
+	t := encodeUint64Heap(uint64(lastEdit))
+	return s.db.Batch(func(tx *bolt.Tx) error {
+		err := bodyBucket(tx).Put(key[:], body)
+		if err != nil {
+			return err
+		}
+		return lastEditBucket(tx).Put(key[:], t)
+	})
+}
+
+func (s boltStore) GetBodies(ids []uint64) (
+	bodies map[uint64]string, err error,
+) {
+	bodies = make(map[uint64]string, len(ids))
+	err = s.db.View(func(tx *bolt.Tx) error {
+		buc := bodyBucket(tx)
+		for _, id := range ids {
+			key := encodeUint64(id)
+			if v := buc.Get(key[:]); v != nil {
+				bodies[id] = string(v)
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (s boltStore) GetLastEdit(id uint64) (int64, error) {
+	t, err := s.getUint64(lastEditBucket, id)
+	return int64(t), err
+}
+
+func (s boltStore) GetLastEdits(ids []uint64) (
+	times map[uint64]int64, err error,
+) {
+	times = make(map[uint64]int64, len(ids))
+	err = s.db.View(func(tx *bolt.Tx) error {
+		buc := lastEditBucket(tx)
+		for _, id := range ids {
+			key := encodeUint64(id)
+			if v := buc.Get(key[:]); v != nil {
+				times[id] = int64(binary.LittleEndian.Uint64(v))
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (s boltStore) SetRecoveryDeadline(ids []uint64, deadline int64) error {
+	buf := encodeUint64Heap(uint64(deadline))
+	return s.db.Update(func(tx *bolt.Tx) (err error) {
+		buc := recoveryBucket(tx)
+		for _, id := range ids {
+			err = buc.Put(encodeUint64Heap(id), buf)
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+}
+
+func (s boltStore) GetRecoveryDeadline(id uint64) (int64, error) {
+	t, err := s.getUint64(recoveryBucket, id)
+	return int64(t), err
+}
+
+func (s boltStore) GetRecoverable(now int64) (
+	ids map[uint64]struct{}, err error,
+) {
+	ids = make(map[uint64]struct{})
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return recoveryBucket(tx).ForEach(func(k, v []byte) error {
+			if int64(binary.LittleEndian.Uint64(v)) > now {
+				ids[binary.LittleEndian.Uint64(k)] = struct{}{}
+			}
+			return nil
+		})
+	})
+	return
+}
+
+func (s boltStore) AppendEditLog(id uint64, ops []byte, maxSize int) error {
+	key := encodeUint64(id)
+	return s.db.Batch(func(tx *bolt.Tx) error {
+		buc := editLogBucket(tx)
+		old := buc.Get(key[:])
+		if len(old) >= maxSize {
+			return nil
+		}
+		// Values returned by BoltDB are only valid for the duration of the
+		// transaction and must not be modified
+		buf := make([]byte, 0, len(old)+len(ops))
+		buf = append(buf, old...)
+		return buc.Put(key[:], append(buf, ops...))
+	})
+}
+
+func (s boltStore) GetEditLog(id uint64) (buf []byte, err error) {
+	key := encodeUint64(id)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		if v := editLogBucket(tx).Get(key[:]); v != nil {
+			buf = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	return
+}
+
+func (s boltStore) IDs() (ids []uint64, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		buc := bodyBucket(tx)
+		ids = make([]uint64, 0, buc.Stats().KeyN)
+		return buc.ForEach(func(k, _ []byte) error {
+			ids = append(ids, binary.LittleEndian.Uint64(k))
+			return nil
+		})
+	})
+	return
+}
+
+func (s boltStore) Delete(ids ...uint64) error {
+	return s.db.Batch(func(tx *bolt.Tx) (err error) {
+		buckets := openPostBuckets(tx)
+		for _, id := range ids {
+			k := encodeUint64Heap(id)
+			for _, buc := range buckets {
+				err = buc.Delete(k)
+				if err != nil {
+					return
+				}
+			}
+		}
+		return
+	})
+}
+
+func (s boltStore) Clear() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, buc := range openPostBuckets(tx) {
+			c := buc.Cursor()
+			for k, _ := c.First(); k != nil; k, _ = c.Next() {
+				err := buc.Delete(k)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s boltStore) Close() error {
+	return s.db.Close()
+}
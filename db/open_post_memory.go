@@ -0,0 +1,176 @@
+package db
+
+import "sync"
+
+// Data of a single open post in a memoryStore
+type memoryOpenPost struct {
+	hasBody                    bool
+	lastEdit, recoveryDeadline int64
+	body, editLog              []byte
+}
+
+// Stores open post data in process memory
+type memoryStore struct {
+	mu    sync.RWMutex
+	posts map[uint64]*memoryOpenPost
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		posts: make(map[uint64]*memoryOpenPost),
+	}
+}
+
+// Return the data of a post, creating it, if none. Requires lock.
+func (s *memoryStore) get(id uint64) *memoryOpenPost {
+	p := s.posts[id]
+	if p == nil {
+		p = new(memoryOpenPost)
+		s.posts[id] = p
+	}
+	return p
+}
+
+// Read data of a post. p is nil, if no data is stored.
+func (s *memoryStore) view(id uint64, fn func(p *memoryOpenPost)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.posts[id])
+}
+
+func (s *memoryStore) SetBody(id uint64, body []byte, lastEdit int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.get(id)
+	p.hasBody = true
+	p.body = append(p.body[:0], body...)
+	p.lastEdit = lastEdit
+	return nil
+}
+
+func (s *memoryStore) GetBodies(ids []uint64) (map[uint64]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	bodies := make(map[uint64]string, len(ids))
+	for _, id := range ids {
+		if p := s.posts[id]; p != nil && p.hasBody {
+			bodies[id] = string(p.body)
+		}
+	}
+	return bodies, nil
+}
+
+func (s *memoryStore) GetLastEdit(id uint64) (t int64, err error) {
+	s.view(id, func(p *memoryOpenPost) {
+		if p != nil {
+			t = p.lastEdit
+		}
+	})
+	return
+}
+
+func (s *memoryStore) GetLastEdits(ids []uint64) (map[uint64]int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	times := make(map[uint64]int64, len(ids))
+	for _, id := range ids {
+		if p := s.posts[id]; p != nil && p.lastEdit != 0 {
+			times[id] = p.lastEdit
+		}
+	}
+	return times, nil
+}
+
+func (s *memoryStore) SetRecoveryDeadline(ids []uint64, deadline int64,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		s.get(id).recoveryDeadline = deadline
+	}
+	return nil
+}
+
+func (s *memoryStore) GetRecoveryDeadline(id uint64) (t int64, err error) {
+	s.view(id, func(p *memoryOpenPost) {
+		if p != nil {
+			t = p.recoveryDeadline
+		}
+	})
+	return
+}
+
+func (s *memoryStore) GetRecoverable(now int64) (
+	map[uint64]struct{}, error,
+) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make(map[uint64]struct{})
+	for id, p := range s.posts {
+		if p.recoveryDeadline > now {
+			ids[id] = struct{}{}
+		}
+	}
+	return ids, nil
+}
+
+func (s *memoryStore) AppendEditLog(id uint64, ops []byte, maxSize int,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.get(id)
+	if len(p.editLog) < maxSize {
+		p.editLog = append(p.editLog, ops...)
+	}
+	return nil
+}
+
+func (s *memoryStore) GetEditLog(id uint64) (buf []byte, err error) {
+	s.view(id, func(p *memoryOpenPost) {
+		if p != nil && len(p.editLog) != 0 {
+			buf = append([]byte(nil), p.editLog...)
+		}
+	})
+	return
+}
+
+func (s *memoryStore) IDs() ([]uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]uint64, 0, len(s.posts))
+	for id, p := range s.posts {
+		if p.hasBody {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (s *memoryStore) Delete(ids ...uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		delete(s.posts, id)
+	}
+	return nil
+}
+
+func (s *memoryStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.posts = make(map[uint64]*memoryOpenPost)
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
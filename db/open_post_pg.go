@@ -0,0 +1,217 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// Stores open post data in the unlogged open_post_data table. Always uses the
+// primary, as unlogged tables are not replicated.
+type pgStore struct{}
+
+// Read a single column of a post's row. Returns the zero value, if none.
+func (pgStore) getColumn(id uint64, col string, dst interface{}) error {
+	err := sq.Select(col).
+		From("open_post_data").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(dst)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return err
+}
+
+func (pgStore) SetBody(id uint64, body []byte, lastEdit int64) error {
+	_, err := db.Exec(
+		`insert into open_post_data (id, body, last_edit)
+		values ($1, $2, $3)
+		on conflict (id) do update
+			set body = excluded.body,
+				last_edit = excluded.last_edit`,
+		id, string(body), lastEdit,
+	)
+	return err
+}
+
+func (pgStore) GetBodies(ids []uint64) (
+	bodies map[uint64]string, err error,
+) {
+	bodies = make(map[uint64]string, len(ids))
+	if len(ids) == 0 {
+		return
+	}
+	arr := make(pq.Int64Array, len(ids))
+	for i, id := range ids {
+		arr[i] = int64(id)
+	}
+	err = queryAll(
+		sq.Select("id", "body").
+			From("open_post_data").
+			Where("id = any(?)", arr).
+			Where("body is not null"),
+		func(r *sql.Rows) (err error) {
+			var (
+				id   uint64
+				body string
+			)
+			err = r.Scan(&id, &body)
+			if err != nil {
+				return
+			}
+			bodies[id] = body
+			return
+		},
+	)
+	return
+}
+
+func (s pgStore) GetLastEdit(id uint64) (t int64, err error) {
+	var v sql.NullInt64
+	err = s.getColumn(id, "last_edit", &v)
+	t = v.Int64
+	return
+}
+
+func (pgStore) GetLastEdits(ids []uint64) (
+	times map[uint64]int64, err error,
+) {
+	times = make(map[uint64]int64, len(ids))
+	if len(ids) == 0 {
+		return
+	}
+	arr := make(pq.Int64Array, len(ids))
+	for i, id := range ids {
+		arr[i] = int64(id)
+	}
+	err = queryAll(
+		sq.Select("id", "last_edit").
+			From("open_post_data").
+			Where("id = any(?)", arr).
+			Where("last_edit is not null"),
+		func(r *sql.Rows) (err error) {
+			var (
+				id uint64
+				t  int64
+			)
+			err = r.Scan(&id, &t)
+			if err != nil {
+				return
+			}
+			times[id] = t
+			return
+		},
+	)
+	return
+}
+
+func (pgStore) SetRecoveryDeadline(ids []uint64, deadline int64) (err error) {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		q, err := tx.Prepare(
+			`insert into open_post_data (id, recovery_deadline)
+			values ($1, $2)
+			on conflict (id) do update
+				set recovery_deadline = excluded.recovery_deadline`,
+		)
+		if err != nil {
+			return
+		}
+		defer q.Close()
+
+		for _, id := range ids {
+			_, err = q.Exec(id, deadline)
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+}
+
+func (s pgStore) GetRecoveryDeadline(id uint64) (t int64, err error) {
+	var v sql.NullInt64
+	err = s.getColumn(id, "recovery_deadline", &v)
+	t = v.Int64
+	return
+}
+
+func (pgStore) GetRecoverable(now int64) (
+	ids map[uint64]struct{}, err error,
+) {
+	ids = make(map[uint64]struct{})
+	err = queryAll(
+		sq.Select("id").
+			From("open_post_data").
+			Where("recovery_deadline > ?", now),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			ids[id] = struct{}{}
+			return
+		},
+	)
+	return
+}
+
+func (pgStore) AppendEditLog(id uint64, ops []byte, maxSize int) error {
+	_, err := db.Exec(
+		`insert into open_post_data as o (id, edit_log)
+		values ($1, $2)
+		on conflict (id) do update
+			set edit_log = coalesce(o.edit_log, '') || excluded.edit_log
+			where coalesce(octet_length(o.edit_log), 0) < $3`,
+		id, ops, maxSize,
+	)
+	return err
+}
+
+func (s pgStore) GetEditLog(id uint64) (buf []byte, err error) {
+	err = s.getColumn(id, "edit_log", &buf)
+	return
+}
+
+func (pgStore) IDs() (ids []uint64, err error) {
+	ids = make([]uint64, 0, 64)
+	err = queryAll(
+		sq.Select("id").
+			From("open_post_data").
+			Where("body is not null"),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			ids = append(ids, id)
+			return
+		},
+	)
+	return
+}
+
+func (pgStore) Delete(ids ...uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	arr := make(pq.Int64Array, len(ids))
+	for i, id := range ids {
+		arr[i] = int64(id)
+	}
+	_, err := sq.Delete("open_post_data").
+		Where("id = any(?)", arr).
+		Exec()
+	return err
+}
+
+func (pgStore) Clear() error {
+	_, err := db.Exec(`delete from open_post_data`)
+	return err
+}
+
+func (pgStore) Close() error {
+	return nil
+}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-playground/log"
+)
+
+// Storage backends for open post data
+const (
+	// Local BoltDB file. Restricts websocket handling to a single server.
+	BoltOpenPostStore = "bolt"
+
+	// Unlogged PostgreSQL table. Shared by all servers, but truncated on a
+	// PostgreSQL crash.
+	PostgresOpenPostStore = "postgres"
+
+	// Process memory. Lost on restart.
+	MemoryOpenPostStore = "memory"
+)
+
+// OpenPostStoreType selects the storage backend for open post data
+var OpenPostStoreType = BoltOpenPostStore
+
+// Selected open post data store
+var openPosts OpenPostStore
+
+// OpenPostStore stores transient data of posts, while they are open: bodies,
+// last edit times, recovery deadlines after server restarts and edit logs.
+// Times are Unix timestamps. Missing values are returned as zero values.
+type OpenPostStore interface {
+	// SetBody sets the body of an open post and the time of its last edit
+	SetBody(id uint64, body []byte, lastEdit int64) error
+
+	// GetBodies retrieves the bodies of open posts by ID
+	GetBodies(ids []uint64) (map[uint64]string, error)
+
+	// GetLastEdit retrieves the time of the last edit of an open post
+	GetLastEdit(id uint64) (int64, error)
+
+	// GetLastEdits retrieves the times of the last edits of open posts by ID.
+	// Posts without a recorded edit are omitted.
+	GetLastEdits(ids []uint64) (map[uint64]int64, error)
+
+	// SetRecoveryDeadline sets the time until which open posts orphaned by a
+	// server restart can be reclaimed
+	SetRecoveryDeadline(ids []uint64, deadline int64) error
+
+	// GetRecoveryDeadline retrieves the recovery deadline of an open post
+	GetRecoveryDeadline(id uint64) (int64, error)
+
+	// GetRecoverable returns the IDs of all open posts with a recovery
+	// deadline after now
+	GetRecoverable(now int64) (map[uint64]struct{}, error)
+
+	// AppendEditLog appends encoded operations to the edit log of an open
+	// post, unless the log already is maxSize or larger
+	AppendEditLog(id uint64, ops []byte, maxSize int) error
+
+	// GetEditLog retrieves the encoded edit log of an open post
+	GetEditLog(id uint64) ([]byte, error)
+
+	// IDs returns the IDs of all open posts with a stored body
+	IDs() ([]uint64, error)
+
+	// Delete all data of open posts
+	Delete(ids ...uint64) error
+
+	// Clear deletes all data from the store. Only used in tests.
+	Clear() error
+
+	// Close releases any resources held by the store
+	Close() error
+}
+
+// Open the open post data store selected by OpenPostStoreType. If another
+// store than BoltDB is selected and a BoltDB file from a previous server run
+// exists, its contents are migrated to the new store.
+func loadOpenPostStore(dbSuffix string) func() error {
+	return func() (err error) {
+		path := fmt.Sprintf("db%s.db", dbSuffix)
+		switch OpenPostStoreType {
+		case BoltOpenPostStore:
+			openPosts, err = openBoltStore(path)
+			return
+		case PostgresOpenPostStore:
+			openPosts = pgStore{}
+		case MemoryOpenPostStore:
+			openPosts = newMemoryStore()
+		default:
+			return fmt.Errorf("unknown open post store: %s", OpenPostStoreType)
+		}
+
+		_, err = os.Stat(path)
+		switch {
+		case os.IsNotExist(err):
+			return nil
+		case err != nil:
+			return
+		}
+		return migrateBoltStore(path, openPosts)
+	}
+}
+
+// Copy all data from a BoltDB open post store file to dst and rename the file
+// to prevent repeated migrations
+func migrateBoltStore(path string, dst OpenPostStore) (err error) {
+	log.Infof("migrating open post data from %s", path)
+
+	src, err := openBoltStore(path)
+	if err != nil {
+		return
+	}
+	err = copyOpenPostStore(src, dst)
+	if closeErr := src.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+	return os.Rename(path, path+".migrated")
+}
+
+// Copy all open post data from one store to another
+func copyOpenPostStore(src, dst OpenPostStore) (err error) {
+	ids, err := src.IDs()
+	if err != nil {
+		return
+	}
+	bodies, err := src.GetBodies(ids)
+	if err != nil {
+		return
+	}
+	for _, id := range ids {
+		var (
+			lastEdit, deadline int64
+			editLog            []byte
+		)
+		lastEdit, err = src.GetLastEdit(id)
+		if err != nil {
+			return
+		}
+		err = dst.SetBody(id, []byte(bodies[id]), lastEdit)
+		if err != nil {
+			return
+		}
+
+		deadline, err = src.GetRecoveryDeadline(id)
+		if err != nil {
+			return
+		}
+		if deadline != 0 {
+			err = dst.SetRecoveryDeadline([]uint64{id}, deadline)
+			if err != nil {
+				return
+			}
+		}
+
+		editLog, err = src.GetEditLog(id)
+		if err != nil {
+			return
+		}
+		if len(editLog) != 0 {
+			err = dst.AppendEditLog(id, editLog, maxEditLogSize)
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
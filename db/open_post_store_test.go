@@ -0,0 +1,181 @@
+package db
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestOpenPostStores(t *testing.T) {
+	bolt, err := openBoltStore("db_open_post_store_test.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove("db_open_post_store_test.db")
+	defer bolt.Close()
+
+	stores := [...]struct {
+		name  string
+		store OpenPostStore
+	}{
+		{"bolt", bolt},
+		{"postgres", pgStore{}},
+		{"memory", newMemoryStore()},
+	}
+	for i := range stores {
+		s := stores[i]
+		t.Run(s.name, func(t *testing.T) {
+			if err := s.store.Clear(); err != nil {
+				t.Fatal(err)
+			}
+			testOpenPostStore(t, s.store)
+		})
+	}
+}
+
+func testOpenPostStore(t *testing.T, s OpenPostStore) {
+	t.Helper()
+
+	for id, body := range map[uint64]string{1: "foo", 2: "bar"} {
+		if err := s.SetBody(id, []byte(body), int64(id)*10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bodies, err := s.GetBodies([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, bodies, map[uint64]string{1: "foo", 2: "bar"})
+
+	lastEdit, err := s.GetLastEdit(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, lastEdit, int64(20))
+	lastEdits, err := s.GetLastEdits([]uint64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, lastEdits, map[uint64]int64{1: 10, 2: 20})
+
+	err = s.SetRecoveryDeadline([]uint64{1, 2}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline, err := s.GetRecoveryDeadline(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, deadline, int64(100))
+	recoverable, err := s.GetRecoverable(99)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, recoverable, map[uint64]struct{}{1: {}, 2: {}})
+	recoverable, err = s.GetRecoverable(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(recoverable), 0)
+
+	// Appending stops at maximum size
+	for _, ops := range [...]string{"ab", "cd", "ef"} {
+		if err := s.AppendEditLog(1, []byte(ops), 4); err != nil {
+			t.Fatal(err)
+		}
+	}
+	editLog, err := s.GetEditLog(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, string(editLog), "abcd")
+
+	ids, err := s.IDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	AssertEquals(t, ids, []uint64{1, 2})
+
+	if err := s.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	for _, fn := range [...]func(uint64) (int64, error){
+		s.GetLastEdit,
+		s.GetRecoveryDeadline,
+	} {
+		v, err := fn(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, v, int64(0))
+	}
+	editLog, err = s.GetEditLog(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(editLog), 0)
+	ids, err = s.IDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, ids, []uint64{2})
+}
+
+func TestMigrateBoltStore(t *testing.T) {
+	const path = "db_migrate_test.db"
+	defer os.Remove(path + ".migrated")
+
+	src, err := openBoltStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = src.SetBody(1, []byte("foo"), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = src.AppendEditLog(1, []byte("ab"), maxEditLogSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = src.SetRecoveryDeadline([]uint64{1}, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := newMemoryStore()
+	if err := migrateBoltStore(path, dst); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("bolt file not renamed")
+	}
+	body, err := dst.GetBodies([]uint64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, body[1], "foo")
+	lastEdit, err := dst.GetLastEdit(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, lastEdit, int64(10))
+	deadline, err := dst.GetRecoveryDeadline(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, deadline, int64(20))
+	editLog, err := dst.GetEditLog(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, string(editLog), "ab")
+}
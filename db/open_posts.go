@@ -2,75 +2,111 @@ package db
 
 import (
 	"database/sql"
-	"encoding/binary"
-
-	"github.com/boltdb/bolt"
+	"time"
 )
 
-// SetOpenBody sets the open body of a post
+// DraftRecoveryTime is the time open posts orphaned by a server restart can
+// be reclaimed by their authors, before being closed
+const DraftRecoveryTime = time.Minute * 15
+
+// SetOpenBody sets the open body of a post and records the time of the edit
 func SetOpenBody(id uint64, body []byte) error {
-	buf := encodeUint64(id)
-	return boltDB.Batch(func(tx *bolt.Tx) error {
-		return bodyBucket(tx).Put(buf[:], body)
-	})
+	return openPosts.SetBody(id, body, time.Now().Unix())
 }
 
-func bodyBucket(tx *bolt.Tx) *bolt.Bucket {
-	return tx.Bucket([]byte("open_bodies"))
+// GetOpenBody retrieves an open body of a post
+func GetOpenBody(id uint64) (body string, err error) {
+	bodies, err := openPosts.GetBodies([]uint64{id})
+	if err != nil {
+		return
+	}
+	body = bodies[id]
+	return
 }
 
-// Encode uint64 for storage in BoltDB without heap allocations
-func encodeUint64(i uint64) [8]byte {
-	var buf [8]byte
-	binary.LittleEndian.PutUint64(buf[:], i)
-	return buf
+func deleteOpenPostBody(id uint64) error {
+	return openPosts.Delete(id)
 }
 
-// Same as encodeUint64, but allocates on the heap. In some cases, where the
-// buffer must persist after the end of the transaction, this is needed.
-func encodeUint64Heap(i uint64) []byte {
-	buf := make([]byte, 8)
-	binary.LittleEndian.PutUint64(buf, i)
-	return buf
+// Grant all posts left open by the previous server process a recovery window,
+// in which they are not closed automatically and can be reclaimed by their
+// authors with the post password. Must be called on server start, when no
+// clients are connected.
+//
+// Posts, that already have a recovery deadline, keep it. Otherwise every
+// restart of any server process would extend the window of posts orphaned
+// before.
+func markRecoverablePosts() (err error) {
+	marked, err := openPosts.GetRecoverable(0)
+	if err != nil {
+		return
+	}
+
+	var ids []uint64
+	err = queryAll(
+		sq.Select("id").
+			From("posts").
+			Where("editing = true"),
+		func(r *sql.Rows) (err error) {
+			var id uint64
+			err = r.Scan(&id)
+			if err != nil {
+				return
+			}
+			if _, ok := marked[id]; !ok {
+				ids = append(ids, id)
+			}
+			return
+		},
+	)
+	if err != nil || len(ids) == 0 {
+		return
+	}
+
+	return openPosts.SetRecoveryDeadline(ids,
+		time.Now().Add(DraftRecoveryTime).Unix())
 }
 
-// GetOpenBody retrieves an open body of a post
-func GetOpenBody(id uint64) (body string, err error) {
-	buf := encodeUint64(id)
-	err = boltDB.View(func(tx *bolt.Tx) error {
-		body = string(bodyBucket(tx).Get(buf[:]))
-		return nil
-	})
-	return
+// GetLastEdit returns the Unix time of the last edit of an open post. Returns
+// 0, if the post has not been edited yet.
+func GetLastEdit(id uint64) (int64, error) {
+	return openPosts.GetLastEdit(id)
 }
 
-func deleteOpenPostBody(id uint64) error {
-	buf := encodeUint64(id)
-	return boltDB.Batch(func(tx *bolt.Tx) error {
-		return bodyBucket(tx).Delete(buf[:])
-	})
+// Returns the Unix times of the last edits of open posts. Posts, that have not
+// been edited yet, are omitted.
+func getLastEdits(ids []uint64) (map[uint64]int64, error) {
+	return openPosts.GetLastEdits(ids)
+}
+
+// GetRecoveryDeadline returns the Unix time until which an open post orphaned
+// by a server restart can be reclaimed. Returns 0, if the post has not been
+// orphaned.
+func GetRecoveryDeadline(id uint64) (int64, error) {
+	return openPosts.GetRecoveryDeadline(id)
+}
+
+// Returns IDs of all open posts with a recovery window, that has not yet
+// passed
+func getRecoverablePosts() (map[uint64]struct{}, error) {
+	return openPosts.GetRecoverable(time.Now().Unix())
 }
 
 // Delete orphaned post bodies, that refer to posts already closed or deleted.
 // This can happen on server restarts, board deletion, etc.
 func cleanUpOpenPostBodies() (err error) {
 	// Read IDs of all post bodies
-	var ids []uint64
-	err = boltDB.View(func(tx *bolt.Tx) error {
-		buc := bodyBucket(tx)
-		ids = make([]uint64, 0, buc.Stats().KeyN)
-		return buc.ForEach(func(k, _ []byte) error {
-			ids = append(ids, binary.LittleEndian.Uint64(k))
-			return nil
-		})
-	})
+	ids, err := openPosts.IDs()
 	if err != nil {
 		return
 	}
 
 	// Find bodies with closed parents
 	toDelete := make([]uint64, 0, len(ids))
-	return InTransaction(true, func(tx *sql.Tx) (err error) {
+
+	// Missing posts are treated as closed, so this must never run on a lagging
+	// replica
+	err = inTransaction(db, true, func(tx *sql.Tx) (err error) {
 		var isOpen bool
 		q, err := tx.Prepare(`select 'true' from posts
 			where id = $1 and editing = 'true'`)
@@ -91,20 +127,10 @@ func cleanUpOpenPostBodies() (err error) {
 				toDelete = append(toDelete, id)
 			}
 		}
-
-		// Delete closed post bodies, if any
-		if len(toDelete) == 0 {
-			return
-		}
-		return boltDB.Batch(func(tx *bolt.Tx) (err error) {
-			buc := bodyBucket(tx)
-			for _, id := range toDelete {
-				err = buc.Delete(encodeUint64Heap(id))
-				if err != nil {
-					return
-				}
-			}
-			return
-		})
+		return
 	})
+	if err != nil || len(toDelete) == 0 {
+		return
+	}
+	return openPosts.Delete(toDelete...)
 }
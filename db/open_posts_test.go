@@ -2,8 +2,11 @@ package db
 
 import (
 	"database/sql"
-	"github.com/bakape/meguca/common"
 	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
 )
 
 func TestCleanUpOpenPostBodies(t *testing.T) {
@@ -77,3 +80,66 @@ func TestCleanUpOpenPostBodies(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkRecoverablePosts(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	err := openPosts.Clear()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		for _, p := range [...]struct {
+			open bool
+			id   uint64
+		}{
+			{false, 2},
+			{true, 3},
+			{true, 4},
+		} {
+			err = WritePost(tx, Post{
+				StandalonePost: common.StandalonePost{
+					OP:    1,
+					Board: "a",
+					Post: common.Post{
+						ID:      p.id,
+						Editing: p.open,
+					},
+				},
+			})
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Orphaned by an earlier restart
+	marked := time.Now().Add(time.Minute).Unix()
+	err = openPosts.SetRecoveryDeadline([]uint64{4}, marked)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = markRecoverablePosts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := func(id uint64) int64 {
+		d, err := GetRecoveryDeadline(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	AssertEquals(t, deadline(2), int64(0))
+	if d := deadline(3); d <= marked {
+		LogUnexpected(t, "new recovery deadline", d)
+	}
+	AssertEquals(t, deadline(4), marked)
+}
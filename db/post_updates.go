@@ -25,11 +25,16 @@ func ClosePost(id, op uint64, body string, links []common.Link,
 			return
 		}
 		err = writeLinks(tx, id, links)
+		if err != nil {
+			return
+		}
+		err = persistEditLog(tx, id)
 		return
 	})
 	if err != nil {
 		return
 	}
+	markWritten(op, "")
 
 	if !common.IsTest {
 		// TODO: Propagate this with DB listener
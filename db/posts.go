@@ -35,25 +35,37 @@ func GetPostBoard(id uint64) (board string, err error) {
 	return
 }
 
-func getCounter(q squirrel.SelectBuilder) (uint64, error) {
-	var c sql.NullInt64
-	err := q.QueryRow().Scan(&c)
-	return uint64(c.Int64), err
+// Read a counter from the same source as the data it versions. op and board
+// are optional and are passed to withReader, which pins reads of the same
+// thread or board to the same replica.
+func getCounter(op uint64, board string,
+	q func(r reader) squirrel.SelectBuilder,
+) (ctr uint64, err error) {
+	err = withReader(op, board, func(r reader) error {
+		var c sql.NullInt64
+		err := q(r).QueryRow().Scan(&c)
+		ctr = uint64(c.Int64)
+		return err
+	})
+	return
 }
 
 // BoardCounter retrieves the progress counter of a board
 func BoardCounter(board string) (uint64, error) {
-	q := sq.Select("max(update_time) + count(*)").
-		From("threads").
-		Where("board = ?", board)
-	return getCounter(q)
+	return getCounter(0, board, func(r reader) squirrel.SelectBuilder {
+		return r.sq.Select("max(update_time) + count(*)").
+			From("threads").
+			Where("board = ? and archived = false", board)
+	})
 }
 
 // AllBoardCounter retrieves the progress counter of the /all/ board
 func AllBoardCounter() (uint64, error) {
-	q := sq.Select("max(update_time) + count(*)").
-		From("threads")
-	return getCounter(q)
+	return getCounter(0, "all", func(r reader) squirrel.SelectBuilder {
+		return r.sq.Select("max(update_time) + count(*)").
+			From("threads").
+			Where("archived = false")
+	})
 }
 
 // WritePost writes a post struct to the database. Only used in tests and
@@ -96,6 +108,10 @@ func WritePost(tx *sql.Tx, p Post) (err error) {
 	if err != nil {
 		return
 	}
+	err = writeAttachments(tx, p.ID, p.Attachments)
+	if err != nil {
+		return
+	}
 
 	if p.Editing {
 		err = SetOpenBody(p.ID, []byte(p.Body))
@@ -135,6 +151,7 @@ func InsertPost(tx *sql.Tx, p *Post) (err error) {
 	if err != nil {
 		return
 	}
+	markWritten(p.OP, p.Board)
 
 	if p.Moderated {
 		// Read moderation log, if post deleted on insert
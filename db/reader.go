@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -20,8 +21,20 @@ const (
 		join threads as linked_thread on linked_post.op = linked_thread.id
 		where l.source = p.id
 	),
-	p.commands, p.imageName,
-	i.*`
+	p.commands,
+	(select jsonb_agg(
+			to_jsonb(ai) - 'phash'
+			|| jsonb_build_object('spoiler', a.spoiler, 'name', a.name)
+			order by a.position)
+		from post_attachments as a
+		join images as ai on a.sha1 = ai.sha1
+		where a.post_id = p.id
+	),
+	p.imageName,
+	` + imageSelectsSQL
+
+	imageSelectsSQL = `i.audio, i.video, i.file_type, i.thumb_type, i.dims,
+	i.length, i.size, i.md5, i.sha1, i.title, i.artist, i.phash`
 
 	threadSelectsSQL = `t.sticky, t.board,
 	(
@@ -34,8 +47,14 @@ const (
 		from posts
 		where t.id = posts.op
 			and posts.SHA1 is not null
+	) + (
+		select count(*)
+		from post_attachments as a
+		join posts on a.post_id = posts.id
+		where t.id = posts.op
 	),
-	t.update_time, t.bump_time, t.subject, t.locked, ` + postSelectsSQL
+	t.update_time, t.bump_time, t.subject, t.locked, t.archived,
+	` + postSelectsSQL
 
 	getOPSQL = `
 	select ` + threadSelectsSQL + `
@@ -60,6 +79,7 @@ const (
 type imageScanner struct {
 	Audio, Video, Spoiler             sql.NullBool
 	FileType, ThumbType, Length, Size sql.NullInt64
+	PHash                             sql.NullInt64
 	Name, SHA1, MD5, Title, Artist    sql.NullString
 	Dims                              pq.Int64Array
 }
@@ -69,7 +89,7 @@ type imageScanner struct {
 func (i *imageScanner) ScanArgs() []interface{} {
 	return []interface{}{
 		&i.Audio, &i.Video, &i.FileType, &i.ThumbType, &i.Dims,
-		&i.Length, &i.Size, &i.MD5, &i.SHA1, &i.Title, &i.Artist,
+		&i.Length, &i.Size, &i.MD5, &i.SHA1, &i.Title, &i.Artist, &i.PHash,
 	}
 }
 
@@ -98,30 +118,62 @@ func (i *imageScanner) Val() *common.Image {
 			SHA1:      i.SHA1.String,
 			Title:     i.Title.String,
 			Artist:    i.Artist.String,
+			PHash:     uint64(i.PHash.Int64),
 		},
 		Name: i.Name.String,
 	}
 }
 
+// Scans the JSON array of files attached to a post after its image
+type attachmentScanner []common.Image
+
+func (a *attachmentScanner) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		return a.scanBytes(src)
+	case string:
+		return a.scanBytes([]byte(src))
+	case nil:
+		*a = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot convert %T to []common.Image", src)
+	}
+}
+
+// Decode into a new slice, as the scanner is reused for multiple rows and
+// decoding into the old one would overwrite the files of previous posts
+func (a *attachmentScanner) scanBytes(src []byte) (err error) {
+	files := make(attachmentScanner, 0, 4)
+	err = json.Unmarshal(src, &files)
+	if err != nil {
+		return
+	}
+	*a = files
+	return
+}
+
 type postScanner struct {
 	common.Post
-	spoiler   bool
-	imageName string
-	links     linkScanner
-	commands  commandRow
+	spoiler     bool
+	imageName   string
+	links       linkScanner
+	commands    commandRow
+	attachments attachmentScanner
 }
 
 func (p *postScanner) ScanArgs() []interface{} {
 	return []interface{}{
 		&p.Editing, &p.Moderated, &p.spoiler, &p.Sage, &p.ID, &p.Time, &p.Body,
 		&p.Flag, &p.Name, &p.Trip, &p.Auth, &p.links, &p.commands,
-		&p.imageName,
+		&p.attachments, &p.imageName,
 	}
 }
 
 func (p postScanner) Val() (common.Post, error) {
 	p.Links = []common.Link(p.links)
 	p.Commands = []common.Command(p.commands)
+	p.Attachments = []common.Image(p.attachments)
 
 	return p.Post, nil
 }
@@ -141,62 +193,11 @@ type PostStats struct {
 
 // GetThread retrieves public thread data from the database
 func GetThread(id uint64, lastN int) (t common.Thread, err error) {
-	err = InTransaction(true, func(tx *sql.Tx) (err error) {
-		// Get thread metadata and OP
-		t, err = scanOP(tx.QueryRow(getOPSQL, id))
-		if err != nil {
-			return
-		}
-		t.Abbrev = lastN != 0
-
-		// Get replies
-		var (
-			cap   int
-			limit *int
-		)
-		if lastN != 0 {
-			cap = lastN
-			limit = &lastN
-		} else {
-			cap = int(t.PostCount)
-		}
-		r, err := tx.Query(getThreadPostsSQL, id, limit)
-		if err != nil {
-			return
-		}
-		defer r.Close()
-
-		// Scan replies into []common.Post
-		var (
-			post postScanner
-			img  imageScanner
-			p    common.Post
-			args = append(post.ScanArgs(), img.ScanArgs()...)
-		)
-		t.Posts = make([]common.Post, 0, cap)
-		for r.Next() {
-			err = r.Scan(args...)
-			if err != nil {
-				return
-			}
-			p, err = extractPost(post, img)
-			if err != nil {
-				return
-			}
-			t.Posts = append(t.Posts, p)
-		}
-		err = r.Err()
-		if err != nil {
+	err = withReader(id, "", func(r reader) error {
+		return inTransaction(r.db, true, func(tx *sql.Tx) (err error) {
+			t, err = readThread(tx, id, lastN)
 			return
-		}
-
-		// Inject  moderation into affected posts
-		moderated := make([]*common.Post, 0, 64)
-		filterModerated(&moderated, &t.Post)
-		for i := range t.Posts {
-			filterModerated(&moderated, &t.Posts[i])
-		}
-		return injectModeration(moderated, tx)
+		})
 	})
 	if err != nil {
 		return
@@ -212,17 +213,77 @@ func GetThread(id uint64, lastN int) (t common.Thread, err error) {
 	return
 }
 
+// Read a thread and its replies without injecting open post bodies
+func readThread(tx *sql.Tx, id uint64, lastN int) (t common.Thread, err error) {
+	// Get thread metadata and OP
+	t, err = scanOP(tx.QueryRow(getOPSQL, id))
+	if err != nil {
+		return
+	}
+	t.Abbrev = lastN != 0
+
+	// Get replies
+	var (
+		cap   int
+		limit *int
+	)
+	if lastN != 0 {
+		cap = lastN
+		limit = &lastN
+	} else {
+		cap = int(t.PostCount)
+	}
+	r, err := tx.Query(getThreadPostsSQL, id, limit)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	// Scan replies into []common.Post
+	var (
+		post postScanner
+		img  imageScanner
+		p    common.Post
+		args = append(post.ScanArgs(), img.ScanArgs()...)
+	)
+	t.Posts = make([]common.Post, 0, cap)
+	for r.Next() {
+		err = r.Scan(args...)
+		if err != nil {
+			return
+		}
+		p, err = extractPost(post, img)
+		if err != nil {
+			return
+		}
+		t.Posts = append(t.Posts, p)
+	}
+	err = r.Err()
+	if err != nil {
+		return
+	}
+
+	// Inject  moderation into affected posts
+	moderated := make([]*common.Post, 0, 64)
+	filterModerated(&moderated, &t.Post)
+	for i := range t.Posts {
+		filterModerated(&moderated, &t.Posts[i])
+	}
+	err = injectModeration(moderated, tx)
+	return
+}
+
 func scanOP(r rowScanner) (t common.Thread, err error) {
 	var (
 		post  postScanner
 		img   imageScanner
 		pArgs = post.ScanArgs()
 		iArgs = img.ScanArgs()
-		args  = make([]interface{}, 0, 8+len(pArgs)+len(iArgs))
+		args  = make([]interface{}, 0, 9+len(pArgs)+len(iArgs))
 	)
 	args = append(args,
 		&t.Sticky, &t.Board, &t.PostCount, &t.ImageCount, &t.UpdateTime,
-		&t.BumpTime, &t.Subject, &t.Locked,
+		&t.BumpTime, &t.Subject, &t.Locked, &t.Archived,
 	)
 	args = append(args, pArgs...)
 	args = append(args, iArgs...)
@@ -231,6 +292,8 @@ func scanOP(r rowScanner) (t common.Thread, err error) {
 	if err != nil {
 		return
 	}
+	// Archived threads can not be posted in
+	t.Locked = t.Locked || t.Archived
 
 	t.Post, err = extractPost(post, img)
 	return
@@ -262,23 +325,36 @@ func GetPost(id uint64) (res common.StandalonePost, err error) {
 	args = append(args, pArgs...)
 	args = append(args, iArgs...)
 
-	err = sq.Select("p.op, p.board, "+postSelectsSQL).
-		From("posts as p").
-		LeftJoin("images as i on p.SHA1 = i.SHA1").
-		Where("id = ?", id).
-		QueryRow().
-		Scan(args...)
-	if err != nil {
+	err = withReader(0, "", func(r reader) (err error) {
+		err = r.sq.Select("p.op, p.board, "+postSelectsSQL).
+			From("posts as p").
+			LeftJoin("images as i on p.SHA1 = i.SHA1").
+			Where("id = ?", id).
+			QueryRow().
+			Scan(args...)
+		if err != nil {
+			return
+		}
+		// Parent thread is not known in advance
+		if r.db != db && pinnedToPrimary(res.OP, res.Board) {
+			return errUsePrimary
+		}
+		res.Post, err = post.Val()
+		if err != nil {
+			return
+		}
+		res.Image = img.Val()
+		if res.Image != nil {
+			res.Image.Spoiler, res.Image.Name = post.Image()
+		}
+		if res.Moderated {
+			err = injectModeration([]*common.Post{&res.Post}, r.db)
+		}
 		return
-	}
-	res.Post, err = post.Val()
+	})
 	if err != nil {
 		return
 	}
-	res.Image = img.Val()
-	if res.Image != nil {
-		res.Image.Spoiler, res.Image.Name = post.Image()
-	}
 
 	if res.Editing {
 		res.Body, err = GetOpenBody(res.ID)
@@ -286,18 +362,12 @@ func GetPost(id uint64) (res common.StandalonePost, err error) {
 			return
 		}
 	}
-	if res.Moderated {
-		err = injectModeration([]*common.Post{&res.Post}, nil)
-		if err != nil {
-			return
-		}
-	}
 
 	return
 }
 
-func getOPs() squirrel.SelectBuilder {
-	return sq.Select(threadSelectsSQL).
+func getOPs(r reader) squirrel.SelectBuilder {
+	return r.sq.Select(threadSelectsSQL).
 		From("threads as t").
 		Join("posts as p on t.id = p.id").
 		LeftJoin("images as i on p.SHA1 = i.SHA1")
@@ -305,50 +375,100 @@ func getOPs() squirrel.SelectBuilder {
 
 // GetBoardCatalog retrieves all OPs of a single board
 func GetBoardCatalog(board string) (b common.Board, err error) {
-	b, err = scanCatalog(getOPs().
-		Where("t.board = ?", board).
-		OrderBy("sticky desc, bump_time desc"))
+	err = withReader(0, board, func(r reader) (err error) {
+		b, err = scanCatalog(r, getOPs(r).
+			Where("t.board = ? and t.archived = false", board).
+			OrderBy("sticky desc, bump_time desc"))
+		return
+	})
 	return
 }
 
 // GetThreadIDs retrieves all threads IDs on the board in bump order with stickies first
-func GetThreadIDs(board string) ([]uint64, error) {
-	return scanThreadIDs(sq.Select("id").
-		From("threads").
-		Where("board = ?", board).
-		OrderBy("sticky desc, bump_time desc"))
+func GetThreadIDs(board string) (ids []uint64, err error) {
+	err = withReader(0, board, func(r reader) (err error) {
+		ids, err = scanThreadIDs(r.sq.Select("id").
+			From("threads").
+			Where("board = ? and archived = false", board).
+			OrderBy("sticky desc, bump_time desc"))
+		return
+	})
+	return
 }
 
 // GetAllBoardCatalog retrieves all threads for the "/all/" meta-board
 func GetAllBoardCatalog() (board common.Board, err error) {
-	board, err = scanCatalog(getOPs().OrderBy("bump_time desc"))
+	err = withReader(0, "all", func(r reader) (err error) {
+		board, err = scanCatalog(r, getOPs(r).
+			Where("t.archived = false").
+			OrderBy("bump_time desc"))
+		return
+	})
 	if err != nil {
 		return
 	}
+	hideNSFWThreads(&board)
+	return
+}
 
-	// Hide threads from NSFW boards, if enabled
-	if config.Get().HideNSFW {
-		filtered := make([]common.Thread, 0, len(board.Threads))
-		confs := config.GetAllBoardConfigs()
-		for _, t := range board.Threads {
-			if !confs[t.Board].NSFW {
-				filtered = append(filtered, t)
-			}
-		}
-		board.Threads = filtered
-	}
+// GetAllThreadsIDs retrieves all threads IDs in bump order
+func GetAllThreadsIDs() (ids []uint64, err error) {
+	err = withReader(0, "all", func(r reader) (err error) {
+		ids, err = scanThreadIDs(r.sq.Select("id").
+			From("threads").
+			Where("archived = false").
+			OrderBy("bump_time desc"))
+		return
+	})
+	return
+}
 
+// GetBoardArchive retrieves the OPs of all archived threads of a board,
+// most recently bumped first
+func GetBoardArchive(board string) (b common.Board, err error) {
+	err = withReader(0, board, func(r reader) (err error) {
+		b, err = scanCatalog(r, getOPs(r).
+			Where("t.board = ? and t.archived = true", board).
+			OrderBy("bump_time desc"))
+		return
+	})
 	return
 }
 
-// GetAllThreadsIDs retrieves all threads IDs in bump order
-func GetAllThreadsIDs() ([]uint64, error) {
-	return scanThreadIDs(sq.Select("id").
-		From("threads").
-		OrderBy("bump_time desc"))
+// GetAllBoardArchive retrieves the OPs of all archived threads for the "/all/"
+// meta-board
+func GetAllBoardArchive() (board common.Board, err error) {
+	err = withReader(0, "all", func(r reader) (err error) {
+		board, err = scanCatalog(r, getOPs(r).
+			Where("t.archived = true").
+			OrderBy("bump_time desc"))
+		return
+	})
+	if err != nil {
+		return
+	}
+	hideNSFWThreads(&board)
+	return
 }
 
-func scanCatalog(q squirrel.SelectBuilder) (board common.Board, err error) {
+// Hide threads from NSFW boards on the "/all/" meta-board, if enabled
+func hideNSFWThreads(board *common.Board) {
+	if !config.Get().HideNSFW {
+		return
+	}
+	filtered := make([]common.Thread, 0, len(board.Threads))
+	confs := config.GetAllBoardConfigs()
+	for _, t := range board.Threads {
+		if !confs[t.Board].NSFW {
+			filtered = append(filtered, t)
+		}
+	}
+	board.Threads = filtered
+}
+
+func scanCatalog(r reader, q squirrel.SelectBuilder) (
+	board common.Board, err error,
+) {
 	board.Threads = make([]common.Thread, 0, 32)
 	err = queryAll(q, func(r *sql.Rows) (err error) {
 		t, err := scanOP(r)
@@ -373,7 +493,7 @@ func scanCatalog(q squirrel.SelectBuilder) (board common.Board, err error) {
 	if err != nil {
 		return
 	}
-	err = injectModeration(moderated, nil)
+	err = injectModeration(moderated, r.db)
 	return
 }
 
@@ -405,28 +525,31 @@ func filterModerated(moderated *[]*common.Post, p *common.Post) {
 	}
 }
 
-// Inject open post bodies from the embedded database into the posts
+// Inject open post bodies from the open post store into the posts
 func injectOpenBodies(posts []*common.Post) error {
 	if len(posts) == 0 {
 		return nil
 	}
 
-	tx, err := boltDB.Begin(false)
+	ids := make([]uint64, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+	bodies, err := openPosts.GetBodies(ids)
 	if err != nil {
 		return err
 	}
-
-	buc := tx.Bucket([]byte("open_bodies"))
 	for _, p := range posts {
-		p.Body = string(buc.Get(encodeUint64Heap(p.ID)))
+		p.Body = bodies[p.ID]
 	}
-
-	return tx.Rollback()
+	return nil
 }
 
 // Inject moderation information into affected post structs.
-// tx is optional.
-func injectModeration(posts []*common.Post, tx *sql.Tx) (err error) {
+// runner is optional and defaults to the primary.
+func injectModeration(posts []*common.Post, runner squirrel.BaseRunner) (
+	err error,
+) {
 	if len(posts) == 0 {
 		return
 	}
@@ -446,8 +569,8 @@ func injectModeration(posts []*common.Post, tx *sql.Tx) (err error) {
 	q := sq.Select("post_id", "type", "length", "by", "data").
 		From("post_moderation").
 		Where(fmt.Sprintf("post_id in %s", string(set)))
-	if tx != nil {
-		q = q.RunWith(tx)
+	if runner != nil {
+		q = q.RunWith(runner)
 	}
 	r, err := q.Query()
 	if err != nil {
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/go-playground/log"
+)
+
+var (
+	// ReplicaConnArgs specifies the PostgreSQL connection arguments of
+	// optional read replicas
+	ReplicaConnArgs []string
+
+	// ReadYourWritesWindow specifies the duration after a write to a thread or
+	// board by this server instance, during which reads of it are routed to the
+	// primary instead of a replica. This ensures clients always see their own
+	// writes regardless of replication lag. Zero disables.
+	ReadYourWritesWindow time.Duration
+
+	// Connected read replicas
+	replicas       []reader
+	replicaCounter uint32
+
+	// Times of the last writes to threads and boards by this instance
+	recentWrites = struct {
+		sync.Mutex
+		threads map[uint64]time.Time
+		boards  map[string]time.Time
+		any     time.Time
+	}{
+		threads: make(map[uint64]time.Time),
+		boards:  make(map[string]time.Time),
+	}
+
+	// Returned from a replica read to retry it on the primary
+	errUsePrimary = errors.New("read must use primary")
+)
+
+// Database connection and statement builder to run read queries with
+type reader struct {
+	db *sql.DB
+	sq squirrel.StatementBuilderType
+}
+
+func newReader(conn *sql.DB) reader {
+	return reader{
+		db: conn,
+		sq: squirrel.StatementBuilder.
+			RunWith(squirrel.NewStmtCacheProxy(conn)).
+			PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+// Returns the reader of the primary database
+func primary() reader {
+	return reader{db, sq}
+}
+
+// Connect to all read replicas. Replicas, that can not be reached, are still
+// added and reads from them fall back to the primary, until they recover.
+func openReplicas() (err error) {
+	replicas = make([]reader, 0, len(ReplicaConnArgs))
+	for _, args := range ReplicaConnArgs {
+		var conn *sql.DB
+		conn, err = sql.Open("postgres", args)
+		if err != nil {
+			return
+		}
+		if err := conn.Ping(); err != nil {
+			log.Errorf("read replica unreachable: %s", err)
+		}
+		replicas = append(replicas, newReader(conn))
+	}
+	return
+}
+
+// Returns, if writes need to be recorded for routing reads
+func trackingWrites() bool {
+	return ReadYourWritesWindow != 0 && len(replicas) != 0
+}
+
+// Record a write to a thread and/or board. op and board are optional.
+func markWritten(op uint64, board string) {
+	if !trackingWrites() {
+		return
+	}
+
+	now := time.Now()
+	recentWrites.Lock()
+	defer recentWrites.Unlock()
+
+	if op != 0 {
+		recentWrites.threads[op] = now
+	}
+	if board != "" {
+		recentWrites.boards[board] = now
+	}
+	recentWrites.any = now
+}
+
+// Record writes to the threads and boards of all posts matching the SQL
+// condition. Must be called before any writes, that make the posts no longer
+// match.
+func markPostsWritten(cond string, args ...interface{}) {
+	if !trackingWrites() {
+		return
+	}
+	err := queryAll(
+		sq.Select("distinct op", "board").
+			From("posts").
+			Where(cond, args...),
+		func(r *sql.Rows) (err error) {
+			var (
+				op    uint64
+				board string
+			)
+			err = r.Scan(&op, &board)
+			if err != nil {
+				return
+			}
+			markWritten(op, board)
+			return
+		},
+	)
+	if err != nil {
+		log.Errorf("recording writes: %s", err)
+	}
+}
+
+// Returns, if reads of a thread or board must be served from the primary.
+// op and board are optional. board = "all" matches writes to any board.
+func pinnedToPrimary(op uint64, board string) bool {
+	if ReadYourWritesWindow == 0 {
+		return false
+	}
+
+	recentWrites.Lock()
+	defer recentWrites.Unlock()
+
+	recent := func(t time.Time) bool {
+		return time.Since(t) < ReadYourWritesWindow
+	}
+	if op != 0 && recent(recentWrites.threads[op]) {
+		return true
+	}
+	switch board {
+	case "":
+		return false
+	case "all":
+		return recent(recentWrites.any)
+	default:
+		return recent(recentWrites.boards[board])
+	}
+}
+
+// Remove expired write records
+func cleanUpRecentWrites() {
+	recentWrites.Lock()
+	defer recentWrites.Unlock()
+
+	for op, t := range recentWrites.threads {
+		if time.Since(t) >= ReadYourWritesWindow {
+			delete(recentWrites.threads, op)
+		}
+	}
+	for b, t := range recentWrites.boards {
+		if time.Since(t) >= ReadYourWritesWindow {
+			delete(recentWrites.boards, b)
+		}
+	}
+}
+
+// Returns the replica to read a thread or board from. Reads of the same thread
+// or board always use the same replica, so an update counter is never newer
+// than the data read after it. Other reads are distributed evenly.
+func pickReplica(op uint64, board string) reader {
+	var i uint64
+	switch {
+	case op != 0:
+		i = op
+	case board != "":
+		h := fnv.New32a()
+		h.Write([]byte(board))
+		i = uint64(h.Sum32())
+	default:
+		i = uint64(atomic.AddUint32(&replicaCounter, 1))
+	}
+	return replicas[i%uint64(len(replicas))]
+}
+
+// Run read queries in fn on a read replica, if any are configured and reads of
+// the thread or board are not pinned to the primary. op and board are
+// optional. On error fn is retried on the primary. fn can return errUsePrimary
+// to force a retry on the primary.
+func withReader(op uint64, board string, fn func(r reader) error) error {
+	if len(replicas) != 0 && !pinnedToPrimary(op, board) {
+		err := fn(pickReplica(op, board))
+		switch err {
+		case nil:
+			return nil
+		case errUsePrimary, sql.ErrNoRows:
+			// Possibly not replicated yet
+		default:
+			log.Errorf("read replica: %s", err)
+		}
+	}
+	return fn(primary())
+}
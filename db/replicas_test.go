@@ -0,0 +1,123 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestPinnedToPrimary(t *testing.T) {
+	old := ReadYourWritesWindow
+	ReadYourWritesWindow = time.Minute
+	defer func() {
+		ReadYourWritesWindow = old
+	}()
+
+	now := time.Now()
+	recentWrites.Lock()
+	recentWrites.threads[1] = now
+	recentWrites.threads[2] = now.Add(-time.Hour)
+	recentWrites.boards["a"] = now
+	recentWrites.any = now
+	recentWrites.Unlock()
+
+	cases := [...]struct {
+		name   string
+		op     uint64
+		board  string
+		pinned bool
+	}{
+		{"recent thread", 1, "", true},
+		{"expired thread", 2, "", false},
+		{"unwritten thread", 3, "", false},
+		{"recent board", 0, "a", true},
+		{"unwritten board", 0, "c", false},
+		{"all boards", 0, "all", true},
+		{"expired thread on recent board", 2, "a", true},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			AssertEquals(t, pinnedToPrimary(c.op, c.board), c.pinned)
+		})
+	}
+
+	cleanUpRecentWrites()
+	recentWrites.Lock()
+	_, ok := recentWrites.threads[2]
+	recentWrites.Unlock()
+	AssertEquals(t, ok, false)
+}
+
+// Replace replicas with distinct fake readers for the duration of a test
+func setFakeReplicas(t *testing.T, n int) {
+	t.Helper()
+	old := replicas
+	replicas = make([]reader, n)
+	for i := range replicas {
+		replicas[i] = reader{db: new(sql.DB)}
+	}
+	t.Cleanup(func() {
+		replicas = old
+	})
+}
+
+func TestPickReplica(t *testing.T) {
+	setFakeReplicas(t, 3)
+
+	cases := [...]struct {
+		name  string
+		op    uint64
+		board string
+	}{
+		{"thread", 4, ""},
+		{"board", 0, "a"},
+		{"thread on board", 5, "a"},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			r := pickReplica(c.op, c.board)
+			for i := 0; i < 10; i++ {
+				if pickReplica(c.op, c.board).db != r.db {
+					t.Fatal("read routed to different replica")
+				}
+			}
+		})
+	}
+
+	t.Run("unkeyed", func(t *testing.T) {
+		seen := make(map[*sql.DB]bool)
+		for i := 0; i < len(replicas); i++ {
+			seen[pickReplica(0, "").db] = true
+		}
+		AssertEquals(t, len(seen), len(replicas))
+	})
+}
+
+func TestMarkPostsWritten(t *testing.T) {
+	prepareThreads(t)
+	setFakeReplicas(t, 1)
+	old := ReadYourWritesWindow
+	ReadYourWritesWindow = time.Minute
+	defer func() {
+		ReadYourWritesWindow = old
+	}()
+	recentWrites.Lock()
+	recentWrites.threads = make(map[uint64]time.Time)
+	recentWrites.boards = make(map[string]time.Time)
+	recentWrites.Unlock()
+
+	markPostsWritten("id = any(?)", encodeUint64Array([]uint64{2, 3}))
+
+	for _, op := range [...]uint64{1, 3} {
+		AssertEquals(t, pinnedToPrimary(op, ""), true)
+	}
+	for _, b := range [...]string{"a", "c"} {
+		AssertEquals(t, pinnedToPrimary(0, b), true)
+	}
+}
@@ -0,0 +1,243 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+	"github.com/lib/pq"
+)
+
+// Maximum number of reply links read to build a reply tree
+const maxReplyTreeLinks = 1000
+
+// GetReplies retrieves links to all posts replying to a post, on any thread or
+// board
+func GetReplies(id uint64) (replies []common.Link, err error) {
+	err = withReader(0, "", func(r reader) (err error) {
+		err = assertPostExists(r, id)
+		if err != nil {
+			return
+		}
+
+		replies = make([]common.Link, 0, 8)
+		rows, err := r.db.Query(
+			`select l.source, p.op, t.board
+			from links as l
+			join posts as p on l.source = p.id
+			join threads as t on p.op = t.id
+			where l.target = $1
+			order by l.source`,
+			id,
+		)
+		if err != nil {
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var l common.Link
+			err = rows.Scan(&l.ID, &l.OP, &l.Board)
+			if err != nil {
+				return
+			}
+			replies = append(replies, l)
+		}
+		return rows.Err()
+	})
+	return
+}
+
+// GetReplyTree retrieves a post and all posts replying to it, recursively,
+// up to the specified depth of replies. Each post is included in full only
+// once, at its shallowest position in the tree. Any further replies by it are
+// only referenced by ID.
+func GetReplyTree(id uint64, depth int) (tree common.ReplyTree, err error) {
+	err = withReader(0, "", func(r reader) (err error) {
+		err = assertPostExists(r, id)
+		if err != nil {
+			return
+		}
+
+		// Posts replying to each post in the tree
+		children := make(map[uint64][]uint64)
+		ids := []uint64{id}
+		rows, err := r.db.Query(
+			`with recursive tree (source, target, depth) as (
+				select source, target, 1
+				from links
+				where target = $1
+				union
+				select l.source, l.target, t.depth + 1
+				from links as l
+				join tree as t on l.target = t.source
+				where t.depth < $2
+			)
+			select distinct source, target
+			from tree
+			order by source
+			limit $3`,
+			id, depth, maxReplyTreeLinks,
+		)
+		if err != nil {
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var source, target uint64
+			err = rows.Scan(&source, &target)
+			if err != nil {
+				return
+			}
+			children[target] = append(children[target], source)
+			ids = append(ids, source)
+		}
+		err = rows.Err()
+		if err != nil {
+			return
+		}
+
+		posts, err := getPostsByID(r, ids)
+		if err != nil {
+			return
+		}
+		tree = buildReplyTree(id, depth, posts, children)
+		return
+	})
+	return
+}
+
+// Build a reply tree from the posts replying to each post
+func buildReplyTree(
+	root uint64,
+	depth int,
+	posts map[uint64]common.StandalonePost,
+	children map[uint64][]uint64,
+) common.ReplyTree {
+	// Find the shallowest level of each post in the tree breadth-first
+	levels := map[uint64]int{root: 0}
+	queue := []uint64{root}
+	for len(queue) != 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if levels[id] == depth {
+			continue
+		}
+		for _, ch := range children[id] {
+			if _, ok := posts[ch]; !ok {
+				continue
+			}
+			if _, ok := levels[ch]; !ok {
+				levels[ch] = levels[id] + 1
+				queue = append(queue, ch)
+			}
+		}
+	}
+
+	// Include each post only at the first occurrence on its shallowest level.
+	// This also breaks link cycles.
+	included := make(map[uint64]bool, len(levels))
+	var build func(id uint64) common.ReplyTree
+	build = func(id uint64) (tree common.ReplyTree) {
+		included[id] = true
+		tree.StandalonePost = posts[id]
+		tree.Replies = []common.ReplyTree{}
+		level := levels[id]
+		if level == depth {
+			return
+		}
+		for _, ch := range children[id] {
+			if _, ok := posts[ch]; !ok {
+				continue
+			}
+			if !included[ch] && levels[ch] == level+1 {
+				tree.Replies = append(tree.Replies, build(ch))
+			} else {
+				tree.References = append(tree.References, ch)
+			}
+		}
+		return
+	}
+	return build(root)
+}
+
+// Return sql.ErrNoRows, if a post does not exist
+func assertPostExists(r reader, id uint64) (err error) {
+	const q = `select exists (select 1 from posts where id = $1)`
+	var exists bool
+	err = r.db.QueryRow(q, id).Scan(&exists)
+	if err == nil && !exists {
+		err = sql.ErrNoRows
+	}
+	return
+}
+
+// Read multiple posts with unknown parenthood by ID
+func getPostsByID(r reader, ids []uint64) (
+	posts map[uint64]common.StandalonePost, err error,
+) {
+	arr := make(pq.Int64Array, len(ids))
+	for i, id := range ids {
+		arr[i] = int64(id)
+	}
+	rows, err := r.db.Query(
+		`select p.op, p.board, `+postSelectsSQL+`
+		from posts as p
+		left outer join images as i on p.SHA1 = i.SHA1
+		where p.id = any($1)`,
+		arr,
+	)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var (
+		post  postScanner
+		img   imageScanner
+		pArgs = post.ScanArgs()
+		iArgs = img.ScanArgs()
+		args  = make([]interface{}, 2, 2+len(pArgs)+len(iArgs))
+		p     common.StandalonePost
+		all   = make([]common.StandalonePost, 0, len(ids))
+	)
+	args[0] = &p.OP
+	args[1] = &p.Board
+	args = append(args, pArgs...)
+	args = append(args, iArgs...)
+	for rows.Next() {
+		err = rows.Scan(args...)
+		if err != nil {
+			return
+		}
+		p.Post, err = extractPost(post, img)
+		if err != nil {
+			return
+		}
+		all = append(all, p)
+	}
+	err = rows.Err()
+	if err != nil {
+		return
+	}
+
+	var open, moderated []*common.Post
+	for i := range all {
+		filterOpen(&open, &all[i].Post)
+		filterModerated(&moderated, &all[i].Post)
+	}
+	err = injectModeration(moderated, r.db)
+	if err != nil {
+		return
+	}
+	err = injectOpenBodies(open)
+	if err != nil {
+		return
+	}
+
+	posts = make(map[uint64]common.StandalonePost, len(all))
+	for _, p := range all {
+		posts[p.ID] = p
+	}
+	return
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func prepareReplies(t *testing.T) {
+	t.Helper()
+	prepareThreads(t)
+	assertExec(t, `insert into links (source, target) values (2, 1), (4, 3)`)
+}
+
+func TestGetReplies(t *testing.T) {
+	prepareReplies(t)
+
+	replies, err := GetReplies(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, replies, []common.Link{
+		{
+			ID:    2,
+			OP:    1,
+			Board: "a",
+		},
+		{
+			ID:    3,
+			OP:    3,
+			Board: "c",
+		},
+	})
+
+	_, err = GetReplies(99)
+	AssertEquals(t, err, sql.ErrNoRows)
+}
+
+func TestGetReplyTree(t *testing.T) {
+	prepareReplies(t)
+
+	// Reduce tree to post IDs for comparison
+	type node struct {
+		id      uint64
+		replies []node
+		refs    []uint64
+	}
+	var toNodes func(tree common.ReplyTree) node
+	toNodes = func(tree common.ReplyTree) (n node) {
+		n.id = tree.ID
+		n.refs = tree.References
+		n.replies = make([]node, 0, len(tree.Replies))
+		for _, r := range tree.Replies {
+			n.replies = append(n.replies, toNodes(r))
+		}
+		return
+	}
+
+	cases := [...]struct {
+		name  string
+		depth int
+		tree  node
+	}{
+		{
+			name:  "root only",
+			depth: 0,
+			tree: node{
+				id:      1,
+				replies: []node{},
+			},
+		},
+		{
+			name:  "direct replies",
+			depth: 1,
+			tree: node{
+				id: 1,
+				replies: []node{
+					{2, []node{}, nil},
+					{3, []node{}, nil},
+				},
+			},
+		},
+		{
+			name:  "cross-board chain",
+			depth: 2,
+			tree: node{
+				id: 1,
+				replies: []node{
+					{2, []node{}, nil},
+					{3, []node{
+						{4, []node{}, nil},
+					}, nil},
+				},
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			tree, err := GetReplyTree(1, c.depth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, toNodes(tree), c.tree)
+			AssertEquals(t, tree.Board, "a")
+		})
+	}
+
+	t.Run("shared replies", func(t *testing.T) {
+		// 4 also replies to 2 and 1 replies to 4, forming a cycle
+		assertExec(t, `insert into links (source, target) values (4, 2), (1, 4)`)
+		defer assertExec(t,
+			`delete from links where (source, target) in ((4, 2), (1, 4))`)
+
+		tree, err := GetReplyTree(1, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, toNodes(tree), node{
+			id: 1,
+			replies: []node{
+				{2, []node{
+					{4, []node{}, []uint64{1}},
+				}, nil},
+				{3, []node{}, []uint64{4}},
+			},
+		})
+	})
+
+	t.Run("nonexistent post", func(t *testing.T) {
+		_, err := GetReplyTree(99, 1)
+		AssertEquals(t, err, sql.ErrNoRows)
+	})
+}
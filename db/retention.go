@@ -0,0 +1,93 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bakape/meguca/config"
+	"github.com/go-playground/log"
+)
+
+// Data purged after a number of days configured in config.Configs
+type retentionRule struct {
+	// Description of the purged data for logging
+	name string
+
+	// Retention period in days. 0 disables purging.
+	days func(conf config.Configs) uint
+
+	// Purge data created before cutoff and return the number of purged rows
+	purge func(cutoff time.Time) (int64, error)
+}
+
+var retentionRules = [...]retentionRule{
+	{
+		name: "post IPs and passwords",
+		days: func(conf config.Configs) uint {
+			return conf.IPRetention
+		},
+		purge: removeIdentityInfo,
+	},
+	{
+		name: "moderation log entries",
+		days: func(conf config.Configs) uint {
+			return conf.ModLogRetention
+		},
+		purge: func(cutoff time.Time) (int64, error) {
+			return deleteCreatedBefore("mod_log", "created", cutoff)
+		},
+	},
+	{
+		name: "reports",
+		days: func(conf config.Configs) uint {
+			return conf.ReportRetention
+		},
+		purge: func(cutoff time.Time) (int64, error) {
+			return deleteCreatedBefore("reports", "created", cutoff)
+		},
+	},
+	{
+		name: "board statistics",
+		days: func(conf config.Configs) uint {
+			return conf.EngagementRetention
+		},
+		purge: func(cutoff time.Time) (int64, error) {
+			return deleteCreatedBefore("board_stats", "hour", cutoff)
+		},
+	},
+}
+
+// Purge data older than the retention periods set in the server
+// configuration and log the amount of purged data
+func applyRetentionPolicy() {
+	conf := config.Get()
+	for _, r := range retentionRules {
+		days := r.days(*conf)
+		if days == 0 {
+			continue
+		}
+		cutoff := time.Now().Add(-time.Duration(days) * time.Hour * 24)
+		n, err := r.purge(cutoff)
+		if err != nil {
+			logError(fmt.Sprintf("retention policy: purging %s", r.name), err)
+			continue
+		}
+		if n != 0 {
+			log.Infof("retention policy: purged %d %s older than %d days",
+				n, r.name, days)
+		}
+	}
+}
+
+// Delete all rows of a table with a timestamp column value before cutoff
+func deleteCreatedBefore(table, column string, cutoff time.Time) (
+	int64, error,
+) {
+	res, err := sq.Delete(table).
+		Where(column+" < ?", cutoff.UTC()).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/config"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestApplyRetentionPolicy(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	old := *config.Get()
+	t.Cleanup(func() {
+		config.Set(old)
+	})
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	for _, days := range [...]int{1, 30} {
+		assertExec(t,
+			`insert into board_stats (board, hour) values ('a', $1)`,
+			now.Add(-time.Duration(days)*time.Hour*24))
+	}
+
+	countStats := func(t *testing.T) (n int) {
+		t.Helper()
+		err := sq.Select("count(*)").
+			From("board_stats").
+			QueryRow().
+			Scan(&n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	cases := [...]struct {
+		name string
+		days uint
+		left int
+	}{
+		{"disabled", 0, 2},
+		{"nothing expired", 60, 2},
+		{"expired", 7, 1},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			config.Set(config.Configs{
+				EngagementRetention: c.days,
+			})
+			applyRetentionPolicy()
+			AssertEquals(t, countStats(t), c.left)
+		})
+	}
+}
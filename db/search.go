@@ -0,0 +1,115 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+// SearchParams specifies the query and filters of a full-text post search
+type SearchParams struct {
+	// Only match posts with images
+	HasImage bool
+	// Include deleted and shadow-binned posts in the results
+	ShowModerated bool
+	// Only match posts with an image of this file type, if set
+	FileType *uint8
+	// Only match posts with a lower ID than this, if set. Used for pagination.
+	Before uint64
+	// Only match posts created in this Unix time range. Zero values are
+	// ignored.
+	From, To int64
+	// Plain text search query
+	Query string
+	// Board to search. Empty or "all" for all boards.
+	Board string
+}
+
+// SearchPosts performs a full-text search of closed post bodies and thread
+// subjects. Results are returned newest first.
+func SearchPosts(p SearchParams) (posts []common.StandalonePost, err error) {
+	q := sq.Select("p.op, p.board, "+postSelectsSQL).
+		From("posts as p").
+		Join("threads as t on p.op = t.id").
+		LeftJoin("images as i on p.SHA1 = i.SHA1").
+		Where("p.editing = false").
+		Where(
+			`(to_tsvector('simple', p.body) @@ plainto_tsquery('simple', ?)
+			or (p.id = p.op
+				and to_tsvector('simple', t.subject)
+					@@ plainto_tsquery('simple', ?)))`,
+			p.Query, p.Query,
+		).
+		OrderBy("p.id desc").
+		Limit(common.MaxSearchResults)
+	if p.Board != "" && p.Board != "all" {
+		q = q.Where("p.board = ?", p.Board)
+	}
+	if p.From != 0 {
+		q = q.Where("p.time >= ?", p.From)
+	}
+	if p.To != 0 {
+		q = q.Where("p.time <= ?", p.To)
+	}
+	if p.HasImage {
+		q = q.Where("p.SHA1 is not null")
+	}
+	if p.FileType != nil {
+		q = q.Where("i.file_type = ?", *p.FileType)
+	}
+	if p.Before != 0 {
+		q = q.Where("p.id < ?", p.Before)
+	}
+	if !p.ShowModerated {
+		q = q.Where(
+			`not exists (select 1
+				from post_moderation as pm
+				where pm.post_id = p.id
+					and pm.type in (?, ?))`,
+			common.DeletePost, common.ShadowBinPost,
+		)
+	}
+	// Hide posts from NSFW boards on /all/, if enabled. Must be filtered in
+	// the query, so the result limit is not consumed by hidden posts.
+	if config.Get().HideNSFW && (p.Board == "" || p.Board == "all") {
+		q = q.Where(
+			`not exists (select 1
+				from boards as b
+				where b.id = p.board and b.nsfw)`,
+		)
+	}
+
+	var (
+		res  common.StandalonePost
+		post postScanner
+		img  imageScanner
+		args = append(
+			[]interface{}{&res.OP, &res.Board},
+			append(post.ScanArgs(), img.ScanArgs()...)...,
+		)
+	)
+	posts = make([]common.StandalonePost, 0, 32)
+	err = queryAll(q, func(r *sql.Rows) (err error) {
+		err = r.Scan(args...)
+		if err != nil {
+			return
+		}
+		res.Post, err = extractPost(post, img)
+		if err != nil {
+			return
+		}
+		posts = append(posts, res)
+		return
+	})
+	if err != nil {
+		return
+	}
+
+	moderated := make([]*common.Post, 0, 16)
+	for i := range posts {
+		filterModerated(&moderated, &posts[i].Post)
+	}
+	err = injectModeration(moderated, nil)
+	return
+}
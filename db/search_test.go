@@ -0,0 +1,261 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
+)
+
+func uint8Ptr(i uint8) *uint8 {
+	return &i
+}
+
+func TestSearchPosts(t *testing.T) {
+	assertTableClear(t, "boards", "images")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+	writeSampleImage(t)
+
+	now := time.Now().Unix()
+	err := InTransaction(false, func(tx *sql.Tx) (err error) {
+		for i, body := range [...]string{"foo bar", "foo baz", "qux"} {
+			p := Post{
+				StandalonePost: common.StandalonePost{
+					OP:    1,
+					Board: "a",
+					Post: common.Post{
+						ID:   uint64(i + 2),
+						Time: now,
+						Body: body,
+					},
+				},
+			}
+			if body == "qux" {
+				p.Image = &assets.StdJPEG
+			}
+			err = WritePost(tx, p)
+			if err != nil {
+				return
+			}
+		}
+		_, err = sq.Insert("post_moderation").
+			Columns("post_id", "type", "by").
+			Values(3, common.DeletePost, "admin").
+			RunWith(tx).
+			Exec()
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name string
+		in   SearchParams
+		out  []uint64
+	}{
+		{
+			name: "no match",
+			in: SearchParams{
+				Query: "nothing",
+			},
+		},
+		{
+			name: "hide moderated",
+			in: SearchParams{
+				Query: "foo",
+			},
+			out: []uint64{2},
+		},
+		{
+			name: "show moderated",
+			in: SearchParams{
+				Query:         "foo",
+				ShowModerated: true,
+			},
+			out: []uint64{3, 2},
+		},
+		{
+			name: "by board",
+			in: SearchParams{
+				Query: "qux",
+				Board: "a",
+			},
+			out: []uint64{4},
+		},
+		{
+			name: "other board",
+			in: SearchParams{
+				Query: "qux",
+				Board: "c",
+			},
+		},
+		{
+			name: "before",
+			in: SearchParams{
+				Query:         "foo",
+				ShowModerated: true,
+				Before:        3,
+			},
+			out: []uint64{2},
+		},
+		{
+			name: "date range",
+			in: SearchParams{
+				Query: "foo",
+				To:    now - 60,
+			},
+		},
+		{
+			name: "has image",
+			in: SearchParams{
+				Query:    "foo",
+				HasImage: true,
+			},
+		},
+		{
+			name: "file type",
+			in: SearchParams{
+				Query:    "qux",
+				FileType: uint8Ptr(common.JPEG),
+			},
+			out: []uint64{4},
+		},
+		{
+			name: "other file type",
+			in: SearchParams{
+				Query:    "qux",
+				FileType: uint8Ptr(common.PNG),
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := SearchPosts(c.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]uint64, 0, len(res))
+			for _, p := range res {
+				ids = append(ids, p.ID)
+			}
+			if c.out == nil {
+				c.out = []uint64{}
+			}
+			AssertEquals(t, ids, c.out)
+		})
+	}
+}
+
+func TestSearchHideNSFW(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	old := *config.Get()
+	t.Cleanup(func() {
+		config.Set(old)
+	})
+	config.Set(config.Configs{
+		HideNSFW: true,
+	})
+
+	err := InTransaction(false, func(tx *sql.Tx) error {
+		return WriteBoard(tx, BoardConfigs{
+			BoardConfigs: config.BoardConfigs{
+				ID:        "c",
+				Eightball: []string{"yes"},
+				BoardPublic: config.BoardPublic{
+					NSFW: true,
+				},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WriteThread(Thread{
+		ID:    2,
+		Board: "c",
+	}, Post{
+		StandalonePost: common.StandalonePost{
+			OP:    2,
+			Board: "c",
+			Post: common.Post{
+				ID:   2,
+				Time: time.Now().Unix(),
+			},
+		},
+		IP: "::1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = InTransaction(false, func(tx *sql.Tx) (err error) {
+		// Newer NSFW posts, than fit in a single result page
+		posts := make([]Post, 0, common.MaxSearchResults+1)
+		posts = append(posts, Post{
+			StandalonePost: common.StandalonePost{
+				OP:    1,
+				Board: "a",
+				Post: common.Post{
+					ID:   3,
+					Body: "foo",
+				},
+			},
+		})
+		for i := 0; i < common.MaxSearchResults; i++ {
+			posts = append(posts, Post{
+				StandalonePost: common.StandalonePost{
+					OP:    2,
+					Board: "c",
+					Post: common.Post{
+						ID:   uint64(i + 4),
+						Body: "foo",
+					},
+				},
+			})
+		}
+		for _, p := range posts {
+			err = WritePost(tx, p)
+			if err != nil {
+				return
+			}
+		}
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, board := range [...]string{"", "all"} {
+		res, err := SearchPosts(SearchParams{
+			Query: "foo",
+			Board: board,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, len(res), 1)
+		AssertEquals(t, res[0].ID, uint64(3))
+	}
+
+	res, err := SearchPosts(SearchParams{
+		Query: "foo",
+		Board: "c",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(res), common.MaxSearchResults)
+}
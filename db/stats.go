@@ -0,0 +1,167 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Maximum age of posts aggregated into board statistics. Poster IPs are
+// removed after this time with the default retention policy.
+const statsWindow = time.Hour * 24 * 7
+
+// Viewer counts of server instances are ignored, if not updated for this long.
+// Must exceed the viewer sampling interval of all instances.
+const viewerSampleTimeout = time.Minute * 3
+
+// Aggregate board statistics of all hours completed since the last
+// aggregation. Only counts are stored, so no poster identifying information
+// outlives removeIdentityInfo().
+func aggregateStats() error {
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		// Lock row to prevent concurrent aggregation by other processes
+		var last int64
+		err = sq.Select("val").
+			From("main").
+			Where("id = 'stats_hour'").
+			Suffix("for update").
+			RunWith(tx).
+			QueryRow().
+			Scan(&last)
+		if err != nil {
+			return
+		}
+
+		end := time.Now().Truncate(time.Hour).Unix()
+		start := end - int64(statsWindow/time.Second)
+		if last > start {
+			start = last
+		}
+		if start >= end {
+			return
+		}
+
+		_, err = tx.Exec(
+			`insert into board_stats (
+				board, hour, posts, threads, images, unique_posters
+			)
+			select board,
+				to_timestamp(time - time % 3600) at time zone 'utc',
+				count(*),
+				count(*) filter (where id = op),
+				count(sha1),
+				count(distinct ip)
+			from posts
+			where time >= $1 and time < $2
+			group by 1, 2
+			on conflict (board, hour) do update
+				set posts = excluded.posts,
+					threads = excluded.threads,
+					images = excluded.images,
+					unique_posters = excluded.unique_posters`,
+			start, end,
+		)
+		if err != nil {
+			return
+		}
+
+		_, err = sq.Update("main").
+			Set("val", end).
+			Where("id = 'stats_hour'").
+			RunWith(tx).
+			Exec()
+		return
+	})
+}
+
+// RecordViewers records the current number of unique viewers of boards on a
+// server instance and updates the peak of the current hour, if the sum of the
+// latest counts of all instances exceeds it. Boards missing from counts have
+// no viewers on the instance.
+func RecordViewers(node string, counts map[string]int) error {
+	var (
+		now     = time.Now().UTC()
+		expired = now.Add(-viewerSampleTimeout)
+	)
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		// Also drop counts of instances, that have stopped reporting
+		_, err = sq.Delete("board_viewers").
+			Where("node = ? or updated < ?", node, expired).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+
+		if len(counts) != 0 {
+			// Ignores the "/all/" meta-board and deleted boards
+			var q *sql.Stmt
+			q, err = tx.Prepare(
+				`insert into board_viewers (board, node, viewers, updated)
+				select id, $2, $3, $4
+				from boards
+				where id = $1`,
+			)
+			if err != nil {
+				return
+			}
+			defer q.Close()
+
+			for board, n := range counts {
+				_, err = q.Exec(board, node, n, now)
+				if err != nil {
+					return
+				}
+			}
+		}
+
+		_, err = tx.Exec(
+			`insert into board_stats (board, hour, peak_viewers)
+			select board, $1, sum(viewers)
+			from board_viewers
+			group by board
+			on conflict (board, hour) do update
+				set peak_viewers = greatest(board_stats.peak_viewers,
+					excluded.peak_viewers)`,
+			now.Truncate(time.Hour),
+		)
+		return
+	})
+}
+
+// GetBoardStats retrieves the hourly statistics of a board since a point in
+// time, newest first
+func GetBoardStats(board string, since time.Time) (
+	stats []common.BoardStats, err error,
+) {
+	err = withReader(0, board, func(r reader) error {
+		stats = make([]common.BoardStats, 0, 64)
+		rows, err := r.sq.
+			Select(
+				"extract(epoch from hour)::bigint",
+				"posts", "threads", "images", "unique_posters",
+				"peak_viewers",
+			).
+			From("board_stats").
+			Where("board = ? and hour >= ?", board, since.UTC()).
+			OrderBy("hour desc").
+			Query()
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var s common.BoardStats
+			err = rows.Scan(&s.Hour, &s.Posts, &s.Threads, &s.Images,
+				&s.UniquePosters, &s.PeakViewers)
+			if err != nil {
+				return err
+			}
+			stats = append(stats, s)
+		}
+		return rows.Err()
+	})
+	return
+}
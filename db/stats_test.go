@@ -0,0 +1,70 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestBoardStats(t *testing.T) {
+	assertTableClear(t, "boards", "board_viewers")
+	assertExec(t, `update main set val = '0' where id = 'stats_hour'`)
+	writeSampleBoard(t)
+	writeSampleThread(t)
+
+	// Move the thread into the last completed hour
+	current := time.Now().Truncate(time.Hour)
+	last := current.Add(-time.Hour)
+	assertExec(t, `update posts set time = $1`, last.Unix()+60)
+
+	// Counts of instances, that stopped reporting, are not summed
+	assertExec(t,
+		`insert into board_viewers (board, node, viewers, updated)
+		values ('a', 'stale', 100, $1)`,
+		time.Now().UTC().Add(-viewerSampleTimeout*2))
+
+	samples := [...]struct {
+		node  string
+		count int
+	}{
+		{"foo", 1},
+		{"bar", 2},
+		{"foo", 3},
+		{"foo", 0},
+		{"bar", 1},
+	}
+	for _, s := range samples {
+		counts := map[string]int{
+			"all": 10,
+		}
+		if s.count != 0 {
+			counts["a"] = s.count
+		}
+		err := RecordViewers(s.node, counts)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := aggregateStats(); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := GetBoardStats("a", last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, stats, []common.BoardStats{
+		{
+			Hour:        current.Unix(),
+			PeakViewers: 5,
+		},
+		{
+			Hour:          last.Unix(),
+			Posts:         1,
+			Threads:       1,
+			UniquePosters: 1,
+		},
+	})
+}
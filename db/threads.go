@@ -145,10 +145,11 @@ type Thread struct {
 
 // ThreadCounter retrieves the progress counter of a thread
 func ThreadCounter(id uint64) (uint64, error) {
-	q := sq.Select("update_time").
-		From("threads").
-		Where("id = ?", id)
-	return getCounter(q)
+	return getCounter(id, "", func(r reader) squirrel.SelectBuilder {
+		return r.sq.Select("update_time").
+			From("threads").
+			Where("id = ?", id)
+	})
 }
 
 // ValidateOP confirms the specified thread exists on specific board
@@ -205,9 +206,10 @@ func WriteThread(t Thread, p Post) (err error) {
 	})
 }
 
-// CheckThreadLocked checks, if a thread has been locked by a moderator
+// CheckThreadLocked checks, if a thread has been locked by a moderator or
+// archived
 func CheckThreadLocked(id uint64) (locked bool, err error) {
-	err = sq.Select("locked").
+	err = sq.Select("locked or archived").
 		From("threads").
 		Where("id = ?", id).
 		QueryRow().
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -129,3 +130,134 @@ func TestInsertThread(t *testing.T) {
 		t.Fatal(p.ID)
 	}
 }
+
+func TestBoardFeedNotifications(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+
+	type event struct {
+		Type     string `json:"type"`
+		ID       uint64 `json:"id"`
+		Board    string `json:"board"`
+		BumpTime int64  `json:"bump_time"`
+		Sticky   bool   `json:"sticky"`
+		Locked   bool   `json:"locked"`
+	}
+
+	events := make(chan event, 32)
+	canceller := make(chan struct{})
+	defer func() {
+		canceller <- struct{}{}
+	}()
+	err := ListenCancelable("board_feed", canceller, func(msg string) error {
+		var e event
+		err := json.Unmarshal([]byte(msg), &e)
+		if err != nil {
+			return err
+		}
+		events <- e
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sleep to ensure notifications fire
+	drain := func() (res []event) {
+		time.Sleep(time.Millisecond * 100)
+		for {
+			select {
+			case e := <-events:
+				res = append(res, e)
+			default:
+				return
+			}
+		}
+	}
+
+	writeSampleThread(t)
+	created := drain()
+	if len(created) == 0 {
+		t.Fatal("no thread creation event")
+	}
+	test.AssertEquals(t, created[0].Type, "create")
+	test.AssertEquals(t, created[0].ID, uint64(1))
+	test.AssertEquals(t, created[0].Board, "a")
+
+	var bumpTime int64
+	err = sq.Select("bump_time").
+		From("threads").
+		Where("id = 1").
+		QueryRow().
+		Scan(&bumpTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := [...]struct {
+		name, query string
+		event       event
+	}{
+		{
+			name:  "bump",
+			query: `update threads set bump_time = bump_time + 1 where id = 1`,
+			event: event{
+				Type:     "bump",
+				BumpTime: bumpTime + 1,
+			},
+		},
+		{
+			name:  "sticky",
+			query: `update threads set sticky = true where id = 1`,
+			event: event{
+				Type:     "update",
+				BumpTime: bumpTime + 1,
+				Sticky:   true,
+			},
+		},
+		{
+			name:  "lock",
+			query: `update threads set locked = true where id = 1`,
+			event: event{
+				Type:     "update",
+				BumpTime: bumpTime + 1,
+				Sticky:   true,
+				Locked:   true,
+			},
+		},
+		{
+			name:  "unchanged",
+			query: `update threads set subject = 'foo' where id = 1`,
+		},
+		{
+			name:  "archive",
+			query: `update threads set archived = true where id = 1`,
+			event: event{
+				Type: "delete",
+			},
+		},
+		{
+			name:  "delete",
+			query: `delete from threads where id = 1`,
+			event: event{
+				Type: "delete",
+			},
+		},
+	}
+
+	// Sequential, as cases modify the same thread
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			assertExec(t, c.query)
+			res := drain()
+			if c.event.Type == "" {
+				test.AssertEquals(t, len(res), 0)
+				return
+			}
+			c.event.ID = 1
+			c.event.Board = "a"
+			test.AssertEquals(t, res, []event{c.event})
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/bakape/meguca/common"
+)
+
+// GetExportThreadIDs retrieves the IDs of all threads on a board including
+// archived ones, oldest first
+func GetExportThreadIDs(board string) ([]uint64, error) {
+	return scanThreadIDs(sq.Select("id").
+		From("threads").
+		Where("board = ?", board).
+		OrderBy("id asc"))
+}
+
+// AllocatePostIDs reserves n new post IDs in ascending order
+func AllocatePostIDs(n int) (ids []uint64, err error) {
+	r, err := db.Query(
+		`select nextval('post_id') from generate_series(1, $1)`, n)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	ids = make([]uint64, 0, n)
+	for r.Next() {
+		var id uint64
+		err = r.Scan(&id)
+		if err != nil {
+			return
+		}
+		ids = append(ids, id)
+	}
+	err = r.Err()
+	return
+}
+
+// ImportThreads writes threads, their posts and image records to the database
+// in a single transaction. All post IDs must already be allocated with
+// AllocatePostIDs and links must only target posts in threads.
+func ImportThreads(threads []common.Thread) error {
+	for _, t := range threads {
+		markWritten(t.ID, t.Board)
+	}
+	return InTransaction(false, func(tx *sql.Tx) (err error) {
+		for _, t := range threads {
+			err = importThread(tx, t)
+			if err != nil {
+				return
+			}
+		}
+
+		// Written last, as links can target posts in any of the threads
+		for _, t := range threads {
+			err = writeLinks(tx, t.ID, t.Links)
+			if err != nil {
+				return
+			}
+			for _, p := range t.Posts {
+				err = writeLinks(tx, p.ID, p.Links)
+				if err != nil {
+					return
+				}
+			}
+		}
+		return
+	})
+}
+
+func importThread(tx *sql.Tx, t common.Thread) (err error) {
+	_, err = sq.Insert("threads").
+		Columns(
+			"board", "id", "update_time", "bump_time", "subject", "sticky",
+			"locked", "archived",
+		).
+		Values(
+			t.Board, t.ID, t.UpdateTime, t.BumpTime, t.Subject, t.Sticky,
+			t.Locked, t.Archived,
+		).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+
+	err = importPost(tx, t.ID, t.Board, t.Post)
+	if err != nil {
+		return
+	}
+	for _, p := range t.Posts {
+		err = importPost(tx, t.ID, t.Board, p)
+		if err != nil {
+			return
+		}
+	}
+
+	// Inserting posts bumps the thread. Restore the original bump time.
+	_, err = sq.Update("threads").
+		Set("bump_time", t.BumpTime).
+		Where("id = ?", t.ID).
+		RunWith(tx).
+		Exec()
+	return
+}
+
+// Write a closed post and its files and moderation records without links
+func importPost(tx *sql.Tx, op uint64, board string, p common.Post) (
+	err error,
+) {
+	var (
+		img     *string
+		imgName string
+		spoiler bool
+	)
+	if p.Image != nil {
+		err = importImage(tx, p.Image.ImageCommon)
+		if err != nil {
+			return
+		}
+		img = &p.Image.SHA1
+		imgName = p.Image.Name
+		spoiler = p.Image.Spoiler
+	}
+
+	for _, f := range p.Attachments {
+		err = importImage(tx, f.ImageCommon)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = sq.Insert("posts").
+		Columns(
+			"editing", "spoiler", "sage", "moderated", "id", "board", "op",
+			"time", "body", "flag", "name", "trip", "auth", "SHA1",
+			"imageName", "commands",
+		).
+		Values(
+			false, spoiler, p.Sage, len(p.Moderation) != 0, p.ID, board, op,
+			p.Time, p.Body, p.Flag, p.Name, p.Trip, p.Auth, img,
+			imgName, commandRow(p.Commands),
+		).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return
+	}
+	err = writeAttachments(tx, p.ID, p.Attachments)
+	if err != nil {
+		return
+	}
+
+	for _, m := range p.Moderation {
+		_, err = sq.Insert("post_moderation").
+			Columns("post_id", "type", "by", "length", "data").
+			Values(p.ID, m.Type, m.By, m.Length, m.Data).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Write an image record, unless it already exists
+func importImage(tx *sql.Tx, img common.ImageCommon) (err error) {
+	var exists bool
+	err = tx.QueryRow(
+		`select exists (select 1 from images where SHA1 = $1)`,
+		img.SHA1,
+	).
+		Scan(&exists)
+	if err != nil || exists {
+		return
+	}
+	return writeImageTx(tx, img)
+}
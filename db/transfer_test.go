@@ -0,0 +1,65 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestExportImportThread(t *testing.T) {
+	prepareForPostInsertion(t)
+
+	reply := Post{
+		StandalonePost: common.StandalonePost{
+			Post: common.Post{
+				Body: "foo",
+			},
+			OP:    1,
+			Board: "a",
+		},
+		IP: "::1",
+	}
+	insertPost(t, &reply)
+	assertExec(t,
+		`update threads set archived = true, sticky = true where id = 1`)
+
+	ids, err := GetExportThreadIDs("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, ids, []uint64{1})
+	exported, err := GetThread(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, exported.Archived, true)
+
+	// Import under newly allocated IDs
+	newIDs, err := AllocatePostIDs(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thread := exported
+	thread.Posts = append([]common.Post(nil), exported.Posts...)
+	thread.ID = newIDs[0]
+	thread.Posts[0].ID = newIDs[1]
+	err = ImportThreads([]common.Thread{thread})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := GetThread(thread.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, imported, thread)
+	AssertEquals(t, imported.Locked, true)
+
+	// Archived threads must stay out of the board catalog
+	ids, err = GetThreadIDs("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, len(ids), 0)
+}
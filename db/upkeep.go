@@ -41,7 +41,12 @@ func runCleanupTasks() {
 func runMinuteTasks() {
 	if config.ImagerMode != config.ImagerOnly {
 		logError("open post cleanup", closeDanglingPosts())
-		expireRows("image_tokens", "bans", "failed_captchas")
+		cleanUpRecentWrites()
+		expireRows("image_tokens", "bans", "failed_captchas",
+			"notify_overflow")
+	}
+	if config.ImagerMode != config.NoImager {
+		expireRows("upload_jobs")
 	}
 }
 
@@ -56,17 +61,17 @@ func runHalfTasks() {
 func runHourTasks() {
 	if config.ImagerMode != config.ImagerOnly {
 		expireRows("sessions")
-		expireBy("created < now() at time zone 'utc' + '-7 days'",
-			"mod_log", "reports")
-		logError("remove identity info", removeIdentityInfo())
+		applyRetentionPolicy()
 		logError("thread cleanup", deleteOldThreads())
 		logError("board cleanup", deleteUnusedBoards())
 		logError("delete dangling open post bodies", cleanUpOpenPostBodies())
+		logError("aggregate board statistics", aggregateStats())
 		_, err := db.Exec(`vacuum`)
 		logError("vaccum database", err)
 	}
 	if config.ImagerMode != config.NoImager {
 		logError("image cleanup", deleteUnusedImages())
+		logError("drop archived image sources", dropArchivedSources())
 	}
 }
 
@@ -92,22 +97,27 @@ func expireRows(tables ...string) {
 	expireBy("expires < now() at time zone 'utc'", tables...)
 }
 
-// Remove poster-identifying info from posts older than 7 days
-func removeIdentityInfo() error {
-	_, err := sq.Update("posts").
+// Remove poster-identifying info from posts created before cutoff
+func removeIdentityInfo(cutoff time.Time) (int64, error) {
+	res, err := sq.Update("posts").
 		Set("ip", nil).
 		Set("password", nil).
-		Where(`time < extract(epoch from now() at time zone 'utc'
-			- interval '7 days')`).
+		Where("time < ?", cutoff.Unix()).
 		Where("ip is not null").
 		Exec()
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
 }
 
-// Close any open posts that have not been closed for 30 minutes
+// Close any open posts, that have exceeded their board's maximum open or idle
+// time, unless they are still within their recovery window after a server
+// restart
 func closeDanglingPosts() error {
 	type post struct {
 		id, op uint64
+		time   int64
 		board  string
 		ip     sql.NullString
 	}
@@ -116,12 +126,11 @@ func closeDanglingPosts() error {
 		p     post
 	)
 	err := queryAll(
-		sq.Select("id", "op", "board", "ip").
+		sq.Select("id", "op", "time", "board", "ip").
 			From("posts").
-			Where(`editing = true and time
-				< floor(extract(epoch from now() at time zone 'utc')) - 900`),
+			Where("editing = true"),
 		func(r *sql.Rows) (err error) {
-			err = r.Scan(&p.id, &p.op, &p.board, &p.ip)
+			err = r.Scan(&p.id, &p.op, &p.time, &p.board, &p.ip)
 			if err != nil {
 				return err
 			}
@@ -133,7 +142,39 @@ func closeDanglingPosts() error {
 		return err
 	}
 
+	recoverable, err := getRecoverablePosts()
+	if err != nil {
+		return err
+	}
+
+	// Fetch all last edit times in one batch instead of one store lookup per
+	// post
+	n := 0
+	ids := make([]uint64, 0, len(posts))
+	for _, p := range posts {
+		if _, ok := recoverable[p.id]; !ok {
+			posts[n] = p
+			n++
+			ids = append(ids, p.id)
+		}
+	}
+	posts = posts[:n]
+	lastEdits, err := getLastEdits(ids)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
 	for _, p := range posts {
+		lastEdit := lastEdits[p.id]
+		if lastEdit == 0 {
+			lastEdit = p.time
+		}
+		conf := config.GetBoardConfigs(p.board)
+		if conf.OpenPostDeadline(p.time, lastEdit) > now {
+			continue
+		}
+
 		// Get post body from BoltDB
 		body, err := GetOpenBody(p.id)
 		if err != nil {
@@ -227,9 +268,9 @@ func deleteBoard(tx *sql.Tx, id, by, reason string) (err error) {
 	return
 }
 
-// Delete stale threads. Thread retention measured in a bump time threshold,
-// that is calculated as a function of post count till bump limit with an N days
-// floor and ceiling.
+// Delete stale threads or archive them, if enabled on their board. Thread
+// retention measured in a bump time threshold, that is calculated as a function
+// of post count till bump limit with an N days floor and ceiling.
 func deleteOldThreads() (err error) {
 	conf := config.Get()
 	if !conf.PruneThreads {
@@ -237,20 +278,23 @@ func deleteOldThreads() (err error) {
 	}
 
 	return InTransaction(false, func(tx *sql.Tx) (err error) {
-		// Find threads to delete
+		// Find threads to delete or archive
 		var (
 			now           = time.Now().Unix()
 			min           = float64(conf.ThreadExpiryMin * 24 * 3600)
 			max           = float64(conf.ThreadExpiryMax * 24 * 3600)
 			toDel         = make([]uint64, 0, 16)
+			toArchive     = make([]uint64, 0, 16)
 			id, postCount uint64
 			bumpTime      int64
+			board         string
 			deleted       sql.NullBool
 		)
 		err = queryAll(
 			sq.
 				Select(
 					"threads.id",
+					"threads.board",
 					"bump_time",
 					`(select count(*)
 						from posts
@@ -264,9 +308,10 @@ func deleteOldThreads() (err error) {
 				).
 				From("threads").
 				Join("posts on threads.id = posts.id").
+				Where("threads.archived = false").
 				RunWith(tx),
 			func(r *sql.Rows) (err error) {
-				err = r.Scan(&id, &bumpTime, &postCount, &deleted)
+				err = r.Scan(&id, &board, &bumpTime, &postCount, &deleted)
 				if err != nil {
 					return
 				}
@@ -280,7 +325,12 @@ func deleteOldThreads() (err error) {
 					threshold = min
 				}
 				if float64(now-bumpTime) > threshold {
-					toDel = append(toDel, id)
+					// Threads deleted by staff are never archived
+					if !deleted.Bool && config.GetBoardConfigs(board).Archive {
+						toArchive = append(toArchive, id)
+					} else {
+						toDel = append(toDel, id)
+					}
 				}
 				return
 			},
@@ -304,6 +354,26 @@ func deleteOldThreads() (err error) {
 			}
 		}
 
+		if len(toArchive) != 0 {
+			// Freeze any matched threads as read-only. Updating update_time
+			// invalidates any cached thread pages.
+			q, err = tx.Prepare(
+				`update threads
+				set archived = true,
+					update_time = extract(epoch from now())
+				where id = $1`,
+			)
+			if err != nil {
+				return
+			}
+			for _, id := range toArchive {
+				_, err = q.Exec(id)
+				if err != nil {
+					return
+				}
+			}
+		}
+
 		return
 	})
 }
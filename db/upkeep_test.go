@@ -29,7 +29,18 @@ func TestOpenPostClosing(t *testing.T) {
 		return nil, nil, nil
 	}
 
+	config.ClearBoards()
+	defer config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID:          "a",
+		MaxIdleTime: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tooOld := time.Now().Add(-time.Minute * 31).Unix()
+	idleSince := time.Now().Add(-time.Minute * 6).Unix()
 	posts := [...]Post{
 		{
 			StandalonePost: common.StandalonePost{
@@ -51,8 +62,38 @@ func TestOpenPostClosing(t *testing.T) {
 				OP: 1,
 			},
 		},
+		{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID:      4,
+					Editing: true,
+					Time:    tooOld,
+				},
+				OP: 1,
+			},
+		},
+		{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID:      5,
+					Editing: true,
+					Time:    idleSince,
+				},
+				OP: 1,
+			},
+		},
+		{
+			StandalonePost: common.StandalonePost{
+				Post: common.Post{
+					ID:      6,
+					Editing: true,
+					Time:    idleSince,
+				},
+				OP: 1,
+			},
+		},
 	}
-	err := InTransaction(false, func(tx *sql.Tx) error {
+	err = InTransaction(false, func(tx *sql.Tx) error {
 		for _, p := range posts {
 			err := WritePost(tx, p)
 			if err != nil {
@@ -65,6 +106,24 @@ func TestOpenPostClosing(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// Edited recently enough to not be idle
+	err = SetOpenBody(6, []byte("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Orphaned by a server restart and still within the recovery window
+	deadline := time.Now().Add(DraftRecoveryTime).Unix()
+	err = openPosts.SetRecoveryDeadline([]uint64{4}, deadline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := GetRecoveryDeadline(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, res, deadline)
+
 	if err := closeDanglingPosts(); err != nil {
 		t.Fatal(err)
 	}
@@ -76,6 +135,9 @@ func TestOpenPostClosing(t *testing.T) {
 	}{
 		{"closed", 2, false},
 		{"untouched", 3, true},
+		{"recoverable", 4, true},
+		{"idle", 5, false},
+		{"recently edited", 6, true},
 	}
 
 	for i := range cases {
@@ -349,6 +411,67 @@ func TestDeleteOldThreads(t *testing.T) {
 	})
 }
 
+func TestArchiveOldThreads(t *testing.T) {
+	assertTableClear(t, "boards")
+	writeSampleBoard(t)
+	config.Set(config.Configs{
+		Public: config.Public{
+			ThreadExpiryMin: 7,
+			ThreadExpiryMax: 7,
+			PruneThreads:    true,
+		},
+	})
+	config.ClearBoards()
+	defer config.ClearBoards()
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID:      "a",
+		Archive: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeExpiringThreads(t, threadExpiryCases{
+		{1, "a", time.Now().Add(-eightDays)},
+		{2, "a", time.Now()},
+	})
+
+	if err := deleteOldThreads(); err != nil {
+		t.Fatal(err)
+	}
+	assertThreadDeleted(t, 1, false)
+	assertThreadDeleted(t, 2, false)
+
+	t.Run("hidden from board", func(t *testing.T) {
+		ids, err := GetThreadIDs("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, ids, []uint64{2})
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		archive, err := GetBoardArchive("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(archive.Threads) != 1 {
+			t.Fatalf("unexpected archived thread count: %d",
+				len(archive.Threads))
+		}
+		AssertEquals(t, archive.Threads[0].ID, uint64(1))
+		AssertEquals(t, archive.Threads[0].Locked, true)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		locked, err := CheckThreadLocked(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, locked, true)
+	})
+}
+
 func TestDeleteBoard(t *testing.T) {
 	assertTableClear(t, "boards", "accounts")
 	writeSampleBoard(t)
@@ -379,10 +502,11 @@ func TestRemoveIdentityInfo(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = removeIdentityInfo()
+	n, err := removeIdentityInfo(time.Now().Add(-7 * 24 * time.Hour))
 	if err != nil {
 		t.Fatal(err)
 	}
+	AssertEquals(t, n, int64(1))
 
 	var (
 		ip sql.NullString
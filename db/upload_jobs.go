@@ -0,0 +1,144 @@
+package db
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	"github.com/bakape/meguca/common"
+)
+
+// Upload job states
+const (
+	UploadQueued     = "queued"
+	UploadProcessing = "processing"
+	UploadDone       = "done"
+	UploadFailed     = "failed"
+)
+
+// Unfinished upload jobs are dropped after this long, so jobs orphaned by a
+// lost spool file do not accumulate
+const uploadJobTimeout = time.Hour * 24
+
+// UploadJob is an uploaded file queued for asynchronous processing
+type UploadJob struct {
+	ID, State, IP string
+	// Size of the file in bytes
+	Size int
+	// Strip metadata from the file before processing
+	Strip   bool
+	Created time.Time
+
+	// Set on completion. Token is the image allocation token, if succeeded.
+	// Error and Code are the error message and HTTP status code, if failed.
+	Token, Error string
+	Code         int
+}
+
+// Status returns the status of the job to be sent to clients
+func (j UploadJob) Status() common.UploadStatus {
+	return common.UploadStatus{
+		ID:    j.ID,
+		State: j.State,
+		Token: j.Token,
+		Error: j.Error,
+		Code:  j.Code,
+	}
+}
+
+// InsertUploadJob inserts a new queued upload job into the DB and returns its
+// ID. IDs are hex-encoded, so they can be used in URL paths.
+func InsertUploadJob(ip string, size int, strip bool) (id string, err error) {
+	buf := make([]byte, 16)
+	_, err = rand.Read(buf)
+	if err != nil {
+		return
+	}
+	id = hex.EncodeToString(buf)
+
+	now := time.Now().UTC()
+	_, err = sq.Insert("upload_jobs").
+		Columns("id", "state", "ip", "size", "strip", "created", "expires").
+		Values(id, UploadQueued, ip, size, strip, now,
+			now.Add(uploadJobTimeout)).
+		Exec()
+	return
+}
+
+// GetUploadJob retrieves an upload job by ID
+func GetUploadJob(id string) (j UploadJob, err error) {
+	err = sq.Select("id", "state", "ip", "size", "strip", "created", "token",
+		"error", "code").
+		From("upload_jobs").
+		Where("id = ?", id).
+		QueryRow().
+		Scan(&j.ID, &j.State, &j.IP, &j.Size, &j.Strip, &j.Created, &j.Token,
+			&j.Error, &j.Code)
+	return
+}
+
+// GetUnfinishedUploadJobs retrieves all queued or processing upload jobs in
+// the order they were created
+func GetUnfinishedUploadJobs() (jobs []UploadJob, err error) {
+	err = queryAll(
+		sq.Select("id", "state", "ip", "size", "strip", "created").
+			From("upload_jobs").
+			Where("state in (?, ?)", UploadQueued, UploadProcessing).
+			OrderBy("created"),
+		func(r *sql.Rows) (err error) {
+			var j UploadJob
+			err = r.Scan(&j.ID, &j.State, &j.IP, &j.Size, &j.Strip,
+				&j.Created)
+			if err != nil {
+				return
+			}
+			jobs = append(jobs, j)
+			return
+		},
+	)
+	return
+}
+
+// UploadJobPosition returns the number of queued upload jobs, that were
+// created before the job
+func UploadJobPosition(j UploadJob) (pos int, err error) {
+	err = sq.Select("count(*)").
+		From("upload_jobs").
+		Where("state = ? and created < ?", UploadQueued, j.Created).
+		QueryRow().
+		Scan(&pos)
+	return
+}
+
+// StartUploadJob marks an upload job as being processed
+func StartUploadJob(id string) (err error) {
+	_, err = sq.Update("upload_jobs").
+		Set("state", UploadProcessing).
+		Where("id = ?", id).
+		Exec()
+	return
+}
+
+// FinishUploadJob records the result of a processed upload job and notifies
+// listeners. Finished jobs expire together with their image allocation token.
+func FinishUploadJob(id, token, msg string, code int) (err error) {
+	state := UploadDone
+	if msg != "" {
+		state = UploadFailed
+	}
+	_, err = sq.Update("upload_jobs").
+		SetMap(map[string]interface{}{
+			"state":   state,
+			"token":   token,
+			"error":   msg,
+			"code":    code,
+			"expires": time.Now().Add(tokenTimeout).UTC(),
+		}).
+		Where("id = ?", id).
+		Exec()
+	if err != nil {
+		return
+	}
+	return Notify("upload_job_finished", id)
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestUploadJobs(t *testing.T) {
+	assertTableClear(t, "upload_jobs")
+
+	var ids [3]string
+	for i := range ids {
+		var err error
+		ids[i], err = InsertUploadJob("::1", 1<<20, i == 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertExec(t, `update upload_jobs set created = $1 where id = $2`,
+			time.Now().Add(time.Duration(i-3)*time.Minute).UTC(), ids[i])
+	}
+
+	err := StartUploadJob(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = FinishUploadJob(ids[1], "", "invalid image", 400)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("queued", func(t *testing.T) {
+		j, err := GetUploadJob(ids[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, j.Status(), common.UploadStatus{
+			ID:    ids[2],
+			State: UploadQueued,
+		})
+		AssertEquals(t, j.IP, "::1")
+		AssertEquals(t, j.Size, 1<<20)
+		AssertEquals(t, j.Strip, false)
+
+		// The processing job is not counted
+		pos, err := UploadJobPosition(j)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, pos, 0)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		j, err := GetUploadJob(ids[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, j.Status(), common.UploadStatus{
+			ID:    ids[1],
+			State: UploadFailed,
+			Error: "invalid image",
+			Code:  400,
+		})
+	})
+
+	t.Run("unfinished", func(t *testing.T) {
+		jobs, err := GetUnfinishedUploadJobs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, len(jobs), 2)
+		AssertEquals(t, jobs[0].ID, ids[0])
+		AssertEquals(t, jobs[0].State, UploadProcessing)
+		AssertEquals(t, jobs[0].Strip, true)
+		AssertEquals(t, jobs[1].ID, ids[2])
+	})
+
+	t.Run("done", func(t *testing.T) {
+		const token = "foo"
+		err := FinishUploadJob(ids[0], token, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		j, err := GetUploadJob(ids[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, j.State, UploadDone)
+		AssertEquals(t, j.Token, token)
+	})
+}
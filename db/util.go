@@ -19,11 +19,24 @@ type rowScanner interface {
 }
 
 // InTransaction runs a function inside a transaction and handles comminting and rollback on error.
-// readOnly: the DBMS can optimise read-only transactions for better concurrency
+// readOnly: the DBMS can optimise read-only transactions for better concurrency.
+// Read-only transactions are run on a read replica, if any are configured.
 //
 // TODO: Get rid off readOnly param, once reader ported to output JSON
 func InTransaction(readOnly bool, fn func(*sql.Tx) error) (err error) {
-	tx, err := db.BeginTx(context.Background(), &sql.TxOptions{
+	if readOnly {
+		return withReader(0, "", func(r reader) error {
+			return inTransaction(r.db, true, fn)
+		})
+	}
+	return inTransaction(db, false, fn)
+}
+
+// Like InTransaction, but runs on a specific database connection
+func inTransaction(conn *sql.DB, readOnly bool, fn func(*sql.Tx) error) (
+	err error,
+) {
+	tx, err := conn.BeginTx(context.Background(), &sql.TxOptions{
 		ReadOnly: readOnly,
 	})
 	if err != nil {
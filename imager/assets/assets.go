@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
@@ -37,24 +38,24 @@ var (
 	}
 )
 
-// GetFilePaths generates file paths of the source file and its thumbnail
-func GetFilePaths(SHA1 string, fileType, thumbType uint8) (paths [2]string) {
-	paths[0] = util.ConcatStrings(
-		"/images/src/",
-		SHA1,
-		".",
-		common.Extensions[fileType],
-	)
-	paths[1] = util.ConcatStrings(
-		"/images/thumb/",
+// GetKeys generates the store keys of the source file and its thumbnail
+func GetKeys(SHA1 string, fileType, thumbType uint8) (keys [2]string) {
+	keys[0] = util.ConcatStrings("src/", SHA1, ".", common.Extensions[fileType])
+	keys[1] = util.ConcatStrings(
+		"thumb/",
 		SHA1,
 		".",
 		common.Extensions[thumbType],
 	)
-	for i := range paths {
-		paths[i] = filepath.FromSlash(paths[i][1:])
-	}
+	return
+}
 
+// GetFilePaths generates file paths of the source file and its thumbnail,
+// when stored on the local file system
+func GetFilePaths(SHA1 string, fileType, thumbType uint8) (paths [2]string) {
+	for i, k := range GetKeys(SHA1, fileType, thumbType) {
+		paths[i] = filepath.Join("images", filepath.FromSlash(k))
+	}
 	return
 }
 
@@ -91,6 +92,9 @@ func ImageSearchPath(img common.ImageCommon) string {
 	return RelativeThumbPath(img.ThumbType, img.SHA1)
 }
 
+// Returns the root of all image links. Set ImageRootOverride to the public
+// address of the image store's bucket or a CDN in front of it to make clients
+// fetch images directly from there.
 func imageRoot() string {
 	r := config.Get().ImageRootOverride
 	if r != "" {
@@ -99,6 +103,16 @@ func imageRoot() string {
 	return "/assets/images"
 }
 
+// OverrideURL returns the URL of a store key under ImageRootOverride or an
+// empty string, if no override is set
+func OverrideURL(key string) string {
+	r := config.Get().ImageRootOverride
+	if r == "" {
+		return ""
+	}
+	return util.ConcatStrings(strings.TrimSuffix(r, "/"), "/", key)
+}
+
 // ThumbPath returns the path to the thumbnail of an image
 func ThumbPath(thumbType uint8, SHA1 string) string {
 	return util.ConcatStrings(
@@ -121,12 +135,15 @@ func SourcePath(fileType uint8, SHA1 string) string {
 	)
 }
 
-// Write writes file assets to disk
+// Write writes file assets to the image store
 func Write(SHA1 string, fileType, thumbType uint8, src, thumb io.ReadSeeker,
 ) (
 	err error,
 ) {
-	paths := GetFilePaths(SHA1, fileType, thumbType)
+	var (
+		keys = GetKeys(SHA1, fileType, thumbType)
+		s    = GetStore()
+	)
 
 	// Write files in parallel
 	ch := make(chan error)
@@ -134,55 +151,40 @@ func Write(SHA1 string, fileType, thumbType uint8, src, thumb io.ReadSeeker,
 		if thumb == nil {
 			ch <- nil
 		} else {
-			ch <- writeFile(paths[1], thumb)
+			ch <- s.Write(keys[1], thumb)
 		}
 	}()
 
-	for _, err := range [...]error{writeFile(paths[0], src), <-ch} {
-		switch {
-		// Ignore files already written and not cleaned up for some reason
-		case err == nil, os.IsExist(err):
-		default:
+	for _, err := range [...]error{s.Write(keys[0], src), <-ch} {
+		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// Write a single file to disk with the appropriate permissions and flags
-func writeFile(path string, src io.ReadSeeker) (err error) {
-	file, err := os.Create(path)
-	if err != nil {
-		return
-	}
-	defer file.Close()
-
-	_, err = src.Seek(0, 0)
-	if err != nil {
-		return
-	}
-	_, err = io.Copy(file, src)
-	return
-}
-
 // Delete deletes file assets belonging to a single upload
 func Delete(SHA1 string, fileType, thumbType uint8) error {
-	for _, path := range GetFilePaths(SHA1, fileType, thumbType) {
-		// Ignore somehow absent images
-		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+	s := GetStore()
+	for _, key := range GetKeys(SHA1, fileType, thumbType) {
+		if err := s.Delete(key); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// CreateDirs creates directories for processed image storage
+// DeleteSource deletes only the source file of an upload and keeps its
+// thumbnail
+func DeleteSource(SHA1 string, fileType uint8) error {
+	return GetStore().Delete(GetKeys(SHA1, fileType, fileType)[0])
+}
+
+// CreateDirs creates directories for processed image storage, if images are
+// stored on the local file system
 func CreateDirs() error {
-	for _, dir := range [...]string{"src", "thumb"} {
-		path := filepath.Join("images", dir)
-		if err := os.MkdirAll(path, 0700); err != nil {
-			return err
-		}
+	if s, ok := GetStore().(*LocalStore); ok {
+		return s.createDirs()
 	}
 	return nil
 }
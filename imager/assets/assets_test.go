@@ -18,10 +18,13 @@ func TestMain(m *testing.M) {
 	if err := CreateDirs(); err != nil {
 		panic(err)
 	}
-	defer DeleteDirs()
 
 	code := m.Run()
 
+	// Deferred calls do not run on os.Exit
+	if err := DeleteDirs(); err != nil {
+		panic(err)
+	}
 	os.Exit(code)
 }
 
@@ -131,3 +134,20 @@ func TestWriteAssets(t *testing.T) {
 		AssertFileEquals(t, path, std[i])
 	}
 }
+
+func TestOverrideURL(t *testing.T) {
+	old := *config.Get()
+	t.Cleanup(func() {
+		config.Set(old)
+	})
+
+	AssertEquals(t, OverrideURL("src/foo.jpg"), "")
+	AssertEquals(t, SourcePath(common.JPEG, "foo"),
+		"/assets/images/src/foo.jpg")
+
+	conf := old
+	conf.ImageRootOverride = "https://cdn.example.com/images/"
+	config.Set(conf)
+	AssertEquals(t, OverrideURL("src/foo.jpg"),
+		"https://cdn.example.com/images/src/foo.jpg")
+}
@@ -0,0 +1,345 @@
+package assets
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3TimeFormat      = "20060102T150405Z"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// Default validity period of signed download URLs
+	defaultS3URLExpiry = time.Hour
+)
+
+// S3Config configures an S3-compatible object storage backend for image files
+type S3Config struct {
+	// Address bucket as a path on the endpoint host instead of a subdomain.
+	// Required by most self-hosted S3-compatible servers.
+	PathStyle bool
+
+	// Validity period of signed download URLs in seconds. Defaults to one
+	// hour.
+	URLExpiry uint
+
+	// Endpoint URL like "https://s3.eu-central-1.amazonaws.com"
+	Endpoint string
+
+	Region, Bucket, AccessKey, SecretKey string
+
+	// Prefixed to all object keys. If set, should end with "/".
+	Prefix string
+}
+
+// S3Store stores image files in an S3-compatible object storage bucket.
+// Requests are authenticated with AWS Signature Version 4.
+type S3Store struct {
+	conf     S3Config
+	endpoint *url.URL
+	expiry   time.Duration
+	client   *http.Client
+
+	// For overriding in tests
+	now func() time.Time
+}
+
+// NewS3Store creates a store for image files in an S3-compatible bucket
+func NewS3Store(conf S3Config) (*S3Store, error) {
+	switch {
+	case conf.Endpoint == "":
+		return nil, errors.New("s3: no endpoint set")
+	case conf.Bucket == "":
+		return nil, errors.New("s3: no bucket set")
+	}
+	if conf.Region == "" {
+		conf.Region = "us-east-1"
+	}
+	u, err := url.Parse(conf.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	s := &S3Store{
+		conf:     conf,
+		endpoint: u,
+		expiry:   time.Duration(conf.URLExpiry) * time.Second,
+		client:   &http.Client{Timeout: time.Minute},
+		now:      time.Now,
+	}
+	if s.expiry == 0 {
+		s.expiry = defaultS3URLExpiry
+	}
+	return s, nil
+}
+
+// Returns the URL of an object
+func (s *S3Store) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	key = s.conf.Prefix + key
+	if s.conf.PathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.conf.Bucket + "/" + key
+	} else {
+		u.Host = s.conf.Bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	}
+	u.RawPath = ""
+	return &u
+}
+
+// Write uploads a file to the bucket
+func (s *S3Store) Write(key string, src io.ReadSeeker) (err error) {
+	h := sha256.New()
+	_, err = src.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	size, err := io.Copy(h, src)
+	if err != nil {
+		return
+	}
+	_, err = src.Seek(0, 0)
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest("PUT", s.objectURL(key).String(),
+		ioutil.NopCloser(src))
+	if err != nil {
+		return
+	}
+	req.ContentLength = size
+	_, err = s.do(req, hex.EncodeToString(h.Sum(nil)))
+	return
+}
+
+// Read downloads a file from the bucket
+func (s *S3Store) Read(key string) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.do(req, emptySHA256)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// Delete removes a file from the bucket
+func (s *S3Store) Delete(key string) (err error) {
+	req, err := http.NewRequest("DELETE", s.objectURL(key).String(), nil)
+	if err != nil {
+		return
+	}
+	_, err = s.do(req, emptySHA256)
+	if os.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
+
+// URL returns a presigned download URL for a file
+func (s *S3Store) URL(key string) (string, error) {
+	u := s.objectURL(key)
+	t := s.now().UTC()
+
+	q := url.Values{}
+	q.Set("X-Amz-Algorithm", s3Algorithm)
+	q.Set("X-Amz-Credential", s.conf.AccessKey+"/"+s.scope(t))
+	q.Set("X-Amz-Date", t.Format(s3TimeFormat))
+	q.Set("X-Amz-Expires", strconv.Itoa(int(s.expiry/time.Second)))
+	q.Set("X-Amz-SignedHeaders", "host")
+
+	sig := s.signature(t, "GET", u, q, http.Header{}, s3UnsignedPayload)
+	u.RawQuery = canonicalQuery(q) + "&X-Amz-Signature=" + sig
+	return u.String(), nil
+}
+
+// Sign and send a request. Non-2xx responses are returned as errors. 404
+// responses satisfy os.IsNotExist.
+func (s *S3Store) do(req *http.Request, payloadHash string) (
+	res *http.Response, err error,
+) {
+	s.sign(req, payloadHash)
+	res, err = s.client.Do(req)
+	if err != nil {
+		return
+	}
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode == 404 {
+		err = os.ErrNotExist
+	} else {
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1<<10))
+		err = fmt.Errorf("s3: %s %s: %s: %s", req.Method, req.URL.Path,
+			res.Status, msg)
+	}
+	res = nil
+	return
+}
+
+// Add authentication headers to a request
+func (s *S3Store) sign(req *http.Request, payloadHash string) {
+	t := s.now().UTC()
+	req.Header.Set("X-Amz-Date", t.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	h := http.Header{}
+	for _, k := range [...]string{"X-Amz-Date", "X-Amz-Content-Sha256"} {
+		h.Set(k, req.Header.Get(k))
+	}
+	sig := s.signature(t, req.Method, req.URL, req.URL.Query(), h,
+		payloadHash)
+	req.Header.Set(
+		"Authorization",
+		fmt.Sprintf(
+			"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+			s3Algorithm, s.conf.AccessKey, s.scope(t), signedHeaders(h), sig,
+		),
+	)
+}
+
+// Returns the credential scope of a request made at t
+func (s *S3Store) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + s.conf.Region + "/s3/aws4_request"
+}
+
+// Compute the request signature. The host header is always signed and must
+// not be included in h.
+func (s *S3Store) signature(t time.Time, method string, u *url.URL,
+	q url.Values, h http.Header, payloadHash string,
+) string {
+	h = cloneHeader(h)
+	h.Set("Host", u.Host)
+
+	var canonical bytes.Buffer
+	canonical.WriteString(method)
+	canonical.WriteByte('\n')
+	canonical.WriteString(awsEscape(u.Path, false))
+	canonical.WriteByte('\n')
+	canonical.WriteString(canonicalQuery(q))
+	canonical.WriteByte('\n')
+	for _, k := range sortedHeaderKeys(h) {
+		canonical.WriteString(k)
+		canonical.WriteByte(':')
+		canonical.WriteString(strings.TrimSpace(h.Get(k)))
+		canonical.WriteByte('\n')
+	}
+	canonical.WriteByte('\n')
+	canonical.WriteString(signedHeaders(h))
+	canonical.WriteByte('\n')
+	canonical.WriteString(payloadHash)
+
+	hash := sha256.Sum256(canonical.Bytes())
+	toSign := strings.Join([]string{
+		s3Algorithm,
+		t.Format(s3TimeFormat),
+		s.scope(t),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + s.conf.SecretKey)
+	for _, part := range [...]string{
+		t.Format("20060102"), s.conf.Region, "s3", "aws4_request",
+	} {
+		key = hmacSHA256(key, part)
+	}
+	return hex.EncodeToString(hmacSHA256(key, toSign))
+}
+
+// Hex-encoded SHA256 hash of an empty payload
+var emptySHA256 = func() string {
+	h := sha256.Sum256(nil)
+	return hex.EncodeToString(h[:])
+}()
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func cloneHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h)+1)
+	for k, v := range h {
+		c[k] = v
+	}
+	return c
+}
+
+// Returns lowercase header names in sorted order
+func sortedHeaderKeys(h http.Header) []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, strings.ToLower(k))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func signedHeaders(h http.Header) string {
+	keys := sortedHeaderKeys(h)
+	if _, ok := h["Host"]; !ok {
+		keys = append(keys, "host")
+		sort.Strings(keys)
+	}
+	return strings.Join(keys, ";")
+}
+
+// Encode a query string sorted by key with AWS URI encoding
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vals := append([]string(nil), q[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, awsEscape(k, true)+"="+awsEscape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// URI encode s as required by AWS Signature Version 4. Only unreserved
+// characters are kept. Slashes are escaped, if escapeSlash is true.
+func awsEscape(s string, escapeSlash bool) string {
+	const hex = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~',
+			c == '/' && !escapeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,111 @@
+package assets
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	storeMu sync.RWMutex
+	store   Store = NewLocalStore("images")
+)
+
+// Store persists image source files and thumbnails. Files are addressed by
+// keys of the "src/<SHA1>.<ext>" and "thumb/<SHA1>.<ext>" format, as returned
+// by GetKeys.
+type Store interface {
+	// Write a file to key. Existing files are overwritten.
+	Write(key string, src io.ReadSeeker) error
+
+	// Read opens a file for reading. Returns an error satisfying
+	// os.IsNotExist, if no file exists at key. If the returned reader also
+	// implements io.Seeker, it can be served with range requests.
+	Read(key string) (io.ReadCloser, error)
+
+	// Delete a file. Deleting a non-existent file is not an error.
+	Delete(key string) error
+
+	// URL returns a URL, that clients can be redirected to for downloading the
+	// file, or an empty string, if the store does not support redirects and
+	// files must be served from Read.
+	URL(key string) (string, error)
+}
+
+// SetStore sets the storage backend for image files. Should only be called on
+// server start or in tests.
+func SetStore(s Store) {
+	storeMu.Lock()
+	store = s
+	storeMu.Unlock()
+}
+
+// GetStore returns the current storage backend for image files
+func GetStore() Store {
+	storeMu.RLock()
+	defer storeMu.RUnlock()
+	return store
+}
+
+// LocalStore stores image files on the local file system under a root
+// directory
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore creates a store for image files under the root directory
+func NewLocalStore(root string) *LocalStore {
+	return &LocalStore{root}
+}
+
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+// Write writes a file to disk
+func (s *LocalStore) Write(key string, src io.ReadSeeker) (err error) {
+	file, err := os.Create(s.path(key))
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, err = src.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(file, src)
+	return
+}
+
+// Read opens a file on disk
+func (s *LocalStore) Read(key string) (io.ReadCloser, error) {
+	return os.Open(s.path(key))
+}
+
+// Delete removes a file from disk
+func (s *LocalStore) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// URL always returns an empty string. Local files are served by the server
+// itself.
+func (s *LocalStore) URL(key string) (string, error) {
+	return "", nil
+}
+
+// Create the image storage directories
+func (s *LocalStore) createDirs() error {
+	for _, dir := range [...]string{"src", "thumb"} {
+		err := os.MkdirAll(filepath.Join(s.root, dir), 0700)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package imager
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime/multipart"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/thumbnailer"
+)
+
+const (
+	mimeAVIF = "image/avif"
+	mimeHEIC = "image/heic"
+	mimeJXL  = "image/jxl"
+)
+
+// JPEG XL bare codestream and ISO base media file format container signatures
+var jxlMagics = [...][]byte{
+	{0xFF, 0x0A},
+	[]byte("\x00\x00\x00\x0cJXL \r\n\x87\n"),
+}
+
+// Detect AVIF and HEIC images by the brands of their ISO base media file type
+// box
+func detectHEIF(buf []byte) (mime, ext string) {
+	if len(buf) < 16 || string(buf[4:8]) != "ftyp" {
+		return
+	}
+	size := int(binary.BigEndian.Uint32(buf))
+	if size < 16 || size > len(buf) {
+		return
+	}
+
+	// Major brand, minor version and compatible brands
+	for i := 8; i+4 <= size; i += 4 {
+		if i == 12 {
+			continue
+		}
+		switch string(buf[i : i+4]) {
+		case "avif", "avis":
+			return mimeAVIF, "avif"
+		case "heic", "heix", "heim", "heis", "hevc", "hevx":
+			return mimeHEIC, "heic"
+		}
+	}
+	return
+}
+
+// Detect JPEG XL images
+func detectJXL(buf []byte) (mime, ext string) {
+	for _, m := range jxlMagics {
+		if bytes.HasPrefix(buf, m) {
+			return mimeJXL, "jxl"
+		}
+	}
+	return
+}
+
+// Thumbnail still images in formats the thumbnailer does not process itself
+// by decoding them with FFmpeg. Requires FFmpeg to be built with AV1 and JPEG
+// XL decoding support.
+func processFFImage(rs io.ReadSeeker, src *thumbnailer.Source,
+	opts thumbnailer.Options,
+) (
+	image.Image, error,
+) {
+	c, err := thumbnailer.NewFFContext(rs)
+	if err != nil {
+		return nil, thumbnailer.ErrInvalidImage(err.Error())
+	}
+	defer c.Close()
+
+	src.Dims, err = decodeDims(c, opts.MaxSourceDims)
+	if err != nil {
+		return nil, err
+	}
+	return c.Thumbnail(opts.ThumbDims)
+}
+
+// Read the dimensions of an image and ensure they do not exceed max
+func decodeDims(c *thumbnailer.FFContext, max thumbnailer.Dims,
+) (
+	dims thumbnailer.Dims, err error,
+) {
+	dims, err = c.Dims()
+	switch {
+	case err != nil:
+		err = thumbnailer.ErrInvalidImage(err.Error())
+	case max.Width != 0 && dims.Width > max.Width:
+		err = thumbnailer.ErrTooWide
+	case max.Height != 0 && dims.Height > max.Height:
+		err = thumbnailer.ErrTooTall
+	}
+	return
+}
+
+// Transcode HEIC images to JPEG, as browsers can not display them. Files of
+// other types are returned unchanged.
+func transcodeHEIC(f multipart.File) (multipart.File, error) {
+	head := make([]byte, 4<<10)
+	n, err := f.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if mime, _ := detectHEIF(head[:n]); mime != mimeHEIC {
+		return f, nil
+	}
+
+	_, err = f.Seek(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	c, err := thumbnailer.NewFFContext(f)
+	if err != nil {
+		return nil, common.StatusError{
+			Err:  thumbnailer.ErrInvalidImage(err.Error()),
+			Code: 400,
+		}
+	}
+	defer c.Close()
+
+	conf := config.Get()
+	dims, err := decodeDims(c, thumbnailer.Dims{
+		Width:  uint(conf.MaxWidth),
+		Height: uint(conf.MaxHeight),
+	})
+	if err != nil {
+		return nil, common.StatusError{Err: err, Code: 400}
+	}
+	img, err := c.Thumbnail(dims)
+	if err != nil {
+		return nil, err
+	}
+
+	var w bytes.Buffer
+	err = jpeg.Encode(&w, img, &jpeg.Options{Quality: 90})
+	if err != nil {
+		return nil, err
+	}
+	return memFile{bytes.NewReader(w.Bytes())}, nil
+}
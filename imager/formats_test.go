@@ -0,0 +1,227 @@
+package imager
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/test/test_db"
+	"github.com/bakape/thumbnailer"
+)
+
+// Construct an ISO base media file type box with the passed brands
+func ftypBox(major string, compatible ...string) []byte {
+	buf := appendUint32(nil, uint32(16+len(compatible)*4))
+	buf = append(buf, "ftyp"...)
+	buf = append(buf, major...)
+	buf = appendUint32(buf, 0) // Minor version
+	for _, b := range compatible {
+		buf = append(buf, b...)
+	}
+	return append(buf, "\x00\x00\x00\x08free"...)
+}
+
+func TestDetectHEIF(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name      string
+		in        []byte
+		mime, ext string
+	}{
+		{"avif", ftypBox("avif", "mif1", "miaf"), mimeAVIF, "avif"},
+		{"avif sequence", ftypBox("avis", "msf1"), mimeAVIF, "avif"},
+		{"compatible avif", ftypBox("mif1", "miaf", "avif"), mimeAVIF, "avif"},
+		{"heic", ftypBox("heic", "mif1", "heic"), mimeHEIC, "heic"},
+		{"compatible heic", ftypBox("mif1", "heic"), mimeHEIC, "heic"},
+		{"mp4", ftypBox("isom", "iso2", "mp41"), "", ""},
+		{"truncated", ftypBox("avif", "mif1")[:12], "", ""},
+		{"not ftyp", []byte("\x00\x00\x00\x10moovavif\x00\x00\x00\x00"), "", ""},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			mime, ext := detectHEIF(c.in)
+			test.AssertEquals(t, mime, c.mime)
+			test.AssertEquals(t, ext, c.ext)
+		})
+	}
+}
+
+func TestDetectJXL(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name string
+		in   []byte
+		mime string
+	}{
+		{"codestream", []byte{0xFF, 0x0A, 0xFA, 0x1F}, mimeJXL},
+		{"container", []byte("\x00\x00\x00\x0cJXL \r\n\x87\n\x00\x00"), mimeJXL},
+		{"jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0}, ""},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			mime, _ := detectJXL(c.in)
+			test.AssertEquals(t, mime, c.mime)
+		})
+	}
+}
+
+func TestProcessFFImage(t *testing.T) {
+	config.Set(config.Configs{
+		MaxWidth:  2000,
+		MaxHeight: 2000,
+	})
+
+	cases := [...]struct {
+		ext      string
+		fileType uint8
+		dims     [4]uint16
+	}{
+		{"avif", common.AVIF, [4]uint16{320, 240, 150, 112}},
+		{"jxl", common.JXL, [4]uint16{256, 192, 150, 112}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.ext, func(t *testing.T) {
+			t.Parallel()
+
+			var img common.ImageCommon
+			f := test.OpenSample(t, "sample."+c.ext)
+			defer f.Close()
+			thumb, err := processFile(f, &img, thumbnailer.Options{
+				ThumbDims: thumbnailer.Dims{
+					Width:  150,
+					Height: 150,
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertThumbnail(t, thumb)
+			assertDims(t, img.Dims, c.dims)
+			assertFileType(t, img.FileType, c.fileType)
+			assertFileType(t, img.ThumbType, common.WEBP)
+
+			writeSample(t, fmt.Sprintf("thumb_%s.webp", c.ext), thumb)
+		})
+	}
+}
+
+func TestTranscodeHEIC(t *testing.T) {
+	config.Set(config.Configs{})
+
+	f := test.OpenSample(t, "sample.heic")
+	defer f.Close()
+	res, err := transcodeHEIC(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ioutil.ReadAll(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf, format, err := image.DecodeConfig(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, format, "jpeg")
+	test.AssertEquals(t, [2]int{conf.Width, conf.Height}, [2]int{320, 240})
+
+	t.Run("not HEIC", func(t *testing.T) {
+		f := test.OpenSample(t, "sample.avif")
+		defer f.Close()
+		res, err := transcodeHEIC(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != f {
+			t.Fatal("file replaced")
+		}
+	})
+}
+
+func TestProcessRequestModernFormats(t *testing.T) {
+	test_db.ClearTables(t, "images")
+	resetDirs(t)
+	config.Set(config.Configs{
+		Public: config.Public{
+			MaxSize: 10,
+		},
+	})
+
+	// HEIC uploads are stored as the transcoded JPEG
+	heicSHA1 := func(t *testing.T) string {
+		f := test.OpenSample(t, "sample.heic")
+		defer f.Close()
+		res, err := transcodeHEIC(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := ioutil.ReadAll(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := sha1.Sum(buf)
+		return hex.EncodeToString(h[:])
+	}
+
+	cases := [...]struct {
+		ext                 string
+		fileType, thumbType uint8
+		dims                [2]uint16
+	}{
+		{"avif", common.AVIF, common.WEBP, [2]uint16{320, 240}},
+		{"jxl", common.JXL, common.WEBP, [2]uint16{256, 192}},
+		{"heic", common.JPEG, common.WEBP, [2]uint16{320, 240}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.ext, func(t *testing.T) {
+			name := "sample." + c.ext
+			var id string
+			if c.ext == "heic" {
+				id = heicSHA1(t)
+			} else {
+				h := sha1.Sum(test.ReadSample(t, name))
+				id = hex.EncodeToString(h[:])
+			}
+
+			f := test.OpenSample(t, name)
+			defer f.Close()
+			stat, err := f.Stat()
+			if err != nil {
+				t.Fatal(err)
+			}
+			token, err := processRequest(f, int(stat.Size()), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token == "" {
+				t.Fatal("no image token")
+			}
+
+			img := getImageRecord(t, id)
+			assertFileType(t, img.FileType, c.fileType)
+			assertFileType(t, img.ThumbType, c.thumbType)
+			test.AssertEquals(t, [2]uint16{img.Dims[0], img.Dims[1]}, c.dims)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package imager
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
+)
+
+// Directory uploaded files are stored in, until their upload job is processed
+const spoolDir = "uploads"
+
+var errQueueFull = common.StatusError{
+	Err:  errors.New("upload queue full"),
+	Code: 503,
+}
+
+// Init creates the upload spool directory and requeues any upload jobs left
+// unfinished by a previous run of the server
+func Init() (err error) {
+	err = os.MkdirAll(spoolDir, 0700)
+	if err != nil {
+		return
+	}
+
+	jobs, err := db.GetUnfinishedUploadJobs()
+	if err != nil {
+		return
+	}
+	var (
+		requeue = make([]jobRequest, 0, len(jobs))
+		spooled = make(map[string]bool, len(jobs))
+	)
+	for _, j := range jobs {
+		// Jobs without a spool file belong to a different process
+		_, err := os.Stat(spoolPath(j.ID))
+		if err != nil {
+			continue
+		}
+		spooled[j.ID] = true
+		requeue = append(requeue, jobRequest{
+			size:  j.Size,
+			strip: j.Strip,
+			job:   j.ID,
+		})
+	}
+
+	// Remove files of jobs, that have expired in the meantime
+	files, err := ioutil.ReadDir(spoolDir)
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if !spooled[f.Name()] {
+			err = os.Remove(filepath.Join(spoolDir, f.Name()))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	// Queue jobs in order of creation, without blocking server start
+	go func() {
+		for _, req := range requeue {
+			enqueue(req, true)
+		}
+	}()
+	return
+}
+
+func spoolPath(job string) string {
+	return filepath.Join(spoolDir, job)
+}
+
+// NewAsyncImageUpload handles the clients' image (or other file) upload
+// request by queuing the file for processing and immediately responding with
+// the ID of the created upload job. The status of the job can then be queried
+// through ServeUploadJob or is pushed to the client through websockets on
+// completion.
+func NewAsyncImageUpload(w http.ResponseWriter, r *http.Request) {
+	var id string
+	err := func() (err error) {
+		err = validateUploader(r)
+		if err != nil {
+			return
+		}
+
+		// Limit data received to the maximum uploaded file size limit
+		r.Body = http.MaxBytesReader(w, r.Body, int64(config.Get().MaxSize<<20))
+
+		id, err = queueUpload(r)
+		if err != nil {
+			return
+		}
+		return incrementSpamScore(r)
+	}()
+	if err != nil {
+		LogError(w, r, err)
+	}
+
+	w.Write([]byte(id))
+}
+
+// Parse the upload form, store the file in the spool directory and queue it
+// for processing. Returns the ID of the created upload job.
+func queueUpload(req *http.Request) (id string, err error) {
+	ip, err := auth.GetIP(req)
+	if err != nil {
+		return
+	}
+	file, size, err := parseUploadForm(req)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	strip := shouldStrip(req.Form.Get("board"))
+	id, err = db.InsertUploadJob(ip, size, strip)
+	if err != nil {
+		return
+	}
+	err = spoolFile(id, file)
+	if err == nil && !enqueue(jobRequest{size: size, strip: strip, job: id},
+		false) {
+		err = errQueueFull
+	}
+	if err != nil {
+		os.Remove(spoolPath(id))
+		finishJob(id, "", err)
+		return "", err
+	}
+	return
+}
+
+// Copy an uploaded file to the spool directory
+func spoolFile(job string, src io.ReadSeeker) (err error) {
+	_, err = src.Seek(0, 0)
+	if err != nil {
+		return
+	}
+	dst, err := os.Create(spoolPath(job))
+	if err != nil {
+		return
+	}
+	defer dst.Close()
+	_, err = io.Copy(dst, src)
+	return
+}
+
+// Process a queued upload job and record its result
+func processJob(req jobRequest) {
+	path := spoolPath(req.job)
+	defer os.Remove(path)
+
+	token, err := func() (token string, err error) {
+		err = db.StartUploadJob(req.job)
+		if err != nil {
+			return
+		}
+		req.file, err = os.Open(path)
+		if err != nil {
+			return
+		}
+		defer req.file.Close()
+		return processRequest(req.file, req.size, req.strip)
+	}()
+	finishJob(req.job, token, err)
+}
+
+// Record the result of an upload job. Errors are logged, as there is no
+// client to pass them to.
+func finishJob(job, token string, err error) {
+	var (
+		msg  string
+		code int
+	)
+	if err != nil {
+		msg = err.Error()
+		code = 500
+		if err, ok := err.(common.StatusError); ok {
+			code = err.Code
+		}
+		if code >= 500 && !common.CanIgnoreClientError(err) {
+			log.Errorf("upload job %s: %s: %#v", job, err, err)
+		}
+	}
+
+	err = db.FinishUploadJob(job, token, msg, code)
+	if err != nil {
+		log.Errorf("upload job %s: recording result: %s", job, err)
+	}
+}
@@ -0,0 +1,107 @@
+package imager
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/test"
+	"github.com/bakape/meguca/test/test_db"
+)
+
+// Wait for an upload job to be processed
+func awaitJob(t *testing.T, id string) db.UploadJob {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		j, err := db.GetUploadJob(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch j.State {
+		case db.UploadDone, db.UploadFailed:
+			return j
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	t.Fatal("upload job timed out")
+	return db.UploadJob{}
+}
+
+func TestAsyncUpload(t *testing.T) {
+	test_db.ClearTables(t, "images", "upload_jobs")
+	resetDirs(t)
+	config.Set(config.Configs{
+		Public: config.Public{
+			MaxSize: 10,
+		},
+	})
+
+	id, err := queueUpload(newJPEGRequest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j := awaitJob(t, id)
+	test.AssertEquals(t, j.State, db.UploadDone)
+	if j.Token == "" {
+		t.Fatal("no image token")
+	}
+	img := getImageRecord(t, assets.StdJPEG.SHA1)
+	test.AssertEquals(t, img.Size, assets.StdJPEG.Size)
+
+	// Spool file must be removed after processing
+	_, err = os.Stat(spoolPath(id))
+	if !os.IsNotExist(err) {
+		t.Fatalf("spool file not removed: %v", err)
+	}
+}
+
+func TestRequeueUploadJobs(t *testing.T) {
+	test_db.ClearTables(t, "images", "upload_jobs")
+	resetDirs(t)
+	config.Set(config.Configs{
+		Public: config.Public{
+			MaxSize: 10,
+		},
+	})
+
+	// Job left over from a previous run
+	id, err := db.InsertUploadJob("::1", assets.StdJPEG.Size, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(filepath.Join("testdata", assets.StdJPEG.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	err = spoolFile(id, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stale file without a job
+	stale := spoolPath("stale")
+	err = ioutil.WriteFile(stale, []byte("foo"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Init()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = os.Stat(stale)
+	if !os.IsNotExist(err) {
+		t.Fatalf("stale spool file not removed: %v", err)
+	}
+
+	j := awaitJob(t, id)
+	test.AssertEquals(t, j.State, db.UploadDone)
+}
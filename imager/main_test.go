@@ -16,9 +16,16 @@ func TestMain(m *testing.M) {
 	}
 
 	config.Set(config.Configs{})
+	// Board, that keeps metadata of uploaded files
+	if _, err := config.SetBoardConfigs(config.BoardConfigs{ID: "a"}); err != nil {
+		panic(err)
+	}
 	if err := assets.CreateDirs(); err != nil {
 		panic(err)
 	}
+	if err := Init(); err != nil {
+		panic(err)
+	}
 
 	code := m.Run()
 	err = close()
@@ -29,6 +36,10 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
+	err = os.RemoveAll(spoolDir)
+	if err != nil {
+		panic(err)
+	}
 	os.Exit(code)
 }
 
@@ -0,0 +1,363 @@
+package imager
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+
+	"github.com/bakape/meguca/common"
+)
+
+// EXIF tag of the image orientation
+const exifOrientationTag = 0x0112
+
+var (
+	errInvalidMetadata = common.StatusError{
+		Err:  errors.New("invalid image metadata"),
+		Code: 400,
+	}
+
+	jpegMagic  = []byte{0xFF, 0xD8}
+	jpegEOI    = []byte{0xFF, 0xD9}
+	pngMagic   = []byte("\x89PNG\r\n\x1a\n")
+	exifHeader = []byte("Exif\x00\x00")
+)
+
+// In-memory file, that can be passed to the thumbnailer and stored the same
+// way as an uploaded one
+type memFile struct {
+	*bytes.Reader
+}
+
+func (memFile) Close() error {
+	return nil
+}
+
+// Strip privacy-sensitive metadata, like GPS coordinates and camera serials,
+// from a JPEG, PNG or WEBP file. Other files are returned unchanged.
+func stripFile(f multipart.File) (multipart.File, error) {
+	_, err := f.Seek(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	stripped, _, err := stripMetadata(buf)
+	if err != nil {
+		return nil, err
+	}
+	return memFile{bytes.NewReader(stripped)}, nil
+}
+
+// Read the EXIF orientation of a JPEG, PNG or WEBP file. Returns 0, if the
+// file has none or can not be parsed.
+func readOrientation(rs io.ReadSeeker) int {
+	_, err := rs.Seek(0, 0)
+	if err != nil {
+		return 0
+	}
+	buf, err := ioutil.ReadAll(rs)
+	if err != nil {
+		return 0
+	}
+	_, o, err := stripMetadata(buf)
+	if err != nil {
+		return 0
+	}
+	return o
+}
+
+// Remove all metadata from a JPEG, PNG or WEBP file, except for what is
+// needed to display the image correctly. The EXIF orientation is preserved
+// in a newly written minimal EXIF block and also returned. Files of other
+// types are returned unchanged.
+func stripMetadata(buf []byte) (stripped []byte, orientation int, err error) {
+	switch {
+	case bytes.HasPrefix(buf, jpegMagic):
+		return stripJPEG(buf)
+	case bytes.HasPrefix(buf, pngMagic):
+		return stripPNG(buf)
+	case len(buf) >= 12 && string(buf[:4]) == "RIFF" &&
+		string(buf[8:12]) == "WEBP":
+		return stripWEBP(buf)
+	default:
+		return buf, 0, nil
+	}
+}
+
+// Drop all APPn segments, except for JFIF, ICC profiles and Adobe color
+// transforms, comments and any data trailing the image
+func stripJPEG(buf []byte) (stripped []byte, orientation int, err error) {
+	body := make([]byte, 0, len(buf))
+	i := len(jpegMagic)
+	for {
+		if i+2 > len(buf) || buf[i] != 0xFF {
+			return nil, 0, errInvalidMetadata
+		}
+		marker := buf[i+1]
+		switch {
+		case marker == 0xFF: // Fill byte
+			i++
+			continue
+		case marker == 0x01, marker >= 0xD0 && marker <= 0xD7:
+			// Markers without a length
+			body = append(body, buf[i:i+2]...)
+			i += 2
+			continue
+		case marker == 0xDA: // Start of scan. Copy the rest till end of image.
+			end := bytes.Index(buf[i:], jpegEOI)
+			if end == -1 {
+				return nil, 0, errInvalidMetadata
+			}
+			body = append(body, buf[i:i+end+len(jpegEOI)]...)
+
+			stripped = make([]byte, 0, len(body)+64)
+			stripped = append(stripped, jpegMagic...)
+			if orientation > 1 {
+				exif := append(append([]byte{}, exifHeader...),
+					encodeOrientation(orientation)...)
+				stripped = append(stripped, 0xFF, 0xE1)
+				stripped = appendUint16(stripped, uint16(len(exif)+2))
+				stripped = append(stripped, exif...)
+			}
+			return append(stripped, body...), orientation, nil
+		}
+
+		if i+4 > len(buf) {
+			return nil, 0, errInvalidMetadata
+		}
+		l := int(binary.BigEndian.Uint16(buf[i+2:]))
+		end := i + 2 + l
+		if l < 2 || end > len(buf) {
+			return nil, 0, errInvalidMetadata
+		}
+		switch {
+		case marker == 0xE1: // EXIF or XMP
+			data := buf[i+4 : end]
+			if bytes.HasPrefix(data, exifHeader) {
+				if o := parseOrientation(data[len(exifHeader):]); o != 0 {
+					orientation = o
+				}
+			}
+		case marker == 0xFE, // Comment
+			marker >= 0xE3 && marker <= 0xED,
+			marker == 0xEF:
+		default:
+			body = append(body, buf[i:end]...)
+		}
+		i = end
+	}
+}
+
+// Drop all textual, time and EXIF chunks and any data trailing the image
+func stripPNG(buf []byte) (stripped []byte, orientation int, err error) {
+	stripped = make([]byte, 0, len(buf))
+	stripped = append(stripped, pngMagic...)
+	for i := len(pngMagic); ; {
+		if i+12 > len(buf) {
+			return nil, 0, errInvalidMetadata
+		}
+		l := int(binary.BigEndian.Uint32(buf[i:]))
+		end := i + 12 + l
+		if l < 0 || end > len(buf) {
+			return nil, 0, errInvalidMetadata
+		}
+		typ := string(buf[i+4 : i+8])
+		switch typ {
+		case "eXIf":
+			if o := parseOrientation(buf[i+8 : end-4]); o != 0 {
+				orientation = o
+			}
+		case "tEXt", "zTXt", "iTXt", "tIME":
+		case "IEND":
+			stripped = append(stripped, buf[i:end]...)
+			if orientation > 1 {
+				stripped = insertPNGOrientation(stripped, orientation)
+			}
+			return stripped, orientation, nil
+		default:
+			stripped = append(stripped, buf[i:end]...)
+		}
+		i = end
+	}
+}
+
+// Insert an eXIf chunk with the orientation after the IHDR chunk of a
+// stripped PNG
+func insertPNGOrientation(buf []byte, orientation int) []byte {
+	data := encodeOrientation(orientation)
+	chunk := make([]byte, 0, 12+len(data))
+	chunk = appendUint32(chunk, uint32(len(data)))
+	chunk = append(chunk, "eXIf"...)
+	chunk = append(chunk, data...)
+	chunk = appendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	// IHDR is always the first chunk and has a fixed length
+	at := len(pngMagic) + 12 + 13
+	out := make([]byte, 0, len(buf)+len(chunk))
+	out = append(out, buf[:at]...)
+	out = append(out, chunk...)
+	return append(out, buf[at:]...)
+}
+
+// Drop the EXIF and XMP chunks and any data trailing the RIFF container
+func stripWEBP(buf []byte) (stripped []byte, orientation int, err error) {
+	size := int(binary.LittleEndian.Uint32(buf[4:])) + 8
+	if size > len(buf) || size < 12 {
+		return nil, 0, errInvalidMetadata
+	}
+	buf = buf[:size]
+
+	stripped = make([]byte, 0, len(buf))
+	stripped = append(stripped, buf[:12]...)
+	vp8x := -1 // Offset of the extended format header, if any
+	for i := 12; i < len(buf); {
+		if i+8 > len(buf) {
+			return nil, 0, errInvalidMetadata
+		}
+		l := int(binary.LittleEndian.Uint32(buf[i+4:]))
+		end := i + 8 + l + l&1 // Chunks are padded to even length
+		if l < 0 || end > len(buf) {
+			return nil, 0, errInvalidMetadata
+		}
+		switch string(buf[i : i+4]) {
+		case "EXIF":
+			data := bytes.TrimPrefix(buf[i+8:i+8+l], exifHeader)
+			if o := parseOrientation(data); o != 0 {
+				orientation = o
+			}
+		case "XMP ":
+		case "VP8X":
+			vp8x = len(stripped)
+			fallthrough
+		default:
+			stripped = append(stripped, buf[i:end]...)
+		}
+		i = end
+	}
+
+	// EXIF and XMP chunks are only valid in the extended format
+	if vp8x != -1 && vp8x+9 <= len(stripped) {
+		const exifFlag, xmpFlag = 1 << 3, 1 << 2
+		flags := stripped[vp8x+8] &^ (exifFlag | xmpFlag)
+		if orientation > 1 {
+			flags |= exifFlag
+			data := encodeOrientation(orientation)
+			stripped = append(stripped, "EXIF"...)
+			stripped = appendUint32LE(stripped, uint32(len(data)))
+			stripped = append(stripped, data...)
+		}
+		stripped[vp8x+8] = flags
+	}
+	binary.LittleEndian.PutUint32(stripped[4:], uint32(len(stripped)-8))
+	return stripped, orientation, nil
+}
+
+// Parse the orientation from the first IFD of a TIFF-structured EXIF block.
+// Returns 0, if none or invalid.
+func parseOrientation(b []byte) int {
+	if len(b) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(b[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	off := int(order.Uint32(b[4:]))
+	if off < 8 || off+2 > len(b) {
+		return 0
+	}
+	n := int(order.Uint16(b[off:]))
+	for i := 0; i < n; i++ {
+		e := off + 2 + i*12
+		if e+12 > len(b) {
+			return 0
+		}
+		if order.Uint16(b[e:]) == exifOrientationTag {
+			// SHORT values are left-aligned in the value field
+			o := int(order.Uint16(b[e+8:]))
+			if o < 1 || o > 8 {
+				return 0
+			}
+			return o
+		}
+	}
+	return 0
+}
+
+// Encode a minimal TIFF-structured EXIF block containing only the
+// orientation
+func encodeOrientation(orientation int) []byte {
+	b := make([]byte, 0, 26)
+	b = append(b, "MM\x00\x2a"...)
+	b = appendUint32(b, 8) // Offset of the first IFD
+	b = appendUint16(b, 1) // Number of entries
+	b = appendUint16(b, exifOrientationTag)
+	b = appendUint16(b, 3) // SHORT
+	b = appendUint32(b, 1) // Value count
+	b = appendUint16(b, uint16(orientation))
+	b = appendUint16(b, 0)    // Value padding
+	return appendUint32(b, 0) // No next IFD
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint32LE(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+// Rotate and flip an image according to its EXIF orientation
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 { // Transposed
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // Mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // Rotated 180°
+				dx, dy = w-1-x, h-1-y
+			case 4: // Mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // Transposed
+				dx, dy = y, x
+			case 6: // Rotated 90° clockwise
+				dx, dy = h-1-y, x
+			case 7: // Transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // Rotated 90° counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+	return dst
+}
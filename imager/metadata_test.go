@@ -0,0 +1,180 @@
+package imager
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/bakape/meguca/test"
+)
+
+// Return the markers of all JPEG segments before the start of scan
+func jpegMarkers(t *testing.T, buf []byte) (markers []byte) {
+	t.Helper()
+	for i := 2; buf[i+1] != 0xDA; {
+		markers = append(markers, buf[i+1])
+		i += 2 + int(binary.BigEndian.Uint16(buf[i+2:]))
+	}
+	return
+}
+
+// Insert a JPEG segment right after the start of image
+func insertJPEGSegment(buf []byte, marker byte, data []byte) []byte {
+	seg := []byte{0xFF, marker}
+	seg = appendUint16(seg, uint16(len(data)+2))
+	seg = append(seg, data...)
+	return append(append(append([]byte{}, buf[:2]...), seg...), buf[2:]...)
+}
+
+func assertStripIdempotent(t *testing.T, buf []byte) {
+	t.Helper()
+	again, _, err := stripMetadata(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertBufferEquals(t, again, buf)
+}
+
+func TestStripJPEG(t *testing.T) {
+	src := test.ReadSample(t, "sample.jpg")
+
+	t.Run("sample", func(t *testing.T) {
+		buf, o, err := stripMetadata(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, o, 0)
+		for _, m := range jpegMarkers(t, buf) {
+			if m == 0xE1 || m == 0xED {
+				t.Fatalf("metadata segment not stripped: %x", m)
+			}
+		}
+		if len(buf) >= len(src) {
+			t.Fatal("file not reduced in size")
+		}
+		if _, err := jpeg.Decode(bytes.NewReader(buf)); err != nil {
+			t.Fatal(err)
+		}
+		assertStripIdempotent(t, buf)
+	})
+
+	t.Run("with orientation", func(t *testing.T) {
+		buf := insertJPEGSegment(src, 0xFE, []byte("Nikon D750 serial"))
+		buf = insertJPEGSegment(buf, 0xE1,
+			append(append([]byte{}, exifHeader...), encodeOrientation(6)...))
+		buf = append(buf, "trailing data"...)
+
+		buf, o, err := stripMetadata(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEquals(t, o, 6)
+		if bytes.Contains(buf, []byte("Nikon")) {
+			t.Fatal("comment not stripped")
+		}
+		if !bytes.HasSuffix(buf, jpegEOI) {
+			t.Fatal("trailing data not stripped")
+		}
+		test.AssertEquals(t, readOrientation(bytes.NewReader(buf)), 6)
+		assertStripIdempotent(t, buf)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, _, err := stripMetadata(src[:100])
+		test.AssertEquals(t, err, errInvalidMetadata)
+	})
+}
+
+func TestStripPNG(t *testing.T) {
+	src := test.ReadSample(t, "sample.png")
+
+	buf, o, err := stripMetadata(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, o, 0)
+	if bytes.Contains(buf, []byte("tEXt")) {
+		t.Fatal("text chunk not stripped")
+	}
+	if _, err := png.Decode(bytes.NewReader(buf)); err != nil {
+		t.Fatal(err)
+	}
+	assertStripIdempotent(t, buf)
+
+	t.Run("with orientation", func(t *testing.T) {
+		buf := insertPNGOrientation(buf, 8)
+		test.AssertEquals(t, readOrientation(bytes.NewReader(buf)), 8)
+		if _, err := png.Decode(bytes.NewReader(buf)); err != nil {
+			t.Fatal(err)
+		}
+		assertStripIdempotent(t, buf)
+	})
+}
+
+func TestStripWEBP(t *testing.T) {
+	src := test.ReadSample(t, "sample.webp")
+
+	// Append an XMP chunk and set the corresponding VP8X flag
+	buf := append([]byte{}, src...)
+	buf[20] |= 1 << 2
+	buf = append(buf, "XMP "...)
+	buf = appendUint32LE(buf, 4)
+	buf = append(buf, "<x/>"...)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(buf)-8))
+
+	buf, o, err := stripMetadata(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, o, 0)
+	test.AssertBufferEquals(t, buf, src)
+}
+
+func TestStripOtherFiles(t *testing.T) {
+	src := test.ReadSample(t, "sample.gif")
+	buf, o, err := stripMetadata(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, o, 0)
+	test.AssertBufferEquals(t, buf, src)
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 3x2 image with a distinct top left pixel
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	red := color.RGBA{255, 0, 0, 255}
+	src.Set(0, 0, red)
+
+	cases := [...]struct {
+		orientation int
+		w, h        int
+		x, y        int // Position of the top left source pixel
+	}{
+		{1, 3, 2, 0, 0},
+		{2, 3, 2, 2, 0},
+		{3, 3, 2, 2, 1},
+		{4, 3, 2, 0, 1},
+		{5, 2, 3, 0, 0},
+		{6, 2, 3, 1, 0},
+		{7, 2, 3, 1, 2},
+		{8, 2, 3, 0, 2},
+	}
+
+	for _, c := range cases {
+		res := applyOrientation(src, c.orientation)
+		b := res.Bounds()
+		if b.Dx() != c.w || b.Dy() != c.h {
+			t.Fatalf("orientation %d: invalid dimensions: %dx%d",
+				c.orientation, b.Dx(), b.Dy())
+		}
+		if res.At(c.x, c.y) != color.Color(red) {
+			t.Fatalf("orientation %d: pixel not at %d,%d",
+				c.orientation, c.x, c.y)
+		}
+	}
+}
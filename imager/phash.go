@@ -0,0 +1,108 @@
+package imager
+
+import (
+	"image"
+	"image/color"
+	_ "image/png" // Older thumbnails
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+// Dimensions of the grid an image is reduced to for perceptual hashing. The
+// extra column yields 8 horizontal luminance differences per row.
+const (
+	hashGridWidth  = 9
+	hashGridHeight = 8
+)
+
+// Compute a 64 bit difference hash (dHash) of an image. Visually similar
+// images produce hashes with a small Hamming distance, even after re-encoding,
+// scaling or minor edits. Returns 0 for images without any luminance
+// gradients, such as a single solid color, as those carry no information.
+func perceptualHash(img image.Image) (hash uint64) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w == 0 || h == 0 {
+		return
+	}
+
+	// Reduce the image to a grid of average luminance values. Each cell spans
+	// at least one pixel, so tiny images are upscaled instead.
+	var grid [hashGridHeight][hashGridWidth]float64
+	for gy := range grid {
+		y0, y1 := cellSpan(gy, hashGridHeight, h)
+		for gx := range grid[gy] {
+			x0, x1 := cellSpan(gx, hashGridWidth, w)
+			var sum float64
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					c := color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y))
+					sum += float64(c.(color.Gray).Y)
+				}
+			}
+			grid[gy][gx] = sum / float64((y1-y0)*(x1-x0))
+		}
+	}
+
+	for _, row := range grid {
+		for x := 0; x < hashGridWidth-1; x++ {
+			hash <<= 1
+			if row[x] < row[x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return
+}
+
+// Return the pixel range [start, end) of cell i out of n along a dimension of
+// the specified length
+func cellSpan(i, n, length int) (start, end int) {
+	start = i * length / n
+	end = (i + 1) * length / n
+	if end <= start {
+		end = start + 1
+	}
+	return
+}
+
+// BlocklistImages permanently deletes all files attached to posts and adds
+// their perceptual hashes to the image blocklist. Missing hashes of images
+// processed before perceptual hashing was added are computed from their
+// thumbnails first, so these are blocklisted as well.
+func BlocklistImages(ids []uint64, by string) (err error) {
+	imgs, err := db.GetUnhashedImages(ids)
+	if err != nil {
+		return
+	}
+	for _, img := range imgs {
+		var hash uint64
+		hash, err = thumbnailHash(img)
+		if err != nil {
+			return
+		}
+		err = db.SetPHash(img.SHA1, hash)
+		if err != nil {
+			return
+		}
+	}
+	return db.BlocklistImages(ids, by)
+}
+
+// Compute the perceptual hash of an image from its stored thumbnail
+func thumbnailHash(img common.ImageCommon) (hash uint64, err error) {
+	key := assets.GetKeys(img.SHA1, img.FileType, img.ThumbType)[1]
+	r, err := assets.GetStore().Read(key)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	thumb, _, err := image.Decode(r)
+	if err != nil {
+		return
+	}
+	return perceptualHash(thumb), nil
+}
@@ -0,0 +1,103 @@
+package imager
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"math/bits"
+	"testing"
+
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/test"
+)
+
+func TestPerceptualHash(t *testing.T) {
+	src, _, err := image.Decode(bytes.NewReader(test.ReadSample(t, "sample.jpg")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := perceptualHash(src)
+	if hash == 0 {
+		t.Fatal("no hash")
+	}
+
+	// Re-encode at a lower quality
+	var w bytes.Buffer
+	err = jpeg.Encode(&w, src, &jpeg.Options{Quality: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reencoded, err := jpeg.Decode(&w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := bits.OnesCount64(hash ^ perceptualHash(reencoded)); d > 4 {
+		t.Fatalf("re-encoded image distance too large: %d", d)
+	}
+
+	// Horizontally mirrored image
+	b := src.Bounds()
+	mirrored := image.NewRGBA(b)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			mirrored.Set(b.Max.X-1-(x-b.Min.X), y, src.At(x, y))
+		}
+	}
+	if d := bits.OnesCount64(hash ^ perceptualHash(mirrored)); d < 16 {
+		t.Fatalf("distinct image distance too small: %d", d)
+	}
+}
+
+func TestPerceptualHashNoInformation(t *testing.T) {
+	cases := [...]struct {
+		name string
+		img  image.Image
+	}{
+		{"empty", image.NewGray(image.Rect(0, 0, 0, 0))},
+		{"solid color", solidImage(color.White)},
+		{"single pixel", image.NewGray(image.Rect(0, 0, 1, 1))},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			test.AssertEquals(t, perceptualHash(c.img), uint64(0))
+		})
+	}
+}
+
+// Create a 16x16 image filled with a single color
+func solidImage(c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	draw.Draw(img, img.Bounds(), image.NewUniform(c), image.ZP, draw.Src)
+	return img
+}
+
+func TestThumbnailHash(t *testing.T) {
+	resetDirs(t)
+
+	thumb := test.ReadSample(t, "sample.webp")
+	std := assets.StdJPEG.ImageCommon
+	err := assets.Write(std.SHA1, std.FileType, std.ThumbType,
+		bytes.NewReader(test.ReadSample(t, "sample.jpg")),
+		bytes.NewReader(thumb))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(thumb))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := thumbnailHash(std)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEquals(t, hash, perceptualHash(src))
+	if hash == 0 {
+		t.Fatal("no hash")
+	}
+}
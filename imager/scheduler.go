@@ -12,9 +12,20 @@ import (
 	"github.com/bakape/meguca/db"
 )
 
+// Files up to this size are processed in the small file queue
+const smallJobSize = 4 << 20
+
 var (
-	scheduleJob      = make(chan jobRequest, 128)
-	scheduleSmallJob = make(chan jobRequest, 128)
+	// JobQueueLength is the maximum number of uploads waiting for processing
+	// in each of the small and large file queues
+	JobQueueLength uint = 128
+
+	// SmallJobWorkers and JobWorkers are the numbers of uploads processed
+	// concurrently from the small and large file queues respectively
+	SmallJobWorkers, JobWorkers uint = 1, 1
+
+	scheduleJob, scheduleSmallJob chan jobRequest
+	startWorkersOnce              sync.Once
 
 	// Pool of temp buffers used for hashing
 	buf512Pool = sync.Pool{
@@ -25,9 +36,14 @@ var (
 )
 
 type jobRequest struct {
-	file multipart.File
-	size int
-	res  chan<- thumbnailingResponse
+	file  multipart.File
+	size  int
+	strip bool
+	// ID of the persisted upload job, if processed asynchronously. The file
+	// is then read from the spool directory and the result written to the
+	// database instead of res.
+	job string
+	res chan<- thumbnailingResponse
 }
 
 type thumbnailingResponse struct {
@@ -35,32 +51,68 @@ type thumbnailingResponse struct {
 	err     error
 }
 
-// Queues upload processing to prevent resource overuse
-func requestThumbnailing(file multipart.File, size int,
+// Queues upload processing to prevent resource overuse.
+// strip specifies, if metadata should be stripped from the file.
+func requestThumbnailing(file multipart.File, size int, strip bool,
 ) <-chan thumbnailingResponse {
+	ch := make(chan thumbnailingResponse)
+	enqueue(jobRequest{
+		file:  file,
+		size:  size,
+		strip: strip,
+		res:   ch,
+	}, true)
+	return ch
+}
+
+// Place a job into the queue matching its file size. If block is false and
+// the queue is full, returns false instead of waiting.
+func enqueue(req jobRequest, block bool) bool {
+	startWorkersOnce.Do(startWorkers)
+
 	// 2 separate queues - one for small and one for bigger files.
 	// Allows for some degree of concurrent thumbnailing without exhausting
 	// server resources.
-	ch := make(chan thumbnailingResponse)
-	req := jobRequest{file, size, ch}
-	if size <= 4<<20 {
-		scheduleSmallJob <- req
-	} else {
-		scheduleJob <- req
+	queue := scheduleJob
+	if req.size <= smallJobSize {
+		queue = scheduleSmallJob
+	}
+	if block {
+		queue <- req
+		return true
+	}
+	select {
+	case queue <- req:
+		return true
+	default:
+		return false
 	}
-	return ch
 }
 
-// Queue thumbnailing jobs to reduce resource contention and prevent OOM
-func init() {
-	for _, ch := range [...]<-chan jobRequest{scheduleJob, scheduleSmallJob} {
-		go func(queue <-chan jobRequest) {
-			for {
-				req := <-queue
-				id, err := processRequest(req.file, req.size)
-				req.res <- thumbnailingResponse{id, err}
-			}
-		}(ch)
+// Start processing queued thumbnailing jobs. A limited number of workers
+// reduces resource contention and prevents OOM.
+func startWorkers() {
+	scheduleJob = make(chan jobRequest, JobQueueLength)
+	scheduleSmallJob = make(chan jobRequest, JobQueueLength)
+	for _, q := range [...]struct {
+		queue   <-chan jobRequest
+		workers uint
+	}{
+		{scheduleJob, JobWorkers},
+		{scheduleSmallJob, SmallJobWorkers},
+	} {
+		for i := uint(0); i < q.workers; i++ {
+			go func(queue <-chan jobRequest) {
+				for req := range queue {
+					if req.job != "" {
+						processJob(req)
+						continue
+					}
+					id, err := processRequest(req.file, req.size, req.strip)
+					req.res <- thumbnailingResponse{id, err}
+				}
+			}(q.queue)
+		}
 	}
 }
 
@@ -97,7 +149,23 @@ func hashFile(rs io.ReadSeeker, h hash.Hash, encode func([]byte) string,
 	}
 }
 
-func processRequest(file multipart.File, size int) (token string, err error) {
+func processRequest(file multipart.File, size int, strip bool,
+) (
+	token string, err error,
+) {
+	file, err = transcodeHEIC(file)
+	if err != nil {
+		return
+	}
+
+	// Hash the sanitised file, so it is deduplicated against other sanitised
+	// uploads of the same image
+	if strip {
+		file, err = stripFile(file)
+		if err != nil {
+			return
+		}
+	}
 	SHA1, _, err := hashFile(file, sha1.New(), hex.EncodeToString)
 	if err != nil {
 		return
@@ -109,6 +177,17 @@ func processRequest(file multipart.File, size int) (token string, err error) {
 			return
 		}
 		if exists { // Already have a thumbnail
+			err = assertNotBlocked(tx, SHA1)
+			if err != nil {
+				return
+			}
+			// Matches the stripped file, so has no metadata either
+			if strip {
+				err = db.MarkImageStripped(tx, SHA1)
+				if err != nil {
+					return
+				}
+			}
 			token, err = db.NewImageToken(tx, SHA1)
 		}
 		return
@@ -117,7 +196,7 @@ func processRequest(file multipart.File, size int) (token string, err error) {
 		return
 	}
 	if !exists {
-		token, err = newThumbnail(file, SHA1)
+		token, err = newThumbnail(file, SHA1, strip)
 	}
 	return
 }
@@ -13,6 +13,8 @@ func init() {
 	for _, fn := range [...]thumbnailer.MatcherFunc{
 		detectTarGZ,
 		detectTarXZ,
+		detectHEIF,
+		detectJXL,
 		detectText, // Has to be last, in case any other formats are pure UTF-8
 	} {
 		thumbnailer.RegisterMatcher(fn)
@@ -25,6 +27,9 @@ func init() {
 	} {
 		thumbnailer.RegisterProcessor(m, noopProcessor)
 	}
+	for _, m := range [...]string{mimeAVIF, mimeJXL} {
+		thumbnailer.RegisterProcessor(m, processFFImage)
+	}
 }
 
 // Does nothing.
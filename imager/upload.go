@@ -36,6 +36,8 @@ var (
 		"image/png":                     common.PNG,
 		"image/gif":                     common.GIF,
 		"image/webp":                    common.WEBP,
+		mimeAVIF:                        common.AVIF,
+		mimeJXL:                         common.JXL,
 		mimePDF:                         common.PDF,
 		"video/webm":                    common.WEBM,
 		"application/ogg":               common.OGG,
@@ -58,6 +60,10 @@ var (
 
 	errTooLarge    = errors.New("file too large")
 	errSecretImage = errors.New("image secret post detected")
+	errBlocked     = common.StatusError{
+		Err:  errors.New("image matches blocklist"),
+		Code: 403,
+	}
 
 	// Large buffer pool of length=0 capacity=12+KB
 	largeBufPool = sync.Pool{
@@ -136,7 +142,8 @@ func validateUploader(r *http.Request) (err error) {
 // been thumbnailed and is stored on the server. The client sends an SHA1 hash
 // of the file it wants to upload. The server looks up, if such a file is
 // thumbnailed. If yes, generates and sends a new image allocation token to
-// the client.
+// the client. If the board the file is uploaded to, set by the "board" query
+// parameter, strips metadata, the stored file must be known to contain none.
 func UploadImageHash(w http.ResponseWriter, r *http.Request) {
 	token, err := func() (token string, err error) {
 		err = validateUploader(r)
@@ -155,7 +162,17 @@ func UploadImageHash(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return
 			}
+			if exists && shouldStrip(r.URL.Query().Get("board")) {
+				exists, err = db.IsStrippedImage(tx, sha1)
+				if err != nil {
+					return
+				}
+			}
 			if exists {
+				err = assertNotBlocked(tx, sha1)
+				if err != nil {
+					return
+				}
 				token, err = db.NewImageToken(tx, sha1)
 			}
 			return
@@ -173,6 +190,15 @@ func UploadImageHash(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Reject an already thumbnailed image, if it matches the image blocklist
+func assertNotBlocked(tx *sql.Tx, sha1 string) (err error) {
+	blocked, err := db.IsBlockedImage(tx, sha1)
+	if err == nil && blocked {
+		err = errBlocked
+	}
+	return
+}
+
 func incrementSpamScore(r *http.Request) (err error) {
 	ip, err := auth.GetIP(r)
 	if err != nil {
@@ -205,43 +231,77 @@ func LogError(w http.ResponseWriter, r *http.Request, err error) {
 // Returns the HTTP status code of the response, the ID of the generated image
 // and an error, if any.
 func ParseUpload(req *http.Request) (string, error) {
+	file, size, err := parseUploadForm(req)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	res := <-requestThumbnailing(file, size,
+		shouldStrip(req.Form.Get("board")))
+	return res.imageID, res.err
+}
+
+// Parse the upload form and validate the uploaded file. Returns the file and
+// its size. The caller must close the file.
+func parseUploadForm(req *http.Request) (
+	file multipart.File, size int, err error,
+) {
 	max := config.Get().MaxSize << 20
 	length, err := strconv.ParseUint(req.Header.Get("Content-Length"), 10, 64)
 	if err != nil {
-		return "", common.StatusError{err, 413}
+		err = common.StatusError{err, 413}
+		return
 	}
 	if uint(length) > max {
-		return "", common.StatusError{errTooLarge, 400}
+		err = common.StatusError{errTooLarge, 400}
+		return
 	}
 	err = req.ParseMultipartForm(0)
 	if err != nil {
-		return "", common.StatusError{err, 400}
+		err = common.StatusError{err, 400}
+		return
 	}
 
 	file, head, err := req.FormFile("image")
 	if err != nil {
-		return "", common.StatusError{err, 400}
+		err = common.StatusError{err, 400}
+		return
 	}
-	defer file.Close()
+	defer func() {
+		if err != nil {
+			file.Close()
+			file = nil
+		}
+	}()
 	if uint(head.Size) > max {
-		return "", common.StatusError{errTooLarge, 413}
+		err = common.StatusError{errTooLarge, 413}
+		return
 	}
 
 	// Reject image sekritpost.
 	buf := make([]byte, 6)
-	_, err = file.ReadAt(buf, head.Size-6)
-
+	file.ReadAt(buf, head.Size-6)
 	if strings.ToLower(string(buf)) == "secret" {
-		return "", common.StatusError{errSecretImage, 400}
+		err = common.StatusError{errSecretImage, 400}
+		return
 	}
 
-	res := <-requestThumbnailing(file, int(head.Size))
-	return res.imageID, res.err
+	size = int(head.Size)
+	return
+}
+
+// Returns, if metadata should be stripped from files uploaded to a board.
+// Files uploaded without specifying an existing board are always stripped.
+func shouldStrip(board string) bool {
+	conf := config.GetBoardConfigs(board)
+	return conf.ID == "" || conf.StripMetadata
 }
 
 // Create a new thumbnail, commit its resources to the DB and filesystem, and
 // pass the image data to the client.
-func newThumbnail(f multipart.File, SHA1 string) (
+// strip specifies, if the file has been stripped of metadata.
+func newThumbnail(f multipart.File, SHA1 string, strip bool) (
 	token string, err error,
 ) {
 	var img common.ImageCommon
@@ -271,6 +331,14 @@ func newThumbnail(f multipart.File, SHA1 string) (
 	// Being done in one transaction prevents the image DB record from getting
 	// garbage-collected between the calls
 	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
+		blocked, err := db.IsBlockedPHash(tx, img.PHash)
+		if err != nil {
+			return
+		}
+		if blocked {
+			return errBlocked
+		}
+
 		var thumbR io.ReadSeeker
 		if thumb != nil {
 			thumbR = bytes.NewReader(thumb)
@@ -279,6 +347,12 @@ func newThumbnail(f multipart.File, SHA1 string) (
 		if err != nil && !db.IsConflictError(err) {
 			return
 		}
+		if strip {
+			err = db.MarkImageStripped(tx, img.SHA1)
+			if err != nil {
+				return
+			}
+		}
 		token, err = db.NewImageToken(tx, img.SHA1)
 		return
 	})
@@ -331,10 +405,21 @@ func processFile(f multipart.File, img *common.ImageCommon,
 	}
 
 	img.Dims = [4]uint16{uint16(src.Width), uint16(src.Height), 0, 0}
+	if thumbImage != nil && config.Get().CorrectOrientation {
+		switch img.FileType {
+		case common.JPEG, common.PNG, common.WEBP:
+			o := readOrientation(f)
+			if o >= 5 { // Transposed
+				img.Dims[0], img.Dims[1] = img.Dims[1], img.Dims[0]
+			}
+			thumbImage = applyOrientation(thumbImage, o)
+		}
+	}
 	if thumbImage != nil {
 		b := thumbImage.Bounds()
 		img.Dims[2] = uint16(b.Dx())
 		img.Dims[3] = uint16(b.Dy())
+		img.PHash = perceptualHash(thumbImage)
 	}
 
 	img.MD5, img.Size, err = hashFile(f, md5.New(),
@@ -2,6 +2,9 @@ package imager
 
 import (
 	"bytes"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -9,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -54,11 +58,16 @@ func assertCode(t *testing.T, res, std int) {
 	}
 }
 
+// Upload the sample JPEG to a board, that keeps metadata
 func newJPEGRequest(t *testing.T) *http.Request {
 	t.Helper()
 
 	b, w := newMultiWriter()
 
+	err := w.WriteField("board", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
 	file, err := w.CreateFormFile("image", assets.StdJPEG.Name)
 	if err != nil {
 		t.Fatal(err)
@@ -162,6 +171,10 @@ func TestNewThumbnail(t *testing.T) {
 	assertCode(t, rec.Code, 200)
 
 	img := getImageRecord(t, assets.StdJPEG.SHA1)
+	if img.PHash == 0 {
+		t.Fatal("no perceptual hash")
+	}
+	img.PHash = 0
 	test.AssertEquals(t, img, assets.StdJPEG.ImageCommon)
 	assertFiles(t, "sample.jpg", assets.StdJPEG.SHA1, common.JPEG, common.WEBP)
 }
@@ -202,11 +215,27 @@ func TestUploadImageHash(t *testing.T) {
 
 	rec := httptest.NewRecorder()
 	b := bytes.NewReader([]byte(std.SHA1))
-	req = httptest.NewRequest("POST", "/", b)
+	req = httptest.NewRequest("POST", "/?board=a", b)
 	UploadImageHash(rec, req)
 	if rec.Code != 200 {
 		t.Errorf("unexpected status code: %d", rec.Code)
 	}
+	if rec.Body.Len() == 0 {
+		t.Error("no image token")
+	}
+
+	// The stored file was not stripped of metadata, so must be uploaded again
+	// to boards stripping it
+	for _, board := range [...]string{"", "all", "nonexistent"} {
+		rec := httptest.NewRecorder()
+		b := bytes.NewReader([]byte(std.SHA1))
+		req = httptest.NewRequest("POST", "/?board="+board, b)
+		UploadImageHash(rec, req)
+		if s := rec.Body.String(); s != "" {
+			t.Errorf("unexpected response body for board `%s`: `%s`", board,
+				s)
+		}
+	}
 }
 
 func TestUploadImageHashNoHash(t *testing.T) {
@@ -223,3 +252,107 @@ func TestUploadImageHashNoHash(t *testing.T) {
 		t.Errorf("unexpected response body: `%s`", s)
 	}
 }
+
+func TestBlocklistedUpload(t *testing.T) {
+	test_db.ClearTables(t, "images", "phash_blocklist")
+	resetDirs(t)
+	config.Set(config.Configs{
+		PHashThreshold: 2,
+		Public: config.Public{
+			MaxSize: 10,
+		},
+	})
+
+	_, err := ParseUpload(newJPEGRequest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := getImageRecord(t, assets.StdJPEG.SHA1)
+
+	// Blocklist a hash within the threshold distance
+	err = db.InTransaction(false, func(tx *sql.Tx) error {
+		return db.BlocklistPHash(tx, img.PHash^3, img.SHA1, "admin")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("already thumbnailed", func(t *testing.T) {
+		_, err := ParseUpload(newJPEGRequest(t))
+		test.AssertEquals(t, err, errBlocked)
+	})
+
+	t.Run("new upload", func(t *testing.T) {
+		test_db.ClearTables(t, "images")
+		resetDirs(t)
+
+		_, err := ParseUpload(newJPEGRequest(t))
+		test.AssertEquals(t, err, errBlocked)
+	})
+}
+
+func TestStripUpload(t *testing.T) {
+	test_db.ClearTables(t, "images")
+	resetDirs(t)
+	config.Set(config.Configs{
+		Public: config.Public{
+			MaxSize: 10,
+		},
+	})
+	defer config.RemoveBoard("b")
+	_, err := config.SetBoardConfigs(config.BoardConfigs{
+		ID:            "b",
+		StripMetadata: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := test.ReadSample(t, assets.StdJPEG.Name)
+	b, w := newMultiWriter()
+	err = w.WriteField("board", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := w.CreateFormFile("image", assets.StdJPEG.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = file.Write(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := newRequest(t, b, w)
+	req.Header.Set("Content-Length", strconv.Itoa(b.Len()))
+
+	_, err = ParseUpload(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stored file must be the sanitised one and deduplicated by its own hash
+	stripped, _, err := stripMetadata(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha1.Sum(stripped)
+	id := hex.EncodeToString(h[:])
+	img := getImageRecord(t, id)
+	test.AssertEquals(t, img.Size, len(stripped))
+
+	stored, err := ioutil.ReadFile(
+		assets.GetFilePaths(id, common.JPEG, common.WEBP)[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertBufferEquals(t, stored, stripped)
+
+	// Stripped files can be reused without uploading
+	rec := httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/?board=b",
+		bytes.NewReader([]byte(id)))
+	UploadImageHash(rec, req)
+	if rec.Body.Len() == 0 {
+		t.Error("no image token")
+	}
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
 	"github.com/bakape/meguca/templates"
+	"github.com/bakape/meguca/websockets"
 	"github.com/bakape/meguca/websockets/feeds"
 )
 
@@ -33,6 +35,8 @@ var (
 	errRulesTooLong     = common.ErrTooLong("rules")
 	errReasonTooLong    = common.ErrTooLong("reason")
 	errTooManyAnswers   = common.ErrInvalidInput("too many eightball answers")
+	errOpenTimeTooLong  = common.ErrInvalidInput("open post time too long")
+	errAttachmentLimit  = common.ErrInvalidInput("attachment limit too high")
 	errInvalidBoardName = common.ErrInvalidInput("invalid board name")
 	errBoardNameTaken   = common.ErrInvalidInput("board name taken")
 	errNoReason         = common.ErrInvalidInput("no reason provided")
@@ -147,6 +151,11 @@ func validateBoardConfigs(w http.ResponseWriter, conf config.BoardConfigs,
 		err = errRulesTooLong
 	case len(conf.Title) > common.MaxLenBoardTitle:
 		err = errTitleTooLong
+	case conf.MaxOpenTime > common.MaxOpenPostTime,
+		conf.MaxIdleTime > common.MaxOpenPostTime:
+		err = errOpenTimeTooLong
+	case conf.MaxAttachments > common.MaxAttachments:
+		err = errAttachmentLimit
 	}
 	if err != nil {
 		return
@@ -188,6 +197,16 @@ func servePrivateServerConfigs(w http.ResponseWriter, r *http.Request) {
 	serveJSON(w, r, "", config.Get())
 }
 
+// Serve outbound websocket message queueing counters to the admin
+func serveWebsocketStats(w http.ResponseWriter, r *http.Request) {
+	err := isAdmin(w, r)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", websockets.GetSendQueueStats())
+}
+
 func isAdmin(w http.ResponseWriter, r *http.Request) (err error) {
 	creds, err := isLoggedIn(w, r)
 	if err != nil {
@@ -286,8 +305,9 @@ func createBoard(w http.ResponseWriter, r *http.Request) {
 						Title:      msg.Title,
 						DefaultCSS: config.Get().DefaultCSS,
 					},
-					ID:        msg.ID,
-					Eightball: config.EightballDefaults,
+					ID:            msg.ID,
+					StripMetadata: true,
+					Eightball:     config.EightballDefaults,
 				},
 			})
 			switch {
@@ -435,6 +455,11 @@ func deleteImage(w http.ResponseWriter, r *http.Request) {
 	moderatePosts(w, r, db.DeleteImages)
 }
 
+// Permanently delete an image from a post and add it to the image blocklist
+func blocklistImage(w http.ResponseWriter, r *http.Request) {
+	moderatePosts(w, r, imager.BlocklistImages)
+}
+
 // Spoiler image as a moderator
 func modSpoilerImage(w http.ResponseWriter, r *http.Request) {
 	moderatePosts(w, r, db.ModSpoilerImages)
@@ -625,6 +650,35 @@ func getSameIPPosts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Serve the recorded edit log of a post, so moderators can replay how it was
+// typed
+func getEditLog(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := extractID(r)
+		if err != nil {
+			return
+		}
+
+		_, _, err = canModeratePost(w, r, id, common.Moderator)
+		if err != nil {
+			return
+		}
+
+		ops, err := db.GetEditLog(id)
+		if err != nil {
+			return
+		}
+		if ops == nil {
+			ops = []db.EditOp{}
+		}
+		serveJSON(w, r, "", ops)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Set the sticky flag of a thread
 func setThreadSticky(w http.ResponseWriter, r *http.Request) {
 	handleBoolRequest(w, r, func(id uint64, val bool, _ string) error {
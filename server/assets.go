@@ -3,9 +3,12 @@ package server
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -14,6 +17,7 @@ import (
 	"github.com/bakape/meguca/assets"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/db"
+	imager "github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/thumbnailer"
 )
 
@@ -24,11 +28,18 @@ var (
 		// it is a year or greater, so keep it a little below.
 		"Cache-Control": "max-age=30240000, public, immutable",
 	}
-
-	// For overriding during tests
-	imageWebRoot = "images"
 )
 
+func init() {
+	// Image types not known to the mime package on all platforms
+	for ext, typ := range map[string]string{
+		".avif": "image/avif",
+		".jxl":  "image/jxl",
+	} {
+		mime.AddExtensionType(ext, typ)
+	}
+}
+
 type fileError struct {
 	name, msg string
 }
@@ -46,9 +57,38 @@ func newFileError(h *multipart.FileHeader, msg string) error {
 
 // More performant handler for serving image assets. These are immutable
 // (except deletion), so we can also set separate caching policies for them.
+//
+// If the image store supports redirects, clients are redirected to the
+// store instead. Public addresses set with ImageRootOverride take precedence
+// over signed store URLs.
 func serveImages(w http.ResponseWriter, r *http.Request) {
-	path := extractParam(r, "path")
-	file, err := os.Open(cleanJoin(imageWebRoot, path))
+	key := path.Clean("/" + extractParam(r, "path"))[1:]
+	store := imager.GetStore()
+
+	url, err := store.URL(key)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	if url != "" {
+		// Only redirect to the override for stores, that are not served by
+		// this server. Otherwise a CDN using this server as its origin would
+		// be redirected to itself.
+		if o := imager.OverrideURL(key); o != "" {
+			for key, val := range imageHeaders {
+				w.Header().Set(key, val)
+			}
+			http.Redirect(w, r, o, 301)
+			return
+		}
+
+		// Redirect URLs may expire, so they must not be cached as long
+		w.Header().Set("Cache-Control", "no-cache")
+		http.Redirect(w, r, url, 302)
+		return
+	}
+
+	file, err := store.Read(key)
 	if err != nil {
 		text404(w)
 		return
@@ -60,7 +100,14 @@ func serveImages(w http.ResponseWriter, r *http.Request) {
 		head.Set(key, val)
 	}
 
-	http.ServeContent(w, r, path, time.Time{}, file)
+	if rs, ok := file.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, key, time.Time{}, rs)
+		return
+	}
+	if typ := mime.TypeByExtension(path.Ext(key)); typ != "" {
+		head.Set("Content-Type", typ)
+	}
+	io.Copy(w, file)
 }
 
 func cleanJoin(a, b string) string {
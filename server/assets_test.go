@@ -4,6 +4,10 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+
+	"github.com/bakape/meguca/config"
+	imager "github.com/bakape/meguca/imager/assets"
+	. "github.com/bakape/meguca/test"
 )
 
 func TestAssetServer(t *testing.T) {
@@ -45,3 +49,43 @@ func TestImageServer(t *testing.T) {
 	router.ServeHTTP(rec, req)
 	assertCode(t, rec, 404)
 }
+
+// Image store, that supports redirects
+type redirectStore struct {
+	imager.Store
+}
+
+func (redirectStore) URL(key string) (string, error) {
+	return "https://bucket.example.com/" + key + "?X-Amz-Signature=foo", nil
+}
+
+func TestImageServerRedirect(t *testing.T) {
+	old := imager.GetStore()
+	imager.SetStore(redirectStore{old})
+	oldConf := *config.Get()
+	t.Cleanup(func() {
+		imager.SetStore(old)
+		config.Set(oldConf)
+	})
+
+	const path = "/assets/images/src/tis_life.gif"
+
+	// Fall back to signed redirects without an override
+	rec, req := newPair(path)
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 302)
+	AssertEquals(t, rec.Header().Get("Location"),
+		"https://bucket.example.com/src/tis_life.gif?X-Amz-Signature=foo")
+	AssertEquals(t, rec.Header().Get("Cache-Control"), "no-cache")
+
+	conf := oldConf
+	conf.ImageRootOverride = "https://cdn.example.com"
+	config.Set(conf)
+
+	rec, req = newPair(path)
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 301)
+	AssertEquals(t, rec.Header().Get("Location"),
+		"https://cdn.example.com/src/tis_life.gif")
+	assertHeaders(t, rec, imageHeaders)
+}
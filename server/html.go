@@ -70,6 +70,30 @@ func boardHTML(w http.ResponseWriter, r *http.Request, b string, catalog bool) {
 	)
 }
 
+// Serves a list of a board's archived threads
+func archiveHTML(w http.ResponseWriter, r *http.Request, b string) {
+	if !auth.IsBoard(b) {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+	pos, ok := extractPosition(w, r)
+	if !ok {
+		return
+	}
+
+	archive, err := getBoardArchive(b)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+
+	setHTMLHeaders(w)
+	templates.Archive(w, b, resolveTheme(r, b), pos, archive.Threads)
+}
+
 // Resolve theme to render in accordance to client and board settings.
 // Needed to prevent Flash Of Unstyled Content.
 func resolveTheme(r *http.Request, board string) string {
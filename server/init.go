@@ -12,6 +12,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/ErikDubbelboer/gspt"
 	ass "github.com/bakape/meguca/assets"
@@ -19,10 +20,12 @@ import (
 	"github.com/bakape/meguca/cache"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager"
 	"github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/meguca/lang"
 	"github.com/bakape/meguca/templates"
 	"github.com/bakape/meguca/util"
+	"github.com/bakape/meguca/websockets"
 	"github.com/bakape/meguca/websockets/feeds"
 	"github.com/go-playground/log"
 )
@@ -45,6 +48,15 @@ var (
 		"restart": "combination of stop + start",
 		"debug":   "start server in debug mode without daemonizing (default)",
 		"help":    "print this help text",
+		"export": "export <board>[/<thread>] <file>: export a board or " +
+			"thread with its images to a gzipped tarball",
+		"import": "import <file> [board]: import threads from a tarball " +
+			"created by export under new post IDs. Defaults to the " +
+			"exported board.",
+		"migrate": "migrate status|up|down [--dry-run] [version]: list " +
+			"schema migrations, or upgrade or downgrade the database " +
+			"schema. up defaults to the latest version and down to the " +
+			"previous one. --dry-run prints the SQL without applying it.",
 	}
 )
 
@@ -52,9 +64,36 @@ var (
 // Flags override this. All fields are optional.
 type serverConfigs struct {
 	SSL, ReverseProxied, Gzip                            *bool
-	ImagerMode                                           *uint
+	ImagerMode, SendQueueLength, SendQueueSize           *uint
 	CacheSize                                            *float64
 	Address, Database, CertPath, KeyPath, ReverseProxyIP *string
+
+	// Store image files in an S3-compatible object storage bucket instead of
+	// the local file system. Images are served through signed redirects,
+	// unless the image root override points at the bucket's public address
+	// or a CDN.
+	S3 *assets.S3Config
+
+	// PostgreSQL connection arguments of read replicas
+	Replicas []string
+
+	// Seconds after a write to a thread or board, during which reads of it
+	// are not routed to replicas
+	ReadYourWritesWindow uint
+
+	// Relay thread feed updates to other meguca processes serving the same
+	// database through PostgreSQL. Enabled by default. Can be disabled, when
+	// running only a single process.
+	FeedBus *bool
+
+	// Storage backend for open post data: "bolt" (default), "postgres" or
+	// "memory"
+	OpenPostStore string
+
+	// Maximum number of uploads waiting for processing per queue and the
+	// numbers of uploads processed concurrently from the queues of files up
+	// to 4 MB and larger files
+	UploadQueueLength, SmallUploadWorkers, UploadWorkers *uint
 }
 
 func validateImagerMode(m *uint) {
@@ -74,11 +113,35 @@ func setConfigDefaults(c *serverConfigs) {
 	if c.Gzip == nil {
 		c.Gzip = new(bool)
 	}
+	if c.FeedBus == nil {
+		c.FeedBus = new(bool)
+		*c.FeedBus = feeds.EnablePGBus
+	}
 	if c.ImagerMode == nil {
 		c.ImagerMode = new(uint)
 	} else {
 		validateImagerMode(c.ImagerMode)
 	}
+	if c.SendQueueLength == nil {
+		c.SendQueueLength = new(uint)
+		*c.SendQueueLength = websockets.SendQueueLength
+	}
+	if c.SendQueueSize == nil {
+		c.SendQueueSize = new(uint)
+		*c.SendQueueSize = websockets.SendQueueSize >> 10
+	}
+	if c.UploadQueueLength == nil {
+		c.UploadQueueLength = new(uint)
+		*c.UploadQueueLength = imager.JobQueueLength
+	}
+	if c.SmallUploadWorkers == nil {
+		c.SmallUploadWorkers = new(uint)
+		*c.SmallUploadWorkers = imager.SmallJobWorkers
+	}
+	if c.UploadWorkers == nil {
+		c.UploadWorkers = new(uint)
+		*c.UploadWorkers = imager.JobWorkers
+	}
 	if c.CacheSize == nil {
 		c.CacheSize = new(float64)
 		*c.CacheSize = 128
@@ -162,6 +225,36 @@ func Start() error {
 0	handle image processing and serving and all other functionality (default)
 1	handle all functionality except for image processing and serving
 2	only handle image processing and serving`)
+	flag.UintVar(
+		&websockets.SendQueueLength,
+		"q",
+		*conf.SendQueueLength,
+		"maximum number of outbound messages queued per client",
+	)
+	flag.UintVar(
+		conf.SendQueueSize,
+		"Q",
+		*conf.SendQueueSize,
+		"maximum size of outbound messages queued per client in KB",
+	)
+	flag.UintVar(
+		&imager.JobQueueLength,
+		"u",
+		*conf.UploadQueueLength,
+		"maximum number of uploads waiting for processing per queue",
+	)
+	flag.UintVar(
+		&imager.SmallJobWorkers,
+		"w",
+		*conf.SmallUploadWorkers,
+		"number of uploads up to 4 MB processed concurrently",
+	)
+	flag.UintVar(
+		&imager.JobWorkers,
+		"W",
+		*conf.UploadWorkers,
+		"number of uploads larger than 4 MB processed concurrently",
+	)
 	flag.Usage = printUsage
 
 	// Parse command line arguments
@@ -169,12 +262,36 @@ func Start() error {
 	if cache.Size < 0 {
 		return errors.New("cache size must be a positive number")
 	}
+	if imager.SmallJobWorkers == 0 || imager.JobWorkers == 0 {
+		return errors.New("upload worker counts must be positive numbers")
+	}
 	validateImagerMode(conf.ImagerMode)
+	websockets.SendQueueSize = *conf.SendQueueSize << 10
 	config.ImagerMode = config.ImagerModeType(*conf.ImagerMode)
+	db.ReplicaConnArgs = conf.Replicas
+	feeds.EnablePGBus = *conf.FeedBus
+	if conf.OpenPostStore != "" {
+		db.OpenPostStoreType = conf.OpenPostStore
+	}
+	db.ReadYourWritesWindow = time.Duration(conf.ReadYourWritesWindow) *
+		time.Second
+	if conf.S3 != nil {
+		s, err := assets.NewS3Store(*conf.S3)
+		if err != nil {
+			return err
+		}
+		assets.SetStore(s)
+	}
 	arg := flag.Arg(0)
 	if arg == "" {
 		arg = "debug"
 	}
+	switch arg {
+	case "export", "import":
+		runTransfer(arg)
+	case "migrate":
+		runMigrate()
+	}
 
 	// Can't daemonize in windows, so only args they have is "start" and "help"
 	if isWindows {
@@ -222,7 +339,8 @@ func printUsage() {
 	} else {
 		arguments["debug"] = `alias of "start"`
 	}
-	toPrint = append(toPrint, []string{"debug", "help"}...)
+	toPrint = append(toPrint, []string{"debug", "export", "import", "migrate",
+		"help"}...)
 
 	help := new(bytes.Buffer)
 	for _, arg := range toPrint {
@@ -258,7 +376,7 @@ func startServer() {
 		go ass.WatchVideoDir()
 	}
 	if config.ImagerMode != config.NoImager {
-		tasks = append(tasks, auth.LoadCaptchaServices)
+		tasks = append(tasks, auth.LoadCaptchaServices, imager.Init)
 	}
 	tasks = append(tasks, feeds.Init)
 	load(tasks...)
@@ -12,11 +12,18 @@ import (
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	"github.com/bakape/meguca/util"
+	"github.com/bakape/meguca/websockets"
 	"github.com/bakape/meguca/websockets/feeds"
 )
 
 var errNoImage = errors.New("post has no image")
 
+// Default and maximum depth of served reply trees
+const (
+	defaultReplyTreeDepth = 4
+	maxReplyTreeDepth     = 16
+)
+
 // Request to spoiler an already allocated image that the sender has created
 type spoilerRequest struct {
 	ID       uint64
@@ -99,6 +106,50 @@ func servePost(w http.ResponseWriter, r *http.Request) {
 	serveJSON(w, r, "", post)
 }
 
+// Serve links to all posts replying to a post as JSON
+func serveReplies(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(extractParam(r, "post"), 10, 64)
+	if err != nil {
+		httpError(w, r, common.StatusError{err, 400})
+		return
+	}
+
+	replies, err := db.GetReplies(id)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", replies)
+}
+
+// Serve a post and all posts replying to it, recursively, as JSON. The depth
+// of the tree can be set with the "depth" query parameter.
+func serveReplyTree(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		id, err := strconv.ParseUint(extractParam(r, "post"), 10, 64)
+		if err != nil {
+			return common.StatusError{err, 400}
+		}
+		depth := defaultReplyTreeDepth
+		if q := r.URL.Query().Get("depth"); q != "" {
+			depth, err = strconv.Atoi(q)
+			if err != nil || depth < 0 || depth > maxReplyTreeDepth {
+				return common.ErrInvalidInput("invalid reply tree depth")
+			}
+		}
+
+		tree, err := db.GetReplyTree(id, depth)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", tree)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Serve board-specific configuration JSON
 func serveBoardConfigs(
 	w http.ResponseWriter,
@@ -135,6 +186,19 @@ func threadJSON(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, r, formatEtag(ctr, "", common.NotLoggedIn), data)
 }
 
+// Stream live updates of a thread as Server-Sent Events
+func threadEvents(w http.ResponseWriter, r *http.Request) {
+	id, ok := validateThread(w, r)
+	if !ok {
+		return
+	}
+
+	err := websockets.StreamEvents(w, r, extractParam(r, "board"), id)
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 // Confirms a the thread exists on the board and returns its ID. If an error
 // occurred and the calling function should return, ok = false.
 func validateThread(w http.ResponseWriter, r *http.Request) (uint64, bool) {
@@ -185,6 +249,33 @@ func boardJSON(w http.ResponseWriter, r *http.Request, catalog bool) {
 	}
 }
 
+// Serves the archived thread OPs of a board as JSON
+func boardArchiveJSON(w http.ResponseWriter, r *http.Request) {
+	b := extractParam(r, "board")
+	if !auth.IsBoard(b) {
+		text404(w)
+		return
+	}
+	if !assertNotBanned(w, r, b) {
+		return
+	}
+
+	archive, err := getBoardArchive(b)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", archive)
+}
+
+// Retrieve archived threads of a board or the "/all/" meta-board
+func getBoardArchive(board string) (common.Board, error) {
+	if board == "all" {
+		return db.GetAllBoardArchive()
+	}
+	return db.GetBoardArchive(board)
+}
+
 // Serve a JSON array of all available boards and their titles
 func serveBoardList(res http.ResponseWriter, req *http.Request) {
 	serveJSON(res, req, "", config.GetBoardTitles())
@@ -201,6 +292,28 @@ func serveIPCount(w http.ResponseWriter, r *http.Request) {
 	serveJSON(w, r, "", feeds.IPCount())
 }
 
+// Serve the status of an asynchronous upload job
+func serveUploadJob(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		j, err := db.GetUploadJob(extractParam(r, "job"))
+		if err != nil {
+			return
+		}
+		s := j.Status()
+		if s.State == db.UploadQueued {
+			s.Position, err = db.UploadJobPosition(j)
+			if err != nil {
+				return
+			}
+		}
+		serveJSON(w, r, "", s)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
 func serveThreadUpdates(w http.ResponseWriter, r *http.Request) {
 	err := func() (err error) {
 		var data map[uint64]uint64
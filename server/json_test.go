@@ -1,10 +1,12 @@
 package server
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
 	"github.com/bakape/meguca/cache"
+	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	. "github.com/bakape/meguca/test"
@@ -218,4 +220,31 @@ func TestServeExtensionMap(t *testing.T) {
 	rec, req := newPair("/json/extensions")
 	router.ServeHTTP(rec, req)
 	assertCode(t, rec, 200)
+
+	var res map[uint8]string
+	err := json.Unmarshal(rec.Body.Bytes(), &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, res[common.AVIF], "avif")
+	AssertEquals(t, res[common.JXL], "jxl")
+}
+
+func TestServeUploadJob(t *testing.T) {
+	test_db.ClearTables(t, "upload_jobs")
+	id, err := db.InsertUploadJob("::1", 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec, req := newPair("/api/upload/" + id)
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 200)
+	assertBody(t, rec, `{"id":"`+id+`","state":"queued"}`)
+
+	t.Run("nonexistent", func(t *testing.T) {
+		rec, req := newPair("/api/upload/foo")
+		router.ServeHTTP(rec, req)
+		assertCode(t, rec, 404)
+	})
 }
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
+)
+
+// Parsed arguments of the migrate mode
+type migrateArgs struct {
+	command string
+	// Target schema version. Only valid, if hasTarget is set.
+	target    int
+	hasTarget bool
+	dryRun    bool
+}
+
+// Parse the arguments following the "migrate" mode. Flags are accepted before
+// and after positional arguments, as the standard flag parser would silently
+// ignore a trailing "--dry-run" and apply the migration.
+func parseMigrateArgs(args []string) (a migrateArgs, err error) {
+	var flags, pos []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			flags = append(flags, arg)
+		} else {
+			pos = append(pos, arg)
+		}
+	}
+
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.BoolVar(&a.dryRun, "dry-run", false,
+		"print SQL statements without applying them")
+	err = fs.Parse(flags)
+	if err != nil {
+		return
+	}
+	if fs.NArg() != 0 {
+		err = fmt.Errorf("unexpected arguments: %v", fs.Args())
+		return
+	}
+
+	switch len(pos) {
+	case 0:
+		err = errors.New("no migrate command")
+		return
+	case 1:
+	case 2:
+		a.target, err = strconv.Atoi(pos[1])
+		if err != nil {
+			return
+		}
+		a.hasTarget = true
+	default:
+		err = fmt.Errorf("unexpected arguments: %v", pos[2:])
+		return
+	}
+	a.command = pos[0]
+	return
+}
+
+// Inspect, upgrade or downgrade the database schema from the command line and
+// exit
+func runMigrate() {
+	err := func() (err error) {
+		if flag.NArg() < 2 {
+			printUsage()
+		}
+		args, err := parseMigrateArgs(flag.Args()[1:])
+		if err != nil {
+			return
+		}
+
+		err = db.Connect()
+		if err != nil {
+			return
+		}
+		current, migs, err := db.GetMigrationStatus()
+		if err != nil {
+			return
+		}
+
+		var target int
+		switch args.command {
+		case "status":
+			printMigrationStatus(current, migs)
+			return
+		case "up":
+			target = db.SchemaVersion()
+			if args.hasTarget {
+				target = args.target
+			}
+			if target < current {
+				return errors.New("target version below current version")
+			}
+		case "down":
+			target = current - 1
+			if args.hasTarget {
+				target = args.target
+			}
+			if target > current {
+				return errors.New("target version above current version")
+			}
+		default:
+			printUsage()
+		}
+
+		if target == current {
+			fmt.Printf("database already at version %d\n", current)
+			return
+		}
+		err = db.Migrate(target, args.dryRun, os.Stdout)
+		if err != nil {
+			return
+		}
+		if !args.dryRun {
+			fmt.Printf("migrated database from version %d to %d\n",
+				current, target)
+		}
+		return
+	}()
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(0)
+}
+
+// Print the schema versions of the database and codebase and the state of
+// each migration
+func printMigrationStatus(current int, migs []db.MigrationStatus) {
+	fmt.Printf("database version: %d\ncodebase version: %d\n\n",
+		current, db.SchemaVersion())
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	for _, m := range migs {
+		state := "pending"
+		if m.Applied {
+			state = "applied"
+		}
+		rev := ""
+		if m.Reversible {
+			rev = "reversible"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t\n", m.Version, state, rev)
+	}
+	w.Flush()
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestParseMigrateArgs(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name string
+		in   []string
+		out  migrateArgs
+		err  bool
+	}{
+		{
+			name: "status",
+			in:   []string{"status"},
+			out:  migrateArgs{command: "status"},
+		},
+		{
+			name: "no target",
+			in:   []string{"up"},
+			out:  migrateArgs{command: "up"},
+		},
+		{
+			name: "target",
+			in:   []string{"down", "104"},
+			out: migrateArgs{
+				command:   "down",
+				target:    104,
+				hasTarget: true,
+			},
+		},
+		{
+			name: "leading dry run",
+			in:   []string{"--dry-run", "down", "104"},
+			out: migrateArgs{
+				command:   "down",
+				target:    104,
+				hasTarget: true,
+				dryRun:    true,
+			},
+		},
+		{
+			name: "trailing dry run",
+			in:   []string{"down", "104", "--dry-run"},
+			out: migrateArgs{
+				command:   "down",
+				target:    104,
+				hasTarget: true,
+				dryRun:    true,
+			},
+		},
+		{
+			name: "dry run between positionals",
+			in:   []string{"down", "-dry-run", "104"},
+			out: migrateArgs{
+				command:   "down",
+				target:    104,
+				hasTarget: true,
+				dryRun:    true,
+			},
+		},
+		{
+			name: "invalid target",
+			in:   []string{"down", "foo"},
+			err:  true,
+		},
+		{
+			name: "extra arguments",
+			in:   []string{"down", "104", "105"},
+			err:  true,
+		},
+		{
+			name: "unknown flag",
+			in:   []string{"down", "104", "--force"},
+			err:  true,
+		},
+		{
+			name: "no command",
+			in:   []string{"--dry-run"},
+			err:  true,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			args, err := parseMigrateArgs(c.in)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, args, c.out)
+		})
+	}
+}
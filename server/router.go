@@ -96,6 +96,8 @@ func createRouter() http.Handler {
 		// All upload images
 		api.POST("/upload", imager.NewImageUpload)
 		api.POST("/upload-hash", imager.UploadImageHash)
+		api.POST("/upload-async", imager.NewAsyncImageUpload)
+		api.GET("/upload/:job", serveUploadJob)
 		api.POST("/create-thread", createThread)
 		api.POST("/create-reply", createReply)
 
@@ -121,6 +123,18 @@ func createRouter() http.Handler {
 			// Artificially set board to "all"
 			boardHTML(w, r, "all", true)
 		})
+		r.GET("/all/search", func(w http.ResponseWriter, r *http.Request) {
+			searchHTML(w, r, "all")
+		})
+		r.GET("/:board/search", func(w http.ResponseWriter, r *http.Request) {
+			searchHTML(w, r, extractParam(r, "board"))
+		})
+		r.GET("/all/archive", func(w http.ResponseWriter, r *http.Request) {
+			archiveHTML(w, r, "all")
+		})
+		r.GET("/:board/archive", func(w http.ResponseWriter, r *http.Request) {
+			archiveHTML(w, r, extractParam(r, "board"))
+		})
 		r.GET("/:board/:thread", threadHTML)
 		r.GET("/all/:id", crossRedirect)
 
@@ -138,6 +152,7 @@ func createRouter() http.Handler {
 		html.GET("/mod-log/:board", modLog)
 		html.GET("/report/:id", reportForm)
 		html.GET("/reports/:board", reportList)
+		html.GET("/stats/:board", boardStats)
 
 		// JSON API
 		json := r.NewGroup("/json")
@@ -150,13 +165,19 @@ func createRouter() http.Handler {
 		) {
 			boardJSON(w, r, true)
 		})
+		boards.GET("/:board/archive", boardArchiveJSON)
 		boards.GET("/:board/:thread", threadJSON)
+		boards.GET("/:board/:thread/events", threadEvents)
 		json.GET("/post/:post", servePost)
+		json.GET("/post/:post/replies", serveReplies)
+		json.GET("/post/:post/reply-tree", serveReplyTree)
+		json.GET("/search", searchJSON)
 		json.GET("/config", serveConfigs)
 		json.GET("/extensions", serveExtensionMap)
 		json.GET("/board-config/:board", serveBoardConfigs)
 		json.GET("/board-list", serveBoardList)
 		json.GET("/ip-count", serveIPCount)
+		json.GET("/stats/:board", serveBoardStats)
 		json.POST("/thread-updates", serveThreadUpdates)
 
 		// Internal API
@@ -177,16 +198,19 @@ func createRouter() http.Handler {
 		api.POST("/configure-board/:board", configureBoard)
 		api.POST("/config", servePrivateServerConfigs)
 		api.POST("/configure-server", configureServer)
+		api.POST("/websocket-stats", serveWebsocketStats)
 		api.POST("/create-board", createBoard) // behind admin check
 		api.POST("/delete-board", deleteBoard)
 		api.POST("/delete-posts", deletePosts)
 		api.POST("/delete-posts/by-ip", deletePostsByIP)
 		api.POST("/delete-image", deleteImage)
+		api.POST("/blocklist-image", blocklistImage)
 		api.POST("/spoiler-image", modSpoilerImage)
 		api.POST("/ban", ban)
 		api.POST("/notification", sendNotification)
 		api.POST("/assign-staff", assignStaff)
 		api.POST("/same-IP/:id", getSameIPPosts)
+		api.POST("/edit-log/:id", getEditLog)
 		api.POST("/sticky", setThreadSticky)
 		api.POST("/lock-thread", setThreadLock)
 		api.POST("/unban/:board", unban)
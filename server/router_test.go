@@ -9,6 +9,7 @@ import (
 
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	imager "github.com/bakape/meguca/imager/assets"
 	"github.com/bakape/meguca/lang"
 	"github.com/bakape/meguca/templates"
 	"github.com/bakape/meguca/util"
@@ -30,7 +31,7 @@ func TestMain(m *testing.M) {
 	log.AddHandler(con, log.AllLevels...)
 	router = createRouter()
 	webRoot = "testdata"
-	imageWebRoot = "testdata"
+	imager.SetStore(imager.NewLocalStore("testdata"))
 
 	config.Set(config.Configs{
 		Public: config.Public{
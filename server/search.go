@@ -0,0 +1,174 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/templates"
+)
+
+var (
+	errNoSearchQuery      = common.ErrInvalidInput("no search query")
+	errSearchQueryTooLong = common.ErrTooLong("search query")
+	errInvalidSearchDate  = common.ErrInvalidInput("invalid date")
+	errInvalidFileType    = common.ErrInvalidInput("invalid file type")
+)
+
+// Parse search query and filters from the request's URL query string.
+// board is the board to search.
+func parseSearchParams(r *http.Request, board string) (
+	p db.SearchParams, err error,
+) {
+	q := r.URL.Query()
+	p.Board = board
+	p.Query = strings.TrimSpace(q.Get("q"))
+	switch {
+	case p.Query == "":
+		err = errNoSearchQuery
+	case utf8.RuneCountInString(p.Query) > common.MaxLenSearch:
+		err = errSearchQueryTooLong
+	}
+	if err != nil {
+		return
+	}
+
+	p.From, err = parseSearchTime(q.Get("from"), false)
+	if err != nil {
+		return
+	}
+	p.To, err = parseSearchTime(q.Get("to"), true)
+	if err != nil {
+		return
+	}
+
+	switch q.Get("image") {
+	case "true", "on":
+		p.HasImage = true
+	}
+
+	if s := q.Get("fileType"); s != "" {
+		for typ, ext := range common.Extensions {
+			if ext == s {
+				p.FileType = &typ
+				break
+			}
+		}
+		if p.FileType == nil {
+			err = errInvalidFileType
+			return
+		}
+	}
+
+	if s := q.Get("before"); s != "" {
+		p.Before, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			err = common.StatusError{err, 400}
+			return
+		}
+	}
+
+	p.ShowModerated = detectCanPerform(r, searchPermissionBoard(board),
+		common.Janitor)
+	return
+}
+
+// Parse a search time range bound as either Unix time or a date. If end is
+// true, a date is extended to the end of the day.
+func parseSearchTime(s string, end bool) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return 0, errInvalidSearchDate
+	}
+	if end {
+		t = t.Add(time.Hour*24 - time.Second)
+	}
+	return t.Unix(), nil
+}
+
+// Returns the board staff permissions are checked against for a search of
+// board. Searching all boards requires global staff permissions.
+func searchPermissionBoard(board string) string {
+	if board == "" {
+		return "all"
+	}
+	return board
+}
+
+// Serve full-text search results as JSON
+func searchJSON(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := r.URL.Query().Get("board")
+		if board != "" && !auth.IsBoard(board) {
+			text404(w)
+			return
+		}
+		if !assertNotBanned(w, r, searchPermissionBoard(board)) {
+			return
+		}
+
+		p, err := parseSearchParams(r, board)
+		if err != nil {
+			return
+		}
+		posts, err := db.SearchPosts(p)
+		if err != nil {
+			return
+		}
+		serveJSON(w, r, "", posts)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
+
+// Render a search form and full-text search results of a board
+func searchHTML(w http.ResponseWriter, r *http.Request, board string) {
+	err := func() (err error) {
+		if !auth.IsBoard(board) {
+			text404(w)
+			return
+		}
+		if !assertNotBanned(w, r, board) {
+			return
+		}
+		pos, ok := extractPosition(w, r)
+		if !ok {
+			return
+		}
+
+		// Only render the search form, if no query submitted yet
+		var posts []common.StandalonePost
+		if r.URL.Query().Get("q") != "" {
+			var p db.SearchParams
+			p, err = parseSearchParams(r, board)
+			if err != nil {
+				return
+			}
+			posts, err = db.SearchPosts(p)
+			if err != nil {
+				return
+			}
+		}
+
+		setHTMLHeaders(w)
+		templates.Search(w, board, resolveTheme(r, board), pos, r.URL.Query(),
+			posts)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestParseSearchParams(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, url string
+		err       error
+		from, to  int64
+	}{
+		{
+			name: "no query",
+			url:  "/json/search",
+			err:  errNoSearchQuery,
+		},
+		{
+			name: "unix time",
+			url:  "/json/search?q=foo&from=10&to=20",
+			from: 10,
+			to:   20,
+		},
+		{
+			name: "date",
+			url:  "/json/search?q=foo&from=1970-01-02&to=1970-01-02",
+			from: 86400,
+			to:   2*86400 - 1,
+		},
+		{
+			name: "invalid date",
+			url:  "/json/search?q=foo&from=yesterday",
+			err:  errInvalidSearchDate,
+		},
+		{
+			name: "invalid file type",
+			url:  "/json/search?q=foo&fileType=exe",
+			err:  errInvalidFileType,
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := parseSearchParams(newRequest(c.url), "a")
+			if err != c.err {
+				UnexpectedError(t, err)
+			}
+			if err != nil {
+				return
+			}
+			AssertEquals(t, p.From, c.from)
+			AssertEquals(t, p.To, c.to)
+			AssertEquals(t, p.ShowModerated, false)
+		})
+	}
+
+	t.Run("file type", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := parseSearchParams(
+			newRequest("/json/search?q=foo&fileType=png&image=on"), "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEquals(t, *p.FileType, common.PNG)
+		AssertEquals(t, p.HasImage, true)
+	})
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/templates"
+)
+
+// Default and maximum number of past hours of board statistics served
+const (
+	defaultStatsHours = 24 * 7
+	maxStatsHours     = 24 * 90
+)
+
+// Retrieve board statistics for the number of past hours specified by the
+// "hours" query parameter
+func getBoardStats(r *http.Request, board string) (
+	[]common.BoardStats, error,
+) {
+	hours := defaultStatsHours
+	if q := r.URL.Query().Get("hours"); q != "" {
+		n, err := strconv.Atoi(q)
+		if err != nil || n <= 0 || n > maxStatsHours {
+			return nil, common.ErrInvalidInput("invalid hour count")
+		}
+		hours = n
+	}
+	return db.GetBoardStats(board,
+		time.Now().Add(-time.Duration(hours)*time.Hour))
+}
+
+// Serve hourly activity statistics of a board as JSON
+func serveBoardStats(w http.ResponseWriter, r *http.Request) {
+	board := extractParam(r, "board")
+	if !auth.IsNonMetaBoard(board) {
+		text404(w)
+		return
+	}
+
+	stats, err := getBoardStats(r, board)
+	if err != nil {
+		httpError(w, r, err)
+		return
+	}
+	serveJSON(w, r, "", stats)
+}
+
+// Render hourly activity statistics of a board. Only accessible to the board's
+// owners.
+func boardStats(w http.ResponseWriter, r *http.Request) {
+	err := func() (err error) {
+		board := extractParam(r, "board")
+		if !auth.IsNonMetaBoard(board) {
+			return errInvalidBoardName
+		}
+		_, err = canPerform(w, r, board, common.BoardOwner, false)
+		if err != nil {
+			return
+		}
+
+		stats, err := getBoardStats(r, board)
+		if err != nil {
+			return
+		}
+		setHTMLHeaders(w)
+		templates.WriteBoardStats(w, board, stats)
+		return
+	}()
+	if err != nil {
+		httpError(w, r, err)
+	}
+}
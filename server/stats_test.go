@@ -0,0 +1,18 @@
+package server
+
+import (
+	"testing"
+)
+
+func TestServeBoardStats(t *testing.T) {
+	setBoards(t, "a")
+
+	// Public without logging in
+	rec, req := newPair("/json/stats/a")
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 200)
+
+	rec, req = newPair("/json/stats/all")
+	router.ServeHTTP(rec, req)
+	assertCode(t, rec, 404)
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+	"github.com/bakape/meguca/transfer"
+	"github.com/bakape/meguca/util"
+	"github.com/go-playground/log"
+)
+
+// Export or import a board or thread archive from the command line and exit
+func runTransfer(mode string) {
+	err := func() (err error) {
+		min := 2
+		if mode == "export" {
+			min = 3
+		}
+		if flag.NArg() < min {
+			printUsage()
+		}
+
+		err = util.Parallel(db.LoadDB, assets.CreateDirs)
+		if err != nil {
+			return
+		}
+
+		switch mode {
+		case "export":
+			var f *os.File
+			f, err = os.Create(flag.Arg(2))
+			if err != nil {
+				return
+			}
+			defer f.Close()
+
+			err = transfer.Export(f, flag.Arg(1))
+			if err != nil {
+				os.Remove(flag.Arg(2))
+				return
+			}
+			fmt.Printf("exported %s to %s\n", flag.Arg(1), flag.Arg(2))
+		case "import":
+			var f *os.File
+			f, err = os.Open(flag.Arg(1))
+			if err != nil {
+				return
+			}
+			defer f.Close()
+
+			var n int
+			n, err = transfer.Import(f, flag.Arg(2))
+			if err != nil {
+				return
+			}
+			fmt.Printf("imported %d threads\n", n)
+		}
+		return
+	}()
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(0)
+}
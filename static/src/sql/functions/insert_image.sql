@@ -16,7 +16,7 @@ begin
 		raise exception 'post not found';
 	end if;
 
-	select to_jsonb(i) into data
+	select to_jsonb(i) - 'phash' into data
 		from images i
 		where i.sha1 = image_id;
 	return data || jsonb_build_object(
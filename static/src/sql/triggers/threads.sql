@@ -6,17 +6,55 @@ begin
 	-- Init Russian roulette
 	insert into roulette (id, scount, rcount) values (new.id, 6, 0);
 
+	perform pg_notify('board_feed', json_build_object(
+		'type', 'create',
+		'id', new.id,
+		'board', new.board,
+		'bump_time', new.bump_time,
+		'sticky', new.sticky,
+		'locked', new.locked
+	)::text);
+
 	return null;
 end;
 $$ language plpgsql;
 
 create or replace function after_threads_update()
 returns trigger as $$
+declare
+	feed_event text;
 begin
 	-- Prevent infinite recursion on timestamp updates
 	if new.update_time != old.update_time then
 		perform bump_thread(new.id);
 	end if;
+
+	-- Archived threads are removed from the board
+	if new.archived and not old.archived then
+		perform pg_notify('board_feed', json_build_object(
+			'type', 'delete',
+			'id', new.id,
+			'board', new.board
+		)::text);
+		return null;
+	end if;
+
+	if new.bump_time != old.bump_time then
+		feed_event = 'bump';
+	elsif new.sticky != old.sticky or new.locked != old.locked then
+		feed_event = 'update';
+	end if;
+	if feed_event is not null then
+		perform pg_notify('board_feed', json_build_object(
+			'type', feed_event,
+			'id', new.id,
+			'board', new.board,
+			'bump_time', new.bump_time,
+			'sticky', new.sticky,
+			'locked', new.locked
+		)::text);
+	end if;
+
 	return null;
 end;
 $$ language plpgsql;
@@ -25,6 +63,11 @@ create or replace function after_threads_delete()
 returns trigger as $$
 begin
 	perform pg_notify('thread_deleted', old.board || ',' || old.id);
+	perform pg_notify('board_feed', json_build_object(
+		'type', 'delete',
+		'id', old.id,
+		'board', old.board
+	)::text);
 	return null;
 end;
 $$ language plpgsql;
@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x96\x0d\xdbX\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01\x0d\xc4|f\x94\xdaWT\xd3Q\xd7?\xf8_*I(&\x01B\x02\x08\xa1w\x0c\xbd\x88\x1a\x02\xa1\x83\xa1w\x08\xbd\xaa4\xe9j\xe8\x84\xde\xbbJ\x95.U\x9a-\xf4&\n\x88\nb\xa1\x89\xa0\xa8`\xc5\xca\xac\xe7\xf9\xcf;\xef\xc5\xcc\xcd\xec\x9b\xb3\xd6\xb9;{\x9d\x8b\xfdY\xfbkhl\xa0\xa9\xe5q\x1b\xb8\x0d\xfc\x00\x00\x10\x08\x04\x02\x83\xc0\x00\x08\xfc\xdf\x13\x02\x06A\xa0 \x08\x14\n\x81\xc2\xa1 0\x14\x0c\x81\x81@0\x08\x08\x06\x03\xc1\xa1P8\x1b\x1b\x1b\x04\xc4\x06\x03\xb1\xb1\x81\x11\x08\x00	\x05\xa1\xc0 \x14\x12@\xa1\xa0\xecp\x80\x13\x06pr\x00\xc7\xd8\x004\n@s\xa1\xd0\xc7\x00\xf41\x10\x16	`9\x00,\x1a\xe0c\x07\x04y\x01A<\xa7\x10\x1e \xe2\xa1D!\xa8\x84\x18RV\x9aC\x8e\xc8\xae\xacxL[\x85OG\x05uZ\x87GO\x0boxF\xd0P\x97\xdd\x8c\x8a\xb16\xe1v\xb0\xe4qw\x94\xa6;\xe2|\xddI\x01\xee\x82\xe7}\x05/\x9e\x97\x8c\x8f\x10\xbb\x14m\x94\x10'\x96\xca0a^Q,\xc8:]^@\xad,T\xac\xa9 \xd5T\x187\xd7\xc8u4\xab\xf6v\x04\xf6\xddT\x1e\xe8\xb2\xba\xdd\xa3\xca\x1a\xb2\x1d\x1e\xd0\x9ab\x9d\x9a\x9f:\xf5zY{wS}o\xd7ro\xd7\xf3\xe3\x9e\xcd\xfe[\x9b\x83\x8fg\xbe|\x14\xfa\xf2I\xf6\xdb.\xff\xb7\x03\xc1\xef\xfb\x12?\xde\x13\x7f\x1c`\x0e?\xb3\xfd\xfc\xa4\xf3s_\xe0\xe7\x17\x8e_{\xec\xbf>\x1d\xfbu\x00\xff\xf5\x15\xf1g\x1f\xf6\xe7\x0b\xfc\xcf7\xd8\xdf\x8f\x98\xbf\x9f\xd8\xff\x1e\xb0\xfd\xfd\x0c\xf9\xfb\x05\xfc\xf7\x0b\xf4\xdf\x01\xec\xdf\x01\xef\xbf\xcf\xc0\xd1>\x14\x04\xc0A \x08\x08\x04\xfbOs! 0\x00\x06\x83\xa0\xe0\xff\x14\x14\x0c\x86\x83!\x10\x08\x04\x05\x81\x83\xfe\xd3l\x10\x14\x0e\x86\xb0\x81@l`8\n\x04A\xc188`p.(\x18\x0d\x01\xd0p\x08\x16\x0e\xf0p\x80x\xb9\x00\x1c\x1b\x80G\x03\x04, \x80\x02\x8es\x02\xc71\x80\xb8\x00\xbb\x94\x00XI\x82\xddTO\xf8\xac\xb1\x88\x1dM\xe2\xbc\x8fLx\xb0\xe6\x95(Uf\xe2\xa9\xecT\xad\xbcL\xcb\xd2\x02\xbb\xea2\xbb\xe6z\xcf\xd6z\xa3\x87\xd3\x89\x8f\xa6\xfd\xe6\xa7\\\x96\x1ez>\x9d\xa7\xbczf\xf5f\xcd\xf4\xdd\xa6\xff\xbb-\xda\x87\xed\xd0\x0fo\x83?\xbc?\xbd\xbf\xed\xb9\xff^\xf8\xcb\xae\xee\x97]\xdb\xaf\x07\x1a\xdf?\xd9\x1d~\x90\xfd\xf3E\xff\xef\x01\xfc\xef7\xd4\xdf\x1f\x98\x7f\x9f\x10\xff\x0e\xc0G\x9f\xff\xfbo@\x08\x10\x88\xf3\xbfOD\x82A00\x84\x0b\x02\xc0\xa0 8\x14\xc4	\x07\xa3P(\x14\x16\x06\xf0p\x00<\xdcX\x02\x1b v\x0c\x10\xc7\x01\x9ar\xe8\x980\x8d\x9ctL\x7f\xa7\xe3\xbd\x81\xe0G\xd3\x17V\x9e0^.\xfb\xbfy\x9d\xf9f=~\xfb\xb5\xdb\xcez\xf8\xde\xf6\xe5\xef\xefu\x7f}\x94\xfb\xfd\x91\xff\xef\x01\xea\xdfW(\x01\x01<[\xf4y\xbet\x9e\x8f\x07\xc9L\xe4/\xca\x12\x1b\xbeM\x0f\xf6\xd1\x1e\xbb\x1f5\xc1r\xc6#\xa1\xc7\x8f\x81\xff\x1ep\xe1\xb1`\xf2)0\xf0\xff\xa7D\x8e\xd8-\xa86\xd6z\xba4\xaa\xb2\"	\x02\xfa\xcf\xd5?ICc\x03\xa2\x8fw@\x84\x8f71* \xc2\x9f\xe8\x1f\x11\x11\x12\xae}\xe2\x84O\xac_\x80\xaf\xa2\xd7\x85s'\xbc\xc2.\x84\x00\"?\xa1H\x04\xf0\x06\x90\x17\x00\xb0@\nP\x0e\x00\x88#`\x16)h\xd4Y=\x9c\x04\xfd%\xe82\xd5Y3\x9a\x86\x163\xa9\xd2\xcc\x7fD\x04\x81\xa5\x03_Lu\xd5M\xa6q;\xe8\x96+\xeb\x82\x92r\xa5\xad[\\\x1c\x1f\x0e\x17+vU\x91t\xd9@b\xb3\x95\x9a\xe1\xb8\xfa\xfds\xd9e*d&G\xc26;\x0bp\xd7.\x94}3\x05d=\n	\xdd2\x92\xe8\xf6z,)`P\x1a\xf8r\xa63\xa0R\xe3\xecp\xce\x01x\xfbM\x16\xdeq\xb0\xe5\xe5\xbbb>\x1b\xd53\xaf\xbf\x87m\xbe)n\xe0	\xfa\"{\xeewI&\x7f\xb3\xe7\xeey\xe6\x9b\xf4\xa4W\xa3\xf2?\x13{\n`\xe7\x97]r.b\x0c\xe2{\xc8\x08\xaeR\x15\x9e\xf9\x00V\xc0\x92R\xb6\xcb\xf8\xf3\xcf\xbc!k\xea\x1d:4\x03dM~\x9d\x98\x93gP\xf9\xad\x84\x1e\xbf&IY\x06\xfe\xcd\x8f^\x82\xba\xa7!\x03\x98/8\x0cL7(\xb5\x95\x81X\x9f\xbf\xb9\xa4\xc5{\x81`\xa9\xf0\xf8\x98\xe6=\xd3\xdcuJ\xd0\xa1 \xc4(W\xe3HU6voS\xf5B\xa7M\xfae\x816q\xeb!\xcd\x955l\xa6\xe2\xfc\xa3C\x05\x0f\x7f\x12\xf9\xcf\xc8V\xfd\xd5(~cM\xdd\xf9W\xe1\xf5+V\xc9\xec\xcb\xf9\xeb\x82\x97\xe9\x0e\x0b\xa3J1\x87R\xb4\xb0\xa4\xf4\xd1\x19\xb9S\xe5\xdb\xeb\xee6\xaa\xb4\xb5\x84\x07\x83>\xfa\xec\xa0\xd3\xf0\x1a\xbe*\x9eZF\n\x90\xcc\x18\xcfz\x15\x11z=\xad\x1bf\xb4\x9a\x9e\xb7\x1e%\x8c[b\xd0\x98\n\xdb?\x07\xd8c\xfb\x96\xb0[\xa1\x06\xba_\xa8\xf9\xac\xbf\xdfm/\xe8\xf0\xa83\xbd\xbbi\xac\xba\xfc\xb3\xd42s\xf1\x13\xa11u2I4\xbb\xa5c\x1d\xfa\x98gi\xd7\xbb\xd6\x8c\xa4\xecH\x06\xcfr\x8a\xf3\xa5\x1b\x04\xb2\x07\x95)\xc1\xcfC	0u\xb4 <A\xbbSg\xa5.c8_\x19\x96\x9f=\xd7\x00\xd3\x88Z\x8a\xd9	\xf0\xd9]\xccV\x17\xaf\x9a\xdf\xc9\x96\x03\x0f=\x05Mx[\x0bW2\xd4J\xa8\x12\xad\xc1\xef\xea\x14\xfeJ\xf8\xbe\xe5\xd5\x1b;\xaaNj6\xa1q\xebe{\xa6\x86\xc3\xe4\x93{QX\x03\x83\x14{\x0c\xd6,\xab\xc2\xb5\xd9\xcf\xb2\xee]\xcb\xbd\x16\xef\xb5zF(xTJk\x87$\xe7\xd1]$\xbd\xad@\xd1\x9c\x0f\x0e\xa5\xc2\x9cY\xe8\xad\x92\xca\x07\x96\xebB\xdf\xdc\xd9(\xfe\x0bo\x93\xac\x02E\xc7\xd0+\xb5Q\xe2=\x05a\x84lN\x9a\x03\x8c\x82>\x131\xcf\xe4\x9b\xbc\xdc7.\xb9\xc6t\xc6*!\xc6\xe8\xbc\xc7\xa0\xaf\x05Rmy\xecSJB\xd7n\xf4@pA\xf7L\xed\xd0\xd7\xa2\xb1\x9cu\x03\x7f$\xb6}oR\xe4\x8a\xe3\x1fPC\x9f^F\x86T\xc9y\xdbU\xf9\x84w\xf0\xe9\x90\x01\xae\xb3\xc9\xc6-\xd9\x16\x18\xdd\xa4\xcb\xc3\x13d\xc8M\x15g\xa5\xec\xb9\x82\x97?Uf\xe4N\xb5^\x90\xa4}=\xa4\xa2\xd8\x05CL\x92\xce\x18\"y\xf0\xe9<^\x86\x9d\xe5M\x1bk\x0f\x12N\xe9N\xa3\x1c\n\xc3\xcd\xe3N\xeb}\x9b\xd1|J\xa7g\xaf6\xce_\xe2\xd4z\xd9t$\xbf\x12+#0\x08C\xe7\xbb,\xf6'\xd9\x0d\xf8\x0f\xcbc\xeb\xfe\x96\xc2v6D\xcc\x84\x95\x972\xa5K\xbf5\xf4\x0d+\xb7G\x84\x07(X5\xfd\\\xe5]\xf5\xf6\x97@d\xa5\xef\xa7\xf98\xb3/\xbb\xde+\xeb\x80\xf82\x0b\xa2\x89#\xea\x1fc\xe3?G\xe1\x98sd\x15\xbf\xb8\x0f\x87g\xfb4\x93\x86>he~\x86)\xec\x98}6\xfb\xc8\xe5;l\xb2\x9c[\xbd\xa1\x84o\x97\x07\xe2\"\xbbU\x9e>\xa6wk\x8a\xa5\\\xf5\xa4$~!!\x17B%\x8ar:\xf8=\xc4Z\x05>\xebaT\xc68\x0b\xb7\xdb\xf0qN\x1a\xb7\xce}\xaa\xc4\xfd	\x87\xa7\xdd\xbb\x91\x82\x91o\xf4\x1a\xac\xb00\xdf\xa1\x01tA\x16\xc4.\xc4\xecf\xaa\x063\x93\xb4\x07'\xcb\xd9\x1enA;\xc4\x16dB\x8c\x0b\xc1H\xae\xb4T\xb9t/\x81\xe7C.L\xcf\x1e\x0e\xbd%\x8f`mg\xf8\xb4\xe4\xf2-\x952\x12\x1a9\\\xfd\xb8s\\\xad\xf1\xd2v\x92\xe5*\xe3\xc1Q\xf2\xdb\x12Rz\x8a:/\xde=V\x87\xa5),\x99\xe7\x7f'\x9c>\xe6o4jT\xf5	6\x84\xd6\xbb\x80\x95\xa2\xc8\x86\xe4]\"g=G\xfb\xa9WrH\xa4CJz\x8eq\x06$0u\x0e\x13\xa2\x08\x08!\xdep^\x18\xaf\x19\x17\xd9\xef\xfdC\xf1\xaf6eO\xb5\x1aQ	\xcf\xb1\x98\x03\xee{#F\"\x9e\x05e\x89\x98L#*i\x89\xac\xc1\x91\x85\xf9\xb9]&,\x08\xafN\xc85B\xcf{\xd6]C\x89\x8b\xa4\xf8Ma\xe3\xe0\xd3S\xbb\xe6\x1ez\xe6*\x90\xa36{\xd2\x19sD\xce|\xddV\xdf\xf3\xe84#_\xe5S\xdf\xb5\x0b\xe4\xed\x80\xca\x89\x14\xff\xa2\xc9\x8al\xa4[\x17\xe5z\n+\xb7;\xd8R\xe1\xc7\x1a\xc3yF\xd9>7kc\xdb\xaa\xfe\xb8\xff\xd7Ezk:@b\xfa'\x1b\xe8x\xe1\x9a\x9b\xaf\x8aC\x8d:\x0d\x88\xe19N4\xc9!2Y\xdc\xe0\xf4cz7\xd9a(\x82\xdbTY_\x9a{1\xcfA\xaa?\xe6Hj\xc4\x12\x80|\"\xdb\xee\xd0\xa6\xabF\xe2\x17\x99\x83V~\xc2.F\x89._o\xa8~\xfac\xae\xba\xe8\x87!k=\xaa\x91\\\x85q\xeaxT\x8c\x85\xa6\xe2>m\xe8\"_\xa5\xa7\x07hz\xe4\x7f\x0c\x06k\xb7H\x1a\xf2\xd7n\x14s\xc93\xff\x8a\xc6\xd63GB\xc0\xa5e\xd3\xda\xbe\xfb\xe3\xd9\xb5Ku\x0b\xa0\x00\xba\xbe\x13\x83//\xf3\x92\xf4UqNf\xe7\x83\xe4\x84\x82\x1b\xbe!`\xae\xcb\xf97#\xad\x006-M\x17\xec\xb5\xf9\x85\x0b\xcc.=\xdd\x010\xba\x8d\x92\xec\xa2\xfe\xb1\xad\xbe\x82h\xad\x103]Oy\"\x15$!c8\xcb`<\xf9KD\xd4>n\xb4\xbf\xda\x00m\x14\xf7 \x04<h\xfa8o2-\xc1\xa2'P\x03('\x9f\x18=\x1e\x98x$\x8f#\xf1sZ\xad\xdbU\xe7(C\xe4\x88\xdb\xf4\x97\xc6&\x8e\x8b\xe2\xa0R\x0e\xf8\xc9\xb7\x894G\xa1\x04\xec\xab\x8d\xe3>\x8eT\x8a\xd8\xdd\x8fu\xd5\xebp\x93\xc7\xbf\xec\x84\x8f\xda\xc5.\xb3\xb7\x93qZ\xa6\x8f\x1f\xdd\xb4\xc6\xb59\xb5\xe5\xbb`\xd2\xeb\xbf&\xa8f\xd2S\xa0'\x9d\x9d\xff\x1c\xaa\x17\xb4\x93e\xcd\xafv&\x9c\x8c\xcd\xc4\xb5Q\xa5C\x7f\x8cP\xa8\xd8\xbeA\x17Qkk\xee\xda%\xbf\x02\xf1\x1b\"\x07p6_\xa5\xd1\xc5\xe0g\x03\xcdN\x9fx\x02\xd8_5Ui\x14L6\xbdu\x06\x118\xde\xe3>3\x84b/X\x89\x0e\xcb\xf1<--\xb8\x85?!\xf4\x95\xdd\xf6\xdfH\x01\xa95:\xf0{\x89\xe8M\xbd\x96}\xd6\x19\x9bN\xdf\xe1\xcc\xb1\x96w\xb5\x84\x81J\x1e\xe2-pK\xcaSp\x02^\xbd\x80'\xde\xcfTO\xed\xd9\xf0\x9d \xb3\xc3\xa2U[\xec{]0\\\xf1\\\x86^\xb3\xe6W\xab\x84.\xce\x89\x0f\xcb\xcf\xb5\x1e/vQ\xc1\xaf\xee\x92Rg\xc1MC\xaf\xf2\x93n\x99\x93\x8c\xdd\xd3=\xfbi\x1aV<\xf7B\x93\xef\xdf\xf6j\xdc7\xf1\xbb\x13\x0e\xbb\xb4\xd0\x9c\xb9\xa8\xf5\x93kDm\xee\\\xae\x0b\x00\xe8E\x1cM\x9eV\xeeQ\x15vZ\xfd}Yj\xee\xbacO6'\xc0n1\xec8\x8e\xf7\x0f\x1b\xd87\xb3>Y\x17\x93\xf7R\x07\x1eD\xea\xbbI\xfeZ>\x1b\xb2m\x9b>F\xddI\xe7\xaf\x98\xd7\x03>\xd4r/\xf2z\xf5\x06=\xc8\xa9,\x96\x97\x98\x03L\x9e\xfdXLz\xd0\xdb\xc33\xb1\xb3\x11\xbc\xfc\xa8\xfb\x81U\xcc\x81^\xa0u\xf8@B\xc1\xc9\xde\x0be\x15x\xbbc[\xd2\x1d\xe7}\x16U\xe9b\xdb\x8b\xfc\x90\xa6{\xb7\xd2\xce&e4\xf3k((\x10\xaa\x1d\xb3?<\xf71\xb27\xbbu\xf9ss\xe8\xdc\x03\xa5\x19]\xde]\xf4\xd3\x1e\xa6u\x1au\xee\xd9\xe2Sx\xd8\xcd\xee\xbf#&\xf7\xbf\xdc{\x80\x80\xaa\xcd\x91\x93\xf4\x96\x13\xe4H<\x93bN\xeb\x19\x94\xe1\x8a\x8c\xdeA\xf5\xd2e\x05DTe\xe9\xea\xeew\xa7\xbb\xe3\xe6\x1b\x07\xa7\xbc0b\xfbc|\xceC\xed\xc9\x8f\xf2\xd7\xda_v\x18\xbb\x1dn\xb2\xc6\xc0\n1Jd(\xf3-\xc4\x98\x05T\x9d=j\x1f`\x81\xae\xeem\xfb\x86\xcf7.\x9c\x9d:\x0e\xb8\xc4=G\xb37\x18\xe9K\xee\xde9\x9f	\x89\xbf\x9b\xfeR9\xda\xdd\xcdt\xfd\x15e\xfb\xba\xb6~\x1f\x9c\xeb\xc0\x91\xcd\xe5\xe52\xd7p\xbdZ\xdeA\xbf\xef4\xe8-'\x9a\xad\x9b\xed\\\xfc\xb1\xdaE\x8c'\xa9\xda^\xc8\x8f\xd4~x\xcc(N\xc9\xbf\xe7_1g\xfc\xe0\x13q\xdc\x93\xf6i.\x91\x9a\x9b\xcbkl\xee\x82W\x02\xeb\xaf\xd9.a>\x8a\xbb\xe1n\x95[D\x9dQ\x00\x7f\xaaSD\x19O4\xba\xf9\xb4\x8d@\xd3e\x0e_\xd4|\xd4\xbc\xf0\xac\xfcZ\xdd\xc6H\xac\x9a\xf0\xcf\x1c>\xb77EG_\x86@U\xb0o\x89\xaa\x90\xfc+\x93\n\xa7OH\xda\x92=\xfb\xb4q\x97\xc0\xc5\xba\xe2\x9ce\xcd\xb0\x987\xe7\xc4\xf2\x89\x90k\xec\xce\xdf?\xca\xd3?\xd8\x17\x02\x8f\xa2\xf22\x1a\xb8\xd5\x9a\x12\x1e\xb5\xadW\xca\x1c\x1f\xe4\xdb\x13l\xef\x84pM\xf9\xfe|\xc06+%?\xaf\xb1zT\xf1\x84q\xd6\xec\xe8}u^\xf2g\xe1\xa2\x02\xe1O\xef\xef\xf7t\xfc\xee\xbcx&\x8c\xa7A:%\x9f\x94\x13\x9a\x9cxN\x8e1\xd1F\x08`7]:\xc3{\x8f/\xee\xcaw\x0f\x19Z\x9c\x06\xec\xdcp\x81O{>\xe4\xe3gl\xdb\x0f\xb5	\x15\xb0\xe7w\x86\xb7:<\x94\x12*\x07F\xda\xac\xf1dV\xab\xa8\xf0)*(\x91\xee\xa7Y\xc4-\xe9]x\xea\xf2\x847\x98awB\xac\x1a7\xf8\xe4\xa2\x82\x92\xf2o\xfb\x98\x874!\x95tz\x99\xa5\x10\xa2!F\xf91\xec\xaf\x97\xd7\xa6\x07D\xcc^\xf9\x16/\\|\x0d\xf1\x84\x1e\xc3?\x89\x17#\xa3B\xadMz\xeaL\xf0\xa4\xf3\x1a\xb8\xcb\xaf\x9f\x81o\xab%\xb6\xd7S\xbb\x00\xce:\xba\x1e\xf5\x90\xb3o\x1f\x94Ag\x18\xee\xdb\xbc\xb4<\xe1\xb0\x8f\xd8\xb4\xc3\xccWK\xaa4(\xefD@\x08\xc4\xe4\x97\xc4\xe8\xe2F\xadS\x08\xf8\xaf\xd0\xe3\x96\x05\xb0\xd8ng\x0do\x11\xbeE~\x99\xb0$\xa48{\x90\x04\xb2\xb2\x96\xfa\xe5\xa8\x98jO\xc2\xfb\xd2\xd8S\xf2%e\xeaa\xe7\xc6\x18>K\x884/J\xb2\x11z:TXd\x91\xc3\xf6\x87\xc5j\x0dl%\x99\xf0;\xdfh\xad\x07f7/Z\x99D\xf8\n\x8a\xbbQ\x0b\xcb\xdcA<\xf3sLN\x17I\xb7AP\xd8\xdc0#\xa8\x1e\"\xcc\x86e\xd82\x80\xb8J\xce\xb2e\x84UJ\xb0\xf5\xd1a5\x8f\x99\xb0\xaf\xcc\x94\xf48.#\xbc\xaf^\xc2\xa8\x98\xc4#\xbc\xd4\xb5M\x95\xc7%iSz\xd9\xa5K\x90\xd5}j\x90\xe6\x05\x0f\xe9\xb4\x06[\x9f3_\x9c\xb6\xfc\x11\xd4\xfd\xc0\xae}Xa\xde\x19\xa7\x81\xcb{\x8d\xf0oS\x08\xd2\x948$\x8fK\x0e&\xd1\x87\x86\xbc'F\xfe\x966=\x8b\x86\xd0z3\xb1\x00\xea\xd7&\xb73\x03\x92\x8dfxnp>YL\x7f.m\xba\x1d\n3\x0fQ\xda\xd8\x10\x06\x18\xc8\x05\xdaE\x86\x03\x08\x9dt\xe9%\x0bR\xb9\x8f\xf4\x90`\xb0\x8c\xa0\"\xe1\x0e\xaf\xa3\x80\x0d]@\xe9\x88\x96qf8\xeemd\xbc~\x13U\x95\x04\xa9\xeb\x84j#\xb3LC\x9c4!\x19G\xf80\xbd\xda8\x9f\x9a+\xf8t\x8b\xf9\xb5+qu\x89\xdeR\x0e}y\xa8o\xf6WtY\xe7\x10\xa1^\xbe\x1bf\xf5K\xaa\xd1\xe9\x97Q\xe2\xc8:\"\xfb\xb5M\xad[\xb4\xa2(\xab\xdc+\x9f\xcf\x96\xd8\xa4_-\x895\xd1d<t\x80\xe42tZ\xc2Ov\xee\x972\x90e\xb3\x08\xf6\xc4\x89\x00\xb5\x80\x94\xc8\x15\x91!\x0d\x86\xe7\x0eg\x16\xd8h\x12_N\x12Cp~-\xde\xf9\xc2,P\x8d\xfe\xc8\xe3\xe0\n\xe7\x1a\x82\x92\xcb\xf6!ekhS\xe3xQRrE@\x82eY\xeelCn\x16/\xf2\xca3\x884I\xa8!\xa0\xb4\xed'\xf7\x9fBD:[\xd6\x8c\xee\x85tG\xc1\xb2]q\x99F\x8a\x8fR\xc6mB*Z\x1f\xfe\xcd\xa0\"\xb63S#\xa4\xb4\xc8\x93=)\x01\x16\x1f\xe1\xd54]f\xf3\x0c6k\x9c\xed\xda\x85\xb4s\xd4\x8fOdN\x1e\xf5h\xa1\xeard\xec\xc8\xc6<p\xf6\xa8\xc2[\x8e\x82\xd7B\x04\xaa\"\xce\x18\xe23\xd7\xfb\xd9\x1e\x00W\xbf\xd9\x92\xc3\xfa\xaf\xfa\x82\xaf\xbcO\x94\xf4\xc6X\x8c(U\xdf\x8f\x11\xd1\xfa\xee\xe7\xb2\xcf\xd1l\x15\xfb\x87\x10C/a\xc0Cynb\x90_\xa6y\x0e\xa7+)O\xe0\xb9c\xd7P\xdc5\xf7|k2\x1d\xdd\x15h\xa9\x12\xddy0\x93\x14\x08\x9a\x84\xe6\xb7\x11qJ\xd6&\x83\xaeK\xe2\xb8%\xa4\xbf\x8c1\xcb\xf3\x14\x17\xf2A\x84\xe7>\n\x9e\xf1~\xa4:\x11?U\xb1o\xc0A\x1fT\xb8\xbf\xf6n_\xdd\xdbA\xa8\xc8\xba*\xf9\x97\x8c@\x19\xe4\xd0\x8f\xe0\x93\\\xdf\x1aYc\x97\x06*\x99w\xf2\xda\xafW\x94\xe6\xaf[\xcf\x7f%\x0e\xb7\x7fv\xcd\xac\xa6N\xa1\xa2\x01\xf3\xb3\x8e#\xed\xc2\xbb\xce\xb4\xe3Z`\x11\x82\xbe\x03\xca\xf6O\xa1\xf7\xc2\xe2\x8d\xc3	\x81`,\xac\xcc\xe3\x06\x8feD\x00\xbf7\x81\xd1\x18\xfc\xae\xfa\x14:-\xc2\xa7A\xe8H\xa4\xa9\x9fi\xe1\xb4\x88m,:\xe9\xd6\xc2\x07T\xeb)\xd8\xde`\xfc\x92\xa3\xa86a\xc6cR\xa2=o\xd3\x9aK2R\x16\xdfr\xa7\x0c7\xf1\x0e\x93\x10\xcc*\x93\x00Nwr\x8b\xc8$\xb8\x93\x0d\x7f\xedqB\xe5s\x7f\xc7\xc3&\xffB\xfaI\x91\xd2\x88\x90\xc2| h[\x97=R\xb5\x11\x9b/x:\x96\xfe\xc9\xab\\\xf4'\xe2T\xf3\xd9\xd2<\xb5\x83g\xd7\xa6\x0f$\xb6\x07\xcf\x1du\xd9\x97\x04^\xca\xd02z\xa9\xc9\xc9$\xb7\xff\x85]~S\xae\x89#\xa4\x82'\xaeBu\xc1+C\x92\xab\x98\xb2\xdaj:K\xb9\xe0fgl\xf3A\xfb5\xedKM\x937\xd9jd\x9a\x7f\xf2\xb4U\xa3 j\x9d\xf0 \xd9\xc7^\x97\xee\x0c\x19|\n\xa9%-\xc6\xd5\xa4i->\xc9wOM/\x1a\xbb\xf8\xef\x97\xb0&\xad5\xd0\xde\xeewE4\x17\xbb\x18x\x1eI\x9eo\xe9g\xb6\x0bg^\x86\x16t]\xf5\xed\x16\xae<\xb5\xfb\xcb\xee\x15\xa9C\xfe\xa4\xd0\xb7\xa3\xc6\xa6\xd31\xd2\xe1\xe1\x9c\xf5\x9d \xc1\xd6H\x91\xd9\xab\x13k\x99nc9\x0d\x1bx{4\xac\xfa\xb1\x82\xe3c\xc4\x99\xb8\x18\xb5L\xc1\xbb\x14i\xeb\xfd\xd2*\x82\x89\xed*\x8a\xb6$\x0c\x9f\xa7\xc9\x98R_\x022\x96\x95m[&\x17C)b\xa9\x06\xc0\x87\xd8\xab\xdf\x1a\xfc\xbcnc\xf6\xfdt\xd7\xd9\xda\x97\xdf:\x82\xf6\xfb\xd4\xb7\xbbo4q\xa93\xfb\xe3\xd6A\xbf\x7f\xc4\xb8\xb2\x82.\xff\x0eV\xaeM	\x92\x95Lv\x1e\xd0\x9aI\xc7\xc4\x89w\xabT5,\x8af\xfd\x0e>\x1f\x10\xce\xdc\xee.r\x1e\xd4\x8a\x8d\xbf\x1c\nsH\x08z\xdfS\xe69\xde^\xb5\xdatk+\xc7i\x0b\xfcD\x0d\xad\xbc\x94\xa3A\x037:\x13G\xd1\xe0\\\xff\x13]4\xe4\xd1\xd2\xcd\xc3\xc1\x9a\x9e\xd5\xa0\x1ct\xfb\xc6J O\x08\xf2\xec!\xc1\xea\xd7\xed\xe2\x03 \xe7\xbd\x80\xd6<(\x84Ol\x81\x1fn\xd4x\xe7!\x05)\xf3\x13\xff;\x19-\xf8\x1e)\x16x\xd7\xf2R\x92\xa8~D\x11|\x9e\xd7h\xbc^\x97\xfd^/\x93\x9a\xad\x87',\xf0\x16\x8d\x1by\xce\x0f\xbd\xab\xc4\x9aw\xb7:\xef\x9br)9\x19\xf24\x7f\xbb\xed\xaf\x9cG\xc1\xdf\xbe\xaf\x7f\xe3\xfe\xd1,\xff\x97a8\xc2\x19t\x99!h\x1eq\x91-\xf8~Y,\xdc\xa0\xa4\x0d\xf6\x0d\xc0\xc8\x0f/\x84G\xf2\x0eS\x08\xf2\xc3\x97E\xfa\x12\x9c\x86\x85\xff`J+z}j1\xec6#\x88\xbd>y\xb3\xba\xe3\xfb#\xec\x18\xf4\xff-\xf2\x0d@\x9e\x1f`\x07\xa2\x80\x1b\xff\x15\xf9\xf8\xff\x88\x1c\xf4U\x8b\xf1?\"7:\xa8\xa6@Q\x80\xcd\x7fE\x9e\xcd\xa7\xb0\x15\xad\xbf^7](\xad\xeb\x96\xd5 \xf14-K\xc6k\xa6\x88\xb0\x98\xa5\xa8\xd0\xc3\xb8S9\xdd#5\x99\xf7\xfai\xbd\x167<\xb3\x81\xc2\x7fI\xd65\xb0S\xb2Rs\xfdZdW\xa2\x8c\xcc\xe3\xd6\xd3W\xefad\x1b[1X\x11\xfe\x07\xc3&\xea\x92\xf3MV1\xe7`^\x0d8\xb5V\x9f\xcd\x7f.\xe5\xec-/k\xbc\x92'\n\x12to\xbe\xce\xf0\xfb\x146\x1c\xf4\xd8\x9cO\x96W\xe8\x8f\xeb\x1fR\x88@\x88a7\xfan\x9c\xa4\x9dB\x10\x18\x1ft\xde\xe4\x99bj\xa8\xcf\x08\x8f\x9bs\x91A\xd2\\\xd3\xb8\x16\xdcO\xf4`p\xdau\xe1\x1d\xa5\xca\xe9\xcb;\x059}j\x10\xc5\xf7\xfd\xa6k\xa4\xd23\xb7;N\xa5\x0d\x01\xc6\xea+\x9f\xaf\x96\xcc\xf1\x9e\x0fI+Tx\xc5i\xdb&R>\x16\xd1\\Yb\xdaf*`s\xec(/\xe7\xd6a\xf1\x07\xba\xc14\x8d\xbb\xf23A\x06\x8cx\xc1\xb4\xb8\xe6\x17\xba\xe0\xe6\xce\xc1\xf5\xc1\xed\x99\x1a\x02\x82\xa6\xbd\xb6\xfaa\x9eJ\x95\xa6L%\x1a\xc4%6,\x8e:@|\x9f8^\x19$\x1f<\x14\x9f\xb5\\\x86qO\xcd\xf3\x89\x98\xc0\xfa\x9e\x8d\xab\xe5G\xd7\xacsg\x91\xbe\xae\x9b\x89\\\x86L\xc4\xb4\xea\xd4\xb2\x07\x7f<\xb7\xc0<!\xb7\xa8*\x12N\xaf\x07gF\xb9\xb4}\x1eAK*kzi\xeaF\xef\x94\x16h\xcbB\xe1QE\x12H\x88`1\xd5\xcb\x98\xb7{0\x0d\xe2%\x12\xb3h\x04O\x94\xbe\xf5\xfc\x82[t\xc1\xb46\x7f5K\x0f\xd7\xaf\xa9\xd7\x90\x7f\x96\xda5R\x80R\xab\x9d\x95\xa3\xda\x89m\xe7t\xc2,!\xb8\x15\xc6\xe7)\x9e\x15\xf9/\xb0+\x7f]\xb1\xf6\x8cX\x8e\x02\x13\xd3\xba\x9a\xe1\x84d\x0b\xca\x8a\x1f\xe7`\xc80\x8aM\x80FG\x88\xabZ\xd9AD\xedST\x11Zf\xf2\xfe\xe2\x88\"\x83}\xef+\xc6\xbf\x8e\xc8K\x1e\xdc[5\xban\x1f\x9b\xa9p	\xdc^3K\xa2+\x1a$\x13\xde\xd5\x90\x90\xc2\xf8~\x80t!8\x08v1\x13\xbd\x18]\xd9\x108\xc7A\xe0M\x1f\x06\x83\xad\xeeE\xd8\xea.q\xdc\x8c\xa7\x80\x87\xe6_*qs\xf5nM\n\xe8T\xb61\x81[\xaej\xd2@!\xf5A\x94BJ\xbff\x17\x049	\xcd\xcb\x126B` \xb8\x11O]\x98\x8a\x19\x8a\x07\xab\xf1r\xea\xb6\xbc\xfd\xba\x8b\xc88b%%A>sf\x06~\xe2J\x1f\xf4 \x11i\xa5\xdf\x12\x94m\xf4\xd7\x19\xac\xe0T\xb6\xfa\x04M\xf2\xa7\xebB\xf7pc\xfe\xa8\xf7j\x8d\xef\x9cr\xe9M3\x99\x1c\xbdq\x98^)\xf5M_\x7f:\xeb\xf3\x03\\z\x81\xf1\xca\xd0\x8b\xc4\x0dRW\xef\xbc2\xd5\x13\xa2\xf4n\xfa\xb1\xba\x1fhB\xdb\xd6e\x95\xda1\xad>\x04RP\x8f\x8a*\xe7\xa2\xe6\xe8\x8e\xab\xec\xe6\x98}\x8e\x0f\xe7\xf9\xc2\x90\x93}\x1b;+u\xa3\xaf@\xa4\xcfc\xe0\x88a\xccuNO\xc2\xb4\xd5{\xf4\xa7\x00_\xc7\xf2\xfcE\x9f^\xa3D\xb5\x14\xba\x10\xfa\x07$e/\xed\x9c\xe0\xc0\xa0N\xca\x9b\xb1\xf3\xdf\xc6\xe5z\xaa\xac\x14=\x12\xb9\xdd\xfaV}l\xd6\x95\xaf\x05x'\x05\xbcM4\xde\xdb=\xf1\xd96\xef\xfb\x9b\xb7\xf9\xde#\xbb\x81w\xac\x8a\xc5\xe2\xf4\xce\x0d\xad\x1f\xbb\x0fve\xfa\x0f|\x1e\x1e\x1d\x90;\x9bv|\x84D\x1f\x9f\x1b8\xd1o\xae\xa2\x8c\x16\x81\x0d/\x0c\xed3\xa5\xad\xef(\xcb\xfb\xbc\xe4x\x14\xa71Y\xc8\x8a\x95#\x8ag\x9f\xc4z\x9e\xe0\xf9\xf3&\xc8<\xc1B\x1b\xc6!\xca\xcewBPt\xce2_l\xf8\xcf\xcc\xdb\x1e\x13\xccY\xf6\x94\x11\x8a\x06?\xae\xf8\xbc\xa0\x82\xcd\xf2/\xcaQ-\x1b\x07\x17-\xf7\xb2\x8b\xe5\xd5\xadzF\x8e\xccZ\x9a\x02\x90H\xdd{0Y-\xddV\xcbSL\x9f\x8e\x11\xf4U\xfdN\xde\x0b\xd3C\x8c\x91\x02\xa5\x949>\xd6\n\x00.\x06\x96\x11\x0d0\x9c\xb8gx\xeaON\xb9##\xe91K\x12\x1dv.tL\xbc\xbaK\x80\xfb\xe2(D\xb1\xb7\x06\x03\xd8\xa4\x0d\xac\xcf\xa4F\x85R\xee\x9eW\xab\xb0s\x81$\xd2\x11gA\x937z\xd5\x9b\xc0\x17\xd6\x9e\xb3\xc4\x1d\x0e\xa0\xe1\x0fX\xd8\x82rXS\xfa[\xa1}\x1a\xfa\x8cC/\xbb,i\xad\xe3\xe2\x1a\x95]\x8c\xfc\x9c\xf7I\xb7\"v\xa4F\xa0\x92\xc4\x12\xe6\xef\x04;\xbc\xd8\x88tfRD\xd7\xd2\xd9\xe5+\x0c\x82O0\xaer\xde\xdfb\xd7\xa7\xeb*Ov'\x1f\xea\xe38F\x8cop<%<\x81\xfe\x137220\x13p\x90\x15\xebk\x01\x9f:M\xaaMU\x87\xd2\x19y\xa9\x02X\x1f\x06\xca\xfe\x05\x05\xe4\x94\x82\xfc\x10\xfc\xce\xd2\xf2\xbd\x01O\xa9q\xdd\xd0\xc2\xa7\x82\x7f\x15,l\x15\x93J\x91\xf7\xc6\x87\xc7?\x11z\x8c3@J@<\xce:Aa\x06?(\xda\x9f\x1f\x9e\xcf\xac'r\xbe\xb2\xb1\xf8\x15\xd0Zb\xac\x89\x0da\xf4\xb7Q*\xceC\x96YuZ/K\x8f&K/\xa6\xe3\xdfoI\xc1o[\x9aRod\x9d\xc9C<\xf5\xf2Wz\x10\x17\xb8Y\xfd\xe1\x81\x18\xdc\xb0\x93A\xf8\xe8\x01\xc7\x1c\xf7\x8f\xd7F^c\x0c\x0d\xe3\xc4\xd2\xcd\xb9~\xad\xb5\x9e\xdc\xfah8P\xb4C\xa6\xb2\xd2N\x01\x00w\xd5\xac\x98Q\x80\x1f#\xc0\xac\x86\xbf\xa5\x8c\x83o\x85h`\x9d\xc88\xff\xc0:\xe0\x8c\n\x96(1\xb5\x98\x9a\x1e#\x84\x1d!\xf3\x06]\x15\xa9\x0f\xdc\xdd\xc5_\x15a\xe7\xea\x1d)\xa6\xbc\xdc\xfa\xc2\xefc\xb3\xb4\xd2\xd9\xc2[O6ML\xc8\xb2\x84\x9em\xf1\xfa\x93\x00k[\x98\xb9\xe4\xcb+;S\xdd,R\xf2\xce\xb6\xfd\xf4'\xf6\xd7\x9d\xfa\xd8\x08@\xf5\x9a\xf2\xb8\x0d\xbb\xee\xb8\xe5\xeb\x8cv|\x8e\\S\x89M=\xc9\xbb>\xb1Z\x1f\xd5\xeeq\xdb\x17\x16\x16k1be\xd0\x15\x03L|U\x8e\x1d$L\x91bc.\xb6\xb9\xdeD'\xdc\xdf6\xbf^2N\x84(;/\xcc\xf9^\x8c\xbc\xb2#\xf2c/,\xe3\xf3\x91\xa0]a\xf3\xc5\xb3\xd4 \x0d\xf6\x87C\x85mct\xa8\xaa f\xe0yI\xa0\x87\xcc\xdc\x07\x1f\xa9(\xb6\xd8{v\xdd\x83Fc\x1f\xb2L\xab\x1f+[\x0f~,OV\x93z\xebT<\xf2\xddn\xae\xec\x1c\xff\xe9P\x97-7\xbe\xdbQ5\xa5\xc2\x81\x1a\xce\x9f\xec0\xb1ne0U\x83\x93\xd7r\x93\xebE\x18/'\x88\x0b\xbf\xfb\xdac\xf7\x07\xbd\xaf\xb2\x06=-\xdds\x81\xa7\xa27\xde)x\xfe\x94\xe3\xe9T~\xd6\xbf\x0c\x1e\xc1)\xcck]\xbeZ\x1f\xc907I\xec\x9aY\x9b\x0c\x19\x86\xec\xb5\xbe\xb9\x9c6\xe4Pi\xce\x92'\xd8\xe1\xfb\x1d\xd2\x9c\x05\xdeU\xb7\xe2\xa1\xbb\xbd\xfaUO\xbc$\x88d\x13\xb1s\xad\x1bR\x83\x1f\xfb\x0e\xc3N\x9c\xee8\xaf'\x7f\x1d\xb3:?\xa1)\xb4\xc2\x99\xd7\xb5\xf9\xed\x9f\xfc\x0d\xdd\xb66\xd7Dy\xda\xb07\x8b\x17\xaf\x1e\x96\xb5\xd9\xd8+X\x86\xebTh\xd2\xe5\x89\x1c\xa0\xff\xb2\x8c\x99\xd5wc\x94\xec\xa6y\xa3T&\x8ff\xdf\xdc5\xd2\x0e&e[\xf7R\xca\x93\xc6\x9f\x96\xafd%\xa8v\x10\xa2\\$\xb39g\x94o\xca\x0d\x00P\xb1\xf4T^!\xbf\xed\x80\xa2\x95\x99\xf6\xe8\x13\xd0\x7f\x0f\xfa\xff\xac\x14h-\x1b\xe1'xF<\x15\x10\x1eP\x92f\x91\x8e\xc0_\xff_\xbf\x06'\x12>\x9a\x88\xaafoH\xd5\xb5\n\xec8\xe8\xa3\nO\xfa\x18\xbe%\xc8*+J7\x84\xa7xcb\xf12\x8f\x07\x15~\xde,\x05#>\x11\xe6\xf3\xe1Ph\xd3\x81\x9dx\xe3\xb9\xfa\xe4\xc8\xd2\xb7\x06\xbaP\xafU\xfe\xb9o\x94\xc9?\xde3\xcf\x8f\xa9{Z\xf2M\xfb\x9d\xcf\xcdV\xc8\xbb\x07\xe6\xb9\xc4\xfa\x12\xde*\xca\x8f\xd9\xc3\xbc@-<\x1a\xe8\xc8\x1e\xbeJ\xad\x81\xbd\xc3\xc92\x96\x15\xd8\x10w\x04^%L\xfdp\x9e\xce\x1e\xc6KG\xbf\x14 \x8e\x0e\xed\x11\xceY<\xba\xbdtU\x05\xac\x96V\x81\xa4\xffB\xf3FX!hR\x1f\xed\x12\xa3\x96U\xb2\xd1\xe2\xeca\x07\xc9\xf7\x93\xa1\x02YG\xffB\xdb\x03^\xae\xcb\x8d`6%\xef#\xb4\xde\x9b\x9c\xb1\xa3\x8fL\xca\x1a~\x9ae\x8a\x85\xdb\xe7\xf0G\x95\xa5'\xb0\xce\\k\xbd:y\xc7\xe2\x8e\x87V\xdf\xbd\xcf\xee\xfd\xb5\xf6}\xa43\xb1`\x03\x91\n\xf5_k\xef\xee\x0e{r\xe9/\xd8\xd5\xde\x9eC\xc1\xd1z\xb2\xa4,\x8fU\xd9-\xee\xf7\x0e\x86\xd2N\xac\xef2\xd3K\xb0F\xfcd]\xd1M\x97\xcep\x8f\xdc\xab[]+J\xf1I%\xf5\x82Q\x0fi\x1b\x1b\xcb\x01\x8erY\x8e\xde\xf6\xd5\xe32\xaa\x0d\xb5t?<pr\xad\xd3\x0cD0m\x84h\x17\x1a<\x07\xabY\xe5g\xda\xec\xdd\x8d\xb4\xbd\x9d.)~&\xc68\xfd\x93\x9b#\x15\xc6\xae\xbc`O1\x89\xe2\xb3<!C\xa1|n\x94\x9d]B\x15TC3\xad\xef#\\U\x13nF\xad\xebK-\x153\x95J.}\x7f\xd1R\xfe\xa6N\xa7B\xc1E\x8c\xed\x15:\xec\xdauz\x92L\xcdT\xb3\x9c\x98\x15\xc1	\xe7h\xad%.\xae\x10\xfd\xf8\xa8\xb5>WW\xd4\xc1\x16\xd7n\xb8G\xeb\xb5\xe4\xf4\xd7\xf4\xb0\xd3a\x00\xe8\xcf\x9e\x10\x91\x07S\xaa^<|u3\xa1I\x8e0\xcb\x1a\x8a\x0f\xbb\xe3>vn\x9e\xd4\xd7I\xf6\x8e\x94\xdaiD\x95\x00\x9ch\x1fy]\"T\x83\x04\x11\x0b\x89\x96%\x1e\xbf!*\xc7\xc2\xc0\x94I\x10\x9a\xa4\xb5\xdb>\x8a\xe7\x8d\xb2\xd8<\xaa\x87\x06K\xc6F\x0b\x11\xc9tov\x93N\xae\xf04\xd8\x96\x87l\x01\x91\xff\x9a\x1d\xa2C\xc4G\xe9\xbb\xb131\x91>\"\x93fxy\x0f\xc4\xa6^\xad\x80\xf7\x97\x95\x11?\xb9\xbf\x1f\x85\xbdhb\x89Q\xa0';\xc7\x0c\xd3T\xf0\xd8\x19\xa9\xc8Bz\x9cji\xb8\xf3\x94\x86\x8a\x9a\xe0\x8d\xcf\xf2n\xa3(\xbd\x9ext\xb2+\x8f\xb4\xf0-\x0f\xd9k\x9dq\xe7\xc9\xa2g\xde^t/\x8e\n\xed!xL\x91\xf2\x13\xf1zi\n\xa6d\xa8p\x99\xf3\x10\x03\xfc:\xcc\xdd\x05\x8b\xf5\xc4\xcb\x9c\x1e\x08*\xdf\x00+\x10Q\xdf\x8f\xd8R\\\xd3\xa9\x90e\x95\x8dPL\x8at0\xfc\xb30[\xffY\xc5EF]\x08\xd7\x15i\x1f{\x12\x8a\xcc\x80\xa8\xb0\x08\xd5\xa5\xa1\xfci\xb1\xa1\xddH,\x1fS\x0d\x0c)GC\x17\x18\x97\xd6\xf2$\xee\xe6e\x0e\xa5\xc3\x1d\x01\x98`\xb7k\xc9@\xea\x99p\x1b:\x00\x13*\xbe\x88\x9e\xd4\xbbW\x08!\x93\x12\xee$\xfa+np\x82\x07\xc86\x8c\xc4\xfa<\x03\xb6\x12~\x91\x03\x9a\x02\xdc\xc4O\xda\xda|\x1eY7\xaf\x9b<\"\xf3\xef\xabB\x12>\x16%\x92\x85R\x8a\xda\x1eK\x9e\x8b4\x02\xe3\xb3\x074\xf4\x95\xb9\xb3\"\xa5c\x13\xc4\xd2\xdcl5\xb8\xb6 \x04\x1a\xf4GYF_(F~*\x85\x9d\x1a\x9b\xf2\x8c\xe3oj\xc6{\x87\xbc\"\xee\x13\xdaen>\xcb\x1e\x03\x86p\xd1}\x91^\xbedn$\xfb\x15Z\x12\xfa\xbb\x1d\xf7&L\xb8:\x1f\x85A\xad>\xcdvZ\xd3A\xad\x04\x9b\x88\xcb\xa0h\xc2\xa9\x87\xd0\xa6qG3^\x88\xc4\x11\x19\x10I\xcb3\xc7\x1b\xf9\xae\x81\x94\x99\x1e'\xad\x8d\x9d\xfd\xd9\xa5vP[D\xd4\x92\xa8V1#k\x07\x86l\xdbD\xd8Jk	2\xc2\x13\xf3\xf9\x9c:!\xad\xa9\xd9\xce\x0d\x08\x0b\xa6+\xf2\x07\xcf\xa3\x90\xe4\x98~\x14\x05\xc7\xae\xb6\x9fEW\xce\xc3U_\xea\xd4\xb4\xa1\x82#\xe9\xfey\xef\"\x19\x86\x83\xa8\x0b\x07yMMz\xd3\xd2\x96\xc0J\x04n4GjP\xd8\xd47\x87\xa4\xc7<\xb4\x95B\xa7\xb8\x1a\xef\xb3\xbd|\xc2\xf7\xa4\xa1tjR\xda\x8c\x04=\xb9\xe6(\x0b\x02\xe2\x99\xb8\x19^\xbd\x9f\xf0r\x89\x86\x80\\\x0d>HgaU\xb9s)\x11\xf5\xa0\x10\xe4\xfe\xf4D\x04\xdaO\xa0\xb3\x8c\x11\x8d<=Q\xd1.Sn\x0d\xcb\xa5\xf0BvC\xec\xa0VLv\x1a\xd7V\x1e\"?\x80\x8dv\xb1\xa2\x84\x97]\x06\x00\xd6\xa5\x8c\xaaW\xc4\x19\xc5\xe0\x8c(\x84\xc4\xc5\xaa\xac\x80\xbc\xdb\x10.\xa9\xbe\x00\x0d\xf5\xf4\x07d\xa41\x9a\xdd\xf0(\x04\x14K\x8fW\x0bp\xcc^\x91p\xa7\x15TFr>\x19(\xbe\x06\xd6a\xd8\xd0\xe7\x03\xae\xce<)c\xed\"\xc4o\x05\xb0\xb68%5a\xd4e\xae$K$\x96\x15X\x1eP\xf5v%x\x1fR\x85.\x90_\xff\xa2\x8b\xea\xd5X\xcc3h\xb7\x93r\x0fp\x14*G\x0f4\x8b]G+\xd7\xab\xf0\xdf/\xc3\xbe\xc4\x82\x7f\x97UYCB\xaeh\xd8	\xc4V\xc7\xb1\x10C2\x96+h \xcc\xf2d\x0e\x8d]\xa2%\xf3\xa8\xab\xf6\x97a\xe9%\x19f\x12\xf1\xa2\x1dR\x908&S\x1f\x83\xa9!\x82\x15d\xacE\x80\xfc'\x91nW\xa5\xd8)\x9d\xce'\x03\xf3\xbe\x8e\x846E\xb2\x95:@5d\xeb}\x190%\xe5\x8a\\>l\xc8\x92$E\xda\xd2\xc6!o7/\x89\x0d\xcav\x83\xa6\xecQ\xe1Q6\x7f=d\xaa\xb0\xa58\x97\x9d\x14T\xb2!\x80\x82qu\xa0\xd5\x1e1\xfcE\xc9\x10M\x1ambMF\xba\x1a\x92/\xc2/b\x10\xe5t\x14\x05\x8a\xac\x00o\xeae\x0d\x88fw`\xd9u\xe6\xa1\x99\x92n\xe3\xb5\x9c`\x92\xe1\xcc\x13\xf3&K\xa9\xcc\x89\xf8\xac\xc0\x06oe\x05\xa3$\xd7!\x9a\xd3\xf3\xa6\xf2\xeb\x115\xe6\xd1\x16\xcb\x00\xb5E\x9b\x1d\xce\x1f,Bj\xb9}X\xe2`#-\xb2r\xd6z\x1a\xd50\x93\"%-\x9d\xe1`n\x1d\x80\xd4\xa9N\xb9\xf3Kts\xd0I9\xd1UX\x9d\xf8\xc5\x12,E\xcb\xefj	1 \xb9\xe4{\x01\x8a\x8c*G5\x1eB@\xc1\x0cQ\xde`;y\x8e\x87\xbd{\x1e\xa54\x7f5c\xa5.\xbeD\x0c\xd5\x854\xda\x8a\xfa\xa5o\xee<\xcf\xdfi\xcb\x14\xcf\xab\x96\x1d\x87\xa9\xe5\x89\xc9J\x02\x1f2\xfc\xad\xc9\xb0\x9b,hE\x08t\xfbyR\x13\x0bY8R\xf2\x0f\xa5\xb4\x9f\x8e\x04\xb7\xd6\x84\xbc\xd3K3\xaeyw\xa8s\xcd\xe4\xcc\xdb\xea\x1b=\x11]\xe8\x84\\\xc4\x0d\xde\xd2:\xaa.:-\xb6\x88C<\xce\xe3U\x81\x0bS\xa3)\x03\xf0\xfa\xf8\x0f\xa3w\"\x08{\x03\x1b3\xa0*z=\x85\xcd\x8c\xe5\xef<\x0f\xd1j\xed\x813onm\x9eE\x81\xe2\x8b\x86\x9b\xa9At\xcf\x99\xd0\xd3\x88*A\x82`\xff\xaf^.\x020\xfe\xbd\x86z@\x84\xc8\x01E\x17\xf9\n\x92o\x1dOHv\xe0\xad\xe3\x7f\x1a>K\xed\xa3\xce\xb0\x99\xef\nA\xd5\xf2\xaa\x0c\x8d\x02!\x8e\xc1\x02\x18_\xf6\xfe\x18R^\xe8s\xa1\x8c\xb0~\xb6_\\\x1dM\x8d\xd0wy\xf6\xdfxZ\x19e\x0c9\xcc\x8d\x8d\xc8\x96ZXXLw\xdao\xfd\x8e\xc1\x01\xeb\x99\xaa\xbcd\x9f\xc2r\xae\x8dQ\xae\xdf\x01\x83Y\x02\xb7t\x8a\x06\x98\xd3\x01#\xd0!\x9e\x85\x81t\xd1\xc4x\xda\x10\xa4S\xbd\xa9r\x88/4\x9c0\x86\x90\x10 \xba	\xdc\xaeiEO6qe\xfc\xbe\xf4\xb5\xf9vv(\xa6\xa3\x10f\xcd}g\x84]t\xcc\x94\xa7\xbe\xf2N\xfe7)\xaf\xf7w\x1e	\xb0SCn-\xca\xdd>q\xab\xfaY\xe5\x95\xd5J\xde&\x81\xff!\xf1\x0e \xaf\x08\xc0\x003\xa0\xe3\xbf$^\xf8?$N\x04\x10\xe2:\xffgI-,Y\xa5\xa9T;\x9e\x89?n\xf5\xa2\xac\xabn2]\x80\xdb*\x06\xb4V7]\x08\xd1\x03\x0f\xbc\x91\xe0\x9e)\x15\xb2\x9d\x91},\xaeo\\\xa6)2\xe3\"f\x90\x91\\E\x16\xf8\x98\xe5\x05G\xd4T\x1b!\xb9\x8a\xb9'\x8c\x16\x1bM\xcbqC?U\xfd\x96\xae	\xb5\x80\xef\xc6J\xdai\xb5k\x0e\xde\xd0\xb2\x07\xed\xcd\xd6\xbc\xbe\xf3\xc1\x93\xdf\xa2\xd8\xb1\xc8\xffR\x91\xc8L\xd0l]\xbf\xe4\x82\xa4\x93\xb2\xe4\x8bJ\x17;\xe8Z6!Lr\xc1\x7f\x96\xd59\x07\xf3\x0e\x99\x1c\xb0I\x95\x7f\xaa6\xa54\xd1\xb0x\xf3\x8f\x83 [\xe5\x9bL\xd3a\x97\x83\xfc\xf1Y\x82Jho|_4o\x16\xd25\xa8\xc1\xcau.\xaf5u18=RN\x83\xd17?2\x18\xad\xd0w\xe0\xa3\xf8R$\xc2\xf7\xb7\x05\xd2%zO:\xf3\xc5\x9f\x86\xcc\x9a\x1fa\xc9sZ\x8f+\xd4\xaeJ\xcb\xb1=\xeb\xbap\xa4\xaa3h\xaar?\xe2\xae4\xaaC\xf5\xe4#\xc7\x0c\xdddc\xe1\xb7\x84\xb8\xed\xcd\xa4\xb3\xb9v\xbb\x9c\xad\xc7\xbf,\xf0\xceB~\xd4\x84?\x91\xa4\xce\x87Q\xd9\x03\xb5\xa2\xc6]\x7f\xb8\xde\x1a\x95\xe3v\x18I\x91\xf4\x1f\xdb\x9e\x90\x80\x99DSy\x89\xb4\x84\xa4P\xfd\x84\x85\x9a\xb8\x013\x078J\xa0\x08Mt\x95\xd1O\xaf=VP\xf4\x1d\xa4\xe4\xba\x0f\xad\xcb\xfa\xa9\xf5\x8cO\xde2\x0fT\xab\xf0\xb5\x99B\x8fv\xb9\x9b8\xc3k\x7f\x08B\x06\xee\x9e\x0b\xb1\xa2\x864G8E\xbd*S\xe6c\xc3\x91\xb5\\\xa5\x90\xb4fnj.	4\xec(\x12P#\x128\xaf9\x9a\xca\xdb\xd0\xb4[\x15\xc5\x7f\xa8d\x06I\x08&:\x9c\xe31\xc7\x98\x12\xec\xaa\x07\xf3\xabv\xa77\x98\x01\x8eF5\xc2\x97\x92\xbfz$\xa0\x9d\xa6D}\xe2\xee\x89K\xa5+\xdf\xec\x0dm\xa8\xe6\xc1\xbc\xdb\x8c\x1c\x9c\xee\x97\xb4d,\x83\x86y\xaf\x04\x0c\x03\x97\x91\xc5\x80\xa9{f\xe8\x91v~\xe3\xe9\x1czN\xd0\x8d78\x1f`\\\xf0\x9d+\x8a\x9bD\x02\xa8\xfa\xac\x1b\xef\x03\xf06Z;MM\xd9<\x06\x1a\x16!\xa9\xa6\x9aI\x82\xc4,D\x10E*\x81r\xdcA\xe4\x1c\xd6;\xa4:\xb8\xf1\xc1Ce\xa6\xa8\x1bC\x84\x93\x19!\xb2\xbe\x03\xb2\x17\xd2\xd8\x83w\x8c\x9d|\xc6c\x92\x0c\x1a\x19(\x96\xac^\xee\xf2\xa5\x8bLK\x01\x97\xbd\xb8\x08\x8b\xbc\x19\x8cf-\xe5w\xba\xbc\x80\x8a\xad\xd7\xc0\x1f\xc9^\xea\xc8\x19\x8a\x1e\x8cyo\xb1\xb1\x83r\x02\xe5\x0f\xe4\x87\xbf\x0bj\xe3\xe1=\xb1&\xc3\x83+\xea~y}]\xbf.u2(^\x83,\xa6\x8ds\xca\xc67\xdd;0\xe1p\xf07\xb1\x99\xb9v>1\x0b\xee\xabX\xa0G\x80=\xaeJ#\xb1\xe4+^qr\xcew\xa6\xda\xbf\x18\xd7\xf6\xb9\xff\x02\xab=+\x1c\xf7u\x9f\x03o\xf7\x02\x0dgPN\xbe`\xeb1\x0b\xc5d\xc9\x8bg\x01\x892\x9c9\xf9\xdd\xfd]\x9d/\x18\xb2\xa6\xa2G/\x9eq\x8d/\x06\xcf\x9d\x00\xa2w\x95 \xc5\x871)R3\xc1C\x17\x91y*\x8f\x13\xc4|\xa2\xc1\xd0&\x91\x9e\x1a\x94\xea\xe5\xc7\xf6\xa6a\xe5\x9f\x9e\xef#\xc5{\xb3\xad\xb6\xa6\xa5\xe7;\xee\xb1\xad\xb6\xb6\xfa\xef\xc4\xdc\x9c\x04\x91\x8b\xe4\x87\x04_E\xcf\xf2\xcc8\x87s\x86\x89\x86l\xc9\x9f{\xde\xfd\xc3#\xe9C\x84\xe5\x00\x8a\xb0\x19\xc1\xe5\xe1f\x07\x16\x0e\x8d\x1a\xfd\xe2m\x1a\xa6\xe8\xb9\x17\xa2\xd8\xa0\xef\xb9'*s\x96f\xb09\xf68\xf82i,\xfd\xec\xdf\x9b.\xc0PV\xc4\xc6\xf7\xd3\x1d\xe3\x84\xad\xc0\x7f\x7f/\xd4\x0b]\xfc\xe6i\xd6\xc0\x19\xbc\xc0\x1c\xbd\xb7\xf5\x8cmZ\xc9\x0b)\xd8)\xfc\xcf\xff\x86\x946\"\xf7\xf42\xa3\x02\x1f\xedQv\x88\xa1\x01\xe0\x9d\x04\x98b\x1f\xf9\x8by\x0d\x97\xdc\xfak\xf5\x9c\xe1\xc432M\x9c\x8e\xc8\xeb\xc7\xb7\xb0\xb7\xb9$O\x98\xfd\xfc\xbc\x06\xa8Fw\x9d\xafZ7\x93\xdd\x1a\xfe\xe5\x82gs\x10\xf4\xc2+\xe9\xbcV:*\xdb\x12vc\xe9K\xa0\x98v\xa9\xd1F\xa5\x1d\x0b \xe4\xc9z\x0b\x904\xe4v\xa7\x03\x93\x99\xe6\xb5\xfc\x17\xb7\x06\xa8\xe3\x01g\x03\x9d\x0d^\xe1\xa9\xd1\x93eJ\x19\xf5\xfc\x0cvM\x96\xaeR\x0e&\xd3\xea\x0e\xe4\x9f\x13\x19\xe0\x8d\x08\xf0OvD`	,E\xcd\xd3\xed!\x87\x90\xd4\xe3)\x91\x84b<\x82\x89\xfa\xea\x15\xd6\xed{\x9a^\xff\xc9\x9d\xb0\"&\xba^v\x0d\xb1\x98\x90oq\x92!\x9b\xd8\x93b$\xab_\xc0\xad\xbb\xfa\xd5e9\x83\x0d\xd0\xb3\xdc\x0e_\x054\x1dR&\xe3?\x17w\x9aY\xcc\x1b\x18\x9b\x18\x86\x8a\xe5q\xf5.\x18\x92\xb5\xe4\xbdjB\x13\xda\x07\x92q)\x9a\xedj\xde@l\x81\xd9\xd6\xdf)\xdd	;\x1e\x8f\xb2roS\x1fE#E>\xf5G^7\x110\xd5\x1e##\xc7\xb9\x13p\x936!\xd7\x9b\xc6\xc2~\xe7\x0b-\xc1\xd5\x99\x02zaLaV\xa2\xf7\x86\xb8_\xec\x03\xadq\x85\x1b\xd6\x9av\xe5\xaf\xf7\xda\xe8GE9\xb7B\xaa\x8e\x8a \xa7B\x9b-\xe4\xde\xf5p\x04\xe8\x9a=\xf2\x97<;\xc4\xb1t\x0d\xf6\xcd\xb7!\x04\x92B\xe3\xf1\xa8\xf9\x1a m)d\xf2\xc0Us\xa4zV6_,\xe2\xadR6\xeak\xf9$\xb1\xc9P\xd7$\xffa@`\xfd\x94\x8a\x8c\xe1U\x03ds\xa7\xaa\x88\x02\x9e\xd7X\x85\x7f\xdeV\xa7\x907\xc1\x06$\xf4L\x0bC3\x9b\xaf\x00\xbd1\xc0r\xb0\xd4\xdf9xr&\xcc\xf8\x81n<7\x15\xaa\xe0\xf1D\xefx\x19(D$\xb4\xd7O\xb7\x80\xae\xb2s\xb8\x18\nK\xb7\x01\xec\xd3\x05-\x0d\x87\xaca\xae\nU\x0d\xbbQwY\xefN\x94}\xf4\x93(]F\xb1\xdd\xe3pT7\xbfb1\x0d\xffZ\xf5\x82\x93\xcc\xe7\xf4\x1e\x8eg\x9a{\xe0\xcd/\x85\xb7\xad\xea/\xde_\x82\xa9\x86$\xe9\xf0\xf9\xaeq\xe9Z\"\xa5\xbe\x0e\xe9\xe7\xea\x8a\x85\x14\x7f\xa8\xbe\x96Gy\xb2\xb8\x98\xe1\xab\x9fW\xeey\xa0\xf1\xfc\x01\xb8\x11\xae1\x9f\xb0\xb4\xd8\xb32u\xd4\x1d\xe9\xfc\xd4*\xe8~^u2\xfe\xb0/~r\xd0\x80\xae5;:0g\xda\xef\xb2\x9a\x88\x93~\xaf\xf4\xccl\xa443\x9b\xcf)\xff6\xe6\xca\xc6Y\x14\xfaw\xeb\xa4\xbf\xee\xa29\x8eR\xfb4\xd1G\x8a4\xf3\xbc\xef\xd5\x05\xb6I1\x13\xf7\xd0t3\xb7\x16\xb3\xb8\x1fI\xce~s|L\x0ct\x97\xf3\xec\xb9\x0e\x11\xdb\xb0\xa7OL\x0cQ\xea\x1c\x1fPC]\x02\xd7\xf3\xcd\xef\x0bjm\xd9g\xb2\x8bo|\xf3\x81\x1d\xfd\x9e \xdaP\xbbt$\xcb\xac\xbe\xa8\xc8\xc7\xef\x86\xdf\xf5\xeb\xa4\xf0\x86\x0dA%\xf0+\xe2\xd5Nl\xb2\"+\xe5\xf8\xda\x10hi\xec>F\x92\x9c(\x15L\xcf\xb0\x93\x9e\xc79F\xc3\x1c$	\x19\x8bJ]\xf0\xd3k\xa6\xfc\xbe\xef\x0b\x8f\xbf\x7f\xec\xf4e\x9a%/\xa3\xe5\xbe\xae\xda'k\x14\x9f\xe2\x19\xc2r\x91|LE\xcc\x84~p\x02\x01\x10\xc9^N=\xef\xaf\x17pH\xc6F^\xd5\xe8\x05\xee\xba\x86\xb2\xf7\x89IgK~\x1eU7\x1d\xa4Z\xfa\xd9\xd9\xcd\xff\xd2\xe0\xe7\x11i7Q\x05\xf5\x90\x96\xd4\xda\xdeN\xe9?\xfe8\x99\"Smc\xb64N\xbe\xde\x93\x97\x0e\x1c;\xc1\xbb\x90\x1e$\xdb\x93\x90\x96r\xa7\\\xb4<\xef#\x82G.S(JD\xe1\xa2\xf5y\xd1m\x86\xbf\xc2\xa3O\xb5;\xeb\x89\xec\x16\xb7_\xda\x90<\x97T_%\xbf\x90\x05fM\xa3\xdaS\x9aB\x0e\xfe2\"`\xa2MtD\xf7\x9a\x01T1\xf8\x1d\xf3\xcf1\xfa\xd6\xf8\x86\n\xff\xcev\xfd\xa1\x85H\x91Cwqs)\x02}w\xf1)@\x96\x08\xf1s6p,\x83%	\x1c\x00:\x01p\xc5Ue\xd9\x16\x9a\xddtH\x9d?\x8c\xa4\xb2\xa0\xc3\xb38c\xc0\xe1\xe0c\x90-\xdd+\xf4\xb5\xef\x8e\xe5\x16\x9d\xebE%Sy'1\xe7\xf1\xdd\xfc\x80\xe4\xf2\x87r\xd2\xac\xfb\x99\x029\x0e\xe8\xaa\xa0\x07\x0e\x1a\xdd\xcd\xc4`\xb7\xdd\x8a[\x8b\xd3\xca\x96o\xa3\x9e\x8b$U;\x95\x0c\x0d\xfc\xa4\x0d\x9b\xa9\xf8\x0b\xde\x99=\x1a\xc7\xe2\xdc\x865\xcd\x84\xab|+\xc8o\xa6\x9b\x9b\xd9\x02Y\xfdp\xc3\xb8\xef#'\x0f\x93\xed\xbf\x9d}$\xa2\xebSO\xaf\x81\x9e\x92.\x13=\xe9N\x9cWU\x0b\x87:\xb7=\xebQd\x90\xbf\xdf\xd9\xd6\x1c\x03N\x99\xf7\xef\xcbQk\\\xb43\xd8vm\xd3\x12<\xba?\xce\x88\xf9Q\xce\xb3Y\x1a\xc7f\xdeN\x8f\xcd\x7fI4\xbcQ \xfe\x13:[\xff\x1a\x17}\xe1h\xe8t\x14\x01{{U\x85pc\xead\xaa^\xed\xb7\xe4C\xd7\xf4\xe9\xf5\xdf=\x97,\x0fA\x82\xf7\x06\x9f\x9d\xff\x03\x91\xb6L\xecNr\x9d\xdb\x95\xa1\x98\xf0\xdc\xc7\x1c\xa7\xcd\x15\n\xc4\xe8\\R=\xf1s_2\x89\xcd)z\x8c\xa9\x8b-hP+>}}-\xdeH\x9b8\x18\xd4\x84R\xcd<\xf6\xf2\xaf6\xf1'\x7fu-X\xe4PSev\xa4b\xe0\xa85\xa9\xbd(^\xfe\xde\x01\xd3\x96C\x91\xd7\x0f\xf1`\x95\xef\x8c]x\x9a\xde\xf2\xcc\xb1\xe5F\x8f\xc8\x143U\x13\xd1O\x15\xaf\x8fxz\x83N\x9f\xd7\xfc\x0b_\xc4=\x973\xc9\xbf\x18b-.\xf0\xfc\xe3\x9al\x13!\xe3A\xf1D\x1b?\x92)\xd1\xc6\x98\x869%n\xd3\xf2\x82=\xab!\xbd%\x91]\xaa\xa0\xa6}\xd4\xdfI'U\xfbs\x02\xdb0\x15\x12\xa7\x94\xa4\xf1\xa6\x06j%\xe5\xc2\x92\x88\xa5(\xe9|f(,	b\xa2\xb9iy\x90v\xe6|O($\x0f\xcc\xc7\x06o##\x87\xad\x02\x14\xca\xd0\x06\xf3\xa7\x83\x16\x00\xc1\x81\xa0d	\x9b?\x9b\x90U\x10\xd2\x8c\x0e\xcb\xab\x86\x0c\xd5\xd9G\xe9\x9b$\xdb\x82o\x89)L\xf6\xc7\x87n\xdb\xf3\xef\xc7\xbe\xd1w\x00W\xeb\x8dQ/[\xa7\xcb\x07\xf9\xb2\xd1\xa6(ei\x06}\xfb\xba\xe3\xe2N\x8e\x0e\x1a\xb6?\xf8\x86\x7f\x9cVT\x0d\xa1.k\x84\xf0\xb0_Wa\xebX\xa7\xd8\xd0\x82{\x92\x90'o99\xee\x13\xd3\xf2\xd0\x17\xa3C\xeel:p\xe7#\xe3\x8c\xfc\x85{\xb5G\xc5R\x171qae\xe8\xb2\xce\xb0\xb8\x06\x12\xcf\x11K\x8dSO\xf8\xad\x87&\x0f\xe3\x9c\xc2\xbe\x0d~\xe7\x92sd\xbce\xb7\xb1\xe8\x97\x10\xeeP\xbb\xa6\x91K\x9c\x9e0\xf1.\xbau8\xc0,\xd61P?\xf3f\x1e\x81J\x07;\xcc\xa3\xa4\xf3\xa0\xa7\x95xe\x87\x99w\xd7P\xa3\x8b\xf6\\\xdfpq\xde\xdcA\x17#\x04\xd9H.`\xa0~_;\xe0\x0d\x1ei\xc0\x9b\xd9\xe8yBU\x8e\xe0@\x1cs\x90\x19,Q1P\xc1\xd0\n\xe2\x97\x9a\xce\xeb\xa9\xf3\xb5,Z5\xe7s.i^\x14	\xcd\x1e\xd6\x85\xfc\x12\xf7N\xba(*\x90\x90S\xb4~\x99\xc0\xc3\xe5\xceb{\xe3\x90\x1d\xbf\x0f\xbdM\xc1(s\xa3~\xa4\xe7\xfe\x92V\xfa\xac	T\xce\x8b\x1f\x95eR aI#\xd1?\xf0Q\xbf092\x91yle\xa4\n\xbe(\xf7\x85<D\x80\x90\xbbT\xfe\xfa\x0d\xfc\xce\xf7\\\xf8\x00\x86\xca\xe2\x86@\x0bD\x1c\xcd\xaf\xd0\x0b\"\xa4r:\xab\x0b\xe4\xb4\xe4 \x1b\x05RZr\xa6\xc4B\x95r\xb4\x1bO'X\x1d\x19\x95\xd2U\xa83\x88I\xdf)<\x13\x10p\xe1\xff\xd9\x1dE\x00\xf2\x8e\x00\x04@\x01\xdd\xff\x1d\x94w\x11\x82Fl\xc0p\x12\xf0KP\xb0,\x8f>\x9a\x8cC\\>$O\x8es\xc0\xb1\xa7l\xda\xbaD6\x0d\xb8\x84\xd6C\xbfF{m\xb1\x98\x11\xf5\xe5<\xe9V\x12S\x85<\x0dW\xe3\xa6\x95\xb7\xcdr\x95\x03\x16\x03b\xb8}g\xae\xa9\xed\xee\x00C\x8f\x93\x92\x05\xf4\xb3/O\xe5/I[_5X\xcf\x0f\x90-\x0e\x14hu\xa8\x89mj)\x0e2\xe8 \x0d?\x8d\x18\xbe!\x93\xd9\xedd[=\xd7\xd4'\x93q\x8b\xf4\xba\xe2\xees\xa5\x9b\x95\xea\xe7\xee\xc5\xaeT\x1e\x18\x94\xdd'4|\xf8\x86=\xbc\x80gJ?\x04\x9fF\x9eM\xf3`\x8a>\xda\xf7\xd9\xf3\"y\x0f\x17:\x8e\xa8\xfc(\xebz\xad\xec\xa3x\xc1\xd1E\x85\x0d-tZP9\xa4k35\xe7^{8+\xb2\xbd\x82\xba\xf7\xbc\x9f\x80eL\xd6\xc1$\x935\n\x82C@.\xd1	\x1bq_\x81G\x94t\xd6\xe7c\x0f\x8f\xff\xba\xc4sU\xe4\xd8&\xdb\x19\xa3Q\xc5\xf9\xea\xc8\x8fR\xaevG~2\x9c\xaf\xe0o\xb0\xfdghe\xa7\x8d3\xb0+\xb3\xb7Ien\x9c\x0bW\x13+\xabC\xd27R\x7f\x82\x0ex\x13,\x8e\x1dP\xb1\xa9Z\x07\xba\x07cA\x1f\xca\xb9\xf5\xe2\x8d\x0f\xf7\xc1\xc9X\xabh\xbdx\x0b\xb4\x88y|h[\x02\xfdR\x10t\xd8\x83\xefZH\x99\x1e\xa7\xa0\xdd\x08=:\xee\x03\xa9V\x12\x16\xbb\xee\x19+B\xef\x12\x8d\xc1\x13\xf3\x8d\xe31\xf4N \xf9r\x16\x1b\xf9\x8a\xc6W%\xa1\xcbf\xcdha\x1d\x92\x0b\xfa:/\x9b\x0e\xe0R\xc8\xbd\x9fn\x15\x1b\x1e\xb2N\x8eT}\x11:\x1c\x8a] \x8d\xc7 \xda\xf3`R\xea\xf2\xf5%\xa0\x8b\x18UwD\xf7qh\xae\x1cSA\x9c\x1766\x1d` !\xb2<\x98\xac\xa5\xd3TB9\xffR\xa9\x1a\x8cy\xcbq\xc0\x8b\xb7w\xe9j\xb2\xd5\xd0\x1c.\x11T+\x1b~\xca\xe1Q\xc6z\xaa\xa9f4\x8c\xc3\x89\xa6+\x05\xf0n\x98\xb5\x8c\xcb\xdd\xd0\xe6\xb7\xe7\x16\xd4\x19\xc1\xa1\x1a\x1f\x1c\xd5V\xc0\xd2l\xba\xc6)\x06\x97,Az\xf2)\x9eB\x1dT52\xa8\x13\"^=\x99\x0fS\xa8ft\x7f\xe5\x0d\xf4\x06\xe6$\x83\xd2\xbb\x11\xb6\xc4t\xe8\xe4C\x14\xe8\x16l!\x1f,\xa9t\x87\xb5g\xf1\xea\x9a\xe7\x98\xc8,n5\xa1_/\xde1}\xe8\xfdg\xdf$\xb4\x16\x07y\xa4\x94\x17\xd4\x92\x84\xe2\xae\xe9]\xc1h\x87Dt\x18\xc8/\xd1-\x8cM,\x8b(<\xfa\xd2\xa1\xf9V\xbc\xa1\xeb\xfc\xbcH\xfb^(\xe2\xdf\xb7L0P\xc9\xb9N\xc9\xb7t%>\xe1x\x8b\xd0\xc8\x8f\x11\xb3\xf6\xf8l'\x9c\xf5\x00\xca\x85\xae\xe1`ST\x16\xc7~\xb1\xf3J\xb7\xfc2g\xd5\xd0\x15>u\xca\xdd\x86g\xe6\xeca\xc8\xd81\xea\xc1\xec\x16\x87JU^\xc4\xacF\xf00\x13\xbeh\x00\xe4\xa2t\x1cf\xad(\xfb\xe2]\xe1Q\xe0\x83\x93\x14\x917\x8c\xe3{\xb7Vd\xe6g\xb1\x03\xfck\xc9\xbc\xe7\x1e\xad{?\x0f\x9bT\x90x\x19e\xba\x84\xac\x1c0\x91\xd1\x0bz}\xc5y\x90f\xd2\xce\xa5\xc8\x89\xae\xc7+\x9a\xf3f\\\x91~\xbb\x9et\xc1\xae\xf6\xea\xb6\xe6\xc7\xf9\x80$\xbe\xafkk\x9c0\xe8\xff2\x93\xf2\xffff\x12\x82\xbb\x16\xc2B3F\xd2\xd0b&\xc9\xd1\xd55\xe3L\xbcJ\xa0\x8b\xd1\xb0\xe7h6Q\x8f\x1f\xba\x9f\x98a\x98*j\x9d\xfa`\xeb\xcdS\xe34Y/\x13(\xba\xd6\x98\xe0x\xc2\xe7]\xbc\xb4nF\x99\xda\xcb\xb0\x8f\x82Nyh\xb4J\xe8\xb2\x03\xc6\xa4\xb79\x9f\x14V\xa0xj\xa5HzT\xd92j|\xd8\xf8\xd6;\x8bcm\x97?\xa3\xf8>\xf2Zw\xc8\xce\x8ek\x8b\x95\xb4\xad\xdc\xf4\xb7\xb6\xd3,\xf5\xdd\xc3\xd7\xfb<\x9a\x805g\x04\xdb\\x\xfd|+\xe5v\xd4f\x9dB\xe4\xeca\xa7\xc4a\xfb\xe4\x08\x11\xe60\xfd-u\xaf@?\x81\xedLj\xdb\x81\xb4\x8f\x16\xbd\x84::{Q\xe5-3)\xe2g\xb1JrF\xee\xcc\xa0\x89\xbb\xce)\x8c\xf3\xe2\xea\x99\x9bF\xb3\xb2\xbf\xc5\xce1\x9d\xce\xc7Mw\xe39\xf6^I_\xf9\"\x18\xca\xd7\x81\xdc\xa4M\x8a\xa65\x0b\xae3\xc2^.\xbdxX;\xcc\x19\xbc\x1b\xdbz\xec\xc8[S\xfc\xc0\\ h\xb9\x89\xed\xac\xc3\x9fh\x8e\x85\x0b'2D\x1d\xe4\xe2\x1e\x7f\x9cL\xe3=\x9d\x89{\xf5\xf5\xc7\\\xc9\xeb\xe8Q\xe433>\xc4\x06\x98\x1e\xbc>\xf8#+\xc48\x81\x06\xc7\xf74E\xba$=9\xd5\xf94\xd6c\x0fm'Q\xe73N\x8f\xe3r\xf3\x10\xf1Wp\xd15\xc5T\xc6\xe3\xaa/@j\xc3\x99Q\xfd\x01#)\x12\xdcw\xbaG\x03+\x90\x9a\xc4\x085+\x10\x85\xd7~\xcf\xffu\xfa\xa1\x96\x11<Y\"\x981bn\xe0f\xe4yQN\xb5\xd4\x86o,\x9eQ-\xde\xc1\xc4/\x15\xdd?5_\x1a\xd2\xf7U\xdf\x80\xa7s\xec\x87g\x98\xe8\xb0\xff2\xb3Xw#\x8d\xd9t'l\xabb\x89:1&\xc6\x8b^\xbbA\xc1]\x04\xd7\x10p!\xb4R\x99W\xd2\x87\xee\x86\xb1\xe2\xcd\x0b\xde]f	i\xb9\xd4sh#\xc8\xe8a\x8d\xael\xc8D\x0dU\xa6P\x0b\x92\x9d\xf9.\xa0\x0b(\xc33w2\x94\x13\xe7\xd7e+\xc1\xe7\x8e\x88\x998\n\x8f	\"\x1b\xfd+\xb9H\xaa\xbb\x93\xba\xdd\x9c\x13\x18\xf1\x16\xdf\xa9*C\x84\x0c\xdf\x0d\x85A#\x81\xda\x97\xd5\xd8n\xcb\x94+\x81\x1f=e[T\x9e\\\x94\xc2\\\x8a-\xa2\xf0v\xc6X\x99\x01\xb0?~D\xfc6\xe9mk\x0c\xcb\xaa%\xbf\xc6\xb8\xed\xbd\x1d[\xb6	\x00\\2\xcc \x9aQun\xbc\xd5\x8f$\xd1\xd6S\x06\x13]\x05\xe4\x0d\xb0\xb2J)\xe9?\x8a\x7f\xbc$\xf0~K\xd0\xbf\xefc\xe4U%Zh3\xe51\xfc\xe1\xdfOEB\x8dO\xa47\xaf\x10^{Gh\xces\xb83j\x1b\xda7*U\xd0\x14,\x84G\x1ez~\x99\x93\xd9m\x99U~RR.\x9f\xccu\xe8\xd8N\xbf\x96\xc5a^aw~A\xa9M@\xe2x\xd2U\xbf2\x07\xce%3\xae\x90\xc9\xcbI\xa9x\x19\x85\x06\x07 %\x13~\xbb2\xbfx\xcc\xaa\x1b\xc7\xc9\xf9\x82\x1cb\xc5\xdac\x17/?\xb9\xdd\x89@\xc4\x89>\x16\x0d\xf6\x88\xd3\x89\xb4zUv\x04\x1eA\x88\xaf\x8d\xc1\xec\x82~X\x1a\x8a\xdc\x0dv\xf1\xc2k$?\x87\xcc\xf0g\xe6\xf7\xcf\xc9\xa85fIIZX\"!\xa3\xa8\xc9<59)\xaa~\x11\xb1\xae\xd6\x042G\xeezj\xc3v\xb6H\x1e)%\xcfl\x10\xf5\x17\x87\xab\x1e\xbfE3\x83\xabz=e+\xe6\xe9\x05,{\xc5\x8e\x93\xac-\x90\x13\xdb{\xa5\xbc\xcc\xd8P\x86f\xd2+\xb8>u\x88\x0b5\xb1\xca~3\xff<\xfe\xfb\xab\xe0x\xa4\xcc\xa9\xee\x97\x9a\xdem\x9f\xd9\x9f$=\xa7G\x7f[8\x13tI4\xf9W\x8c\xca\x9bs\xbe\xbc\xc62\x83\x1c\xdd\x86\x89\x0b\x02\xb3\x0e\xdf?$\xe1\x04H)\xed\xe9e\xc1\x84\xc8\x8d\xc4y\xb0f\x82\xfc\xb4\"\x83C^\x0f\xc2n\x94\x8f@\x1a\x08D\x91\x05!\x93\xdfH\x08JP\x98\x1d\xbb\xbb\xd7\xc4\xa2\xd4jp\x08\xa4\xe6\x0b1[\xb33\xf9\x13\xe1a \x14-\x82\xff\xc3\xcd\x8e\x13\xf4\x12\xf8\x8c}X\x8a\xbb1\xb2#\xf3!\x14\x8c\xa8E\x1f\xd9\x82\x13T\xbc\xdf\xa6A\xe6\x0d\xd8.\xd4A[\x13Z\xd3\x92>\xeb\xf6\x90T\xd5\x13\xcey8\xc6\xe0\xf9\xb9U\x8a%\xa5)\xe9l1\xd1\x1e\xef\xad|2\xf3x\xab]\xa2\xb0\\X\x97\x06\xa5\x13\xaf&\x90\xd0z\xba\xbe\x91?\x03\xa4\x08$N\x18\x95\x0b\\\x90\x9a\xd42\xc8\x86(vV|8\\.9eB\xbb\xec\x19\xe4\x94lCf\xe08\x16\xc3	fu\x02b\xfb\x05:)caY\xaa\x0b\xf0\x1e\x7f\xa3\x9c7\xf9\xb2\xccd\xa3Dy\x18\x87\xb9\xc1\x05\x1cA?uC\xd2\xd18\x87X\x10pL\xd7L\xd1B#m\xf8\xd6\xb1b(\xc9Cj2/\x9dd\xd7\x9d\x0b\x89\xa9]\xdc\xa7\xcfZ\xf7\xb5\xf3\xa6\xbc\xd8\xe4\xda\xde7\xa5O\xccp8\xce\xa7\xb4\x9aiI\x9fw\xe0L\xbb\x1d\x01\xc1\xe5\x1d\xb4\xd8k\x7f)\x7f\xe7\xe1\xab\xba\xea\x07\x9ct\x01\x97\xaf\x14Ir\xbc\x7f37\xf5b\xc26(\xae\xf1x\x06\xc3\x02\x91?j\"\x14,)o=~\xa4nQ\x10~\xed\xa6\x83\xe8\xcfX\x04\xe5\xee\x07\x05.]3\x8f3\xbb\x84\xbb	\xb1\xf3H\xa5\xf4e\xed\x1f2\xf2\xda\xb2<5\x00\xef\x8e,\x9f7\xbb\xc3\x9f5,\xf6\xf8\xa6\xfe~X1\xa7r\x04\x8d\xeb\xdc\xf5\x01\x06K;,\x85&\xb9 \xb3{\xe6l\xe0\xa3\xec\x7fJ\xfc\xcfNn\xe9_\xdc@\xb4C\xbd\xf0|\xb6\xac\xe3\xfe\xb2UE\x05\xcd\x88\x9aC~Ua\xd2\x99\xaa\xf1\xfa\xf4\xdb\x08/Z\x8a\xee\xd2*\xfe\x9b\xba]\xc8X\xea\xce\xe1\\\xf2\x0b\x13\xae\"\xa0\xc5\xf8\xbd\xe3\x92\xedLm\xe3=1\xbd\x1f\xfa3g\x0d\xdc\x8ax\xa1M\x81V7e\x8f\x03}\x8c\x8c\\'H\x8e\"\xff\x13\xf1\x10\x08c\xbd:\xe879\xe1Y\x96\xcb\x9aA\xd5\x88\x18\xe1\x9a\x83\xb5\xb42\xbd\x16s\x88\xc2\xd9U/\x01#\xe4\x9a\xc4\x06\x07\x0f\x95\x8d\x0c\xdb)b\x17\xa8\x1e\xe4{n\xa6\xd3#\xb2\x90\xcb}\x9aL\xb0\xe9\x9f\xb5\xf6\x0f\xa5\xe9\xfa\xcb\xfe\xf5\xf6<1\x7f\xb4\xf68\xfb\x19Q\x10\xda\xe8e<\xd8\xf4=\x9f\xf6`\xa4\xc9\xc6?g\x8e\x1f\x97\xb2\">\x12rj\n\xb9)\xca1x;\x0b\x8e\x11w|\xae\xe2\xf4#K\xbb\xcb(\x8a\xb1\xaag\x11\xd1b\xa0\xd1\x04\xa2\xddYY\xcd#\x16@\x07j\xcf\xff\xae\x0e\xe1L\xa3~\xc2*\xa5'`\x9fJ;\xb7N\xed\x99\xd4\xa3\xd6\x1eV\xe5[\x0b\x07\x0e\xf7~;\x8e\xda\x88\x9d\xd4y	\xefD\xed%U\x0f\x10\x05fr\x07\xd5e\xd4\x89\xae\xbf\xfbH\xcc?\x16\x03\xdc\xbf\x8a\xdc\xcd\"=\x983\xfa\xa0\x90\x03UZG\x04[Mbw\xcf0\x969\xc2\xf1\x9a\x9ex>\xa2\xceD\xfd\x83Ab\xe9\xceU\xfek8	\xa9\x8f\xb2	\xb2\xf4\x16\x96?d\x82\xa3\x90\xda\xe5\xf6\xfbtM\xde\xb3j\xb8w\x9e\x8c\x9f.\x93\xa0k\xcd\xdb\x16\x9d\n\xfb\xf7lt7kl\x90-\x86\x8ak\xd2)\xb1+\x1b\x81_#'\"\x85\x12\xd4\x16\x85@\xeeO\x1c\xe4\n\x02Nn\x0e\xc9\x0f\x1f\xb1m\xb4_\xedS4I\xa4uJ\xf8k\xab\x07\xc7\xfe4yN\n\x0e\xa7\xa7!vM\x17\xc0\x80H\xde\xd3lG\x16\x88\xdfw\n$R\xbb\xdc\x0f\xb0\xdc\xd4\xcf\xe9\x13$Y\xc0\xec\xa7\xcf\xdc\\\x97K\xcc\xe7\xc7\xe4e\xdf\xd2Cb\xae\xcb\xdeGc\x8a\x10\x87\xfc<\xd2\x15|\xfcp\xfb7\xc5_\xe5\xb7\x93\xa3\xf2\x90C\xf2\xa1\xa8\xd6t\x0byU\x11\xff\x8c\xc5\xef\xe1\xdd\x16\xad\x04\x7f4\xe7<\x97\xac\xf4\xa6\x8b\x9e<\x89\xecFq\xde\xca\x18\xfa\x97\xda\xfb^g\xc3\xfb\xb9\xa1\xeb\xb7\xe9\xb1\xc5x\xf3\xb1\xc2,:w#\xcd]\x14\x9f\x8f\xfaj\xbe\x0c\x1b\xdf\xa3`\x049\xf5:\xa2\x0b\x05\xba\xf1\x89\xa8\xf4\xeaZ\xc5W\xea\xb1M;|=\xab?\xdf\xd9\x9f6z8\xf2\x9d\xa8\xf6$iXG`^T\xdc\xfa\x82\x94\xd4o\x87\xbe\x89\xad\xb6\xf8Wu<\xa4\xe3\x05\x0d\xc9\x9a\x8c\x83\x8d\xf3\xe5\xd2\xff\x14{\xf6\x8feT\x94eQjX\xe7\x1bc\xa98\x9f#\xb8V\xc4\xa5))_\xd2\xc5\xb9c\x9f\x129D\x15\x05\x05\xcb8:\xa33f\x9d\xf4\xc9\x9b\xc0s\xc1)\x99*\xf8\xa4e\x8f\x84\xa8\xe1#\xbb\x17\xd5\xe0R-(\xacT\xa0J\xcaH\xc4\xf0\xde]\xfc\xa2\xff7S\xf6\xb0\xb0[\xb6\xefDa\xe6\x03\x96\xab	\x8b\x9fWo\xa6!.H\xd6\xc9\xe4\x97<\xe5~\xe1\xff\xa5t,\xc6ui\xea \xb8'\x8e\xef\xc2\x18\xe3\x83\xd6B\xd2\xc8P~\x1b\xf3lK\xb3\xf3\xb3\x99\x97w\x97\xbf\xcf9DA\xf5\x06\x12\xc1i\\\x17\x95\xa66\xba\x93\x9f\xfd\x8a\xb6\xea\x85Y\x92TX\x11\x1a\xa6\xc8\xfd\xd3\x0d\xa1I\xc7\xbb\xc1n:\x80\xe6\x15\xcf\xf7S\xdfm\xbb\x02\x1b<\xfeJ>\x16\xc2\xfd[\xb8Q\xaa3\xe2\xe6C?vOh\xbbKx\x08\xcf\xc65\xbc\x1a\xbe\xda\x9a\n@\xef\xbfV\xcb\x13\x9dw\xe1\xba'|\x8b\x92\xf7#\x8c\xd58v\x98v3\xdf\xd4\xe3,\x9a\xf2\x82\xcc\x86\xa0\xeb\xe8\x9e\x0e\xfd\xf4}z\xae\xfc\xd9\x02\xf8\x08_\xf9\xe8\x90+>\xaa\x90+\xb7\x18w\xd3\x12\xd4\x18\xc5\xfe\x85\xdf\xa3\x8d\x85\x0c\x8d\xad-\x14\x03]i\x05d\x03\xc9\xc6\xc7\x8f\xadu\xd0\"\x02\xc2\xc6\xd0\x85\xf9(\x02\x9d\xb3\x95ui\xd6\xee\x8a\x00\x88qJ\\&e\x1c]^m\xdcU\xad,\x99H\x8d)	\xa2\x89\xc3Vha`\x16w\xb5\xa7\x87C\x08D\x08\x8f=\xde\x97`\xc7\x10[\xa68\x12\xf5H5\x9d\x17\x96\xfc\x8e\x8bG\x06w0P\xdc\xbd\xd6\xf67 O#a\xe2\x8d\x97\xd8A!B6\xb0\xba<8\xba)\x96]\x89S\x85D\x92\xb3N>[\x1ai\x1b\x89pZ@\xacnc\x9e\xb2\xe2\x9c\xbaB\xab\x1b\xb1\xae\xf5\x14\x1fOa\x17:\xb4>\x02[\x94\x17&X\x00\xee\x96\xc2:7QptE/:L\x1b@\xdauJ\xb1\x1eK\xc8\x8c\x19\x9d5Ds5\xa5\x1e@\xd9v\x17\xdc\x9fb\xb5\xc9t\xf9]{\xdd\x9c[i/\x1a47\xed\xc3z/^6\xd4\x80\x99\x1eE\x85\xe6\x8aSO;\xa0\xb7#)q\xdb\xd4W\x9dfs\x92\xdc`\xeePI\x96\xd9\xadN\xdb\xc9\xc9\xa4\xc4t\xa3\x93\x0b\xbe\xa0I\xedBz\xca\xa9(\xc9\xb2\xf9 \xbd\xe1K\xbbOtT\xc4\xe2\xd57\xd3\x8f\xf1$_\xe7K\xd2\xefWb\x1356\x87\x81\x9f\xe0\xc8\xee\x0cX\x87&\x93\xcd\xd2\xf4^\xb8\xb6ht\x0c\xe706\xcf\x1f\x19\xa5F\xfd`\xc7\xbb3z\xf9B\xff\xf1\x95(\xa7\x13\xc40\x86e\x14\xf7H\x88\xa3\x03\xaf\xf7ET\x7f\x99\xe6s\x07\xe3\xee\x02\xa8\xf4<\xaa\x10\xa6\x93M\xe3\xdaO\x8b\x9c6@q\xd6$\x7f\x86\xc6\na\xc0|h\xff+iqg}$>\x95\x06z\xe7\xfb\x86\x80\xb8\x93\xa23~\"\x80(=1u\xcd\\\xa5\x02\xce\xe9\x0d\xcc\x99B\xf81f\x92\x92\xb2\x90d\x18\x01'\xe2t=4\xff\x0e\x82\x10\xbf\x0f[Gs\xee\xa5\xda\xbd\x02\x15\x00?\x05\"}\x0b\xf6&\xce\x9ce\xb1E\x1d\x06R\xc1\x8e\xf9krJ\x1eZ\x85_\x0fsq5\xc9\xafh\x16\xc8\x18\xfe\xedC\xec\x19/9\x91i\xc2g#\xde\xf9\xb7p\x83\x98\xa2\x14]\x19\x1e\x92IeY>\x06$Cdd\xf5b\x14\xde\xdf(l<`3ML\xb1(\x17vM.vtL\xf06\x160e\x1dw1\x16\xb0\x0d+\xe9]\xb0\x923.\x86\x1c \xcf\xaf\x14{ 1 \xd0\xff\xe6\x1d\xe5\xfe?\xf2\x8e\x08nY\x97\xa9\xf9\xb5\x84\xd3\x00\xbfI\x95QW\xedx&\x04$M\xae\xc6\xd4M\xe6\x12\xf5|\xe3\x8bF\x13'\xd2E\xad[\\dh\x9bi\x0eN\x9e\x96\x9a\xd3\xdd7\xe62?1\x1e,\xd6\xcer\xc0+4\x92P\x17\x1d\xa6\xb0%$\xbf\xc5\xb6\x9f\xdf{6\xe7\x1buA\xf4\xee\xc5\x94\xec'\x9av\x12@\xca\x8c\xedV\x15\\\xc4\x08o,k8W\xa1a|K\xfb\xc3\x0cN\x0c\x08\xbc\xd5T\xa0\x1bWl\xafI\xc2\xf4\xf5\xbd\x94\xc3$e9\x9c\x7f\xf8\xdd*CU\xd0\xeeN\xecO\xd9\xd3\x87\xd5\xe0\xe2\x96\x88\x850\x107\xe1\xe0\xf9x\x99\x8aS+L3lE\xde\x90uK\xeb\x051\xd4w\x9c\xd3_0%x\x99c1\x14\xefR\xa8~i\xa8}\xf3Z\xc2\xec\xbb\xd3o\x9b\x12\x91\x0b='\x9e\xf5\x9d\x9bc\x7f\xeaz\xadR\x9f\xfb\x96\xa7\xe9\x9b;#i\xe10\xd6\x07\x93\x97C}\xea\x12\xe5\xbe\xc8\xa8\x90\xa2\xc5\xeb\\G\xe9\x03\xebX\xb3\x97\xf6\xad\xbe\xfcXv[\x8d\x95\xd7\xa3\x99\xf7\xf5\xc8\x0c\x9e\xeek\xb33x\xea`\xe6\xb7\xb7\xbd\xd3\xfdV\x97\xd0.\x9b/C\x07C\x0f(\xfe; \xfcU\x06\xe4{\xde\x0c\xceq+\x95\xc8\xdf\x8d\xc0\xccXr\n\xf9\x11\xa2\xd1\xdd\xf5\x18\xee6W\xe9T\x85\xc2;\x10\xaab\x9d+\x9f{\xba\xdaq\xd6\x147\xae\x02\xcfL\x90\x1f\xda\x11\x89 \x8e\xec\xd8J\xf1<\x8d^\x17k-\n\x85\xe1_o-\xaf\xa5m<\x81\x93\xd1\xed5\xeb\x1b\xe8\x13m\xca\x04\xbeN\xb3\xe5\xda\xa4S\\e\xbab\xd78\n\x18'\\#S\xf4R\"\xcb\x1dR$\x16\"\xe0I\xca\xb4\x8d~/\x86\xa8\xadW\x15_\xc7\xce\"\x02z\x8c\xeb\x16\x85'\xbc\\\xbaA\xa5\xdf{\x18\x82\xe2o+dJE\x9d/g/T\x0f%6\x04\x1b\xdd\x10\xf5\xbd\xae\xe1jUV\xed._8\xc7\x93\xcb(\xe0\xc4\xa9\x8d\x95\x0b\xd7+\x8dH\xe1\xf9\xcd\xf2\xc4\xb6\xe1e-G\xc9F\x9c\x1f\xeb\x03\xeaDTBY3\xde\x0d%\x9a~mB\x98N\xfe\xb0k\xc7w\xa9\xf2\xa5\xc16\xbe&8\x1a\xd6\xff\xb2_@\xde$\xd4\x9a\xbc=*z\xc3\xe0\xa7tn\xfe\xe7\xde]|\x8e,,\x85\xa7\x13lJS\xea\x08\x0b\xc1\xeag\xb7\xb3S\x08\x94X\xcdd\x10W\xf0\xe3\xeb\xf2\xcf9O.w\xcc&\xea\x03V\xe5Y\xc4\xf1+\xdan\x0b\x9c\x1a\xc7\xe7W\xb0*6m\xa9T\x0e\nq\xddP\x19$\xa87\xc2e\xf1\x02|\xa2\xf7zs\x90\x91B\xb0\xe0 \x98\x19\xe66u.K\xa99\x91\x9a\xf1*\xa4\xad\x92C\x06mTdL\xe2\x10i\xc4\xf5\x16\xd1\x80\xf1\x94\x90\xe5\x9a\xf6\x04c\x8f\x11v\xdd\xe5\xc5\n	\xf1\xf0\x07\xd4\x9b\x7f/{\x83\x82\xe86]\\\x05c\x8a1\xc1e\xd9>[x\x85^U'\x82\xb4\xbb\xad\x0b\xa3\xff\x8c\xd8\xc0\xb8J\xe7\xd7\xb7)*sd\xdd\x90;\xdd{\xc1\x1f\xa9\xddee\x10I\xc5\xf8\xd6\x16\xc1\xbe\xde\xa3\xc5\xd51 \xbe\xb6k\xd6s`\xf1\xa3\xd0W-\xfb\xee\xec\\\xcc\x0d\x88\x16\xb1\xf5\x9c\xa8\xc2\x9b\x87\xe7\x16\xe2$)\xa13\xf2\x1d\xf1]J\x8a\xeb\x9e\xd3\x0c\xf8\xbc\xb4\xa2\xb4c\x8a \x97\x19\xfc^\x98,R\xe1)\xf5\xdc\xa8\x9cK\xd1%\xde7\xa8+\xc9?\xb7\xee\x9a\xfb<m\x89(\xefd\x89y\xdc\xb94\xc4\xc7\xfb\x87B\x84=1Q\xf4\xe7\\\x91\xfe6s\xcd6\xde\xed[\x8a\x83w\xdem\xc3\xdb^i_xj\xe3\x0b4\x06\x1c\x8ct\x939\xe6\x82{2\x17\x92\x8f\xe7\xae\xefD\xce{\xa4\xcbygbn\x9e\x17\x1eP\xde!\xbe\xd8\n85\x7f|U\xc8`\xc8O\x1c\xeb@\x84\xbaw'\xabr\x1d\x80\x15\xc7\xda\x81]\xb2\xe1\x17\x97\xd4S\x1f`\xa2\x97\x8dB^\x1d\x90\xe73\x16\xccN`2w\xdc?l\xf0\x92g\x1d\xf5\xddC\x19)e\x7f\xfc\xee\xcb\x18\xa1\x93\xf9\x9a^mX\xa4\xf1\xe6\"\xbd\x80\x13\xfb\x82\x9cs\xdf9\x1e\x10\xda\x04\xe42_\xd5\x1f\x01xev\xf5\x0fbm\xd1%\x02\xbfJ\x10\xb8\xa5\x13\x1c\xb3\x8ct	\xc4\xc0\xe8\xa6x.Y\xddz\x91\xcb\x18\xc84>\xbe\x9bY,=.\x0bP\xf5y\x90h)~\x9dQ5\xed\x01\x89\x00\xc2\x082\xb6\xd5\x93s\x14\x0e\x12\x137\xce	\xdc\x07\xb6K\xd0\x9c\xfe\xf5F(;\x07\x10\xc4\x00\x03\xe3\xdcx\x98\xcc+3\xc1=\xad\x01\xeen\xdf\xc4\xf3\xea\xa5\xf4\xed#\xc6;\x032\x12\xf5*`\x84n:\x93P\xb6\x89l3\xc2\\\xcf9@\xda&\xdf\x0c\xd3@\xa6\xbe\xea\xdcG\x99\x06\xb3<\xd2\x89\xd6bYi\x93|\x9a\x0c\xb3\xa8j\xdd\xf7\x16oM\x94\x9f\x9fU\xefg\xa4\x10\x8f\xe8\x0cs\xf4<J\xdd\xacaw\x82\x9cr\xc2u\x94ya9Qtb\x94\xf1\x91\x86\xd5\xcdZ\xd3#m\xa41]\xff/6\xfe\xc2\xab	?\xfe\xfb\xff\xd7l\xa3F7\x0c\x18\x0d::D\x1d\x9d\xc2\xe8\xd1\xa3$\x04\x19\x1d\x82ntK7HwK[#%\x04Ii\xa5D\xc2\x00\x05\xfb-\xbf\xf3\xfb\\\xd7\xf7\xba\xce\xf9\x9e\xef\x7f\xf1\xba\x9d\xc7\xeby\x0f4\xe4\xf1\x0e\xa6\x8f\xf7\xc1\x02=\xe6\x0c\xb3\xa3\xb4\x0e\xb8d\xe4z\xbf\xb3\x81\xca\xfe\xa3\x8cbw\xf5\xe0vS\x01\x88\xf7\xac%W\xda\x7f]\xbe\xf8\x03d\nm$k3\x8f\x91\x11=\xbc\xb2\x04\xf7'1\xc2\xa7\xd5\xa9\x18\xce3G\x9aaa\xfa\xaf\x06\x02\x03\x99\x99\x8f^h\xa1Xo\x07J#\xb1\xb1\x9eS\xa3\xb7\xa4\xd1\x9d\"2\xa3\xd97B+\xaa\xb4\x93\x05\xfb\xea\xd7\x18\xaa=F\xc6a\xe3\xdb\xe9\xb2\x82rY\xaa^\xb7\x84\x83\xe4\xe7\xddw\xcc\x139\xcb\x80\xbb\x07\xaa \xe3\xdd\x80D\xbb\xb9q!\xaf\xdf\x99%\xa2_%\xb9\xe1\xb9\x92M;\x9a\x91\x12\x0f\xa39e\xc4w\xb5\xa9\x9a||l\x8d\x92\xd2\xfc\x86\x8c\xc7\n\x0d\xa2\xf1\x86\x96Y\x85\x7f\xb9\xf5F\xf9$+k\xaf\xfc\xd7\xe40\xe9\xaaR\x9an%.\xbf\x9c.\xa3KI#\xf2\xc6{\x87\xdc;:z\xdb\x94\xc8\x13I\xf4\x0f\x1a\xab~\xe7>\xd4\x1b\xb2\x8b\xb9\xde5\xa7\xc1<\xf8->\x9f\xdb\xaf\x8d\x88\xbf\xb3J\xcd[m\xbf;\xc4\xcd\xfes\xc8@\x00\x9b\xfb\xb4\xed\x98Gr\xa2\xda\xc5\x8dLPm\xb2\xf0\xb5\xfd\xf8\xd1\xccl\xde2\xf42k\xd9{\\K\xc6\xe2.g\x12WxO\x8a5\xa1l5\\h\xa3\xbai7\xa57zJ\xa2;\x1e\xcaV\x98[\x1c0\xbaw\xa7~lmW\xb2\xb7\xd6\xa4g\"\x90e\x1f~\xb3+\xb8$\xe3\xf7\xb1\xf4\xa6\x95\xe3IL\x9e^\x1ft2\xf4(;\xc9\xa1\x10\xc2\x88*\xc2\x88\\*\xb5j\x86\xcf<U\xd0&\xf8U~M\xfe\xdejlz^C6SU\xb4\xd34\x1e.\xf9\xb9\xb5 \xd9Q\xe2\x14\x87\x84q\x17G\xf9\xf3\x1d\x17\xad~D(\x83\xb2\x8cN\x19\xe8\x13\xa7?\x8av\xc9\xaf\xb2\xf2@\xda\xc2\x96$V\xdf\x80d\x84;\x13\xfa\xba\xe9\xdd\xfa\x0bM\xa6\xbfq \x1c0.\xe8\xee/\xcdhq\xdfU\xd0\x93\xdd\xc5\xf4\x1fc\x8c\x0b\x9dnLr@\xe6'\x9d\x9e~\xad\xbb \xf8~\x1f\xd3\xf7\xd5\x97\xfd\xa0\xa9\xc1:c@;\xa7\xe9\xf0\x8d\x9c\x93\xec\xbd\x15\xd7\x96A+\x1a\x03\xa5\xbb\xce\x8dk\xd9\xef\xe9m\x05'\xfa)\x93\x0dE\x1e\xbc\xf2k\x97\xddV\xa5\xb0BO\xf1\x83u\xe4\xc0\x10\xdb\xa4Dd\x87:\x91\xc5\xaf\x08\x10\x02}K\xfc mO\xc3\x90'l\xc1\x90R\xde*\xde\xa7\xf4\xfa\xc7\x8cS]ei\xb5\xfd\x18F\xa7\x12&\x03p\xa8\x8aw\x05\x9f\x9c\xfd\xea\xe3i\xf1\xcf\x81\xca4\x18$\x16Y\xb4\xaa\x8c)\xf8f2,\xef\xac\xc9\xac&\xbdRx\xf9\xd8Xy\xd1s)P!\xa46\xcb\xad\xc3+\x0d\x00\xb9\xd2\x0f\x1a\x98P/\xfb\xbe\xcbX\xd0sC23\x80\xcfS\xd3\xe67\xad?3h\xad.\xec\x80(\x18\xa1 Y\x13\xf2h%J\xeb\xf6\x83\xa2\x05-;\x88Z\x94\xfe\"\x8d\xf5\x86L\xaany\xaf\xc6\xf5U\xb3H\xfb\x85\xd9\x83\xf7\xf0^\xe0hv\xd1\xc6\x08g\x91\xc8\xf1\x9bt\xdb`\xe3\xf0\xa1\xc9+!O\xa1:\x10\xcd\xde7\xec\x13\xd1=\x8bxAP-\\\xb3\xb95\xc4O\x00'\xfbL\x9f\x91{\xb3\xfeF\x86\xf1\xebO\xec\xeb\xf9\x1e(\xd4k\x95z;\xbc~\x1e\"Ta\xf0\xf2J\xb7\x8cj\xc6\xb8\xc6i\x8d\xba\xe8\x80\x17\x9b\xe7\xd0\xba\xdf\x17\xa1\xf7e\x9f\xc6\xa1g\x9f\x98\x12\xaee!\x81n\xb8\x9b\x12\xaa\xec%{O\x87\xfb\xf6Uu\xbd \xb7\xbc\x13\xd0\xbb\xa6\xf7G\xe6\x7f\x15-\xd5H\xf1b\x1a\x00\xb1\x8a\xaf\xcd\xdf64\x1b\"\xc0?>\x8e\xe7=\x0fne\xdc:9\xec\x04\x89\xc2\xd6\xd7\xd2\xbb\xfe\n\xdb\xbeS[\xd7{{KMx\x99\xb6;\xfa\xde\xeb\x81m\x87\x92\x12\x0e\x87\n\xc9\xc1\x89\x1fo\xe2\x18[\xee\xdc\xb8-\x8a\x93\x1c%\x02Rg\x9f\xc9U\xe9\x87\xb1\xcd\xec\xc4N-\x8a\xd3E-\xdf\xd3\xfal<\xd3\xe2O=s\xfab*9\x1e\x90r\x16\xff#\x18\xebE9\xd6\xea\xcc\x08\x89\x02=w\x0f\x9b\x8e3\x01^\xd3\xd0\x01\x9e\x97\xc5k\x997\xa1\x11z*\xd5\x9a\xdf\xd5\xb78\xfc;\xe0uc\xfc\xc2\xa9\xa6PE\xd0]\xa3\xb4T\x1bfY\xa6z\xe6\xf6\x01\xb0\xe4\xcbB\x99Cc\xed\x14&L\xaaq\x07\xa0|\xe0%\xd7\xe5\xf9\xc8\xa4\xe9\xad`\xc2\x9d\xdb	\x86\xa2\xe2\x8b\x08\x1387\xe2\xd6\xdcb\xca\xe0]\x07\xc3\xa7\x0cC\xb9\x9b Up\xf0\xcf\x85a\x80\x93\x16\xca\xde\x06{\xd5\xb4\x02\xadD\x8a\xf8\x08\xc3\xbd\xcd\x04\xafT\xa8\x9c{\x06\x1d\n\xdf\xdc\xcbB\xbd	\x0b\xa1\x13d\xfb.\x0c)S\x82\xe1\x00@u\x92\x9e	\x80\xde\x12\x1a\x92)B{_\x0b2\x9bh\xe9\x93\xe3\x8d\xb4\xa0\xb0\xf7\xa3\xa29\xa0\xc2X\xb02\x1a\xa2\xb3\xe7<GD4\xa1i\xd5\xc5\xcd]\xa8\xb1\xbd\x99\xd0\xad9\xba\xe7YP\xf6\x82\x07\xeef\x82\xa3z\xb7\x8ez\x9c\x95;\xb9\x1b;\x18\x0cIq\x06.\xb8\xf4m\xba\x0d,\xd4\nK{\xbf\xfa\n\x1a\x0br\xacu\xcdS\x02\xcbm\x1b\xad\x04F/h\x00\xd1\x1d\x14.\xc5\xe0\xfc7\xf7\x9c\xf4\xafi\xf4\xeb\xdf\xb7\x81=\x9dw\x0e\x9a\xbf\xc7\xfc3\xc6l^\x91Q\xdf\x87\xb2\xab\xeeU\xa5\x13\xfbA\xf0\x94\xc4c\x83G\xe4\x05E91\xc7\x89]\xfe\x08?\x92E\xf8\xae@\x99\x1a\"\x9c\xbe\xc2\x9fC\x932'T\xde}E\x96\x00n\xa6\x1d^\x0d\xb6T\xfe\xd9\x97\\%\x88P\x11\xe2l'+\xe6\x8b\xa7|\x8e\x011Yy\x19\x0eC\xb5; \xae\x13\xd7|\xe3\xd9\x9d\xa9\xf4\xad	^Q\x9c\xcc\xea{\xact$Pl!Y\xb2\x10\xcaT\x1di	\xc7}eMpDA\x1f\xad>\x88\xca\xba\x0f\x95\x05\xab\x00 \x7fe\xb5\x1f\x86_\xfb\x80E^%\xb2\x86a\x02\xf7\x96\xc9\x8b/\xef1\x7fOu\x92\xf30\xdeVZ\xef\xbfZ/\x0f\x99GA\x8a\x00\xe0\xe9~-\xdd\x0e\xc4\xf3`;C\x91\xf4z\x0d=\x0b]dGG<\x8e3\xee\xed)\xfd0\x18\x94\x0b\x00+\x03 a,	\xb6c\x19\x907)N\x9dl@]\xb3_\xa3\xc0\xd5\x8a\xf8C,\xb2\x0c\xf5\xe0\x85vRx0\xff'/\xa5?\x9c)m\xab\"\x95\xdalzLq\x92\x1eiOn\x87\xcd\xbbK0\x1f)\xfc\x15g\xd0\xc9\x04\xde\xb8\xc4\x89\xaf\x9c%n\xacZ+\x87\xaa\x1f(\xe7\xdc5\xcb\xd9EK\xcc\xcce\x17\x13\x92p\x17I\xde\x00\x18\x0c\x93+=O\xca \xdfC\xd4\x89\xfdp	\xb2\xc3\x1a\xc7\xbf\x17\x16 \xab\xdbH8\x1f\x88 \x1e\xcc\xe9\x18\x0c\xe4\x14\xe8\xe6\x8et2y\xcd\xdd\xbbx\xec\xfaB\xf7\xa6\xc0\xaf\xa4^\x14\x12D\xcc\x96\n H\xb1\x90\x1e\xf4\x89\x89\xa0\xc0\xea\xdfs\xc8?!\xcf\xbc\n\x84:\xf1\x9c\x9a\xe6\xd7#,SV-\xabp\x0cm\x1d\xf1\x17^\xecv$\x07cq\xf4k\xa25\xa6\x90\xf0\xb87\xb1\xbd\x0b\xfc\x969*H\xd6\xf1P\\\x8a=\x04Nc\x9d\x1f\xa4x5y\x87\xd9\xc5\x0c\x8c\x0e\x95\xa7\x0d\xbd\xef= !z\x00V\xb7u1[\x12\x13\xd3\xa0oFe\x96\xc6Zi5HW\xdb\x16\x960;\x18d\x16\x05^8PA)l\x15W|\xf2Y\x95\x93\xf3\x17\x7fJ\xabq\xd8[\x15\x95\xb4\x8b\xb2]\xc3B\x8c3\xddFu)\xf8\x88+\x81\x97B\xc8\x82\x1a\x97\xa9F\xd2\xbf$#\xdaNo3\x97\xeb>\x1c\x19\x0f\xc2\xca\xad\xac\xed\xe8\x00\x06q\x12\xe5\xa5y|\x8a\x9e\xe5_1\xf2\xe1c\xe5\x9f\x06\x0c<2\xbd%N\x8b\xefq\x11\xc3u\x18\xde\x9fz\xea$\xdb\xd4\x8b\x83\xd4\x83J\xff&W4.\x91eGJ\x8f\xb9\x84\x9d\xe7\x10\xd55L\xcc\x91e\xac\x92B\x1c$H'\xd6V`,\"t\x8d\xe6\\\x87_ 2\xb28\xc2FV3\xad\xec\xabgrE\xfc\nIBi\xe7\xa1N\x91F\x80\xb7\xe3\x1a&\x97\xdb\x0c\xa1\xbf\xc0\x10K\x04\xdb\xad\"e\x16\x8d\xe3TM\xd1\xae\x02\x13\xbf\xbc\x16\"\x98\x960\xf4\x14\xf1D\xf73\xa4\xa8R)\x07A&9\xd9\x12\xceZ\x9b\x9c\x0b4\xa8\x964\x14\x84\xb6\xd7\xd7r\x88k_i\x8c\xb9\xd9\x14v\x85\xa2iW\x83\x0fI\xae\xe38\xf4\x8a\xff\xae\xd1\xa5\x9a\xcb\x9a\x99r\xd3\x82\xf6|\x8cC\x10w\xbf\xd8\xa0\x00\xa0\xc6\xb4'\x9b\xb7\xaf\xec\xb8\xa8\x0f\xcc\xbal\xf4\xe8!\x81\x8c\xbd4\xc5\x04!]D\xc3\x07\xfa\x90[\x14.\xda\xedD\xc4\x1b8\xf7\x9ea\x96\xa1\x87\x8e-\xc5\xda\x15V\xb1\x06\x9d7\xa8g\xad\x04\xc2\x8e\xaba1\"v\xefc\xd8\xbc\x90$t\xc3]\x12\xc3\xb3B.\x0ej\x02\xcd\x94n/	\xa2F\x16\x1f\xf7\xa4Q\xc1\x9a	\x903\xad\xa9\x8aU\x95\xb4\xad\xc7\x82\xa9\x8dM\x02J\xd1]\"\xa6\xdbq\x04\x02\xd5\xf0\xd6)\xa5$\x0e\xb3\xb1RM\xfdF\xaf\x1b^97\xd8\xd4I\xf0\xe2\xae\xb8\x81\xb6U\x1cK*V\xfcJO\xc1\xdc4\xa92X\xe1\xba\x13\xf6\xa3\x86I\xb5\x8d}\x08~zT\xed3X\xf7\xeb\x87\xdd\x8e\x99\xfb\n\x02i\x17^\xf2}P\xa42\xf8\x11\xc3\x9bF&n\xccQ\x8a\x99:\xa0\xe9g\xb1\xff\x0f\x11\xfb+D+\x84\xbb\xde\xc2q\xb6\xdd6\x9dX\x85|\x18J\x88\x7f.\xff@\xcdCv\x0e\xd9\x85\xce\xb6\xcei\xcb\xad\xa4#c;\x05w\x8a\x89\x11\xa9\xf5\xdfr\xf7/5\xf4D+\n\xb8\xa0,*\x8fA\xf3\x87\xc2 \xb3\xb0\xb8\xa0\xd0\xcd7\"\xbcT\xc4\xe7\x9d\x0e\xea\x14\xa4\x15\x07\xad\xf8\x1a/\x8b\xad\xc7\xf1ts\x861|\xe8\xe8\xdc\xe9M\xfc\xc0\xdc\xc8C\x05\xddX\xae\xdc)2\x84\x8b\x8bkt\xc0I\n\x8f\xe7\xcd\x1b\xa0L\xbd\xbe@\xc0\x9b\x08\xc1\xb8xp\xbc{\x98+\xa2\xce\xc2\x9e\xe9:\x8ad3W&\xd6\xe9\x14c\x1e\x9d\x17\xd1\xe7d\xd6*Ze\xcb=\x14\x1a#\xd4\x82\x94B\x03\xf0\xbc\x00L\xac\x82l\x1a\x83D\x85dEo\x8cT\xed\xaf$\xb6\\\x89\xb4\x08\x1e\xc7\xdd\xc1\xfe\xaf\xb2.\x05!\xbc%\xa9\xcc\xdc\xf3\xed\xf6_\x07\xb0<\x1d\xc5\xdaX\xb95\xe1\xd8\n\xf3\xd7\xc8A\x90yy \x9e\xb6s\xb0\xf7\xa1\x8b\x10\xb3\xd1\x93J{\x8c\xf5\x8er\xdd\x19\x8d\xa6\xa4\x98\xee)C\xe8\xbd\x81\x84b\xe0\x97y\xe8\xf0\x13i6@d\xae\xd7\xc0\xd4\xb4\xc9\xc7\x0eh\xb9\xb8\xb26\xac\xa0\xb9+\xf0\x9f\xed\xb3\x7f\xee1\xcf\xe2\x00n\xd4\x0dn\xe9\x89^\xac\x10\x9e2\xcd9O+\xe0\x9f\xfdt\xfbI\xc4\xf5\xd32\xc4\x10|\xe6	l\x0b\xd5\xdcL+\xc8\xd2\x1fq\x8cW'\xc8\x9a\x1d\xf2\xbc\xd0\x96\xd5\xf8\xf3\xe1	\xe4i/U\x8a\n/\xa5~0\xd7\xa7y\xfa\x82\xa1\x94I\x07E\xcb\xa4>\xd4K\x81\x14a\xa9\x9c\xea\xf2\xcc\x9bCw\xa69\xfe\x0f\xbc\xdf\x03\xa4y\xff?\xd2?\xff\x03\xef\x0e\xc1\x91\x1b\x10\xde\xff\x0do$\xd4k?\xb6\xf3\xff\x0fo\x0e9<\xd6\x05\xc9j\xcd\xa2\xd9\xe40\xd9%\x08H?,TI^~\x9f\x12\x9b\xaf\x12\x94p\xae\xb8-\xd2-\xa8=X\xca5x\xc0\x03-\xd3\xcf1\x8c\xf2v\xcbY\xac\x08\xacJH\xb5\xc8\xa5,4\x13\x10\xcf\xd3d\xb5V\xd8\xb0N\xe3\xa7\x91\xf2\xa1\xf4kW\xac\x1f7\xdd*\x1e^h\xeex\xf6*\x97-6\xe1\x98\x80\xf5^9v\x9c\x1c2k\x91\xf1\xd8,\xcd\x9b\xd29]\"\x85\x07\xb1O\x00\x84\xb4\xdf\x81\xee\xd9gT\x18\xd4\xddm\x1b\xba\xfd\x98\x00\x0cS\x91\x9d\xa5\x7f\xdc\x1d\xc7\x14=j&\x0c\xcf\xd2\x88\x0c\xbf\xc6\xf1-\x9f\xd0\xc6\x9d\\{\\$\"\xf8\xc6o\x15\xcdn\xe7a\x0b\x034\x16\xe8F\xa9\naoO\xeb\xe8)*	\x16\x84:='dx\xbea\xe6	'e\xc1\x0e\x9e\xd6X\x11\xd2\xcf\x95I\xf7\xdd/\x9b\x94\xd6Z\xbe\xe5C\xeb\x0e\xf3}\xc2N\xf3\xf5\xb9[\x9co\xae\xb2\xc4\xd6\xce\x7f\xfav\xe9\xd74\x9d\x8bqt/\xf3\xe4aK\x88\xf8\xb1%%N\x1fc,\xf8u\xa6\xe0\xa5\xecu_\x18\xf1\xfe\xa7\xa1\x1dM\xa6\xde\xc2N\xe8]\xd0WE\xa3\xbb\xed.\x82\xdc\xf7\xe2\"\x84\x85\xd83f\xc4P\x06\xa5\xd4\xf7U\xf7\xed\xbb\x89\xda\x1e\xda\xd0B#?I3t*>'m\x1b\xce\xe8\x12\xb2\xb2\x19\xf96\x1a\x98\x1e\xb3\xc2\xa5yK\x86\xab\xae\x936\xf0\xd3\x9a9\x02\xbe\xa4\xadW\xe5\xa8\xf2\xf9\xbc\x92\x87\xc7n>YX:N\xa3:B+\x85iL\xea&\x9ak\x0d]\xf7T\x1f\x93\x9f\xe5>\xb2\xe5\xe1\xbe^\xf8^/\x8c$\xbf{\xb7\xd7X\x03\x89\xb8\x9b\x1f\xb1\xc7\xd1\xd1\x10[\xec\x84\"\xb2@\x1d\xc7\x11j\xeb\xf0\xb6\xd2,\x1d\x8a\xa9u\xb8W\x06\x97\xf9\xaa.{\xb3\xa5\xdcQ\xcd;\x1d6#\xa5q\xde\nv\xd0j\xf8{q3\xdbR\\\x8c\xd2V\xf8%\x94\xbd\xec\xa5\xe3\xa0E]\xf1m\x0dz\x1b\x9b%\xc2QI'\x96\x12\x07b;;\xcf\xd6e\xb8\x80\x18\xb5\xf7{\xa5$(\xcb\xe2\xab!\x8f\xd5F\xb1\x90\xc7\x98\xb7G\xf3\x00\xcd\xe3\x82Ti-xera\xf3\xbf\xa6x\x14\x9fB\x83U\xf4\xb8(x\xe9\x89\x96thK'\xf4\x023*\xf3Y\xe1\xa4E:\xf9o\xed\xc8\x8bG\xd4\xb9\xaav\xbe\xb7\xc7-\x14\xb7U\xe6t1:\xfb\x08\x11\x97k5Z\xd9Q\xf6\x9fPgc%\x99\xf6\x12\x8c&cd\x80\xec\xdaul;\xb0\x04\xf0\x9dt\xcf\xf9\x1f\n\x02?\xf2\x05A\x1f0\xde\xd90\x03\xf6\xf3K\xb3\xd2\x17\xbc\xc2\xa6\xc7\xb5\xf6\xc0Z_\xbe\xb4\xd3\xd4\xc2?\xf6\xf9\xb8\xcef\xba\x8d#O\x8c\xa0\xe4\xc0\xb3\xa6\xdf\xa2\x02\xadj\x0f\x9ezq)\xd3I\x97\xb56}:\xba\xa3\xbc\xef\xc0\xf2]\xc2\xd5>\x96\xa2\xb8G\x9a\x10\xa9~J\x16\xfc\x0e\xf13\xfb\xaa]q}\xa3\xd6P4\xdc\xd7\x92NH\xe7\xc5e\xc4\xa9\x04\x8dt\xe60\x87\xc2s\xdet<\x9c\xa3e\xbd\xb4\xbb\x18\xda\xff4M\xdas\x1d\xbd\x81m.b\xedy\xb5pK\xb9\xf5\xe7\xf7	\xfb\\>\x87Ji9\\\xee\x0f\xbe\xeb\x82Na[\x8f	(\x95\xd7[\x0e\xcc\x08\x94\xa8@\x9dJy\xeb\xb9\x1fZ\xf2a\xff,\xb2\xc1\xc4\xe7\xec\x15\xe6p\xeek\xbc8\xad\x03\x9eB\xc3\xad\xf0\xa6\xe6s\x15\xcb\xf2+\xfd{\xdf\x98\xd2B\x9b\xfe|R\x0e\x11\xc2^\xce\xd8f\xb5\xec\x85\xb4f\x8f\xd9\x85I\x8b+~E\xf6\xfa\x92\x03\xc6\xe8tj$\xba~\x7f^\xf4\xab\x9e\x19^<\x9b^\x98\xfc\xa8\x01\xc7\xac\x8d<b-\x15L]\xc9\xdc\xe1\xe9\xed\x88\x91\x1f-P\xb9\xa6\x03\xb8n\xbf\xf0\x16\xc8\xd6/,\x1e\xb2\x03\xa3\x07\xac\xa1E\x87z\x9d\x0dN\x89\xd6D~\xec>\x14\xc8\xbc4kPu\x92\xbe\xe2\xb4\x93v\xbb\xf0\xaa\xa5\xc3v\xb1\x8f\x90\xb8\x86\x8a86\xd8sh{a}\xe89\xa3\xeek\xf6\xe7.v|\x97t\x9diLP\xac\xcd\x06*\xf1\xfd\xe0Y\x93\x93\xc6ks\x14f\x15\xe8\xe6\x02\x95\xd4\xd6D&g\xe2\xc3\x9cHt\xfft\\5\xe7S\xf9it\x1b\xfa\xfe\x04c#[\xef0\xb3\xab\xa2\x8a\xf1\xad4:\xc7\xc5\x95\xd5\x00\xcf\xe2U\xf1X\xf6\x96c\xb3\xb96v]\xd9c\x8ac!\xb5\xfa\xd3\xf0\xc4\xd5\x8e\xa7\x9e(\x0b\xcew\xc7\xf8\xf3\"\xe4A\x82\xa4\x01\xe1m\xb2\xb4X\xa2V6\x8a[\xf7\xc4\xad-W\xb6\x8b[]6\xfer~\x86\xdbQ\x85\xa7\xc4c[\x1e\xc2\xbd\xc8\x96\xd1\x01\xd2\xeb\xfdb\xf1\x8c\x96\xfc/\x9bI\xff\xd6\xbf9YG\x05\\\x9c:\xa7\xee\xdb\"\x7f\xf3.\xe2\x18+\xe6\xec\x89\xacYR\xd6\xa1-\xf4O7u\xca\xd8\x10\xc9\xcc\xe3U\xcc\xd7\xbdg\xa0\xb1\xc6l\xc2p*p\xdc\xad\xa4\x88\x04~\xcer\x96\x88\xf5\"L`X\xf9\xbf\xe5\x1e\"(#\xa1\x82\xe9\xc6\xfdr\xd8_\xd3\x15\xa1\xb0\x8edP\xfe\xd7b3\x10\x90\xeb\xe2\xe5%BG\xf7\x0bk\xd7\x19\x88Ed\xa7Z\x1f\xed\x15.\xbf^\xe3Zs\x80\xe0.\x94\xe2\xb0\x18\x06(\x89\x12\x9f=\xe3\x9b-^\x91\xa6Cty\xabi\x9e/\xa6\xb3\xa6\xd48\x8frA\xads-y\x98j\xc9Bd\xc0\xf8\x01 \xa0R\xdb\xa7a\xbc\x05\x1b\x1b\xb2z\xce\xccz\xfd\x8dN\xad\xd7t\xb16\xba^\xeb#P\xb2b\xc9(Xa(&8`X\x01\xc5\x04\xbf\x99\x0b\xd0\xf7\xa8\x82\xaa\xcd\x0b\xf7\x88a4\xa4\xb7&(\x0bq \xed\xe3\xff@4&T/\xddi\x1c\x9c\xfe9D\xd2uL\xc0\xf4%.N\x90\x14\x00\xc5G\xd7X\xba\xf8\xb4\x89C4\xee\xc3\xe1ibUG\x89\xe5\x15l5\xd1\xee\xc7\n%\xbfW\x96\xd3\xd9\xca\xb5\x938?\xc5\x00\n\x06\x94\x98	\x8d\xe4b\xe8\x95\x00\x1c\x1df\x9b\xd1]Z\xfa\x06\xbd[\xaa(\xe4n\x12\x8a<\xc4\x0c\xb8\xb7\x8a\xac\xa48\xdb@c\x99\xe9p6\x9fde\xb5\x1a\xa9\x1f\xd5%\x02 #\x97N\xdfh\x7f\xbbs\xa8-\x0c\xda\xfemE\x83o,\x1b\xa0\x1d(#Q\xa2\x99\xed\x17\x1az\xef\"\xed\xf3\x91\x85\x17\x8c'\xfd\x02A\x8a\x93\xe9:\x18i\xa0\xbe\xc6>\xba\x96yx/\xd0\x92\x93\x07\xde\xec\xe7\xb76\xf0|6\xa8oqL\xcc\xb0\xaddK\x9f\xaf\xde\x94\xf7\x14\xc6F\x1e$kg\xd8\xe4\x84\xef\x08\x98j\xce\xd5\x9d\x7f\x0f.\xac\xa6\xa4\x91\xb6N\x9a\xdf\xa2X@\xaf[cd\xeb\x9b\xe3\x1df\x17\x9e\xff\x13\xf1\x8f\xb2	H\xfc^l!Y\xf6;U\xe9C\xe36\xfce\xe5P\x83\xb0\x9b\xcc\xec\xe1N\xac\x08\x13{\x16\xde\x10\xa0\xe6\xc0\xfe9\xe2}\xe4\xb7*\xc1X\xe7J4\xce\xe1;\x83\xb9\x171Y\xb1g\xfc_#\xc1\xaf\x05M$\xe87\xf0\xb1\xd7s\xf4}\x8b\xc2\xcb\x06Jo\xf1=\x9c\x1d@\x99\x05\xbc'C_\xdf\x94\xfc\xc5\xd8x\xde\xce\x96\xf3\xb4/L\xcd\xd6\xc2\xe0ou\x8a,\xfa\xed \xfd\xf7\xc7\xc2oV\x82\xb5\xbc\xde?t\x19\xb9$;+uB~\x93\xd9h\xb4\x7f\xb8\xf4\xc2\xcf^\xd6m\xbaG\x0d\x0e7\x0b\xc5\x8e=\x0e\xe7\xfa\xa0gNx%y{[5\x87n\xe7|\x1c\x9f\xffq]\xb7\x1a\xe9\xb7.9q\"\xf92\\O\xd3\xb1\xfa\xc3y\xa6RZ\x84\xe8\x8e64\xfa\x9d\xb9\xd1O\x91B\x13*\xeat\xd3\xfbB[ei\xbc\xf8j\xa3\x80\xc1\x11\xf7PU#\x98\xca\xa0\x0f\xe88.\x1f*\x16k\xfb\xee\"\xf0`\x86\xec2;\xf8P\xad\xc1\xd6\xdf\x8f\xd2F\x83qwz\xf2\xc6\xf7\x8f\xdd\x7fG\x02]\xcd\xab\xbe\xfbG\xa2\xcf\x9e\xdar/F8\no \x18\x12\x8ae|^\xfc^\xb7\xa0R%\xc3\xefH\xdcm\xeek\x01sq5\xfd\xcaf\xb0\xca\xbd72tC\xae-\xd1\xcb\xd7\xef\x8e\x0f\xd7\xd5M\xdfS/\xde/\x11R\x07(\x06E\x1bt!\x85h\xfa\x88\xa7{\xf5F(g\x81\xe0^'s\x13\xc3{\x957\x85,\x19\xb7\x14l\xec\x83G?\x87)o\xf8\xdd\xcc\x1c\x19f+\xbbd\xd1\xbb\xfc\x98\xc9\x06\x18\xad\xe8\xff\x06m\x1d-\xdaH\x87Z\x99\xee\x17\xd7\x84O>\x8b\x87^e\xcb\xa5%h\xe7r\xd9z\xd8\x12\x1f\xf2\xbe\x98HWi\x15s\n@\xcd\x82`\xd9\xa7C\xe5\x9a\xe2\xc1Q\xf3\x87+'\xdfO+\x7f\x95}\xaan\xd7\xfb\xf0\xe3\xfd0[\xa4\x0dR*\x9a\xf1p\xcd\x816@M\xaf@\xa6Aa\x8b\x8e\x14\xd6\xb7\x07\x06\xd9\xae\x18\xa4\xddZy\xe8\xd6\xd4\xe1\xd0tz\x87\xc1\xe7\x8b@J\xe7\xb1C?\x8f\xed\xbb\xf8\xcc\xe1\xd5\xc7W>\xb6\x7fp\xbci\xbe\xf8\xa4\xd1\xa46F\x1c\xaf\xa2\xf6v\xae~\x87\xe7a\xb0\xa8|\x869\x0d\xf8I\xb5A\x0f\x07\x0b~5\x8eH\xdd=Pe<\xd6\x81l\x9ed\x84\x89\xdexL\xa5(V>\xbb\xa6\xa0zK\xf6\xfa\xd3\xd9'{\x8e\xdf\xb9\xd8F\x8b_\xd5\x00Y\xb8\x1a\x90\xe9\x07\xcf2\xec\xaf\x86!\x18\x05\x92\xa6\xfe\x85\xb7\xbe\x04!\xee\x95\xa8\xdd\xee\x0b\xd7\xe3=\x89r|`\xf2`U\x0e\x07\xba\xe4\xb5Ky-\xdeM\xfc\xb3\x99\xf8\xfc\xc5\x8bGR\x8a\x87TP6\xec\xbf\x07\x9dR\x0e\x11\xca\xfa`\xcf\x86_b\xa1\xb3\xf7\x17}(\xe0k\xa8\x88\x11]V3\xb4\xecZ\xad\xb3Q\x0eyT\x03\xa6\x98\x08\xff\xbb\xf2@\xa6\x0eR\x88w\x98\xcf\x81\x18\x11)\xf8m\x15\xdaLV\xee>\xa1\xaf\xf9X\x89L\xf8~\x98\xf1W\x1bW\x96ah#J)\xffT\xbd\x16\x06\x9b\xeb\x00a9\x91{X\x86\x91ln\xda\xc2H\x806\xa5Z\x98\xdc\x9e\xe0r3\xcct\xe4@[\xb5\x03N_\x80	\x1e\xba\xfa\x86F\xf3\xd6\x05y:_\xfb\xad\xf2\xbd2\x00\xefN\x82xR\xac\xd0V\x95\xc0\xf2P,]\x1d\xd4\x06\xc5cZhf\x87\xa2?\x96\x8b/\xd4U)	\xd4	\x0e\x82>\xa8\x82\nv\xdfk\xd7\xe5'w\x11W\x94\xd5\x94\xb6i\xb9\x98\x98\x08gp\xeb\xe1\xab1K\xdc\xb5g\xf0'sV\xbd\xa7\xd73Ba\xd5\x00\xfa\\\x0dWP\xd8\xfd\x81\xd0D\xbf\xcbP\xf2\xea.|tL\xc9;P\xa0\"FH\xb8\xe3F\x133G`\xff\xf5\x12\x8bDe\x92\xf6\xabe\xa9\x83\xc4\xfb\xf0y\x8aZV\xac#\x16\x9f\x1c\x0c\xd5R\xa1`\\\xd5O\xb3n\xc3\xb4\xefG/J&\xe3\x80\xdaDS:Q\x93\xc7.\x06\x92\xe6W\xed\xce\x1f\xe0u\x15\x10A\xba\xb1\xfa\xf8\xb4J\xbaR\"T\xb0\x0b\n\xbbm\x1b]i,6\x1a\xc9:\x9c\xf2P\x01!\xc0\x92\x063\xe3\x7f\xcad<\x1b\x1el\x15\x17e~*\x921\xcf\x91\xb9\x8d\xb4$\xab\xb6\x9cF\x8a$9\xd7\xd3p\xdc\xd8u\xdbl`\xe3\xd9\x06\x1a\x87\xba\x9c$j:\x9dAK\xdc\xa0\xced\xa9\xdfI\x96\xa0z\xe4\x7f]<\xdd\xd4\xa4\x8b\xd5\xf4G\x9e\xe9\x1fa&e\x82\xd1\x1b\xe3\xb6\xd6\x1d\xf4\xd7q\xcc\xb4G\x94\xb4\xb9(\xd4D\xe4;\x94;pR\xa9\x1d\x0fI\x98K\xba($k\xca+3\x1c\xb9\x83\xbd\xe5ON\x03\xd3Y\xa0\x87h\xd9\x9cz!\xf8a\xe0\xad\xcb9-\xe6d\xe7\xed\\\xb0\xea6}Y\xbf\x81\n>]\x11\x88\x0cbO{\xc4\xe6\x8f\xa5b\x17\xd8=mI6%T\xf0gq5u<pzWy\x0bE_HL\xa9w%q\xbdd\xe9V\xb4{\xfe\xc3g\xab\x03R}\xaa\x80\x1bxh\xa3	\xde\xadO\xea\x8cMTJ\xf2\xbap\x95\xd6\xc0\xc6\xba\xe7 \xe7\xfb\xa2P\x08\x93v\xacR>9\x89q\xed>y\xcd\xd3|8\xcf\xed\xbb\xef\x9b,\xf5\xfeqz\xcf\xaa\xd8/V\xb4\x97\xfd\xaaS\x9dpQ\x80g\x90\x17\xff#\xb9\x98_\xee\x85w\xe4L\xb7\x0f\x1cVI\x81\xfb\xe2\xc55E\x81[A\xdc\x0f\xf1\xb7dTSz\xa1z\\\x08?\x98\xe0-z=\xb3\xf43\xce\xe0\x97a\x9f\xdd\xa2\x9a#\x8a|\xd6\\\x1d\xf3\xb2\x9c\x07P\x85h\xfeYq2n\xa2\xe4\x8fP\nz\xe5Z\xfbQ\x1e\x8c\x02\xe2\x98\x04\x99\x9d\xea\x94\xfe\xccx\x93\x1cL?\x19\xe1\xfb\xa6Th\x07\xdf\x1aT\x1a\xf0\xc6\xca\xe2r\xc0\xb1g\xb8\x88\xb2RFw\x08\x9c:rw\xd4\x81:\xab\xe4#\xbc\xcb\xc5\x8e\xca\xc6h\xcaM\xc6o\xfb+\x1b<\xb2\x14#\x905\xa8\x11)0\xdc\xa3Jm/\x91\xdb\x06\x02\xc2\xe9\xe9\x95\xc5\xce?\x91%(\xf8\xa5\x81\x00\xa2\x80\xfem\x7fvo\x1c\x94\x8e\xa6\xe2G\x12\x1c\x03P\x1b\xb4\xe5 v\xd8\x80\x12*\x95\x8e\xf0\xb6\x1dP.\xa5\xe8\x0d\xaa\x1dc@\x06T\x84\xa1x\x95\xfeklef>F\x11ms\xbcTR\x9d\x04\xe4\xd0\xe0=\x0d\xe0\x16\xfdU\x14\xcb\xc6\xab\xf2K7e\x0ed\\X\xcd\xe9\xf2\xa0SB[\xa4\x08s-\x9e\xf30\xdb\xf7\xfb\x91s\xc6\x05\x17\"\xc9f6B\xd2\xd4\x10\xf6\xed\xc2,^\xd4\xea\xab\x01\xd3\xb7]\x93\x1bSW\xee\x01\x01\xf6)v{?j\xdd\xdck\xafp\xd0\x1a\x01@t\x05\x00\x112\x82?V\xdc\xdb\x07\xc1\x89-gL\xe4\x7f\xb9[\xbe\xa8\x85\xc0\xfe\xcc\xf9\x16_\x0fa\xa1\xe5\xa5\xd6[\xfe\xe6\xb9\x7f\x89\x04\x9c/j\xcag\x95<H\x10\x0f`S?\xaakHz\xc9\xf1R\xae\xb2)\x16\x9a\x10X\xb7\xc9\nKP\x96r>(V\xd5\xe4\x8d\xd2\x96\xb2\xad\xe0\xbd\x13hfGV\x8d\xed\x10\x8aX\xab\xbf\xd6I\x0e\x0c\x87r\xfc\xf2K(\x86\xf5l;\x9c\x85\xc6C\xa7l\xc2\xbb\x1a7\x95`\xff\xbcc\xe7O\xa1\xb7\xb5\xa4_\x18Z\x0e!\xe5\xe4G\xaa\x8b\x8bh?\x14`\x82\xea\xcc/\xb2oC\x8e\xbd^h<`\xd7h\x89\x9a\x02\xb9\xd8=\x08\xc6	\xf0\xaa\xa6\xc8*\xb79\x0f\xe8yC\x05\x94l\xdb\xce\xb3<X#\x8d\x98\x8bkqju\xb79\xe5\x9d\xa8\xa5\x8c\xc5\x019I\xa5}\xfbi7\\\x1f@J\xe0\xd7X2\xbe$\xe1\xfc\xd6\xdb\xb9V\xee\x0d\xfdf\xef\xdbF\x86[tTa\xa1\xec\xf7\xf2^\xf4_\xc5P;\xce\xbe3|\x90$E\x9b\x19\xdbR\x91\x18\xfb.\x91Zd\x0b\xa9&O.\x94\xd7\x9e\xd4\x8a*\xe4\x1aS\x7f\x1cj\xfa\x17\xder\xf3\xd2RM\xe6\x84\x029p\xd7&\x02\xe5\x95\xdb1U\n\xaa<\xfe\x93\x1c-k\xe4jr-\x14\x87\x8a\xbf\xb9\xde\x15\x86FzO\x96\xf3\x9fvEF\x94\xa5*u\x9fd\xc7\x99\xf4\x02k\xd0J>#9\xcar\xb1\x9d)=v\x82\xed\xa2\x84:\xcdLU3\x9c\x01YG\x9c\xa4\xd7{1L_\x88\xecj\x19\xbb\x92?\x87\x14S\xce\x1b\xc2\x14'\x80\xfcI<%a\xa1\x8f\xf8\x015B\x99\xfaB\xa0\xcc\x9f\x8d\x85\x0f\xfe\xf8R\x9e\xf4\xc8\xd9\xb9\xa5v\xf6OV1<)\xaa>\x96\x1c\xb82\x9fK7\xae^x\x87\xa7\xf8D\x86\xb1\xc8\xb9`c\x80k\xa7v\xab\x1b\xf7g-\xa0\xa6D\xb8r\xd0 \x19+\xac\xf6\xcb\xbd\xfa\xc9\xe0\xb9\xcb\xe0@\x95^\xaa\x0b\xd8\x845\xf2\xb1\xd1\x93\xd7\\)\x1b\xbf\xf9\xf9N@\x9dF\xc2nKO\x9e\xf3\xf875JL\xecS\xca\xe5\xbc\xa11F\x93\x7f\x98\xa42\xc1w\xe2-\x82\xd6\xd8\xd4\xdf\xc3\xdf\xc8?\xdb\x90\x0bf-\x1b~f\xe4\xf3\x8cQ\x18!\xb4\xf7L~\x11T^Q\x94BJ\x11\x0e\xe1U\xc7!&R\x9f_\x15\xcc\xc7\x07\xa7\xb0\x17\x1a\xc8\xca\xbd8\x7f\x02\xf4d\xb5\xe7:{\xae5\xac\xf3\xf7\xe4\x19k\x05}\x13\x12\xc4\xb8S\xbe\xdcH{\x05\xcb|\x98*F\xf7\xd7X\xaa\xf8\x9a\xb6\xb9\x1a\xd4\x97\xdaF\x83n\xddc\xb6\xb6U \xe2\x13\x99\xd2\xc4>\xa2Y\x93\xb1L\xb9\x16\xc2/\xd5\x86\xe5\xbfq\xeb	\xf2\xfe\xea\x1f\x96.\xad\x91\xb0\x93\x8e\x1c\x86\n\xa9\x8f\xccKj]\xe9\xbc!\xd72\xe2\xd3\xc7\xa0J7z/\xeb\n\xd7+\xd4\xff\x11\xfb\x17\x804\x0f\x00\x05\x08\xfb\xdfb\x7f\x87\xe0\x85\x93\x87*b\x010\xc9\xff\x1b\xeb\xd5gr\xd5@\xadj\xbb\xf8\xb0\xeej\x8d=Dk\xa5\xf0\x0e\xccq\xc0$\x08\xc3\xb9C\xae\x1e\xc8\xaa8\x8c\xdbT\xe9\xaf\xb3*v\xa5@R\xd3\xbelU\x16\xa0\x18\x17\x0b\x92\x81\xfb%L)\x04\x04\xc7\x0c\x0c\xb4\xc6\\\xad\xc4W[K7\x1c\x1d\\\x91\xbf\x9b}\x80Y|?\xdd`d>\x92\"c\xb0,\xb9\xd0\xea5\xd2\x11\xa9\xd0\xdb\xba\x14\x13L\n\x9fO\xb1\x12\xdbR\xb2\xcb\xe5\x8a=\xea\xf57\xee\xb7\n\xf2v\xb9\x98\x9abo\xf3?\x16}\xf3\xc6 \xe6]/\xc9\x00\xf4\xbc\xc8\xe6*b\xeaN\x86S\xfa{	\xb3\xcag\x0fc\x87\x1d\xd1d\xf2\xfe\xb5\x9b\xafB&\xe5t\xa7\xa5,o\x9a\xd8\x06\x80ag\xcb$O\x82\xe6\x19\x93]\x175F\x89\xff=u8e\xf6\xc8\xb6\xf7qB\xa5\x88\xf7\xbc\xe2Lh\xc0\x87\xbbK<\x98\xf9\x0c,\xc1\xe5\xf9\xf0J\xa4\xc6~XN\xcceQ\x8ff\xc8\xfa\xab\x12\x0f\x195\xc2u! \x03g\xe9\xa1k\x84J\xe7\xecg\xc5\x17\xd5\x8c\xa6u\x081;\x0f\xfd\x95q.\xf0]6ZV\xc9\xee\x959/\xcb`\x8b\x12\xd7`\n\xe5\x9d%t\xb2K\xe0\xc1\x82\xba|\xaa\xb2\xc3\xa65\xda\xd5~s;'\x06\x02\xc4\xc7H\xce\x95\x15X8	\x0fy\x08>\xe8s\xcc\xa2\xd7\xebD\x1d\n\xc6\xf5\xe2\xe5\xf5\xfd\xb3\x9e\xbd\x040\x05Ix\xbe\x8b\xd8\xec!\xec\xc4\xff\x8d\xf8\x96\xc2\xacYX\xebzg\x13\x8du!TG{N\xb1i\xbd\xad\x9df\x08a\x93\xc8N\x15\x18n\xc8V\xce\xebv]\x8d\xfd\xfb\xcc<\x16\x16]\x14l&\xdcB\x0er\x0b\xbe\xe5\x9e9\xc1	\x8e\xe4b.\x9c\xd4\xf1\xdcf\xcfX\x9d\xd4L\xf1~\xd6E\xb1\xbf\xc1\x1d\xc3\xc0NH\x99\x9c\xa2}\xcf:\xff>\x97e(\x0fy\xc7u\x9f\xf96\xe7\xb5\xb4]\xa4tB\xcf\xb96\xa3iE2\x86\xe3\xf8?m\x0f\x8dW8\xa1G\xe4\x1d\xab\xcb\x805\x84\x89\xb9\xe4<\x00\xea\xb8\x00)\xefX\xe8\x8d\xf1d\x81\xfc\x81\x8bjffk\xc4\xa2\x90*\xfc\xe2\x91x\xf7f\xad\xe4\xf1\x05qn\x03\x1f@\x85t\x0f\xb7\xadu\xfb\x0e\xf9\xac\x99\x9f\xb7\x034\xa6*\x1f<:+\x8c^j0z\x14\x9e\xfd\xcb<Y$\x9c\x0c\xf4\x0e-l\x980\xfah\x0b\xe1\xa0\xdf\\\xe8\x89c\xce\xech\xfeP.\xea\xcd\xbc_gO\xa0\xcc\xa0\xe5\x15~\xd3\xdf\xbd2_q\xd7\xef\xc2@,\xd5\xc8\x1c\x89\x8a\x95\xef\xea\xe9NA\xc9j\xcf\xc7s\xf4\x12\xceF\x1f\x93\xcaF\x9a\xd3\xed\xbe\xa3c'\xb4o\x9c\xbc\xed\x0d\x12\xad\x9b\x96\xd1z\xd5\x91\x92\xe3\xc4\x9a5\x19\xf4\x16\xb0\xb3\x86TW\x99Ooa\xd5\xceL\xd75\xe7m\x81M\xdb\xe6K\xb5\x0b\xbc8e\xfb4\x91Q\xb7Xo\x0d\xbe\xa7L\x1e\xfa\xcf\xa7\xb8^\xde\xca}*5\x9a\xe0\xd5?\x0f\x0c#Rc`\xa9\x99\xd1\xe7\x85R\xa9\x03U\xec\xad\x9c\x00\xe3g\x88\x9d\xcbu2\xda`\xe9o7\x8f\xf3\xf9y\x13U\xee\x84\x16\xb0\xeayg\xc0\xee\xce\xab\xbc\xc1F\xd3 \xfd_I\xb1\xe7\x9f\x94U\xd2:uq_\xb0\x0cT\x99\xa4\x05\xeb\xa1\x06\x975o\xcb3\xd7\xbd-\xd1\x7fs\xee\xb6\xa1\x04\x18[\x0f\xaf\x0c\x82\x0d]\xb8{\x1e\x0cc\xb2\x18\x92\xefu\x97P\xff\xe0Pog\xb7\x91O\xca\x1b\x93R\x7fg\xa5b\xa4\xaa2\xbf9\xd8\x12V\xf6n\xf7I\x90GCY6)HS(\xd5UFW\x1a\x83\x9b\xd3\xdb\xc86\xde\x98>\xbdo\xfd\x87\xf2n\xa2\xb9\xdf\xdb\\\x82\x13\xe4L\xb7\x16\x90\xf9\xecd\xce</\x9a\xab|G\xc3\xc5u\x07\xcc\xa1\xcf\xa0L>\xe6\xfb\xdd\xa0\xf7\x14\xd6\x0b#\xdckX\xe7\xb5\xcc\x94\xe6\x8c\x16tb\xaf\x18n\x9b\xd0\x13\xff'\x03W\x010o\xf8\xb1\xbb\xa4/\xb7jG\xb5\xe3(\x16\x9b\x10p\xa1j\x07\xa5\xbb\xd0\xe6}\x94M\x92\x86\xd9\x9d\xfbZ\x92\x9aX\x08\xb0|-\x9e\xf8\xa0\x1e\x08P\xb8\xcc\xa0\xe2\x14\xa5= \x1dhK\xd7\x16T,\x84\xca\x08*\xe8\x1f\x1eq\x12\x17\xb5'\xe3\xfc\xe5\xff:~\xcb\xae\xd1\xd2V0\x8c\xcc\x86g\x1e\xa8Odj\xff7w\xc5\x96\x08\x14/\x16\xady\x93\x0bD\x16\x13\x04\xdb\n\x99\xe8Y\xcc\xb3n\x9e\xee\xd8\x03\x90sI2*t\x89\x0eE \x86\x96\xed\nc\x1dW\xa5\xddk%\x17m\xf57\x07_	1Y\xae\xf2^\x0c\x93\xe2\x04&\xb2\x18\xf2\xbeQ\xe0_\x92\xf4\xe6\x10\xe0`Ql\xde\xdf\xc0*~\xd96w\x1a\xac\xb8{0\xbbAe\xcc\x12}\x98\x84,\x9e\xcb\x0b\x10\xa7b\xf2)\x07\x8c\x03In\xae\xde09\xf6P\xb0\x8f\x9b\x97(u\x11\xb6\xc7\x8d\xb0\x90V1+\x13\x04\xdd\x92\xb91dj\x92\xc7\xdd\x0e@\x9c\x91\x82\x18T\x9e{FAr\xc2\xa0-d\x9f3\n>\x8e\xe1\xff1\x8cPO\x8bJ#\x83\xae\x9a\x8e\x19w\xf5/DX\xb4\xc40\xe6\xe2\x80\xbe~\x14\xdeD\xaa\xeb\xb3^\x89\xcb\xfe\xbb&B\xde\xe5\xda\xeeW{\x82\xeb\xb1\x96\xa0l\xc3\xa5\xe4\xc8\x7f\xbb\xafx(;0\x1c\n\x8a\xfe7\xae\x8e\xdd\xb6x8\x85\x8f\xc1<1(\xf0\xf88skh\x9f\x91\xaa\xb4\xc8\xcb\xc1zv\x855s\xd2\xab\x8b\x06\xc6\x8d\xdbM\x192LVx\x9e<\xad/\xd4X\xa2\xf7\xd7\x01R{D\xa5W?Dn\x11\xe2\xd5\xb2\xc9\x12o\x1cEj\xe9\xa2?\x94\xce\"f0SC\xbc\x05\xa5Z\x8ckz:\x9f	\xb8G9\x0d\x04S\xf9\xb1\xf8\xb6\xb6}\x1a\x84\x8e\x0f\xea\xa2\x02\xe4\x9f\xb6\x90\xf2\xf8,\x8e~\x9d\xddD]\x81\x9a\x01\xfe$A\x7f\xf2~\xb8Dw\x02\x9e\xacb\xcc!\xc2\x04\x16\xc3\x8f4\x8a\x97Mzm\xa7\xf4\"\x88\xb4\xdc\x13T\x8fg\x1d\xb2:\x19oEh\xb1[\xd3\xc0\x7f4\xc2\x05\xe6\x0f\xcd\xac\xf1\x9a\x9e\xffPO\xf7\x93\x14\x1b\xb7HK\x02\xd2\xa3 \xd2\xb0\x7fS\x06_\xe9\xdc\xa6\xc2`:s{\x84w\xff\xb0\xe8\xc7\xfa\xa4\xaa\xcb\x05Q&\x85\x9d\x9cG\xcf\xb7\x91\x0c*\x13P(\xfc\xb3\x18\x07\x9b\x8d\xb3kn\xba\xcb\x13a\xba}(\xd8\xe0\xbeR\xd2\x84U\n#\xd7<\xbd.\xfeS\xd0\xb1\xe1'\xc7)\x9a6\\\x1a{\xbd\x1f\xef\x82\xf2v5\xfd\xe3N\xfd\x8e\x16\xe4\xa7\xba\xb6\xb3$\xf1p\xad\xe0\x97<\xa3R\xfd\x93f\x9bk\xfe	\xc7\xcbm\xa7\x0c{i\xbaVDq\xbd\x94\xad\xb4nt\xe5uv\xb7\x84\xb5\xe5\xab<\x0c\xdc\xef\x90(\xeb!\"`\x08>\x05p\x00\xd0R\xeb\xfe\xa8\xbe\x94\x1f\x97}\xd4\xec\xfa5\xbc\xcb\x82\xa4brj\x96\xf3\xd1x\xf4\xaf\x99\x0el\x98*J\xeb\x1f[\xbf\x83\x8bg\xaaQy\xcb(P\x88\x12\xb4\xee@\x84r\x0e}\xa9\xfa\xd9\xb9\xc9\x05\xa6\xd1\x0ebr\xe7^\xce\x1d\xea\n\x00\x12K\xed\xec3	]a\xa8\xc3\x87\xfe\x85\x1c\x00\xe6\x01t\x19\xd6~F\xfd\x9550\xd8z(\xe9}\x00?<sOb\x1aH\xcb\xab\xd2\x1e\xffX\xdd\xf0\xca\xbd\xb0K\x9a\xde\xe56\x91\xbb\xbe\xc4\x8b\"\xaebm\xaf\xfa]5Y\xe7\xbb\xcb*\xa9\xc6&\x9f6\xba\xe0\xe9\x90\x12\xd5Gt\xe4)\xaf(\xee\xb75\x98\xb5do~\x11\xb1\xc5\x06S\x18\xe0\xcd+\x15\x84\xa5\x00\xeb3\xf3\xef\xd7\xb9\x83)\x07\x87\xc5\x19\xf6j\xc6Z\x16\xaf\xe5-\x8e#n\xf0y31\xfe\xee&\xb3\x068\xe1[U\x18\xe3B\xe6+?\x1f4#\xe66\xba\xc6\xec^x\xdd\xc7Y\xc2\x9a\xb5\x95\xfb\x06\xfb\x196C,\xa2\xd2\x8f\xfe@\\W\xe9\x9aJ\xaa\x03d\x8e\xaeIn\xf4\x85B\x03{\x9auN\xec\xe4\x87g\x1dW\xc9\x85\x0f[\xdf\xdd\x91/	_\xae\xf5g\xfb\x04i\xadQ\x93\x8d\x0b\xed\xbcr'hf\x12\xcbQ\xb3\xb2!Z*\xf7;\xf9\xee\xcd\xfe\xc7\x1bV\xd9_\xad\xfeM*\xe1?\\[s\x9c\xdd\x18X\x91\xf9\xc4\xa1\xf9\x8d\xcfJR%R\n\xef\xd40\xe9\xbar\xde\x1b\xfa0\xb1\x92\xe4~b_\xc4\xf00\\\xa3\xad\x85\xfe\x9a\xdb\xdfm\xfa\xcbn\xe5\xf6\x91\xf7g\xc1{\xbb\x19\xe6\n\x96\xee\x152I\x1fp\xfa\xef\x1f\x07\\\x84\xd0\xce\x9d	'\xbe|o\x19\x17\xcf\x8ay\xaa\xd5\x996N\xf3P\xcd\xa8\xf0\xaa\x06\xc51\x85\x10	?R\xa5\x93\x01\x04\xfe\xdb\xac\xea\x13VY\x07\x8c\x07\x9e\xd7\x15\xd2wJU\x80T^\xb487\xc4\x98\x1c\xff\xd7\xf6[~\xcd\x1e!{c\xf3\x90\xe3L#W\xf7@\x06\x84\x1f~\xee\xf1\xa6\x0dy\xfdt\"\xde>\xf3\xf8j\xe2<s\x0c6\xf9\x86g\xed\xa0\x83\xb2\x01\xa2dX]\x0e\xa0\xfcqH\xe4\xc9}+\x95\x92\x0d\xb3\x8b\"2\xc7\"\xdd+\x96\xca[b\xf7\x81\x81\x0fy\x0e\xee\x03\"\xaeQ\xed_\xb6\xaefd\xfek\x0b\xd5\x115W/O|\x02\n\xa7\xc6K\x96B\xc4:\x7f\xc6\xbc:Q\xfbe\xad\xa0\xf6\x89\xf2a_\x0cm\xc1\xae\xf0!L\xa2dN\xc3\xcd\xe3\x93\xdd\xf6\xde\x92\xc2\xa3\x0f\x14\xae\xb9\xdf'\xb7\xdb_\xff\xcaIx\xfe\xb1\xd64:\xd7\xfe\xf2\x03\xcb\xf7_\x97wl\xcer\x0fMo\x82A\x977^U\x0f\xdd6Q\x82\x9a\xfdk\xfe\xac$\xde|\xff\x89\x86\xe2\x8b0\xed\xd1Q\x96\x0b\xfex\xaaNNc\x95\xd5\x85\x8d\x89\xf2\x1c)#\x88\xfd\xf6i\xe8g\xd1@\xd8w\xfe\xcd\x1f4*\xe3\xfe\xe0p\x8e[s\x0ex7\x8dv\xa2\x85D\xa6\xbd\xb0\x8d\x90\xd0\xcbh\xe3\x82\xfb,\x84\xeb]8\x86u\"\xc2\x99H\x9eeA\\\xefD>[\x16\xbdLd\xedX\x8aT\n\x14\x9d\\v\xd7\xc4\"fq\xb4\xef\x88\x9e\xa1\xf2w\xde\xd3< S\xa3\xe3\xe5\xa1\xa0U\xd5\x1e\x9d\xd8\x0b+z5\xdc\x03z\x0c\x94\xae_\x92Ra`\x03\xbc5\xa8eE\xc2\xf3r\xe2\x88\xfc?4\x9b;\x91s\x99\xf8\xab\xc1\xf4-V\"\xfe\x1a\xf2N\x1dp\x9b\xc3 \"\x15\x92\x81\xa2\xedg\x11\xebz\x83\xcc q-\x8b\x89\xee\x7fx \xf4\xd2[\x1aON\xeed(\xaa\x90\xfb\xa3h\xf10\xd3B\x8f\xe0\x9c\x8d7\xab\xb2\xb429\xf3\xaf\xbe\x14d%\x9d\xc1\x9f\xc4\xb0\xdd\xa0\"p\xd9\xbc\x07T\x90V\xae\xb1)\xd6\xf8\xbf<\xa1\xd4~\x03G+\xc7\x9d\x0f\xd1\xd1\xe6\xb4\xf4\x99>\x99\x9c~O2\xa1\xdc\xe3\xf4\xd7\xf7!2\x15 \xcbU\x95r4D\x89\x08\x8e\x0ba\xd7\x11\xd1\xb9d\xbd\xd2`eu\x7f)\xc5\xa6\x06=\xaa\x14\xbd\x8ev\xa1\xd7L\xe9\xe1TTBC>\x00 \xea\x15\x88\xe3`\xd6\xcems\xe6\xef\xe6\xcd8\xfdc]\xf5\xf01zIA\x08\xe3\x9c\xdfg%\xa8\x06\xc8\x12\xd5\x9d\n2GHT$\x8f\nA4\x00\x90y2\xb8\x12KoLD\x8a\x16\x1a3\xff\xb4\xbb\x08\xe2\xd4\xca\xd4\x1b\x87\x8b\xe6\xce\xd3\xc7\x05G\xfa\xf4\xb1:\xaf0Hn\x83\xe4I`\xf1\x18\xb6J\xb8\x93K\x8d\x84-\x19\xf4BS\x08\xda	zg\xe1\xe5\xe0\x91b\x15\x92\xf8\xa27\xb68 `\xbf\x9f|\x7f5\xbe\xc0\xe6A\xbe\x98\xaei\x87\xf5\xcc\x02\xff\x8d\xaf\xfcF\xa8,\x96$r\xb6R\xb0\xdde\x8f\x083>\xdb\xd9\\\xa4\x96\xe4*.q\xe7u\x07\xddO\x1a\xe1/l\x9cc\x96\x909<\x1f]!\xbc\xb1>F\"\xd9\xe2V&\xe28\x13\xc9x\xdb\xb2\xbdBQ9\xf8\xf6\x13\x14\x94\x89*\xfcP\x85{\x05K\x0b\xf9\x15i\xf1\x86^\x0f\x00)GGH\xa8B\x02\x12X::\x84\x1f\x9e%\xf1\x1fr\xc9@\xee\"\x95h\xdb2\xe9e\xb6\xf3\xd1k\"b\xb5x2\xb6@\xbe>\x11\xd6\x0b7\xc3gv\x8f\xe43O\xa4nh\x81L\x0e	\xc4\n\x86\xfb.	\xed\x8f\xbdv{\xe5\x9b,5Z\xcf\xe8E\xb7\xcd\xa8\xbb\xf0\xba\xf3{DX\xec\xfa\x0f\xff\x90F\x00l'\xd7\n\x1d\xdcFB.\xa2!\xa5D\xf0U\xfc\xcdU,\xady\x85Z\x89w\xca\x93IU'\x03\xa1\x17\x1a\x05\x8d\xde7N\x04\xa5%\xd0`U\x00D\x97\xa4\xf2@B4y5\x1d\xf6\xd5\x99*QJw\x06|S\x04\x99\xc3\xd3\x0f\x88\xc5\x1c\xd7\xc5A\x99\xc5\xab'\xfd#.\x93\xb2\xe2\x07\xd0|\x15\xe0\x17B\xa9'\xdbYN\xfd\xfcJ\xabQ\xc1\x9d\xa2\xfbk\x94\xffb\xa5\x06\xf7@'\x03\x0fy\xf3pldp+	YMJv\xf8I\x18#\x14\xd4E\x00\nlQ\xa6$\xd8\xcf\x01E5\xe5\xbb\xa2B\xe0K\x05^\xc4\"\xb3\x17\x006\xadX\n\xc5E\x9d\xaf\x19\xf7wU\xfc\x8cH\"&Td\x14\xea\x0d\x0d#\x9b\xb0\xc8\xf1\x8aJ*\"\xa0\x1aX\x95\xba\x1d\xfc\x1b\x11\xa4e-\x91\x9f\\\xd4\xb4Pu\xd5\x10\xd64\x07z\xa5\xea\xa1\xb8\x9e\x13kE\x1a\xc3\x96\x91:+\x9c\xb6!\xf2\xe4\x1a\x8d5\x0b7E@'\x9e\xfeU&\x9dN\x85\xeb\xb7d\x1a\xe3\xf5\x8a\x8f\x06\xea\x1a\x86\xd8/\x9d\x08f\x10r\x9fH\xeb\xc5\x9e\x99_\xc5\xa63tO(\x01\xb8\xbe{S\x03\xa5\x99\xe8Z\x07 \xd2/\xee\x9a\x17\xa3\xd2\xd6\x15\xef8\xb1$\x87X\x80u\xedP\xb5\x99\xbc\x92dD\x0f\xa0l\xbc\xa7\xbe\xbf\xca\xea\xe1y=\xf8\x92\x84\x0c\x19\x01-\xcf\x95\xfc\xb6\xaah=\xe7\x07y\x02\xd4\x90\x1c\x94\xc8h\x1f2\x01\x97\xec\x9a\x1b)U\xe8m\xbf\x94\xd5H\x1e\x8cbS}\x80\xe3\xbeW\xc5\x9d\x9c\x90\x9fRgGWr|\xab\xe9\xb7[e\x93J\xbf\\\xb2\x97 \xad\x0cw)Y\xb2!\xf6\xd4\xd5\xa2\xae\xbe\x9f\xdb\x01\xc8\"\xe8\x87\xa7}v\\\xf5B\x92\xd3	\xe0\xd0\x1f\x98\xf7g@\x93_2\x10\x14D?\xad\xc6]2\x08Q\xa5\xda-\xa8y\xbb\x11\x9d\x97\x8f\x9b\\:\xa2\x92\xcd\xea\x9e5\xb5\xee-\x82<~\xb6nAn\xbdZ\xc8U\xa9\x80X\x90hK\x1bow\xda\xd1\x9bS\xdb\x9e\xfbdp48\xd6j\x83\xae\xa2\x03's\xfc\x92\x99 \xd3SU\xaf\x06\x81\xf7i:\xa6\xb1i\x1f\x00\xd0\xd5a)\x7f.A\xeb\xe2\x0eZ\x1e\xb5\x90\x01d\x96Au\x1a8od\x91\xc19\xb9\xf3\xf9o\xffGH\x97\x07\xa7\x9d\xe2\xc9\xed\x18\xda\x00~\x1fDB\x9d\xb3%\x9a\xe3\xd2\xc7\xe6\xfa\xa5\x97\x87L\x89\x8f\xa8y.\xa0\xb9\xebc\xac\x04\x01\xeb\xa9\xba\xd1\xd4\x1fS\x9f\x91\x97\xd7Sa6\xc0Tn\x10 [G\x06k\x9dH\x0f\xd6\x12e\xb5\xb9\x87*\x8b\x8eC\x1e\xcb\x8d\xe6\x04r;\xfe\x0e\xa1E\x9e\x8b\xaadBV\x0b\xab\xcd\xa4z\n1H\xf38\xd7\x91\xc4bO\xa9\x1a<\xb2+\x0c\x03\xf1\x9f\xf6n\xd3\xce\x19\xb6\xe9\x0b\x9d\xa6O\x9b4\xdbm\xe8\xb3I\xb8\xef\xdb\x0c \xdfq\x19\xa2>\xce\xb8\xe8\x8b\x9f\xa6\x84m#\x13$\xabve\x9dR\xa7\xfbCQ\x85R\x84\xf4\xfc'\xb4\x0f\xa9=\xf1'\xa2\x9c\xa27\xfbs\x107\x88\xedV\x1c\xa0 \xec\xc0\x9f\x82;\xd6\xa3\xd6\xd2\xc4\xcc\x85\x80\xe6\x86\x93Z\xd6\xcc;rzm\xfa\xcc\xe2\x9d\xf6\x19ER\xa1\xcf%Az\xe1\xf8\x81\x93\xbb\xb9\x17\xa6\xcf\x91\x80\xac\xd3H\x07\"p\xe8\x9a\xf5\xa3\x0d\xb3\x04oBh\xa8\xd5t\xb3\x8c\xbf\xca\xcd\xd7\xdf\x9f2\x1e\xf9.J\xd5\x08n\xf7C\x8e+E\x9c1\x98;=\xcf6\xa7\xef\xb9\xff\xd4\x80\xcd\x81\xeb0\x03\xfbRO\xe0\x13\xcf\x97\xed\x92<\xcf\xb2?M\xeb\x0f\"_\x1cJ\xbd\xf8i\xff\xc2\xab\x19\xa4\x8d\xe1\xf4mR9B\xeb\x9cO\xbfh\x85\x80\xe5\x07\xe0J\x91.\xb2\xeeT'CmA\x9c`%\xa9\x98\xe1)U\xe64F\xd1l\xa8\x8b\x16\xc8\xbd)\xfa\x8c\xfb\xff9\xa9^\xf8_\xed\xa1[\x80\xea\xff1w-\x82W\x1f\xcfM\x8e\xf9_+y\xa5V\x0c\x00\xa6\x9f\xfb\xb3\xa3j,\x95N\xd8g)I\xd8\x0c\x94\x86\xd6R\xdbl\x08`i\x91\x90\xd5\xe4e\x9d\xec\x121p\x0e\xcf'#b\xe3\xad\x12\xf3U4\x16(\x12d1HQ\xe0lp\x91\x9cx\xfd|\xe2\x0d\xa3\xbbL\xd5u3\x85XEq\xde\xb2\xe2\xd3\xdaQ>\xcd\x99\x0e\x03a\xddi\xa9&\"\x8d\x1d\xb4C\xe2\xa4\xe4\xb1\xa6\x00\x14i\x14\xe2k\xdbMj='^[\xf6\x93\x963\x9a)n\x14\"\xb7\x99\xf59\x06\x0f\x11\xf3lN\x8a\x84\xee\x07\x998\xb4\x0c'\xb69`\x98\x07\xe0,\xc1',t\xc9\xb6\x19e\x7f\x97\xee\xd4J\x0d\x94O\xfdR\x12y<1sg\xb7\"z\x99nj\xaf\xc2\xae\x98K>\x9d\xa9\xe7xc\xbe\xca\xccd\x06F\xcb\xa4\xdd-\xb3\xfe\x9c\xef\xfce\xc1\x94\xfd#\x06xF\xf0\x99\xf6\xec\xe8\x1a\xce\xd6\n\xf6\xabM6\xb7\xf0a\xf0\x15&\xb5\x00\xec\xec\xe0\xd1\xa5\x95ps\xc3\xf7\xf8\x91\xeai\xe6\x90M	\x7f\xe1\x0f\xf3A4X\xd5\xc8\xf7z\x98\xd0o\xcfc\xaa\x08QqG:acj\xed\xfd/78d\xfe\xda\xcc\xdd\x96\x9fq\x17\xe0\xc0\x86\x03W\xac\xdfK\x88\xb3\x03\xaf\xe6\xa1\xcb)\x03\xab\x0bh\x16\xcd	9~\xb1\"\xa6\xb99\x02\xf8\x94\xba\xc3U\xae\x0d\x9ef7\x93f\xd6\x0cY)\xeb\x89\x83\x12]\xfa\xe7C7]\x14\x05Q<\xc6\x99\x9aR]TMB\xba\xa7\x81\x8b\x90\x89\x81\x06s\x92\xca\xb5\xa8\x10Ws\xa6g=V\xf8\x03\x1c\x95L\x97,\xcd2%T\xe1\xd8\xb8~\xabX&\xdfD\xda\xaeC3!N=\xdb]\xe4\xeag&\xabx\xe5\x17\x121\x06^\xb0:\xd0\x907\x8f\x15\xb3N\xa1W\xcf@\xd6CR\x8a\xea\xb0\xf0r\x80\x19\xed5;BA\xbc\"GeR|\xec\x04\xc2n!|\xd2{o\x9d\x9b8\x87N\x8e#\x02\"'\xf8\xd9\xcc\x06X\xa4wNuKk\xadR\xac\x0f9\x1d2/]\xc6D\xde\x91\xed\xa6\xa4I\xdd\xc2:-\xee\xd2\x86\xd9\x1aR\x1a5\x89\x19AD\xb7\xf8j\x97.\xc37\xc505/Z\x16\xa1r6{\x918\x87\xd3!Usi\xf9\xa1^)\xfd\xce\x0c\x18\x9d\xf6r\x01\xce\xda`\xe8c\x9e\x03i7\x81g\xaa\xc9\xcb1\xd5B\x7f!N\xca\xd8\xf1%\x07\xf6A\xe6\xae\x86|\x81m\xa56\xd1t\xbey\xcc\x7f\xff`\x87E9\xf7\xedA\x9a\xdc\x89\xe4\x9f\xdb\xbc\xed\xdd(\x8c\xf2u\xdf\xbd\xa6\xec\x04<\xb6~\xa0\xb7\xf4\xda\xce\x95\x9e\xf3\x0b\xe66\xfd\x043\xcc\xc8G\x13\x83\x16m\xcf\xe7\xb7aw,	.cBm\xa7\xdcFb4\x9cA\x93	\nJ\x1e\xefB\x0c}O\xc8\x19\x15I\x8d\xdc\x9c\xe7o\xaa\xb8\x9bE\xb0\x8f\xbd\xf1\x96\xe7\x80\x86\xe9\xa2s\x97DP\xf1y\xcb\xf1k\x1f2\xf0\xb3\xebe\x13\xcd\xa4\x97\xd1\xb5\xa5s\xf0\xacL\xe7\x95%\x1c\xe7\xd6\x05=\xdb\xc7%@e!R%=\x7ft\xbf\xe5\xac\xf9\x96\xc8%\x8842%\x1a\xe0\xa1\x8cQ\xa0\x88]\xd1\x08\x01\xdf\x02\xc2?f\x0d9:\xdc\xe4\xd4\x90e\xb6\xad\xd4\x9c\x9f\xef\x01\xa4\xbf\x9cy\x86	\xdfE\x93j\x9b\xd8U\xdeQv\xe0\x08\xbd6;ef\xf8^\xf8\xe4hku\xc8\x94d\xcey\x04\x8f\xa5\xd7\xf6\xa1h@\xc9l\xda@R\xf3\xfds)\xaf\x0ft\x7f\xe6OP.\xfbye\x1b7\xc2\x0fO\x9c\xe6\xb5\x19X-o\xeai?\xa0m\xb7C\x8d8\\\xd7\xdfR\x92\x00%\x8f\x91W\x9e\xcf\x7f\xf2\xd6\xbe7&U\xa2\xbf\xa0\xaf\x14\xc5\x9b\x93\xa8X9\xd04(\xb9\xecJ0|6/(;\x84\xba\xd7h\xfcm\xd6\xb3[zS\x93Y)S\x83\xf5\xf6Y\xcc	=\x9a\xe7\x0d\x13\xff?\xf1z\xf8\xc4\x9a\x18\xa7\xf1j\x8a\x12\x84S\x85\x018\x04\xa9\x8e\xd4h\xc8t\x19\xbc\x96iz\xaf\xa9\x88(\xb0\xe4^\xb8\xe1\x00\xa4-\"\x0d\xb3\xd9i\x94}\xf5\x18\x95\xc4\xa9\xa2\x18Q\xb2@\xae\xb8J\xd6\x9c'oa\x97\x17Uj\xe2*\xd8\x10\xe4\x1f\xdd\x06\xa1\x9d.\xe0\xdd8\xcf\xcfk\x9a\xf0r@\xe2[\xc7[7\xc5[\xc6/\xecPC\xd5\xaa1R\xb3\xa4\xf1\xf5a\xb3\xa6_\xdd\x9a\x81GR\x82{\xc7\xec\x03\x9aTw\xa4\xa3oJ\x92\xefN\x8a8\xb56-po\x92\xab\xaf\x0eF;1d\x10\x9e$@c~!\xdb\xc6\xa7\xa1\xf7\xd2g'\xcc\xd5\x85\xb2\xb4\x93\x85\xb9\xc7\xba\xa8\x1cL3\xd1\\\x04\x8a\xd7\xdb\xcb\xdek@\xe9\x0d\x8fj\xf21G.\x0b+k^\xd5Y\x8f\xc9\xd6j\xd6\x82\x02\xe25o\xbd\xad\xbc\xce\xa1\x9e*,j%.\x95f.\xbcIv\xc4\xe0\x00P\x94\xd3\x17\x93\xf6\x85r\xa9\xeb\xba\xa5\xba\xcf\xf1i=O\xdfk\x87CKt\xfa\xa9\x88\x88\x11\x1bJ\x8c\xc4S\xd8o\x0d\xa0\xdb_\x13\xd80\nL\xf8+Q4\xc5\x0b\x90?[\xa0\x93\xc6\x08\x02Z\xa5\x00R=\x1fz\x92G\x99xgPZ/\xfa\xfb\xd5v\x08P\xda8\xe9\xd8[Ss\x97\x90f\xc7\xd71\xe2\xb9U\xcbu\xb6\xfa\xff%\xb9\x81\xdf\xa2RdF\x10\xea\xb6\xe5\x9d\x8f\xd4\xb9\xea\xbfO\xc5\xcef\x0c\xdc\n\xac!\x19*\x7f\x9c,\x0b\xe6<,QVs=\xc5\xab\x80b\xe9\xc1)M%\\6\x0dT\xb3\xcf\xfaKt\xa8\x90-#+e\xc9\xa9!\xf3{\xea\x84)\xcd\x91\xe4\x05\xe5\xd1s\xc6\x13V'I\x1e\xec\xe2Der\xad\x8c#\x9d\x86\xce\x0f\x8bT\xd7\x85H\x16No\\NG\x9a~^j\x92\xac\x0e\xe8rU\"	\xe7\n\xc8\xb9\xa2^cu\xeb\xfdc\xcd1\x05\xdf\xc2R(\xf4?\xd8\x9b\xe3$\xc5\xc6\xebCN\xc1\xb3\xbfg\x1f1}\x89t\xf6m6\xfd\x82\xe7i\xd6\xcel\xe3xo7\x81\x8d\xb3\xee(\x7f\xdc\x1e\x91+\xe5\x00H5\xf8\xe9\"\xde\xb0\xfe'\xce\xe4o\x13\xc7x\xb6\xe5]}V'\xf6\xdfX\xdb\xcf\x9b\xe8\\\xa5\x05}\x97\x9e\x97*]\xe7\xb9\xdd\x82\xc9R\x06*\xb6\\\xd3\xd9\xec\xa2x\x92$A \xd3\xae\xf9\xd2\x18d\xd0\xc6vlbn&\xf5L\xb4\xba\xf7\xead\xb3E\xa0\xd1\xe2\xd6\xfa\x97F\xaa\x08B\x18g\xb4\xa9\xf4\xd9\xba\xb7o\x00\xa2\x98:)\xf6^iJ\x82\x80Z\xf7\ni\xa6\xb1rW\x82A\xb7\x90rS\xfb\xbc/\xc9Z\xf9M\x9bw\x03N*\xd2\xbfH\xca\xa9\x15\x12\xd6\x08\xd1\xc3\x08\xc3F\x0f\xce\xe3\x90+\xf7\xb6x\x06\xb8P\xb4\x9d7\xb2\x8ac\x0dS\xde\x0d:e\x06~\xfa\x8f+\xfc\xcf\x93\xea_wk\x90\xe9\xc4\x14\xbb\x98S\xecg\xd1\xd5\x1b\xb4\x07\xdc\xf41\xd0m\x1a\xb7\nk\x91\x99-\xeb\xf8\x97\xe9\x1as;\xc3\xa7\xf4i\xd1\x81X\xa4\xb2u\x98p\xe1D[\x9c\xe6SrU\xa0\x9f\xfd[E|\xb0\x951/\x01\x0b\x84+\xa0\xdbm\xceHu\xda\xbcRr\x03\xb1mx\xf9N)\xa1\x04\xe1\x91+\xda\x96<1\x7f]\x19?$\x86\xb4\x00p.\xfa\xc7q\xbcn\x85/\xdd\x02Qy9F-\xf6\x1d&R\xea\xf8l>O\xda\xf5\xab\xcd//=\xdd>\xb7\x04\x8d\xee\x01=e\x84+\x89\xcf>\xa5\x84\xf2\xaa\xd6d\xdb\n\x1f\xd8\x8d\x0e\xe4\xb6\xac\xb0\xea}\x86i\xde?\xe5\x84\xf0\xbd\xd5\xe4\xb1\x1bI\x86\x9dWy~\xf0\xa2\xbf\xf6\xcb\xb0()X\xbb\x01;ybm\x17\"\xf3\xe4,\x8a\xa2('\xebEf\xf7\xf8\xaf;\xba\x14K_\xb0\xa6g\xcb'\xee\xd4x/\\\xdd\x8a\x06Jc\x823\x0f\xb6\xac\x94~\x90\x7f'\x9bi\xb7\xbau\x1a\xef\x92\xc47\xb0\"\xaef\x0d\x02\xffq\xea\xb82\x07\xd7\x8f\xd2\x15\x0e\xfc\x90\xddl\x02\xe4O\xfc3+\x931m\xc2\x83\x0d\x18\x0d(DP\x14o\x01\x82\x965\xe3~=]Pe\xcd\xf2c\x9d\x05\xad\xbf\xba?\x82\xe0\xdet)\xf7\xbdG\xbb\xd4\xa0\xc91\xf0\xe1\x88\xbc \xff\x96\xae\xa2/Vd\xe65\xf7\xf3)\x9e\xe2\x8a\x18\x11s\x99\xa7\x06\x0er%\xc7\x9d\x86\xcfds\xe9\xe7^\xd1\xa6\x12\x01\xf1\x0c\xc3\xa7\x0cQJJ[\xf1\xbe\xd0FE!\xcf\xda\x98\xf9=\xd2e\xd5\x18:\x98\n5l\x01G\xbf\n\xcc\x93\x99\xf5%\xcaQ\xb7\xaf~k\x044\x8c\x9e\xc5\xbf-&\xfava\x80\x93\x91Rj\xad\xfd\x0f\xdd\x1d\xc0\x15+\xa4\xbbla\x8e{\\	#r\xf2\x9e\xf3iw	\x95\x95\x84	\n\x9d\xb8l\xfc\"\xb3\x18_\xe7u\x9cc\xcc\x9fB\x99\xd5\xed\x8f\xb2\xe1\xc5\xdd\x90u:\xee\xc1*n\x85$]\xa6\x8e\x89\x1e\x1b|\x16\x8f[\xdf]/\x06x2\x07~\x90\xce\xda\x13C#\x97)@\xd2\xcbHq\x0f\xd7{ku-V\xdb\x832'P\xb2^\x9c\xfa\xdc\xd3I\xc1IME\xcdp\xb6\xd6\xf8W\xb1\xa9\xf2[\xbdH\xf0\x99\xc2Mvn\xb2\xed\xcb\x91\xe7&q3\x1d\xc1\x0b\xf6:\x9f\x1e\xf1\xc7\x8a&m\x1f\xcbry\x19\xa4\x04\xd06\xed\xce\x98\xf0\x91c\xe6\xda+,\xc5\x1dn\xb6\xe4	\xe6n$\xb0\x81\xcay\xfeK `\xb7~\x9a\x1a\xef\x7f\x10^A\xf2P\xcb\xeb\x06\xf4\xe6\xb55\x01\xf8\xd0r\xb9\x15\xbf\x99\x1b\x97\xe7\xe9\x8fR\x9a\xfb\n>{uB\xe0\xb9C\xd6.wD\x1e\xdfk\xfan\x84\xec\xfd\x8f`\x9a\xd6\x07hN~\x18\xbf\x9dRh\xf5Oi\x9b\xc3\xd8\\\xf3\xee\xde\x0f\xe6M\x14\xe4*\x8e\xc8N\x8d\x1e\x85\x04\x9c\xb8\x02z\xc4\x8c\xbe\xd6G'\xb39\x98\x12\xa1\xecyP\x86\x9f\xf2ts\xd1M\x1a\xac\x18\xb1\xfb_kA\x18B\xcc\x12\x0b\xed\xe7.\x8ar>\xfc\xf0\xd4\xbf\xe2\xab\x94P\x83\xf0\x02\x1e$\x81\x8fU,d\xde\"\x82\xcb\xf5xe\xb3Y\xeb\xe7@\xaf3\x1dS\xcf\xa2\xe8\x0flT;b\xfb\x86\x90\xc26Q\xdf4\xa2\x04\xbd\xe2fD\xd5\x91f\x14\x04VR\xb6\x9f\xf9\x1b\x13L)\xd3\xb6I>\xde8\xe9&mP\xbcQ\x03\x13\xef\x8f0G\x02\xdb\xa8yB\xf2\x8e\x8d\x0eJ\x86\x18\x9f\xd0\x14\xafB\xe8H\xd8\x9cgk\xfe\x91\x00\xd8\xbd\xce\xec\x12\x1c\xac\xcfL\xea\x02\xd6\x8bYFp\xda<\xd1H|EJ\x9a\xdc\x06\x98\x87\xc2\xffK\xb0\x1b\xbe\xec\xe24\xee4\xbb\x1f`\xe5u\x1al&\xee\xc3\xe1*\xaaQI\x1f\xdd\xcf\xb4r\x81\x84%jF\xe5%O\xb3\xb2\xc6\xcf%\xbb\xf7\xab\xc5':\xd4\xac\xd2\xa0\x82\xf9\x90\xe4\x94\x14+~\x87	J\xab\xb8\x89\xe2\xb0\x8bK\x80\xa9W\xe55\xe2\x18\x08\x89K\xde\xb1\x16\xb24\xe7T\xa3\xb1V#+G\xf6\x93\xe2\xe5\xa2\xba\x0ei%\xf3\xaf5\xccY\x8b\x1c$G\xca\xa6\xe4\xfcd\xbe\xaa\x02H\xd8\xf3]\x17\x02\x88m'\x08xq?\x9d\xb8\xbe9\x11\x05Z\x80>]\xd6\x89\xc3e\\yO\xcf\xba\x94n\x1a\x1f5r\x98\x81\xa9\x0c\xe4\xa600\xad\xea\xf2\x84\xcbw\xcb\n\xf0\xed\xb0d\x86\xb8\xa1\xea)YL\xbcu\xbb\xbc\xe9\xfa\x16Y\x1c\xe0u32K\xa7\xa5\xe6{D\xfan@x\x18\xbfx`\x92\x18)\x99\xc6\x1a^E\x05\xea\xd6\xa4\x9e\x0d\xa5\x16\x8c\xc5\xb1\x7fMJ\xa5\x042\x07g;5d\x16|GK#\x12M\x97\xee:\xc8'\xca\x0e\xa0\xdd\xa78\xf0B\\9\xb9cV\xca\xc99\xc9\xcb\xe4\xc2\x9f9\xe7\x9e\x81U\xfa\xb9}\x0d\x1e\xa1\xb5wn\xe3\x01\xfa\xb6\xb9\x81Av7;L\xc8\xa7\xb9\xf8F\xc2X\xb7a\x9fg\x9e\x9b\x17M0\x07\x98I6\xac\xea1\xd0A5A\xbf\x9e\xbb\xa0\x82I<,\xcbz\x92\xaf\x85\xcd\x91\x9b\xc9\xd4\xb3\x13\xe9\xe1\x1d\x95\xa5 :\xba+\x1c<\x99w\xbd\x91{*\x0f\xe3\xa1\x9a@\"\xc3\xfav\xcc=\x99\n\x9b\xd8\xf4I\x96\x857~\xb2Rl\x19^Q\xe3VO\xb9\x9d\xd1\x85\x9e\xe4\xc2\xa9\xf8p%R\x9e\x13\xaa\xd0\x8d@_\xd9Y\xe46\x01.\xff\xc5L\xc5\x14\xeb\x14\xf1\x15\x16\xb1V\xcdA\xaf\x1d\x15\x194B\xdb\x11%\xf8\"\xba9\xed\x92\xaf\x9dL|\x94\x12\xf5l\x96\xff3\xa9\x05\x01\xa4m\x01 \x00\x12P\xfb?\xcf{\xc4\xab\"0\xb1B#\x86\x96\x99\x1f\xe3\x85\xd0}\x18CsU\xbf\x91\xd86J\x8d\x01\xdc\x98\x9bB\xa7\x8f\xe9\xc4\xc9\xc5a\xc3eO\xb5t\x19\xe5z9\xc96_\xdd\xa7\xf2d\xaeWXN\x06f\xd0\x14\x10u\xc7K\xd7\xa8\x9b\xb3\x1c\x06\xd2\xfa\x1b\x93\xe0\xb7\xf5\x95J\xd3\xd1?\xb7\xc1o\x99\x1f\x19\x14\xfc\xc6\x0f\x89\xbc\x1c\xe3\xe0P\xffI%NKj\x84\x83\xbb\x87\x1f\x8b\xcc\xc4\xde\n7\x7f\x99\x7f]\xea\xb1\xc4\x1a\xa3\xef\xd8\xf3\xf4\x93P\x99\xa9n\xbb\x1a\xb6\xa7\xcd!	lO\xbc\xaewp\xcbAO\xcd\xb9=	\xabVS\xf2\xc9\xc8\x12\x84\xe7s\x9c\x8e\x90\xa4\xe9\xd5\x87\xfeS\xc6\x11\xbbt\x063\xd9\xaf+:J\x99\xdfrs\xd4\xf8MbG\xb2\xa1)#\xf6\x04\xb2 W\xf8\xed$Q:X\x878i\x15\x99(j\x18&\x90?\xec:y\x87\xc3\xe5\xbao\xe5\xd9h2\xf29\xb7\x8d^\x00\xb0l\xfa\x89\xa2AC=\xb6\xb1\x14\x14\xda\xdd>\x12xyV\xbe\xa5\xf5\xf3\xb3\xfb\xd4\xa3\xbe\xb7\x10\xa4\xdd>sn\xfd\xab\xaf\xcfG\xcc\xa5\x9b\xdd\xf8\x9c%_\xa4\xdd?\xd4UgJu\x9bk\xb5\xd6\xd1\x81f\x03iczj\xbb\x91\xb1\x7fU\xe1;\xb7{Y{\x1f\x19\x9b\xd4\x08\x19\xb2r\xf0+i\xf0>\xa1\xd4r\xd9\xf9?-0#`\x8aU\xaa\xa0\"U\xc1\xbbh\xb9\xf0\xb9\xaa$\xc0>vT5\xa0\x01\x0e\x90r\x90\x96u\xd3\x0d\xd2\x0f\x88\x7f(9k\x96\xc2\xaa=\xe8e\x0d\x95{E\xd5\xcdbR\x98\xa4\xcd\x95W1\xa8\xcd3,\x8f'di\xf3Rj\x93\x82\xd0g:\x0c\x1d8\x03M\x06y2\x0d\x15\x90Y\xd9(Z\x82\xf8\x04x]\x12D\\\x8e\xa3\x0b>\xee\x88cy<\xb9\xa4a\xe0D7\xaf\xeb'\xe2\xc74\x9as\xdfId\x849.\xc6R\x8b^tb\xc9\x9cNQa\x90\xc2\xaaiY|\xc8\xbb\xa0\xa0\x03\x0e\xc9\x86!bh\xe5\x9e\xb2\xfb\xb0\n\xff\xacHi$%\x1f\x83\x13KL.\xdd\x19Z*\xa7\x0c\x9bm\xdb\xde\xaa\xa1\xd84'\x87\x8fq\x133\xc4\xcd:\xc6\xb4\xd1\xd8\xc6\xeaV\x83\x97\xa5\xed\xf14\xcc\x8c\xc3E\x90\x056\xc2\xf2q\x92\xea-u\x8ec\x19\xf4&\xa9\xfeq\x90\xaf%8Z\xaeSUO\x8ass\x0e\xdap\x10\x88\x9af\xefd\xa2\x8de\x08\xf4Q\xf2\xce+\x11\x0d\x18\xe7\x19\x9c\xcd\x8ac\xf7%\x85/\xe0\xa4\x0e\xb7u\xa4\xe7\x9d\xf2FQ\xe91\xac\x9c\xdaA\x9b\xd4!N\n\xdf\xf9\xb0LP\x82\xdd\x02\x86k\x0f)\xcdt\xfa\n\x17\xf3\x122\x86\xd6\xb2\xc0\xd0l\x93E\xb8\xf4\x96\xa8\xfa\xc4\x81W5H\x940\x06@\xffP\xc3\x81\x81\xc5bunX\xb3@_n\x18p\xc0\xdd==\xbf!\xa3=\xbe\xa0\xd0\xed\x83\xefd\xac\xe8\xc1G1\xeb\xf31I\x9a\x9en\x9f\xe3H\xc1\xcf\xdeh8\xc6\xfd\xd73\xef\xea\xefgqe \x8d^\xfc\xaa\xcf#\x19\xbd\x0d\x96\x17\xc0>n\x9fM\xac\x9dv\xe0S\xc7\xc5\xc5\x80\xd1\"\xc1\x99\xb7w.\xaf\xf7pz\xc33\xfa8\x13n\xce\xa4H'\x17\xc4i\xa9\xa9\x86c\x1e\xdf\xb1\xd8\xd0\xc3\xcd\x94&\xe0\xa0\xdb\xe0\xd6o\xe3\x0d\xb43\x9d\x93\xb7+\xa5\x02\xc6!\xa5\xaf)\xb3G\x8d6\xe41h\xa3\x85\x8e\xf3\xa1tG\xb6\xdb\xa1\x8eB\xe0\x1fM\xa1O\xf7Q\xe2\x02u\x19\x84+\xc3z\x95v\x87u\xe2\x82F\xc7'i \x88\x01m\xa3\xf0\x14\xe3\x83+\xb9\xa0\xa8\xee\xd8\x01\xbc\x8c}\xf7\xf5D\x11\xf5\xf0Gl\xe6\xb4\xb5\xe0\x87\x8c\x95,\x96`\xd8\xd1\x8aY\x06\xdf\xd1\x03g\x85\xb9oz\xb9\xf7q\xff\x17\xe3\x1a\xff/\x8c\xff\xef/\xeb\x96p\xe2\xff\x0c\xe0r\xbc\x00\xb2\xabV2\xa7\xbc\xabq&<\x91\x9c\x82\x96\xf0\xe15\xc8F\x93Q\xb2u\xd4\xf5\\1\xf7\xb1l\xacb(1^\x84\xaeP$<\xeah\x93\xeb\xb1\xd8\xa8\x86\xc5`i\x96<\xac\xde\xa8\xecF\x8eP\x0d~\xe46\x8f\xa2ko\x87zS\xb7\xe4\x90\x8d\xddh\x8d\x8b\xdb\xed\x13\x86T\xe2U\xbb\x0b\xae\xc3\xac\x82J\xff\x85\xbbe\xdc\xd6\x1fW\x0b\xaf\xb7\x90\x1c\xab\x95d\xec\x0b\xfc\xef!\x1b\x16\xf2V\xda*\xed'F\x7fG.\xf9\xbd\xcd\xd2\x99\xab\xdb\x1aF&\xb4]\x99_\x7f\xaen\xcc<\x92\xa3\x124\xd2\xaf\xaf-TpE@\x12c!\xca\x95{\xc8\xf9:\xaf\xe3.\xf4\xb1\xed\xb9W\xf2x\xb1\x87\"}\xedZ3)z\xa5\xfb\xee\x1f5!!!\xc5\x13\xdd\xd4Pn\xf9\x83-\x97{7y\xb1\xd7\xbe\x15\x08\xb8'q\xaf\xc8\xacu|\x8d\xcdMY\x14\x19D\x1a\xde\xde\x9b\x18r\xba\xf4\x14\xa7\xf5\xf9|w\x96\xfa\xb7\xccd\xeb\xe2g\xac.\xbf\xbf\xcd7\x1d\xcb_\x17\x8b#\xf6Q\xfe\x1d\x9c)R\xf33z1\xb6\x07\x9d\xf5\x8f\xf4\xff2-\x0ds\x0c0]L\xa9\x01v\x0b\xd6(o\xd2i\xa8\xd1\xf7\x0dh\xc0\x1c@\xc3\xb0\x84\xc8\x166\xebJ\x83\xdc\x80\x05\xc1\xdb]\xf0!\x08\x99\xb8\xba\xbb\x16|\x98\xdc?\x87\xbev+?\x17j$\xaek	O\xd5\xee\x1c3,?\\\x851.\xa7\xab\xccI]K\xb2`OW\x1f\xd2&\xb3z\x1a\x14@\xa5:&\x16\x0c\x91,\xbe\x00\xc8]\xael\x0dB:\\\xd2r\xdcVa,\xdb$0O\xa7hM\xa8R:'\x89\x8e\x0eGd(\x08\xda\xb2\xaa\xd6\xe2\x1f\x0f\xd0a\xcd\xab(b\x824\xfa^\xb0\xde.\x14\x1f\x06\x92\xac,\xf1\xeb\x15\xf85\x04s\xf9\xb7\xe8\xa41\x90\x00\xc5{_\xe3\x8d{V=\xfb\xc7\xa5a\x17\xae\x87i\xd8\xdb\xe5\x1eQ\xc9+\x9aYMPZX\xf0i\xef\xa6\xe0\xf1\xd1%W3Fa\x14\x19\xaf\x14\x13\xad\x97[\xad$\xba\x9f\xc0c\xe3u\xcc\xab\x14T\xeac\xae\x95\xcb\x0c0\x97\x99\x0f\x10\xd4\xb9Fze\x9e\x92\xea\x14\x15\x94\xec\xf0\xa8|\xe2\xa4\x16\xf4\xdf\x94A\xef\xb8\x01\x93\x86\xc0\xfd\xfb\x96Z\xa5'\xfa\x9d \x88\xed\x15\xe11U\x01\x07\x9c\xbeH\xb6\x82\xd8t\x8a'e\xa2\xa1QP\xa3)M\xbe\x9a\x9b\x06\xa6\x9c\x8f\x8c\x03\x92\xec\xfa\xb3\xfd9\x0c	g\x13,3\xe1\xb8)\x01\xaa/u%\xb2MAp\x92u\x1e\xd2(\x9c\x15\xff\xab\x99>!vvx\xc3\x89\xf9M^3+\x11\xe33\x01\xc0\x17\xf7\xdeb\x84\xf8w\xa0\xefmhN\xb5Hu\x9a\xd0\x186O}\x95\xa50_\x0fK6\xa2\xb8\x8c\xce\xcf\x88\x8c\xf3\xce\x19H7\x0f,T\xb3Yb\x02Kr=\x07?4\x97\x07\xe2\x86\xa5\xef\x19\xb2\xa2\xac\xe7?r\xf9\x15\x80B\xcf\xb3\x0c\x16\xd4\x9c\xe6\xc1lc\x14 T^\x04\xc3\x12<\x97\x99Q\xacO\x157\xbf$)C\x16\xa3r|\xd6\x82\x8a\"\xc1\xfdx`\x00-\xef\x02\x17\x9b9\x8d\x88\xfa\xf9\x89\xc3\\7U\xfcvE\x03Q\x07\xcdnf\x14\xbe\xd6\xe9\xdeA\x03\x82\xe2\x16g\xf6c}\xa2\xd4\xcc\x0c,\xc5T\x8f=ZF\xc2\x12\xf3\x0c;~\x7f\xf0xH\xc4\xcd\x99*\x17\xc2\xe0\x9c\xbd\xfc\x81o\xad\xa8\x9a\x90\xb8\xd8/\xd99]M;\xb3\xe5\xac\xafXO\xf4\xa0%\n}\xc2\x9b-O\xca\x7f(erNM}0f`\x89\xcb\x9c\x0d3\x1b\xdaSU`\x12\xe4\xa6\xd6\xb7]oz\xb1\xab6\n\xd1\xf7Rv\x94\xd0\xbc\x89K\x92\x9c5\xad/W\x94\xd9\xd1R\xe2\x16L\xbe\xa5\xf0\xd0}\xb8\x1ayV5\x12Gl\x98|\x90 	\xc3\xfe\\yq\xe4>\"t\xb5P\x87\xd5\x9d\x086\x90\xce\xf0{`P\xd19\xca\xfa\xc2\xdaa\x08QD\x1d\xfe]7\x82\x0c\\\xa6\xc4\xdc\xfe\xb3\xa9\xf4yn\x00\xc57\x0c\xb1G\x16\xee\xe9\xf6\xf7\xf0y\xbc\xe7\x81\x80\x1c\x05/\xbd\x0e\xcb\x90	F\x8c\x0bR\xf1\xb7\xbbF\xc1\xaf\xe3\xe7\xcc\x9f\x1dW\xf2\x94\x02;\xde\xad]\xbd\xa9\xd9\x0ck\x9c\xf2\xa4t'\xd33\xa0h\x8dc\x07\x05\xaf\xb6\x1a\xc8\"\xd5\xb5\xc0\x93\xe5\x1cte\xbe\xf3\x11\xcc\xa8\x12\xf0g<?=|*fz\xaf\x92U\xc0\x8dB\x08\xdf\xa3`\xb3\xdf2u\xbb\x8e\x92H\xe8\x87\x99\x87\xdc\x0b\x87\x0bH\xf1\xdf \xa1A\xf3D\x81\x17z\xfdM\xe9&0tK\x85\xc4\xdf\xfc^\xed\xf5,\x13\x07\xef\xfdS^!\x8e=cI5\xde\xf0=\x0e\xafp\xa0q\xddX\x92\x8d\xca\xa3.\xf5\n\x18\xbdJ\xa7\x94\xb9\xbc\x9b\xa6\xe1Q\xc19`\x02\xd4.]B\xebh;!\xcf\xdaD\x10m\xb8\x85\x01\xd8\xcaC\xb3\x03\x03\xef\xc5\xe8\xd0\x0dg\x02\xbb\xfch\xfc\x82\xc3\x81\x82\xb4\xbf\x90\xa9\xa8\x11DI'?\xc7z\x83Q\xdci\xa0\x06w\xbf\xb1<\x15\xf8\xfc\x88\x8fZ\xec\xd0B\x00d\x93\xa1k\xc1^\xa5\xd5Q\x07\x89J\xe8\xcb\x8ckNgy[\x98\x02NZ.\xb5#E\xa0e\xee\xc7\xa9\x9a\xaf\x03\x99\xcb\xb7\x8c	\x92,\xae\x00r\xe7\xf8\xbf\x1d\x0b1\x1a?\x1d\xb6#\xa1+	\x92\x90{\xe0m\x8f\xa9U\xad\xf1\xfc\xa4\xa6B\x0e\x11\\\xc6\xf7\xa5\xca\x96\x81c\xc1\xd5\xdd!\x1eD\x9f!\x82\xdf\xb5.\xce\xe8H\xe3W\xbe\xf9M\xd58\xa4=y]\xfff\xc1\x82\xebq\xb2+\xebZc\xa2\xe1\xf1\xabD\xe9\xd8r\xdd1\x9d|\xf1\x0b\xd3\xcd\xb5\x18ao\x0bI\xdc\xfbC\x8bD\xf8.\\JA\xa890\xa6\xff\xab \x10e\xb0\xbc\xcc\n\x01\xea\xac\xd2\x05\x86\x9e\xf9.K\xa7q+\xa5\x1dKF\x11\x03\xefhK\x1d\xc2S\x1dZ3C\xd8\xc8\xd6\x0f%\x85\xdfa\x80\xb9\xbat\x1fW\x00\xd7\xd2\xf2\xe9\xac\x19B\xefHj\xdf\x10\x14\xc8=~\xcd\xb0Vq\xc7\x0d\xfa\x87\x0fkl\xd95M\x11\xd7\x98\xe6\x04\xce\x1c\xecw\xee/U\xdbL\xae\xbc\xb8\xab=\x9e>F\xf7\x91\xe8O]\xbe\x7f\xe9\xb7\x1f\xd8\x11\xa79gL\x0bh\xb5\xeb\">H9\xb60\xa7\x8c\x02O\xad\x9bs89\xbc\nb	\xc2MU\xfd//\xf0\xce\xd7\xa7\x1c6\xb9?0\xc7>\x86\x06\xd8\xb3mi\xc8\x0eg\xc9\x0d\x1d)H!\xb6V\xef*\xa9\x94s5\xfb\x14\x04*\x1b\x7f\xbc\x18$\xf9\xd9\xf2a\xbc\xec\x98\xc8s4\xefK\x93\xbanwL\x1c\xa5\xf5\xf9\xae\x17\xb6\xa3\x8f\xa7\xc5M\xba\xd9\x94\xd0\x8dVVON\x9d/\xa1\x85\xcf\x0f\x9e\x8d\x18,/\xc6\x82/\x93a\xe4s\xff\xa0\xf7\x1b\n\xd5w\x85\xe0\x1f\xb9-\x84\x1dz7m\xd2\x85h\xce\xfc\xb0\xfc4C\xf4\xd5\xb0*\x9f*\xd0nP#-\x137\x80\x82\xe8 7\xa7\xc8\x8e\xec\xd8\x072\x8d;4\x1e\xc8\xae\xfcA\xd1\x8d\xec0i.c\x0fk2\x93M3\xd8'Jr\x0c\xf9\x0b\xf4\x98\xe9\xbe\x0d?\x1e\xc2,%X\xed@\xc4F\xbej\xa6\xaal\x91\xf5\x8d\xdd\x13	E7no\xc8\x1e\xcbQ\xd7_\xeb\xb8\\>\x90S\x04\xc3\x06\x03Rw\xff\xa5+\xccZ\x049\x8b\x02m\x9a\xaf\x80\x9fu\xbb\xfb\xd8\x88\x04\xc6\xaa+s\x84\xea\x86\xec\n\x8d\xcf\xcd\xff\xff\x00~\x14\x81\xeb\x12\x17\xd9\xd0\xc2\x85\xe5\xa2\x1b]\xe6\x85\xac\xcd\xbaZ~o\xa4\x8d\xb6\x01F\xbf/\xb8\x98\xd6B:\xb6\xb9i\xa2v\xfa\xb7\x95\x86\x9f\xae03\x0d'\xe0\xb4\xd4\xb4\xb0#\xaa\xfbe\xdb\x88\xc0\xb0lN\xc0A\x83Jm\xa0	\x88a\xd6\x8f+,C\xcc`\x85\x8a\xeab\x13}\xe6\xf5.`\xf0\x87o\xd4\xe2\x00@\xfb\x9b\x0b\x1d=\x10\xa0\x10\x10tF\x18\xd9\xae\x95\x0d\x03\x1b\x8cas\xd1\x16Q\xa5+\x12\xe3\x9cF\xc2\xdb\x8c3\xea\x07\xc9\x07\x8f\xa0<\x9b\xc63\x02\xc9	4\xcd\x060d{\xd5\\<\xc7\xb6c\x91A6P\xfaN\x7f\n\xc9\x8bpg\xb9\xaa\x9az|\x88\xad\x025\x02Sg\x0b\xffD\xda\x15e\x8f)\xf8\\LKPA\x13\x7f4\xda*v\n\xec(z\xf5\xce\xad\xa07.3\xe9\xbcz\x86A\x07\xa0\xff \x1b7vzAis\xeb!\x8e9\xda\x89 \xd8\x0d\x1f\x9f(	\xeb\xc8\x8e\x0e\x06A\x8b\xcc\x98<\x0d\xb2\xe0\xe4\xa0e\xa2\x9e!\x18\xdc\xdf<\xaa\xac\xa0\x04g\x86Y\x0c(J\x900A\xd2\xbe\xf1\x05qPK\x96\xd2\xd6\xcb\x16\xa00j\xe7uc\n\x94\xe0 z\xa5P\xecf\xccU\xa1\xdd<\x9d\xc6\xae\x11\x1d\x90\xb9\xbc'\xdc\x88\xc5\x13\xfe\xea\xbc\"D\x83\x06\xd5p\x85J\"VM\xd1\xee)\x067\xb0\x81\x0d\xc4(\x02xc\xe4\x1d\xd1y\x02\xd3-\xaa\x95\x01q`\x04\xb1\xa0s\x8a\xbb\xf7\xc1\x85R\xdb7Q\xe0\n\x7f\xf1\x0d\xca\xcc\xd3\xa5\x0b\xeb\xf3\xa7\xcal\x91\x05a\xcd \xbf\x1b\xb9\x9a\xb3\xfa\xfb\xea\x18&OP\xa1\x8ac\xaa!\x82\xe4\xae4\xe2\xe8\xad\x87%\x0d\x8a\xa4\x97m.\xbalD\xd0\x04\xee\x8b\xa9\xdb\xcf\xce\xfe!\xa1\xe8\x12\xddM	\n\x1dl;\xaa+\xa9\xdd\xf1\x1d\x92d\xa1\xf4\xd9\x1bB\x88O\x96\x13\xbd\x81\"\xe9\xa1'\x1f\x98y\xff\xf2\xda\x00\x05\x1b\x84\\\xb8e\x177\xde`RY#v\x99Z\xc3\xdfN\xe8@\xe0\xfdG\x95\x04\x02\x03\x17\xd8\xa1\xaf\xf9/K\xccT\xbbd\xb7]v\xe6r\xebG\x12\xcb\xc7\x15\xae\xb6}\xcaG\x05\xae\xa0\"U\xa2B?\x94\n\x8d\xc4O\x1d@Z\x08\"l\xe1\xb5W\x06\xc0y\xfe\x04\x06<\xd2\x00\x8f\xf7\x02A\xd0L\x8eD\x80\x05\xe8\x1b\xcbBj\xa7\x11:\x1c\x98]V\x001\xa2S\x7f\xea\xf7r\x05a|\x00P}^\xd0\x02\xe8\x90\x08y@r\x15\xd4D\x1a\x90\x03*0\x84D(\x0d\xe2\x16\x13`RO-p\nB\xa7\x13\x9eWL6@F1\x90\x02TX\x85F\xf8vHxY\xea'\x0d	UH(\xa6^\xf6G\x80Nr\x08XUk\x05a}P8\x06]t*Xx\x7f\xceP\x013\xe6B\x08\x81iV\xe5m\xca%\x1eW8}bb_C'\x0dt\x88;{#{x\xd1\x86%\x11\x1c\xd2'\x00\x07\xf1\x874\x95\x18\x82h\x7fo\xff\xd1ir6t\x8a\x98;5\x01\x86a\xf2\x16\x19\xa2G\xc65\x89\x02\x03\x89d\x98W\x87\xb1:/e0\x9cgn\"6G\x90\x13=4\x88gr\xa0	Me\x07\xa5\x98;l\xf7\x06\xff\xe6qJQ\x08\xa6\x11'xH\x8a\xb1hA\xaa\x87zZ\xf7\x17-w\x82\xb0\xd8\x8b\xc6A\x02\x18\x02\x8cHH;\xc6RZ\xc6xT\xc9xy1\xb1>-T\x18,\x90{\xc6\xe1d\x9e%\x8d0\x91|\xafd	 \xe8Ei\xe3|\n\x08>q\xe3pcq\x07m\x13n-&r\xd3\x81\n\x82\x80=\xe6\x03\\\x87\x91c0r\x02\x1f\xf1g\xa2\xe7<M@\x11\x11\xa2\x8c\xbdG8\x81\x82^\xeeG\x11\xe3\xb3\x05\xe8\xf8\x16\x04\xd9 {\xe3z\xa0\xd3\x8f:\xe1\x0d\xdb\xa8\x87$\xf1-o\x84\x7f\xc5\xb4\x83\xb3\x14k\xa3\x98\x17'\xf1|\x18\xe0\x90\x12\xb5\x13\x1e\xd1\x91\x87\xa1f^fQ%\xe8\x88\xbd\xd1\x8e\xc1\xe1	\x0dPL\x01\xe5}\x16B\x01\xb5!\nC\xff\x84\x08\xc5t\x02t\x13Y\x02\xc6\x92\xd7\xe1\x12\xcb0Z\xdc8\x1e\xd8SA\x96\xb7\x8b	r\x10\xd6\x13Wf\xb8\x94\x05\xb1\x90Vex\xfcq\x83\x92\x16n\xb6\x08\x95v`s\x843U\xd1\x06\x95K\x91n|U\x94A\xf9|h@R\x00\xf9\x1e\x05\xb0k\"\x95!WE\x96\xca\x01\x08\x1d\x08\x1d\xa4\"h@\x03\x96\x07a\x0c\xc1\x84\x90\x00\x00W^	\x94x\xc9\x8b[Q\x04:\xb1c\xe2x\x82\x81)\x10\xed\xb8\x0b\x96\x87]\x81$}\x89i\x10\xab\xe0]\x18$!\xc0\x16\x99\x051\x99o\xc0/\xf6#JO\x19\x99\xf7\x91\\`\xf1@X\x07\x98\x89\xa9P\xbah\x11:\x92,\xa6y\x9ac\xb0I\xe1\x86\x1fk5e\x98y\x86\xb1\x84\x17\x0c\x82\x19\xc4\x02\x12tS\x9b\x04\xe1\x05\xddg[\xe8\xf6\x04\xbb\xd3\x87\x81\xe9v\xfb!\x0d\xe8V\x0d?\x99\x95\x89I	wC\x10\xe86d\xc9\xe9\x9b3\xd2\x058	\x97\x91y\x839\xf9\x99\xd6\x99\x97]\xe6\x93\xab\x0dh\x9d\x00P\x92J\xf9\x9d\xfa\x95\x98\x01\x01\x00!\xf9\x04\x05\x08\x00\xef\x00,*\x00\x10\x00w\x00\x99\x00\x00\x08\xff\x00\xdf	\x1cH\xb0\xa0\xc1\x83\x08\x13*\\\xc8\xb0\xa1C\x81\x00\xf0\x0c\x9a\x08\xe0\xa1\xc5\x8b\x183j4\xf8!D#L\xa2\xb0\x81\x88\xb8\xb1\xa4\xc9\x93\x18#N\xb1\x82\xa5L\x18,R0\xf8BI\xb3\xa6M\x01\x8dF\xf0\xe8\x12\xe6\x0c\x1b9l\xb4\x8c\xach\xb3\xa8\xd1\x87\xbe*l\xd3\xa5\x05L\xb8\xa7q\xd2\xec\n3\x03Z\xd2\xa3X\xb3\x1a\xbc4\xea\x0bO1@\xa3\x9a\x99\xaaES\x01\xa2Z\xd3\x1a}\xc4\xaa'\xaf\x9fa\xd5H}S\xf6\x0eI\xb5xMFT\xa6\xa5M\xdc\xbfn\xbd\xe0\xa0s7\xe3\xde\xbci%t\xd26\x16\xf0\x16+\xaf\xda\xe6\xd2e\xb6pJ\x01\x0b\x92\xa2E|\x12\x90\xb1B\x89<\xc5\xf2\x19\x97\x0b\x1a\xa1#%5\xadk\xf9\"\x1dVXr<\xb2\xe1\x19\"\xe7\x8d>\xa0\xc8j	\xb5\xb1S+\xa7~\xa0z\xb5\xba\x8b\xb9\x1fv7\x02\xb8\xf2\xf2%+\x18\x19B\xb4\xc0|\xfbb\xa3\xd1r\xdd\xc0\xdd~m\x91$Z\x81\xdf\x92\xff\x995`\xb3k\xb0\xdb\x1f\xb7t\"\x93@\xf5\x85{e\xa1\x07\x0c\xd4\xdc\x8aYN\xc5\xea'\xdf\x1ai\xfe\xec\x8d\xb9\x01F7T(\xc2@\x7f\xef\xbd\xa3\x02\x14\xd8\xd1\xf7\x14-\x9c4A\x86x\xfaE\xc5\x9fy\xfe\xf5\xe2\xe0dL\xa9R\x02\x82\x9c5\xd0\xc8\x0d[\xa0A\xdav\xbbp\xe1\xcd,\xcfH\xf3\xd5\x1b\x15F%\x8b\x1d 2\xb4\x9cv1\xc28\x97\x17D\x1c\xe8\x9e]\x18&F\x8d-\x1b\x02\x15\x0b	\x8dd\xc3\xdcT\x0e\xceX#|K\x9a\xb1\x83.\x13\xd2w\x85)\xdaP\xb0\xd7UxE\xc4MSEj!\x84\x12\xc4\x99\x08`\x8cN\x06\xd9\xd0\x8d~%\x92\x81\x84E\xb2\x92\x13a\xe5uYC\x99\x14\xea\x87\x85\x15\xadT\xe2M\x95E\xb6\xf1\x8a O*\xc4\xa6\x19E\x94\xf3\x82d\xbd\xe9\x89\x05:\x86\x1c\x96\xd8+\x1c2\xb9\x86\xa5,x\x10\xdd\x16QZ\x8a\xe2\x84\xb7TPhB\x87jq\x82.$T\xd1h\x8c\xec1#\xe9Q8\xed\xffV\xe4\x13=<1\x0bK|\xba2\xca\x94f\xe6\x99E*Z\xaaic\xa7\xf2\xd1\xa2A\x16\x9f:\xfa\x88f\xae9\xa4\x07(\xb0\xfc\xb7*\x81\x19\x90\"\x04&\x8fx4\x81\x05\x89\xac \xad_\x935q\xc9\xab\xe7\xf5\xf6\x15$J,\x91,\xb8\xe1\xd0\x90Y\xa1\xe5\x81\xa6\x08\n[b\xe0\xc1\x87hE\xea\xcc\x9fc\x04\xc8\xee\x91\xd9:\x12\xc2\x0c\xe7\xe8\xc6\x81\x1e\x1bx\xbb\xeaS\x95\x8d\x8a\xd0\xa1\x01F!C\x0f\xc8\xe6(\xde9g	\xfb\x0e\x00O\xb0\xe4E\x17\x12\x0b\xb1\xcaJQ\x9c\xe2L\x0c\x98!\xa0\xc1J\xfdBUb~\xb1\xd0\xc0\xce\x14T~\xd3\xd3\x153\x0c\x82\x8d\xc7\x97\xfa\xda\xb0r\x9d\xa2\x98\x0e\x9e:V\x88\x85\x9b\x85E\xe4G\x01\xf7\xce\xf0\xf1\x99\x80\xe22\x96\x14\x99\x84\x14\x01\x05\x90T\xd1\x8d\x13<\xac` \x10\xde\x80qM&E<\xfd\xd5*\x04\xdfR\xb3\x83\x839\xfcp\xd0q9!\xc9\x08^-<V\x12\x84\xd6Yo+`\x06\xff*\x16v\xb7\xca\xe9]\xa6@b\"\xc53\xbc\x15]\xc5\xbe\x93\xf5\xccv\xbc@O\xab\xeax,\xfakt6W\xb77\x0c\x90\x110\xea\xa9\xc5\xd9\xd9\xac\x05K\x03.\xab\xf2\xd5\xe1\xb5\x11\xcb\x13\xdf\xc11\x06\xdc\x15\xfe\xac\x11\xc4~M\"\x89\x139\xd0rt\xdd\x16\x8f^\xb1%\xc5p\x1e\x82\xac'\x82^\xb4\xbf\xb6<#*1\x0cx\x00\xb7) \x14H\x05\x81T\xb8d\x1a\x8et\xc9n\x18\xdc\x1cH\x01C\x0c\x90\x1c\x9c\x82\xe5\xc7\x17\xadB\xbc\xc2\x0c\xd0\xd1\xb7\xc6\xaf{\xc6\x15s\x03IB\x83<Az\x027\xf1\x97C4\xbb\xda\xa7\xc4},YhB\x81\x14P\x02\xde\xb5\xef|\xc1\xf8\xc5\x1e4\xd3\xb7\xf6\xb5/~u\xc8C\xf3J\xf4\x94+<\xc1\x05>\x88\x05dx\xe0&\x97\x94\xaf\x7f\xe5\xcaS\x08f\xe1A\xfe\x94\xad|9\"\x1c\xf3\xe6\xb0@\xf0\xb4\xcc\x81\xa0\x93X\xfa4\x83\x02\xa2\xf5\xc5\x83\xb2\xb0\xc6\"<\xb8\x1dZ\xb4McP\xc2\xdeT\xff\xe6\xe6\x04\xeb!B\x03E\x80]\x8cL\xb5<\x16\x1a\x00\x04D\x82\xa1\xf1p\x06\x81\x04:1\x06V\x88Z\xd1`1\x02,|\xeb\x08Vq\xdbAh\xc7\x13+D\xe2i\xdd\xb0\x02\x0eh\xa6\xc5%n\xa1\x11\x19K\x19\x07\x8a'E4E\xc3U\xe8\xd3\x04\x14\xcap=\x1eJ\x01\x14,\xa8XXB\xb0\xa5\xc8\xf9\xaa\x1b0\xd8A\x14\xa52	\xf9\xf8M\x0c`0\xc1\x036\x17/\xf2\xd5q;> \x84\x13\x15X\x81\x1f\x1c!<n\x91\xde\xf1\x0e&\xc61*\x11\x0e\x9e\x80\xc6\"\x9dq\x8a\xf9\xb4O(\x07\x08\xc0^^xI=\x8d\x01\x15H@_\x1f4\xa5	 \xd0\x80\x8f\x96:Z\xf5xHJ \x06\xd1W]0\x0d\x14\x18\x11\x02\xecL\x80\x96S\x9c\x84\x15wy\xc3Z\xe6h\x1a\x04\xd8d6R\x90\x93K\x80\xad\x9a\xbbc\xd7\x18\x8aY\x12\x008\xd2}S\xe8\xd6K\x12QM\x07Za[\x94\x9c\x84\x83\xac\x89M\x1a9\x91\x1c\x9c\x9a\x91\x12|0\xff\xb9o,\x92I\xe4,\xe7\xd3R8\x82\xef\xbc\xe5\x19J\xb0\xa4\x1b\x9b\x08\x8ce\xb4\xd1\x9ajP\x8228I#|\x0eh\x81\x80\x10\x05\x10H\xc4C\x80\x92k{\x9f{]\x12\xd4\xb1\x8anDi\x036\xcb\x93\xc5\x8e\x86Q}e\x81}\xd6\x1c\xe0\x0cC\xe0\"\x96\x1a\x02\x04\x90\xa0\x01L\x03j\xc8\x18\xfd\xa0%WP\x11\x95,\xf0\xd0\x1c\xbdQ\x96+|f\xea z\xa1'V\xc0\x1cr\xf8\x06\x17y\x86LX\x96\xd2\x94\x9f\x83\x055\x9c\x00>\xef\x94\xed\x8dFp\x1d\x0c\xa3\x10\xcb\x99R	\xa2\xe2L\x13\x1f$b\x03O\xa4\xe1\x9d\xff4\x1a\x02\xaf\xca\x91\x81N\xc8\x16\x7fh\x84'\xc8\xe4\xc5\xc6\x88\xe3\x97\n\x8dQYi\x04EW\xa2\xf5\x1b\"\xb1g\xfaT9\xa3\xb8\xf6\x10i\xc6\x1c\x16\xf6\xb2` Cl\x02v\xedt E\"\xa8\x01 \xbc\x08\xado!O\xa4\xe2\xc5\x023\xcc(\x8b\x8d\xcbS7\xda\x83\x12\n\xd8\x15:\x1e\x80A`\xe7\x99\xa3\x98\xffP\xd2\x0e\xf2D\xa15\xb9\x00\xa9\x99\xba`O\x1cp\x85\x01\xb7#\xd3\xd6\xf6-K\x1cH\xa9Q\x1f\xf9\xd8@\xc4\xf3\xb3\xa0}\x1fC\xfd\xc0\x88\xd5*\x831\x824\x93m#k#\xeb9#\x17\x14\x8c\xae\xd1\xe0I\x98e\x0cS\xbc\xa6\xdd\xec\xbb\xbc\x11\x85G\\\xe3\xac\xfa\xe9\x06% \xd7\x19\x17\x94\xa1\xa00\x15\xafZ1S	\xf7\xd52\x16\xea\x95%\x18\x84\xa2$q\xa6\x97\xae\xa4\nC\x06T\x91Z\xf4\xfem\x15\x06\xd0LC\xed\xb6\xdb\xe9Z\xc0f\xe3x\xc69z\xe5\x93\xe2\xd2d\x8e|\xc9\xae\x83e\xf4\x87\xe0% \x18&\xe8(h\xb9\xa0I\x13\x13FtF@E\xa3\xb0\xb0\x8d\x94\xd5D\x03\xf7\xcd\xec\x88w\xf1\xce\xdb\x9e\xc0\xb1\x10\xb5\xa9\x84wDGOl@\x01\x08~[r\x99[a	*\x0d\x1a\xf0]\xb1\x1c+\xb9\xa1N8\xe0\xa3\xe5\xec\x11jw\xfc\xb7\x16;\xd1\x12v=\xec\x0e\x0e\xe1\\\xcd@-.V\xae\xd3M\x9a\xd7\xe0\x1d\xc7\"\xff\x8cN.\xadJ\x83\x1c\x1d\xce)\xb7B\xafH3w\x91\xb2\xcb\xd9F\x17\x16>Z\xac\x88\x0f[L\xcc\x109.G\xfe\xcc\x9e\x9d\x15\x81-s\xd9\x0cZ\xa8B\x03\x90\x8a\xdb\x1a\xcc\xb9\xc9'6\xb4\xc5\x86\x92dR]X\xb7\x0e\x06\xf4\x11I\xeb8)\xdfV\xa9(\xd4\x9cQ&\x99\xb8G\xb3aq\xae\xc8fR\xe4,^!G\xe4\xd0\xe8\xd1T\xa7=\xad\xe3\x1d\xc3\x1aa\xb3.5\xa1O\x8d\xeb0\xf4x\xd7\x0f[@\xab\xa5\xb8\xec\x18\x06\x17\xd8}\xa0utm\xfd\xe9\xe3\xd5\xc2\x11\x85\x9c]\xb2\xf9u\xc9ft\x03\x86\x92n\x05\xb4\xa5mj\xf4A\x17~\x99[\xb4A\xb2=\x10?8\xba\x8e\xcax\xb7\xc5\xc2=na\xd3\x99\xd8\x9e2V\xa6\xd5]\x10Y\xfb\xbb\"\xc5\x907\x0c3 p4=\xbb\x0f\x8b\xb57\xa6c\x99\xdb\xe6b\xf92\xf52\xe7\xa5\xdbg\x81o\xbb\xf3\xe0\xc1#\xf7\xb0\xcb\xc3\x82sB\x05\xb9\xfc\xb6\xd1\x95\xf7@\x1c%\x82\xae\xe2B\xff\x9c7\xc6\x81\x01e\xf4\nY\xda\xae\x03yV\xce\xd2\x8c\xe8\xc1\x82\xc29B\xf9\xc5\xc5M\xc9\x96\xeb7\xd1y\xf09i\xba\x83l\xf8H\xc1\xba\xc2m\xf3\xa6-\xde>z\x7f\x06\x05ED/*(p	F\x10\xc0\xe7\x8b\xca$}\xb12\x80-Wb\x12\xd7\x88BQ\xc5\xa2\xf3\xa6c\xdc\x1c\xf9\xbd$^\x8fA\x05\xaf\xf9|\x03\xdb\xe0\xe0\xbb\xb4\xf2\x00\x8b\xf7\xeb\x15\x93\x08\xc2\x12\xee,X\xa6\x1bO\xd2\xb3\xd0\x14\n\x98\xfc\xca\x89\xb4\xfd\xea\xf9\x81\xbb\xd6\x8bn(\xc7\x9ac\x06i\xcfE\xd9\xff.	/\xbcs\xf0\x0e^\xfb\xe1\xed{\xd1mXA\x87\\\xe2z\x1fm9q\xc9\xfb}\x8a\xbd\xd0B\x9f\xc6~I}\xb7\xfd\xa7c@\xfa\xe2\xd3\xf2	\xc3\x82\xbaB\x1c8\xbd\x03W\xef`\xb2\x1e\xe2\x15^)#\x06\xaa\xc0\x05\xb9\xbb\x87\xee@Ny\xdf\x95\x8fz\x85\xefV\x07\x97\xfd\xcfj\xbdh\xfc\xc4$\xbf\xf4h\xc8=\xf3]]\xc7\xc6Ji\xeb\\_\x85\xff\x8a\xb1\x7fP\x8f\xfb\x97\xfb\x97\x86\xeb\xf7\x19\x0f\x9f\xa5\x90\xbfB\x9d\x88\x86\xc9\xd1\xdf>\xf5W_-\x88\x08\xf3R\xf1\xcc\x01\xb4\x87\x94\xfe\xedSc\xdfv\x7f\x93\x121g\x16#7\xb0d\x87\x06\x80\xa0sT\xf21{\xed\xf6oe\x85\x12\xe3c\x06\xd3CB0\x04\x03pr{\x0c\xa8#,E$\xc6\xa7K\x11\xc7x\xe5\xe0\n\xabPP\x91`~\xf3f	\xda@\x0e*\xd8\x81\xfcw	\x7f\x12\x82\xf5B\x10\xb2\xd6ZU\xd4\x17\xf3'\x1eAE\x0b\xce\x90w\xbd\xe6r\x05\xc7\x7f\xc3\xc4	\xef\x12r\"\x17qR\xc0\x81\xc6\xa3\x03\xd5\xa06\\\x06K\xfb\xb3D\xe9\x14|\x04\xb8f#\xf8^QvI\xd0\xb0\n\xcd\xb6b\xb0\xe499\x02=\xa8e\x1ce\x86\x85\xd0\xb6\x19\x84 ~\xa0\x85$|\xb3\x801\x15	Tp$L\x90v\xd0c6W\xa8\x17#\xd8o\x15\xb0\x83\x976`\x8f\xd1:\xb5&\x02!\x96-.\xb20\xe7\x90	\xeb\xc0$7\xc0\x02\xec\xff\x07\x11\xf0R\x0b\x80\x12y'\x87\x01&5zJ\xc7>\xa6R	\xa7\xf0\x046\xb3\x04\xd0\xc0\x02\xfeW4D\xc0	>\x00\x17M`:HXN\x85\xf5R\x0drI\xb3pm\xde\xd1W\xefS1\xc5&\x0b6`\x03B\xe0V\xec\xe2\x03*\xd0\x02Q\x07\x07\xb3\xc0\x0d)p\x0e\xc8\xa2Ad\xf6p\x89\xf1\x00B\xf0\x13\xdcp\x08a\xc5T|\xb2\x0c\xe1\x83S:\x05(P\xa0	<\x00\x04\xe7 \x046\x00X\x1de\x86\xce\x90F\x05\x12=7\x84w\xca\xb8\x8c\x1c0\x04\xe0\xa5N\xe3WG\xc2H\x0e\xa6\xc0\x04\xd3\x10\n8\x10\x0d@\x10\x04B\x00\n\x98\xf0\x02,\x90\x02\xfd\xf5P-\x93&\x89\xa0;-\x93N\xe98s+\x80\x02\xb6Xy\xb6\xb7[\xe8\xa1\n7P\x15*\xd0\x0e\xf6uW\x94(\x17\xb4 \x0b\x90a\x1a}ql\xab\xa8\x1c-\xe0q>\xc8z\xe7GG\x14\xf6\x90\xe4'\x15W\x00\x0d\"p\x02^\x13\x92\xb3\x03\x006\xf0\x04\x01\"'\xfa\xff\x07\x83\xef\x07\x17	\x88>\xd5\x11\x11\xa78\x89\xd7\xb5\x7fLH[Q\xb8\x04\x81v|\xb7\x01\x0dI\x14L\x9a\xd0E\xe4\xb7Z\xcf V\xd0\x84~[\xb0\n\xa5\xd0D\x8f\x88\x14b\xf7-C\xf3H\xb2\x90\n\xea\x90	\x1b(5F	\x86<\xb7\x87\x881\x087\xf7)Y\xc0\x8e\x06\xd6\x80\x9e\x00\x04G\xa0{\xbeF D\xa77\xfe\xb6\x96\x0c\xe6 \x19\x19#C\xd0\x02\xd9\xe0\n\x18\xe8f2\x04Y6\x98\x90E\xa1\x0e\x7f\xb9\x93\x8d\x83$\xde\xd6GU\x89L\x7fC\x05\xd3\x93F\xbe\xb7o\xeb\x06ly!t'\x89s\x95\x884\xd4(:\x964`&u\x82\x89\xa6j\x9e\xa6\x98\xad\xd5\x95\xee\x04]\xccF\x8eR0\x8c\x8d\x90\x02/`\x03\x9c0&\xa7`\x82\xb0P\x05\xcf\x18`\xf9B'\xc9&\x93\x92U\x94%	\x0eE\xe9|w\xe5\x04F\xb0Q2\xc0M\xcb@^UD'\xe6Q\x83	\x02\x00l\xb8\x92\xdb\xc2.\x99g\"\x1dY\x0d\xd0\x807\x1d\xff\xb1\x9a\xc4\xa9\x1c\x96\xe6@/\xd1cD)^\xeb\xa0\x81\x15\xb0\x02:\xd0\x01\x17`(\xac\xd9\x19\xe4\x12\x11\xe1\x85#\x03\x06\x16D0\n\xbb\xd96\n\xf8h\xed\xb9\x0b\xd0\x03~\x9b\xb9\x95\x07\xcan\n\x00\x03Nq+\x97(\x1b\x93 \x03\xe50\x82`\xf6\x99\xb54\xa0O\x10G\xe4\xa9\x8e{	\x11\xa3\xa0\n\xac\xb0Y\x85\x86!\x07P\xa1Q\x88\xa1\x12`\xa0\x89\x89\xa0ctb\xc9 )\x11\xe7,_(^Sg_\x19\xcan?\xa94\xcb\x942\x9d6G\x9f\x83^\\\xc5`7\xca\xa2H\xa1\n\x89\xc2\x0c\xd5\x00`\x08\x97M\xca\x91\x93\xd1\xf5\x043\x10uCZ\x9e\xb3\xe3E\xe4\x00\n\x82\x00\x06\x9b\xd0\xa1\x86\xe1\xa4\xa05\xa002\xa5	\x02\x11\x1fC\x0bA \x06c\x86\xa3\x16\x81\x01^\nQQ\xd0\x8f\x1e4u/J\xa4\x0fq\x9en!	\x12\xe8\x1a\xcd\xf4\xa3h5\x0bO	\x8eW\x00zt\xea,\x85\x99z@wU\x01\x00\x02\xef\xd8\xa7\x98\xff\xa0\x02v\x95\x95\xf5IwQ\x03\x92\xea\x96\xa8\x8b\xcaT\xe0\xe9/\xa7\xe0\x93c*\x10\x8d`)Y@\x02\"\xc8g\x97jM\x10\xe6\x99\x90:\xa8\x19\xe2\x1b#\x10G\x03@\xaa|\nQ\xdb`\x02\xd9\xf5\x0d\xa9J\xa5&\xa1\x82M`\x9d\"\xb7\x9e\xa6:?\x7fq\xab\x9d:\x10\x92\xc8w\xe1\xe3\xaa\xf0Q\x0cA\x88V5\x86\xaa\x9a9\xac\xef\xa0\x85\xe5\x03Y\xa3\xca\x87}\x98\x9ccEjK\xb2\xa9\x91Z\x80\xe5\xb3N\x9e\xc1\xab\x11x^\x8ei<j\x95\x03\xea\"\x06\xc2\n\xad\x1b\x13\x84\xb70\x05J\xf0C#\x08\ntc\xa10\xa4\x0b\xfb\xd5\x02D\xc2\xad\xaaz\x11g\xca\x81\xc03i<\xfa\x02$p_/xX\x08\xf9\xaa\x1b3\x92\xd5 a\xec\n\x11}	j\xb6\xc8\x05\xb2\x00\x05\xc6\x98\x06ot}\xff%i0\xca4@\xf7\xb0\xed\xd6\x0e\xa5\xdaF\xfdb\x16\x1a[K\x10\xf6*1\n\xb2\x9e\xba\xacM\xf8\x07'\x0b\x8f)\xcb\xa4+\xca\xb2\x04\xff\xe1\x08\xc0\x84\xadg\xb0F~VGo\x86T\xebf\xb3\x1ca	\xbc1h9\xa2	\xe4\x1a]\xf2\xa5\xa6B\x9b\x108\x80%*\x08\x16\xf9\xa1\x04\xb5\x80\x03\x94\x12je\xa6fM\x9b\xacH\x96\xb3g\x90F\xb1p\x82\xb4\x91\x19\xe0\x81\xb5@\xb2\xb5z\xba\x014\x90\x04\x00Z/P\x88^\xdb\xa5\xb0h\xeb\x1aXV{\xe5\xda\x80\xa2\xc0\xa9sK\x13\xcdx\x96\xd9\xaa\xa2{[\x12}\xebr\x83\x15\xb8\x1f6\xb8\xd3\xf6\x9b\xb8\x1a\xb8\n\xf0\xaf?\xd7\xad\x86\x0b\x1f\x88\x0bZ\xab\x05\xb8\x91\xeb?\x91\x97\x9f\xa0\x13k\x84q\xb9\x9d\xf1\x08|Z3\xcbT\xb6\xc6\xa3X\x9e\xab\x17!\xa0P\xb7\xe0\x03\x99p\x08I\x1b#\x1ev\xba\xca\xa1\xa8\xf4A\x0b\x8e\x18/\xd7'd\xb2\xcb\x8a(yT[r\xb5F\x15,\x8b\xcb\xb8\x01i$AA\x88w\xb1\x00\xaf[%\x9c\xdb\xaf6[m\x96\x12	\xd9\xa6>JTY\xc3\xbb\xb7\xd4D!W0(\xf7\xf91\xb0;\x9d\xd7\x83;\xb7\xd9\x14e\xaa\x00\x1a\xad\x11\x08\xde\xab\x1fV\x15\xbe\xe2k\x03<D\x0b\x82\xban\xec\xe3\n\x84t \xbb[N\xa4Y\x06=\x10aA\x82\x9d\xc8\xc3S\xf7\xdb\xa5\xe0\xa2\x8ajRX~\x85\x0c\xce\xdb\xb4z :\xaah()\xd5\x05\xbb\n\xb9\x01,\xbfW\x90\x0e\x8a\x89\x01Q\x04F\x96;\xc12\x9a\xb0k\x12\x0bK\x00\x1d4\xcb\xc1\xb3\x13\n\x99\xf1\x10\xcdt\x046F\xc2\xe5d\x1d\xae0\x1dZ\xcb\xc2]B^2\xfc\x93\x13\x1c\x10\x00;\x01\x00\x00\xff\xffPK\x07\x08!/\xf6\xd0\xb2\x94\x00\x00\xb3\x95\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x18\xac\xd7X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/common.jsonUT\x05\x00\x01\x81\x94xf\x8cVMo\xdb8\x10=\xdb\xbfb  h\x8b\x1a\xe9\xbd7;v\x1aw\x9d\xd8\xb0\xd5\x06\xc5v\x0f\xb48\x91\xd9H\x1c\xadHE5\x8a\xfe\xf7\x05g\xa8\x0f\x07(\xb0'\xbdy|\x14G\xc3y\xa4~M'\xc9\x13\xd5\xa5\xf2\xc9G\xf85\x9dL\x92\xa3\xb2\x16u\xf2\x11\x92\xc5\xfc\xe1a\xb5\x84\xc57xs\xe5\xde\xc0\xedv\x0fW\x8e\x1f\xdf\x93+\xf7=IfA\xaf\xb1@/\x13\x96\xab\xcd*\x1df\xc8\xb8)U\x8e\xcbA\xb4\xbe\x9f\x7fZ\xc1\x9f\xa5\x87\x8aL\x81\xf5X|\xd8m\xd7\x9b\xd5\xfe\xb5\xdcb\xbb#\xe7\xdd\xda\xa6\xa7\x1a\x15O\xb8\xd2`\xb1\x85*\xf0`,x\x1e\xb9\x96	\xcc\xce\xad^\x87u\xdc\xb64>\xe6t\xa5e\xc6[\xf7\x0e\x94\xd5p\xa5\x81s	1E\xd9\xf0\x86?L\xbc\x146u\x8e:\xa4\x17d\xbb\xed!\x85\xdd\x97\xfd\xa7W\xe5\x1c\xd7\xd1\x9d\x94\xa6va\xba\xea\x1f\xee\xe6\xcb\xed#,\xd6\xffg\x13\xe437\x94=\xa7\x94\xe7\x85\xbc!\xbd\xdb\xaf\xe6\xcb \xbf([c\x87-\xfe\xf2p\xb9\xc9\xa2x1\xd8\xa2>\xa8\x12\xd7\xbb.\xfd\x03lo!\xbd[\xc1a~\xbf\x82\xf5\x0e\x1eW\xfb\x15|]\xaf\x1eG\xb3\xa7\x93\xdf\xb3\xd8P.\xf4\x13GU\xd1\xd4\xaap]\x7fiuN>\xc2\xdf\xd3I\xc4\xb3\x0e\xb9d:\x99\xfc\xc3	\x9c\xa8\xa9{\x11\x07\xb3\x1e\x8ed\xa5\xb1\x8d\xc7^\x18\xc3\xd9(\x18\x8b\xc9\xfa\xd3\xa0\xe5h6\xe0\x91\xd2aFV\xf7\xd2\x18\xceF\xc1H|F5\xa4\xca\xc1\xac\x87\"\x8bE\xe1>\xe9\x8a\xa0til(\xed\x9c\x01\xbfI\xe5\x14\x98\xf0\x90\x98s\xe0G\x8cI\xa6X\xb2\xe7\x92\x1a'tF\xd6\xd7*\xf3\xd2\xd4Ap\x13\x19\x88\xd4\xc8\xa6\x8b\xf3\xb0\xab\xe2IPE\x01\xc73\xacw\xa2\xc3\x9fU\\x%h\xc4\x0eK\xc8\xd8\xc5\x02'\xa3\xc3V$w\xe1)v\xe6t\xbb\xcf\xfb\xa1\xac\xf1T\xf3\xf4{4:~\xe5\x8f\xc6\xf9\x07j\x03\x1b Xje\xa0\xa0\xecY\x9a4\xa2\xb8\x8b\x1aku\xf1\x9e\xf7\xefe\x88\x06[^X\x91Z\x8b\"\xbfC\xa5a\xb4\xb6C\x9c\x17\x05\x9b\x0d\xb9\x10\x9d\x13%\x9f\xc3\xa9\xcb\xc5\xc9\x99\xc4d\x84b;6\xdb\xc1\x9b\xec\xf9\xcc\x8e\xe3\x18\x9c\x10\xd1m\xc3\x87\xf4\xb8w\xd9x?\x02\xea\xb7\xe1LM\xe0\xde~\xa3\xe6]g+w\xb6Yl\xb5D\x1b\x97\x91\xb5\x98\xf5\x9f\x19Cc\xf3\x98\xf3\xd9f\x17A'\xd4\x18\xa3)\xb7p\xe2M\x89]_f\xaa@\xabG\x1d\xfdY\xd9\xd8\xd0\xb7x\x8c\xe8\xbeo\xf2yU\xf7\\\xe7\xe4\xcf\x8d\xedQ\xd1\xe9\x9a<\xa2\x03V\x11m3\x1f\xd1\x03\xbdD\xb4\xc4lpV\x8b\xf8\xdc\xe7q\xe8\xdfzO\x1dJ\x9b\xce\xec\x8f\xd8\xf93=5]\xbe\xb5\xe9\xd6T~l\xc4\xc6\xf4W\x1dyOe\xa8\xf3B\x90\x14R\xd9\x0c\xb9+n\x04E\xd6\xab\x82r\xa1\x05\n_\x98p\xe8\xde\x0c\x93\x02\x01\x9e \x1b\xcd\x8e\xee#U\xeb\x91\xf5\x8e\x1c\x8b\x80,\xbbg\x19\x9e\xcc<\x99\x02S\xa2\x8d\xaas\x1e	1x\"(\x98\x89\x1ak\xdcI\x9a\xeb\xb6\xc3<\x92\x13\x85\xce$\x9b_\xe4$48\xea\x1a\xc3\x94\x15\xd5\xfe\x86\xea\xba\xa9\xf8\xaaZ3\x01O\xca\x14\xa8\xaf\xe16\xac\x9a\xc5\xe1\xd1\x8ce\xcc7\xca]\x93e\xe8\xdcSS\\CzB\xa8T\x8e\xd0\x9a\xa2\x08~\x86\x1a\x0b\xea/ac_Ta\xf4\x8d\xaa|vR\xfc\x0ea \x8b\x14\xcb\x9e\x11+.\x93\xb1\xf9-\xb1\xf5\xfeB\xac@G\x0e\x9e(\x9a\xb0Pr\xc9n\xc2s\xd6\x1d\x1d\xc3/A4\xa5\\\x91\x10\xc6\x06\x15\xea\x94\x16}\x17l\x98	u:\x8e\xfa\xa1\x0c\x07\xc6W\xe3\x0c\xd9\xee>\xe73\x04^\x98\x8b\xa2\xc6\xf9{\xe5\xb3p\xc3$;\xe5\\K\xb5v\x10h(\x99g\x99\xc5vH\xec\x01\xdb\x98\x95\x0cVd\xac\x0f\xad\xd4w\xda.0!\x9f\x8b\x96\xe3\x9bd8\x8b\xf9\x1f\xe8\x83\xc4\x1f\xd2t\x03\xeb4\x15\xe1\xbf\x0d\xc5\x03\xf1\x1b5pR/\x08GD\x0b\x91gM\x8d\xca\xc9\xbd\xb2\x17\x14Ymj\xcc\xfc\xe2,g\xd3>\xc6\xafo\x8bA7|\xd6k\xed\xf8\x13k|\xaa\xd1\x9dD&0\xf2Uq\x166\x80\x8e\xa3\xdaG2\xa0\xc8\xfa\xa6\x8e\xf92\x12\xb6)\xa4\x18\xe1\xcc\x86=G<\xe0P\xd5\xb2)\x07A\x91ua\xebV?+\x13\x7f47\x94\x1b\x0b\x91\x07\x8c\x03\">Q\xfb@\xded\xdc\xf0\x11\xc9Hs,\x0dgx\x10\xc4\xac?5\xe5\xd1*S\x181_:\x8a\xaf\xaf\xa3\x0b<U\xd2\x9br\x1c&\x8d\xed\xbc\xdc\xf5X\xbfe\xca\xc20\xca\xbf\x9b\xdd\x9464\xd6P\xf8/B\\\x14\xbc\xa9\x82\xf5\x82\x8bY\xc1\x11\x84\xa3d<\xbc\xab)\xaf\xd1q\x01\x85A\xddg\xfaj\x91\xc7\xf1\x12\xd3\xc9\xef\xe9\xef\xff\x02\x00\x00\xff\xffPK\x07\x08\x7f\xc22u\xca\x04\x00\x00C\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x0d\xac\xd7X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/server.jsonUT\x05\x00\x01k\x94xf\xbc:]o#7\x92\xcf\x9e_Q\xd0a\xcfw\x80\";\x9b}X\xccC\x00\x7f\x8c'\xde\xb5=\x8e\xe5\xc9`\x11d\x01\xaa\xbb\xa4\xe6\x9aM\xf6\x90l\xcb\xca\"\xff\xfdPUd7[\xf2$\xc1\xed\xde=\xa9YEV\xf1\xa3\xbeK\xff|s4[;\xdf\x86\xd9[\xf8\xe7\x9b\xa3\xa3\xd9\xd5\xd9\xf7\xb3\xb7\xf0\xe3\x9b\xa3\xa3\xa3\xd9\xb5%\x94\x8a\xdaY\xe8\x94E\x03\x11_\xe2l\xce\xc8w6z\x8d\x01\xd6\xceCl\x10V\xcaZ\xf4\xc7\x01\xae<~\xee\xd1F\xb3\x83\xb3\xf0\x845|\xdfc \x1a\x01\x8c\x0e\x11\x94\xadA\x17\x94[W+3{st\xf4\x13\x11\x9e\xdd-\xaf>\x0d;\xb8s\x11\x96j\x8dp\xe5<|r\xfe)1?w\xca\xd7\xa0\x8cq\xdb\x00\xad\x8a\xe8\xb52s\x88\x8d\x8a\xa0<\x82u\x11\x02\xad\x8b\x0eV\x08\xcf\x1a\xb7H\\A\xc1\xd6\xf9'@\xfb\xac\xbd\xb3-\xda8rVf\xabv\xe1\xc6UO\x03\xff3\x06\x01\xc1\x88\xd4\xb9\x8b\xd1\xb5i\x0f\x0c\x0c\x95w\xc6h\xbb!t\xa76\x08+\x9e\x03\xf8\x8c\x16\xb6\x0dZ\x88j\x05:@\xa3\xeb\x1am\xc1\xcd:\xbbku\xc0\x91\xd9\x00\x11\x06\x97:tF\xed\xe8\x98\xd0\xb9\x10\xd1\x07P\x01d\xa1\xebCA\xab\xaf\xb5\xfb\xc1\x99\xbe-\xa8\x11\x0c\x9e\x05(\x04e\x06\xb85\xf0\x02\xba\x8f\xb6\x0f\xba\xe2'y\xd65: \x86\xe8\xc3\xa2\xa4\x1d\xddF\xaf\x8b]j\xba\xf0\x1a\xde__\xc1c\xd3\xb7+\xab\xb4	\x89E\xc222\x8e\xc8\x81\xdajs\xdb\xc7q\x934\x80sU=m\xbc\xebm\x0d?\xd0&\x12)\xc6\x89d\x0dx\xdedIM\x16dr_\xa0tV\xb7\xda~\x15:\xac\xf4Zc\x0d-\xb6\x18\xa0\xd3\x1d\xd6\x10\xa2Wz\xd3D\xd06:\xd8\xb9\xfe\xd8#\xb4\xce\xea\xe8|\xc1\x88\xe4\xed\xddK\xa7\xfdnd\xc62\x88\x0c\x84\xa8\x87[\xbe\xeb\xdb\x15z\xba\xe5\x9adg\xabc\xe3\xfa\x08\x16\xb7\xfc\x8a\x01V\xb8v\x1eA\x01S%\xd9\xa8\xd1`\xc4z\x8f\xdf\x9d*\x9eS\xb8Y5\xb2Q\xf2\x94tCD\x9b\x97,\xe0BY\xa8\x9c\x8dJ[\xe8;\x92\xca\xafO\xc1\xb8-\xfaJ\x05\x84\x1b\x15\xb5\x05\x83\x91\xa5\xc9y\xb0\xbc\xdb\xf2\xc1\x99\xd0\xa3\x8ef\x9fyd\x98pg\xfc\x01\xfb9\x84\xbejHH\x8fI\x10z\xf8O\xb8Uv\xe3\x8e\x17\xf01\xed\xe5\x14\xaaFyU1\x7f\xe3\xecf\xc2\xb9.\xaf\xb7\xdeef\xf8\x12\x81\x90\x99!\xdd\xe3\xb8\xacR]\xac\x1a5\xac\xbcH\xe3\xf4\xf8\xe1	\xfa@\xdc\xa2\x83\xca\xb5\x1d]5(H\xab\xd8~U\xe8\xf9\xc2\xa2\nOd\xa5\x9e\x10<n4\xc9\x06\x9b(\xd2\x8f\xd8xT5T\x1e\x19v\xc0\xfeQm\xc2\xfe\x16 \x120\x1dBmx\x0b\xc1\xf5\xbeBa\x9bf\x05gz6\x90\x0b\xf8`\x91\x16\x91Pxek\xd7\x9a\x1dT\x8d\x0bhy\x05\xaa\xaa\x81\x0dZ\xf4\xac\x82\x89\xc0\x02\x1e\xf0s\xaf=\xd6rFy}\x15\xc1\xa0\n\x11\xbe\x81Z\xaf\xd7\xe8\xd1F\xdePq\xe3\xf4\x16\xcb\xca\xf9\xf1\xa9/\xf2\xeb@\xe8T\x0b\x81\x91Y\xb7\xa3f\xe0\x16Yc\xdc\x9al\xb7^\xef\xc8\xfc\xa9\xf1]\xc5\xce\xd2\x1b-\xe0lM\x00|\xa9\x10k\xb6\x92\x0d\x82\xd1\xad\x8e\xfcE\xef\x02[m\x0cX\x94\xcd\x07g\x9e\x8b\xd7)\xb7\xda\x87\xe8\xda\x8b\xe5r\xd8j\xda\xd6\xc7\x80\xfe\xab\x1a\xd7\xdab\x0d\x17\xcb%\xf8\xde \xc9\x96\xaa\xb1\x06g!\xba.\x0bN@\x83\x15\xdd\\l\xb0\xc5W\x88?\xba\xcd\xa6\x10\xfc\x0b\x86\x13\xd5\xfc\x8a\x8c\xa7\x8d\x13\xc9jD\x0f\xa4j\\\xab\xde\xc4r\xa3\x97\x02JL\x13!\xfa\xa6\x13\xd3>a\xb5\x83\xb4n.N\xc3:\x8b$\x04\x01#!i\xef\x95\xd1\x13w\x95\x16\xdc(\xbb9\xe0d\x94\xdd\xf4j\x93\x99\xdd\xa4!tJ\x1c\xd9\x1e\xd3\x92h\xa03yZ;\x12\x0d\xfd2\xc1\x84^1k\xe1\xfc\x06tK\xc4\x03*_5\x05-\x1d\xd4\xca\xe0\x83[\xb98\xaa\xc6\xbd'\xd7\x18\xa1\xf2jk\xd0g\xf5\xc8`r7\xe2_\xb6\xb8\n:\xe20q4.\xc2\x08\xd0n4\xdd\x92\xad\xf1\x85\xf1k\xefZPU\x85!\x88\xac\xe9\x90\xcc\xe2\xc1\xa6Hh\xd8\xaa\x8d\x1b\xbb\x14\x0c]\xfdW\x8a<F\xb2\xcf\x83\xc6O6J~xG\xcc\\O\xbb\xee\x94\x8f\xc2\x9f\x9e\xea\x98\xd7\x1f\x0fhF\x08\x1d\xbb\x19\xcde\xe1\x17kg\xc7\x0b\xbf\xd2V\x87\x06\xee\xc9\xca	\xd3\x0b\xe3H\xe2:\xb4{\xb6\x8f5q\xa5\x8c\x19\x16\xff\xc7\x9fi\x08\xca\x86\xedx\xbb7\x14x\x91\xdf\x17\xe8\x10\xb4\xa5\xc9\x8d\n\x0d\xd9\xc6V\xd9/\xb8\x90\xd3a)\xd9\xc2?\x9eN\xedxtQ\x99\xe2\x92\xb1U\xda\xbc\xf3~\xd8\xd4;\x02\x00z\xef\x86-%M\xe2\xa9t+\x82|\x85\xc8\xad\xd2fJ(G\xa0\xb4B\x08\xbc\xb2\xec^\x85B\xe8T\x08[\xe7\xeb\xc3\x95\xd0%\xd4k$\x9c\x8f{G\x08\xe8\x9f\xd1CG\x98CZ\x05\xf6\x15r\xcb~\xf5*\xb5/\x13\n\xfd\xaav\xad\xd2\xb6\xa4\xf6\xd2 \xbd\xcdH*\x03\x84L\xc6\xff\x8ab\xe2K\xa7l}V\x08\xcd;\x86\xc0\x991pMK\xf2#%8\xc9\x08\x93\n\x0b\xf8\x84\xabv\x0e\xf7\x97W,	\xb7\xf7\xdf\xf0\xef\xce\xf5\x1e\xdcV\xc4\x93Bp{\x1cA\xad\xd7lm\x17p\x97\x82\x9fD\x85cte\x82\x03\xd9\nNn\xff\xa5\x9b\xdc\xfbKy\xd9<\x00\xd7I>\x11\x1d\xac\xb5)\xec\xf8\x1a\xb1\xa6pQ\xc4d\xd0\xa7\x04\x95\xcb-\xdc\x06\xac'\x18\xa2W\xa7\xa0\x9b\xa2\x81\x06\xd9wHfS01\x13WO\x1a\xeew \xd0y6%LD\xa2v\xa8\xca)\xe4\x918\x0e,\x08:_aM\xe1\xff\xb8e\x06\xc1\xd9\x10\xeb\xcf'6\x8a\x1d'\x05\x83a\x0e\xd1\xeb\xaer5\x8aj\xf2A^c\xb2Q\xc6\xa0\xdf\xdd\xbaz44\xef\x05\x06\x0c\x14\x0e\x1f\xac\xd9Ah\\\x0eV\x93! \x0d\x95\xb7+(:W\xfa\xca\xf72\x9c\x17\x83/\xc8_J\x85\xf2\xc2\xefx\xf8\x16N\x07c\x87\xca\xa7|i\xff\x14\x8d\xae\xf1\\[\x8bu\xb9\x1c\xe1R\"h\xb6\x99\xf9\xb2\x18\x91\x93\xa7 \xc9a\xa3\x9e\x11V\x88\xf60\xe6&\xd2\x93\xe4\x93\xd7\x13\x84n\xf3D\x19sR\x12\x16\x0bN\x01\xd5\x06k\xf2JC\xceI\xc6\x95\xb3\xcc\xc1\x1f\xf0bh1*^5\xe5\xf9\x80U\xef\x83~F3\x06\xbf\x03L\xb4\xa6\xd1\x14<\xbdz\xac\x94\xf4z\xec\x8c\x96\x10J\x95W\x07\xbe\xa0>a\xcb\xa9[\x98\x1e6\xee\xa7sC\n\n?.\x1b\xb7\xfd	V}\x8c\xce\x92vtFU)\x038L\xf3\x1a\xe7\xf5\xcf$9\x86\x1eD\x17\x11\xcaw\x03\x062JX}\xf3\xe7\xaaQ\x14\xf8\x84\xa8\xccF\xab\x91\x98\x1e\xdf\xfa\xc6mtv\xc4\xc5\x04\x92\xb2\xef\x1c\x99\xd2<\x91\xad\x180\x0c\xd8\x88\x85\xd1\x83\xe7C\x89pv\x1e\x9f5nYe\x1a\xa61\xa5\xfb\xe0\\\xfc\xf0\x8c\xde\xebBq\x84\xbcw.\x82\xcb8!~\xbd&[\x08[r\xe0\xd1AS\x18=	O `\xa7(|\x07\xe3*I/8\xe1Ppqy7\x07\xb4d1Hh\xd6\xbd1\xc2B\xd5\xb5\xc7\x10\xa0A\x8f\x0b\xb8A\x92al\xbb\xb8#\x0e\x14\x8a\xd2\xf4\x14\xca\xe5\xc9\x0bx\xf7\xa2(\xd9y\x0b\xc7M\x8c]x{r\x92Lx\x8b\x9b\xbeR\xe4\x1f\x8e\xf7\xce:M\x05\xe4\x90\xbf'\x0d\xe8\xe4%AY9\xeb\xbf3\xee\xd7\xed\xc4\x1d\\\xb7\x85;\x90\xc1\xe0\x0e\xf8\x82\xa7\x0eA[\xa3-^\xe98uu\x854$\x17\x97\xdeH\xdb \x8a@\xb12gL\xe2\xd0l\x0d\x1e\x83\xfe\x199\xa6\xf3u\xaa\xf8\x04\x8c,\xc2#\xbf\xcf\xf5\xe8\xe4\xaf\xbf\xbf<O\\\x08\xfe+\x1e\xf9\x1f\x8a\x0b\x0e\xa3:\xfe%\x03\xe6\xe5p\x08(\xaf/\xc3\x02\xf2\x1c\xa8\x94\x05Gv[l\x9a\x18\x86\xe2\nM\x99\"\xdcLS\x83\x8bF\xd9\x0d\x05\xd1\x11\xfd\x9a4zH\x1d\xc6\xe5\xda>\x85\xe2\xfe\"z\xab\x0c\x088\xc9D]\xcf\xc1c\xeb\x9e\x11(\x88\xa9u\x04\xcc\x13\xbf\xfd\xf6\xdb\x93?\x91r\x9f|\x15w\x1d\xa5\xd6\x9c\x8cV\xa5/iU\xa8\xdc\xf8H\xb72\x14\xe2)R\x94)\xd9A\xef/ea\x1d\xd6\x0f\xf2A\x97\x9d\x94D\x05~\xd6\xc4\xa9X\x1e\xfbB\xe8oy\xc8n\x8f\xb2\xadD\x87\xcb\x95\xa3\xe8\xd2\xc7\x90\x9e\x88\x83\xe42d\xb1\x92\xcc\xc9Z\xfb\x10\xe1Y\x07=\xe1\xf7\xf2\x1d+\xce\x9e\x9e5\xa2M\xac$\x89\xed\xadz\xd1m\xdff\x94[C\xdf\xa5\xb4v\xdf\x17\xb7\xeae\xa9\x7f>\xd0]\x12\xd8\xd7(2\xe2\x90\x1e\xd9\xf5\xdb\xf3	\xd5O\xba\x8e\xcd\x1e\xd9-\xc1^\xa5+\x98_\xdd(n\xfa\xc7\x1f\xc6\xeb\x96\xa1P\xb9'\xab,e\x0fq\xaf\xb9fWI\xe1\x8f\xf7G\xf6\x96c+\xaeT\xee\x13^6\xce\xc7\xaa\x8f\xff\x17\x0c\\\x8d^M\xb4\xf4v\x04\xcd\xa7\x80\xa9\xa6\x8e\xf3XWK5\x9dSx9\x96wmM\x02N6\xa5'\x0f\x92\xe1R\x02\xd0\x1eZ\x8b\xad\xb3\xbab\xba\xe3\xdelY*\xbc\xdb+\x12RL\x10\xa3\xaa\xd8!\x0de\xc8b1n\x87\xfch\xa0A\xd3\xa6I\xd3t\x01e\xa1\xe5\xe4\"Y\xfd@ij\xe6S\xacrQW\xc5.e(K\xf8\xd9\xca\xae\x802\xd0b\x08$mI\xe1)\xc8\x8aCL\x9e\x0b\x81\x13CE\x1c\xd6Z\x9c\xea\xc0\xe7\x12\xc3\x13\xad\xb8+\x90\xf9\xb9\xdec\x84:\xe1\xcb\xc5A\x8a0\x9f{G1%\xbd&\x84\x9d\xad\xb6*V\x0d\xe8\x00j\xe5\xfa\xc8\xf6?*?9\xe2\x96D\xac\x8cw\xee\xdc\x16\x12\x0c\xce%\x91H\x86\xb7\xf7^\xba$]B\x07g7d6\xfc\x89:\xa9\xb5\x13C\x8aR}t\xb1\xa1|0zT\xed\xa4{r\x90\xa7\x14R\xe1L}\xf0\xb0\x1fL\xfd\xe5\x87\xcd\xb1\xcc\xc5+%\xcd\x1c\x03A\xaem>\x8e\xf9\xce\xad\xea::\x81[\x0fa\xb1\xe3R\xe3\x9e\xed\xcd\x85N\xae\xca\xd1x(\xc9\xef\x97i\xdc\xd6b\xa1h\x1fd8\x1f\x07S\x05\x93\x02\xb5,\x92\x18\x9f\xa3'\xa9\x05\xb1\x02\x18\x93\xcb9\xceF\xef\x0cie\x1f\xe2a\xb5\xd4Y,\xf6\xd1\xed\xdf\xdf\xfd\x97\xee\x8e\x84\xfd\"\x95\x8a\xa6q\x14\xa9\xc6PE\xfa]\xf1\xd4P*R\x83\x1e\xfd;C*\xa2w\xcd\x81\x91\x04?\xa3yg \xeb2\xdch\xfbt\x10:\xa7	\xe4\xfa\xb1\x96\xd0\xa8\xb7u\xf2\x88<$\x14=oet\xf5\xb4\x80O\xa4G\xa9\xe6V\xcf\xc1\xaag\xbdQ\x11E@\x9a)%mCDUJA\xe7{\x8b{e\xba{\x82\xe5\xfa\xd9<\xa98\xdb\xd3,zC\x9eG\x19Y\xa3j\xae\xd4\x8d\xed\x17J\xcf\xee\xb87\xb3\xc7\xe8\x91+\xfc\xfb\x9cb\x82NX%\xe0\x17x	\x1fm\x0f\xd9\xec\xfa\x81\xfa\xd2\xf4\x11\xd0\xd2\xc5d\x93p\xe9\xb8j\x12\x9e\xc6\x15~\xf5\x88/\xa3\xa5}\xc0\xfa\xe4\xdc\xf4\x08\x8fc#6\xa73\x9e,\xa4\xadaE\xf8\x88/\x11\xf4\x1a\xc4Pp9U\xc7\x06\x8e\xff\xee\x8f\xc9\xae\x1c\xff}U\xc4\xfet\x16\xca\xfb\x0b6\xaa\xe6\x88rd\xc1e\x87\xae\x94\xe4r\xfdF\x93\x9f*\xd6'\xc0\xbe\x92x4*\xeag|\xd4\x85\xbfzH@ h\x88\xaa\xed\xf2m\x0f\x18f\x1c\x074%7\x8b\xb7p\xfc54\xae\xf7\xa06nr\x9a\x0eUye<<\xdcIgv\x0f\x938\xec\xc7\x07\x82\xfdD\xa6@\x10\xc9\xb8QP\xcb\xa8\x9c\xfd&\xd9\xf5\xac\xae\x9c/\xbc\xea\x8e(w\xfb\xf8p3\xee\x84r9\x02\xcc'\xc3\xbc\x98#\xa5\xd4\xc5\x1b\xfa9$\xac\xc1\xb5\xd3J\nt\xdeQ\xbc\"=\xad\xad\xf3O\x85\xcep\xf7c\xe4\xc9#a(FR\xba#\x87\x95.\x82K/\x1e\xb6\xda\xd6n;\x92\x0ceh\xbd\x1cs\x87K\xc7b\xbf\xea\xdbN\x9c\x90\xb4\xc8\xd2\xf5\x14nZ,o\xaaI\x0d\x02\xb4(9\x98\xf1\x1d\x96XQ \xcd\xb0y\xe2i\xa2\\\x85\xa0r\xc9\x8b\xe5}\x08\x89R_\x8c(\xc3\x03V\xaem\xd1\xd6b\x0bW8\x1a\xf8?\xfdZ\x0b2\xa8\xbeB\xab\\q\xdc\xbe\xc2;\x95[\xc9\x19\xbf\xa8\\\xfb\x85\\.` \x8b\xb9\xd7->K\xee*aS\xdf8Q%\xc1\xa7w\xe0\x86qo\xa36b\xcf\x93\x8fK\xf5R\xe9\x8c\xe8J\x19\xb3\x03\xe3\xb8\xee\xe4\xfa\"\xfc\x08\x9d\xd3\xa6t\x9b\x12\xae/3\xb8\xb42i\xeeA|\x1e\xa2Z\xaf\xa7\xdd\xdf%\x81\xe0\xb1\xe8\xfef\x93\xc3U_^!\xcd\xe1,KR\xb4BU\x97\xf13\x99\xa4\x89\x95\xe1\xb6\xee+V\x862\xf8\x94@\x14\x1b\x93\xe6\xd9\xb0\xb6h\xa5-\xb9\xa9\xc7\xad\xbf\xbd\xb6\x9e\x08\xa4\xbc\xc4\xadz\x19\xe3vJW\x94\xc9\x02\xfb\xaf\xf4\xf0\x13\x89\xd7\x9a\xf8\x13\xeez\x8cHo\xb5\xfd\xff\xe0\xfe\x1b\x1d|	\xb9k\x0c\x95\xd7\x1d\x1b\xd9X\xf6\xf4\xf7\n\x95\x91s\xf0$I\xaf\xcbW\x96e\xc9\xd6\x07\xf9\x12\x89x\xa5uE\x12~\xfe~\xa0\x95Z\xad\xe3_8\xa6\xf4R\xabU\xfeb3\xce\xd9\xa3\xf6\xbb\xab\x00\xaf\x91\xe0\xf0>\x05\x01\x1f,[\xfc\x81\xd4'\x0e\xfd\xb3\xdb\xa7 \x9d\xd1\xc2\xe0l\xa2\x99\xaa\xae\x0bK\xc8D\xb1>\\:\xb2\xc5U;-^~\xc2\xd5\xedo\xd4.y\xcaA\xe9rp\x1c\x01^-\x80\xa6x\xa3\x0c\xb1\xb6\x8d\x8ag\xb6t\xc7\x9f\x06\x88\xb0\xa4\x19\x8a\xc6\x8b\x8d\x02\xfe\x80PyD\x1b\x1a\x17\x0f\xac\xdfN\xd9\x1aGM\xfb\x9b\x0c\xe7\xc5\xe0\x0bf\x93\xdc\xd8\xa49\xf1\xc9\xf9'rHy\x1f\xdf\xe9\x1a\xc3\xd0@\x92D\x99N\x13\xc4V\xbe\xfa\xa2\x89\xe6^W\xff_\xa3\xfc\xe6\xe8\x97\xf9\x9b\xa3Y*:\xe6\xff\xcdYi\xe0\xca\xafXR\xbe$\x82\xad\xb5$\x8b\x02\x90\x9d\xa5\xb2J\xc6\xc98\x91\x0e\xceG\xdav6\xe4\xb3s\xf6\xb0\xf9If7*H\xc5\xbf\xb0\x1a\xb3\x9c{\x14 	Z\xd8\x85\x08\xe0\x8a,\xab\x8c\xdf\xf0\x15\xcd\xa2\x1az\x00\xb3\xf7\xec?\xa5?6[\xc6]2\x15Y\xcb\xe5\xbd\x04\x99\xaa,a \x83mg(\xae\xcf\xd7\xb1R\xf6\xbeT\xc5\xbf\xb9\xbe\xe8\xbdp\xa6ZK\xbd\xf6\xe4\x0f\xe1\x04V;\xf8\xc3\xd8\x95^;c\xdc\x96r\x1c\x8f*8\xfb6\xcb\x0f\xb9\x9b\x95\xb2\x92\xe7\xb0\xddD\x92\xfd?\xf0?\x994},\xca'\xea\xf5\xf4_\x8d\xe5\x1f\x1a\xe5 \xc9\xc1\x12.;h\x0e\xa1\xd9Z\xb6\xca\xaa\x0d\xb6\xb9\x0c8Su\xcd\x13\xeb:\x8d;\xb1\x10\xb3\xb3.\xdb\x82\x99\nAo,{L\xc6\xf0P\\\xa4LX)\x16\x89se\x87\xb1E\xbf\xec\xb0\ni\x17\xd8\xc5\x90\xfa\xee\x7f<\x85\xbf\xdc\xbf{?\x87\xfb\xbb\xf7s\xfeO\x9d\xf3\xf0\xe9\xdd\xf9-\xfbH\xf1\x0c\xd0\xa6\xda[\xad[d-\x0fd\xc3\xbf9=}\xf9\xfa\xf4t>\xa0\xd9\xab\xe6\xba\xdf\xd7\xa7\xa7\xf0\xd7s>\xab\xe5\xbf$\xd9z\x91\xf6\xc3':O\xc7\x19\xffZ5\xf9W\xd5\xac\xe2\xaaqQ\\\xc8u\xe4IiaV\x19\xba\x8f\x8a\xf0\xf93\xc1Qy^\xc5\x1f\x02sv\xad7\xbd\x97|\x8f\x91\x19\x92\x9c\xd1t\xdaRz\xe7\x93yE?}\xc6QfA\x8c\x87%\xa5ZE>\xd8%\xfd\n\x84}\xe8\xb0\xa4L+\xcb	\xd9\xc9\xe4	l8\xca	\xa98\x96\xf1].\x8d\xcd\xea\xde\xe7\xe2\xd4\xec2\x7f3F\x84\x99E\xe0]\xfadxnP\x13\"\xb7\xb1\x13\xa6\xaf\x9e>\x88\x94]}\xbc\xf8+|\xb8\xba\x12\xc4\xc6\xb8\x952\x04\x7f/_\x0c\xe5&\xda\xec\xfa2\x8f\xd0F\x1d\xf9\xad\xaf\xf3\xb7`\x8c\xc1\x8d,\xbf\x96\xcfI-|FQ\xd9E!\x15\x94\xe6\x0f\xff(\xaaJ\x19!\x88\xb6\x9b\x03\xd1V\x07\x82\xfc[r\xfc\xcd\xffB\x8e\x8d\xdb8.\x03\xcfn\xe4\xab\x80\xca?\x1f\x08A\xf13\xd7\x86j|\xd6U\xbe\xf4\xbd:\xe2\xac,\x1d\xca\x8c\xd1\xf8\xcf>tcEq\xe6\xb6\xf6\xce\x0d\x05\x0b\xb6z5\x87\xdbnk\xb94P\xd4-\xb8\x0cC\xb3\x86\xd2\xe9\xac\xeb\xfdf\x90\x9e{\x1a\xb0\xf0\x9c\x14\x12&n\xf3\xd19\x13u\xc7\x8f\xaf\x8dt\x0c%\xbeX\xed \xf4\xab\x7f`\x15\xe7\xe9/\x93>\x993\xabZ\x04\xb4\x95\n\xf2Wh\x12\xa5`Th0, ?\xce\x03nz\xa3<\x19W/\x99JXd\xc6Q\xea\x97|\xb2%\xc6To\x0c\x03\xfaF\x1e<\xa3\xd3\xfbs\xc8P\\]h\x14%\x97o!\x7f\x15\xd0s\x8e\x92gK\x1e\xc0J\xe7%\xc9\x1f2\x8e\xa2\xd6\xf1\xb0i\x82\x04\x9b\x83b.\xcb\xe4&M\x91Kal\xfa\x14\xf8\xceV\xc9\x88X\xac\xa4B\x17U\xec\xc3\x88\xbe\xc8.\xe2\xa3\xd5\x9f{\x84\xeb\xfb\xe3\x00\xd7\x8f\x8f\xf0_\xaa\xe2\xc0\xf9D\xfe\x00\xf5\xdf\xb2$J\xc1f6\x94hfQ\xc2+N\xf3\x12d\xd7	\x84~\x19\xd2\xdb\xe4\x19>\xf2\xc7\x9b\xa3_\xde\xfc\xf2\xe6\x7f\x02\x00\x00\xff\xffPK\x07\x08\xa1d\xa7\"\x8b\x10\x00\x00\x9e/\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cV\xddn\xdb\xb8\x12\xbe\xb6\x9fb  h\x0b\x18\xe9}\xef\xecXi|\x8ec\x1b\xb6\xdb\xa08=\x17\xb48\x96\xd8P\x1c-IE5\x8a\xbe\xc8\xbe\xc6>B_l\xc1?I\x0eP\xec^i\xe6\x9b\x8f\xe4p8\x1f\xa9\x1f\xd3Iv&]3\x9b}\x80\x1f\xd3\xc9$;1\xa5\x90g\x1f [\xcc7\x9b|	\x8b/\xf0\xe6\xc6\xbc\x81\xfb\xed\x1en\x8c\xff|\xcdn\xcc\xd7,\x9b9>G\x896\x0cX\xe6\xeb\xfc8\x8c\x08qQ\xb3\x12\x97\x03i\xf58\xff\x98\xc3\xef\xa9\x87\x86\x84D=&\x1fv\xdb\xd5:\xdf\xbf\xa6+\xecvd\xacY\xa9c\xa5\x91\xf9\x017\x1c\x14v\xd08\x1c\x84\x02\xeb#\xb7a\x80G\xe7\x8a\xaf\xdc:f[\x0b\x1bs\xba\xe1a\xc4[\xf3\x0e\x98\xe2p\xc3\xc1\xe7\xe2|\x8a\xb4a\x86\xdf\x0c\xbc&\xb6\xbaD\xee\xd2s\xb4\xdd\xf6p\x84\xdd\xa7\xfd\xc7W\xe5\x1c\xd7\xd1T\x8cS\xb7\x10\xa9\xfa\x87\x87\xf9r\xfb\x04\x8b\xd5\xbf9\x84\xb0\xcd5\x15\xcfG*K\x19f8>\xec\xf3\xf9\xd2\xd1\xaf\xca\xd6\xaa\xe1\x88?m\xae\x0f90^\x04v\xc8\x0f\xac\xc6\xd5.\xa5\x7f\x80\xed=\x1c\x1fr8\xcc\x1fsX\xed\xe0)\xdf\xe7\xf0y\x95?\x8dFO'?g\xb1\xa1\x8c\xeb'\xef5\xb2\xd5L\x9a\xd4_\x9c]\xb2\x0f\xf0\xbf\xe9$\xda\xb3d\x99l:\x99\xfc\xdf'PQ\xab{\x92wf\xbd9\xa2\xd5B\xb5\x16{btg#gL&e\xab\x81\xeb\xbd\xd9`\x8f\x98\x06\x0bR\xbc\xa7Fw6rF\xe4\x0b\xb2!U\xef\xccz3\xd0bQ|\x9f\xa4\"0^\x0b\xe5J;\xf7\x86\x9f\x89\x95\xe4\x10\xf7	\xbe\xcf\xc1\x7f\xa2Oa\x88\xfa\xf5\x97\x12ud\x15\xa4\xacf\x85\x0d=\xed\xe2w\x11\x81\x08\x8dT\xba\xb8\x0c\x87\x1a$	LJ8]`\xb5\x0b<\xfc\xde\xc4u\xe7u#E\xdcN\x84\x875r\xef_\xadP	\xee\x8e\"{p\xdf g\x9fn\xda\xde7\xa6\x84%\xed\x87?\xa2\xe01\xffo\xad\xb1\x1b\xea\xfcN+\xd2\x0cja\xd2\xde$\x15\xcf\xa1O\xa3\x15\x0f\x92\xa3f\xfdT\xc9\x0bA\x1a\xb4y\xa5G\xea\x14\x86\x01\x0bb\x9a\xc3\xd6\xf9Qx\x88s)\xc3\\\xc6j\xa6\xc1\x12'\x93T\x19r\x8b\xa1\x08\x86+\xca\xab4\x9aA\x85^{\x07+\x8a\xe7\x8b\x17\xa0\xf7\xc1\x04 \x8ao\xd8To\xf7\xa2\x1b\x9f\x8f\xb3\xfac\xb9P\xeb\xb0\xb7\xc7\xf6]\x12\x99\xb9\xa8\"6^\xc6\x85)H),\xfa\xfdFW\xa82\xa6|Q\xc5\x95\x93\x88\x1c\xa37\xf5\x0d\x9dYQc\xea\xd2\x82IT|\xd4\xdf\xb9J\xf2\xba\xc7S\xb4\x1e\xfb\x96\x9f\x9ft\x8f%]\xff\xa7U\xbd%\x13/v\xf8$;`\x13\xadma\xa3\xb5\xa1\x97h-E1\xe8\xacC|\xee\xf3XR\x1d9\xeb~\xfe!\x8fG\x81\xfd\x9a\xc9\xfa\xdcc\x07v\x1a\xcb\xb2\x15\xfd\xc3G\xd6R\xed[\xff\xc4\xbe%y1U\xa0o\x8e;o\xa5\x16(\x98e\x92\xca\x10\x08f\xc0\xa5pw\xf0\xdd0\xcc\x01`	\xe2L\xb3\x91\x1a]'\x8e\xa4x\xf2~ \x90\xf2bZ\xd5\x0di\x0b\xa6-\n4\xe6\xdcJy\x0b\xc7\n\xa1a%B'\xa4\x04E\x1dh\x94\xd4?rg!\xf1H\xb4f\xba\xf4S8\x1f,\x11H\x8fD\x8e\x12\xa6\x8a\xaf\x04\xeaZ(\x96\xf4X\x12\xb9\x1e&\x15\xf6\xe6:\x14\x1a\xa6\x19\x84\x00\xd3 \x99\xdf\x8b\xa0(l\xe1s\xbc#\xad\xdb\xc6\x8e\x92>3!\x91\xdf\xc2\xbdK\xa0\x88\xe1\xd1\x88\xe5\xef\xf6\xf8\x8f[\x14\xea\x85I\xc1\xefXc\x8b\x8a\xf99\x02\x02E\x84<\xed\x19\xb1\xf1\xa5\x15\xaa\xbc'/\xd7\xff\"6\xc0#\x06\xe7tkH\x16\xde\xe9_\x7fJ+\xea\xa4}\xb7\xf7\xe1\xcf\"\x8a9\xbc\xb4\xe0b\x03\x0b\xf9\x91\x16}\xfb\xec\xb0d\x9c\x80I8\x93Ju\xad\xdd\x95\xf7Y\x18A*\xfd\x15\xf8[\x10^<\x16I\xad\xb1\x8f\xcc\x16\x95\x9f\x86\x19\xd3\x91\xe6\x06\x1c\x0c\xb5\xc7=Ma7\xe4\xb5i\xf1\x85\xe0A\xc8\xb8PCBY\xd7\x81}\x83\xee\x1c\xe2Z\xf0\xaaS\xfd{4\xdc\xe8\xfeO\xea}\xf0\xdf\x1f\x8f\xeb@\xfa\xa3\xa5x\x9b>0\x03\xc6\xe5[\x08\xdb7\x8bFf\xc2\xa3\xb4\x0fVD\xb9\xd0X\xd8\xc5%\xdcd\xfb\xe8\xbf~k\x06\xde\xb0\x9b\xd7\xdcP\xee\xc4?k4U\xa0\x053\xe2\x8d\xbc\x04\xd44-\x1a\xcbz\x9c\xb4\x0d\x01g%\xedj\xb4\xad\x8eY\x97\x1aM\x8f\xb72\xd4b\xef\x0d\x8f\x19d:\x1c\xc7\xa25E\xff\x00\xa0q\x87\x96\x7foD\xfcQ]S)\x14D\x1c0\x06\x02\xb9\xa2nCV\x14\xbe\xdb\xa3\x15\"\xed\xa9\x16>\xc1C\xb0<j\xab\xb6>)&\xa4\x08\x1a<\x8e\xfc\xdb\xdb(\x01K\x8d\x8b\xcd\xb5\x16\xa7\xb8\xddV%Y\xa7\x06\xfbB-T\xec\x05\x81)\x18\xa2\xfe\x8f5\x0d\xe9\\W\x0d\xe5\xff\x14\x80\xab\xb2\xb7\x8d\x93\x9eS\xb1gx\x0f\xdc\xad2\x0e\xef4\xb9R\xfa\xf2\x05\x04y\x9f\xec\xabE\x9e\xc6KL'?\xa7?\xff\x0e\x00\x00\xff\xffPK\x07\x08\x9eLe*\xf6\x04\x00\x00\x86\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/server.jsonUT\x05\x00\x01\xde\xbfsf\xbc:\xcbn$\xb9\x91\xe7\xee\xaf\x08\xd4bV\x80Q#i<>\x18}0\xa0g[\xb6\xa4\x96%\xb5\x1b\x86a\x03Q\xc9\xa8*\xb6\x98d6\xc9T\xa9\xda\x98\x8f\xf1q\x8f\x8b\xb9\xed\xb5\x7fl\x11A2\x93YR{\x06^{OU\x8c #\x98d\xbc\x83\x7f{\xfdj\xb6t\xbe\x0d\xb37\xf0\xb7\xd7\xaf^\xcd\xce\x8f\xfe0{\x03\x7f~\xfd\xea\xd5\xab\xd9\x85e\x14F\xed,th\xc9@\xa4\xa78\x9b\x0b\xf2\xccF\xaf)\xc0\xd2y\x88k\x82\x05ZK~/\xc0\xb9\xa7O=\xd9h\xb6p\x14\x1eH\xc1\x1fz\nL#\x80\xd1!\x02Z\x05\xba\xa2\xdc:\x85f\xf6\xfa\xd5\xab\xbf0\xe1\xd9\xf5\xdd\xf9\x87a\x07\xd7.\xc2\x1d.	\xce\x9d\x87\x0f\xce?d\xe6\xc7\x0e\xbd\x024\xc6m\x02\xb4\x18\xc9k4s\x88k\x8c\x80\x9e\xc0\xba\x08\x81\xd7E\x07\x0b\x82GM\x1bb\xae\x80\xb0q\xfe\x01\xc8>j\xeflK6\x8e\x9c\xd1lp\x1b.]\xf30\xf0\xbf\xd3\xd4v\x9e`a\xdc\xa7\x9e\xd0\x03\x82A\xe8\xd0G\xe2o \xaf\x9d/[JS 4\xde\x19\xa3\xed\xea\xa5\xb9\xa0(\x01W\xda\"h\xdb\x98>8hz\xb4\xca	\x82B\xc4/\xff\x8d\xc0\xbf@\xa1qVi\x85\xd5\x1e\xad\xb3\xdbV\x07\x1a\xb6xd\x9d\xd5\xad\xfe\x8ce#W|\xdc\x1e!:\xe5\x02\x18\x17\xa0s!\x92\x0f\xd0\xb8\xd6\x01\xda/?Z\xdd\xba\x8af\xaf\xb4\xfb\xa33}[Qe\x18<&`\xa2\x9bf\x80[\x82,\xe0\xd3l\xfb\xa0\x1b\xb9\xd0G\xad\xc8AgpK>\xd4\xa4\xa3[\xe9\xe5@\xf6~\xdd\xb7\x0b\x8b\xda\xf0A\xbc\xbd8\x07\xb4\xbaE\xe52\x8b#\x1eA,\x93B\x9e5\xd2[\xac\xae\xfa8\xee\x92\x07p\x8c\xcd\xc3\xca\xbb\xde*\xf8#\xefb8\x86HY0\x07\xbc\xec\xb2\xa6\x96\x16\x14r_\xa1t\xa4Zm\xbf\x0d\x1d5z\xa9IAK-\x05\xe8tG\n\xf8\xa4\xf5j\x1dA\xdb\xe8`\xeb\xfa=O\xd0:\xab\xa3\xf3\x15#\x16\xd7\xb3\xa7N\xfb\xed\xc8LD\x98\x04\x08Q\x0f\xc7|\xdd\xb7\x0b\xf2|\xcc\n\xb7\x016:\xae]\x1f\xc1\xd2F\xee1\xc0\x82\x96\xce\x13 \x08U\xd0|J\x86\"\xa9\x1d~\xd7X\xddg\xe2fqd\x83\xe9.\xf9\x84\x98\xb6,\xd9\x87\x13\xb4\xd08\x1bQ[\xe8;V\x9f\xef\x0e\xc1\xb8\x0d\xf9\x06\x03\xc1%Fm\xc1P\x14yr\x1e\xac\xec6\xec\xef\xf0\xbe\xd7\xd1\xec2\x8f\x02K\xdc\x05\xff\x8c\xfd\x1cB\xdf\xac\x01\x03\xec\xb1$\xf4\xf0\x9fp\x85v\xe5\xf6\xf6\xe1}\xde\xcb!4k\xf4\xd8\x08\x7f\xe3\xecj\xc2Y\xd5\xc7\xab\xb6\x85\x19=E`da\xc8\xe78.k\xb0\x8b\xcd\x1a\x87\x95'y\x9c/?<@\x1f\x98[t\xac@\x1d\x1f5 \xe4Ub\xfe\x1a\xf2r`\x11\xc3\x03\x1b\xb9\x07\x02O+\xcd\xb2!\x16\x8e\x15$\xae=\xa1\x82\xc6\x93\xc0\x9e\xb1\xbf\xc7U\xd8\xdd\x02D\x06\xe6\x8f\xc0\x95l!\xb8\xde7\x94\xd8\xe6Y\xc1\x99^\xec\xeb>\xbc\xb3\xc4\x8bX(<\xdb\x94\xd6l\xa1Y\xbb@VV\x106kX\x91%\x8f\x91T!\xb0\x0f\xb7\xf4\xa9\xd7\x9eT\xfa\xc6t\xfb\x18\xc1\x10\x86\x08\xdf\x83\xd2\xcb%y\xb2Q6T\x9d8\xdf\xc5]\xe3\xfcx\xd5'\xe5v t\xd8B\x10dQ\xee\xa8\x05\xb8!\xd1\x18\xb7d\xd3\xaf\x97\xdbd+\x87{Mf\x9a\xefh\x1f\x8e\x96\x0c\xa0\xa7\x86H\xf14\xbe=\xa3[\x1d\xe5\x1f\xdf\x0bl\xb41`)m>8\xf3X\xddN\xbd\xd5>D\xd7\x9e\xdc\xdd\x0d[\xcd\xdbz\x1f\xc8\x7f\xabh\xa9-)8\xb9\xbb\x03\xdf\x1bb\xd9BE\n\x9c\x85\xe8\xba\"8\x81\x0c5|rqM-\xbd@\xfc\xde\xadV\x95\xe0\x9f\x08\x9c\xa9\x96[\x14<o\x9cI6#z \xa5h\x89\xbd\x89\xf5FO\x13(3\xcd\x84\xf8?\x7f1\xef\x13\x16[\xc8\xeb\xe6\xb0Y\x93\x05\xeb,\xb1\x10\x04\x8a\x8c\xe4\xbd7FO\xbc]^p\x89v\xf5\x8c\x93A\xbb\xeaqU\x98]\xe6!t\xd8<\xbc\xc0\xb4&\x1a\xf8\x9b<\xaf\x1d\x89\x86\xfe.\xc3\x12\xbdj\xd6\xbe\xf3+X|\xf9\x9f\xf0\xa9'\x85l\xefu\xfb\xe5\xef,\xa2\x95\x17Q:\xe0\xc2\xd0\xad[\xb88*\xc9\x8d\xa7G\x96\xc9\xc6\xe3\xc6\xb0\xd7\x99O\xc0\xeczZ\x11\xf3\x0d-\x82\x8e4L\x1c\xcdL \xf4\xcd\x1a\xc8\xae4\x9f\x97U\xf4$\xf8\xa5w-`\xd3P\x08I\xeat\xc8\x06\xf2\xd9\xa6X|\xc4\xbe\x8d\x1b;M\x18\xbe\x84o\x91}G\xb6\xd4\x83\xeeO6\x1a\x00\xed\x96\x99\xb9\x9ew\xcd\xf1B\xe2\xcf\x97\xb6'\xeb\xf7\x06\xb4 \x12\x1d\xbb\x1a\x0dg}V\xceV\xf2\xa7\xc9\xfb\xa4K\x99i\x86\x90\x11 \xe0B\x93\x8f\x95K\x14\xd5\\\xa01\x03\x8d\xff\xf85\x0f\x01m\xd8\x8c\x87|\xc9\x81\x1cG\x02	:\x04\x81y\xf2\x1a\xc3\x9a\x8de\x8b\xf6+>\xe5pX\xca\xc6\xf1\x97\x87S\xc3\x1e]DS\x9d5\xb5\xa8\xcd\x99\xf7\xc3\xa6\xce\x18\x00g\xde\xbbaKY\xb5d*\x1f\x0e	\xf2\x05\"W\xa8\xcd\x94P\x89hyE\"\xf0\xc2\xb2\x1b\x0c\x95\xeca\x08\x1b\xe7\xd5\xf3\x95\xd0e\xd4K$\x9c\x8f;\x9f\x10\xc8?\x92\x87\x8e1\xcfiU\xd8\x17\xc8\xdd\xf5\x8b\x17\xa9}\x9dP\xe8\x17\xca\xb5\xa8mM\xediM|7#\xa9\x02Hd\n\xfegi*=uh\xd5Q%>g\x02\xd1\x9e\xc3Q\x0c`0T\xeb\xe6\xd5\x14\x9a\xcc@\x99\xb0\x0f\x1fh\xd1\xce\xe1\xe6\xf4\x1c\xb6pu\xf3=l!\xf6\xd0y\xd7i\x97$\xd8\xb2\xd1\xb7\x80Kj\"*\x17\xf6\xe1\xba\xa7\xc7\x1c\xf4\x06\x88\xd8.\xf4\x97\xff\xb22)\xedN\xf3\xac\xc9\x96'\xd7\"\xc3!\x98.Cp]\xa3\x9d\xa5\x00\x08l3\xf4c\xa54K\"\xc5Qf\x12\xa6B\xe9<C\xd3\x15T\xde\x06\x96\x13\x0c+\x84\xd2\x81cgv}\xacF\xecrR>U11\x93\x08\x81\xcd\x81\xdfB\x82\xce\x8b\xdd\x11\")\xdc\x87\xa6\x9e\xc2\x8eLN\xa4\"\xe8|C\x8a\xd3\x87q\xcb\x02\x82#I2\\_\x11\x16\x83&\xfe\x96c\xc80\x87\xe8u\xd78EI\x81\xe5C^b\xb2Bc\xc8o\xaf\x9c\x1a\xad\xd2\xdb\x04\x03\x01&\x0e\xef\xac\xd9BX\xbb\x12\xe3fs\xc1z,\xb2PSt\xaev\xb1o\xd3p^\x0d~RJ\xd7Z)\x1a\xbf\xfa,\xa7Y\xee\x0d\x1c\x0e\xf6\xad\xed4\xfa\xbc\x9b\x92\x87\xb9)\x0d:\xd6\xd6\x92\x1a\xe8\xfcV+\x82\xd3\x14\x8b\xc3\x8d\x9c\xc3|D\xb0]\xcc2\xc9Y\xea\x1a\x1f	\x16D\xf6y\xf4\xce\xa4'Y\xb0\xacg\x08\x1f\xf0\x01\x1asP\x13N\x1e\x80C\xb3\x15)\xf6jC\xf2\xcbVY\xd2\xdd\xc1\x9f\xc8bh)\xa2\xac\x9a\xf2\xbc\xa5\xa6\xf7A?\x92\x19\xc3\xe8\x01\x96\xb4m\xad9\x0c{\xf1\xb3r\xf6\xed\xa93:\x05c\x08\xe9\xa0\xd3R_Q\x9f\xb0\x95\x9c0L?vL\x01wd\x1b\xe1\xcfwk\xb7\xf9\x0b,\xfa\x18\x9de\x85\xe9\x0c69\x97\x18\x16\x8d\x0c\x9c\xd7\x9fY\x98\x0c_\x88\xaeb\x9d<\x86qFfu\xedBD\xb3\xd2\"=\xdf\xff\xbaYc\x15\xad\xeb\xf1\xba/\xddJ\x17_^M`q\xfd\xadc3<1\x81\x81\x13\x01\x11G6m\x80\xe2(\xd0\xb3'\xf6\x18\xbf\xfcX(\x95\xec\xbd\xb7\x08\x9d\xa7G\x1dz4\xfa36\xfa\xcb\x8f6\x97\x0fvh\xec0\xbfu.\xbe{$\xefu\xa5s\x17\x8c\x01\xef\\\x04Wp\x89\xe1\xc5\x92\xd3V\xd8\xe8\xb0\xe6K[\xf3]%\x9d\xcba\x10\x04\xea\x90\x13\x060\xaeI	\x8d\xa48\x08'\xa7\xd7s \xcb\xc6\x86\x85k\xd9\x1b\x93X\xa0R\x9eB\x805y\xda\x87KbY\xa7\xb6\x8b[\xe6\xc0\xc1/O\xcf\xc1c\x99\xbc\x0fgO\xc8\xe9\xd5\x1b\xd8[\xc7\xd8\x857\x07\x07i\x1f\xfb-\xad\xfa\x06\xd9\x01\xed\xed|\xeb4\xf9H\x1f\xf9s\x12\x8f._>\xda\xf4\xad\xff\xcaLC\xb7\x13\x87r\xd1N\x1cJ\x19\x8e\x0eEQ`=\xdau*\xda\x1am\xe9\\\xc7\xe7r4\xf1\x9b\xe5\xb2\xb4\x0dIs8L\x97d-ExV\x81\xa7\xa0?\x93\x04\x91>}\x9c\xe3\xcc@\xd4a\xe4\xf7I\x8d\xe1\xc4\xc5\x1fN\x8f3\x17\x86\xff,\xdf\xff\x11\xa5\xea1j\xf2\xef\n`^\x0f\x87X\xf6\xe24\xecC\x99\x03\x0dZp\xec\x05\x929L6\xa5:US\xe7)\x17J\xbb\xb6d\xe8'\xec\xe2\x11\x90\xd3T\xc9R\xf90dB\xb59\xa3\xedC\xa8N2\x92\xb7h \x81\xb3\x98(5\x07O\xad{$\xe0\xc0I\xe9\x08T&\xfe\xe67\xbf9\xf8\x15\x9b\x82\x83o\xe3\xb6\xe3\xfc^x5\xf5\x01\xb4\x18\x1a7^\xd7U\x1a&\xe29:MS\x8a\xbb\xdf]*\xf2;\xac\x1f\x04\x86\x85:\xeb\x0d\x06\xb9\xe0\xcc\xa9Z\x1e\xfbJ\x0f\xaed(N\x94S\xbeLGJ\xae\xa34\xf3\x9f!3J\xeeVJ\xa9\xd5Jv7K\xedC\x84G\x1d\xf4\x84\xdf\xd3oE\x97vTo\x9d\x14L\xf4\xa6\xd83|\xd2m\xdf\x16\x94[B\xdf\xe5\xdcz\xd7\xb3\xb7\xf8t\xa7??Sg\x16\xdd\x97(\n\xe29=v	W\xc7\x13\xaa\x1f\xb4\x8a\xeb\x1d\xb2\x1b\x86\xbdH7a\xfe\xe1Fi\xd5\xdf\xffq<\xee4LTn\xd8K\xa5\xdaK\xf2\xcc\xa5p\xd8\xa4\xea\xa3\xec\x8fM\xb0DjR/\xdd%|\xb7v>6}\xfcw0p\x8a<N\xb4\xf4j\x04\xcd\xa7\x80\xa9\xa6\x8e\xf3DWk5\x9ds\xb0:T\x99\xd9\xe2(-\xf6\xb5g\xa7R\xe0\xa9\x0e\xa1=\xb4\x96Zgu#t\xc7\xbd\xd9\xba^y\xbdS\xa9\xe4p\"Fl\xc4G\x0d\xb5\xd0j1m\x86\x9cl\xa0\xc1\xd3\xa6\x89\xdat\x01\xa7\xc5\xc3d\xce\x1a\xeaL\xf9h\xe1	\xec\x08\x1dW\xba\xa8\x9bj\xa7i\x98\x16\xc9\xd5\xd5\xdd\x0d4\xd0R\x08,qY\xe99F\x8bC\x94_*\x92\xac\xf8\x13\x0eK\x9d|\xed\x84\x0f\x03\x07\xaf\xc1a\xa9g\xe3\xa9K\xa1\xfa\x96\x1a\xbd\x90\xe6\xc7Wg\x96^\x03y\n\xd0hN\x99\xc0Ao!lm\xb3\xc1\xd8\xacS\xeb\x01\xa1\xeb-'&l\x0cZ\xb2\x9f\xeb0\xc3\xba\x0dKa\x1dM\xddR\xe7\x9d\xea\x9b\x14\xa6`\x13{4p\x9cR\x98\xb4\xb9K\xf6\x956\xe1}\x9e\xcd\x99\x99rl\xd7\xd6\xce#\x90\x05\x7f\x80\x07J\xf3\x9e\x88#\xc7-8\x0e\x86\xf2\x81\xa6\xc5\xc1\xf1\xcd\x90\x81\x10=a\xcb\xab\xc8\xe4tI>\xd66\xba\xad\x1d\xb23\xea\x99l\xbc3\xea\xeb\xb2Q\"\xa4\x93\x17J\xb3%\xb2\x82R\xa3\xbd\x1f\x13\xb0+\xec:v\xafn9\x04\xe5NJ\xa6;\xe6\xbb\x14l\xa5\xba\xc8\xe3\xa1\xb5\xb0[dr\x1bK\x95\xae\xbeK\xc3\xf98\x98\xeah*\xb4\xa7E)\xc3\x90\x98,U\xb2D\x87\x8c)\xc5(g\xa3w\x86\x15\xbb\x0f\xf1y\xd5\xd7Y\xaa\xf6\xd1\xed\x9e\xdf\xcd\xd7\xce\x8eu\xe5$\x17\xba\xa6\xd1\x19\xab\xdbP\x03\xfbYQ\xdaP\xe8\xc2A\xe5\xff\x95\x81\x1a\xd3\xbb\x90(+ER\xa3\x87\x10\xa0\xa4pp\xa9\xed\x03\xecF^y\x02G\x0f\xa4R\x9c\xd5[\x95\x9d\xaa\x0c\x19\xc5\xd7\xdb\x18\xdd<\xec\xc3\x875'z)\x8dVs\xb0\xf8\xa8W\xc8\x81\n\x0b\xc8zJI\xdb\x10	k)\xe8|oi\xa7\xc8x\xc3\xb0R\xfdK\xbbJ\xa9\xe7 zC\x96\xc9\xf9\xe0\x1a\x95\xd4\x19\xc76\x12'\x87\xd7\xd2c\xdaat/\x9d\x8a]N1C'\xac2\xf0+\xbc\x12\x1fm\x9f\xb3\xd9\xf6\x03\xf5;\xd3G \xcb\x07S,\xc5\xa9\xb3{\x110<\x8c+\xfc\xe2\x9e\x9ebem\xd4\xc1\xb1\xe9	\xee\xc7~t\xc9\x10=\x1bX\xab`\xc1\xf8HO\x11\xf4\x12\x92=\x96b\xb0\x8ek\xd8\xfb\xab\xdf\xe3\x18o\xef\xaf\x8b*\xa3\xe0oyg'\xc9/*	JG\x16R\x07\xe9jI\xae\xd7\xaf4\xbb\xbaj}\x06\xec*\x89'\x83Q?\xd2\xbd\xae\\\xdeY\x88\xd8v(\x06;jj;\x07y\x1e\x0e\x95\xb2z\xcat\x06\xa7O\xfbo`\xef;\x10S\x8a\xd1\x7f\xf9{\x98|\\GX\x9f\xa0\x0c\x9fo\xac3\xdb\xdbId\xf7\xe7[\n\x9dc\xe9\xfeK\xeah+\xf24\xf6\xc7\xe0\xaa\xa7G1\xc7\x0b\xc7i,\x0c\xd3'\xb3'\x8d\xef\x8a\x9fs\xf1\xfd\xed\xe5\xb8-\xce\x1e\x190\x9f\x0c\x8b\x9b\x94@,w*\x87\x9e\x15\x0brpm\xc6\x96\x9a~\xe7\x1d\x87C\xa9o\xb7q\xfe\xa1\xd2'\xe9\xf0\x8c<e\x94\x18&\x03\x9a:@\xcf\xcbr\x0cO\xcf\x15`\xa3\xadr\x9b\x91d\xa8#\xf7\xbb\xb1\xd5q\xeaD%\x16}\xdb%\x9f\x9f\xda\x80Y\xed\xab\x08 Y\xe5\\@\x1b\x84k\xbf\xe6`\xc6K\xb9\xa3\x86\xe3t\x81\xcd3O\x13\xd3Q$T\xa9\xcf\x89.\x0c\x11W\xee\xfd1e\xb8\xa5\xc6\xb5-Y\x95\xec\xe4\x82F\xe3\xff\xab\x7f\xd4f\x0d\xd87d\xd1U\x9f\xdb7t\x8d%\n)\xf8\xfd\x86#\xd4\x9fH\x1a\x03\x05\xb6\xab;\xbd\xf1\xa3\xec\xd426w\xc93}V\x1a\xbe\x11i\x8f\xf76j\x93\xac~\xf6\x84A\x1e\x80\xe4\xee\x8fn\xd0\x98-\x18'\xb51\xd7Wa\\\xe8\x9c6\xb5s\x956\xf1\x00\xcd\ngK\xe1\x93\xef\xe9\x17\xbf\x10\x87\x92\xa6\xc0\xc2\xb8\xe6!\xc8\xfd\xb1\x15\x1a	G\\.\xa7\x0d\xf0;\x06\xc1}\xd5\x00/\xd6j\xebz\x0f\xb2\"\xf5\xc7\x8b\xa8\xa5j\x1b\xa1\xaa\xa3w\xe631P\xb2\xe5\x17\x0c\xd4R\xf3\xa6%}\xa9\xce:\xf5\x0f\xc7\xb5C\x06}G\x86\x1a\x8e\x16\x91\xad\x8a\\\xd5\xa4'\x99\x846\xdd\xd1\x15>\x8d\xa9\x03gLh\x8aP\xff_\xde2d\x12/=f\x98p\xd7c@|\xa5\xed\xff\x07\xf7\x9fx\xc9\x90\"~%\x11v\xc76=\xdfc\xad\xd6\x155)\x03\xdc%	\x1a\xc5#K\xd4P\x1e,!Q\xc3\xa6}\x10\xb8\x14\xe8v/\xf6\xedX\x03\x8e\xdf\x0e\x14\xcf\x1d\x07\xf9\x1d\xf9\xc0)\x88\xfe\\=\xb1I4\x972AQy\x8a4\x9d\xbaC\xf6\xebu	\x0b\x1dz\x84>\xa0O\xaf\x8b\x96/0\x1e\xa8I~\x91\xc3\x8bw\xf6\x96}\xcd@\xf5\x83\xe4\x1e%\xa0p\x92#\x0cr}4\xd1fT\xaa\xb2\xa3B\x94\xd4\xf3\xa5#[Z\xb4/VdsE\xf5\x03-\xae\xfe\xf9\x82\xac\x99\xae\xcf\x8e\x89\xd8\x04\xb3\xb1\x85\x9fW\xfd\xe5\x94I?\xa2r\x95\xa1\xdd\xac1\x1e\xd9:D\xf80@\xd2\xdex\x06\xf2x\x7f\x85\xf2\x94\x8a 4\x9e\xc8\x86\xb5\x8b\xd9\x13V\xf4\x9c\x7f\x984b\xae\\\x92\x81\xe8q\x81\x1f\xdd\x10d4\xec\xbf\xa7\xad:\xd8\xb2\x8c\xafq\xa1\x8d\x8e\xd2?~\xe1\xa6\xf7\x9f\xf3\xday\x10\xf1o\xe4\xb8E\xabh\xb4M\x7fJ\xc3y5\x98\x04\x08\xb2\xf0\xf5\xab\x1f\xe6\xaf_\xcd\\'\xcfg\xca\x13H\x9bZ\xe83\xab\xed\xaa\xb7i\x93\xb3t\xae\x0c^r\xaa\xe1\xf2A'\xe4&\x17\x98f\xf8\xb1\x0fQ\x9e	\xa2m\xd6\xbd\xe7PGX\x04\xe7#\x9fGq7\xb3c\x89\x08\xcaU\xce.1\xa4\xdeIe\xc1f%\x8f\xaa@\xa24\xa9\xb5\x97\x00\xe7l\xea\xd3\xf8\xb5\x9c\xc4,\xe2\xd0M\x99\xbd\x15\x7f\x9f:\x1b\x1c=j\x93?\xe7\xb8\xf8\xe54<\x8a\xf8Q\xba[\x89\x02\xb5\x9d\xe1\xf4\xa4\x9c\xc8\x02\xedMm\x02\xfe\xe4\xfa\xaa\x81%\xa9\xb7J\xdd\x82\x83o\xc2\x01,\xb6\xf0\xcd\xf8&`\xe9\x8cq\x1bN\xd5<ap\xf6M\xb9\x14v}\x0b\xb4)]\x13\xf3M\xac\xbd\xdf\xc8\xc32\xcd\x7f\xf6\x07\xa3UU\xae\xc5cJ?{\x9e\xdc\xe7\xc5\x0d[\xeeor-)\x1fx\xaf\xa7/Z\xeb\xc7\xac\xe9\x9bs\xbc\xc0\xb8\x12oH\xde &\xbeE\x8b+jK\xf9t\x86J\xc9D\xa5\xf2\xb8K\xc6kv\xd4\x1535\xc3\x10\xf4\xca\x8a\xaf\x17\x8c\x0c\x93sO\x13\x16(\x02t\x8cv\x18[\xf2w\x1d5!\xef\x82\xba\x18\xf2\x1b\x89_\x1e\xc2\xefn\xce\xde\xce\xe1\xe6\xfa\xed\\\xdeJ:\x0f\x1f\xce\x8e\xaf\xc4\xbb'w\x06m\xaeY*\xdd\x92\x18\x19\x89G\xbe?<|\xfa\xee\xf0p>\xa0%\x1e(\xf5\xd2\xef\x0e\x0f\xe1\xf7\xc7\xf2\xad\xd2<\xef\xad\xda\xcf\xfb\x91/:\xce\x9f3\xbe\x8b\x9b<\x89\x9b5k\xb4+\xaa**\xb3\x13\x81L\xeb)\xb3\xc6\xf0y4\x8c/\x7f3\x9c\xd0\xcb*\xf9\x93`\xce.\xf5\xaa\xf7)\xc9\x15d\x81d\x0f:\x9dv\x97\xde9L\xe6Uo\x1ff\x12>W\xc4dXSR\x18\xe5\xc3N\xf97A\xc4\xf1\x0fK\xea\\\xba\x9eP\\a\x99 &\xa5\x9e\x90\x8b\x8a\x05?\x14\x14g\xaa\xf7\xa5\xa07;-\xff\x05\x93D_D\xe0,\xff\x15xy&\xc0\x88\xf2\x98 c\xfa\xe6\xe1]\x92\xb2\xf3\xf7'\xbf\x87w\xe7\xe7	\xb12n\x81\x86\xe1o\xd3?\x81J\xd3rvqZFd\xa3\x8er\xd7\x17\xe5\x7f\xc2\x18C\xab\xb4\xfc\"\xfd\x9d\xf4\x10f\x1cO\x9eTRat\xf5\x1c\xac\xa9e\x84!\xda\xae\x9e\x896>\x13\xe4\x9f\x92\xe3\xef\xff	96n\xe5\xa4|>\xbbL\xff*hz\x9b\xc2\x08N\x07\xa4 \xa6\xe8Q7\xe5\xd0wj\xafC\xd9u\xbc\xae\xd1[\xcc\xde\xe5\xbf	\xbe\xb1\xd7n\xa8\xd2\x88\x8dTR\xc9p\x1b+\xf5\x90\xaaX#\xb5'\x9eu3\xc8G\xd7\xfb\xd5 =7<\x10\xe19\xa8$,y\xae{\xe7L\xd4\x9d\\\xbe6\xa9\xf9\x9aB\x9f\xc5\x16B\xbf\xf8HM\x9c\xe7\xf7\xae>\x9b3\x8b\xad\x14F1\xa4g\xf0,J\xc1`XS\xd8\x87r9\xb7\xb4\xea\x0d\x07%O\x9dO\x89W6\xc0\xb3@1\xd5r\xe5\xcb\xee(\xe6rk\x18\xd0\x97\xe9\xc2\x0b:\xdf\x7fz\xde=\x1e]X#g\xcdo\xa0\xfc\xab\xa0\xc7\x12\xda\xcf\xeed\x00\x0b]\x96d\xc7)8\x0e\xb5\xc7\x8f\xcd\x13Rt<(f	\xa6\xebsK\x87\"\xd8\xfe#\xc5\xe2\xd2\xb7\xb6\xc96\xc4R\x93\xaa\x92\x11c\x1fF\xf4I\xf1\x10\xef\xad\xfe\xd4Kc\xcc\xa6\x17\xa0\x12\xaf\xd1\x81\xbcTc\x0fT\xb9\xe4\x98JU\xb3\xa185\x8b)\x88\x93\xd45C\xb6]\x82\xf0\xaf@z\x9b\xdd\xc3{\xf9\xf3\xfa\xd5\x0f\xaf\x7f\xf8\xdf\x00\x00\x00\xff\xffPK\x07\x08\xb0:\xc5J\xa9\x11\x00\x00\x9e1\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cV\xcdn\xe3\xc8\x11>\xcbOQ `\xcc.`x\xef\xbe\xe9\xcf;\x9aH\xb6 i<\x08\xb29\xb4\xc9\x12\xd9v\xb3\x8b\xe9\x1f\xc9\xcab\x1e o\x91c\x98W\xc8\x91/\x16\xf4\x1fI{\xb0\xc0\x9eX]\xfduWuU}U\xfc\xfdj\x92\x1dI\xd5\xccdw\xf0\xfb\xd5d\x92=3)\xb1\xc8\xee \x9bM\x1f\x1e\x96\x0b\x98\xfd\x15>]\xebOp\xff\xb8\x83k\xed?\xbfe\xd7\xfa\xb7,\xbbq\xf8\x02\x05\x9ap`\xb1\\/\x0f\xc3\x89\xb0\xcfkV\xe2b\x00\xad6\xd3_\x97\xf0\xc7\xd0}C\\\xa0\x1a\x83\xf7\xdb\xc7\xd5z\xb9\xfb\x08\x97x\xde\x926z%\x0f\x95B\xe6\x0f\\\x17 \xf1\x0c\x8d\xd3\x03\x97`\xfc\xcem8\xe0\xb5SY\xac\x9c\x1d\xfdXs\x13}\xba.\xc2\x89\x9f\xf4\xcf\xc0d\x01\xd7\x05x_\xdc\x9a\"l\xb8\xe1\x0f\x0e\xbe\x07ZUb\xe1\xdcs\xb0\xed\xe3\xfe\x00\xdb\xaf\xbb_?\x84s\x1cG]\xb1\x82\xce3\x9e\xa2\xbf\xff<]<~\x83\xd9\xea\xcf$!<sM\xf9\xeb\x81\xcaR\x84\x1b\x0e\x9fw\xcb\xe9\xc2\xc1\xdf\x85\xcd\xca!\xc5_\x1f\xde'9 N\x1c\xcfX\xecY\x8d\xabmr\x7f\x0f\x8f\xf7p\xf8\xbc\x84\xfdt\xb3\x84\xd5\x16\xbe-wKxZ-\xbf\x8dN_M\xbe\xdf\xc4\x82\xd2\xae\x9e\xfc\xaa\x11V1\xa1S}\x15\xec\x92\xdd\xc1\xdf\xae&\x93I\xf6BVy\x93A\xd4\xd9\xd5d\xf2w\xefB\xe5v\x12\xacB\xab0\xe2\xbc<\x02\xd6\\Z\x83=4.oF\x8b1\x98\xa4\xa9\x06,q\x9d\x90N\xeca\x1as\x92E\x8f\x8b\xcb\x9b\xd1b\x04\xbe \x1b<eRvm2\x1f\x16\x01\x1a#\xe3\x8b%E\x82\x155\x97.\xbeS'pm\x143\x18\x03\x92\xb1\x92<\x01\x04\\\x80E\x95\xf7)C\x93\x96\x14NK\x92\x97:\x18\xcdr\x92F\xb1\xdc\x84\x12w\xdb\xbb\xae-,W\x08\x02u\xa8j=&\xee\xec2\xe4y\xb5\x85;0d\x0dh\xdb4\x8a\xd7\x18\x9d\xc1\xb7&\x1a\xdf\xe1\x0bqY\xc4t\xc4\x8d\xc1X\xf7/\x83n\xf7\x07c\x15/\\\x92\xb29\xcb\xabtkx|\xc1d\xc4\xbc0\xc9\x0d)\x7f\xd1\x9cd\xceQ\x95\xd1\xce\x8b\xd5\xe6\x81\xce\xde\xc4\xbfA|\xe2R\x1b&c \x04\xe5\xaf\xa1\x9c\xa3\x14s]\xa0b\xe9\xbe\x0d\x15];\x8e/\x0d$\xe6\xa5$\xd5\xb5\xd1\x0b:K\x0cg\xb6\x8a\x1a\xc5\xbb\xd60\x9e\xde\xab\x11\xa7Bxr\xb90\x9d\x88\xab\xc4\xde\xe0\xdd\xf4x\xe4\xc3\x0bu\xe8e\x9e\xceQ\x0ct\xf5$\xdd\x1b\x9e\xbf^B\xd8\x1a.\xcb~\xdb\xca\xe1I\xbd\xdc3\xf3\x87\x8c\x0d^\\\xc8:\xedOOd\xf5\xcf\x89\x8d\xfa\"\xf3X\xa0\xd9\xa2ks\x92\x12s\xd3\xb5\xe1\xc8\xdc-\xdf8\xc9\xb0\xdc_d^)\xba\xbd\x8d-s\xc1\x15\xe61\xce\xef\x0e_\xf9\xf2\xcf\x0c\xaf1\xd5s\xce\x04\xcab\xc4\x86/LF*\xdcw\xed)\x8a\x1b\x96(?=\xa9^\xc7\xa3\xf4\xc5r9\x88\"!\xa9\xfb_\x14\xf7\xd8D\xe91\xfa5\xc9\x1e(]\xee\\\x1c\xa8yF|\xed\x9dY\xf0:\x82\xd6V\xfe\xe0\xcb\x06\x93\xf4\x05m\x94\x9e0\xe1\xf6\xac\x1e\xb3\xd8\xf2~X\x921T\xbb\x98\xcfX\xac\x9f\x9c\xc9\x1cE \xa6\xb4}Nsf\x98\xa02p\xc0\x8b6QVp\xd7\xb6\xe7\xfd\xb9\xb9S\x80!\x88W\xdd\x8c\xd8JL\x85\xe9\x90\x08\nV\"4\x82\xc9\xbc\x8a\xf7\x15$=\xd5\x0e\xa8j.\x93\xfd#\x17x Z3\xc7\xa9;\xf0k0D X\xcf\xb2\xa3\xebBU\x1c\x1e\xfet\xaa\x92\x92\xc8\x15,\xc9\xf2\x9d\x83A\x0d\xda\xe9=\x8e\xd7\x0d)3'\xa5l\xe3'\xdf\xfaSP1\xc3I\x02\x83\xae\xcd+\xb2]\x0b\x0dY\x059\xb3\x1a\xa1@p\xa4\xe1\xa8 '\xa5\xa8n\xec\xed\xf8\xbaE|\xd0\xc7\xbbT\xd7Z\xad\xf9-\xac\x194\xacD81\xa8\x19\x97\x06%\x93\x06\xba\xff\x1a\x85\xa00\xaf\x98*\xbb\x16\xd3\x9d\xf2\xc4\x04/\xe6\xac1y\xc5B>\xbc\x08\\:\xf3}\xb9\xbf\"6\xfe\xbf\x85\xcb\xf2\x9e<\x89\xff\x82\xd8@\x11up\xa4\x18[\xc1\xc2\x98/PI\xee\x9a\xc7MjK\xc3\x8f\xc9\x13*E\x96\x8b\xbe \x02\xad\x0f4\xebK\xe8\x9e\xbfu-0\x0b\xcf\xa9\x96j\xe4\x05=q\xcdI\xa6_\x89\x8dS\xc1\xc9\xeb\"\xc8j\xb3a&\xaf|\x8cPCMF\xbb\xa06L\xbb\xe8\x12?\xa14>\xb4\xa8\x1b\x1a\x9a\xb7\xc4\xf3\xe0\xdf\x03\xd9\x132\x0b\xda\xbe\xa4\x01\xd3\x10\x97\xc6\x15f_\xb9O\xa84\x08\x84\xfc}\x05\xfb\xa96\x0c\x81\x0dj\xedd\xf8\x05\x82\x12~\x81\xc3a\x1d\xb0\xff\xb0\x14\xdb\xaekR\xc0N\xf8O\xe8Z\xd3\xb5\x90\xf3\xbe))d:\x0c\xb7\x1d\xe3:=Ta\xe1\xbb\xd1\xec\x12\x9a\xdf.\xae\x81	\x01\xcf\x17Xm?\xe2\x86\xe7}\xc4\x86\x1f\xa6\x84?*\xd4>|\xd3\xdcX&\xb8NIR\xd8\x08\xdf\xa1w];\x0e\x9dBW\x88\x9e\x88\xbc\x94L\x0cxcUp\x1cM\xfa\xb9\xc9\x94\x15i\x16\xff\xa7\x14i$jd*$m^\xa1\x1a\x0d\x0d\xd4.\xb9\xcb\xb7\x86\xc7\xbf\xe05\x83\xa8\x04\x06\xe8\xf4)Pn\xec<\x90\xe1\xb9'\xc8J\x1e)]n\x9fk\xee\x1d\\\xca\x13]\xfa\xc1S\xd9\xfaY2.x`\xf2\x86K\xce\x8cU\\{R\xf5m\xdfP\xe3\xb6?3\x1bk\xc1\xca\xd4\x1cR!\x0e\xe9\xb3\x12\xea\x90r\xe0\xd2\xcd\xf7Sr\xcf\xca\xb3+\xcc!\x0d_\x83\xe2]\xf8m#\x88\x15\xf7\\\xf87|\xf5+p\xedi\xbc\xbdUT*\xd4>\x8a\xa6k\x85\xeb#\x9e\xd6\xbd\xcb\x1f,}\x1b\xdb\xb9\x9a|\xbf\xfa\xfe\xff\x00\x00\x00\xff\xffPK\x07\x08\xf6\xb8\x13[\x96\x05\x00\x00\xeb\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xde\xbfsf\xc4Z]n\x1c9\x92~\x96O\x11\xa8E\xa3l@#\xb9\xa7\xe7aa`\x1b\xb0~\xec\xd6\xace\xabUj\x1b\x8bF\x0f\xc0\xca\x8c\xaa\xa2\xcd$\xd3\xfc)\x95<\x98\x03\xf4-\x06\xfb\xe4\xeak\xe4\xc5\x16\x11$3\x99U\xb2\xc6\xbd\xbb\xb3\x0b\xe8A\xc5\x9f`d0~\xbe\x88\xe0_\x1f\x1dL\x16\xc66n\xf2\x0c\xfe\xfa\xe8\xe0`\xf2\xe2\xf9\x8f\x93g\xf0\xf3\xa3\x83\x83\x83\xc9\x95\xd0\x1aE\x80z*5-\x12^\x1a=9\xe4\xb9KtN,\x11\xba\xbf\x83X,d\xb5B\x0b\xb5\xd0\x0e\x94\x80\x17FZ\x84\xe7a\x03?\x06t\xb4\xc9M\x1e\x1d\x1c\xfcB;'\xafg/\xde\xf5'\xf0\x8fH\xf0\x14\xbdGh\x95\xd0\xd5\nA\x04o\xact\x08u\x80\xcah\x8f:\xc0\xc7 AO\xd1yh\x85\x03\x8b\x95i\x1a\xa1\xebn\x1b\x0f\x0e\x1aP\xaf\xa55Zc\x83\xdaC\x8d\xe0\xadX\x0b\xa9\x86\xd3\x85\xba\x15w\xee\x95\xa9>\xf4<\xdc\x98\xf0\xde\x04\xeb\xc0!,\xe4\x06-\x88\x00s\xe1\x12_o\xd1\x9a \x95BP\x08u\xb7]H\x15\xc9\xc7Ut\x8a\x12\xd0\x92,\x9a\xee\xb7\x06\xc1IPS\xa3\x97\n=\x10\xb3\x95\xa8V\xdd\xb6`A\x1b}\xd7H\x87=\x07\xcf\xf3\x88\xcd\xb2\x10$\x03\x85\xa0M\xc3\x9fa\x82\x03\x85\x0e\x82\x97J:\xe11\xd8B\xa4\"\xd4\xd2\xbc5*4\x05M\x1a\x83u\x1cL_\xc2?H\xa2\xcehhM\xb0\xd0\x04'?\x06\x04\xf4\xa0\xb0\"\xb2\xb0\x96u\xb75%qo\x96r\xd1\x13~+\x97\x9a\xae\xca\xc1\xcb\x8b\x17 \xb4l\xba-fa=\xd7\xb2A\xe6\x94&\x1b\xa9\xa5\xf0\xc1J\xd7m\x0bv\xe7\xcb\xcb\xe0\x07N\xe9\x07\x9c\x88\xea\xc3\xd2\x9a\xa0kx+k4\xbd\x96y@\x0d\xce\x04[K\xcd\x92\x8e\xfcA=\x15\xd6\xca\xee\xb3\xc5?\x90\xce\x94\xd4#\x81L\xfeK\x94\xbb\xcf\x0d:hLP\xdd\xd6\x91\x1a+\x01\xca\x04\x12|+,\xa8\xa9\xa8\x1b\xa9\x0fA\xc9\xb5\xa5\x15\xb5\xb4$\xa1\xa8Y\xa4ok\xe3-Bc\xb4$\xb9\x15\x0c\x18a\xeb\xf3M+\xed]\xcf\x04\xffd\xf3\x81z\x1at\xaf\xe7\x89\x99\xd7\xa6\x99[\xa4\x9bN\x9aH\xf4\xb5	k\x14a\x03M45\x07b-\xb4'>]h[\x8b\xce\xed\xd3\x1bs\xf1Z\x14\x1a\xf1:\xea\x12)\xeb\xe8\xf0+\x0c>\xd9\x98\xb4\xf0>\xb8\x8fa\xda\xfd\x1d\xbe}\n\xd5J.\x16\x16\x1d\x98\x00\n\xbd\xa7\x7f\x1b\xa9\x83\xab\x02]\xf1c\xdc`\xd3*\x84g\xd0\x1a\xf5d\xe7\xec\x1b\xe9\xd5p\xf8\x8d\xf4\xf1\x03\xbf\xfe\xf8\xa7P	+*Ow<:\xec\xca(\xe9ImGG\xd6\x83\xb4\x93o\xea\xd5\x9e\xef)\x8d\xf5;*\xd1\xfaj%\xfaM\xa7\xe9w\xdct\x86\xe4Z\xc8\x0fmFFG\x9fP\x99\xa6U\xdd\xd6\xa3%\x9f\x93\xe8D{\xaa\xd0z!5:\xf0\xdd\x7fV+t\xb4\x98,b\x8a\xda\xe2R:o\xa3\x06\x91D\x05T\xb6\xdb\x0eZ\x01.\xbcG\xbf\xc7\xe1\x8dX\xba].\xc1\xd3`d\x95\xe6I\x7f#\x9bh#'tA\xce\xa8\xc0\xde\x97\xb9\x8e;\x8f\xe0'M\xbb\xa3kZ\x19\xe9$\x08El\x90\xd3f\xde\xe2\x97\xac\x049\x86\xfcu\xcbn\xab\xbb\xad\xed\xb6Gpj\xb4\x97\xb4Nw\xdb\x8a\xa4\x9a\xf7\x89\x00\x8d\x91\xda\xc1w\xcc\xdeQ\xf1%+ag\x95\xb1\x83>\xf0/b\xcb\xb5\xa2a\x83\x1b.;+\x86\x91\xb5\x03\xa1\xbd\xe45\xca\xd8\xecl\x1bS\xcb\x85\xac\n\xc9\x0d\x9b\xfbX\x90.\xfc\x08\x9e\xb7\xb6\xfbL\xc6c\xa4\x05\xdcT\xdd\x96B\x06Y\xbbl\xa4\xc7CP\xd3\xe2\x86\xa1\xc6\xb5\x15`I\xd9dy\xc1\xe5\xe7\x04\xe7Ms:\x9b\xf5\x9f\x93X~\x811R\xd0w\xf9;\x85\xd0\xa2uFk\xa1\xc8\x05r\xb0$Q,\xe9\x92\x04\x1d\xe5\x1c;v\xf0+rG\xe0\xba-\xb9ai\xb4.#F\x7f\xde\x8dY.\x0b\xa3:\x9d\xcd\xc6'd/\\y\xb9fI-\x1efh8\xa2\xc6\x85\x08\xca\x97\xdft\xc3<e%\x8b\x0c\xee~@\xb7]\x88\xe0c\xc8+\x85\xa8\xa7\xa8A\x8c\xbf\x07D\xa8\x82\xde;\xf2\x95\xd0\xcb\xfeL\xfa\x11\xb0\xa4\x9d\xceO\x13\xf7\x9f_\xd2t$+K\x96\x9ei\x9e\xa1\x0b\xb34\x16\x89]c\xb5\xa2?\x026\x0d\x05\xeeb\xdb\x91\xb1\xcb\x82\x9etb\xae\xf0\xda\xcc\x8d\x1f\x8c\xf0D\x99\x8f\x01\xa3\x8d\xd98\x15	\x9f7m\xf7[\x0c\xddy\x06\xea)nZez\xef/*\xd2@\xb4)\xe4\xec9\xeet\xe4O\x0e\xed	\xf9\xd1\xe1\xd8\xab\xb8\xd4\x95\xf1)\x1d<\xc3\xa0\xf2\x109\x99\xa4\xca\x01\x9c$P\xc5.\xd6v[\xc2h\x18\x03\x8b\"\x16\xd3\xe1El\xae\x8d.\x9c6\xdaFj\xfe\xd0\xde\x7f\x1e\x963\xc5\x04\x10Q[\\\x05\xca\xe5\xca\xcf\x85R=\xb9\x1e\x0c\xc2\xbf\xfc+O\xdc\x17\x00\xc8\xef\x7f\xec\x17\xa2'\x0b\xf4\xc6\x0bE\x9c\xff\xf1\xe98*\x14\xa75B\xaask\x07\xdb0\xc1Z\x89\x8a\xe4o-\x05\xe8\xd26\xe2\xddUi\x8d#\x8cQE,77\xcb\x80G\xfb\x84/	F\xf6\xe0\xaa\xa6\xe8\x9b\x85\x91~\xf5\xe4\xfa#\xef!s%\xdcp\xa1\x97\x86Aj+\x06Z\xe5\x10{\xbb\xa9\xd8\xa1~\x1fQc\xfd\xa0%\xc6zph\xd7\xa4\x01yS\xefR\xadg\xadH\xd3\xf5\xd71=\x0b\xf3\xc1q\xdfOyf\x82\xfbCm\x1a\n~\xbf\xe7\x84\xcd\n\xb5\x17\xb2\x00Ji\xa0\xb0T[\x9aj\xde1\xb6S\xdc\xb4B\xd7\xcf\x0be\xeb~\xf5\xa8k\x1bM\x91\xb7f#\x8d3\x84\xa9	\xc4\x16\xd3%\xb5R\xa2\xe7\xfc\xb3\x07\xe77\xddVu\xdb\xe8\x86\xc8\x00(\xf9\x91lYl\x99\xa2\xe9>\xfb\x91n.\x10\xeb\xb9\xa8>\x9c7\xa5\x0e\x9d\x8e\x05\x98\x95\x88\x05\xa6\xbd\xa8\nSZ\xa8\x12\x02\x9cY\xd1\xa2\x08y_L\xbe89\x89\x13$\xfeV\xdc\xc5`Yz\xe5\x82\xa0\xb1\x15\xd6\x94v\xec\xe4 \xc2\x03\xcd\xf5\x91\xe4\xac\xdb:\x91\xa2		jH\x03]\x0e%\xd1\x93\xf0\xc7\x87\xb9JQ\xb9\xf8\xfa\xa5P\n\xed\xdd\xa5\xa9\x0b\xc8oj\x84\xa5Ph%\xee|G\xd0\x84\xec\x18O\xa8\x1d\x9a \xd6X\xf1I\xbb\x17\xb64\xa6\x8c\x8b/\xe3\xcf/\xa9P\x9a\xeew\xafd]\xa3. \x16\xe5k\xf0\x0c\x9e\xf6\x14\x1a\xf4\xe4\xae?u[k\"rp\x9e\xa3\xc6\x80\xcdc\x96\xe7FT\xf1Dj\x8duO\xf9\x07Y#\x9c\xa1B\x8f5\\\x19\xd7\xc7\x0d\x9e\x10JAK\x83\xe0W\xc2\xc3J\xac\x11\xe6\x88\x1a\xea\xb8cLz\x94Fs\xbe\xc8n\x9a\x86\xc1\x05\x0b\xc7B\xa9\xe3D>\xa7\x93\x83\xc3O\xa2\x1cr\xeb\x16\xc3\x90QS\xfc)\x88\x8c\xce\xbd\xc6*X'\xd7\xa8\xeev\x8f\xb7\xdd6\xcd\xd1\xf5e\x0d\x92\xce\xc9&(,\x0d\xcev\xdb\xd6hGz3\x1d\xa0\xda^\xa6L\xe7\xdd\xacB3w\xfb_\xbac\xd4\xd7\x84\xd7DU\x9a;\x87\xc9\xa0an\x827\x1a~N\x1af\x7f)\x0e0V~\"kSt\x1b\xb2\x00\"3\x82\xe2\x0e\xfa\x05a\xb3go.\xe2u\x0e\x1e\x84r\x82\xc5a}\xa9_r\xd0\x80\x8b\x1a\xb5\x97\x0b)z\xf9\x14\xcb\x88\xeb\x1f\xcc\x1a\x87 v\xc1\xfa*\x02\xc7\x83\x08T8\xfd3\x94S\xef\x19\x0eBk\xbb\xedZ\xba \xd8\xe4\x19o\x90\x0f\x90\x0f\x92\x193pm\x8c\x7f\xb3Fkea\xaf\xe7Q\xb8\xa9\xaa\xb2#\xfa+J\x17V\xddv\x8e\x8c\xcb\x8a\x0b\xa8\xb1\x0d2\x16fJ\x02\xd2y\xcac\x1f\xafI\xeb\xff\x0d\xd6BQ\xa4(\xf0\xdc\x93\x1d\x9e\xc6\xa9C\x14\n\xe7\x04\x8e'2\x1b\xf7\xa4\x0b\x01<{\xeb5Z\x97N\xe7t\x99\xc9\xfe3R\x03\xd9\x8cB\xc7E3\n\x1d\xa71h\x04]\x04\x8d\xfbc\x86\xd4Jj|!\xcb(\xe4Q\xbb\xa2\x00'\xb4\x8c	\xcf\x94r\xd1\x9dX\xc7\xe8r*\xb5\xef\xb6V&,\x98\xcd\xac\x15\x96\xecs8\xebc=\xc4\xf7\x8b\x1f\xcfN\xbe\xe89i\xe58\xf0\xbe\x17Zzc\x0b\x035\xba\x92\xa4	\xae\x84w\x0eC\xaa\x9aq\xe9B6IQ\xb2\xfb\x1c\x08\xaa\xfdt`\x10\x9e^\xb2\xe2\xaa\x98\x0cD\xdd\xd6\x1e\xedBT\x85\xbd)\xa9?\x0c\x0c\xbd\x92H@r\xe3\xd1\xea\xa1N\xf5\x9e\x9c\x91=,\xd81\x01\xbam-}\xe2L\xf1\xb6\x1a\xc1\xdf\xb5\x08\xdf\x7f\xff\xfd\xf1\x9f\xaa\x95\xd0\x857l\x84\xab\xccp?\x97\xfc\xd3\xef\xa5X\xe9\xc0>%k\xbe\xb0n\x87.kyO<Q\x89\xaa_\xe6\xfa\xa8a\x19\xa4C\xc2\xcc=\xe9\x92\x16\xb9\xa5\xf2r\xa2\xb7'\x93\x15uP>\xf3w\xc1\x91\x1d\xf7\x8a\x8cL7V\xba8\x95\xa8r\xf2_\xc6\x8e\x81\xda\x90\xa5\xb3I[l\xa2\x8e\xae%\xed.\xf9\xda\xfc\xc0	\xc2\x10\x1bE`\x1b\x8b\x96\x97\xe3b\x1al\xc4F6Ba\xe1|\x92a3\x0c\xe3\x02dI{&?\x15Y\x8c\x88\xd5\xdb\x92p\x1aC\x0d\x97'\xbf\x9b\xfa;Y\xfbU\xa1\xa3v\xb9\xc7x\x1e\xfc}\xa4q\x19n\xde\x0e\n\x15\x7fF\x82\x7f6!\x12\x89\xe5OW\xd4l8Krm\xb7\xad\xe4\x82\xb0\x93\x1bg\x96}A$\x17x]h\xd1\xb6\xc6\x95\x916\x1e=[\x19\xeb\xab\xe0\xff\x7fX05Z1\xf2%\x97\xa6\xee\xb66U\xbbKor\xaf\x0f9\x84\xb9\xd0\x94Bb\x8c1\x92\x9c\x84}\xa0l\xaew\xaa\xa3\xe9\x88\xd7\xa6!\xf6\xdf\x1b\xc9>U\x84\xfd\xda\xa1\xc6[J\xe4n\x8d\xad\x0b\x02\\\xae\x85f?\xa9\x1bo4\xce\x17\x06\xd94\xa8\xabXI\x1c\xa7\xd8y\x8aft\xa6\xbd\xc7\x88\xf1\xb2*B\xa3^\x98AR^\xfa\xde\xe7\x8fZ8\xa9c\xd3m\xc9\x00V\"\xf8\xa2\x891\xa6\x9d\xabl\xc5W\x0e\x83\x03\xf6\xaa\x90#()G\xb9\xcb\xb1;\x88\x1e$8\xe8~#\xfcWIO\x07{\x98K\xd4\xd0\xaa@\x00\x8a\xe3xq\xf2\xed\x95\x12w%\x1e{mn!\x8d\xc1\x89\xd0\xba\x0f\xa9\x19\xd5q9u%\xb43\x9a\xb3\xf9XG\x0f\x16\xec\xb18\xae\xa5!\xff\x8e5j\xd6\x8e\xa9\x08\\\xcc\x1e\xe53\x8e\xab\xa6\xe0\xbcE\xd1\xf4\xbd,\xd6\xa9\x18g\x03\xcb\xaa\x08\xf6F\xd5{j\xf0\x9c\xc2\x9f~X\x0bL\xc2W\xa7\xf7\x14x\xdf\x10g\xbc-\xaa\xae\x17\xcb\xb2|\x9b/WH\x9b\nW\x05\xa6\x0f\x9b\xb8\xbc\x0c\x10\xb1\xfe\x9c+\xc1e!\xb8\xe0\xe7VcavW\xd6\xb4Vv[/\xc8\xb4{x\xe5\\\xf7\xb9\xcei\x04J\xca\x00M\xa0{/L\xaa\xdd\x15\xc7=%\x8eb\xb5q\xfe\xd4\"\xcb\x7f\x8c\xf1\xc8H\xa0JS\xff\x10\xebE\xed\xdd)\xa6\xef\xd8\xcc?\x03\xec\x11\xff\x17\x0c\xd4\xce\xb9\xfe\xd0\xb3_\x14\x1fv\xac\xfa\x9e\x99d\x11\"\x80C\xa9S\x131\x19\xe4\x90\xfd\x16\xa7\xda\xa0q\xa748#\x8fx\xc4]\xd3\x91N\xa4Sg\xc9a\xf2\x02\xd2\xf7Q\x8a\x9d\xf5\xe7\x0b]\xa7\x01\xc5\xa7\xee\x06\xe8q\xbbj\x87\xb5\x9b\x95E\xf1\x00o1k\xfa\x1a\xceR~\xf5\xbf\xc0\xd7]\x18\xd8Q\xc1\x03j\xcaq\xed\xa0\xdb\xac\xa2\xb4\xf3\xe3~\xc7\xda\xceop3\xf8\xeck\xac\x8fOT@\xe0\xd1\x91\x17\xe2\x84\x83\xa0&\xb9 k\x02\x97E\xe9\xff\xb9\xc2\x00N\xc2<\xa2\xbd\x98~O\xffb\xa74?\xfd\xcb|Z\x9c\x86\xa2~\xa3\x8b\xec\xfaU\xca-\x19C\xa7\xf3\xca\xba\xccH\xf3q_P%\xe9\xa5t\xbe\xc8/gE[\xca\xee\x99\xa7E%\xe8\x88\x1b\xd9\x945\xe1\xa6u\xd0m+n\x9c\xf6\x11 g\xdf\x02j\xe1S\x07\x15\xc1\x8f\x16\xe7\x1b\x1b+v\xd9\xd9\x93\n\xee@\xc0\xb7\xb0\xc2`\xcb\xf6\x9e\xc5\x16E\x91\x0b\xe9\xc2\x19\x8cV\xa9\xbb\xeb\x11\xac\xfc\xf9\x9aK\x0d\xb5\xc5_\xc8-\xd6\xd6\x0c \xed\xac\xdb\xe6\x9aA.\x120\x94\xb5y\xcb\xb0#\xe6\x1a\xdd\xb6\xb2e\xab\xd9\x1a\xe3\x7f\xba~\xd5\x1fF\xff'\x81\x88*\x17BG\xb8\xd7\x06\x85\x83e\\w\x9f\x97\xaa7\xd2W}Q\xc9\xc6q\x18\xb7K{\"\xae\xcc\x0cf\x83o\xb9B\xdb \xbb[\x8d\xe9\x89DC\x10\x9d\xbd\x17\xdbR\x82\xe7\x9c\x02\xe7\x1aLIW\xf9\x82\xae\xba\x8f\xb2E\xcd\xc5C\x8e\xb7\x8e\x0b>\xec\xbd\x1e+\xca\x0dj\x84\xdaH\x0f\x0b\x8a\x1cC?\xf0O\xa3\xa2\xfd\x93\xf2\xc4P\xa1\x16\xa685T\xf8Z\x98\x01Y\xecd\xa0y\xc7Qe\x9a\x82N\xec\x85\xff\xa3\xa6{Z6\xe0\xbcQ\xd3\xbd\xef\xae\xd7\xa4\xb0Z\xe3\x86\xa1\xd2\xe0\x97b\\\xb9\xb7\xa0\xeaZ#U\x19Cgq kZ_\x04+2t\xf6\x01jj\xda\x14\xe3\xe2\x86\x81\xa4\x17\x8b\xc5\xb8\x89\xfe\xd2\x8az\xb7^\x1as\xb3%\xcd$\xcc2E\xfd\x07OX\xab\xc8\xf9\x07\xb2\xe4\x9eF\xee\xe5\x86\xfd\x159\x97{|\x0b\xee\xd7O\xfa\x82w\xe1X\xfc\nK\x17Q6\x0es\xd6\xde\xf78\xef\xed\xdby\x8e\x19\xf1\xfe.\xc5\xa6'\xf5Vb\x91C\x0d=\xf2\xfbo\xf0\xf7>\x9b\xd8m\xb8\x8f\xb8\x90z\xcc\x85\xd4\xffW\\\xec?\x9cH'\xdd\xf7\x88b\xd8\xc6\xbd\xe1\xacwy{\xafy6\xd7\x01\xb3\xfe\xa4\x1b\xde\xd1\xbf\xb2N\xb4\xdb\xd1\x0b\x0e\xed\xc9\xcb\x9e\xf4\x0b\xa3\xeb\x07\xdb\xcf\x08\x8b\xfd%;\xd4\xfe;e\x0e\xa2:\xd0\xb9\x15\xbeZ%\xcc\xf1F_S\x08\xe8\xe9\xbd\xa39\x88\xd7\xea\xc0h\xe0\x08\x91\xd9\x8c5\xa0{\x90\x07,\xa4b4\xbd\x90\x8aP\x88\\K\x07\"\xc2G\xd4k#\xbf\xe8Boq\xde\x8cK\xb8\xefp~\xf9?\xab\xe0\x06x\x88\x06<N\xcf0(J\xf5\xb7Y\x87\xf4\xb8\xa0p\xb6\xb7+\xe1\xf9}\xd6\xc0[?\xb2\xe7nc\x9a\x10,\x97\xe8c\xdc\xa3\xb4\x89\x9fw\x11!\xfe\xefh)\n\xf2\xc6~\xd8o\xf2\xe4\x07x\xc9\x19p#\xe2\x81\xce[&\xb2\xf3\xd0\xe1\xebIqz7r`\x0f\xe9\xe0\x9d\xd05\x0e\xde\xe6?\xe2\xcf\xc3\xe2\x07\xa4\xb0\x83\xc2V+\xde\xf8\xe8\xe0o\x87\x8f\x0e&Q\xd0\xfd\xb3I\x1d\xfb\xe6\x13~\xe3\x10\xe59q\x95E\xee+M\xfaGb\xf1#S\xfdh\xa2b\x95h\x92H:c=}i\x8e\"\x85g\x89\x91\xbc\x7fh7\xb9\xee\x1b(\x96n_\xfb<q&\"X\xe9!a\x1c.(\xe5\x9d\xbb\x13\x85W'\xe1L\xbc\xe8\xdb.\x93\x97\xf1\xf5\x8f\x88\xc2\x9f\xcc\xfc]B\xa2\x04t(\xd5\xae\xe4\xb0\x8f\xb1\xa0\xc7^4s\xa1\xafJ#\x7fk\x02\x07\xbfO\xd0m	:p\x86M\x1c\x1c\x7f\xe3\x8e9<|\xe3\xd2\x1b&\x01VH\xca\xe8\xc9\x06\x85\xf6\x08\xcf\xf2\xf5\xf3kQ'4\xbf.\x81\xd6RV%`!5\xdd\xf97\xee\x10*~)J\x08N\xe6WA\xdf\xb8\xa3\xb4}\x86\xcaP\xbe\xc0u\xcd\xd8\xaa>L\xa14\xf7\xdb/\xae\xf8\x89\xd47\xe9\x1dS\xba\xa3 \xc7\x0feS\xe1\xb4\x105\x0bD\x13\x90\x9a\x9c\x9a\xa6Mhs\"jJ\x0f\xb3\xd3IcmtV\x93\xe7m\xab\xc8\xff\xe4q\xe7\xe4R\xcf\x08\x00\xd0l\xf7\xeb\xc7 \xdbDg.X\xa3N\xb8\xd4\xd5\x0fi\xb4\xb3\x16+\xc7\xc4\xaa\n[\x8f\xc3S\xba?>\xed/\x17\xfe|u\xfe\xf2\x10\xae^\xbf<\xe4\x17\x9a&\xc0\xbb\xf3\x93\xcb\x18\xb3H\xd0\x8fk\xd9\xc46\x03<\x83\xef\x9e>\xdd|\xfb\xf4\xe9!\xf8X.}\xc6\xaf3\xfe\xfd\xe4\xc9Q:\x98\x99\xbf\x12\x89\x8f\xe1E\xdd\xe81\xdd\xa4b\x08PTJ\xf6\xab\x02\x93J\xd1GW4\x99\xffM\xe3(\xc8\x99N\xde\xca:\x8b\xa72z!\x97\xc1\xc6$8\n:\x8dX\xd8}\\9\xac\xe6\x97\x0bvg\xb9\xea\xef?\xad\xb6(|A8>\x9a\xd9#Z\x0b\xcf\xdfy\xc6/\x9b\xb21\xc5Fm\xbfy\xd6\x97)\xf7\xf7\xf3\xca\x1c\xfc\x8a\x95e\x90N\xabR\xc1\xb0\\4\xae*L\xea`s\x95nr\x16l\xee:L\x90\x90\x0c\x1bb\xc4\xc2i8\xbfG\x88\xa2(^!L\x16\xa1\xfa\xf0&*\xdd\xe9\xf3\xd9\xec\x1cn\xde\\\xc4\x99\xa52s\xa1h\xe2e\xfc\x8fG\xb9\xb39\xb98\xcb\xbfP{\xe9Y)b\xa3\xd3'L0!\xf5Y\xc6\xfd\xb9\x07!\x95\xea\xb6\xcbLI\x19Q\x9f\x16\xfa\x93\x1ey\xa9q\xe1\x8b\x97I\xbd\xdc\xd3u\n\x00\xbd\x8e\x7f\x9db\x7f\xf7\x80b+\xb34\\\x06'0\xdc\xe7\x02\xe5\\|j2\x9a\x86(\xa3$\xe4\x9d\xea\xe9\xa8p\x1aW\x0c\x11\x84L\xa8o\x01\xc6\xb9[\xfd\xda\xf45\x9e\xe82I\x87\x8cs\xdd\xb6\xc6O\xf1-\xdd\x8eR\xb5IQ\xca\xe7\xaf\x936\xd8e\xafB\xdd\xafJ\xc6\x17]I\x7f\x8e\x0bm\x8b1\xee\xc6\x18\xe5eK\xab_H\xe5\xed\xa8\xd3M\xce\xd9\x13\x08=,@b~\xa4\x9e\x8b\xfdE>\x7f\xdc\x1au\xfc$\x93\xf7\xb1p\xebz\xff\xd5?\xf6\xa4\xc9W\xf1jYy\x9a\x84r\xe2#\x9b\xfe5\xc1\xc4\xadDmniI\xfa\xaf\x18=a\xc0>\x99\xf1\x0f\x98\xa7\xa7q}P\xa5\xb9\x1b+SU\x95\x81]\x9b\x1dW\xc2\xbe\xbd9~\x014O\\\x98\xbf\xc7\x8a%9\xc0\xf2\x89\xbb\xd3\xec\xb7f^\xf8XJ\xdf\xd1\x18Zp\x9acB\x0e\xb8\xd3\x8b+\x97\x1e\xbb\xb8\xb8\xa1\xe2\xf8\x0e\x11\xb988\xee\xcbY\xbc\x94\xdf\xc0Ez>V\xa3b\xe6\x96\x86\"\xac\xe3\xf0\x9fF\xee\xda\xf8\xcdw9r\x04\x9db\xc7Y\xb7\x8d\x9d\x12\x02\x1e\x8f\xfe\xf6_\x01\x00\x00\xff\xffPK\x07\x08\"\xb3C\x86\xf6\x10\x00\x00\x032\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/common.jsonUT\x05\x00\x01\xde\xbfsftV\xcdn\xdb\xbc\x12]\xdbOA\x08\x08\xda\x02E\xba\xef\xce\x8e\x95T\x89#\x1b\xb6\x93\xa0\xb9\xbd\x0bZ\x9cH\xb4)\x8e.\x7f\xec\x1aE\xdf\xfd\x82\x7f\x92\x92\x0f\xdfJ\xc3\xc33\xe4pxf\xa8?\xd3I\xf6\x86\xaa\xa5&\xfbN\xfeL'\x93lO\xa5\x04\x96}'\xd9s\xbe\x99\xcf\xca2/\xc9b\xb5\xda\x90OW\xfa\x13yv\xd6\x95\x0e\xdf_\xd9\x95\xfe\x95e_\x9d\x1b\x03\x01\xa6\xf7{)\xee\x17\xf9f\xe1}\xc2<oi\x0d\x8b\x814\xbb\x9d\xe7\xf9rQ\x94wd\xc4\xef7\x1a9m;\xe4\x02Tp+\x1egw9\xd9\xaeW\xc52\xdf\xe4\xffp\x90p^\xa36\xba\x90\xbbF\x01\xf5.W\x8cH\x0eg {P\xbcj\x0cH\xc2%1\xd8\xf1\xea:xu\xcee&Y\xe1\xb6\xd3\xab\x96\x9b\x18\xe4\x15#~\xee\xb3\xfeB\xa8d\xe4\x8a\x11\x1f\x92\x1bc\xa4\x0d+\xfc\x8b\xe3{\xa2U50\x17\xa3\xa3\xcd\xf3Mq\xf3cG\x9e\x8a\xdd]\xfeRlw\x1f3=\xce\xb0n(\xc3\xf3\x9c\xa7\xeb\xd9\xfe\x98-V/d^\x94e\xbe \xf3\x9f\xc1\xed6\xdc\xcf\xed\x07g\xe3\xd3\xb1\xc4\xea\xb8\xc3\xba\x16a\x85\xddj]\xdc86CT\xa3,Z9\x88\xe0\xa9\xf4\x1a\xe87\x08\x8c\x93K(\xdb\xd2\x16\x8a\xf5\xe8 yI\x9eg%Y\xe4\xaf\xf9\xf2v\x91\x93bM^\x8b\xfb\x92\xcc\xf3\x87\xfca\xac\xa3l:\xf9\xfb5JO;\xe5\xf9Q'\xac\xa2B'%2z\xc9\xbe\x93\xffL'\xde\xae\xfd\xce\xde\x02\x99M'\x93\xff\xfaH\x1a\xb4\xaagY\xab\"\xcb\xaa1\xa9\xe5\xd2\x1a\xe8inhMd\x86\xb91\x19\xa5i\x06.\xa5\x92%\xaa\xb3\xc7T\x0d\x15J\xd6s\xc3\x10\";\x8eF\xf4\x0b\xd0!\xd6\x03\xa5)\xd8\x03M\xd1\xc6\xb4x\xed\xa44P\xd6r\xe9K\xc6\x1b~%Z\xa3Cj\x10\xe0v\x08\x98\x8f$\x1b\x86\x18\xbc$J\x0em\x00+\x94F\xd1\xca\x04\xa5\xbb\xe9\x9b\x88\x90\x08\x8d\x8ay~\x19.\xf8\x19\xd4\x99\x1f\x18(B\x85\x00MNT\x92b\x1d\xd8\xf0\xbb\x8b{?q\xb3W\xc0\xfb\x90\xc2\xcc\xb0\xd9\xecm\x0f \x18\x975Hb?\x90\x1b\xce\xc0\xeb\xbaj,\x17\xb1\x01\xf8#\xa4S\x1f\xa8\xe4\x06\x95_\xea\x118\xc3\x08[mJ<;\xb4\xb4\x01\x12X\x1d\x83~k\xd0\x02M\xda\xa3E\x06\x8a\xf6k\xa4Q\x98\xc4\xa1~\xdf\xd5,\x9e%\x04\x87\x9c\xd7 \xd3\xbde\x1a`&\x84\x97?\x1c\xf9\xe1\x18R\x93\x8a5\x04\xb4C\x99\xf6\xd6\xa1\x8f\xf9#F3\x94\xa6/\xc8\xad\xe1\xd5\xf1\x12\\\xdc\x98\xe8\x00\xc4\x82\x1c\x0e\x84\xd2\xd4\n$\x03\xc1\x86Z\x1c_\xd5+\x887\x06\xfd\xed\\\xd0:\xf4\xf3O\xb4_R\xd9\xe9\x8b\xac\xa2\x10\xb3\x13\xa8=\x97\xeeN\x883\x15\x1eS\xc0q\xa6\x8f\xff\"\xabF\xa1\xe4\x1aT\xc2j\x18\xa1\xa0X\x92\x8fC\x81eS/\xfc\xcc\xf0\x16\x92\x9a+*@\xb2Q\x1d\xdcS\x19\xcb\xe0\x16\xf6\xd1z\xecKc\xd6\xa9\x1e\xbbD\xeb\xde\xca\xde\x12\x89gS\x7f\xd8B\x17\xadU\x95j\xbc\xc4S\xb4\x16P\x0d\xf5x\x068\xf6q\xbc\xa2\xecwJ\xd6\x82G\xe3\x05SU/0\x1a\xcf\x8a\x1f\xa2\xf9J\xcd\xb8z-\xef\xdfR4\x06[\xaf\x10d}\x0dRY\x81\x08\xa5)\xad\x18\x92YQC\x05\xd6\xbe*\xa3\x19p\xc1]\xdb\xbe\xe9\xfdn\x1c@\xb0%\x06\x08}\xbfF\xac\\\xa4\x8a\xbd/\xdb\xbd\x87\x02\x07\xa5/\xb4\x07\xd1K\xf9\x8d\x0b\xd8!.\xa9\xaa\xfd\xd4\x1e\xb4q\x0f\x1e\xd7n\x8fZ!\x9aD\x94\\7A\x89#\xff\x1a\xd1i\x18e\xfd.>\xc1\x81\x1d\xc0/\xe1	1H\xdev\xa8\xcc\x0d*e;\xff\x0e\x16\x1ep\xa7 -\xd7\xc2\x1e\xcd5\x99\xc7\x10\xaaH\x1by.\xe2\x01Fn\xdaV\x15\xe8\x13\x8ak\xb2\x00\xd2\xd1\x9aKJ\xce\xa8\x98!\xd2\x12\xec$\x07{&5\x08\xca@\xc6g\x9f\xcb\x13\x15\x9c\xdd\xd0\xceT\x0du\x0b\xae\xe4\xc1rm\x80T\x11\xf3\xbc#@\xe7\xff\\\xb8\xaco\xd1\x17\xf0\x03@GX\xc4\xc8[\xea \x82\x86w}I\xa9\xd1\x06\"\xe8\x1e\xdd\xfeod[5\xf4\x08\"\xfc\x7f\xb8\x8a\x8b\xc5\xec\xd6I}.\x14\xfb\x0e\xe7\xbd|\xeeb\x17#\xae\xfb\x90=\xc8\xa1\xed\xb7\xae\x11>s\xcdQ\xa6\xdf\n\xdf\x1b\xc9\xc9c\x91d\xb5y\xa4\xa6r\xefZ\xf6B\xab\xc6\x9c\x11\x15s\xf9Fp\xeb\xe2	\x14\x80<b\x9b\xd6\x95p\x1e\xa2.]\xfa \x04\x1d\xa6;\xe4\xd28Q\xf6\x9a];\x84\x18$\xef\xc4\xeb\x9f\xb2\xe1\x05\xf0\x7fg\xdf\xc2\xf8\xdbn\xb7\x0c\xa4\xffY\x8c\x8d\xf7\xde\xfd\xa6ICj\xa8\xb8\x19:\x8a\x02\xaa\xc3s\xb6\x19N\xae\x80q\x05\x95\x99_B\xe3\xdb\xc4\xb1\xeb\xc3d\x7f\xe9;\xe0\xc0\x1b\x0e\xf4\x91\x1b\xfe\x8f\x12\xffM\x81n\x02-\x98\x11\xef\xc4%\xa0\xceH\x18*\x13\xc1\xa4\xc64e\xac\xf21\xef@\xd9\xfa8\x9a\xb1\"d#\xbe\x1b\x1b\xa8A\xa4\x87\x03\xa8\n\xd7\xf4\x8a\xd0\xb7b\x0d\xda]f\xfe\xbb\xe3\xf1Gx\x895\x97\xc4\xe3\xe0d$\xb0\xeb\xc9\x0d\x9eK4\xbc\xf2E\xb2\xeaZP\xc3Bv\xdfr\x1f\xefZ8\x91&\xdc4\xb6\xddK\xca\x9d\x0e}\xc8\xa3\xf1\xf5u,\x18\x83\x9d\x9f\xc3\xd0a3+S;H\xca\xbb\x07\xd2\xc0\xde\x10p\x92\x92\xf4\xad\x86\x96\xd2\xa3\x01\xff7\x9c\x9c\xceN\x88\xc3U<\x05`,.\xdb	\xa4\xec\x96\x0b\x7f\x82'?\"\xb1'\x8d\x19k\x85\xb5\x02\xeds\x19\x10`}\xb4\x1f\xb6\x89\xb9\x0e\xbbL'\x7f\xa7\x7f\xff\x1f\x00\x00\xff\xffPK\x07\x08\xa5\xfeKi\x82\x05\x00\x00\xfc\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xde\xbfsf\xbcZ\xcdr\x1c9\x8e>\xdbO\xc1\xa8\x8d^]\xa4\x92{z\x0e\x13>L\x84~,\xb7\xa6-\xd9\xa3\x92\xed\xd8\x99\xe8\x89@f\xa22\xa9b\x92\xb9$\xb3J\xd2D?P\x9f\xf6\x05\xf6\xd6/\xb6\x01\x90L2K\xb2\xbd\x1b\xbb\xbd\x17\xa9\x92 A\x90\x04\x81\x0f\x00\xff\xf9\xf2\xc5bml\xef\x16\xaf\xc5?_\xbex\xb1\xb88\xf9\xeb\xe2\xb5\xf8\xfb\xcb\x17/^,.5\x91\xc0K\x14\x03hT\xc2\xe3\xbd_\x1cF\xda\xd6\xa0\x15[c\xachP(y\xe7\xbc\xe8\xd1\x8b-\xa2j\xd1yT\x0d\x8a\xad\x85\x16\xb5@M}df\xd7\x9b\x06\xd4\xe2\xe5\x8b\x17?\x13\xb7\xc5\xf5\xea\xe2\xf34\xeb\xb5d.R\xc96\xb0\xdf\xa1\xdd\xc4YO\x8dm\x84\xf3\x00^\xf4\xe0\xd1J\x00%\xbcA\xd1\x80\x17\xba\x18(\x9d0\xbd\xf0(v\xc66\xa8E\x85\x1b\xdc\xa0\x16R\x0bD\xcd,M\xdf\xe2V\xea6\x8b\x01j\x07\x0f\xee\x9d\xa97\x930'\xdc$\xa8Mx#N\x8d\xf7\xa6\x8f\xc2|B\xdbZ\xd4\x0d*\xe1jk\x94B-4\x80\x15\x03\xb4R\x830\xbaA\x0b\xa0\x0f\xc5#\xaa\xb5\x13\xa0\x9c\xe8\xd0\x0b\x0fU\xa5\xa0\x11[\xb4\x95\xb1\xb4?\xd2\x15Bh\xa3\x1fz\xe90\xcb\xa0\x8d\x96\xd4bQ\xc7\xa9o\x8d\xd1\x02\x94B1\x18\xe7\x03k\x1a(\xb1/8\x8d\x8d4\x9f\x8c\x1a\xfb\x82\x17\xb5\x89mh\x8c\xcb\xe0\x0f\xb1\x05-x\x04\xedQ?>J\xdc\x1c\xd1\xc1me\x83\xc6\x0d\xa8\xd0\xbae\xc9\xdc\x9bV\xae\x0b!%\x9dH#\xde^^\x88\xdbn\xec+\x0dR\xb98G\xa42\xd1g\xe2\xc4\xadj\xafF\x9f\xa5\xa4\x0fqRw\x9ev\xd8\xe8F|\"!\"/&6(\xa0\xa0\xb3\x90%\xbb0`\x12\xee\x0b\xacN\x9a^\xea#7`-\xd7\x12\x1b\xd1c\x8fN\x0cr@\xd22\x0b\xb2\xed\xbc\x90\xda\x1b\xf1`\xc6\x03Kz\xab\xa57\xb6\x98\xc9\x80m\xde\xdc\x0f\xd2>L\xb3\x9dR\x1b\x9d\xee\x16T\x03~L\xfar\x02\xda\x83\x12\x0d_\x89G\xd6\x0e\xd2\xd9q\x87\xa2B+IF\xcd\x1aO\xcaLZZ\x91\xb6KG\xacv\xf2\xaeA\xdb\xecM|\x0d\xc5\xd1\x86i5@\x9a\xef\x1a\xa0\xe7c%\xa5\xe3\xfeK\xf1\x13h\xe1\x8d\x17\xdf\xbf\x12\xef\xc0\xcb;\xedP(\xf4\x1e\xad\x13f-jy\xb7\xa6\x9fR\x8b\x8dB\xa93\xb1\xc2-x\x8fz\xb9'\xc2\xad\xf4j_\x06/=\xaa\xa4\xa9\xf4{\x92\"-\x97\xba\x1d\n\x8d\x9e5\xf7\x80\xd4c\x14\xff*\xae@\xb7\xe6`)nY\xc2W\xc2\xd3\x9duB\x81ng\xf36\xe5f7\x0fi*\xbc\xa7u6\x0f<]\x13\xeeF\x1eV\xc3\xe0\xeb\x0e\xa6\x91g\xf1;\xde\x02\x0b\xd0\x8a\x16+;\xca\x0d-\x98\x0e \x0e!;\xb25\xca\x1b#\xe3	\x89\n\x07\x00\xb2p\x1e6H7\xdc\xd0B,\xb6\x92\xf4\x86L\x1c\x86\x15\xf7Dg\x81|g\x11\x1a\xf7D\xa0[h\xdd\xbeP\xc2Sc\\\x16\xb4N4r2eQF\x1f\x04\x89L\x8e\xcc\xa0\x8csR\xb7\xa8\x97\xe2\xcdo\xbfjb\xc1C\xbc\xd8I\xa5p\x83\xa3\x95\xb4\xc2\x8dyL\xab@\xb5A\xd1b\x8b\x1a-\xa2m0\xb1[\x8aOhQ:OV\xb4\x97t{A\x89\x1fH\x13]\xdd\x11;\xcdko\x1d\xed\xcd3\xbaQw`W\xb5\xb1Y5~\x02\x0b\x1b\x8fV\xb8\x01z\xe1\x98\x96\x8c\x83\x97\xdc\x98\xb4d'\xef\x1ee\x1b\xb7\x8d\xce\xc1\x97\xb6;\xde\x95\xa5\xb8\x06a\x82@V\xde5R\xb7\xe9\xdc\x95\xec\xc9\x15\xf4\x06=}N\x87:;\xd3\xb0as\xa1G\xe7M\x7f\xb6ZMBG	\xcf\xa3\x97\xcb\x9cZlp-\xb5\x0c\xbbv\xb6Z\x1dYlQ\x85\x83\xaa\xcc6\x9e~\x8b\x0e\x15\xd6>t\xf3\x1d\xf6 \x1e\xe5\x1d\x1d\xa2\x82\x06\xf53s\xdf\x9a\xb6-n\xd4\x19\xb7\xd3\x0c\x93\xe9'\xba\x18\x1d\xf2\x85\xcd\xe4\x89U\x83k\x18\x95/\xd7\xb1\xf2\xa0\x1b\xe0\xbbI2$V,\x0fY\x1b7\xd1\x83\xc6D\xf1\x0e\xc5\x0e\xb4F\xda9Z2{*A:\xc6\xee]4q[j%Q\xfb'\x02\xbc\x03\xdd>'\x01\xc0d\x1cH\xab\x06\xd8l\xc8@=\x14R\xecmN\x83\x8e\xd6I`bbx\x8en\\\xc5\xb6\xc0\xad\xe8\xb54\xb6\x15\xb0\xae\x10\x15+\xc6\xa3!\x0d*\x18J\x07\x95\xc2\x1bS\x19_\\>\x0b;rs|96\xa6\x9f\xdc\xed\xa7\xf4\xcd\x9b\xd5\"9?\x022.\x9c\xeb\x0e+'=\x1e\xd5q|2\x07R7x\xcf]\"O\x96\xa3\x87\xba\x93\x1a\xdd!\x01\x97\x16t+6V\xde\x91\xba\x93an\xa4\x17kc\xc7~\xf9D\xda\x8f\x0e-\x1b\xd8,\xf1\xaa\xee`\x83j\xcf\xd2\x10\x10:\xaa\xb0\x8bSW\xc1n\x8c\xd2\xcf\x97\xe3Er4\xc0\xbe\x08\xea\xda\x8c\x9aZ\x83\xf2\x1e\x00\xb9\xc7\x83\xd4\\\xdan'z\x80My\xe4F\xe7\xa3\xf9\x14le\xba\xa9q\xd6\xf7C\xbe\xbc\xc2\xa9Q\xfa\xf2D\x90|m\x05JM\\\xfe\xe5O\xf4)@\xfb\x9d\xe1\x15D>\xef&\xa8\x99I\x13\x0e\x8d\x83:p\x9d\xa8M\xdf\x83\x8eN\xaf\x87\xfb`\xc4\xc8\xaf\x14\x03Q\x8b?\xbc\xca\xae\xe6\x19S\x86=H\xf5\xc6\xdaI\xb07\xd4 \xd6f\xf4\x05\x1e\xe3K\xc9]I\xdf\x02\xf1\x19&W \xd5\x9cQ\xe4paF\x1f\xc6?3\xea\x03\xb8|\xe6\x9f	\xf8\xb0\xf8O\x86\x8a\xddD{\x8e\x8b\xb1~o\x11\x0e\xed\x16\xad\x18\x88\xf2\x84YA|\x86\xdbj\xac\x9ee\xf6E>n\xac\x1a\xd3\x83\x9cm\xcb}\x87\xda\x83\xcc\x9cRC\xe0\x92\xe8\xdf\xba\xcfx?\x80nN\n\xed9!p\x9cG\x04\xfd\xaf,\xca\xacH_\xeb\xb2\x14\x9f\xb1\xea\x0f\xc5\x87\xf3\x0bR\x91\xab\x0f?\xd0\xbf;\x14\xc8\x8e)\xa9qt\xc9\x1cyT\xf8\xdb\x7f\xe8\xad2\xd8,\xc5\xf5\x0c\xd6\xcd'\x89c\x8c\xd9\xd0|\xe4RP\xce\x8e\xeb~\x98\x1d\x14\x7f\x16\xd8?6\xa0\x15f\xf0\x12]\x889*d\xeb\x99\xd9\xac\x11\x9b\n\xeaMP\xb2\xc4\xed\"\xb6\x86\xa3I\x1c\x8f\xf8\x9czvX	\x00\xa5\xf1\x84\x00$\xbbUvk\x8e\xfc=\xa8\x8d\xd8\x91\x04\x9e|\xe2\xb6<\x89\xb5*\xe1\xcc;\xb2,Zl\x15\xb4\xed<x!l\x86\x96\xa0]\x93\xc8\xc2\x0c\x19\x08\x17\x1c\x8d\xad\xb1\xa1 hb\xfb\x16\xb9\x11m#Nb\xd4s8\xb3\x87y\x19\x1az\xf2c\xde\xca\xa16\x0d::F\xe4\xf5\xba\xd9|l\x1e\xa79[\x8a\xad\xec\xc3\x95i\xb2U{\x0b\n\xad\xbc\x13\xdcX\xac\x84\xbaf\x95@\x1dLSq\xe2\x05[cJ\xdf\xfe6|\x1e\x16\x1f_S\xf2N6\xa4\xbc\x93\x95M\xe1\xe3k\xf1*\x19\xf6\x14(\x14\xb1\xe53;\xda\xc9\x06O\xa5\xd6\xd8L\xcc~\x94\x0d\x8asTH!\xdc\x07\x8a)#K&\x90I\x0d\x81\xa6\xef\xc0\x8b\x0e\xb6\xa4\xdb\x1c\xd5\xf3\x889\xeby4\xbf\xba\xf8\xcc\xd2\xa0\x8dG|\xcc;\xe6\xc4q\x16\x9a\x88\xc9\xb1\x10\x80\"\xe7YF\xf3\x112\xf5`7|\xe8SZ\x80\xdc\xdd(\x19\xe5Mli\xff\x81\x99\xcd\xc5\xba\xc1z\xb4NnQ\xe5\xe8!\xb6\xe16\x04\x0bY\xd0=\xd98\xd4\xce'L2\xda8tMk\xa2\xbdx\xb2\xe5\xa2\xc3\xaab\xc0\x9e\xfc\xcd\\ \x8e\x92\xf3]\xc9A\xf3\x131\xde\"\xaey\x8e\xbf\xaf:\xb3\xfbYl\xb4\x19\xc2\x05\x94Z\x0c\n\xc0\xbb\x18b<\x0d\xad;c\xe5\xa3\xa1\xc8\x93\x8eU\x16\x90\xec\xc7D\x01%\x12-\xcc\xf7\xc3\x9f\xea\x8ep\x84q\x1eT+!s\x93Ye.\xb52\xc5\xad.\xfa\xf4\xd0\xe2\x8f\x04\xcd\xa7\xbe\xa7\xa4\xd3[\xb4\nuK\xaa\xfd1\x1a\xda<%/q\xb0\xb8\x95\xb8\x0b\x8b\xe1{P\xaa-\xf3\xbd1\xc6\xbf\xdf\xa2\xb5\xb2\xb8\x9a'\xf9\xd2Xc|\x19\x16\x14\xd6\xde\x89qn\x89Sx\xc1\xf0\x07\x8525Gn\x01\xbc\x11\xe1\xec\xfc\x9a\x82'/:\xb2V\xfaPl\x0dZ/F\xd1I\xb4\x8c\x91\xb6F)ld\x8b<\xf1\x114\x16	\xf7-\xc5;\x00/\x14bK\xf6\x93zfp\x1b:\xf9\x1cN\xe8\xa5 H\xc6\x92\xbd\x16\x07\x9d\xf7\x83{}|\xcc\xebu\xcb\x1e\xdb\xb1\x06\xf2\x80\x07bo3\xe6\x11\xd6I\xb9\xb6oGYAo\xcam\x98\xc6\xff\x0e\xb1\x95\xecg^\xed\xb2\xdf\xf3j\xb1!{\xb5\xa0\x02{NMj%5^\xc8\xcc\xe8\xa9&\x9d\xd2\xe7\x13\xbfN7%\xe4A\xb6\xa8\x95$\xc5m\xb2\x83&\xb7\xccA'\xf7\xe14%\xf0\xe1\xb6\x04\n9wI!\x8f\xda\xb3\xe7\xf2\xdf\x9b\x0c\x82.\xffz~\x1aE\xa0\xf6oA\x96;\xe0TR\xbe\xff\x7fI\x0d\x87\xe5\xe7\x84\xca/\xcf\xddR\xa4>b3j]X\x9a\xe8\x83\xa6D\xd1l\xebU\x19\x87\xdd\xe6\xe8\xeb\x13\xda\x00\xfc\xa5\xf6h\xd7Pc\x88\xcd\xf2@\xa97\xae\x00\"\x1e\xad&\x0d\xa0\xd6\xc0\xe2\xd6\xe0\xd6`KW\xe3S\x9e\x9b\x02\xd3\n-\x1bh\x8c\xa3\xfe\xfc\xe7?\x1f\xff\x91\xac\xca\xf1\x91\x7f\x18\xc8|\xae\xa9+\x1du\x9e\xb0\x07W\x9b|\xb6W\xe13M\xc5\x10;t\x11\x8dt\x83\x82\x87\xfd\xa1\x97}\x19\"&\xcd\xcf\x870\xdd\xb8)`\x88S\x16|\xfchg\xa1\xcc\x0eH\x95\x85\xd4\x9d\x19\x13\xe4\x0e\x99q\xd2\xd6\xd9\x1d\xa0x*\xc7\x83\xc2\xb3\xfd	I_D\xcb\xd0	I\x0f\"3F\n|n\xd3,\x9b\x94%\xe1@\xa4\x14\xeb\xfeG\x0e\x91\x9e\xb3y\x1dS\xe2\xd5\x8c\x12^\xa5x'\x12\xe9:\xb5\xf8\xdb\x7f\x0e\xca@\xf3\x05x\xd2\xc3\xfdJ>>kV[2o\x1e\xbf0G\xa2\x9a~~\xef<\x8a\x91'\x0c\xd9\x9b\xab\xd3\xd9\\\x9fe\xe3\xbb\xe7&\xdb\x11\xe1\x0bS\x05\xda\x7fk5\xd8\x8e\xb7\x9f\xb22\x85\xcf\xc0n5 \xaa2/\x86\x9clM\x99\xe0\x0d\x86\x8c\xf2\x81\x8b\xd0\x97l\xa1\x82\x87\xa3\x90\x07\xdf\x9fc\xd5\x19\xeb\xeb\xd1\xff\xces\x99\x06-\xccL\xc6Un:\x9c7\xcc\xcdF\xee\xb7g8\xe66\xe30\xa2rG\x063F\xe8d\x1aS+\xa7\xac]\xddq\x84\x14\xb2?\xdd\xa8y\x8a,\xa6.s\xd2\xd7{\xd9h\x06w!C1O{\xb3?\x89\x91\x91'\x8bBf\xa50\xfc\x1aw\x14\x04S\x8f\xcc\\\xe2\x0e\x8b\x90\xf7	\x10\xa1A\xc6\xf9r@1k\xec\xcei\x89\xb9<\x05\x07\xe3e],\xc7x\xe9e\xf2\xa8?\x91\xc3*\xaaZ\x04\x8a\x8di\\\xdd\xc1\x10\xfc\x0c\xc4<4\xd7\x83\xe6\\\xd7\x92\xc0F\x11\xd3\x9c\xa3\xdbx3\x88\xeb\x89\x88\xe9T\xdfk\xbf\x05\xdd\x8aj\xb4\x08c\xa5\xa0\xe9s4\x19\x13t\x0d>\x16\x19\xe2Zr\xca\x91\x0c1\xd9\x17\xf7\xa0\xeb\x1d\xf8\xba\x13-\xf9\xb5\n[B\xff\xba\x94h\xf7A\xc1C\x89\x0e\xafGQ\x11o\xcb\x07\xc6\x08\x86\x14RO\x9a\xd6\xa3\xf6\xa4\xd9\x8e\xf4\xdb\x87\xf4\xfe\xd8\xf7\xec\xc1\x85%<.\x8eE#\x0d\x0b\x11S-\x11i9o\x11\xfa\xa2\x1e\xf8L\x80Y\xa8\x94Q\xcd\x93\xc3\x7f?6_;z\x13!\xe2\xd9Wr\xec\x9cX\x7f\xff\x0cT\xbc\x82a ;Dn,\x04%\xde\xc4d\xb7\xe1\xc4+P \x84\xc2\x195\xd2\x19\n\xa3\xc3\xf7TH\n\xd5\x96B\x9c\x9d\xc6\xe2\xde\xbe\x91-)\xc7tm\xd3\xf7\xfc\xd6N\xbdR \x91\xc1f\xca\x1cz\xe3Sl\xe2\xfch\xa5n\x1d*\xe4\x93\xe1X+ \x1e\xdb\x90	@/\xf8\xa61\x94\x11\xbf\xfd\xfa\xdb\xaf\xfa\xb9t\xd7\xb0\xbf\xd3O\xf2ME_\xe3\xfc\x99EV\xe49\x16\xa5{W3\x05\x19\x8a>\x8bD+y\xb7\x97\xb9\xc4t\x17\x7f\xc7,?	}\xc98\xf2\x0dg\x8d\xca\x80Fj\x9c\x92@i\xaa(\xc9\xc6\x0c\x03\xaa\x0c3c\xef\x16\x03!\x96\x9b\x82\x8d\x14\x19Z\x12Z\xa2\x93\xd8(\xb9Y\x8a\xcf\xf1\xb6r\xe2\xc7\x85dEs(4le\x8b!\xb4`=\x08\xb5\x83\x89s\xda\x8cb\x11v\xd4\xb8\x97\x0c\x0e\x9f\xc4{'i\xcd\x19\xe6\xc5\xa4@L\x04\x93\x11\xbe\x8ee\xc8\x16\x9fX?.]\xc4\xc0\xb5\x83fo\xce\xdbX\xc5\x9a\xf0\xa4\x19d\xfd\xb5I}\xe8@\x93\xb6\xd8\x8c\\0\x9fO\xff\xcdy\x1f\xc6i\xba\x95\"\xc8\xa4\xa1RS\xa61T\xee4a\x85i\x88\xadn\xf1>\x1b\xfe\x1bl\x8eO\xd5\x88\xe26?`\xb81\x0d\x17\xe9*\x05\xb4x\x8f\x1b\xe79\xa6\xe6\x84\x16\xa7 \xd8\xa6J'\xcc\xd0\"\xe7\xbb\x19\xb0\x1d\xfc\xc3\x1e\x90i8\xf8GuP\xcc\x88\xd0\xbc\xd7Ej\xe1$@r\x85\x8f\xd3\xa6<\x9f\xad\xffB\n*\xd4\x12\x8b\x18\xfa&\x14\x17\x8b\x98\xa9\xec\xac\xc0\xcb-\xde\xca\xc2\xf9\xdep#\xb9%\x02\xa2\xcec?\xe0T\x91\xcf\xc4\x14C\x94\x9d\x96b[-_\x8b\x83\xef\xc58Z\xd1\xa2\"\x03>[\xed\x80\x90\xf7\xf7G\xb4]\x8e+f\xdd\xd4\xc3\xcd\x0c\xb6\xfe\xfd\x86\xda~f\xa7r\x83u7%\x9a>\xa1\x8d\x99\x8c\x94-\xe1,Gt\xa1\x16\xb9\x84\xbf\x01\xed\xa7\xea\xee\x9eW%\x0c\xfa\xf1\xe6]^\xbe1^P\xc3\xe1\xec3\x0d\xe7\x00:V\xc5S\xb1\x93\x83\x02g\xfa\x9el,w\x10\x0e\xc1\xd6\xdd\xd1`\x0d\xa14;=+A\xbb\x99Y\x15;*t\xe5Y\xe5\xad\x0eE\xf1X\x1f4\xfd<s\x9a\xe2R&\xf7\xa6!\x8c\x1b\xbc`\x01\xfd\\\x19\xd5\xacr\xc5\xebt\xec\x07Z\x0b\xdf\xb1\x9013\xc3\xe4I\xa7\xcd\x9a\xea\xff\xcfX\xdch\xb7\x96\xe5d*\x1f\xd7\n\xeb\x91\x1c6\xb5E%\x06\x157*$\xe7p\xca\xad\xd2m\xea5\xf6F\xcbZpM\x994l)N@W\xb85\x8a\\RQM\xfe\xe3\xac\xbeO\x1b\xf2(\xef\xf4L\x90\xb1F\x0d\xa6X\xf9X\xe35\xa4\xd7\x1b\x89\xbe\xacgq\xc7\x93H\xdb\xa1s\xd2\xe8\xbdW\x1a'\xd1\xd52\x15\xb9\xb2\xed\xad\xbc\xdb\xe44\xb5\xbck\x18\x98@\xac\xcd\x85\xda_J,GW\xed\xc4T\x0c\xac\xbb\x04\xc0`\xdd\"A\xb4B\x86\xc1HUB\x80\"\xdcY%Z\xdc\xe0\xf09\x8f\xa4\xe6\xe6\xcdyX\xaf\xe7//V\xd4$n\xcb\x97\x17\xc6\xf0\xf1\xfa\xe9\x05\xc6\xb8\x13\x03Zg4\xc1\xb6\x08\xb9&\xe7\x96\xb9{\xbc\xf73S\xc6\x0f+B\x8a!\xf2>\x0f\x15\xc9\x94\xa0!\xdb\xc5\xa1X\x11\xcb\xfb\x0e\x0b[t[\x94\x9d\x7f\x92\xe8\xc4\xd9j\x15k\xd1\xc5\x082\xa2\xe1\x98\xae\xe0>G9)\xf6\x0bj\x1e\x1e\xd6\xb0\xb9J\x80\xe2\x7f\xfa\xb0&0\xfa\xc2\xcb\x9a\x99\x182\x03\xf4\xab\xa4\xb8\xffOb|\xe3aM\x88>*\x0c\xc0h\x1b\x1ed\xcc\x1e\xdb\xec\xe5\xc0='P\xa2v}E\x0f'\xf5\xe1|KT\xdc\x14\x9b\x0e\xa8\x13.*\\w\x9edthO\xdf\xce\x81n\xf9\x92k\xce<\x14\xd3\xe2s\xb9\xe2=\xd7>\xbfo\xa5u\x82M\xce\xc9\x1d~\x0e\x97\xd9\x156\x85\xa3\xa0\x88a\xdek\xf6Ey\x8fq#\xef6	\xb0P\x10\x83\x83\xcaQ\xd8Iq\xcf\xbd\x19jI\xe1@L\x7f\xb1\x9bj\xe3\x1b\xc3F\xbaz$\xa32c\x92E\xc0\xaa\x9fg\xc7?cu%\xb8\xa9\x04\x9fqZ\"\x1emSz\x98\xdf.&\x8cB\x00\x9fFe\x07\xc6\x1b\x15Y\x15\x99\xd1\xf0f#\xc2\xcdr7:\xf0'\xba\xc4\x0c\x9f\xa7\x960;\xf5\x00\xfa^\xb6 \xf8\x87p\xb5E\xd4\xae3\xfe\x89\xa5\xdd\x19\xbb\x99\xd5\xcc>\x1b\xbb\x11}.\x98M\xf5\x94\xd2\xb6q\x1d	j\xc2/\x04\x813\x90\x7fV#\xd2\x1c{\xefd>\xe3\xff\xf9L\x0fd\xd9\xb2\x19\xfa\xb7\xf0yX|\xcc0\x02\x0f|\xf9\xe2\x97\xc3\x97/\x16f\xa0\x90hzT\xab\xc3\xeb\x88E\x9b,\xe8\"l\"\xb5\xad\xa5\xa7\xb026\x84%\xc6\xc4X\xa2\x85\xef\xc8\xda\x19\xebi\xfd\xc9\x95\x04\x100\x99\xa1\xc5;\xc2P\x1eY\xef\x1e\x8a\xf6\xb7X\xf3\x1b\xaf\xa2)\x1a\xacR\xcdS\xdbd\xd8\x17/y7\x16\x1e\xa6R\xd5\xe2-\xfb\xf6\x88\xf7\x16+\xff\x10\x8b\x98\xe5\x95\xfc[P\x8d\xd0#\xe6\xc7\xdc\xc4\x0c\xfbA\x81\xc7i\x83*\xd0\x1f\xca+\xfeQT\xa8C9\x0e8_E\x06\xe7\xf8;w\x1c\xb2N\xdf\xb9)\x7f\x1br\xf4\x8c\x10\x1b\xd4\xaf\xe3\xf1|\xdc\x89\n4'\xdd\xc4##*2\xd6*\xdc\x99\xef\xf8\xa9\xa3\xd4\xe2;\xb7L\xda\x123\xfdF?bz\xaf \x1d9\xcc\xcb\x0f\xdc\xad8\xdbQ\xce\xdfJ\x17\xcf\xa4\xc3r#2 R\x02\x19\xfc\x98\x93,x\x0f\x1aZ\x0e\xd0c\xdf\x86\xe2\xcf\x9cG\x8f\xadC\xb0K\xd4>\xc0\x14V-\xc09\xd9jv\xf5De\x18@Fh'\xefR\x94A[I\xb4Oi\xeb\xa6V\x8dv5`\xed\xa2\\8x\xbe\x04\xd3\xc3\x98?\xbc\x12\x7f\xf9\xf0\xe6\xed\xd1\xa1\xf8pM\x7f\xdf^^\x1c\xd1F}~szu\x94\x1d}\x8fi\x0cW\xab{\xf4\xb9\x98\xf6\xc3\xabW\xf7\xdf\xbfzu\x98;\xc4q.e\x88\xa9\xd7\xf7\xaf^\x89\xcd\xa9H\xe1_\x8bj\x94\xcd2\xca\xc9\xcb>7&\xb8\xa1\xe2\xd1\xe6\xec\xbd\xe6\xa2\xee@\xb7Xd\x85r5c/+\xb4\xa8\x15m[M}\xd2\xcf\xd8\x8e@6x\xf1^{;\xca\xf4\xdakQ\x1b\xbd\x96\xedhCxM\x1d\xc2\xd1M\xed\xf6I\xcfUx\xf9\xf2Z,\xc2\xaf\xe7\xfaZ\x04\x9fY^\x01l\xe2\x1b_H\x826\xe0y\xa1\xe7\xf4?\xb4py}\x1aTF\xf2y\x14\xf7I\x1e\xb2\xe8\x93-_\xd91&DK^E:t\xd1\x8c6\xa5%\x17\xef\x0c\x99\xb1d,\x90\x00\x11\xdfX\x1a\xbbM\x01\xc1\xf4\xce\x84\x08\xe9uI\xa4\x8c\xf5\xe6}\xd0\xd4\x8b\x8fg?\x89\xf7\x17\x17\x81\xd0*S\x81\xa2\xf6\xb7\xf4+\x19\x12\xae-/.\xcf\xd3\x17j/=\xeb\xc3e\xf8\x8d\xf1\x05\xdbB*\x85m\xe0p\xc9?A\x95\xf5\x99\x05A\xd1\xb3Bs\x94\x0c\xefX\x8a\xf7\xbc\xe1\x85\xe1\xd4[\xea\xf6\xb9\xcbA'\xf4\xdc=\xf8\xe6-\xf8\xe1\x7fu\x0b\x94i\x0d\x97\x13\x16\x1f\xa5/\xaa\xec\x91\x10\xde9-\xc6Dc~\x9c\x06\x84a\x00\x0b\xe9]\xda~\xa2yQ\xe4\x96C\x87\xec\xa7\x16\xef\x87)\xe3\xbc0;}m\xa6\xec\xd2\xe2/\\\xb7\x92>\xa6kB;w\x1c\xa26\xb1V\x85\x96\xd1\xb6\x93\x92\x11\xf9\xb8\x88\xcc\xe6Y\xa2E\xf0\x9b\xb7\xc6(/\x07V\x13\xa9<\xc5\xc6\x01\x9f\xf1\x93\xd0\xb1\xba\xc3\xda\x1f\x86w\xdd&j\xbe\xd0\xd0S\xb8Y\x83C\x8e\xd1H\xe9\x9c\x02\xd7\xa1[\x8ap~N\xdc`;*\xb0\x02\xef\x07\x1bB\xc0h\xef\x17\x0e\xfd)\x1bD^\xde\xdf\x08+\xc7\xcfD~\x17t\"\x91Y[\x1ad\x04\x94w\xcfu\xd0\x98\x1d\xf5\x89\xbf\x8a\xd6S\x8e\x1c\x16+\xfe\x10\x95LK\x8e\xde\x9bi\xb1\xe0=\x01\xce\xd8% \xee\xe9F?\x8d\x07c\xbf\xb05\xc1\x825\\nM\x1c\x1e4\x9b\xbb3\xa35\xd6\x9c\x82\xf5\xe0G\x97\xa9g\xc9A}\xd4\x127\xe1\xe9\n[w\x02G\xb8>\xf6\x86_\x85\\~ `\xeb\xd3\x05\xf5!\xa7\xb6\x98\xb2h\x0b\x1f\xb0#\xc7\xca\xb1\xe5a\x08-\xf4\x9f[F\x1d]\xd2G\xfe\xf1\xf2\xc5//\x7f\xf9\xaf\x00\x00\x00\xff\xffPK\x07\x08\xff\xb8\xf7\x7f\xa6\x12\x00\x00w4\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cV\xcdn\xdc8\x12>\xb7\x9f\xa2 \xc0\x98\x19\xc0\xf0\xdcs\xf3O'\xe9\x8d\xedn\xb8;clv\xf6P-\x96\xbbiI,-IE#\x0dr1\xc6\x98g\xd8\xcdc\xe46\xc8-\xad\xf7Z\xf0G?6\x10`NR}\xfc\x8a,\xb2\xea+\xf2\xf7\xa3Yr\xcf\xba@\x9b\xbc\x82\xdf\x8ff\xb3d\x8bJ\x91H^Ar~vs3\xbf\x84\xf3\x7f\xc2\x0f\xc7\xe6\x07x\xbd\xbc\x85c\xe3?\xbf&\xc7\xe6\xd7$9q|A9\xd9\xe0p9\xbf\x9aoF\x8f0.\x0b\xdc\xd1\xe5HZ\\\x9f\xbd\x99\xc3\xf7\xa9\xeb\x92eNzJ^\xaf\x96\x8b\xab\xf9\xedK\xba\xa2z\xc5\xc6\x9a\x85\xda\xec5\xa1w8\x16\xa0\xa8\x86\xd2\xe1 \x15X?r\x1a\x1c<z\xa6\xc4\xc2\xadc\x96\x85\xb41\xa6c\x11<~4?\x01*\x01\xc7\x02|,\xce\xe6H\x1bg\xf8\x8e\xe3sb\xa5w$\\x\x8e\xb6Z\xae7\xb0z\x7f\xfb\xe6\xc5qN\xcf\xd1\xecQp}.\xfb\xd3_\xbf=\xbb\\\xde\xc1\xf9\xe2\xef$!l\xf3\x8a\xd3l\xc3\xbb]\x1ef\xd8\xbc\xbd\x9d\x9f]:\xfa\xb3c\xab\xd4\x98\xe2\xf77\xcf\x93\x1c\x18\x1f%\xd5$\xd6X\xd0b\xd5\x87\xbf\x86\xe5k\xd8\xbc\x9d\xc3\xfa\xecz\x0e\x8b\x15\xdc\xcdo\xe7\xf0\xcbb~7\xf1>\x9a}:\x89\x05e\\=y\xab\xcc+\x8d\xb9\xe9\xebK`\x93\xbc\x82\x7f\x1d\xcd\xdc\x7f+\xa9\xfb\xc3/:K\x84\x92\xc9\xd1l\xf6o\x1f\xc2\x9e+=\xd0v,Z\xa9\x0e\xff\x8d\xc4`\x8e\xdcB\xaa\xca\xd2\xc0\xf6\xe6@\xf6\xd6\x84\xcb\xca\xee'T2\xf2\xf0\x94\x0e\xdc`\xd2\xc87\x94\xb2\x12\x83C4O&\x86\x19\xc9\x0d\xe1\x18\xb4\xe6,\xf2r\x0c\x01\xc4\xd3\xf1\x05\xd3\x9f\x06\x8aB*w\xc6g\xfe\xc7O\x83;v\x88\xa5\xa2\x8a\x80\x8f \x91\xbd\xc5\xc1C\xb1j\n\xaeL\x80SVVcjCq;\xc2ED B\x13\xb9\x9e7cv\x836\x01\xf3\x1c\xb6\x0d,V\x81G\xbf\x95q\xd9\xa5\xad\xbf}\xd1\xed\x14\x1e\xd7\x98{\xfb\xd9\n{)\xc8\xd7V\xa6\x9b\x87(l\x1fp\x1d\x8c\x07T\xd2\xb2\xf6\xee\xd7$\x05G\xb82\xf6\x86k\x87\x96\xba%\x01\xe9\xbe\x96\xf9\xe1)\x8c\xe6\x9cf\xa1d\xe3_\xcc\xa6 \x8d\xc3d\xbd\x15\x06y\x94i\xc9\x85\xab\x1f\x1b\x97\xe2ZQp9g\xd4\x02\x96\xce\x8e*$:\xcbs_\xf4\x9ca\xf7\x15j\xd36\xc6f\x92z\x95\x86\x10\xc3h\xc4B\xc7\xf2\xa2-\xf9!\xef\xe7\xb2^\x8ak+\xd3\xac\xf1z\xf46\x98\x00D-\x8e\x1b\x1b\xfe\x07\x0dN\xb3\xe4\xfe\x86\xe44\\9\xec\xc7M\xf3S\xaf9\xd3\xa84\xd6^\xc2\xa2{<<\xa5-\xab\xb8a\xabk\x84\x80\x91\x1a\xb6\xd2\xa8t\xafY\xc9\x16\xd3\x07\x0cX;\x82\\c\xef\xbe\xd5\x98\xc13\xbaS\xaa\xab\xf9\xc4\xca\x82\xfaZN1'%&\x12X\xdb&mG\x81_U\xb6\x89\xbf\xd7\xa8[\xeae\xf7\xae\x96\x94N\x1a\xc15>\xc4\xbf\x8b\x96\xb4\x1b\xec'\x90\xe5h\xac%\xe9r\xe2u\xa7[2\x13{\x85\xdd_\xae\xbfh%\xb3\xc1\xdfX.\xb1\xd7\xef\x1b]\xc5\x064(\xb8&\xca\x86\xf0o$\xb9\xf1\x1c\xfb\x19Yy\x04\xbbG\xea\xa7\xbc\xb3\xac\x07\xa3\xfb\x9ff\x81C\xec5j;\x8c\xad\xe4\xe1i\xb4\xd6\xbce\x8b\xd3\xaeP\xc9\xe1\x02fk\xb9p\xf9\xbdA\x10\xdf\xbet\x8f!\x0b)\xaa\x94\xf2 \xec{%\x1fz\xd4b\xce;\x07\xbf\x8b\xbf\x01\xcf\xa5\xbb\x0c.F'\x07\x80e\x88\xf3\x9cL\xba\x81S\xc1\xa4\x15l\xbd\x1d\x08\xac\xbc\x98\x17E\xc9\xda\xba\x9a\x90\x04-f\xdc\xfd\xe1\xea\x8b\xc0TYJ\x86\x8aSX[\xcd\n\xa1ec=\xcb\x92\xc6\x16Xt\x9fkI\xddWV\xb1\xc6\xeeeN\x1b\xe6+\xd4;?\xb5\xb3\xc12C\xee\x91\xc8Q\xd2\xec\x83.>\x0c\xab\xc5r\xdc1;]\xb1\n\xbb\xcee\xa6\xe4\xc3	t_i\xdb@\xdd\x98\xb6\xca\xf0\xf0'\x94\x92\x0d\xa9,\xde\x04\x89\xf4;\xb8`\xad\xab\xd2\x8e[\x82{\x949\x89Sx\xed\xc2H\xe3\xf0\xc4\xe3\xf2\xd9	\x80\xa9\xd2\x94\x8c\xb9\xaf\xf2S\xd8\xec	J\xdc\x11\xd42\xcfAq\x0d\x9ar\x1e\x9e\x1bR}\xc4\\\x8a\x0b,m\xbaG\x9fPI\xa5\xc6Z\x8a\xee\x91k\x844\x8exvFT\xfa\xf3\x97j\xf7\x9a}7yGT\x82\x88\x18\xdc\xf7\x8d-\xc7\xf0\xaaX\x1a\x8bV\xc5[\xc1\xf5\x8e\xf1\x19\x14[Mx\x16\x80\x1b\x1bY$6|>\xd4\xd8?\xc8X\xea>\x83B0X4\x05\x08\xcec\x12\n\xd7\x9c\x7f\x91F\xb2\xea\x1f2\xbe_\xc3G\x8fERe\xec5\xdat\x1f\xba\xa2@E\xb0G\xd3=\"\x14\x95i\x0fO\xb0m\x0e\x7f\x82\xc5L\x92[!\xce\xad\xa8\x1e\xa3\xbd\xe1\xba\x01K\xee\x11\xea\x07K\x96\xca\xba\xda\x1dJ{\xe5\x10W\xbc\x17\xd3\x1a\xf77\xe9x\x17\xf9\xc7\xe0\xcf\xc1\xfey\xb3\xb9\n\xa4\xffT\x1co\x81\x0f\xae2\xbbG\xb7\xdb\x16\xd3\xc6Ws\xec\xc3\x9a\xd0\x84[\xf56\xfcETHM\xa9=oB\x0f\xbe\x8d\xf6\xcb\xbbr\xe4\x8d{z\xc9\x0d\xa9\xe8\xf9\xf7\x9a\x8c?\xb1e/\x8f~\xa4\xcc\x9b\x80\x97\\K\x12\xdd_\xc3\x00k\x9f\x82\x0f\xbb\xee\x91\xbb\xcf=l+\xad\xc2\xe6k\xfd\xedK<@]\xe5\xe1H>\xa0A\x11\xf7h\x08uH\xd3]\xd0\xc8C\x8f\x1b\x97\xce\xf9o\xa5\x8c\xaf\xee+\xdeI\x05\x11\x07\x8a\x03\x81\xbc\xe7\xfa\x86\xadL).*Qp!'\xb7J\xb5-d\x08\x14m-I\x0f[\xb0\xfb\xaa\xd8*\x94\xb9\x0c\xc2\xbd\x96J\xa2\xadt\xd3\x86\xb6rz\x1auc\xb9LB\xf7\xdb}\xfb\xa2{\xfdV\xaao\nC1\xa2iAI\x1a\xdbQ\xe3_\xe3=\xbfve9f\xe4}\x00\x9ee\xa2*\x9d^\x9d\xf4=\xc3[\xe0\x1a\xd2tx\xa5y\xa7\xc9\xf8\x03uO\x13W\xdc\x8a\x87p_,s7]\xe4h\xf6\xe9\xe8\xd3\xff\x03\x00\x00\xff\xffPK\x07\x08\xb2\xb7\x93{\xdb\x05\x00\x00d\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xde\xbfsf\xbcz\xddn\x1c7\xb2\xf0\xb5\xfd\x14\x85\xf9\x90O7\xb3\x92w\xb3\x17\x0b_,`I\xb6\xa3\x8d\xf5\xb3\x1ey\x85M\x90\x05j\xbakf8\xc3&;$[\xad\xeeEn\x8c\x18y\x06\xc3\x8f\xe1\xbb\x03\xdfY\xf3^\x07E\xb2\xbb\xd93\xf2&8\xc89W3\xacbU\xf1\xa7\xfe\xd9\xff~\xfch\xb2\xd0\xa6\xb0\x93\xa7\xf0\xef\xc7\x8f\x1eM^<\xfb\xfb\xe4)|\xff\xf8\xd1\xa3G\x93+T$A(\x9e\x80Y\xb3V\xcdd\xea\x117\xa5\xb0\x0d\xb4\xb5\xb8\x7f\xd7\xa2\"hA\xe1:k\xef\xdfo?d\x82\xd6\xd0b\x8e5\xaa\xa6\x10P6\x0e\x95\xc0B\x80\x00\xa1<\xa8c\xb8\xc6BL\x1e?z\xf4\x033\x9d\\\xcc^\xdc\xf4\x92/\xb4\x83\x19.\x08^h\x037\xdal\xa2\xe0c\x8d&\x07\x94R\xd7\x16\ntd\x04\xca)\xb8\x15:@C\xa0\xb4\x03\xcbtN\xc3\x9c\xe0VPM9\x08\x05\x08\xb56\x1b u+\x8cV\x05)7HFYcc_\xe9l\xd3\xcb\x7f\xe6A\xc00fu\xac\x9d\xd3E\\\x83\x07\xda\xcch)\x85Z2\xba\xc4%\xc1\xdc\xcf\x01\xba%\x05\xf5\x8a\x148\x9c\x83\xb0\xb0\x12yN*\x91\xa64\x9f\x82\xa5AX\x0f	\x02N\x85-%6\xbcM(\xb5ud,\xa0\x85@\xa8+\x9b\xf0\xaar\xa1\xff\xa1eU$\xdc\x18\x06\xb7\x01\x18\x18\x86\x19\xa0\x17\xe0	\xf8<\x8a\xca\x8a\x0cP\xe5p+r\xd2\xc0\x02\xc9\x8cX;\xbd\x14\x8bd\x91\x82\xcf;\x87\x97g/\xe0zU\x15s\x85B\xda(!b=\xd2\x0d\xc8\x9e\xdb|y^\xb9a\x8d<\x80c\xcc6K\xa3+\x95\xc3?x\x0d\x91\x95\xc7\xb9\x15\xc1|\xc0\xfb5\xa6\xdc\x02A\xc7\xee\x0b\x9c\x9e\xe5\x85P\x7f\xb0%eb!(\x87\x82\n\xb2P\x8a\x92r\xb0\xce\xa0X\xae\x1c\x08\xe544\xba:0\x04\x85V\xc2i\x93\x08bu{~W\n\xd3\xf4\xc2NZ\xb4P7K\xdc~P\xe2\xfe}&\x10\xf2V\xe0\xf6m\xd5\xe9\x87\xc8\xda9B\xae\xc4\x14J\x0d\x1b\xf7\xf9\xa3i\xb2U\x9c\x05sjA\xe7\xa5\xae\x05\xe5\xad\x80V[6\x10\x82\xcaV\xcc\xcf5;\xe2/0\xb9\xdc\x0bl\xeb]y\x01\xa6tMK\xdd\xa1\x0e\xe1\\o?\x11\xb4X\x0b2x\xff\x0b\xe4\x1a\xfe\xf8\x04\n\xdc\xbe\xe5\xb5H\xe1\xc8\xc0\xf6-fb\xfb\xb3\xdd\x88l\x05\x02$/\xfcpG\xfc\xb5pr\x90\x7f\xdd\xb8j\xfbvg\x01\x118^\xc1\x142\xbd\xfd\x00k\xdc\xc0\x01kG\x05\xff\x1f\xceQ-\xf5\xc1!\x9c\xf2Z\x9e@\xabp\xf3\xf9c=\x92\x98\x0f\x07}\xcc\x83(\x81\xee\x1c0\x92u\x98u\x83\xcdb \xcb\xb0t\xd9\n\x87+\x8a\xe3@|\xa5K\xc3K\xa9\xb6\x9f\x1a\xb7\xd1\xb5\x12\x1b\x04\x0duS\xd2\xf6\xad\x12\xc4\x87\x1f84P\x9a\xb6\x01\x87\xfe@LKY\x8b\xd9\xca\xef\xc1\xd0\x9aXg\xb25\x82\x00Wk\xd3zBG\x05\xbajo)\xd7\xb8\xb4\xbb\xcb\x01\xc7\xc0\xb8!\\Z\xf6\x1cVW&#Xh\x13\x97\x80`\xb5\xac\x9c\xd0\xca\x1e\xc2\xa5\"&b/bP\xe5\xba\x90\x0dd+mIy\n\xe2\xc5-I\x91\xf1\x86\x19\x19\x1c\xc2k\xfa\xb1\x12\x86r\x16\x90i\xe5\x90\xfd\x9f\x03Ih\x1d|\x0d\xb9X,\xc8\x90r~A\xc9\xe9g+4\xb3L\x9b\xe1\xbaOVh0cU\xb1%\x16`=\xb2\xb3x'<\xb0&oGz\x01\x85\xce\xc5\xa2a\x9f\x88\x90\xf5\x94\xde\xf9\xf2}\x1d\xc2\xb3\x05\x03\xe8.#\xca\xbd\xeb\\\x11HQ\x08\xe7\xffU\x96\x0c\xd4BJP\x14\x16o\xb5\xbc%f\x16w\x96,\xb5\xb2N\x17'\xb3Y\xbf\xd4\xb8\xac7\x96\xcc\x1frZ\x08E9\x9c\xccf`*I\x16\xa4\xc6\x9cr\xd0\n\x9c.;%\xb2$)\xe3\x93s+*\xe8\x01\xe6\xd7z\xb9L\x94\xff\xc4\xc3\x99kw\x8b\x1e\xcf\x0bg\x96\xd9\x80\xeeY\xe5\xb4\xc0J\xbat\xa1\xa7\xbah\xb6\x1f\xa4j\xc0\xbaFv.?\x02\x05\xb1Q\xe6\x9a\x83\xa7\xc7OaM\xdb\x0fR\xa4\xda\x0b<\xadn\xe6\x82LK\x1cTi\xa9\xf7D\xbeB\xb5|@\xe6\xfa\xfe}\xdbl\xf6\xa52{/4NH\xd8Y\xde\x97\xc1\xe5p\x10\xa7d\xabY\x84\x05N\xc9\xacCm\x96 \n\x0e\x89\x96\xd0d\xab\x84\x97\xb08\x97\xf4Z\xcf\xb5\x1b\xcc\xe3\xcap\xcct\x90\x19\xac%\xc7\xa0\xe9\x08\xcc\x81(D\x9e\x9a\xe6V8\xea'N\xc1V\xd9\x8aCc\x10\x04\xa4\x96B\xf1\x89\xe4t\xe7\xf1\x0b\xa3\x0b\xc0,#k\x83\xbe	\x0b\xde\xab\x1d\xee-\x8a\x15\xc7g\x17\xc3\xc2N\x03\x06\x94V\x7f@\x8e%\x81\x162C\xc8\x16:^(\x07\xe8\x86\x85\xe9\x8aW]\xa2qA>\xab\xda\x81\xa7?\xe8\xd1\x1e\x11\xf8\xa8%(\xaa\x03\xeb$b\xe6Z\x0d\x07\xfeB(aWp\xc5^/\x08=\x91\x9a\xb5\xae$\xb5\xe3\x0b\xbd5\xceQ\xca\x9e\xf8r\x086\xff\xef/\x1e\xd3\xc5)\xeb0\x0dE\x1b]\x90\xca\x9b8k?\x84\x14\xb8\xb1M\x81^_\xd8\x7f'\xa4\x02\xfe\xf4\xe4A\x8fN\x05\n\xf9\xdc\x98~5\xcf\x19\x00\xcf\x8d\xd1\xfdMG3\xf2S\xf98\xc8#\x1f`r\x8eB\x8e\x19E\x0e\x9e]`\xf0\x00\xd9\x15\xdaD\xdb\xd0\xdaZ\x9b|\x9f\x12\xca\x88z\x88\x856ng\x0b\x96\xcc-\x19(\x19\xb3\xcf+\xc1>\xc0nV\xcd\x1f\xe4\xf6eF\xb6\x9a\xe7\xba@\xa1Rnw+b\xef>\xb0\xea\x00\x81M\x87\xff\x0f\x16Iw%\xaa\xfcY\xa2-\xcf=\x04\x9eI	gL\xd2]R\x84sN\xeaY\xd9C\xb8\xa1y1\x85\xab\xd3\x17>\x8f<\xbf\xfa\xda\xff6\xba2\xa0\xeb\xa0\x97\x9c\x94\xab\x03\x07\xb8XxW{\x08\x17T\x07L\xe0\xe2\xb3v\x94VCX\n\x8dN\xff\xae\x1c\x9d\xfb]z\xd8~\x00\xba\xf4\xb1\x92\xa3\xc5B\xc8\xc4\x89/\x88r\xce \x83\x9a\xf4\x89'\x1f\xe9\x86c\xe2\xc6\xe9\xbaK/v\xa0\xac\xc6\xeb\xbcZ\xdf\xbf\xcb\x1a\xb0\xe2\xfe=(\x84\xe5\xe7\x8f\xecm\xad3Z5\xc9\x1a\x17r\x14\xea\xd9\xbaM\x03\x01:\xed\xdc\x88O\xe7C*\x0fY:\x85#\x12\xc3\x13\xc3_h\x93Q\xce5A\xcf\xf5\xa6)*\xdbj\x85\xbe\x06\x10\x85\xae\xf5\xf6\xc3\xfd/\x91\xffM\xb3}{\xff.\xe3\x1a\xac\xad\x9b4P|\xfeXO\xc1\x19Qn8u\x12\xc0GA\x10\xce\x1fS5X\xa2\x94d\x9as\x9d\x0f>\xe7e\x80\x81\x07\x06I\x97J6`W:p\xb0]r\xc16\x1bn3\xe1\xa8u\x1a:_\x86\xe14\x19|A#c\xb9\xd4\x11~\xe3\x87O\xe1I\xef\xf7\x08M\xac\xa9vOn%r:\xe6p\x98\xa7\xe4\x04\xa7$\x89\xe3\xc7\x95\x9f>\x1d\x10]\x81eC\x01\xb9\xc2[\x829\x91\x82<P\x8cY\x8f\nTO\xcf\x10\xbe\xc1#\x94\xf2(e\x1c\x9c9\xe7WK\xca9@\xf5u)\xe7m\xbe\x12\xedC\x83'\x86\x82\x1cz\xaa\xb1\xcc\xd7\x94U\xc6\x8a[\x92C^\xdc\xc3\x82\x1d\xad\x04\xe7R\x0fn+\x16\xc6\x86J)BF\x85\xe9\xd1\x81I\xb8\x8f\xc4\xfa\xfa\xce\x8e7\xebvk\xbe\xbeL\x85\xefg+]\xff\x00\xf3\xca9\xad8\xe1+%f\x14\xd2\xac\xfdZp\xa5\x8dhYs$_\x88H\xd2\x94oz\x0ct\xa8 \xea\xeb\xbfd+T\xa0Xo\xe5R\xe0\xc0L\x0cw\xfdJ/E\x17\x93\x93	\xace\xdfhv\xae\xddD\xef\xd7\xc0\xc3\xc0\xbb5;\x04\xf3nSA9KC\xb7\x82jo\xa6+\xcfc\xcc\xf7\xb5\xd6\xee\xf2\x96\x8c\x11\x89\xe1\x04\xf6Fk\x07\xba\xc3\x05\xe6g\x0b\xf6\x8ePs,w\x1aV\x89\x1b\x0c\x99\nX*\x91\xb3y\x90:\xf39\x06H\xb1\xe1\x14\xf8\xe4\xf4b\n\xa4\xd8\x81\xb0\xd2,*)\x83\x08\xccsC\xd6\xc2\x8a\x0c\x1d\xc2+b\x1d\xa6\xa2t\x0dK\xe0\xcc\x94\xa7\xc7\x9c\xb0\x9b|\x08\xcf\xef\xb0(%=\x85\x83\x95s\xa5}zt\x14\x9dzA\xcb*C\x8e\x18\x07;{\x1dW\x06a\x93\xbf\xa5*(\xc3M\x02\xaa\xb0\xd7\xdf\xb3\x0c\x10\xc5(@\x9c\x15I\x80\x08\x83>@\xf8\x03\x1e\x87\x08\xa1\xa4P\xf4B\xb8q\xf0K\xb4!\x06\xbdxGB\xd9`\x08\x04%\xfa\x02*\x848\x95\x83!+Z\xf2\xe9\x9d\xc9cW\xc8\x92\xf3*<\xc8\xfb1\x1f\xc2\xfe\xd9\xdfO\x8f\xa3\x14\x86\xff\x87\x18\xbdF\xdf\x95\x18\xcc\xf1o\x1d`\x9a\x0e\xfb\xdc\xf2\xec\xd4\x1eB7\x072T\xa0\xd9o\x07\x9f\x16\x1cCr\x842\xad\x13\xb8h\xa8\x86\xac\xfed\x85j\xc9\xf9\xb4#\xb3`\x8b\x96\x1d~ \x17j3\xac\xec\x95P\x1b\x01-\xd5\xea\xfe\xbd3\xad\xea;Z:\xc7\xf5\x14*[m\x7f\x06Y\xcd\x81\xf2\xc6Uk\x90\xbb\xf3\xa1\xf6UO\x05\x7f\xfd\xeb_\x8f\xfe\xccF\x8fG\x83\xb0\x02m\xa6]\x12\xcc\xfd0\x88\x88Id\x98\x02y0\xe3]R\xaf\xb5=}\xaf(|\xea\xd1Z\xd0\xfa\xfb\x8d\x92\x12rW%\xda\x7fmh\xfb!\x13\x90K\x84\\\x1bm}W%\xf2\xbb2m\x03\xa5 S\xdb\x96\xd6P\x8b\xb6\xc9\x04\x1f|\xe8{V\xeb\xddN\x84\x0b\xdc\xb8\x9a\x1f3\xe4\xbc\xc3\xd1:$\x1d\x82\xd2\xe5\xdc}\xe3\x0d,9y\xb6\x9f\xba\xb1z\xa3\xfd\xd2\xf4\xdc`\xbb\xc1>\xc3\x89\xa9;\xf6s\xee\x7f\x81\xd2\xb4d\x9b\xed[T,,P|\xfeX\x8f\xe4\xccDK;R\x8cn\x0b\x81\xa6\xfa\x92\x8c\xa6\x9b\x01\xb5i\xab\x0c\xb9>\xed\xe6B\xdd\x18\xdc~\xd2\xaa\x81\x1a\nZ\xe2\x1c\xd7\xe8Ru/\xf0\xeeF\xe4n\xb5#\xd4\xb6d~uo\xfd\xa4\xdf\xb49ZV\xd7\xff\x18\xb4)\x0c\xe3\x1dr\x0c\x08=\x97\x10\xcc\xbb6b\x16z\x91\xec\x0c\xbcw\xf7\x89\x9d\xef\x9d\xee2\x9e\xad\xb4qY\xe5\xfe7\x04\xe8\x9c\x0c\x8e|\xc2\xf9\x00\x9a\x8e\x01c\xbf0\xcc\xf3\x9e!u\nS\x98\xa3\x1a\x1a\xce*g+b\x0fVq\xbc\xea\xe0\xf3\x86\x0dD\x18(\x14\x15Z\x89\xcc\xf3\x1d\xd6\xa6\xd2ve\x1a\x92\xcf\nq\xff~\x1a\xda\xa1\x04\xf3\xfb\xf7y;\xf4\x1d(t\xde\x94\xae\xf9\xb2\xf6\xf2SEu_\xb7u\xbc}!1.\xe6\xc6\x04\\\x16\xa7\x93\x93\xea\xf9\x92\xebf\x15+\x91\x84J;\x91\x0d\xab\xbf\\n\xdfj\xeb\xfb|\x91\xee[\xf3\xf9\xa3\xdb`\xf2\x82\x01\xa5\xde`\xcb[\xc0\xbd*a\xccx!BD\xef\xd9\x9f\x92\xdd8]\xc2E\x82\xecn\xef%9\xc8#>%\xb6\xe1i\xe1\xc7JsB\xcb\x97\x0b\xb6QY\xcd&\x04\xc2\x02\xceu\xe5|\xf0qhF;\xabY\xe3\xd2d\xebB\xd7\x10ap\x8cJ\xf5\xd5\xe7Ie8\xb8\xc9\xa0w\x8c\xb6Z-9\x052Gx\x94\x0b\xcdr\x89\x93H\xd6\x11\xedV\\\x9e:CXt\xe7\xe2\x13\x17\xa1\xbc'\xe5\x1d\xcc=\xfbDI\xb4\xcc\xf7\xee\xf3R\xe6_\xbe\xcf.\x91:y\xa0\xbd\xda%`\xd0\xf5Y\xaf\x87\xda\xeb\x1c\xcb\x92w\xa0\x17}N\xae}\xdbs\xc7\xdfwMW\xdf!\xe4q\xffh\xb0\xdb.\xd2\xb5\xa2\xc4\xee.\xc3p:\x0c\xc6\xf6\x16\x1e\xac\x02Q(0|\xea\x16zR>#\x97\xb2k+i\xe5\x8c\x96l\xa4\x95u\xfb\x9d[\xad(YG\xb9{~W_:;\xd6\xf1\x93\xd8\xb2\x1a'ql\x11}7\xeb7%s}\xcb\n{\xf3\xf9=\xf39\xe6w\xe6\xb3\xb2\x90y\x0d\xd9\x92\x07z\x13\x06N2\xf6\xf2\xf68\x81\x13\n\xcaC^V\xa9<f\xcb~\xc8(\xbe\xdeL\x8als\x087lG\xb1\xf7\x97OA\xe1\xadX\xa2\xa3\xa0 \xab1'\xa1\xac#L\xb5\xa04\x95\xa2\x9dv\xe1\x1b\x9f\xdd\x84\xe7\x8f\xae\xb30\x82\xf9\xa7\x9f\xed'\xccU\xe7\xe4>\x7f\xacA\xe7p\x01\xb9\x12;\xcc\xafW\x86p\x8f\xbb\x7fn\x183\x0f\xa0\xdf\xcc\xbb\xa9z\x963Y9 \xc5'`\xfaD\xcdwkl\xd2\x006\xf3k\xba\x1b<\xe9k\xca\x8f\x8eeE\xe0\xa1\x91*d[`\xb8\xd8U9\xcc\x19\xef\xe8\xce\x81X@\xf0\x08\xbe\x7f+\xdc\n\x0e\xfee\x0e\xd8\x81\x1c\xfck\x9eT\x18\xbc\xd7K\x95\x14\xb9\xd7\x8d\xdch\xc85\xe8<k\x1b\xd7=5\xf5\xbd\x8eBo?IQ\xc7P\xaf\xad\xd35\xaa\xb404\xb4\x14\x1c\xb1\x92\x85G\xc0\xae}\x18\x92\xe8\xc4-]\x8b$r\xbd\x8e@`\xa8uX\x94\x9d\x91\xf7\x18\xaf\x1a\xaeGsQu\xf8\x14\x0e\xfe\x08+]\x19\xc0\xa5\x1e\xed\xaf$L\x0f\xd1\x0f\xf7WR\xca\xe6\xf5(\xaf\xfb\xfe5\xc3~`/\x10\x10\xd1\xaf\xe9[\x02\x8f\xea\xaa\xee\xa8\xb6\xc6[\xaa\xafS\xba\xa7\xb2Q\xbe\xce5\xe3\x9b\xd7\xaf\x86\x95p\x0d\xc9\x80\xe9h\xd8\x11\xfb\x8a$\xb8\xc0\xe1Yi\xa1\x0dX]\x8c;8P\x1a\xcd\x99\x8b\xf1\x16Tk\xb3I\xcc\xc5?\xc2\xf42\xbfC\x8b\xfd\xd3^\x18t\xaf\x86C8\xe5R@o\x94 hy\xc6\xc0\xcb\xa6)\xfcl(VN\xb5\xef\xb2\xcc\xab\xa2\x0c\x81\xc7\x1bPw.\xc9\xb3O\xf0\xb6\xb1\xf1\xd6\xbb\xbf\xc3T\x82\x1c.\xe0\x98\xdaRP\xd6rv\xf9\xf9c\xd7p\x9e}\xfe(\xa1\xc5yD\xe2\xfa\xfe]\x86}\xab\xed\x10.p-\xa9\x14\xb4\x9e\xc2\xf6\x13\xcd\x1b(x{\x08\x99\x06\x85\xebB	Z\xc3\x9fC\xbf\\\x8c$W\x19)\xd4\xc9\xfe\xaa\x8c.\xb0{\xd1\xee\xf0\x87\x99.\xbeP-Z\xb2\xec\x16w\x1e\xado\x92\xf7j\xa6\xb1k\x11\x1a\x9e]\xcc\xf7\x8f\xda\xec*0[\xf9g\xeb5nD\x11\xe6\x80\xbd\x7f\xd7=\xc34Y\x1b^\x9f\xa4^\xea\xda_T\"\xbb\xd4B\xa6\xd11\x94V\xb3\x0e\x9c\xfa\x988w\xafqh\x1d.\x16\xe3G\xe7\x19\x83 \xc0\xc6\x0e\xc7\xf7\x9a=\x058FwIGh\x8c\x11\xe6i\xd6\xcc\x0e\xe9\x01\x1f\xe3h\xd3\xe7\x85\xbd{\x19\x8a\x07\xae\xdc\xa4\x18W\x0e\xe1\xbd\xb0g\xe3GQ/\xfc\x93\xa2\x7fx\xdcyT\x0c\xea\x18\xae\xe5\x1c\xef\x86\xc4\x1d\xefD\x81\xb2SW\xf2\x13\xbc[\x89,/\xaabN\x86\x957\xc7\xc6z'\xca9^\x17~-\xcci\xa1\x0d\xc7\xd3\xc8B\xd8\xfd\xfe\xe5H\xba\x18r\xd0s\xa1\xfe/\xa4\x8f\xae\xf3\xa1o\x18bb=\x05]\n\xabk\xf4\xbd\xeca\xd2\xc0\xc9W\xf9Q\xa1\x1eV\xb3\xc81\xf6\x03z5\x0b\x8a\xf1\xc0\x83\x19\xe7'\xc7/{^\xf1\x91w\xf8\xa4d\xcc/>\xf2\x86/~\x869;\xdc~s\x9f\xe1!\x16>\x99\x8f\xe1\xffRy'?\xd8\xb1O\xf4\xc3Q\xfb\xae\xa4\x0f\x19]\xbe\x16LTd(e\x03\x98\xe7\x89\x0f\xf4L)\xdf'\x1d\xc4\xd2\xbc\x18\xf7Ioh~\xfe+mR?e\xafK\xda\xc7\n\x0b\x0f\xf6Zc\xd2\x91&T\xf5\n\xdd3\x95F\xe0\x9b\x1e\x12D\xf2\x0c\xe4\xf1\xe1\x12\xc1\xff\x01\x9b\x19\"eW\xda\xed\xb9A\x0e@\xa3\xe7\x8c\x1bm6P\x0co\x19\xdf\x88\x9cl\xff\x08\x15\x8a]^\x94\x0d	\xeb\x83\x17\x13y\xee|\x16\xf0;pnP\xe54\xf8\x84\x7f\x86\xe14\x19\xec{\xfb\xc7\x8f~\x9a>~4\x89\xfd\xcd\xee\xf3=\x15\x9e\x8d's\x83\xe1\x95\x7f\x12\x0e\x89a\xb9.\xd1Vk\xce\xaahcP\x05\xe3\x9b\xd4\xb1\xdb\x92\xe2\x93n\xcb$\n\xb2\xda8\xde}\xe7\xdd'\xc7>\xd2v\x17\x14B\x08\x17\xee\xceG\xb7\xe1q8AW\xdd\x873!\xeet\x9fJ\xed\xce\xed\xc0I\xcf\x86\xcfi\xe2\xb0\x7f\x94\x98\\.?\x7f\x94\xb1\xc18\x99u_RLn\x1a\xdbV\x1bQ{\xc7\xed\x19\xc4]\xce6\xecb\x9a\x9e\x15\x15\xa5\xe4|\xbf;\xb89\xaa\xab\xd4h\xff\xa9\xab\xe4A\xc8W\xb0yh\"\x1f}e\x8f`\xde\xc0W\xd6gB\xbe\x1d\xaf\xa5\xd45\xd7>\x86\xd0j\xf5\xb4\xbb;\x8eOsT\xa1\xfe\xf1\xde\x95\xd8J\xbe\xb2\x9c\x02\x0b\xfes\xd8\x19o\xda:\xf6a\xcd?\xfcNC\x8c;\xbbb\xc7\xfaUl\xb4\xc4\x1b\xa9\xc4\xf8\xa3\xcd\xc9Y\xd7\x96\x88\xc7\x12+QF}\xab\x95\xd3 \xa0E\xd3\xde\xbf\xcb[\x7f>\xc1\xbdb\x11\x8f\x1ds.\xb8B\xb36B\xca\xe0|&\xdf\xa1\xab\x05\x99|\xfb_\x11a\xadX*\x1f\x98\x19\xfd\xcc\x0fC$\x0e\x13\xe6\xe8U\xee\x18U?Vdf%e\xd6\x13d\x19\x95\xceBU\xf2v\xff\xf4\x04\xfev\xf5\xfc\xe5\x14\xae.^N\xfd7\x84\xda\xc0\xcd\xf3\xe3s\xdf\xb0\x0fQ\x07\n\x0e\x94U\x01\xb9(\xc8{\x11\xcbQ\xe9\xeb'O\xee\xfe\xf8\xe4\xc9\xb4G3\x05\xf8f\xbc^\xf8\xaf\x14\xbe=\xf6F\xa8\xfc\xc7V*?\x8c\xeb\xf1\xdb:\x0e\xae3\xf9\x80l\xf4\xed\xd8$\xf3\x0d\xf0\xa4U1\xf9\xae\x10\xb4\xfd\x19Vh\xb7ou\x9c$\xf942\xc6v\x7f#\x9c\xd0x\x96\xfeO\x80i\xb5\x10\xcb\xca\x84\xda1^\x8c\x87\xf8fR\x1a\x14\x87\xb9\xb3\xf0m\xc0\xeedK\xa6&\xd3\xad\x94\xd3\xd8\x81\xebu\xff\x81\xda\x88e\x8e\xceo\xf2\x14c\xe67	\xb1\xba\xa7\x0bO\xa5!EN't\x01\xad\x9b\xe0\xbdQ:!v\xdb:|\xd9\xf5\xda&ye\xba\xb6\xd7\xe4\xb4\xfb\xef1\xc1\x1c\xbc:<\x8f\x7f=\xbc{\xb9\xef\xd4\x167\x91\xd5\xa2\xca6\x97A\xe1^\xbc9\xf9\x16._\xbc\x08\x88\xa5\xd4s\x94\x0c\x7f\x19\xfey\xa8\x7f\x1a\x9c\x9c\x9dv#RN\xb8\xa67\x86\x08\x96\x92\x96\x81\xf6,\xfc\xf5\x8d\x17RQ\xa6\xd4\x98\x9f$\xda!E\xf8\"\x98\xe1]\x03c\x98)\xd4rO\xc5qO\xa1\x7fM\x9f\xbf\xfe\x1f\xe83\xa7\xe3\xbe\xd7<\xb9\xe1\xd4\xbcZ\xa7\xe0\xf0}G\x87\x81\x96\xa0\xb6mc\x9d\xff\xcc\xb1\n\x0e\x81\xb6?\x07\x92\x9d^\xe5$mO\x86\x19C\xc8\x99\xbc\xb1\x0ek18t]\xab\x0b\xdd\xf7E&\x17\xfe\xdd\xc3\n\xcc\xd1\xc6\xf6D\xf2\x89h )\xa3\xe6\xf4\xed\xd9IY\x99e\xafPW<\xf0\xfat\x94(]\x88\x83\xd7ZK'J\xaf\x10B\x86\xb7\xd1\x90\xde\xcc\x1b\xb0\xd5|M\x99\x9b\xc6\xefFM\xac\xfb\x14\x16\x04\xa42\xb4\xe1\xc3p\xd64+\xd1\xae\xc8\x1eBwg\xafiYI4\xec\xb1M\xa8\xa7\xa2\x9f\x9eXr\xa1Y\xea\xf77#\x17\x9b\x9b\xb6G\xbf\nz\xd0\xa1\xa3Z\xf8\x8c%9C\xbb\xc2\\\xd7<)\xfeK\xa0\xc7>A\x9f\xcc\xfc\x00\xe6\xa2#\x89\x01\xd8\xe3\xb4q\xd5\xba\xeb\xfd\x94Q\x99c\xb2\xdb\x1b\xeb,\xad\xb1\xe2\x94p*\xdeG0q\x846\xca\xfb\xaf\x99CWY(u(\x83\x86{\xe5	'],y\xa3\xc4\x8f\x15\xb1\x9d\xa8\xf0\xfd$fN\xdc\xd2\x91\xd3\x0e%\x87\xaa\x10u<\xa1\x0b\xbd\xa3I\xdf-\x9a\xb8\x90\xe4M\xae\xfb\xcc\xc15e\x80\xf0\xaf\x87T*\xc6\x8f7\xfe\xcf\xe3G?=\xfe\xe9\xbf\x03\x00\x00\xff\xffPK\x07\x08\xc8\xbd\xa6\x93;\x12\x00\x00\xaa0\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cV\xcfn\xdb8\x13?\xdbO1\x10\x10\xb4\x05\x8c\xf4\xde\x9b\x1d+\xad\xfb%\xb1?[MP|\xfd\x0e\xb48\x96\xd9P\x1c\x95\xa4\xecx\x8b\xbe\xc8\xbe\xc1\xeeu\xf7\xb4\xd7\xbc\xd8\x82\xff$9@\xb1{\xd2\xcco~$\x87\xc3\xf9\x91\xfa>\x1ee;\xd25\xb3\xd9;\xf8>\x1e\x8d\xb2-S\ny\xf6\x0e\xb2\xd9\xf4\xee.\x9f\xc3\xec3\xbc\xba0\xaf\xe0z\xb9\x86\x0b\xe3?_\xb2\x0b\xf3%\xcb&\x8e\xcfQ\xa2\x0d\x03\xe6\xf9M^\xf4#B\\\xd4\xac\xc2yOZ\xdcN\xdf\xe7\xf0s\xea\xa6!!Q\x0f\xc9\x9b\xd5rq\x93\xaf_\xd2\x15\x1eWd\xacY\xa8b\xaf\x91\xf9\x01\x17\x1c\x14\x1e\xa1q8\x08\x05\xd6G.\xc3\x00\x8fN\x15_\xb8u\xcc\xb2\x166\xe6t\xc1\xc3\x88\xd7\xe6\x0d0\xc5\xe1\x82\x83\xcf\xc5\xf9\x14i\xfd\x0c?\x19xNlu\x85\xdc\xa5\xe7h\xab\xe5\xa6\x80\xd5\xa7\xf5\xfb\x17\xe5\x1c\xd6\xd1\xec\x19\xa7\xe3L\xa4\xeao>L\xe7\xcb\x07\x98-\xfe\xcd!\x84m\xdeP\xf9XPU\xc90C\xf1a\x9dO\xe7\x8e~V\xb6V\xf5G\xfc\xe9\xee\xfc\x90\x03\xe3 \xf0\x88|\xc3j\\\xacR\xfa\x1bX^C\xf1!\x87\xcd\xf46\x87\xc5\n\x1e\xf2u\x0e\xf7\x8b\xfca0z<\xfa1\x89\x0de\\?y\xaf\x91\xadf\xd2\xa4\xfe\xe2\xec\x94\xbd\x83\xff\x8dG\xd1\x9e$\xcbd\xe3\xd1\xe8\xff>\x81=\xb5\xba#yg\xd2\x99\x03Z-Tk\xb1#Fw2p\x86dRv\xdfs\xbd7\xe9\xed\x01\xd3`I\x8aw\xd4\xe8N\x06\xce\x80|B\xd6\xa7\xea\x9dIg\x06Z,\x8a\xef\x93T\x04\xc6k\xa1\\i\xa7\xde\xf03\xb1\x8a\x1c\xe2>\xc1\xf79\xf8O\xf4)\x0cQ\xcf\x7f*QGVI\xcajV\xda\xd0\xd3.~\x15\x11\x88\xd0@\xa5\xb3S\x7f\xa8A\x92\xc0\xa4\x84\xed	\x16\xab\xc0\xc3\xa7&\xae\x9b{K\xe8!\xde/\x12\xa2gK\xec\x05G\x1f2\xaeD\x18\x07\x86m\xa6=~eJX\xd2~\x8a[\x14<n\xe2kk\xec\x1d\x1d\xe3\xf65\x83\x1aM\xda\xa0\xa4\xf214k\xb4\xe2ir\xd4\xac\x9b*y!H\xbd@\xcfDIG\x85a\xc0\x8c\x98\xe6\xb0t~T\x1f\xe2TJ\x17\xbaG\x0d\x968\x99$\xcb\x90W\xfe$\xb6\xa9\x18&\\Q^\xa5\xd1\x0c*\xf4\xda\xdbXQ>\x9e\xbc\x00\xbd\x0f&\x00Q|\xfd~:\xbb\x13\xdd\xf0|\x9c\xd5\x1d\xcb\x89Z\x87\xbd.\xda7Id\xe6\xa4\xca\xd8x\x19\x17\xae\xe6\n\xcbn\xab\xd1\x15\xaa\x8a)\x9fTy\xe6$\"\xc7\xe8\x8d}CgV\xd4\x98\xba\xb4d\x12\x15\x1f\xf4\xf7G\xa6b{_\xe3!Z\xb7]\xcbO\xb7\xba\xc3D\xb4>\xb6\xaa\xb3d\xe2\xc5\x0e\x1fe\x1b\xb4\xd1Z\xb6\xc9\xba\xa34\xf3\x1c\x7f\xe9uvD|\xec\xf2\x98S\xdd\xcdPE\xab\xc0\xb4\xfa\x7f[\xd6Y\xa2\xe3=%\x8bm\x87\xb2lE\xf7\xf0\x91\xb5T\xbb2\xaf\x89\xb3\xe6\xf9\xb7XI\xa6J\xf4\x9dq\xe5\xad\xb8\xdd\xacd\x96I\xaaB \x98\x01\x97\xc2\xdd\xc2W\xfd0\x07\x80%\x883M\x06ztm8\x10\xe3\xd6\xfb\x81@\xca\xabiQ7\xa4-\x98\xb6,\xd1\x98]+\xe5%\x14{\x84\x86U\x08G!%(:\x82FI\xdd3\xb7\x13\x12\x0b\xa2\x1b\xa6+?\x85\xf3\xc1\x12\x81\xf4H\xe4(a\xf6\xf1\x9d@]\x0b\xc5\x92\x18+\"\xd7\xc5\xa4\xaa\x98\xfd\xb7\xd6-\xa7\x194h\xbe\xb5\xc20\x0d\xaf\x03\xe9\x0d0\xa8\x9f\xff2\xa2\x0c\x15\xcf\x84\xcf\xf6\x8a\xb4n\x1b;H\x7f\xc7\x84D~	\xd7.\x952\x86\x07#\xe6?\xdb\xed?nV\xa8\x03\x93\x82_\xb1\xc6\x96{\xe6\xe7\x08\x08\x94\x11\xf2\xb4G\xc4\xc6\x17Y\xa8\xea\x9a\xbct\xff\x83\xd8\x00\x8f\x18\xec\xd2\xe5!Yx\xb3\x9f\x7f\x95V\xd4\xe9\x1apJ\xed\xff2\xa2\xb0\xc3\xab\x0b.\xd6\xb3\x90\x174\xebZ\xa9\xd0\xec\xc08\x01#\xd0\xc3\xae\xaa\xdd\xe5w/\x8c \x95~\x12\xfc}\x08\x07\x8fERk\xec-\xb3\xa5{\xb6\xb2\x153\xe6H\x9a\x1bp0\xd4\x1e\xf74\x85\xc7>\xb5;:\x10\xd8\xe7?\x1aQ\xc6\xf3lH(\xeb\x1a\xb2\xeb\xd7\x95C\\G\x9e5\xae\x7f\xa0\xfa\x1b\xde\xffZ\xbd\x0d\xfe\xdb\xa2\xb8	\xa4o-\xc5\x9b\xf5\x9e\xca\xe7\xdfaG\x02\x1c\xd6u\x8fFf\xc2;\xb5\x0eVD\xb9\xd0X\xda\xd9)\\n\xeb\xe8\xbf|~z^\xbf\xa3\x97\xdcP\xf5\xc4\xdfi4\xfb@\x0bf\xc4\x1byJ\xbbH\x8a\xd5\xe8\x9a+p\x9d\xd5\xe3\xb6\xd51eKZux+C)\xd6\xde\xf0\x98A\xa6\xe3yD1$\xdc\xb8s\xcb\x9f\x1a\x11\x7f]o\xa8\x12\n\"\x0e\x18\x03\x81\xbc\xa7\xe3\x1dYQb81o\x85H\xbb\xad\x85Oq\x13,\x8f\xda}[o\x15\x13R\x04M\x16\x03\xff\xf22\n\xc1R\x13Z\xb3\x89'\xd1\xaa$\xf2\xd4a\x9f\xa9\x85=; 0\x05}\xd4\xff\xc1\xa6!G\xd7V}\xed?\x05\xe0\xac\xe6m\xe3\xe4\xe7\x94\xec\x19\xde\x03w\xc7\x0c\xc3+M\x95F\xe3\xcb\x17\x10\xe4]\xaa/\x16y\x18.1\x1e\xfd\x18\xff\xf8;\x00\x00\xff\xffPK\x07\x08\x9a	\xb1m\x05\x05\x00\x00\x96\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xde\xbfsf\xbc:]o$\xb9q\xcf\xbb\xbf\xa20\xc1E\x801'i\xefb\xc0X\x03\x06\xf4\xb9'[\xd2\xca;Z/\x0c\x7f\x005\xcd\x9ai\x9e\xd8d/\xc9\xd6h\xce\xb8\xffb#\x0f\xc6\xe55\xc9K^\xf5\xc7\x82*\xb2\xbb\xd9#\xed\x9e\x91\\\xf2\"\x0d\xab\xc8*6\xeb\xbb\xc8\xbf\xbc|1[9\xdf\x84\xd9k\xf8\xcb\xcb\x17/f\xe7G\xbf\x9d\xbd\x86?\xbc|\xf1\xe2\xc5\xec\xc22\n\xa3v\x16Z\xb4d \xd2C\x9c\xcd\x05yf\xa3\xd7\x14`\xe5<\xc4\x9a`\x89\xd6\x92\xdf\x0bp\xee\xe9cG6\x9a-\x1c\x85;R\xf0\xdb\x8e\x02\xd3\x08`t\x88\x80V\x81.(7N\xa1\x99\xbd|\xf1\xe2OLxv\xbd8\xff0\xec\xe0\xdaEX\xe0\x8a\xe0\xdcy\xf8\xe0\xfc]f~\xec\xd0+@c\xdc&@\x83\x91\xbcF3\x87Xc\x04\xf4\x04\xd6E\x08\xbc.:X\x12\xdck\xda\x10s\x05\x84\x8d\xf3w@\xf6^{g\x1b\xb2q\xe4\x8cf\x83\xdbp\xe9\xaa\xbb\x81\xff\x82\x9a\xd6\x13D\x8f\xf7\xe8\xc1:\xf0Na\xfb\xf8C\xde\xc6-\xc3\xc1A\xa8\xbc3\x06\x14B\xfb\xf8\xb7\xb5\xb6XL\x85\x86B\xe3\xa0r\x0d \xe0RP\xab\xce*\xb7_p\xb6\xcen\x1b\x1dh`|d\x9d\xd5\x8d\xfe\x0e}fu\xe5B\xf4\x08\xd1)\x17\xc0\x05h]\x88\xa8\x9c\xa7\xc0\xa4\x1d\xa0}\xfc\x0f\xab\x1b\x17\n\xaa\x9d\xd2\xeew\xcetMA\x97ap\x9f\x80\x89r\x9a\x01n\x05\xb2\x80O\xa9\xe9\x82\xaeDP\xf7Z\x91\x83\xd6\xe0\x96\xfc\x84ttk\xbd\x1a\xc8^i\xab1v\x1e\x03(\x827\x17\xe7\x80V7\xa80\xf4\xdb\xff\xf4\x84\x81\xe8r}\xd5\xc5q\xab<\x80c\xac\xee\xd6\xdeuV\xc1\xefx+=9\xc6%\xad\x1b\xf0\xb2\xd5\x92ZZ\xd0\x93\xfb\x04\xa5#\xd5h\xfbeh\xa9\xd2+M\n\x1aj(@\xab[R\xc0'\xae\xd7u\x04m\xa3\x83\xad\xeb\xf6<A\xe3\xac\x8e\xce\x17\x8cX\x17\xcf\x1eZ\xed\xb7#3\xd1O\x12 D=\x9c\xf5u\xd7,\xc9\xf3Y+\xdc\x06\xd8\xe8X\xbb.\x82\xa5\x8d\x084\xc0\x92V\xce\x13 \x08U\xd0|\\\x86\"\xa9\x1d~\xd7X\x085q\xb38\xb2\xc1$P>!\xa6-K\xf6\xe1\x04-T\xceF\xd4\x16\xba\x96m\xe3\xd5!\x18\xb7!_a \xb8\xc4\xa8-\x18\x8a\x91|\x00\xe7\xc1\xcan\xc3\xfe\x0e\xef[\x1d\xcd.\xf3(\xb0l\x15\xfc\xfb	\xfb9\x84\xae\xaa\x01\x03\xec\x1dY\xddt\xf0\xcfp\x85v\xed\xf6\xf6\xe1}\xde\xcb!T5z\xac\x84\xbfqv=\xe1\xac\xca\xe3U\xdb\x9e\x19=D`d\xcf\x90\xcfq\\Va\x1b\xab\x1a\x87\x95'y\x9c\x85\x1f\xee\xa0\x0b\xcc-\x8a\x85\xb6|\xd4\x80\x90W\x89o\xab\xc8\xcb\x81E\x0cw\xec\xc1\xee\x08<\xad5\xeb\x86\xb8/\xb6\x92X{B\x05\x95'\x81=a\x7f\x8b\xeb\xb0\xbb\x05\x88\x0c\xcc\x1f\x81k\xd9Bp\x9d\xaf(\xb1\xcd\xb3\x823\x9d8\xcf}xk\x89\x17\xb1Rx\xb4\xca5f\x0bU\xed\x02YYAX\xd5\xb0&K\x1e#\xa9\x9e\xc0>\xbc\xa3\x8f\x9d\xf6\xa4\xd27&\xe9c\x04C\x18\"|\x0dJ\xafV\xe4\xc9F\xd9Pq\xe2,\x8bE\xe5\xfc(\xea\x93^:\x10Zl \x082\x9f\xa4\x8dZ\x80\x1b\x12\x8bq+\xf6\xebz\xb5\xd5v\xcd\x07:\xac\x14\x1f\xcc2\xda\x87\xa3\x15\x03\xe8\xa1\"R<\x8d\xa5gt\xa3\xa3\xfcb\xb9\xc0F\x1b\x03\x96\xd2\xe6\x833\xf7\x85t\xca\xadv!\xba\xe6d\xb1\x18\xb6\x9a\xb7\xf5>\x90\xffR\xd1J[Rp\xb2X\x80\xef\x0c\xb1n\xa1\"\x05\xceBtm\xaf8\x81\x0cU|r\xb1\xa6\x86\x9e!~\xeb\xd6\xebB\xf1O\x04\xceT{)\n\x9e7\xce$\xab\x11=\x90R\xb4\xc2\xce\xc4r\xa3\xa7	\x94\x99fB\xfc\x9b\xbf\x98\xf7	\xcb-\xe4us\xd8\xd4d\xc1:K\xac\x04\x81\"#y\xef\x95\xd1\x93P\x96\x17\\\xa2]?\xe1d\xd0\xae;\\\xf7\xcc.\xf3\x10Z\xac\xee\x9eaZ\x12\x0d\xfcM\x9e\xd7\x8eDC\xb7\xc8\xb0D\xaf\x98\xb5\xef\xfc\x1aZ\n\x1f;\x1d\x90\xfd\xfeE\x83k\xb2\x85\xcbW:\xe0\xd2\xd0;\xb7tq\xb4\x90\x1bO\xf7\xac\x90\x95\xc7\x8d\xe1\xb83\x9f\x809\xf84\xa2\xe3\x1bZ\x06\x1di\x988\xfa\x98@\xe8\xab\x1a\xc8\xae5\x1f\x96U\xf4 \xf8\x95\xe78\\U\x14BR9\x1d\xb2w|\xb2)\xd6\x1dqn\xe3\xc6N\x13\x86%\xf0%r\xe0\xc8nz0\xfc\xc9F\x03\xa0\xdd23\xd7\xf1\xae[\xf41\xf1g\x89\xed\xc9\xfa\xbd\x01-\x88D\xc7\xaeG\xafY\x9e\x95\xb3\xe3\xb9\xdf\x92o\xb4E\x9f\xdc]b{N\xec0\x9c\x80\x00\x97\xe4c\x11\x0e\xc5,\x97h\xcc@\xe2\x9f~\xc1C@\x1b6\xe3\x19_r\x86\xc6\xa9@\x82\x0e\xd9]\x9e\\c\xa8\xd9Q6h?\x11O\x0e\x87\xa5\xec\x18\xbf:\x9c:\xf5\xe8\"\x9a\xe2\xa8\xa9Am\xce\xbc\x1f6u\xc6\x008\xf3\xde\x0d[\xcaf%S\xf9lH\x90\xcf\x10\xb9Bm\xa6\x84\xfaT\x95W$\x02\xcf,\xbb\xc1P\xa8\x1e\x86\xb0q^=]	mF=G\xc2\xf9\xb8\xf3	\x81\xfc=\xb1x||\x86V\x81}\x86\xdc\xa2[>K\xed\xd3\x84B\xb7T\xaeAmKj\x0f5\xb1lFR= \x91\xe9\xf1?n\xa5\xf4\xd0\xa2UG\x85\xee\x9c	D{\xb8u\n\xc3\xb0b^ \xd9\x811\x0e\x03\xe8\x84\xde\x87\x0f\xb4l\xe6psz>\x87\xab\x9b\xaf\x81}n\x07\xad\x7f\xfc\xf7\xd6\xeb\xac\xb7\xf6\xf1_\x1d\x04\xfe\x83+\xe2\x0c7\xec\xc3\xb5\xbb\xcf\x19\xafd\x8fB\xae\x81\x88\xcd\xf2\xf1\x87&MN[\xd4\xcaM\xb7=\x91\x8b\x0c\x87\x84\xba\x1f\x82k\x1f\xff\xfe\xf8\x9f\x9c\xf4\xa1G@\xff\xb1\xd3\xf7\x85\xe1\xac\x88\x14g\x99I\xa1zb\xe7\x19\x9a\xc4PD\x1bXM0l\x14J\x07N\xa09\xf4\xb1)q\xc8I\xc5R\xc1\xc4L2\x04\xf6\x08~\x0b	:\xef]\x8f\x10\xe1S \x0fU9\x85\x03\x99\x9cNA\xd0\xf9\x8a\x14W\x11\xe3\x96\x05\x04GRk\xb8\xae ,>M\xe2-\xe7\x90a\x0e\xd1\xeb\xb6r\x8a\x92\x11\xcb\x87<\xc7d\x8d\xc6\x90\xdf^95:\xa67	\x06\x02L\x1c\xdeZ\xb3\x85P\xbb>\xc7\xcd.\x83mYDYRt\xae\x0c\xb1o\xd2p^\x0c>\xaf\xa9\xb5V\x8a\xc6O>\x0b\x95KZ\xf1\x1a\x0e\x07\x0f\xd7\xb4\x08\x83>\xd10eB\x85\x8e\xb5\xb5\xa4\x06J\xdfhEp\x9aRq\xb8\x91c\x98\x8f\x08v\x8d\x89\x9cT\xa05\xde\x13,\x89\xec\xd3\xe4\x9dIO*\\Y\xcf\x10>\xdf\x034\xe6\xa0$\x9cb\x00gfkRlJCa\xcb\x8eYJ\xd9!\xa2\xc8bh(\xa2\xac\x9a\xf2|GU\xe7\x83\xbe'3f\xd1\x03,Y^\xad9\x0b{\xf6\xb3re\xed\xa95:\xe5b\x08\xe9\xa8\xd3R_P\x9f\xb0\xbd\xad\xbbf\x19\xa6\x1f\x1b\x19fY\xa5vT\x1b\xe1\x0f\x8b\xdam\xfe\x04\xcb.Fg\xd9^Z\x83U.%\x86E#\x03\xe7\xf5w\xacK\x86\x05\xa2\x8bT\x87\xc7\xe2#\xbe\x19\xa6d^\xd7\x8c1k\x8d\xa0\x1c|\xfd\x8b\xaa\xc6\"[\xd7\xa3\xbc/\xddZ\xf7\xe1\xbc\x98\xc0\xfa\xf6\x8dcW<\xf1\x84\xe2\x82z}l\x00\x1d\xdc\xa0\xf6\xbb\xb5{\xeb\x1f\x7f\xb8\xd78\xfa0\x1bxj\x9b\xa6N\x99\xbcs.\xbe\xbd'\xefua[B\x1e\xbcs\x11\\\x8fK\x1c.V\\\x9e\xc2F\x87\x9a\xa5S\xb3P\x92m\xe5\x8c\x07\x02\xb1{\x8b\x04\xc6U\xa9p\x91R\x06\xe1\xe4\xf4z\x0ed\xd9\xa9\xb0\x16\xad:c\x12\x0bT\xcaS\x08P\x93\xa7}\xb8$Vjj\xda\xb8e\x0e\x9c\xe4\xf2\xf4\x9c$\xf6\x93\xf7\xe1\xec\x01\xb9\x8cz\x0d{u\x8cmx}p\x90\xf6\xb1\xdf\xd0\xba\xab\x90\x83\xcd\xde\xce\xb7N\x8b\x8c\xf4\x91\xffH\x81\xd1&\xa9\x03\xda\xf4\xad?eE\xa1\x9bI\xec\xb8h&\xb1\xa3\x1f\x8e\xb1\x83\xade7rhk\xb4\xa5s=\x92\x19\xbb }\x10\n\x953\x92\xb4E\xdd\x8a\n5\xfd\x94\xd7\x7f\xb47\xd2H\xc3\xd7\xf0\xea\xab\x9f?\xbc\xfa\xea\xe7s\x8eyhkW\xb0\x83V&\xb9_\xfe\xd1.j\xf4m1\xb9Ai\x1fD45*\xfc\xe5\x1fmr\x87\xe4_g\xaf\xf74D\xff\xb2\xd8\xfdG5f!\x17\xbf==\xce[f\xf8\x8f\xa7\x0c\xdf\xa2tIF\xd3\xffu\x0f\x98\x97\xc3!\xfd\xbd8\x0d\xfb\xd0\xcf\x81\n-8\x8e\x1a\xc9\x7f&'TH\xc7\x94u\xcd\xa5N\x05L\xd3[[\xa7\xd0\x03\x82\xe9\xe1\xa0\x104+\xf8\n\xab\xa2\xae3\xda\xde\x85\xc2\x8e\xa3'\x8b\x06\x128+\x9dRs\xf0\xd4\xb8{\x02N\xb9\x94\x8e@\x0f\x91<O\xfc\xd5\xaf~u\xf0/\xec@\x0e\xbe\x8c\xdb\x96\xc0\x93\xd4\xd1U\x19\xcf\x1a\x0c\x95+\xe4\x9f\x86\x89x\xcek\xd3\x94>I\xd8]*\x07;\xac\x1f\xd4\x8fM$[!\x06Q\xee\xcc\xa9X\x1e\xbb\xc2\xaa\xaed(\xa1\x97\x0b\xc5LG\xba\xb0\xa3m\xf0\x8f\xa1\xa4JAZ\xba\xab\xc5J\x8eR+\xedC\x84{\x1d\xf4\x84\xdf\xc37b\x99;\x86\\'s\x15+\xec\x05\x84\x0f\xba\xe9\x9a\x1e\xe5V\xd0\xb5\xb9\"\xdf\xcd\x07\x1a|X\xe8\xef\x9e8\x07\xfd\x1d=KQ\x10O\xe9q$\xb9:\x9eP\xfd\xa0U\xacw\xc8n\x18\xf6,\xdd\x84\xf9\xecFi\xdd\xdd\xfen<\xee4LTn8\xb8\xa5\x8eM\n\xe8}\xbb\xb1J=K\xd9\x1f;t\xc9\xef\xa4\xd5\xbaKxQ;\x1f\xab.\xfe_0p\x8a<Nl\xf5j\x04\xcd\xa7\x80\xa9\xbd\x8e\xf3\xc4bKc\x9ds\x8a\x9b\x93\xd5\x94E*-\xde\xba\xe3\x10\xd5\xc3S\xf7B{h,5\xce\xeaJ\xe8\x8e{\xb3e\x97\xf3z\xa7\xbf\xc9YH\x8cXI\xc4\x1b:\xa8\xc5b\xda\x0c\xd5\xdc@\x83\xa7MK\xbc\xe9\x02\xae\xa6\x87\xc9\xee\xde\x95\xf5\xf5\xd1\xd2\x13t\x0d\xd8\x01>.uQW\xc5V\xd30-\x13\xd9\x957\x1eh\xa0\xa1\x10X\xe5\xb2\xd5sn\x17\x87\xe2\xa0od\xb2\xe5O8\xact\n\xdd\x13>\x0c\x1c\x83\xd0)\x85\xbb\xe8\xda\xde\xc5\x90\xad\x11\xect\x96\xe5\xf0!\xb38\xfb`\xbb\xff\xd89\xae\xb4\xc0u\xf0\xb1c5\x02\x07ak\xab\x0d\xc6J\xca}z\xfc{\x99\xa1X\xb7a\x95+3\xae[W\xc9B\\;\xdfwW\xaf\x1e\xff+\xe8\n\x01c\x87r9\xe2\x0f\xf0@i\xe1C\x9c;\x12\xb8.z\x8e>\xe9p\xc6\xcf\x08\xd1\x13\xf2I\xe7J	B\xd7\x92\xd7e\xdb\xdd\x19\xf5D\xbco\x8d\xfa\xb4x\xfb\x94\xe9\xe4\x99\x9el\x9fjA\xdf\x9c\xbd\x1d+\xaf+l[N&\xdcjH\xc7\x9d\xf4Jw<p\xdf\xa9\x95\xb6\"\x8f\x87;\x85\xdd\x06\x93\xdbX*\xcc\xedm\x1a\xce\xc7\xc1\xd4\xccR\x87=-J\xb5\x85$i\xa9\x8b%f`L\xdf\x88r6zg\xd86\xbb\x10\x9f\xb6{\x9d\xa5b\x1f\xed\xee\xf9\xdd|\xea\xecX\xdbOr\x93k\x9a\xae\xb1\xc5\x0c\xfd\xaf\x7f(m\x1b\x9a\\8X\xedO\x99\xb91\xbd\x0b\xc9\xbcR\x1fbt\xf2\x02\x94\xe2\x0d.\xb5\xbd\x83\x94\xba\x8fm\xbb<\x81\x13\x00R\xa9\xb0\xe98]\x1a\xae\x15\x04\xc5\xe2\xad\x8c\xae\xee\xf6\xe1C\xcd%^\xaa\x9f\xd5\x1c,\xde\xeb5FJ\nRO)i\x1b\"a\xa9\x05\xad\xef,\xed4\x18o\x18\xd6w\xfe\xd2\xaeR\xd19\xa8\xdeP_r%X\xa3\x92\x1e\xe3x\x7f\xc4e\xe1\xb5\\.\xed0\xba\x95+\x8a]N1C'\xac2\xf0\x13\xbc\x12\x1fm\x9f\xb2\xd9v\x03\xf5\x85\xe9\"\x90\xe5\x83\xe9s\xe6Sg\xf7\"`\xb8\x1bW\xf8\xe5-=\x8c\xfe\xf6\x1d\xa9\x83c\xd3\x11\xdc\x8e\xb7\xcc}m\xe8\xd9EZ\x05K\xc6Gz\x88\xa0W\x90<\xaa4\x82u\xaca\xef\xcf~\x8f\xd3\xb4\xbd?/\x8b\x12\x83\xbf\xe5\xad\x9d\x94\xbd\xa8$\xbb\x1cYH\x03\xa4-5\xb9\\\xbf\xd6\x1c\xad\x8a\xf5\x19\xb0k$\x9e\x0cF}O\xb7\xba\x88Zg!b\xd3\xa6\xa2/R\xd3:\xc8\xd3\x8a\x1a\xe0\x933\xb8\x9c\xda\x7f\x0d{\xaf\xa0v\x9e\x1d\xa9\x7f\xfc[\x98|[KX\x1e\xa0\x0c\x9f\xee\xab5\xdbw\x93\xdc\xec\x0fR!\xfb?\xc1\xe3_AiO:\x0eN[r]X\xba\x98k\xda437\xc7\xfa\xc9\xc5Uw\xc1\xc7\xb9\xf8\xfe\xdd\xe5\xb8\x1d\xae\"\x190\x9f\x0c\xfb\xf8&)T\xbe\x99\x1c\xee\xa8X\x7f\x83k2\xb6o\xe3\xb7\xdeq\"\x93\xee\xe96\xce\xdf\x15f$7:#O\x19%\x86\xc9o\xa6\x1b\x9f\xa7m8\x86\xa7\xb7\x07\xb0\xd1V\xb9\xcdH2\x949\xf7b\xbc\xda8ub	\xcb\xaeiS\xb0N\xd7~\xd9\xda\x8b\xd0\x9d\x9cqn\x98\x0d:\xb5_r0\xa30\x16Tq\x86-\xb0y\xe6ib:\x8a\x84\xea\xfbqb\x02C\xae\x94\xef\xfa\x982\xbc\xa3\xca5\x0dY\x95\xdc\xe3\x92F\x9f\xff\xb9[\xd5\x80]E\x16]\xf1\xb5]E\xd7\xd8\xdf\x8e\xf7\xf8\xfd\xca5\x9f/\xf8\x02\x05v\xa5;\xf7\xe0G9\x8eel\xbe\x11\xef\xb3\x13\xcdr\xb6\xe9*\xbc\xb3Q\x9b\xe4\xe8%\xf8-c\x90\x97\x1c\xf9\xb2GWh\xcc\x16\x8c\x93F\x98\xeb\x8a\xdc+\xb4N\x9b2\x9e\xca\x95\xf0\x00\xcdFf\xfb&'\xcb\xe8g?\x93\x18\x92\xa6\xc0\xd2\xb8\xea.\x88\xec\xd8\xf1\x8c\x84#\xaeV\xd3\xcb\xee\x05\x83\xe0\xb6\xb8\xec\xee\x1d\xd4\xd6u\x1edE\xba\x0b\xef\xd5,\xb5\xd6\x08U\x99s3\x9f\x89O\x92-?\xe3\x93V\x9a7-EGq\xd6\xe9\xaep\\\xdb\xf4\xc6\xbb C\x95v\x96-8R\x83\xd3\xdb\xc7\xa4\xaeIBW\xf80\xa6\xfb\\\xe5\xa0\xe9\xd5\xf9\x7f\xf3j!\x93x\xee\xd9\xc2\x84\xbb\x1es\xd8+m\xff?\xb8\xff\xc8\x9b\x85\x94\xa4+\n\x95\xd7-;\xf1,\xc5\xd2\xa0\x0bjR\xba/\x92\xfe\x8c\xca\x91\xf5\xc9b\xbe\xa2\xe8s \xf6\xe5\x83\xb6\xd9O\\\xd0\xb1\xea\x1f\xbf\x19\x88\x9dw\x9cN\xb7\xe4\x03\x17\x0c\xfa;TnB.?\"\x9a\xcex\xd6-'\xc2\x9fn$4\xc9\xb7w\x01}zJ$\x84\xc7\xf5R\x05\xe4\xf4\xe1\xad}\xc7\xc1d\xa0\xf3A*\x84>apVZ\xc4\xbd\x12\x1fML\x17\x95*\x1c\xa6\x10%\xf5t\xe9\xc8\x96\x96\xcdgZ\xad\x1fhyUtO\x13\xc7g\x1a\xad\xd3y)\xd2\x90\x87\xcf\xb6mA\xa2\xef\xe4\x85\xd6\xa6\xc6xd\xcb\xc0\xfea\x80$\xde<\x03y\xbc\xbfFy\xdcD\x10*OdC\xedb\x0ed\x05=\xe7\xef&\xf7&\x1f\x9c\xbf\xe3p\xd4\x93\xfbF+\n}\xff\"\xd7\xcf\xec\x12B\xf2\x91\xe3\xbb\xa7\xa74w\xde)\xfc\x04\x94\xb7h\x15\x8d\x0e\xe3\xf7i8/\x06\x93x-\x0b_\xbe\xf8~\xfe\xf2\xc5\xcc\xb5\xf2z\xa5\x7f^h\xd3%\xf6\xcc\x92\xad\xbbd \xb3tL\x0c]q\xbe\xef\xf2\xb9%\xe4&7jf\xf8m'\x89\xc8\xe3_\xc1\xa0_w\x9eU\\X\x04\xe7#\x7fw\x1f\x01f\xc7\x12\xa0{\xd1\xcc.1\xa4\xbb\x8b\xc2\xad\xcc\xfaj\xa6\x00\x89j\xa7\x9b\xb5\x048g\xef\x9b\xc6/\xe5$f\x11\x87\xdb\x8c\xd9\x1b\xf2\xf9Zav\xd4\xa2\x7f\xfc7[\xe9\xe4\x88g79RflDS\xcb\xfdR\xa2AMk\xb8L\xe8\xcfd\x89\xf6\xa64\xce\xdf\xbb\xae\xb8B\x92\x02X\xa56\xfe\xc1\x17\xe1\x00\x96[\xf8b\xbc\x98_9c\xdc\x86K&O\x18\x9c}\xdd\x8b\x85\xe3\xd1\x12m*\x9b\xc4\xab\x12[\xd9\x17\xf2\xb2K\xf3\x8f\xfd\xdeN\xab\xca\xf9Tuqm\xee\xf3\xa5\xf2<\xc5\xb4\x8b\x1bv\xa8_\xe4\xb6L>\xf2NO\xdf\x8b\x96OE\xd3G\xe7\n\x96q}\x12 \xf9\xbbx\xde\x06-[\\\xdf\x89\x9c\xa1R2Q\xa9<n\x93\x93\x99\x1d\xb5\xbd;\x99a\x08zm%\x00\x0bF\x86)\xe2\xa6	K\x14\x1d:F;\x8c-\xf9EKU\xc8\xbb\xa06\x86\xfcP\xe1\xabC\xf8\xf5\xcd\xd9\x9b9\xdc\\\xbf\x99\xcb{D\xe7\xe1\xc3\xd9\xf1\x95\x84\xdc\x14e\xa0\xc9\xed?\xa5\x1b\x92BQ\x92\x84\xaf\x0f\x0f\x1f^\x1d\x1e\xce\x07\xb4\x04\xe9\xbe\xf5\xf8\xea\xf0\x10~s,\xdfj\xe5A\x97U\xfby?\xf2E\xc7\xf9s\xc6\x87i\x937i\xb3\xaaF\xbb\xa6\xa2\xb31;\x11\xc8\xb4\xaf1\xab\x0c\x9fG\xc5\xf8\xfeg\x86\x13zY%?\x12\xcc\xd9\x95^w>\x15\x9b\x82\xec!9\xb0M\xa7-\xd2c\x83\xc9\xbc\xe2\x01\xc2L\xf2\xd9\x82\x98\x0cKJ\n\xa3|\xd8)\xe6\xb2b\x96\xe2\xf1\xb0\xa4\xaci\xcb	}\x90\xea'\x88S)'\xe4\xfe\\\x8f\x1f\x9as3\xd5\xf9\xbe56;\xed\x7f\x0b&\xa9\xbe\xa8\xc0Y\xfe)\xf0\xfe\x9e\x9e\x11\xfdm~\xc6t\xd5\xdd\xdb\xa4e\xe7\xefO~\x03o\xcf\xcf\x13bm\xdc\x12\x0d\xc3\xdf\xa4_\x02\x95[\xc3\xd9\xc5i?\"\x1bu\x14Y_\xf4\xbf\x13\xc6\x18Z\xa7\xe5\x17\xe9\xe7\xa4\x1d?\xe3$\xef\xa4\xd0\n\xa3\x8b\xf7XU\xa9#\x0c\xd1v\xfdD\xb5\xf1\x89\"\xff\x98\x1e\x7f\xfd?\xd0c\xe3\xd6N:\xd1\xb3\xcb\xf4\xab\x80\xa67\"\x8c\xe0\x1c]\x1aS\x8a\xeeu\xd5\x1f\xfaN\x17sh`\x8e\xe2\x1a\xe3\xc5\xecm\xfe\x99\xe0\x1b{\xed\x86n\x89\xf8H%\x1d\x05\xb7\xb1\xd2\x97(\x9a&\xd2\x03\xe2Y7\x83~\xb4\x9d_\x0f\xdas\xc3\x03Q\x9e\x83B\xc3R\xec\xbau\xceD\xdd\x8a\xf0\xb5I\xb7\xa2)EYn!t\xcbo\xa9\x8a\xf3\xfc\xe0\xd4gwf\xb1! [aH\x8f\xccY\x95\x82\xc1PS\xd8\x87^8\xefh\xdd\x19\xf4\xec\x8a}\xaa\x86\xb2\x03\x9e\x05\x8a\xc7\xe2\xab\xe4\xcb\x16\x14s\xd33\x0c\xe8\xcb$\xf0\x1e\x9d\xe5\x9f\x9eP\x8fG\x17j\xe42\xf65\xf4\xbf\n\xe8\xb1d\xdc\xb3\x85\x0c`\xa9\xfb%9t\n\x8e3\xe0\xf1c\xf3\x84\x94\xb5\x0e\x86\xd9\xe7\xb8\xe5\xb9\xa5C\x99%\xa7\xdc\xd9\xe82|k\xab\xecD,U\xa9=\x181vaD\x9f\xf4!\xe2\xbd\xd5\x1f;\xb9d\xb2\xe9\x0d&V\x9c\x84\x1f\xc8{1\x0eAET\x8e\xa9g4\x1b\xbaD\xb3\x98\xf22)(3d\xdb&\x08\xff\x17Hgs|x/?^\xbe\xf8\xfe\xe5\xf7\xff\x1d\x00\x00\xff\xffPK\x07\x08\x16\x8dz\xdf\xb0\x11\x00\x00\xfd0\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cW_o\x1b\xc7\x11\x7f&?\xc5\xe2\x00!	`8\xef~\x93,:&*K\x84H\xc7(\x9a>\x9c\xef\xd6\xe4U\xc7[\xf6\xfe\x98!\x02\x03$\x85\xc8M\xe5\xda\xadR\xa0A\xe1\xd8u\x13\xb4\x8f=\xd1\xa2M\x91\"\xf9\x15f\xbfB?I1\xb3\xbb\xb7G\xc1n\xfb$\xce\xde\xcc\xec\xfc\xfb\xfdf\xf5M\xb5\xe2<\x12q\xd7M\x9d[\xec\x9bj\xa5\xe2<t\xa3\x88\xfb\xce-\xe6\xecl\xef\xef\xd7v\xd9\xce/\xd9'[\xc9'\xec\xce\xc1!\xdbJ\xe8\xcfW\xceV\xf2\x95\xe3\xdc@}\x9f\x87<U\x06\xbb\xb5\xbdZ\xcbZ\xa8\xefA\xd7m\xf3]\xabT\xbf\xb7\xfdE\x8d}\\\xb5\xd9\x13A\xc8\xe3\xb2r\xb3qP\xdf\xab\x1d^W\x8fx\xbf!\x924\xa9G\xadN\xcc]2\xd8\xf2Y\xc4\xfb\xac\x87\xe7,\x88XJ_n*\x03:\xdd\x8e\xfc:\xde\x93\x1ct\x83T\xc7\xb4\xe5+\x8bO\x93\xcf\x98\x1b\xf9l\xcbg\x14\x0b\xcaB\xabY\x0f\x1f1\xdcT\xcc\xe26\xf71<Tk\x1c4[\xacq\xff\xf0\x8bk\xe5,\xd71\xe9\xb8\xbe\xe8\xef\x04\xa6\xfa\xcd\xbb\xdb\xbb\x07\x0f\xd8N\xfd\xffi\x82JsOxG-\xd1n\x87\xcaC\xeb\xeeam{\x17\xd57\xca\x96E\xb6\xc5\xf7\xf77\x9b\xac4\x1e\x07\xbc\xcf\xfd\xa6\xdb\xe5\xf5\x86	\xbf\xc9\x0e\xee\xb0\xd6\xdd\x1akn\xdf\xab\xb1z\x83=\xa8\x1d\xd6\xd8\x97\xf5\xda\x83\x92u\xb5\xf2\xe4\x86\x1e\xa8\x04\xe7\x89\xa4^\x98\xc5n\x98\x98\xf9\xf2\xdd\x81s\x8b\xfd\xaaZ\xa9T\x1c\xb8\x80),\xe53\xba\x96\xc4%L\xe1\xd2\xa9V*\xbf\xa6H:\"\x8b\x0bm\xf9\x14r9\xd2\xbaJ\x80\x15L\xacv7\x88\xb2\x94[\xefW0\x83\xa5<\x96c\xc8\xcd\x0d\xc5Q\xc9JDi\xa7l4\x95#\xf9B\x9eX\x13}\x00\xd3\xf2e	\xf7D\xe4\x17vZ\xbcQ\x12\x12\xab<\xe0\xae\xcd\x03\xde\xc2\n.\x8c\xfb\x05Lu4\xbaz4P\xa6Z\xae\xdf\x0d\"\xec\x01\xfc\x11.T\xf4\xaaGn[\xe0\xb1\x1c\xc3\n\xae\xe4\xb1>\xa4\x80\x1c\x98\x19Q\x18\xdb%\xac`	3\xb8R_<\x11\xa5\xb1\xeb\xa5\n	\xa4\xf3\x06&0\x95CU\x1d\xf9\x8c\xc1\x0c\xde\xc3\n\xce\xe5\x10rx\x87}\x82\x99|Q\xc6\xfc\xce\xc0\x8e\x08\xfc\x04\x17\x90\xc3\x02f\xcax\"G0er\xc4\xe4\x1f(\xc4\xb7\xb0b\xf5\x86\xb2\xe6_\xf7L\xa0\x7f\x83\x1c\xdeo^\\\xd6)\x85\xf7\x01\xcd\xff\x1ab'\xf0\xb9\xcek.\x87\xf2\xd4\xba\xd6\xf5\x9c(\xe97n\x14\xa4\"V\x97\xbc\xc6b\xc2J~G\xb5\x9ak\x8d,I\xf7E\xbf\xa8\xf6B>\x839\xac\x98|\x8a\xa2\xd2	\x85w\xa4\x10\xa5\x7f\xe9\xc9\xf2y\xec\x16\xde_b\xdb)\x81\x1c-\xe5Pi	K'\xb0\x96CX\xc1Z\x1e\xcb\xef(\x1d\xed^\xf4#\xae\x9d\x9c\xc1\x02r\xc2\x0d\x0e\xce	\x83\x0bX\xc9\x11\xccM\xcf\x13\xce\xb7\xc3Pg\x8e\xb9\x8c\xe5\x10'\xach\x8aa\x1b\x95\x11\xa5<\xa7\xda\xe6\xb6D\x89\xe2`\xede\x0d+\xb8\xa4\xebt\xc4)qL3\x0d\xbc\xa3\x81r\"\x87X/\xbc	\xd6j\x04\x0c\xd7\xd8\xca\x14\xbf\x0b\x8e\xd9\x18\xa0\xbfc\xac\x0c\xdbX\x0c\xca@d\xf8\xe9S8\x93\xa7\x9f\x19rI\x06\x91\xa7\xa1\xe4\xa0	\xcca!\x9f\xcb\xa7\xf2O\x06\x1aD\x0bS\xb8@\xb0\xa8\xa10Y\xcb\x11\xc1\xff[\xaa\xf2\x92\xc6'\x97'vh>\xf0}F\xbf'\x90\x17\xde\xb1}\xa3\xff\xa1Y%\xdc;i\xd0\xe5\x06\xc9\x9e\x1b\xf2\xc8/\xd3\xc0\xbf`\xa9\xa7\xb0\xe2\xc0\xcf\xc4.Zx	\xb9\xaeu\x05\xc1\xbb\xb6\xc2K\xc8\xe1\xd2\x08?\xc8\xe7:*-,\xac\xcd\x04\xde\x1a\xe1\x0dv\xc5\x08\xaf`.\xc7F\xf8\x11V:\xf5\x8a\x03\x7f\x86)\xcc-e\xf59?\xb2\xb1\x9e\xe1\x8c\x19\xcd\xd7\xb0\xb4\xfcu\x86\x13V\\%\x87\xf6\xcb?\xe5\xd8\xa6\xf4Z\x8em\xaco\xe0|\x93\xf3\xb2\xa0x~\x884\x15]\x9a\x88\x1f\xb1\xac\xaa\xe8\x9e\x1by\\M\xf5+9FJ&\xac\x17s\xe6\xb9\xa9\x1b\x8a6)\xfc\x95\xe0\x85\\\xb4\xd25p\xbc0\xc0\xcdx\xbb\xf0r\x1b\x0fX*\x98v|\xa3\xc4j\xc2\x8d\xfd\x0fQ\x9a\x06\x9a\xe1Z_D\x8a`\xbe\xa7)\xc4\xcek\xb4>\nB\xde\x12b\xcf\x8d\xdb\xa4\x812K\x85`!\x9dh\x9d(H:\x1a\xf5\x7f\x81\\1\x9b\xfc]\x19\xf5m!\x10f\"j\xebr \xc9]a8H\xad\xc4>p.O\x19\xcc\x88\x00r\x15\xe6\x1a\xd7\x15,\xe5s\xe5$\xe8\xf6D\x9c\xde\x16q\x9c\xf5\xe8-\x02?\xc0\x15BZ\x0e\x11nKtu\xac\x12\xc5-w\x93\xc1\xcf8b\xb0`\x84\xfb	a\xfa\x1d\\ \xbcn\x96]\xee\x9a\xfc7\xfc\xbd\xb7\xa9\x90\x05\x837\xc4@9\xf5\xeb\x04r\x06\xe7t\xdf\x14\xb5\xd7\xc4\x86S\xb2z+\x87\xf2X\xb3xnn\x8a\x1e\xbba\xe0\xdfv{\xa9\xd7qu\x15\xa6f\x0b@._0\"\xaf5\xbe\x06\x94\xc9\x11\xe7=zm\x06Q\xfb\x8e \n\xfb\x05\xe7=\xe6\xeb3\xf6H\xc4J3t\xd5\xe3\x0c)P\x8e\x90\xdf\xe8\xfd1\xd3\xf8\"&\xb7\x0fK\xf5\xae\xd2\xefI\x86\xdf\xac\x16\xf7[b\xc7\xce-\xb6s\x83\x0b\x19L\x14G\x98\xd9\xe9\xf2\xc0\x17_\x06I \"\xf3D\xbc\x87G\xec1\x9di\xa5,I\xef\xb9\xa9\xd7\xd1A\xe6\xc4/\x0b\x98\xd1(\xc2\x02\xde\xc1R\x9e2\xf5\x08\x825m\x85\x12\x87G\xbco\xa3G\xa4\xc3D\x9e\xc2%S\x0bA\xa3\xd4\xe9\x89 J\x11\x19\x16?\xafuS.\xe5\x18\xef\x9a35[\x06Q&\x07z\xa6\x94\x164UQ\x8e\xe5\xe9\xe7\x84\xc0!Z\xc3\x12\x17\xd3\xe7\xad\xd6\x9e\xb2\xf9m&\xcc\xa6;C\x16ej\xe1\x11\x05\x8fK\x04\xba0\xdb,\xe6n\xa2\xde0\x87\xea\x97>\xf5\x83\x98{\xe9\xce@\xed\x8eC-37\x0c\xd9\xc3A\xb1?\xac\x9e\xad\xc4u]\xd5P\xa3\xff(\xe6\x89\xaa\xf7+8\xa7G\xd3\xa4L31\xef\x85\x83\x82\x85p\x0c\xc7\xd7>\x8b\xb8\x98)x\xa7K6QmAx\x19\xbd4\x8b\xa3\x02\xd48\xfd\xba\x1dq\x16\xdar\x96\xf7\xb2\xaa\x14bk\x86\x0f\x00\xa5\x9dp7.\xc6c\xa5h\xc0|Ip\x90j_\xf7\x02\xfd\x9f\x14n\x01\xb5\xb6\x1043l\x15\xf2}\xc9YG\xf4\xf7E\x1ax\xdc\xe4/\x7f/_\xc0\x84\x80Q\xda\xa1I\xf6\xb0\x1b\xa4E\x11\x8a\xb0l\x19\xd2N\xd6}\x18\xb9A\x18h\xe6\xfa\x9e<\xa8\x87\xcf\x89\n`M#6\x91\xcf\xe4\xf3\x7f\x0f\xff\xa1\xedDO\x8f\x06\xf2\xc7\xb7\xea0\x8b\x0cU\x1a\xa4\xc0O\x8cJ:b\x94\x11\x15g\xa9I\xa4D=\x08\x0e\xb8$\x16C-\xe3\xad\x8f\x88\xb2\xe3p_\x1dl\x8cA\xd6\x0b\x85\xeb\xdf	B\xaa\xc4}\x92\x18\x92x\xf9s#\x16\xed\x98'\xaaY\x96\xbf\xdec\xd3\x8a\x8c\xae]\xf6\xa0|U\xb5\xf2\xa4\xfa\xe4?\x01\x00\x00\xff\xffPK\x07\x08'[\x9e\x89\xcd\x06\x00\x00\x7f\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xde\xbfsf\xcc\\\xddn\x1bG\x96\xbe\xb6\x9f\xa2\xc0E\xe0\x04`,g2\x17\x83\\\x04\xf0\x7f\xbc\x13\xff\x8c\xe5\xc4X\x04\x19\xa0\xd8]${\xdc\xecb\xba\x9b\x96\x95\xc1\x00\x92\xe8\xd8\xce\xca\x13%\x9e\x00	2\x89\xbd\xde\x04\xd8\x8b\xc1b)J\x8ch\x8a\xa4\x80\xec\x0bT\xbd\xc2>\xc9\xe2\x9cS\xd5]M6i\x0b;\x8b\xdd+\xab\xbb\xabN\x9d\xaa:?\xdf\xf9\xa1\xffx\xf2D\xa5.\xe3VRy\x87\xfd\xf1\xe4\x89\x13\x95Kg\x7fWy\x87}t\xf2\x84\xf9\xbb\x8a\x7f\xa9\x7fU\x035\xd2\x9bz\x8b\xc1\xcb\x93'N|\x0c\x1f*\xd7V/\xdd\xce\x86_\x93)[\xe5u\xc1.\xc9\x98\xdd\x96\xf1\x1d3\xf9\x9c\xe4\xb1\xcfx\x18\xca\xb5\x84\xb5x*\xe2\x80\x87U\x966y\xcax,X$S\x96\xc0\xbcT\xb2\x9a`w\x03\xb1&|\x16D\x8c\xb35\x19\xdfa\"\xba\x1b\xc42j\x89(\xcdW\xe6\xe1\x1a_O\xde\x97\xde\x9dl}\xf5\x8d\xea\xa9\x91\xdeP\x03u\xa4\x86zK?f\xaa\xaf&j\xa8\x0et\xd7n\xe4\x89\xdeT\x03\xb5\xa7\xf6U\x8f\xa9#\xbd\xa1\xa6j\xaczzKo\xab>\xfe\xfb\x98\xa9\x11\xb3\xb3\x18lYo\xa8\x1e\xbc\xd0\x0ft\x97\xc1D\xf5\xb3\x1a05\xd0\x9b\xeaP\x0da\x8d\x91:T=\xb5\xafF@t\xa2\x06\xc8\xc7\x96\x1a\xe2\xf2=\x87\xe9HF\xeb\xad \x119\xcf_\xaa\x89\x9a\xe2rcXR\xf5\xf4\x035\xd4;\x96\xdd\xa7zKM\xd5.\xb2\xf0\xb3\xe5\xaf\x0f{\xd0\x9f1u\xa4\xa6\xc0\xa0\x1a\xe0>\xfa\x0c\xf9\xb4\xd4z@\xd1Y\xbb\xe3\x07\xf2C\x19vZ\xf9\xeag\xe1\x1d\xbbK/iE\x1a\xc1d\x9d\xe1\x04\xb8\x86V'	<\xc6#\x9f\xdd\x0d|!Y;\xe4\xeb\"N\\\xd2\xa9l\x04\xf5\xe2\xa6pC\xc4\x17\xb25\xd1\xdbj\xc0._\xb9\xf4&\x1e\xfb@\xf5\xf5c\xfd\x85\xdd\xe71fd\xcb\xd6\x1aW;i\xbe\x19x`\xe7\xb8w\xa7\x11\xcbN\xe4\xb3\x0f\x81YC\x1f\xbf\xa5M\xc1j\xf9w\xdc\x8cK\x8d&Xr\x0b(\x9d\xf5[A\xf4f\xd2\x16^P\x0f\x84\xcfZ\xa2%\x12\xd6\x0e\xda\xc2gI\x1a\xf3\xa0\xd1LY\x10\xa5\x92\xad\xcb\xce\xa9X\xb0\x96\x8c\x82T\xc6\xceB\xa0\x0e\x17\xef\xb5\x83x=?\xb1'\xb8\xc1\xb1\xdea\xeag\x94\x83	H\xd6>\xdc\xaf\x1a\xa9\xa1=\xa6\x7fSC\x94\xba)|\x03A{\xc1\xd4\xae\x1a\xa8\x83\\\x16P\x0e\xf6aDO\xf5\xe1YwQf\x0f\xd5\x00\x85x\xc7%[d\xea\x1awdC}K\xec\xcc\xf1`\xdeO\xf0\x9e\xa6\xc0A6\xa4\xca\xd4XMA=\xf4\x16\xd3\x9bj\xaa\xf6Q63\xc9\x05\xbe\xde:\xc3@]P?&4\x0fdyWw\xd5\x08x\x1f2T\x81\xfbz\x83\xc1#\xc8\xc5\xcf\xb8\xd71\xc3C\xda\x833@\xad\x1c\xcc\xf0\x7f+HCQ4\x07{j\n\xc7\x85\x9c\x8eJ6S2\x067\xa6\xb7g76Q=\x14C\x90\xd2\x81\xde`\xbf\xfc-\x13\xda\x010\x8d\x1a7Q{\xaa\xf7\xcba\x95\x9eFz\x13\xbe\xeb\xae\x1a\xb3\xb7\xce\x9ca\xf4\x88\xeb\xe0z.\xfb~.\x0c\xe7\xe0\x81\xf8\xbb%\xee\xa5\x0c>\x82B\x82\xfc\xb6e\xe2\x18A\x8f\xb7S\xaf\xc9\xf3-\x7f\x87L>T=g\x7f(\x15=\xd5W\x87z\x87n\xe1\x08\x18\xd0\x8f\xd5\x01\xa9\x1a\x18\x10\x14\x8f\x17`\xcb\xfaxk\xc6|\x8e\x88\x1e\xd9\xbdC\xba\xf6\x81\x1a\xa9)\n\xd6\x86\xde\x86\x9b\xdb\x87\xa9\xb8J_\x0d\xd5\x8b\x92\xb3\xa2\x87\xd9\xebCS\x07'7$Y9@9\x9d\xe0;\xba_x1\xb7\xdb[\xbc\x91d;>O\xefX\n/\xcd\x99\xf1F\x02\xae$\x91\x9d\xd8\x13\xac.cff\xb2D\x86\x9d4\x90Qr\x9a]\x8f\x04LbA\xc2b\x1e\xf9\xb2\x15\xae3\xaf)\x13\x11\xe1\x0c\xc1\xbd&k\x88H\xc4<\x15\xbe%p\x9a\xdd\x14\x9ft\x82X\xf8\xb0\x80'\xa3\x94\x83\x9bJY(x\x92\xb2\xb7\x99\x1f\xd4\xeb\"\x16Q\x8a\x0c\x9dvxo\xf2x\xd5\x93q.\x9e\xe7\x9b<\xe6^*b\x96\xb4y\x8b%\xf8\xd1\xd8\x98(\x0d\xf0\xe5\x9a@s\"\xeb\xac%\xfd\xa0\xbe\x1eD\x0d\xc6\x99\x97\xcdD\x1f	\"q\x9a\x9d\xad\xc3\x0bq\xcf\x13\xc2\x87a ,a\xd0\nR\xfc\xab\x93\x88\x98\xad\x05a\xc8\"A\xcc'2\xbc+\x80\x98\xd9\x99\xc3j'Ie\xeb\xfc\xeaj\xc6j\xe6\x8c\x8c\x1d\x86\x0b?\xd4;h\xb0\xc0D/\x14\xa81;\xbf\xbaJ\xd6\xbb\x87\xc2q\xa8zU\x96\x8b\x86\x9a\xccR\xe9\x83\xbc\x80P\x81\x06\xee\x1a\xcf;A3\x83T\xc7z\xbb\x84\xd3[\xb2\xd1p5\xffY\x19?\xfa1\xe9\xb2z\x01\\\xe5\x8e\xc7z\xea\xcc\xf5,Q\x92\x19\x1a\x19'\xbe\xa8\xf3N\x98\xba\x87\x86\x90i\x8c(CM\x19\xd8\x00$\xf8\xd0`\x89\xcc\xf3}\xab7\xf3\xb5t\x97&\x81\xa6\x95O3\xc7\xe0\xea\x85Y\xfc}\x1e5\xf2\xd5\xffC\x1d\xe8m0g\xc7^\x9dL\xdf\xa2\xd5w\x88\xae\xbbz\x02\xd7\x10\xf3F~\x01\x17D\xd2Y5\xefh!g\xd4i\x197\xe8x\xc1\x97\x19\x0e\xc1\xd2\xe8\x0d\xf2	hu\xc6\xce\nA\xc2k\xa1\xb8)k2\xcd5_}\x85Ft4so\xf8\xb0K\x06J\xf5]3\x8f\xb2\x0b+\xd0\xfd\xd2\x98\x9e\x1a\xa3\xf1\xce\xd81\xc6\x7f\xec\x12\xc2A\xfb\xe4_uW\x1d1\xd7\x95\x0c\xe6\x18\xfd \x111b\xde\xc4\x15\x06:\xe3\x119\xe6}D{\x939wI&\xb0\xef\xb8\xcbyw5\xb3\x0f\x04\x83j\\e\x08}\xa7\xe4\x8e\xb2\x15@\xdf\xca(['7(E\x03\xbe\x8cf\xfc(\xe9\xe5#\xbb\xa8\x01\x1b.[#t\x07\xf8\x15\xce\xcc>Za\xc2\xe1\xd9\x02h\xd7j<\x0c\xb3U\xfe\xe17\xf0Hsa\xb1-\xbdm\xa9?G8\xbfI\xde9\xfbn\x81\x0ez\xa5\x11\xc1x5Q\xfbz\x9b\x11\xadW\x04#g\xe6h\x0e\xd9\xaf\xce\x94xk{\xd4{j\xeal\xa4\xc5\x83\xf0b\x1cg\xfb\xb8\x08/\xd8\xc58\x96q\xe6\x90\xd0:1\x1c\n\xa6Y\xe0\xc7\xd3\xf3D\xae\xf2 ,\x122\x14\x90\x1c\x11(\x99v\x83'\xb9\xa4\xc1\xc3\x9a\x8c\xfd\xf9\x99\xacm>\x95\x91\x90q:\xb3\x85D\xc4wE\xcc\xda\xf0e\x9e\x96\xf3\xb5\x84\xdcj\xa7VJm1\xa1\xa4S\xf3e\x8b\x07\x91K\xed^S\x80\x87\xcdI\xd9\x17D\xc6~?\xb6A\x11\xf7\xda<\xf2\xcf:\xd2\xa7\xfeE\xf5\xd4\x01I\xb9\x9a\xe8\xae\xa3Z\x0c\xe1x\x1e\x83\x19\x14m\x85\xf3\xd8\x13\xd9\xeb\x80\xa1\x00i\x1d\xc2#J'\xe8)\xbb-jW\xab\xec\xc6\x85KUv\xf5\xc6\xdb\x19*\xda5\xc8j\x90EDV\x9d\xf4\xf6\x1b\x85=\xb9w\xa8\xfe\x1d\xd1\xe7\x11\xa2\xb4\xad\xa2\x8d\xb4\xac/\x19\x82\x1c\x19\x986U/\xc0:\x00\x18\xd7\xf7UO\xbdP\x87\xf9\xb2u!|\x88\xa9HZ\xb3\xd5\x9f\xd2\xae\xf5\x16\xd2\xd9\x81\x9d\xf4\xd1ud\x8b?SS\xfd\x10lk\x95\x19c\x9d\x05\xb9\xb9\x0b\x04\x9d#@`\x02\x00\xb5k\x00\xc1\xa0\x08\xff\xeba\x01\x14\xcaN\x94\xc6\xeb\x8c\xde\xd2\x82\x17\x82\x04\xe2VDK\"f\x9e;\x84\xc9\x08\xdf;!m]\xc6\x9e\xf0\xcfF2\xca7\xf5\x13\x9e\xd4f1F%F\xdd\xb8{bn\xe7\xb1\x1b\xc3\x8f\xd4\xa1\xfeB?\xb4\xf6\xd3\xa0\x1f\xb4\xce[\x88\x86\x8e\xd0\x86\x81\xf52\xee\x08NGw\x19@o'\xb4\xcb9l\xf00\x14\xf1\xfaU\xe9\x0bW\x8c\x07\x18?\x8e\x19\x04\"\x80\xc1\x10\xb0\x0d\x9dSGe8p\xd2\x1dH8\xf3M\x99l1\xbd\xb9@\x82\x8by\x85\x86\x94.\xf6\xbaL\x8fU\xe7\xe1X\xba\xd9\x0c|_8\x87\xfe\xdcz\x125}\x87\x9d\xc9\x8f\xf4!\x85\x11\xe6@Q\x8e\xad\x8f\x80\x85\xec\x9c\xa2\xb5n\x06\xbe8\x17D\x91\xf0\xb3\x05\xde\x0b|\xc1.\x88P\x00\xcc\xbf\x81BP\xcd?\x80KB\xc9\xa0lU\x93\xdf\x15\xac&D\xc4|\x9aQ$]\xc8\x86\xe1|x\x03\xd2\xb5\xc2\xc3p\xc5%\x8c\xf1j\x02QBC\xf8\x8c'y\x12\x0c\xa2\x0fL{\xd5c\xd9B\xf0\x8e\x93YK\xa4\x1cg\x15\xd7\xbc)\xbcN\x9c\x04wE\x98\x07\x90\xd9;\xe4\x9d5\x03\x88\x08J\xb7e\xb2p\xb1h\x87\x01\xc5\x05\x9c\xd1\x0d\xd0\xd4\xd8\xa1^X\xf6V\xb3\xd3\xaa%%\xd7\x84\xe8`>\xbfS\x9a\xc7\x1a\xa1\xd6\x1c\xa9\x91\xee\xb2\x8fr\xd9\xa4\xcf\x1f\x93U\xc4\x08\x12s\\\xac<\x0b\xd4\x94q\xf0)Db!\xdc^\xe0b\xe0\xbf\x80\xc2\x1a\x19\x9e \x8e\x031\x9f\x140	\xca\xe0\x9ee\xf3\x07\xf3\x92F\xeeQ\xba\x04D\xf6\xed\xdfxM\x1e\xe5\xab\x06\xb9\x04\xa9\xbf\xa2\x98\x0d\xd5\xc4PqF\xb5xC\xbc'\xc1\xf3\xb9\x9e\xc6\x11\xd0\xe1\x02?a!8\x86\xd2}\x13s\x15\x90\xbbC'\xb7\xda\xe5\x1e\xc7\x84\xdf\xb3\xb4\\-FNoJ\x99^\xbf+\xe28pm\xca\x0f\xe6\x02\x10]\x19\xc5\xa53\xd4\x9f\xd1q-\xd8\x81\xe5\xf4k\xc4h\xf8\xed\x00\xaf\xf3\xf3\x9c\xb3\x05[\xc7\x9b\x07\xb7@\xa1\xe6c\x14\x94q\xb6\xa0\x1a\xa0\x17\x9dI2\x10\x16<\x7f\xe1\xda\x1b\x94\xc7\x18P\x1e\x03s\xb5`\x07LLg\x05\xa0\xa7\xf6\x11Io\x96&w\x9ai\xdaN\xdeYY\xc1\x83IN\xb7D\xa3\xe3q@\x1a\xbf\x1c\xce\x9cZ1\xac\xbf\x02\xaf^)\xa4o\x93\xb82\x1e1\xa4\xf3\xf7\x8c\xe1\x83V\x11\x0d|\xab\xc6\xd6\xd1g\x91_\xfe\xaa\xe8\xed\x07`\xa6\x87\xea \xf3\xf6n\xac\x19Da\x10\x89KA\xbaD\xa0g\xa1\xd1\x14\xa1\xc0C\x0c\xb2\x97\xcbi\xdf@(\x93,\x9a\xda\x0b\xcc\x02\xefiv\x8f$\x92\x0ec\x9f\xf89\xe6\xbc\xf2\xbb\x0b\xe7\x0c\x17\xf0\xfe\xd8\x00\xf1\x0f\x1cs\xb6\x8e}{\x86\x01\xc6T\x7f\x8e\x12\xeaDd_\xaa\x11R\xea\x82\x85\x01\xef}43\x12\"\x87\xd71\x12\xd9\x83\xbd\x15\xdd\xad\xcd\xcd:\x99\xb99d\x17\x96\xc5\xf5\xf9-\x1e\x18(a\x1c\xe1\x8e\x8d\xfb\x87h\xf2\x00<\xdd\xa7\xfc\x9c{Za\x10\xddqv\xf7\x04\x11\xd5#\xe4x\xc0\xf4\xa6\xde\xd4\xdb\xea\xd0\xdd\xe6\xd7\x18\x0cc\x12\x07\xd6\xa9f\x9c\xe7\x88\xe6\xd0f\xf8\xf6m\xf9\xa3\x88(\xfb\x0b\x17ax\x0bG\xaa\xc7\xde}\xf7\xdd\x95_\x83\xa5]\xc9ym\xf1\xc4\x93\x8e\xbc}O\xf2\x06\xe6a\xa9w\x19\xe7\xe3\xdc\xad\x139T\xd3\x8c\xa6{\x9a%b\xb9\x90R\xdaq4_=Q\x07\xb0cJ\xd0\xe3\x16\x9d\x98s\x88\"0\xc8W\x9a\xe8\xfb\xa8\x19\xe3WK<\xe5V\xfc\x08)\xf6\xd1 bR\xed\xc0Z\xb9)<\xbb<\x8c\xcbx\xc0\x97=\xf5bv3\xf7\xdeC\xd3T8h\x9b\xc2\xb6\xae\xd3\xc0r\xbd\x0dt\x81\xc0K\xa2\xa3W#q\xa0zjOo\xe8n\x86\xfe\x8d\x9e\x97\xd3vy^\x0d>\x15K9&G\x95\xfb\x9e\x8d\xe3s\\B\xe2x\x1cc 3F'\xd4C-\xc2\xb3\xd7\x9f\x15vr;\xf0\xd3\xe6\xcb\x0f\x1fs0\x1b\x94\xda9\xfeVJH\x1co+\x0e\xcb\xa2\xd1\xb9\xf5a\xc6\xf0Uz\xa4\xa5o@\xa4EiuB\xba\xb6`\xe6Q\xd5-aA\xc4\x00\x16aD\x86\xe5\xc4Y\xc2\xabM\x19\xa7^'\xfd\xdfX@\xfa\"\xe6E\xfb\xfe}\xa6&=[\xdaXh\xe1\xc7\xb3c\xe7m\xfc\"\xb3^\xb5\x11\xac\xb5\x9a\xa4\xc1\xfb\x98\xf0z\xcc\xae\\\xc8}\x9b\xa9\xe9:\x8e \x9a/\xcf-5\x80C,\xd4A\x00i\xb2}n\xc9\xb8\x10KFb-\xcb\x1b9\xb8/\xab\x83\x1d\x81\xab$\xb34\x87qa\xaeL\xd2\x05\xf3\ni\xc2\xa7N^\xf0q\xa1\xd06\x97 \x8cd\x1ax\xcen\x9f\xaa]\xfd\xcfz\x07\xb3&\x84\x123S\xfa\x9d\xb9\x88\x11\x1a]\xf4{\xd6\xb2R\x85ijJ\x08\xc6\"\xc39}n\x89,\xc8>\x98\xc1&\xf90\xdf\x07\xb0]\xe4\xb4\x1ex<\x0d\xdc\\\xc1\x8f\x06\x7f\x02\x168\x9cUL*Ot\xf5C\x1b\x81w\xcbFgU\xb3\x07h\xdf\xdd\xac\xc3P\xef\xc0]\x92\xcf5\x0ea\xa0\xf6)e9\xd1\x9f\xe1\xd0I\xdeO\x00\x00\xc0\xe5x\x0d\xf4\xa7\x10*}\x95\xe7U\xd8/\x7f\xc3<\xe7\x0b\xe4\x0f\x93\x00{\xb8\xf5\x81\xde\xfa\xe5\xd0\xee\x81\x1aB\xba\xfasS\xac\xc0%q$\xc5#n\x1dc\xa07\xd5\x84\x8a\x87=\xa67VTo\x05\x90\x1d\x19\x19\xd8\x82\x80\x80\x13\xb7\x82\xce\xeb!M\x9c\xbb\xc9c\xa5\x84d\xe8\xcf\xcb\xf4s4\xbc\x1b/\x97ji\xc2\xa0\xf3%5G\x1b\"1[|\xbc\x95\xa7\x99\xae\xf2v\x1b\xa0\xbb\xacg!\xbe\xc4Z \xfc\xdbI\x04\x84\xfa\x00\xe6m%\x92\xc9\x88\x9e\xb3\x86\x02\x9c\xe6@y\xb9\x16\x89\xb8\x80\xd5\xa8\xd7\x04+l\xfa\xc1B+\xd5\xcf\xc7\xe9\xc7\xfa\x01\x04\xceN\xae\x9f\xbdnrP\x98~,\xd66\x10GPU\x81\xe9\xfb@Q\x8d\xe8\xf8\xb3:\x88\xa9\x89\xefc<\x85\xce\x81j\xc5#\n\xd1\xb6\xe0\xa6v\x81\x0b\x84\xf0j\xe2X\xb1\xf6\xdc\xa5<[r\x0f\x10$\x9d\x8f\x05jW1\xd0\x02\xa3\xc3<\xf3\xe9\x95\x02.\x1a\x8c%\xd4H\xac\xfd\xdd\xeb\xa6@\xef\n\x86G\x171\xbf\xbc,\xe4\xcf\xd0\xaf\xe98\xe89>bY\x80_@\xcd3\xf3\xb2\xb0\xe6\x10\xe2\x0e\xdd\xad2r\xf1\xfa!\xe5\x8c\xc9H\xd8t\n: \xbd\x89\xc5\x13\x86\xad\x17\x98y1\xa6r\xec\xa4\x1du\xd7\xd9c\xdc\x89\xc4lm\xebK\xea3A\xc5\xa5\xa4\x1c\xf6A\x91\xa0L\xf3X\xe5\xc7\xa2S\xcce\x9162\xd7U\xd0\xc3\x90\x01s \x03\x14'j|)\xf5`\xc8\xd8\xadf,\xf8\xcb9\xcbz\x0b\x9c\x02a\x917;\x02\xf5\xe8\x7f\xca\xd9z'ch5\xec\xa4LD\xbc\x16f\xb5\x90\x0b2:\x952\x9e\xdc\xc9g\xc4\xb5[\xe2^\xeePo\n\x7f\xe5\\\xd8\x11\x0c\xdf\x9aY\x01e\xb3c\xe1ccV\x0d\xbe\xa7\xe2^\xca\x82:\xab\xcb\xb8\xc5\xd3T\xf8l-H\x9b\xec\xd4\xef\xe3SL\xc6\xec\xd4\xefk\xa7\x9cE\x04\xf7\xafGN\xd2\xb0P\xa2\xc4\xd4\xfc\x8c\x9f-\xa9=\xc2\xb1\xd8\xe2\xfeha\xa6:\x16\x8d I\xdd\x1c\xd87\xa8\xf1n/HY\x81\xc2\xa5\x10\xf24\xb8+n\x05.\x02z\x8a\xe5\x85)f\xe4\xb7\x9c<\xd1\x00\xcc\x97i\xa7r\xa1\xc7\xcb\xc6\x16\xd8/M\n\xbd\xc5\xacO\x9c\x18u\xd9w\xd3A\xb1h\x0b\x9e\x16\x93\x06}\x03\x11\x87\x0b6\xd6\x0e\xd7o\x16\xc2\xae\x8f\x90W,G~\x8cyms\xbe\xbd\x1c=\x94hr1\x99\xeaT4{(\xc1\x86\x88\xee\xea/\xcc\x16\xac9Y\x80jb)\xd3\x0fn\xbe\x9f\xef\xe5;\xdc\x05\xb8\\j\xfa\x82\x8f\xd5\x85\x9f\xa8\xbc\xbe\x01\x1aT\xa5\x9e!@X\x9f\x19w0^\xd2M\x94\xc5\xa6Xq\xcd\xcb\xf2\xbd\x97gm\xe2N(\xdc\x94\x8dv\xbaN\xb2\xc3s_\x16\x9dY	\x0e\xa4r[\xdfB\xfe\x9e\x93\x8a\xc4d\xf5\xc4\x05\x1c\x89\x9bQP\xcf\x89\x8a]\xf7\x074\x14\xd8\x93y\x94\xd5]\xc8\xc8\xb8\x14BGz\xbe\xa3\xba\x10U1\x10\xe3\xdeWC0\xe6t\x1eTUs\xbd\xa6zN\x8f\xd9\xe9\xee\xa1\nS\xa0\xf2 o\xab\xca\x8aM\xb6\xdc\x8dE+\x82\xbd\x98\xb3\xaaR\x1e\x87\x8a\xea\x03,\xaaS_\x8a\xb5x\xf4v\xa0\x06\xec\xd7\xa5ur\xe4\x00\xf1[\xd7\xdd]\xc7\x13\x11\xcf;,W\xe1\xc55n;+\xed\xf7\xd3\x9el\x1d+[\x97\x88$	d\xb4\xb8\xa5Rw)[H\xe5\x03\x8b\xa0\x19v\xd1n\"\xfbK\x1b,\xddfJj\x16v\xee\x81\x82e\x93\x1d8\xb4\x95\x00\xcau:In \xe0\x0047\xf3\x92\xb4e\x10\x16 \xdes\xdc\xfd\x0b*\xdfaEpYz\xfd\x07\xc2\x13[yv\x8e\x99\xa6\x1e\x87\xc2\x92lA\xceH\xca\xeb\xf5\x99\x1e\xca\xef\xd1\x88\x8c\xa8\x103\x1b\xf6\xf6\x96\x86\x9fs\xe3\xb3\xd6)s\x1b[p/\xcc\xa89\xc5\xbc93\xe0\xcc\x96\xf8\xa7-\xdb\x8f\xbe\xc4;\xe5\x99\x8d\x03\xb4\x8aY>\xbb\xe0\x9d\xd2\xa6p\x9d\x8a\xe9\xda\xb2d\x9f\xd864\xa3\xb2(Q\xdd\xd2\x0cZ\x8a\xe8\x83\xa4\xf0*\xbf\xe7\x9e\xe1|\x1ef\xd6\xf3\xe4M\xbfN\xe7\xe3\x12\x994M\xbf\xe5\x81}!$,t\xff\x12\xa6/\xe9\xad,0\x1fD.\xf3$\xce\xff\x8fY?~\xd7/y,lS\xa2z\xd3\xc1\xb2I\xf9J\xd8\xc8\xb3J\xfa\xba@]_\x92\x93{2\xd3	P\xd4\xd4\xccr;\x1dTKj\x1e\x10\x9d\x9c\xbb\\\xc4\x1b\xcbZ\x16!\x9a\xce\xaa\x94\xc5\xd6\xbf\x97'\xa0\xe7\xc8\xcc\xb0Q\x9eQ\xff	}\n\x1d\xea\x82J\xa7\xa3\xf4k<\xf5\x9a\x06\xc5_\x8fn\x028\xca(\xde\x86o\x8c\xe4\x14;6\x10;\xd9h\xaf\x93\xca\x16O\x03\x8f\x87\xe1:\xe3\xbeo\x06B\xc4\x86D\x85??5_V\xd4Z/\xad\xd2\xde\x16\xb5\xab\xc6\x17\x1d\xaf&\x0b#w\xd5\x98\"\x9d\xf2\xf9\xe8p_\xb1,\x8c\x86~D?A\xb1\xf17\xc6\"\xbb6\xaf\xd6\xcfd\x0c\xe68\xed\x0fkM\x9e\x9e\x8d\\\x0c};{C;\x80\x11\x1c\x9eO7\xf8\xbc\x0f\xee\x19K0x\x93XE\x0f\xff\x08\xe3}\xc7\xcf\xaf\xc9\xf8\xce\xc2\xae\x94]\x94\xe2\xcd\xdcB\x14\x1a\x07\x16d\xcf\x87\xc7\x97I\xcb\xc4lg\xf0\xff\x01+\xeb<\xf2E\xee\x0e\xfe\x89\x1e\xab\xce\x03\xd5\x7fY\"x\xec5q\xe2\xc9\x13\x7f\xaa\x9e<Q\x91m\xecV\xb7\xbf\xcd\x8a\xa8-\xb3\x82\xa0\x8e<_%\xf1b\x81\xfd2\x15dl\x1f\xcd\xc5\xc4Qe\xa6\xff\x8cw\xd5C4F\x87cJ\x0d\x8b\xa7\xe4\x15\x02\xd0N\xe4%\x91q\n'jqJ\xe17+\x19\xb2\xa5\x05\xdcOG\xa6\x1a50\x1e\x00\x8d\x99\x13\x9f\xcc\xcd(\xfe\x16\xc0\x98\xce\x82+)\xf4k\xce}\x9eq\xf3p	\x95\x94\xd7r\xbe\x9f\xeaMc\x93&YI\x8eR\x83\x98\x8d4\xcf\xcfr\xe1_\x0c\xbfL\x8f\x89\xde\xc1\xf2\xf6\x80\x99_\x82\x01\x0e{\x84\x9e\x83V\x17\xadv\xc8S\x91]d\x8dG7\n\xd1\xc2\x13\xc0h\x94G\x18\x927\xa2\xac\xe6@M,z[y-Y)\xaf=\x8c\xd9k\x89\xd1P\x0cW\x91\x97\x89\x1a\xbc\x93;\x9d\x9e~d\x12\xa5\xcc$B\x06\xfa\xa1\xfeJo\xe1T\x93G\xee\xb3\xd7\x92\xd3\xc59Wn\xb0\xff\xda\xf8\x1a\xdf;b\xd9	\x8a\xbf\x16\xcc~(X\xe1\x1e6\xd6\xa1l\xfd\x98\x85\xae\x99\x07\x9fE\xc1S\xd3}m}\xed\xd4\x1e+\xf7}\xa41Sv6\x1f\xdb\xe4\x17(\x9a\x1b\xba\xf5o3 I\x82F\xb4\np\x16\x87\xfd\x80\xe0\x9cRa\xc3\x05\xe0\xd4\nb\x8d\x932aB<{\x15\x89x\xb5-\xbc\x04?=A\xf9\xa4\xde\xe2	\x15\xf1\x9d\xbe%\x8a\x17~u\x86\xfd\xe3\x8d\x8b\x97\xab\xec\xc6\xb5\xcbUv\xf9\xca%{\xca\xb7/\x9e\xbbZ\x10Q\xec\xb3\x1b\x97V\x1d\xc7&\xae@X\xfb\xc8\x81Ao\x9f9\xf3\x9f\xdf\xbcu\xe6L\xb5P\x964\x15a\xeci\xfe\xed9<X\x83\xb5\x0e \xf4\x07&\xcd\x86\xe8\xf4\x9eZ\x1b\x92\xff\x8ch\xf6\x17D\x15\xaf\xc9\xa3\x86p\x12\xe9`&\xdd\x86\x83\xb9,z\xc5\x0b\xe1\xfc=\x18k\xff4\xef\x05\x8f\xcd\xc2\x85\xee=\xf3YF\xf5\xa0\xd1\x89)\xbdh\xefM\xdb\xae\x94a!ih-Y6i\x95\xfa\x8b\x17\xcc\xb2\xc9\x08;+\x16<u\xd6yn\x0dN\xe9\x1a>O\xf1h.\xc0\xbf\xf4\x06\x9b\xff\xf2\xf9?\x16[!f\xe7\xe3h\x0b\x8f\xe6F\x97C\"g\xa6\xa9\xae\xcdMt\x8bk\x15\xbf\x13\xdb\nT\xe5\x82\xfd\x1b\xbf\x08\xc0\xf4\x82$\xf7[R}\x14\xd7\xcc\x83\xd8\x16^\xba\x9a\xe5\x8d\xbb\x95z\xc7\xbbs\x9d\xd4\xea\xd2\x07\xe7\x7f\xcb\xae_\xbaD\x1f\x1a\xa1\xac\xf1\x10i\xfc\x05\x05{\xd7-\x9f\xd3\x18l\x9a\xab\\\xb9`\x9fD\x94\x06)\xc9\xe2_\xd1l\x15[g+A\x18\x8a\x06\x11\xbdB\x7f\xe2o\xaeDDi\xd0J(\xb9\x7f\xbe \xbb\x98\xfa&\xd9$|\x84h\xba\x10\x08\x82Z\x98\x9f\xb5Y[\x0ft\x82\xa8\x91i\xf8Y\xcf\x13\xed4a\x1c\x15W\xc6\xa4\xb4\xf5 \x14\x94Qm\xf1{A\xab\xd3b~\xd0\x12Q\x02\x1e\x9a\xc9:(\xe5\xbd\xb7A'\xedg\x9c\x90\x04\x9f\xe2\xafi\x8dR\xf2\xc8g\x11\xfe6-\xf2O\xdb\xd5\x1b\xb2C7\xfc\x04\xe2!\xb0L\xee\x17\xeaS\xb7\xedTN\x8a\xc1\xa0=\xec5\x9f\xcbe\xcc\xd6%KK\x92V\xd0r\x9cA?\xf2z\x90\x93\x91k\xd15\x99e\xfc\x8d\xa7\xca\x1a\xd8\xa9\xc04\xa0\xd3\xc6\x9f\xacN\xd1\xcd\x17~\x16\x9aY\xf5\xb6\x95\xe4g\xae\xe8\xb6;q#\x13\xf2\x1b\xf0\x80\xa5\x19\xea\xbc\xa3!\x84\x8cnI\x19\xa6A\x1b)\xfc\x04\xe6T?6j\xde\x9fI\xa9\xd9b\x88\xf9\xad\xd4\xa0:\xf3\xfb\x0fB\xddh!3\xf7Q,\x8eY\x8d\x1c\xabC\xfd\x15v\xdewa\xc2.\x82\xa9Mu\xa8\xff\xac\x1f\xd1/\xac\xdf0u\xb0#\x93e\x1a\xaa\xb1\xde6?u\xd4],)l\xd8\xc6}\x88w\xe7\xc3\xc2J\"\xd2s\xe8c\xe8\x88g\x9c^\xa1\xc2i\xcaC0\xe5}\x92Z\x98\xb2*Rf\x84\x98\x01|w\xd4?ir_\xae\xc1 \xf3\x97\xf3\xf6\x1c\x06\xfa\x95U|`\xb5\xc0N1H\xcf\xda\xc6\x92\x9f\x07\xcc\x1c\xb4\x99G\x81qn\xe9\x9e\x97\xc6\xb6\x8b\xe3\xe3J\xd2\xa9\xfdAx$#n\"\xa6\x92\xacG\x1e\x91t2Gx\xa7\x03*0\xce\xd0Y\x8f\xbc\xf3\x16\x88|\x10\x05\x9ft\x04X\x8eHx\xa9\xf0\x19\xf7\xd2\xe0\xaeXIe\xcaC@8\x04YpbJ\x85\x17\xe7\xff;0\xef)hr`\xaay\xbf\xde\x16f\xfcP\x1d\xd1\xbbNd1\x04i\xac\xd3\xf0\x01X\xfa\xe4\x9f\xfe;\x00\x00\xff\xffPK\x07\x08|\x80\x1e\xdc3\x15\x00\x00tA\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cV\xbdn\x1bI\x12\x8e\xa5\xa7h\x10\x10v\x170\xb4\xb93\xca\xa2\xd6\xf4J$!R6\xce\xb7\x17\x14\xa7\xcb\x9c\xe6\xf4t\xcdu\xf7\x0c\x97\\8\xbfD/p\xd1M\xe0\xc0\x81\xa3\xcb\x0c\xac\x92!\xdf\xeb\xd0?\xf3c\x1d|\xb8h\xaa\xab\xbe\xea\xae\xae\xaa\xafz\xfe8?\x1b} \x9d\x83\x1d\xbdd\x7f\x9c\x9f\x9d\x8d\xd6\xa0\x14\xf2\xd1K6\xba\x1a\xcff\x93kv\xf5\x17\xf6\xc3\x85\xf9\x81\xdd\xcc\xef\xd9\x85\xf1\x9f\xdfF\x17\xe6\xb7\xd1\xe8\x85\xc3s\x94h\x83\xc3\xf5\xe4v\xb2\xea=\x82]\xe4\xb0\xc1\xeb\x1e4\xbd\x1b\xff2a\xdf\x87.\x0b\x12\x12\xf5\x10\xbc\\\xcc\xa7\xb7\x93\xfb\xe7p\x85\xbb\x05\x19k\xa6j\x95j\x04\xefp\xc1\x99\xc2\x1d+\x9c\x9e	\xc5\xac\xb7\\\x06\x07\xaf\x1d+>u\xe7\x98y.l\x8c\xe9\x82\x07\x8f\x1f\xcdO\x0c\x14g\x17\x9c\xf9X\xdc\x9a\"\xac\xdf\xe1;\x8e\xdf\x02K\xbdA\xee\xc2s\xb0\xc5|\xb9b\x8b\x87\xfb_\x9e\xa5s\x98G\x93\x02\xa7\xdd\x95h\xb3\xbf|=\xbe\x9e\xbfcW\xd3\xff\xa7\x08\xe1\x9a\xb7\x94d+\xdald\xd8a\xf5\xfa~2\xbev\xf0o\xd2V\xaa\xbe\xc4\x0f\xb3o\x8b\x1c\x10\x95\xc0\x1d\xf2%\xe48]\xb4\xe1/\xd9\xfc\x86\xad^O\xd8r|7a\xd3\x05{7\xb9\x9f\xb0\xb7\xd3\xc9\xbb\x81\xf7\xf9\xd9\xc7\x17\xb1\xa1\x8c\xeb'\xbf*d\xa9A\x9a\xb6\xbf8\xecG/\xd9_\xcf\xcf|\xef\x9c\xfe\xe1\x8f<\x1bq\xd5|\x19\x9d\x9f\x9d\xfd\xcdG\x90R\xa9;TJ\\(\x88\xb8\x94x\xf3E\xf5\xc8\\\xa8\xd2b\x87\xcd\x85j\xbe\xda\x16\x1cV\xfb\x01\x9a\x94M{0\x1a\x01I\x8b\xf5\x0b\xaaz\xb0\xc1\x84\x14\xef\xd0q\xf9b\xb00=x\x8f\xd0G\xac)\x8b8MY\xdc2\xe6\xc6\xb7K\x9b\x0b\xe0\xb9P.\xc3c/\xf8\x8d`CNS\xe8\xb6\x95\xc0\xc70\x82vE\xde\xe3\x8d\x13\xf69\x95&\xe8\x13RVCbCo;\xc4\xfb\x1c\xd5\xa9\x16\x8c\xd6\xba\xa9\x0f\xd9~H\xd7\xab}_\xdd\xf79\x9c\x9eXu\xaa\xd1f{v`\x9a*\x05\x19n]\x91\x81k4\xd1\x13\x7f/b(\x13/Q\x05\xa7OCS\x7ft\x00\x94\xdbgg\xa7\x82\xa3o\xed$\x1d8\x87\x0cTa\xb1\x05%,i\xbfK\xf3O\xaeOO\xeb\xa6\xd6\"\x1aKcg\xb4\xf3-\xa9\x9b\xbaBfQ\xc3!\x18%%Yh\xea(\xc5\x82s\xd4\xd0\xeex\xe7VMm\xa9\xdd\x91z*W{\x85I\n\xaa\xf9\x1cM;\x85\xd1\x0b\xb6\xc2\xe2\xf1\x89q2\xedU\x0c\xe2XJ\x9f>Zk8\x88\xd3\xa76\x85-\x9dC\xa0\x9d9\xaa\xc3t\xf3Y\x88b \xb0\xa7\xed\xd2\x8a$\xdb\x87\xfba\xa1\x043A\x11y\xdb_\xb1\x93;\xbe\x0e+\xba ~|\x82X\xc8\xe6\xcf$\x8d\xa5\x14\x18\x9beO\xa5\xc3\xfd\xb8\xda\xff\xd4r\xd6\xecU\x12\xfbwD\xbc\xa0-\xaa\xe6\xcf\x80.\xb4(\x9az\x0b\xcc\xc4\x1et\xd8T\x93\x12\x87r\x8b\x9d\xf6@\xbd\x9e*\xe8\xdc9\xfe\x97\xfe\xdc\x93fdE\x8e-\x15\x12\x90\xa8\xf8\x80Co@E\x0e\xdd\xe0:Jw\xa0\xa34.Z\xe9\xae\xa9\xb7Q|\xd3|U\xbd([h\xb9\x89\xd2\x12\x8b(\xcd3\x1b\xa5\x19UQ\xba\xc6\xa4\xa7\xf3\x0e1\xebB\x99!\xc7\xe3S;T\x16\xa48\xca\x8e\xe1\x0f\x96z\xba/\xadF\xde\x02O\xff\xb2\x95\xb6\x9dm!\xa0_,iM\x16\x86\x83\xa1\x14\xdd\x0bL\xd6R\xee\ntM\xb2\x0cIL@%\x18\xfaM\x97\xa7\xba\xeb\xa7\x04,H\xda8\xc3\xafNl\xfe\xbd\x89\x06)\xdcc\xf0\xaa\xf3{\xe5\x14\xcc\x12\x8b[\xbd\x18L\x03\x02\xcd\xe3(8\xc0\xe9\x93o\xf4x0'\xe59;\xcd\x0b\xd2\xd6\xd7\xef\xf3%[Z\xdd\xd4*s-\xc1\x14\x1c\x1f\x9b/\x16\xd8AQU\xc6\xa7\xf6\x83\x90\xb8\"\xba\x05\xbd\xf1\xeen\xcd,\x11\x93^\x131J\x9844\xf4k\xb2TQ\xd0o\x88\x1c\x17H\x85kI\x91)\xc1\n\x8d\xac\xdao\x9a\xaf\x1b\x89J +\xd0(q|l\xc9!|x\xafH\xeb\xb2\xb0}\xbc\xec \xf7)\xc8K\xb6\xa0S\x9d\x11wM\xcdL\xf3uM\xfar\xe8x\x1do9\x03\xf1\xec\x9eM\xad\xff\xd7=\x85\xaa@\n\xfe\n\n\x9b\xa4\xe0\xb7\xc0B\x82UM\xcd2(\x8e\x8f\x91\x1c\x19b\xe1\x7f\x82\x84\xda\xdc\x90\xe7\xff\xaf\x88\x05\xe3Q\xc7>P\x9c\x04\x12\xe2?\x03\x19\x89\xbc\x1bG\x8e\xef\xfdoN\x1c\x0f\xe5\x01\xf2L\x95V \xabdSg\xf1\x95\x8c\x13pEW]'\xbd\x0f\xc0\xe63S\xc0LA<v\xe2(G\xc1\xe9\xad0\x82T\xfb\xb7r\xe7T\xac\xf2\xba\x08*\x8d\xbd\x03\x9b\xa4\xbeThdS\xbb\x8c\xe4\xa5\x11\xc0\x0e)\x0d\xdf\x02\x85\xbb>\xce\x19U\xcd\xe7\x18[\xacnABY\xd7\x98]\xe3.\x9c\xc6uf\xab\x8b8\xf7k\xd7=)\x0b	\x1b7\xb9\xab\x9f\xe7\xe1M\xa1\xea\xe7\xd5\xea6`\xff^R\x9c\xe23\x81\x99%v\xfa\x04,\x11\xae\x8c2\x16J#\x98\xf0r\xde\x07)j\xb9\xd0\x98\xd8\xab}\x98\x9c\xf7q\xcd@J\xb6\xde\xb3\xe9\xe29\xae\xbf\xdbsl\xf8\x07k\xf1\x1f4\x1a\x9f\xae\xf9ZQ\xd5\xf1Uc!\xfd\x80\x9f\xf3\x82*\xe400\x90\xb6\xa1\x07S\xd9\xd4f\xe0bK\xed#\x9f5u\xa5\xc1Fu)cf4T\x82\xcb\xa6\x0ez\x83\xa0c\xa1\x8eO\xd0\x1f`\xd0\xb8\x82N~/D\xfc\xb9^\"\xf7T\xaa\xf6\x85>\xd5 c\x81\xdc\xcb5#+\x12\xcf\x88\x87\x82\x0e\xa4\x95\x03Fs\xb9\xce\x85\x0dW #\xbb\xfdmZ\xe6k\x05B\x8a\xc0\xdb9\xb7\x12\x8e\x8fY\xb9\xc5\xfc\xf22V\xc1R\xe1lo]\x84^Q\xaav\nt\xdd\xd7\xd4\x86)\xe4\x94\x91:>z\xc2\x16\xa1\xf6\xad\xc7\xceub_\x87\x87\xa0\xf8&\xffe!	\xf8\x8d\x90\xf1\x0en\xc5\xdc\x0c\x1a\x9a\x17\x9a6\x1a\x8dO\"\xf9\xbb8\xce\xb7\xa1>;\xe5\xdd\xf0\x8c\xf3\xb3\x8f\xe7\x1f\xff\x13\x00\x00\xff\xffPK\x07\x08\xa2E\xfc\xcc\xe8\x05\x00\x00;\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xde\xbfsf\xbc:Mo\x1c\xb9\x95g\xcf\xafx\xe8\xc5\xac\x81@\x91\x9dL\x0e\x81\x0f\x01,\xc9\xf6x\xc6\x92\x15Kc#\x13$\x00\xbb\xeau\x17U,\xbe\x1a\x92U\xad\xea \xf7=\xac\x91\xbf\xb0\x0d\xc4\x879\xf8\xb4{\x1a\xc0\xba\x94\xfa\x7f-\x1e\xc9\xaab\xb7\xe4$\x08\xb2{\xea\xae\xf7\xc8\xf7\xf8\xf1\xbe\x1f\xff\xf4\xc5\x83\xd9\x82LegO\xe0O_<x0{\xfe\xf4\xb7\xb3'\xf0\xfb/\x1e<x0{\xa9\x19%\x9c$\x0d\xb5\xd0\xa8\xc0\xe1\xb5\x9b\x1dx\xe43\xed\x8cD\x0b\x0b2\xe0\n\x84\xb9\xd0\x1a\xcdC\x0b\xcf\x0d\xfe\xd0\xa0v\xaa\x83\xa7\xb6\xc4\x1c~\xdb\xa0e\x1a\x16\x94\xb4\x0e\x84\xceA&\x94+\xca\x85\x9a}\xf1\xe0\xc1\x1f\x98\xf0\xec\xec\xe2\xf9\xbbq\x05g\xd8\x16\x94\xeb\xfeG\xc8	j\xd3o2\x8c\xecO\xc8\x96\x02jj\xe9\xf6\xa6\xb9B\xa8\x84C#\xfb\x8d:\x80\xd2\x91\xe9?\x81\x96\x08W\x08s\\\xd7x\xfb\x9ei\xd4\x06\xd7h\xc4\xf6\x03\xb4P\x1b\x91Q\xab\xa9\x82\xda\x90u\x06\xf3\xfe\xe3\xb4\x08\xa1V\xa2\xb3\xaf(+\xc7\xa5\xbc\xdd\xde\xe4\x1d\xacE\xd5\x7f*\xc5\x15\x94`k\xca\xcb&.\x87\x87\x82\xcd\x0c)%\xf5\x12\x1cA-\x96\x08sr\x8e*\xc0\x165\xac\n\xd4\xe0\xc4\x1c\xa4\x85B\xe69\xea\x84\x9f&\xddU\xd2\xe2\xc8\xeei\x80\xac\x9b\xab\xc8\xe1{\x9a\x1b\xb1\x86v\xbbAW\xf6\x9f\xb2\x02j#m-\xb1\x15\x0eoo\xa8\x05Q\x120\xa1\xfeS\xa5\xbb\xacH\xa87\xb9\xa4\xb7\xa4\x9a*\xa1\xcf0h\x030p\x08#\x80\x16\xe0'\x80\xd4P5Vf\xfe\xc6Z\x99#A\xadD\x87\xc6\xa6\xa4\x1d-\xe5\"Y\xb6\xac\xa8\x15|\xda/^>\x87Z\xa8\x8c4f\x85\xeb\"\x13\x1e\xd0\\\xddA\x8e\x04\xe7\xcb\xd3\xc6M\xcb\xe4\x0f8\x12Y\xb94\xd4\xe8\x1c\xde\xf22\")\x8f\x0b\xa27\xe2\xfd2Sja\xc2@\xee3\x94\x9e\xe6\x95\xd4?\xb75fr!1\x87\n+\xb4P\xcb\x1as\xb0\xce\x08\xb9,\x1cH\xed\x08:j\x1e\x1a\x84\x8a\xb4td\x12F$L\xfe\xec\xba\x96\xa6\x1b\x99\xdd\xfe\xa7\xb0\x80\x0c\xeb7\x99D\xc8\xc9\x96\xc31\x9c\xd3\xed{t\x90\xeb\xfe#K(hj\xe3\x9d\xf6\x1fm\x8dmI,\xa2\x98\xbb\xfeSu\x00\x1a\xb77`\x85\xa7 `]\x89\xed\x0d\xee\xf1>\x13\xc9\xe5\x9e\xf5\x9b5\xb5;\x0c#HS\x8bW\x01q\x08\xa7\xfd\xfflo\x10hnEA-\xebE%\xaee\xd5o\x94F\xf8\xc5c\xa8\x84\xea\x7f\x04%\x9c\xd4\xb6d\xfd\xe9?\xda\n\xb5\x00\xa1pNp\xfb\xbe\xffh\x958\xdc[\xc9\xa5tjZ\xca\xa5t\xfdO\x8a\xca\x9d\xc5\x8c\xc0d9^|\xb5\xe0\x03(\x95\xc8\xe1\xe19)\xe9d)\xe0\xdf\xe1\xc2\x08-\xf1\xe1!\x9c\xa6\xeb{\x0ck-Jjw\x16\x90O\xe7\x7f\x89j\xb8`\xfe\xeb\x8dW\xe3O\xfa\xc7\xc2\x8b\xf2\xb2\xdf\xb8f\x9a\x9c\x89\xdae\x85\x18\xe7\x7f+\xea\xdb\xf7b\xbc\xb0\xed\x8d\x14\xb9\xb8\x82f{\xd3\x7f\x9c\x94n\xdeA\xdb\xd5JK%\xa1\xe4\x19\x0d_\x1c4\xe6\xf6\xbdt\xfd\x8f\xc0\x1b-\xc2\xee\xae\x10\x0c.\xa5u^ \x04\x08h;\xd7\xf6\x1b\xbf;hU\xbf)S\xa3\x10\xd7s)\x96v\\\xd3q\x80\x81c`\xdc\x9bXZ68\x96\x1a\x93\xa1\xb7\xc7q&XR\x8d\xb7\xbc\x87\xf0Z#Ob\xe3c\x84\xce\xa9R\x1dd\x05Y\xd4~\x06\x8a\xac\x80%j4\xc2a>\x108\x847\xf8C#\x0d\xe6\xcc #\xed\x84\xd4 \x1c(\x14\xd6\xc1W\x90\xcb\xc5\x02\x0dj\xe7\x17\x94\\DV\x08s\x91\x91\x99\x04\xe1\xb8\x10Fd\x0e\x0d\xd8ZT`=r\xb0	Nz\xe0\n\xbd\xa6\xd1\x82\x9d\x82\\tlJ\x05d\xe3Lf\x0e5Yw\x08O\x17\x0c\xc0\xeb\x0c1\xf7\x16\xb7@P\xb2\x92\xce\xffk,\x1aXI\xa5@cX\xbc%\xd5\"\x13\x8b;K\x96\xdaXG\xd5\xf1\xc5\xc5\xb8\xd4\xb8\xac\xef,\x9a\x9f\xe7\xb8\x90\x1as8\xbe\xb8\x00\xd3(\xb4\xa0H\xe4\x98\x03ipT\xf3Z\x99\xa1E\x85\x19\x9f\x9c+\xb0\xc2{\x88_\xd2r\x99\xa8\xc5[%\xacc+\xc9|\xa3\x84\x19\xac\xb5\x84\x9a\xa2\x80\x05\x99\x08\xc3\n\xf2\x03G\xb29.D\xa3\\\xba\xe8\xb7l?(_K\x01\xae\xff\xb1\x1a\xe4\xf6\x92\xff\x07\x97\xf8\x13h\xc1:\xeb\xc4\xf6\xc3\x01\x94x\xfb\x17(\x95\xe4\xcb\xf3\xa2\xady\x00\xae[RR\xdd\xe1\xf4J\xe8\xe5\x1dV\xfdG\xb8\x12\xeb\xae\x8c\xac\xbe\xe1\xff\x83\xf7\x8d\xdb\xd8~\xf0\x92\xdf\x8e3R\xca\x96\x0f\xc7\x88\xe5t,'h\x9b\x8b\x08\x0bD\x93Q\x87d\x96 +\xf6\xab\x16\x85I=\\.\xad\x98+|Csr\x93\xb2|/\xca~\xb3\xbd\x81\x15\xce3#V\n\x0d\xb5\xd3a\xb7\xbcs\xf6_\x95\x17\xf9\x15\xce\xadt\x08q\xa4=\x00\xdbd\x05\x08\x1b\x99\x01\xea\xa5\xd4\x08R\xe7x\xed\xf1\x0bC\x15\x88,Ck\x83\x04J\x0b\xde\x02\x1e\xdeY\x18\x8b\xd2\x11\xa3\x92\xc5\x99f\xbbI\x0c\x80\xb7\xee\x12K\xd0\xf8s\xc1\xfe\xa8\x92\x83\xe7\x17s\xd3o\xb4\x04GU\xe3\x0d\x8e(\xfbO%\xdd\xde\xb4X\x82\xd4\xfd'\xe8\x7f\xf2\xee\xc4\xfb	?\x19**H\x8d\xe4\xb7\x1f\x82\xdd\x8bVxZ\x1e\xe9\xe4\xf0\xa9$}\xfb^n?\x0c\xc6q\\\x80k\xc90\x9c\xf8\x0f2\xc7a\xc4H\xca\xab\xee\\(5\xd2\xfb\xb7_\xf3'P^S\x8b\xb7\x7f\x19n\xf4\x15\x07\x81\x1cdh\xbbB3\x05\x90qx!l\x01\x19U\x95\xd0\xf9!\x1c\x0b=\xda\x9d\xa6fEf\xbb?L\xe5\xc0\xe4\x97\x8f\x1f?\x9eL\x04[B'Tr\x03X	\xa9\x9e\x193.\xeb\x19\x03\xe0\x991dF\x13\xea\x95\x13\xfcP\xbeJ\xf4\xc8{\x88\x9c\n\xa9v	\x0d\xd10\xcf\x08\x04\xee\x99v.\xect\xf1\xfc\xb1\"\x93\xdf\x9d	uD\xddG\x82\x8c\xdb\xdb\x82E\xd3\xa2\x81\x9a1wi%\xd8{\xc8]4\xf3{\xa9}\x9e\x90m\xe69UB\xea\x94\xdau\x81|7\x13\xa9\x01\x10\xc8\x0c\xf8\xbf\xa1\xb9x]\x0b\x9d?M\xc4\xe6\x99\x87\xc0S\xa5\xe0%O\x19.)\xc2YF<){\x08\xefp^\x1d\xc0\xf9\xc9s/	\xa7\xe7_\xf9\xdf\x8e\x1a\x03\xb4\xd2\xdeS\x800\xa8\x1f:\x10\x8b\x857\xd0\x87p\x86\xab\x80	Tx\x00\x08e	\xc2Rp\xe7\xf4\xaf\xeb\x9ds\xf7\x9f>T\x9a\x165@@\x0b\xebD\x8bA\x95\xc1\xf6?\xcd\xc9$\xe1\xc5\x021\xe7H5\x88\xcd\xee\xe1k\x01\xb6\xee\xff\xea\xd8\x06\xb7\xfd_\xd7\xf3&\xf1A0\xcc\x8c\x17\xe2\x08ri9\x14g\x87\xc8\xaa\xc3\x8e(\xe4_	;\x95\xc6\x0d\xdf\x1ay\xa5\xa9U\xe2\x8an\xdf\x8fq\xd8\x90SDp\x03\xa5\x11WRw\x83v\x8b\x84\x1c\x99\x0cs\xceJ&7\xd0\xe9\xfe'\x87\xba\xdf@\xcc_\xdc\xe0u\x82qk\xb672\x04J>|\xacx\xe8\x01\xfb\x02\x85\x8e\xca\xfe\xbf\xf3\x0eD\xd8S\xc7\x19Y\xe0I\xa9t,\x85Rh\xbaS\xca'3\xf5\x06\xb77\xb2\x82%\x07\xa7F\xe2\xceV\xfa\x8f\xa0POQt\x07\x16\x88\xad\xe7\xba\x14\x95L\xe8\x12\xa5\xde\xf8E\xf8<H>>#\xae1q\x1b&^d\x85\xcfv>e\xc5\x13x\x1c	\xbc\xedn\xdfK\xeb\xd8^\xaei\xad}\xb03\x0e\x1b\xb7\xd9\xee\x10\xc5#\xa95\xe6#\xe1\xafe\x8ep\x82\n\xd95\x9d\x93u\x83\x16x\x04\xeb\x00\x8b\xb0\x05W\x08\x07\x85h9\xcfE\x0dy\x98\xb1Kz'\xa3\xf6\xf3\x19\xc2\x01\xcc#\xa1\xd4\xa3\x94\xb0w_\x96\x83\xb9%\xe6\xec\xfb49\xb0b\x11\xc2\xca\x15\x992x=\x16:?\x19*t\xc2\xcf\xda\xe5\xf9\x06\xb3\xc6X\xd9\xa2\xea\x92\x8b\x8b\xb0\xa0~\x85\xe4\xc0\xed\xdem\x1d\x84}\x19\xac\x95\x0c\xe1\x9b\x88)s\x98j\x12\xea;l/\x8b\xa6\x9a\xdb\xbd\xeba\xd5\xec7\xc5\xed\x8d\xc8\x07\xc1?\x89\xfa#\xe0\xf7\x17\x05\xad\xfe\x00\xf3\xc69\xd2\xacO\xb5\x12\x19\x86\xb0\xae\xa9\xe6ZH\x95\xa4\xbb\x05\x19\xb9fw\xa4\xf8Nd\x12\x13}=b`@\x05V_\xfd:+\x84\x06M\xd6	\xb5\x94\x89J\xc9\xe9\xba_\xd1R\xea8!\x19\xc0\"\xf85\xb1Y\xde5\x8eaSQ\xb0;\xa8)\x87\xb21k2T\xed\xab\xc3\xb0\xf3at\xb3;z\x97\xd7\x1b\"\xf7\xbaEcd\xa2o\xde\x08\x83!r@\x03.0y\xb9`[\x0b+i\x0b\xbe\xa3\"1\xaa!6\x02\x8b\xb5\xe0\x8c\x02\x14e\xa1\xda\xa3d\xc9a\xf8\xf1\xc9\xd9\x01\xa0\xe6\x18\x9eei\xd1(\x15X\x88<7h-\x14h\xf0\x10^!\x8b6V\xb5\xeb\x98CcC\xc2\x1f\x83\xd2a\xf0!<\xbb\x16U\xad\xf0	<,\x9c\xab\xed\x93G\x8f\xa2\x8b\xa8p\xd9d\x82\xfd\xcf\xc3\xbd\xbd\xeef'a\x93\xffHfR\x87\xdb\x05\xa1\xc3^\xff\x95\xa9\x88\xacv\xdc\xcd\xcbj\xdf\xdd$\x90\xd4\xdd\xac\xefq7R+\xa9\xf1\xb9t\xf7\xc9\xce\xaeK\x8dw&\xb5e-\xe4E\xd7\xc2'u\xc1\x81\xea\x1c\x0cZ\xb9F\x0ev\xc9\xe4\xb1\xc0e\xd1y1\x9f8\xfe\x90OA\xc5\xcb\xdf\x9e\x1cE.\x0c\xff\x1b\x11\xc0\x95\xf0\xb5\x94Ik\xbf\x19\x00\x07\xe9\xa7g\xdeh\x07/O\xec!\x0cc \x13\x1aH\xab.\x9a\xbe`?\x92#Ui\xe2\xf2M\x92\xad\x1c\x17B/9\x9cwh\x16\xac\xf2<\xb2\xe1\xc4c\x9a+ui\x93\xe3sh8e\xa3\xbc\x14\xeb\xb1\x90cd\xee\xf3\xa9u%\xd6|-\xa16\x82\xb9\x8c\xd7\x84\xc3\xb4\xdf\xfc\xe67\x8f~\xc5\xb6\xe0\xd1@adT	\x9b\xd1tQ\xa7\xe130\x88\xb1i\x1828\xfe\xfd\xa9^|\xc7\xf9\xa3\xb0\xf0qG\xb5\x11\xd6_l\xe4\x94LwM\xa2\x06\xa7\xfe\xd3G\xdc\xa8\x87\x15\x842\xf0$\xcc\xfcg\xcc\x96Bln\xa1\xda\x99\xc9\xcee!\x8du\xd0J+w\xf8]\x7f\xedUi2}^S\xb4\x80\xed\xa6\xffh\xcaf\xf4\xd7\x91\xbb/\xf94\x15\x14\xa3\x066uL\xc1\x83\xdc\xee\x10\xbf\x90k\xbcK\xba\xc5\xdb\x9b\x92\xecd3\xc7<p,(\xdd3\xa8\x81\x16N\x8fv\xc8\xbf\x93\xb9+\xee\xa1\xdf\x7f\xdan\xca\xeesK_\xf1\xac\xbf\xbdr\\6\x97o\xa7k\x08\x9fQ\xc2\xd8Q\x85\x9aM\xf0\xcfC\xa12\x0b\xd5NV\\o\x99y\\(\xd0\xee\x13\xbe(\xc8\xb8\xacq\xff\x17\x0c(G#v\xf4\xf7t\x02\x1d\xec\x02vux\x1a\xe7\xb58U\xe0\x03\x0eg\xfd\xdf!\xcd\xcb\xa57\xbb\x0d\xfb\x9a\x01>\xefX\x16\xa5\x81JcEZf\x9e\xee\xb46\x9d\xd6DOQ\x0f\xd5\xc03Qy\xbd\x10\xce\x89\xcc\xbb.\x1ds\x82\xe4J4\xae\xc6Lm\xa0\xe1S\x87\xdd\xf4mw\x02\xd9\xe9\x8c\xcf\xa8M\xf2\xe40\xfa\xf5\x90H\xeb\x1d\xe4D\x83\x9c\xcc\xa65\xbf^\xeb~S\xa1\x1e\xa3\xdcoM\xbfq\xa5\x18\xfa'\xb7\xef9\xf8\xb6\xb5\xe97-{\x01\xf6\xf9>\x1eg\x994Y\xd1\xec\xe7\xfbL\x7f!\x83+\x1e\xb9\x9c\xa0-9\x838K\x90\xc3\xd5\xbd@\x07y\xc4\xa7\x93mhg\xfc\xd0\x10\x07\xa8|\xb3`;\x9d\xad\x84\xcb\n\x90\x16\xc4\x9c\x1a\xe7\xbd\x84\x13fg\x83+\x16\xb74r:\xa3\x15D\x18\x1c\x85$&Z\xe8\xc6\x98\xd0G\xaa#\xda\x92^\xb2i1\x8f\xc4\xa3\\\x12\xf3E\x0e\nY@\xc8\x15\x9c\xa5:\x83\xa2\xda\xe9/\xdd\xc9\x91\x12	!\x95\xdf\xb9\xe4\xd7*\xff\xfc%\x0f\x11\xd0\xf1=\xb5\xd9!r\x82\xa1H{9\x15iOE]\xf3\x0eh1\xc6\xd8\xe4k\xa6{\xf6y\xa8\xd8\xfa\xf2\"\x7f\x8f=\x89\xfd\xca\x12\xad4&J\xf7:|\x1eL\x1f\xbb\xca\xe6\xabO\x10&\x85\x84\xc1\xc7\\\xa1|\xe5\x95A\xa9\xc0\xc3\xdbpC\x8a5\xb4\xb1\xeen\xd9\x974&\xeb\xa8\xf7\xcf\xef\xfcsg\xc7\nvl\xd0\xdf\xcan\xf4\xc5z\x03YD\xfdCQX\x18\xec\xcb\xc3\x83\xee\xfe+\x031\xa6\xf7\xd2\x07P!D\x9a\xc2\x1a\x0f\xf4!>\xbc\x92\xba\x04\x8f\xb7\xacN\x07\xe9\x00\x0e\x1d0\x0f\x01T\xa3\xf3\xe85\xfd'\xa3\xf8z3%\xb3\xf2\x10\xde\xb1\x1e\xc52a~\x00Z\xb4r)\x1c\x06\x01)v)Im\x1d\x8aT\nj\xd3h\xdc\xab,\x9e3,\x8aY\\U\xc8$G\xd1\x1b\x93FN\xef\n\x91\x83\xd0\xddd\x03}\xaew\x06\xb9\xe8\xec\x1e\xa3\xcb\xc2\xa0\xb8\xc3\xc9E\xe8\x0e\xab\x08\xfc\x0c\xaf\xc0G\xea\xbbl\xbaf\xa4~\xa1\x1a\x07\xa8\xf9`\x06\x93pB\xbe\x96c\xcbi\x86\x99_\xe2\xf5du\xdf`\xfe\xe8H5\x08\x97S\xabz\xc8\xf6\x0c\xe7\xb4:\x879\xe3\x1d^;\x90\x0b\x08\x86\xc2W\x80\xa5+\xe0\xe1\x1f\xcdC\xb6+\x0f\xff8O2\x06\xde\xcbk\x9d\xe4\xb2\xafP\xb3\x91\x0d\xe5\xf4\xc9>\xfb\x8a\xb3\x0f\x03\xc7\xe6,#\x13:K\xc9\xbe+Yn\x04\xec+\x8bA%\x9cl\xf1RVi\xe9#\x00\x81\xa1\xd6\x89\xaa\x1eN}\xc4x9q#\x9aS\xa3\xc3'\xf0\xf0\x17PPc@,igW5\x8a\xf4\xe8\xfc\xe7\xdd\x95\xd4\xaa{\xb3\x13\xb3\xfd\xfe\x0d\xc3\xfe\xc0&! \xa2\x91\xa3\x16\xc1\xa3\x86|:\xca\xb0\xf1j\xeb\xb3\x8b\xd8/\xa9w\x02m\xce\xfc\xbe{\xf3jZ	g\x82\x0c8\xd8\xf9\x1c&\xfb\xe8)\xd8\xc3\xa9A\xc5Bk\xa9\xda-\xdc@m\x88c\x18_\x17\xf6\xf5\x8bDw|;'\x91e\xd1\xf6\x1f\xdd\x18\xbc\x05\x83\x19Z>w+n\x0c\x0f/\x17`%uN\xab\x89\xacMC\xf1\x8b\xa9\x93qB^\x05\xe6MU\x07\x87\xe45d8\xa2\xa4\x97\x14\xac0\xc5Z\xc7`\x16\x0fS\x0ej\xba\x8b\xa3\xe1}\xc3\x06,\xdd\xdeDf\x17B\xb9p$\x98q\\\xee\x8c\xac3\xca\xd1\xcb\xff\x18.\xc5\x86\x1fS\x877\x98QU\xa1\xce\x83m\x9c\xe3d\xf0\x7f\x15\n\xec\x18\n\xec\x8a\xf4rg1M\x86ZP\xb2\xe5&\xc331\x04[\x03\xfe0\xa3\xea3\x19\xa0E\xcb\x16t\xaf}\xfe\xb6\xab\xcdv\xe3\xdb`\x96\xbd\xbc\x14\xbe\xb1\x1aZ\x1d\x91\xb8o\xb1\xb7P\xd3\xed{\x87\x90o\xff\x83\xb2\xe2\x00J\xcc;\xb0b\xbf\xfe\xc83]7\xb4|d\xc6\x01{^\xa8~c\xd3\xaa\x8c\xadI\xaa\xd4\xb3\xb2!\xf1\xbd\x13[\xd3\x95\xc2\xd1+\x9d\xfb\xb7'\xbb\xfd\xba\x9f\xfd,\x94r\xfd@\n\xb0\xb9\xa2\x92\xda\xd0}N\xd88\xb1X\xec\xb6\xcac\x8b\xde\x90\xbaS\xd4t-]5\x8c\xe1\x9c\xa4P\xa2\x95\xb7\xefK\xbc\xa7\x87\xcd\\\xee\x18\xaa\xe4\xd5\xced\xa0\xc8\xb7\x9bT8__<HK\x92\xa1\x8d9\x1e\x81\xff\x8a\x92\xe5;\x9d\xbe\x1f\xba\xd7\xeb\x0c\x02\x1dn\xf1T\\O\x917\xa7AB\x0d\x02\xef_Dt\xdeFE\x92gM5G\xc3\xe2\xcf\xce\xc0\xdba\x8e\x1e'\x874\xc7\x05\x19\xf6\xd4\x91\x84\xb4w+\x9d;\xdc\xe5\x14\xdd\x9eJ\xfd\xff\xc1\xfd\xef?z\x08\xb1;\xfb\xbe\x9a)\xd0~l\xee|\xa2\x7f\x11\xc4o\xd2\xa6 K\xfb\xa9\xa5o\x16\xf7?m?\x0c\xc26\x0e\x00\xff\xc8\x83\xaaA8\x92\x0b\xe2\x08\xe8\xe8\xc5H\xfa\xd8\xf7\xa6\x93\xd75\x91v\xac8\x84\xd6u|\xc74\x8d\xd9\xa3\xf6\x0fW\x1e\xee#\xe1\xd3\x85\x18T\xbc\xd6\xdes\x8c\xa4\xde\xf9Tb\x08#8\xe8\xf7\xe8\xc0\xe0\xe9\xa0\xc3B\xa9\x0eD\x9e'\xd6\xd4\x13\xc5\xfc\xee\xd4\x89-\xce\xab\xcf\x97U\xdf\xe1\xfc\xf4\xbe\x92\xea\x10E\x04\xbc\xc1V\xe2\xcaS/\x98\xd4\xe8\x89l\xe8Z\x81g0\x85\x871\x90Ic\xb7U!\xdcS\x9d\xfa\xf7w#$\xb0\xe4\x11\x82\xbf\x0f\x97\x02\xfc\x1f\xb0\x99A\xd4\xb6 w\xc7\x8c\xb2{\xdb\xe9\x94\xbc#S\xb2\x93\x1a\xc8}-s\xb4c\xdb+$\xd5\xbc(\x1bb\xe3{o(\xd2\xdc{\xbep\x1e^\xcf\xf5\x9f\xc0\xf8n\xcc?\xcf\xa0\x13:\xc7\xc9V\xfc.|\x1e$\x1fw\x9d\xc6\x17\x0f\xfe|\xf0\xc5\x83\x19\xd5!S\x8d/\x17u\xe8e\x87\xdf`c\xfdY1l!C2\x1a\x00ag\xb1\x943\xe0\xc2w$m\xc98\xde\xf6\xe0\x05f'f\xa9\x1b'\x83\xfaq\xbe\xa20\x0f\xef2\xc6^Sh\xd9E\x97\xd4\x85F\xf94\xe1\xf6\xbdkb+<\xef?\xee@\x13\xeb\xcbg2sbla\xcc\xden7Hs\xcct\xffc\x98\xb3\xfd/\xd7\x7f\n]\xe7\xd9\xdb\xce\x97\xf69\xf9g+\xbe[\xdb\x9a]\x94F8o`\x02U\xacj\xc5\x89\xc5p^s\xa1\xcfS\xdd\xfd\x1d5I'\xc9\xa7\xcay\xa8\xe3?\xfa\xd2>\x82y\x07_N\xcd\xfa\x05)E+N\xb2\x0c\nK\xfa\xc9pe\x1ce\xce\x85\x0e\x89\x967\xb6\xc8:\xf2\xa5\xe5\xa0Z\xf2\x9f\xc3A\x87\xd3b2O\x0b\x8d\xe6\x83\xd0\xbf}y\xcev\xf6\xcbX\xce\x89\xd7\xd2\xc8\xddg\xaa\xb14\xe9\x9f\xd7\x85M\xc7\x9c\x97q\x17\xb1,\xe2\xdd~\x03\"1\xc63\x91sJ7\x14o#\xac\x0e\xe6gv\xce\x1e]\x8e`k\xe5R_\xb0\xbb\x8eS\x8c\xb8\xfd\x0b\x90\x159j\xeb\xda\x10\xe7\xf0i2\xfaH\xe8\xf1[\xa3\xb9\xa81\xe3\x03\xe7\xcdb\xedl|\xc3\xf0\xcb\xc7\xf0\xcd\xf9\xb3\x17\x07p~\xf6\xe2\xc0\xbf\x85$\x03\xef\x9e\x1d\x9d\xc2B\xb2\xb6\xf8L\xa4\x8a\xd5\xc3\\V\xe8M\x88e\x1f\xf5\xd5\xe3\xc7\xd7\xbfx\xfc\xf8`D\xf3\x0c\xf0\xd5yZ\xf8\xc7\x11\xdf\x1ey\xd5\xd3\xfeE\x98\xce\x0f\xe3z\xfc\xd6\x8e\xe2\xfe\xa7\xa7n\xe9+\xb7Y\xe6k\xe2IEd\xf6}\x85Zn?@\x816>\xa6\x9be\x8a\x8f$c\xec\xf07\xc2Q\xb0E\x1d\xfb\x9f\x11Lz!\x97\x8d	Y*\xe3\xcf|\xe3\x82\xa9\xf2\x8d4{\xc3.\xc2;\x84\x9dq\xc9\xdb\x84\x99\x8f\x87'bo\xbb\xa1\xa4\x96\x10\xcb\x85\xf3{;\x11\xb1#=\x0b\x0e{\x9c\xf5},\xd6\xa7s\xfc\x88\xc1\x9d\x8d#\x82RQ\x99\x0e\x8ae\xbeqLZ\xe3\x9b\xe5\x8d\x19\xcak\xb3\x93\xe1\xbf\xc7\x04m\xf0\xe2\xe0\xc3\x94\xe6*\n\xed\xd0\xdf\x0fR\xeb\x9f\x01l\xc23\x80\xb8\xfaE\x93\x95\xaf\x83\xfc=\xff\xee\xf8[x\xfd\xfcy@,\x15\xcd\x85b\xf8\x0bEs\xff02 |oq\xf6\xf2d\xf8B\xed\xa4\xf3\"\xf02\xfc\x8f\x94\xa5R\xb8\x0c\x14\xceP\xe1\x92It\xe1Eh\x18\xa0H\xe4\xc7\x89\xb4(Yj\xe9\x03\xf1\xe11\x19\x9b\x1e\xff\xf0QN3\xa4^\xde\x11}qG\xd0\xff\x9e\x9c\x7f\xf5O\xc8\xb9\xa2%\xf9B\xf7\xecu\x8c\xed\x07E\x0e\x98\xf0\xd2$A\xc2\x9a\xd2G\xd5ka$k\xf6`\xa3\xf7*\xa6\xb3\xefjZ\x93\xd1\x93i\x9f\xbc\xd0\xec-\xdd\xde\xcc\xa3\x86\xd1J\x9f\xd1X\x98\x99\x9da|\xca\xf7q\xbb\x89\xcf\xed\xd2\x07\xc1\xbe\xe0\xe4\xcdK*Juc\x96\xa3\xb0\x9d\xf3\x87\x8fHCo3\x0c	^\xf1\x92H9Y{\xf9\x90*4UC\xf03\xef\xc06\xf3+\xcc\xdc\x01\xcc)\xef\xf8\xfcC~\xa9E\x85\x80:\x13\x16s6\xcc,\x80V	[\xa0=\x84\xe1\xce\xde\xe0\xb2Q\xc2\xb0!7!I\x8b\xe6{f\xd1\x85b\xad\x9dT5\xd6W\xbbq\xc4\xab \n\xc9\x08\x0eb\xd8\\7\x89\xf8\xc4\x83\xb4\x85\xe0<\xfa	\x0c\xff\x12\xe8\x91\x0f\xe8g\x17\xfe\x03\xe6\xb1W>\xbai\xaf\x8cdD\xce\xf7\xe9\xdf\xd1j\xc1!\xdc\xed\xcd@;D\xd5\xa3v\xc7(\x1b\xd2\x93\x0c\xc7\xe4\x8f\xda`^\xc5\x1csf;\xedm\xdd\x05/\xbf6\xb2\xa6\xab\xe9\xf2\x19y<8\x9c\xef\xb4\xfc\xa1\xf1-0\x1d\xde\x82\x8a\xcc\xc9\x16\x1f\xf9\x17i\xec\xd0\x82k\xf2\x13]\xa8Y\xcd\xc6*\xd5\xcc\x85@\xd0\xc7\x0f\x11\xd2\xd5\x1er\xd9\xd5\x01\xd0\xe8\xe8e^\xe7s\xa1\x9b+\x8eV\xbe\xf8\xf3\xff\x06\x00\x00\xff\xffPK\x07\x08\xb4ZGmG\x13\x00\x00\xc31\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cV=o\x1b9\x13\xae\xa5_1X\xc0H\x02\x18N\x9fN\x92\xd7\xb6\x10\xdb\x12$%F\xf0\xe6-\xc6\xcb\xf1\x8a\x11\x97\xdc#\xb9V\xd6A\xea\xfb\x13\x87\xb8\xbc\xf6\xd4\xa4\xba\xce\xf2\xff:\xf0c?d \xb8\xabv\xe6\x99g\xc8!9\x0f\xb9\xdf\x86\x83\xe4N\xe9\x02m\xf2\x0e\xbe\x0d\x07\x83\xe4\x16\xa5$\x96\xbc\x83d<\xba\xbeNOa\xfc	^\x1d\x99Wp6[\xc0\x91\xf1\x9f\xcf\xc9\x91\xf9\x9c$\xc7\x8e\xcfH\x90\x0d	\xa7\xe9e\xba\xea2B\x9c\x17\x98\xd3iG\x9a^\x8d\xceS\xf85uY*.H\xf7\xc9\xcb\xf9lz\x99.^\xd2%m\xe7\xcaX3\x95\xab\xb5&\xf4	G\x0c$m\xa1t8p	\xd6GNB\x82GG\x92M\xdd<fVp\x1bk:b!\xe3\xb5y\x03(\x19\x1c1\xf0\xb58_EZ7\xc2/\x12\x0f\x89\x95\xce\x89\xb9\xf2\x1cm>[\xae`\xfeaq\xfeb;\xfb\xfbh\xd6\xc8\xd4v\xcc\x9b\xdd_^\x8cNg70\x9e\xfe\x97C\x08\xcb\xbcT\xd9f\xa5\xf2\\\x84\x11V\x17\x8btt\xea\xe8\x07\xdbV\xc9\xee\x88?\\\x1f\x1er`\xdcs\xda\x12[bA\xd3yS\xfe\x12fg\xb0\xbaHa9\xbaJa:\x87\x9bt\x91\xc2\xc7iz\xd3\xcb\x1e\x0e\xbe\x1f\xc7\x862\xae\x9f\xbcW\x8aJ\xa30M\x7f1\xac\x93w\xf0\xbf\xe1`0H\xf2\xa7\xbf\xa5\x9f2\x98\x82t2\x1c\x0c\xfe\xef\x8bX\xabJ\xb7D\x83h#\xd1\x99\x07\xc4\x82\xcb\xcaRKe\xb8\xe1\x1b\x8c\xe4\xe8t\\%\xed\xba\xa5b\x1diXw\x14C\x99\x92\xac\x9b9\xb8\xc7=\xc7t\xe4\x9a\xb0+\xb2\xde\xefD$z\xd3\xb1\xe2\x8e\xf8&iv\x00Y\xc1\xa5\xdb\xd7\x917\xfc@\x98+\x87\xb8O\xf0}	\xfe\x13}\x15R\xdc\xd7#\x99\x92VcfC3\xbb\xd8$\"\x10\xa1\x9e<\xc7uw\x9aA\x8b\x80B\xc0m\x0d\xd3y\xe0\xd1\xd72\xceyN\x92??\n\xb2\xfd@7K\xea\xfd\x839\xd6\x9c\x91\xcf\xe4\x0f\x82\xa2\x94}\xb5\xcd\xea\xbe\xa0\xe4Vi\x9f\x7fE\x9c\xc5E~\xa9\x8c\xbdV[\x87>?\xf2\x82\xf1\x00\x0b\x95mB{F+\x1e\x1d#\x8d\xed(\x8d\x17\x82\xaa\x93\xe4\x81\x0c\xd5VRH\x18+\xd4\x0cf\xce\x8fz#\x1a	\xe1B\x17T\x1a.9\xe4O?\x8dm\xc3\xebP\xd8\xf9\x01\x18\xee&/\xcfh\x06\xf9y\xd1--\xcf6\xb5W\x9e\xf7\xc1\x04 \xaa\xae[Vk\xb7j\xeb\x9f\x8f\xb3\xdac\xa9U\xe5\xb0\xd7K\x92o\x1ay\x99Zf\xb1\xe9\x12\xc6M\xa6\xa4\xa4\xac]rt\xb9\xccc\xcd\xb5\xcc\x0e\x9c\x86\xc8(zC\xdf\xcc\x89\xe5\x055-\x9a\xa1 \xc9z\xbd=\xcb\x1aI=\xff\xa8n\xa3y\x85:Z\xd7\xdc\xb4X#\xaa\x0b|\x88\xd6\x8a\x8ah\x8d\xf6\x8fU4\xd3\xba\x11K\xba\xe1\xd1z\x8f\xcd0#\xddS\xed\x96h\xd3V2o\x87\x9d?4w\xc2\x12\x9b\xb1\x9e~\xd7\xcf\x8fM\xbc3'\xcd\xac\x13k\xfa\xba\xacx\xfb\xec)kU\xe1%&b\xe7g(3\xf2\x1d\xb2\xff\xab\xb4q\x8a$C\x8bB\xe5^p\xd1\x0c\xb8\xe0\xee\xf6\x9d\xb4I\x13\x07\x80U\x10\xc79\xee\xc9\xd15cO\x8b\xb7\xde\x0f\x04%\xbd\x96\xa6E\xa9\xb4\x05Se\x19\x19sW	q\x02\xab5A\x899\xc1\x96\x0b\x01RmA\x93P\xed\xf3v\xc7\x05\xad\x94\xbaD\x9d\xfb!\x9c\x0fV)\x10\x1e\x89\x1c\xc9\xcd:\xbe\xef\xdc\xda(\xb9\\)\xd7\xc0J\xfau=\xff@\xbd\xd9\xef\xea\xfd\x0eB\x80!\xa0F[\xe0\x06\xf8\xd3\x9f\xeeY\xdd\xef6\x02\xeb\xfd.\n\x9c\xfbj'J\xeb\xaa\xb4\xbd\xf2\xef\x90\x0bb'p\xe6J\xc9b\xb8\x97q\xfa\xab\xd5\xfe\xebb\xb9\xbcG\xc1\xd9\x04K\x9b\xad\xd1\x8f\x11\x10\xc8\"\xe4i\x1b\xa2\xd2o2\x97\xf9\x99\xf2\xca}OT\x02\x8b\x18\xdc5W\x88\xc0\xf0V/\x9b\x0b\xd6i\xb4\xfb\xb1\x88\x92\x0e\x0f-\xb8X\xc7\"\xb6R\xe3\xae\x7f\x9e\x1fq\xff\xe8\xf6\xee\xe9\xa7d\xa4\xb9h.\xb6\xc2]}\x1f\xb9\xe1J6\x7f\x06\xfe6\x84{\x8fERe\xec\x15\xda\xcc=S\xc9\x1c\x8d\xd9*\xcd\x0c8\x18\n\x8f{\x9a\xa4mW\xdc'\x92\x1c6J\x86.OJ\xc5\xa5u\xad\xd8v\xea\xdc!\xae\x17\x0fZ\xd6\xbfJ\xdd\xcd\xee\x7f\xa6\xde\x06\xff\xedju\x19H\xbfU\xca6\xfd\xa29\x18\xfe\xc0H\x02\x8a\xfdN\xda\xfd\x0ej,\xed~\x17\xa8\x9a\xd0\x84gj\x11\xac\x882\xae)\xb3\xe3:\xdco\x8b\xe8\xbf|\x81:^\xb7\xb0\x97\xdc\xb0\xfd\x0d\xffN\x93Y\x07Z0#^\n\x7f\x0bO\xe8\x1eK\x81-\xaa\xb4\x0db6%\xaf\x95\xec\"\xb6\xd2\xbe\xeas\xd2\x1cN\x9f~6\x85W\"l\xcc\xc2\x1b\x1e3\x84:\x1c\x8d\xbb\xa1\"d\xdc\xe9\xa5_K\x1e\xffZ/U\xce%D\x1c(\x06\x02y\xad\xb6\xd7\xca\xf2\xcc\xb7}\xb4B\xa4\xba-x\xe8\xc0`y\xd4\xae\xab\xe2V\"\x17<Hs\xd5\xf3ON\xa2\x16\xac*]\xec\xe9\x0f\x13\xb3*\xd9\xe8\xbc\xe9\xb3O\xaa\x825\xde\x13\xa0\x84.\xea\x7f^\x9b\x94\xadk\xaen\xeb?\x04\xe0`\xcb\xab\xd2)\xd0\x89\xd93\xbc\x07\xee\x9a\xe9\x87\xe7Z\xe5\x9a\x8c\xdf\xb9\x80\x10kK}1\xc9M\x7f\x8a\xe1\xe0\xfb\xf0\xfb?\x01\x00\x00\xff\xffPK\x07\x08E\xd7Z^8\x05\x00\x00\x91\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xde\xbfsf\xbc:\xcbr#Gr\xe7\x99\xaf\xc8\x80C\xa6\xbd\x01\x91\xd4\xee\x1e\x1cs\xd8\x08>G\\\x913\\\x82\xa3	Y\xa1\x8dHt'\x80ZTW\xb5\xaa\xaa	b6t\xf6O8\xcc\xe3\\\xc5\x8bN\xba\x11\xf3_\x8e\xcc\xaa\xee\xae\x06\xc9\x95\xc2\x96}\x02*\xb32\xb3\x1e\xf9\xae\xfe\xfb\xcb\x17\xa3\x99u\x95\x1f\xbd\x82\xbf\xbf|\xf1btz\xf0\x97\xd1+\xf8\xf6\xe5\x8b\x17/Fg\x86Q\x18\x945P\xa3!\x0d\x81n\xc3h,\xc8\x13\x13\x9c\"\x0f3\xeb ,\x08\xa6h\x0c\xb9\x1d\x0f\xa7\x8e\xbeo\xc8\x04\xbd\x86\x03\xbf\xa4\x12\xfe\xd2\x90g\x1e\x1e\xb4\xf2\x01\xd0\x94\xa02\xce\x95-Q\x8f^\xbex\xf1\x1d3\x1e\xbd\x99\x9c\xbe\xefV\xf0\xc6\x06\x98\xe0\x8c\xe0\xd4:xo\xdd2	?\xb4\xe8J@\xad\xed\xcaC\x85\x81\x9cB=\x86\xb0\xc0\x00\xe8\x08\x8c\x0d\xe0\x99.X\x98\x12\xdc(Z\x11K\x05\x84\x95uK s\xa3\x9c5\x15\x99\xd0KF\xbd\xc2\xb5?\xb7\xc5\xb2\x93\xff%9\xf8\x80\x15\x1a\xc0Ow\xb8\xb9\xdb\xdc\x97\x08K\xd4i\x19\xcf\xa2{\x9e\xc6\x9au\xa5<u,\x0f\x8c5\xaa\x825\xd6=\x93%y\x05\x18\x11\xf3\x87\x9f| \x97\xb1hJe\xbf\xb6\xba\xa92&\x0c\x83\x9b\x08\x8cl\xe2\x0c\xb03\x10\x02\xdel\xd5xU\xc8y\xdf\xa8\x92,\xd4\x1a\xd7\xe4|\xce:\xd8\xb9\x9a\xf5\xdbEGK\nZ\xc1\xeb\xb3SX>\xfc\xfc\xf0\xf1\xe1\xe7\xa5#\xaf*MN%IO\xe2`\x11i\x81\x82W\xa6\x171\x9d_4\xa1_8\x0f\xe0\x10\x8b\xe5\xdc\xd9\xc6\x94\xf05/,\xf1\x15\\T\xa5\x0e/\x0b\xcf\xb9E\x82\x96\xdd3\x9c\x0e\xcaJ\x99\xcf}M\x85\x9a)*\xa1\xa2\x8a<\xd4\xaa\xa6\x12|p\xa8\xe6\x8b\x00\xca\x04\x0bk\xdb\xec8\x82\xca\x1a\x15lv\xe8SV\xb0\x93\xdbZ\xb9u/L\x94\x8e\x04\x08Au'\xff\xa6\xa9\xa6\xe4\xf8\xe4K\\{X\xa9\xb0\xb0M\x00C+\xa8\xad\x0f\x1e\xa64\xb3\x8e\x00A\xb8\x82\xf2P\x92\xa6@\xe5\x96\xbc7\x98]q\x94f\xb0\x17\x83\xf1z\xf9\x84\x98\xb7\x90\xec\xc2\x11\x1a(\xac	\xa8\x0c45+\xfc\x17\xfb\xa0\xed\x8a\\\x81\x9e\xe0\x1c\x832\xa0)\x04r\x1e\xac\x03#\xab\xf5\xbb[\xb2\xafU\xd0\xdb\xc2\x83\xc0\xa2t\xc1?\x12?\x06\xdf\x14\x0b@\x0f;\x07FU\x0d\xfc3\\\xa0\x99\xdb\x9d]x\x97\xd6\xb2\x0f\xc5\x02\x1d\x16\"_[3\x1fH.\xf3\xe3-\xd7\xad0\xba\x0d\xc0\xc8V \x9fcOV`\x1d\x8a\x05v\x94Gi\x9c.\xdf/\xa1\xf1,-X(lU\xf3Q\x03B\xa2\x12\x87U\x90\x93\x03\x0b\xe8\x97\xec\x96\x96\x04\x8e\xe6\x8auC|\x12\xdbLX8\xc2\x12\nG\x02{$\xfe\x1a\xe7~{	\x10\x18\x986\x81sY\x82\xb7\x8d+(\x8aM\xb3\xbc\xd5\x8dx\xc4]xk\x88\x89X)\x1c\x9a\xd2Vz\x0d\xc5\xc2z2BAX,`N\x86\x1c\x06*[\x06\xbbpE\xdf7\xcaQ\x19\xf7\x18o\x1f\x03hB\x1f\xe0\x0fP\xaa\xd9\x8c\x1c\x99 \x0b\xcaN\x9c\xefbRX\xd7_\xf5Q{;\xe0k\xac\xc0\x0b2\x9d\xa4	J\x80+\x12\x8b\xb13v\xd6j\xb6Vf\xce\x07\xdaQ\x8ac\xe5;\xda\x85\x83\x19\x03\xe8\xb6 *y\x1a\xdf\x9eV\x95\n\xf2\x8f\xef\x05VJk0\x14\x17\xef\xad\xbe\xc9n'_j\xe3\x83\xad\x8e&\x93n\xa9iY\xef<\xb9\xcfK\x9a)C%\x1cM&\xe0\x1aM\xac[XR	\xd6@\xb0u\xab8\x9e4\x15|raA\x15=\xc1\xfc\xda\xce\xe7\x99\xe2\x1f	\x9c\xb9\xb6\xb7(x^8\xb3,zt\xc7\xaa\xa4\x196:\xe4\x0b=\x8e\xa0$41\xe2\xff\xbcc^'L\xd7\x90\xe8\xc6\xb0Z\x90\x01c\x0d\xb1\x12x\n\x8c\xe4\xb5\x17Z\x0d\xe2S\"8G3\x7f$I\xa3\x9978o\x85\x9d\xa7!\xd4X,\x9f\x10\x9a3\xf5\xbc'\xc7\xb4=S\xdfL\x12,\xf2\xcbf\xedZ7\x07q\xfb\x80\x0e+\xccX)\x8fSMWvjCo\x19\x97\x8enX\x11\x0b\x87+\xcd\xd1g<\x00s\x08\xaaD\xb7W4\xf5*P7\xb1\xf7-\x9e\xd0\x15\x0b 3W|H\xa6\xa4[\xc1\xcf\x9c\xad\x00\x8b\x82\xbc\x8f\xaa\xa6|\xf2\x8a\x8f\x16\xc5:#N\xad_\xd8q\xc4\xf0\xc9\x7f\x8e\x1c0\x92{\xee\x0c~\xb0P\x0fh\xd6,\xcc6\xbc\xea\x1a]\x88\xf2\xf9\xa6v\x84~\xa7C\x0b\"\xf21\xf3\xde[f\x91\xb7\xb4&\xf3\xb6*(\x97\xc4-\xb1\xc6\xecz\xc4\xf0\xa6\xa8u7\xf9\x9f\xfe\x8d\x87\x80\xc6\xaf\xfa\xd3<\xe7\xc4\x8aC\x7f\x84vIY\x9a\xbc@\xbf`WX\xa1y&b\xecw\xa4\xec\xfa~\xbf?t\xdb\xc1\x06\xd4\xd9\xa1R\x85J\x9f8\xd7-\xea\x84\x01p\xe2\x9c\xed\x96\x94\x0cG\xa6\xf2)\x90 \x9f`r\x81J\x0f\x19\xb5\x19&SD\x06O\x90]\xa2\xcf\x94\x0c\xbd_YW>\xa6\x84:\xa1\x9eba]\xd8\xda\x82'wC\x0ej\xc6<\xe6\x95a\x9f`7i\xa6Or{\x9e\x91o\xa6\xa5\xadP\x99\x9c\xdb\xed\x82\xf8nzV- \xb2i\xf1\xcf\xdb!\xdd\xd6h\xca\x83Lg\x0e\x1f~\x0e\x0f?\x1b\xe8\xb3\xb59\x19\xf5\xe9NS\xbb\xcbo\xc8(\x98+W*M\x0eJ\\(\x0d\xd3\xe7\xa9\x94\x1b\xac\xb8\xce\x0f\xf2xs\xff\xe9\x0e\xdd\xe6\x1e\xba<\xf9`\x8dN\x0b\xa8\xb4~\x8d\xeb\x88\xeb\x18\xcc\x88J\xce\xf9\xe2\xe5\xb7|N\x134\x1eY\xe6\xfba6\xc0\xb0\x02\x97\xcasr\xcb\x81\x88\xd5\x9e\x03@\xacG2!z\x10\xaf\xd9N\xdd\x1a\"t\xdc:\x04a\xc2\x81\x8c\x1c\x14\xf9\x14\x0e+\x92\xcce\x0c\xad+\xa8\xe4t\xbe_\xb2\x80\xe0@\x92~\xdbd\x8c\xc5\xd3H\xf4\xe3\x8c\xce\x8f!8U\x17\xb6\xa4hp\xb2\x91\xa7\x84\xccQkr\xeb\x0b[\xf6\xee\xe2u\x84\x81\x00\xa3\x84\xb7F\xaf\xc1/l\x9bq&\xf3f\xbbS\x15\xce)\xe7hm\x1e\xf0^\xc7\xe18\x1b<\xadU\x0bU\x96\xd4o\xf5\xb5\xfa\xa0\xd5+\xd8o)y\xd8)\x90\x82@\x95\xfa\xa0i@N\x87\xca\x18*;\x16_\xaa\x92\xe08f\xc2p)\xfb\x1e\xf7\x08\xf6[q3R\xd5-\xf0\x86`Jd\x1e\xe7\xce\xcczP5\n=C\xf8@\xf7P\xeb\xbd\x9cqt\xc5\x9c\x18\xcd\xa9\xe4\xf0\xd2\x15\x8b\xec5\xa5<\xec\x1c\xbb\x10CE\x01\x85j(\xf3\x8a\x8a\xc6yuC\xbaOb;\x98\xac\x1d\x16\x8a\x93\xa0'\xb7\x95\xaaUG\xb5V1\x15B\x88g\x1cI]\xc6} \xf6z\xd1TS?\xdcl`\x98a\x1d\xda\xd2e\x84o'\x0b\xbb\xfa\x0e\xa6M\x08\xd6\xb0\x81\xd4\x1a\x8b\x94\xc9wD\xbd\x00\xeb\xd4\x07V\x1e\xcd\x17\xa2\xb2L\xe3\xc4p\xf0mo\xb8\xf5iC`\xc7F\xf5\xb7|n\xe7\xaa\x8d\xa5\xd9\x04\xd6\xca/-{\xc7v\xe2\xc3\x7fzv7%\xad\x97dzW\xf3/W\xac\x8d\xff\x9a8\x9cru\xff\xf0\xb3\xcc$\x98\x93.\xd5\xe6\x8e3\x82'}\xdbP\xdc\x95\xb5\xe1\xed\x0d9\xa72c:c\x0c8k\x03\xd8\x16\x17E\x9d\xcd\xb8:\x84\x95\xf2\x0b\xbe\x9d\x05_J4\xa6\x94x\x80\xa7\x1a9/\x07m\x8bX7H%\x81pt\xfcf\x0cd\xd8\x8b\xb0\x16\xcd\x1a\xad\xa3\x08,KG\xde\xc3\x82\x1c\xed\xc29\xb1RSU\x875K\xe0\x1c\x93\xa7\xa7\x1c\xad\x9d\xbc\x0b'\xb7\xc8U\xcc+\xd8Y\x84P\xfbW{{q\x1d\xbb\x15\xcd\x9b\x029\x12\xecl\xedu\x98\xe3\xc7M\xfe\x9a\xfc\xbe\x8e\xb7\x0eh\xe2^\x7f\xcb\x84^U\x838\xb1\xf9\xf1\xe1#_\xd7sQ\xa2D3\x88\x12\xcahe\xe8T\xf5\x1c^\xa7\x8bn\xb5q\xf3\xa3^\xc2\xfc\xe1'S\x12\xdf\xe3R=\xa5\x14\xc0\xab\x8b\x92\x90';\xf6\x08\xeb&h4\xe5\xe6>\xd7\xe1\xef\xcb>\x9e\x9f\xfd\xe5\xf80Ia\xf8\xf3\xc1\xf7o(\x9d\x84\xde>\xff\xdc\x02\xc6\xf9\xb0K\x15\xcf\x8e\xfd.\xb4s\xa0@\x03\x96}ytr\xd1SdG\xa8\x07\xb9\x7f\x17\x15\x8f\x95VP\xd2\xe6N}\xba\xe3T\xb2\x9f\xaf\xcc\xd2g\x99DpdPC\x04\xa7S/\xcb18\xaa\xec\x0d\x01\xe7'\xa5\n@\xb7\x81\x1cO\xfc\xd3\x9f\xfe\xb4\xf7\xc7b\x81f\xef\xf3\xb0\xae\xd9\xc8\xa4\xac,\xf2\x80R\xa1/l\x7f)\x17q\x18\x99\xa7$0Ni\xa3\xf46\xa9hgG\xdfZ\x9f\xa8l\xb2\n\xf4\xa2lIRF\x1e\x9aL\xcb/d(\xb1\x8f\xeb\xa6\xc4G:\x8d\xbd\xae\xf2\x9f\xae\xd2\x88QR:\x88\x19%G\x8d\x99r>\xc0\x8d\xf2j \xef\xf6K\xb1\x94-\xc3ZD\xf3\x11\xabHb/\xf0VUM\xd5\xa2\xec\x0c\x9a:\x15\xa8\xdb\x01\xb9\xc2\xdb\x89\xfa\xf0\xc8X\xd5\x07z\x92\xa3 \x1e\xf3c\xcf~q8\xe0\xfa^\x95a\xb1\xc5v\xc5\xb0'\xf9F\xcc?\\(\xcd\x9b\xeb\xaf\xfb\xe3\x8e\xc3\xc8\xe5\x92\x83Ml`\xc4\x00\xdbv\xdf\x8a\xd8\xc2\x93\xf5\xb1\x83\x95\x04K\xfa\x90\xdb\x8c'\x0b\xebB\xd1\x84\xff\x0b\x01\xb6$\x87\x03\xb3\xbc\xe8A\xe3!`h\x9a\xfd<1\xce\xdc.\xc7\x9cc\xa6l1\xa6q\xa5\x12\xef\xd9p\xc8h\xe1\xb1\x98W\x0e*C\x955\xaa\x10\xbe\xfd\xdaL\xde\xf4{\xb3\xd5\xee\xe3\xac \x04,$\x02u\x0d\xc5\x8c\x98V]\xe9\xd3\xf1\xe0i\xc3zhH`}\x7f\xc61\xefO.3\xcd\x1e\xc0\xd6\n\xf0\xe1c\xc6\xc0\x06Ud\x0b\x8e\xc3H(7\x98\xf7\xf6QCE\xde\xb3\xe2%\xdb\xe7\x8c+t9z\xdb\xddc\xfb\x1fH\x98\xa9\x18Ps\xaf\x821\xecC\xb3\xe6\x18!\x81\xa2uazso\xc2\xe6\x1e\xd6Xo\xeeu\xb9\xb9\xdf\xdcm\xeeM\x89pCk\x04\xbf6\xc5\nC\xb1\x80)~\xba\xd3\x98\xe13^\x83Xc\xec\x8a\x15.\xcf\x7f>\xfd\x97\xaaJ\x05\x0f\xff\x81,\x0d\xdb\x94\xa6\x05\x7fL`\xe0\xdag\xb9\xb9\xe7\x1a\xe7Q+\xdf\xea\xf2\xd1u\xbd\xd5\xe5\xf3\xd7\xd5\xa6$GO\xb4\x1c\xdbT\x06\xda\xde\xe3u_\xca\\`]s\xb0\xb6\xb3.\xdd\xb5\xd2\n\xdc\xf2\xa8m#R\xbaf<\xeeZ\xe6\xdb}\x14\xbb2\x94\x99\xcf\xdb8\x1c\xf7\x83\xa1\xd9\xc4\x06r$\x8a\xb9\xbb$A\xb1Y#j\xadu\xdbo\xb1&8\xab\xd9\xd6\x1a\x1f\x1ew3\xad\xa1l\x1d\xf5\xf6\xf9]>wvl*G\xa9\x973L\x87\xd8\x02\xba6\xcf\xafJ\x8b\xba^\x0evV\xf8[fF\xcc\xefL\xd2\x9b\x13\xa9\xdd{\xa7-@)\x8e\xe0\\\x99%\x08\xde\xf7\xdd\xa94\x81\x03:\x95\xb1ph\xd8n\xbb\xae\xb9\xa0\xf8z\x0b\xad\x8a\xe5.\xbc_p	\x15\x0b\xd2r\x0c\x06o\xd4\x1c\x03E\x05Y\x0c9)\xe3\x03a\xae\x05\xb5k\x0cm\xf5\xd1.\x19\xd66\xb8\xe2\xaabQ\xd7\xa9^W\xbfq\xa5\xb5\xc0RZi\xfd\xf3\x08\x97]o\xe4\xeddK\xd0\xb5t\xe0\xb7%\x85\x04\x1d\x88J\xc0gdE9\xca<\x16\xb3n:\xee\x13\xdd\x04 \xc3\x07\xd3&\x94\xc7\xd6\xec\x04@\xbf\xec)\xdc\xf4\x9an{\xffyE\xe5\xde\xa1n\x08\xae\xfb\x97\xd1\xb6\xf6r\xec\xecL	S\xc6\x07\xba\x0d\xa0f\x10}\xa3\xf4;UX\xc0\xce_\xdd\x0e\xa7];\x7f\x9df)<\xef\x85K\xfaL\x0c\x96\x92\x18\xf6\"\xa4\xa3P\xe7\x9a\x9c\xd3\xcf\x95x\x9e\x9e>\x01\xb6\x8d\xc4\x91\xc6\xa0n\xe8ZeQh\x82\x18\xf6\xfe\x1d\xab\xce\xc9\xc9\xff\xcd=|\x01\x1e1\xc0\xc3O\xa6\xa0u\xc9\xf5\xfeT=\xf6r\x8ej\xc2\xfc\x88d\xf8Xr\xad\xd7W\x83l\xea\xdb#\xba\xc1Z\xe3w\xe0qs\x07\x01\x1d\xceB\xfb\xac\x93p\x10\x9aOw\x8d\xc18\x05u\xc06Te\xac\xad\x0d\xef\xae\xce\xfb\x15p\xe9\xc5\x80\xf1`\xd8\x86\x1f\xc9s\xd2kZ\xf7\xae\xc2J\xe9m\x95\xb0m\x0b\xbav\x96\xb3\x8d\xf8\xb6\xb4\xb2n\x99\xd9\x86\xbcB\xf42e\x14\x05Fg\x18_)\x1e7\xab\x18\x1e\x1f\xc1a\xa5LiW=K\x9f'\xc6\x93\xbe\x1d\x7flE\xbd\xa7MU\xc7X\x1a\x9f\xaa\x92	g\x915z\xd8\xd4V\xea\x14e7\x97\xa0\xfb\xf3\x9fP\xc1i\xb0\xc0\xc6I\xa6\x0e\xf1(\"\xaa\xedZ\x89^w	Mz\x9fb\xcepE\x85\xad*2e\xf4yS\xea\x1d\xf9\x1f\xff\xd1S\xa0\xc7\xa6 \x836\xdbnS\xd0\x1bl\x9ft[\xfcna\xab\xa7+/O\x9e\x1d\xe3\xd6\xa3\xedA\x8aJ	\x9b\x9eo\x13S\xd6z\xbe\x06y\xb7mLP:\xbam	e\xd3\xe0\xe5[\x82\xf4B\xa1\n\xd4z\x0d\xdaJ\xdb\xc86Y]\xe0k\xabt\x1e\x1d\xe5\xfd\xb2\x83FY'\xa6\xed\x01\xf2\xe5\xfc\xeew\x12\x11\xe2\x14\x98j[,\xbd\\\x1a\xbb\x91\x9eq\xc0\xd9l\xf82;a\x10\\g/\xb3\xad\xbbY\xdb\xc6\x81P\xc4\x87\xdbV\xbfb#\x8ap`\",g\xe0ad\xc9Ox\x98\x99\xe2EKI\x90\xf9\xcd\xf8\xb0\xd5\xd3V\xad\x95\xf2_N}<\xe5Ic\xd4\xcfx3\x17x\x9beu\xb7\xaaB\xdd\xea\xef\xff\xe6i=\xb1x\xeam} ]e9\xa52\xff\x1f\xd2\x7f\xe1a=&\xcd%\xf9\xc2\xa9\x9a]q\xba\xbd\xdc\x823nRPO\xa2\xded.\x96\xed!iS\xcb7\xe9\x16-\xf36,\xab\xf7\xe1\xeb\x8e\xf0+\xf5\xe9Ny\xd2\x80n\xc9\xde\xb5u\xf8\x8f\xe0\x9c\x1fK\xd3d\x9b\xd5\x93\xc5\xfbA\xa2\x02\xf5\xf0Q\xa5\x07\x84-\x95\x90\x84<\x05\xf8\xb7\xe6\x8a\x83A\xc7\xe5\xbd$\xebmH\xb7F\x9a\xa4\xadb\x1e\x0c\xcc\x11\xcb2\xf3~\xc2\x94\xca\xc7\xa4\xbdX\x9aV\xbf\xae\xed\xf8\x9e\xa6\x17\xbf\xdcu\xe4Y\xc3\xfe\x12\xbb@\xf1N\xebXU<|\xdc\xdc/\xc1\xea\x8a\x0b\x84a\x87i\xb5\xc0p`\xf2\xc8\xfb\xbe\x83D\xc1<\x03y\xbc;G\x90?\xe0\x0bGd\xfc\xc2\x86\x14\x942~\xd6-\x07/\x05\x9b\x1f?\xddqhi\xda\xc8\xd7\xb7\xc3\xd4\x07Mn\x0c!\xd9+'\xa6\xfdU\xfb\xcd\xfdls\xef4\xba\xc7\xcc\xb7\x1e\xcc\x7fK\x11k4%\xf5\xde\xe1\x9b8\x1cg\x83A4\x16\xc2\x97/~\x18\xbf|1\xb2\xb5|O\xd1~\xc5f\xe2\xf3\xeahm\xe3\x17c\xa3xj\x0c\x9aq~n\xd31F\xe4*5JF\xed%V\xb4&^\xe1\xe6.]\x98\xc8\xf0\xd6\x05>\x81\xd6\xcf\x8f\x0e%\xfe\xb6\xb75:G\x1f\xfb\xf9\x99\x13\x19\xb5\x15H\x06\x12e\x8f\xcfK\x11p\xca>6\x8e_\xcaQ\x8c\x02v\x1d\xfe\xd1k2\x14\x1b}\xa3IH-\xbfx\xcc\x07.\xba\xdd\xd1W\x9b{\x8fk\xab\xe3iF\x0eT\xd5\x9a\x13\xfb\xf6H\xa6h.sS\xfd\xc66\xd9\xa3\x8a\xbc\x96\x95\xb1\xb1\xbd\xf7\x99\xdf\x83\xe9\x1a>\xeb\xdf\x91gVk\xbb\xe2\"\xc7\x11zk^\xb5\xb7\xc21g\x8a&\x16:\xe2A\x89\xad\xee3\xf9\xd4H\xf1\x9f\xdd\xd6n\x8b\xc2\xbaX'Y\x90P%o\xa0\xe3\x18\xb7\xce.\xd9y~\x96\x1a#\xe9\xc0\x1b5\xfc*1\xff 1n<\xd5\x9c\x8ck\x03\xbdd\xdc\xe2e+48\xa7\xaa\xed\x05\x8e\xb0,ebY\xa6q\x1d\x9d\xce\xe8\xa0n\xdd\xcb\x08\xbdWs#AV02\x8cQ5N\x98\xa2h\xd1ar\x95\xa3\xf8\xcc8\xa9\xa9\xf0i\x15T\x07\x9f\xde\xd5\x7f\xbf\x0f\x7f\xbe<y=\x86\xcb7\xaf\xc7\xf2M\x9du\xf0\xfe\xe4\xf0B\xc2j\x8c(P\xa5\x06\\\xa9*\x92\xd2N\x12\x81?\xec\xef\xdf~\xb1\xbf?\xee\xd0\x12\x88\xdb\xe6\xdf\x17\xfb\xfb\xf0\xd5\xa1\xec\xd5\xc8\x17F\xa6\xdcM\xeb\x91\x1d\x1d\xa6\xed\xf4_J\x0d>\x92\x1a\x15\x0b4s\xcaz\x11\xa3#\x81\x0c;\x11\xa3B\xf3y\x14\x8co\xff&8\xa1\x13*\xf9\x13a\xd6\xcc\xd4\xbcq\xb1<\x14d\x0bIAl8m\x12\xdf\xc6\x07\xf3\xb2\xf7\xf2\x91$\xab\x193\x19\xe6\x9cJ\x0c\xb2\xb1cL5\xc2(\xc6\xde\x8e$\xafB\xf3	m\xc8j'\x88O\xc9'\xa4\x0eY\x8b\x97\x8f\xcf\"\xbaqm[jt\xdc\xfe\x17LT}Q\x81\x93\xf4W\xe0\xedS5#\xda\x07\xed\x84i\x8a\xe5\xdb\xa8e\xa7\xef\x8e\xbe\x82\xb7\xa7\xa7\x111\xd7v\x8a\x9a\xe1\xaf\xe3?\x81\xca\x8b\xda\xe8\xec\xb8\x1d\x91	*\xc8]\x9f\xb5\xff#Fk\x9aG\xf2\xb3\xf8w\xd0\x10\x1fq\"w\x94i\x85V\xd9\x07BE\xae#\x0cQf\xfeH\xb5\xf1\x91\"\xff\x92\x1e\xff\xe1\x7f\xa0\xc7\xda\xce\xad\xf4\x82G\xe7\xf1_\x06\x8d\x9f60\x82\xf3pi%\x95t\xa3\x8a\xf6\xd0\xb7:\x88\xd2\xa4\xec\xc62\xa3\x0f\x17\xa3\xb7\xe9o\x84\xaf\xcc\x1b\xdb\xf57\xc4G\x96\xd2\x03\xb0+#\x9d\x84\xac\xcd!]\x1b\x9eu\xd9\xe9G\xdd\xb8y\xa7=\x97<\x10\xe5\xd9\xcb4,\x86\xaekkuP\xb5\\\xbe\xd2\xf1\x9d0\xa6,\xd35\xf8f\xfa7*\xc28}\x01\xe9\x92;3X\x11\x90)\xd0\xc7O\x99Y\x95\xbcF\xbf \xbf\x0b\xed\xe5\\\xd1\xbc\xd1\xe8\xd8\x15\xbbX\xf1$\x07<\xf2\x14\x0e\xc5W\xc9\xce&\x14\xd2\x17\x12\xbeC\x9f\xc7\x0bo\xd1\xe9\xfe%\xed\xc8\x8e\xce/\x90k\xd4W\xd0\xfe\xcb\xa0\x87\x92]\x8f&2\x80\xa9jIR\xe0\x14\x1cg\xbb\xfdf\xd3\x84\x98\xabv\x86\xd9\xe6\xae\xf9\xb9\xc5Ca\xecW\xd64	\xb86E\xf2 \x86\x8a\xd8\xcd\x0b\x18\x1a\xdf\xa3\x8f\xda\xf8\xf0\xce\xa8\xef\x1by\xe31\xf1\x8b@,8\xdb\xde\x93o\x9b8\xfed\x019\xc4\x16\xcf\xa8k\xea\x8cB\xcc\xd2\xa4bL\x90u\x1d!\xfc+\x90\xc6\xa4\xe0\xf0N\xfe\xbc|\xf1\xc3\xcb\x1f\xfe;\x00\x00\xff\xffPK\x07\x087\x8fXu\x19\x11\x00\x00`/\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/common.jsonUT\x05\x00\x01\xde\xbfsf\x8cW\xddn\x1b\xc7\x15\xbe\xa6\x9eb\xb0\x80\xa0\x040\x94{\xdf\xe9\x87\x8e\x89\xca\x12!\xd11\x8a\xa6\x17\xa3\xdd\x119\xd5r\x86\xdd\x1f3D`@\x14a7\x01\x8c\xa8u\x0c\x16H\x90\xa8\xeeM{\xc9\xd0RE[\"\xf5\ng\xde\xa88gfw\x96\x04\\\xf4F\xda\xf9\xe6\x9b\x99\xf3\x7f\x0e\xbf]\xab\x05':\xe9\xf2,x\xc8\xbe]\xab\xd5\x82c\xae\x94\x88\x82\x87,\xd8\xde\xda\xdf\xaf\xef\xb2\xed\xdf\xb3\x8d\xf5t\x83=:8d\xeb)\xfd\xfb:XO\xbf\x0e\x82\x07\xc8\x8fD,2{`\xb7\xbeWo\xf9\x13v_vy[\xeczR\xe3\xc9\xd6\x97u\xf6i\xeaQO\xcbX$U\xf2Q\xf3\xa0\xb1W?\\\xa5+\xd1o\xea4K\x1b\xaa\xd5I\x04\xa7\x03\xeb\x11S\xa2\xcfz\x883\xa9XF;\x9b\xf6\x00\xa1[*j\xe0;\xe9AWfN\xa6\xf5\xc8\x9e\xf8,\xfd\x9cq\x15\xb1\xf5\x88\x91,\xb8\xd6\x8e\xe6o\xf8\xc4\xc1eb\x9e\xb4E\x84\xe2!\xadyp\xd4b\xcd\xa7\x87_\xae\x98\xb3j\xc7\xb4\xc3#\xdd\xdf\x96\x85\xf5\x8f\x1eo\xed\x1e<c\xdb\x8d\xff\xc7	V\xcd=\x1d\x9e\xb6t\xbb\x1d\xdb\x1bZ\x8f\x0f\xeb[\xbbH_2[\xae\xbc\x8b\x9f\xee/;\xd92\x9eK\xd1\x17\xd1\x11\xef\x8aF\xb3\x10\xff\x88\x1d<b\xad\xc7uv\xb4\xf5\xa4\xce\x1aM\xf6\xac~Xg_5\xea\xcf*\xa7\xd7j/\x1e\xb8\x80J1\x9eh\xd5\x8b\xf3\x84\xc7i\x11_\x11\x1f\x04\x0f\xd9\x1f\xd6j\xb5Z\x00Wp\x0ds\xf3\x9a\x9e\xa5\xe5\xdc\x8c\x83\xb5Z\xed\x8f$GG\xe7\x89\xe7\xbe\x87\x05\\\xc1\x0c\xe60)\xf8\x1e\x9a\xf9S]\xa9\xf2L\x94\xe7\xccK\x98\xc2\x0cn\x97NV\xc1\xcaI\xad\xb2\x8e\x7f\xf0\xce\x8c\xcd\xd0\\\x98W^@\x0fU\xc4LE\xa8UT\x9es\xcb\x07\x95E\xea\xc9\x03\xc1\xbdN\xe6\xcc\x8c\xe1c!\xd3\x19,\xe0\xa3\xd3\xc4Y\x92\x82\xab\xb0\x1c\x8f\xbaR\xa1?\xb6\xe8\x83\xae\xe3m\x8d\x889\x87\x05\xdc\x99\x91\x03I\x1a\x04'\x05\xa0\xe9 \xfc\x15\xe6\xb0@#\xc3\x9d\xdd	\xb5\xca\x12\x1ef6%\x90\xb3\xe3\x10\xe6\xa0J\x92o\x0f|L\xd8\x8cf<\x8e\xd9\xf1\x805\x9a\x96'\xbe\xe9\xb9\xc7\xe1\x1f\xb0\x80\x1b\xf4\x909\x83\xb9\x19\x99s\x98U9\xfe\xc1:\xad\x97\x9e\xeb\xc8H\xd0%\xef\xccKX\xc0\x14&\xfe\xb85A\xa1\xea\x9f\xb8\x92\x99N\xe8\xa2'BF\xda\xc1y\x9a\xed\xeb>1\xbf\x87\x05|@\xbd\xedV\xac\xc3S\x1b\xfc\xee\xcb\xb9>\x12	/o*VvS\xfb\x84\x87{r\xd3\xbd\x19\x99\xef1v\xfd\xbd\xba\xaf\x84=\xbd\xady\x12\xb1\x03\\\xbb\xd4\x16b+\x8e\xe9\xf8%\xf9x\x027V%\x06S3\x84\xeb\xa2\x02X\x89WIn\xd7\xd6Ek\x16\xb8'\xa5n\xe1\xda\x9c\xb9\n@y\x7f\x94\xc9\xf0t@\xc9Ok\x96Z\xc0%\xbe\xd7\xbd\xfc.\x13\xbe\xea\\\xfc*}:\xd09b\x9f\xc1\x1b\x98}^\xa4x:P\xa1\x0b\xe3\x00\xde\x981\\m\x98\xb7\x98\xbf0\xf1&\x81Ks\x063\x8b\x9b\x91y\x0bw\xb00C\x97M\xa8\xc5\x0c\xe6\xe6%\x19\x14C\xf2\xa6\xe0|j\xdf\x86B\xe5~\x8a13\xfc\x1f\xbc5J\xbb \x93]Q\xa4Q\xc8c\xa1\xa2J\x16\xc2;36\x7f\xa1JtQ$\xfa\xcf\xe6\x07\xca\xa9\xf7\xee\xadZ\x00\x7fCc\xc35\xdcTy?\xc1\xd4\x8c\xcdy\x15\xfa\xa79\x83	L\xab\xd0\xbf\xe8\xe8\x12\xf43\xcc\xe0\xbe\n\xbc#\xce\x12\xf4\xc6\xbeh\x86Up\x8c\xda\x99\xf3\x15qaf\x86$\xf0=L\xe0\xcaW\xc8\x1f\xcd\x99\x19\xb92{\xe1\xabP_\x88S\xaf\xff/pU\xf0/a^\xben\xceK\xd9\\\x94\xa1*\x1e\xbd\xac\x10\xe0\xb7j\xd9\xcae9M\xe8,\xd3]\n\xda\xb7\xdeq!W\xa1\x88](\x7f\x84\x89\x19\x9a\xd1r\x96\x87<\xe3\xb1n\x13\xe5'\xda\x98\xc0-\xfa\xc3m\xc7\x12[\xddNy\xcf\x0e\x02,\xd3\xcc]\xfd\xa0R\xb50\x1d+%\xeb\x98\xd6\x96\xa0\x95\xad3\xaf\xe0\x0eS\xca\x9c\x99sfFf\x08\xf7fl\xbe\xc3\xbe\x02\x1f6\x19\xfc\x1d&\xe4\xd4\x1bf\xadLu{\x8e\x923\xb8/\xc3bb\xa3\x8ed\xfd\x0f\xcc\xcc\xb9y\x8d\xcd\xc2\x0d\x1f'2\x16-\xad\xf7x\xd2\xa67q\xcd2\xadYL\x88\xe3(\x99v\\\xa5\xf9\x11\x16\xe4\xd1),\xdc\x15m\xad1\xc7\xb5*\xecr\x8b\xed\x03c\x1e\xdfbp\x05\xb7\xe6\x82\xc1{3\x82\xf7p\x8b\x08\xaa1\xa4\xa6J\x17\xc8nO'\xd9\x8eN\x92\xbcG\x83I\x83\x00v\xc2e,\xa2M\xf6\x08e\n\xddv\xe5\xc4\xae\xb3\x93\xa3\xa7y\x18\x8a4=\xc9\xe3M\xd6\xea\x08\xd6\xe3m\xc1\xfa2\x8e\x99\xd2}\x96\x88X\x97#\x97T\xcfy,\xa3\x1d\xde\xcb\xc2\x0e\xa7;,\xc2B\x07\x11\xedT\x88\x1e\xb9G\xaa\xf6#ME\xeewB\xf4X\xe40vR\xd4\xe2\x98\xdb\x91\n~%W`\x96\x97\xeaaI\xf3\xa3\xa0\xab\x80v4b\xb8\xe7Y\"j\xe9m\x1f\x9aT\xa7`\xbaa.\xc8\x8dsX\xa01\xe9O13\x04]\xec._\xc9TjU\x8cu\xd4p\xd8s\xc2\x1c)O\xb3'<\x0b;\xae\x8eO\xa8(\xdd\x9a1\x83;\x98`M1\xaf\x99\x0d/\x98\x16\xb9\xea\xa3^\x89\xbe\x97\x1f~!\xe7\xcf\xe0\x033\xe7\x14b6K\x83\x9e\x96*\xc3\xe0/S\xa4\x89\x08F\x7f\x819\x1e\x0e\xc7e\x9f\xa5Y\xf9\x0b\xbb\xfe\xa2\xd5\xda\xb3\xa4?\xe7\xbahmo0\x11\x19\xfcfF\x98j\xcc\xb6:\xf3\n#y\xb5\xf2&\x82\xa7v\xa48\xb4_\x0e\x8dd\"\xc2l{`\xdb\xc8\xa1[\xafN	\x9e\xe7\xb5]\xe5Z\xb7\x15\xfc\x93D\xa4\xd6\xa6\xbf\xd2\x043%\xa1f\xc5v/\x1eX\x0d\xb0\x11Qo\x9c\xd2\xa0V\xa5\xe8\xc4\xc6\x8d\xcd\xe5\xeb\"\xdd\x97/\xca\xf2D\x05E\x0b\x9eRf\xbb\xd9\x053\xd9\xb1\xf2\xd8\x1a\x94\xc2f\xe2\x06I\x17%\xa9\xe0I\xe9\xfd\x85\xf9\xce\x8c\xdc|\x17\xa4\"\xc58\xa9\x7f\xd3\x93\xee\xc7\xcd\x9enK\xc5\x1c\xce\x84\xdb\xb0\xe4\x8e\xee\xef\xebL\x86\xc2KC\xba-\xe0\x0e\xbb>E\xbd\x93'\xcd\x8f\xbb2s\x113\x81+J\xf9\xdbjXe\x9d\xbc{\xac\xb8\x8c\xa5\xab\x1c\x97d\x81\xe9\x86\xf9\xc1\xb9un.6]\xc6f\xbaG\x9c\x7f\xc3\x04\xee\xb1\xc4Y8WEq*\xa2\x1f\xe7\x01\x1b\xd6o\xb1\x1d1\x98\xbb*\xf8\x11\x8b\xa3m\xa8\x14\xbdd\xea\xa1k\x16A\xae\xfa\x98 \xde\xf3O-\xb0\xe4\xf1\xbc\x875\x04\xcb\x111h\xc5\xb0bV\xb7\x9b\x89n'\"\xb5\xbeX-\xbf\xd7\x85VN\xad\x95G\x9fU\x9f\\\xab\xbdX{\xf1\xdf\x00\x00\x00\xff\xffPK\x07\x08\xb3\xff\x16F\x96\x06\x00\x00\n\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xde\xbfsf\xbc[\xddo\x1c\xc7\x91\x7f\x96\xfe\x8a\xc2\x1e\x0c\xde\x05\x9b%\x1d\xe7!\xd0C\x00\x91\x94\x14%\xa2\xc4\x88t\x84 H\x80\xde\x99\xde\xdd\x0eg\xbb7\xf3Ar\x13\x18\x90H\xcbR\x00A2l\x1f\x058\xb6\x15\x1f\x0e\xb8{\\Q\xa2H\xf1c\xf9/\xf4\xfcG\x87\xaa\xea\x99\xe9\xd9]*\xba\xbb$/\xd2N\x7fTWw\xd7\xc7\xaf\xaa\x9a\x7f\xba|\xa9\xd11q?i\\\x81?]\xbet\xa9q\xfd\xea/\x1bW\xe07\x97/]\xba\xd4\xb0\x9f\xd9\xb3\xfcS;\xce\xef\xdbS;\xca?\xcb\xf7\xec[{f\x8f\xc0\xbe\xb4'vl\x8f!\xdf\xb1\x07\xf68\x7f\x90\xef\xe4\xbb\x8d&OznG\xf6\xdc\x1e\xe5\x0fp\xe0k{\x92?\xc3\xf1#{f\xcf\xecA~?\xdf\x85\xfc\x81\x1bp\x9c\xef\x82\xfdO\xfb\xb9\xfd\x1a)\x8d \xdf\xbbh\xc1S;\xb6\xaf\xed\xc8\x9e\xe4O\xb0!\x7f\x08v?\xdf\xb3\xc7vl\xcf\x1a\x97/]\xfa-.\xde\xb8\xbdv\xfd^\xc9\xfdm\x93\xc2\x9a\xe8H\xb8nb\xb8g\xe2\x0d\xc7\xe0\xa2\x11q\x08\"\x8a\xccV\x02}\x91\xcaX\x89\xa8	iO\xa4 b	\xda\xa4\x90\xe0\xbc\xd4@[\xc2\xa6\x92[2\x04\xa5A\xc0\x96\x897@\xeaM\x15\x1b\xdd\x97:\xadV\x16\xd1\x96\x18&\xb7L\xb0Q\x9d\x1e\x1e\xc4\xbe}c_\xe3\x06\xce\xf3\xfb\xf6\xc8\xee\xcf\xe5\xcf\xeca\xbek\xf7\xed(\xdf\xe1\x03\x1a\xe3?gvT\x9c\xdf\xd7vlO\xb0k\xdf\x1e\xdb\x13;\xb2\xaf\xed\xb1\x1d\x01\x9e\x9e\x1d\xd9c\x9cf\xf7qB\xf3\xbd\xa8V,j\xa3\x87}\x95\xc8\x8a\xc3\xcf\xed\x19\x1e`\xbegO\xf3=\x9fB\xc1\xcb\x0b\xbce;\xaa\x13\xdf\xcf\x1f\xe4{x\x03\xe7v\x8cWO\xb7\xbag\xf7!\x7ff\x8f\x81n\xba\"\xba\xef1\x90\x85\xca\xfc\xcaDY\xbfb\xe1*\xb6\xc1&7\xf2\xa2<\x02L\x07h\x02\x9e|?KT\x00B\x87\xb0\xa9Bi`\x10\x89\xa1\x8c\x13\x9ftj\xba\xaaS\xdb\x192`\xc7\xc86\xfe\xe6\xb3:\xc0\xb3z\n7n^\xc7\x8d\x15\xbbt\x83\xfd=\xce\x18\\.\xd6\xee\xaedi\xb5\x05\xfc\x80E\x11ltc\x93\xe9\x10~\x85,:\xd2\xd4\x97\xf6$\xb4\xab~\xda\x82O\x8d'\x14\xe4.\xa0t5\xec+\xfd\xc3d \x03\xd5Q2\x84\xbe\xec\xcb\x04\x06j CH\xd2X\xa8n/\x05\xa5S\x03C\x93\xcd\xc5\x12\xfaF\xab\xd4\xc4\xdeB(\xf7\xd7\xb6\x07*\x1eV\x8b\x91.Hj\x84T\x95wp;\xeb\xb7e\x8cw\x10\x8aa\x02[*\xed\x99,\x05-\xb7``\x924\x81\xb6\xec\x98X\x82\x00\xa2\n*\x81PF2\x95\xe1\xc4z\xb7\x85w\xd9\xf6[\x14%<d\xb4\x08\xa8\xe5\xaf+Q\xf3\xfa\xce\xe8\xda\xc6\xf9s\x12\xe4\xfc\xb1=\xb6G-\xb0\xdf\xd8\xb1}c\x0fP1\xf6\xe9rG\xf9\x9f}\x81\xffp\x01\xed\xc4\xc8\x9e\xd8\x03{\x96?\xc1Y(\xa5'<\xc6\x9e\xe5\x0f\xbc\xc6|\x8f\x05w\x82\xdfu\x95F\xb2\xae\xc2\xafH\x1f\x89#\x14\xef\x92#\xdf\xdeM\x8c\x99\xb5\x01l\x1d9\x85uz\x0ds\xa5\x9c\x1e\xb0\x01\xb4\xdf\x90\xfa\xbc\xb2\xa3\xb9\x16\xd8oq\xb3/\xf3=\xb4z\xf9c{\x00\x1f.,\xa0\xf1<\xb2\xa7\xb4\xd2I]\xbf\xda&\xf4o6\x1c:\x0e\xd7\xe5v\n\xd8\x89\xf7\x89\xc2\x88WXM\x0b\xc4 \x0dz\xa2\xda\xf4\xd7\xc4\xe7\xa3\xca\"\xbd\xb0G\xc8\x1d\x9f\xf41]\xdc\x11\xdb|R\x98G\xa4\xfc\xbc5 \xbdy\x95\xefQ\xff}6\xe2\xf9sg\xde\xd1X\xec\xd3\xfc\x03t\x07\xf9\x1e\xe4;\xf9C\xfa|\x9d\xefN\xb1\xb4.\xbaI\xc9\xd6\x12\xb7A\x8a\x8dnc\xa2\x9b\xa0\x95NL\x16\x07\x12:&\x067\x13\x12\x13e\xa92:i\xc1\x1d-q\x12\xcah,th\xfa\xd1\x10\x82\x9eI\xa4\xa6\x19R\x04=\xe8J-c\x91\xca\xb0 \xd0\x82\xbb\xf2\x0f\x99\x8ae\x88\x0b\x04F\xa7\x02=@\n\x91\x14I\n\x1fA\xa8:\x1d\x19K\x9d\x12C-\x8f\xf7\x9e\x88\xd7\x02\x13WR\xb4\xd4\x13\xb1\x08R\x19C2\x10}H\xa8\xd3i\xb5N\x155nIR`\xd3\x81\xbe	Ug\xa8t\x17\x04\x04\xe5Lr?xo-\xb8\xda\xc1\x06\xb9\x1dH\x19\xe20\xbc\xd1H\xf5UJ\xbf\xb2D\xc6\xb0\xa5\xa2\x08\xb4d\xe6\x13\x13m\xa2\xa6\x16;\xf3X\xcd\x92\xd4\xf4\x97\xd6\xd6JV\x1d[\x1f'2\xfea(;J\xcb\x10\x96\xd6\xd6 \xce\"\x99@dD(C0\x1aR3(\x84)\x91\x91\x0c\xf0\xe4\xd2\x9e\xec\xcb\x19\xc4\xd7M\xb7\xeb\xe9\xd4\x12\xb5#\xd5\xe2\x16\xa9\x1f\x19G\x92A\xd5]\x92\neGdQ\xea3j\xbf\xb2\x07\x88\x14\xecI\xbe\x83\x8a\xc5H\xe4\xb4\x92\xd9\xff\xe0O\xc8\xff\x8c\xae\xf0\x90\\1:\x82\x1d;\xb2o\xf2\xdd\xfc\xab|'\x7f\x92?@pr\xce\xce\xb2\xa4\x97\xef6I\xcc\x9d\x17\x9e)\xf2\xce!\xb3\x93\x1e\x11\xfd3;F\xe5D\xf9~\xda\x9ab\xfe\x96\xd0\xdd\x8b\xb9/<U\xc1\xfd7\xfc\xe9\xb8Gk\xf7\xda\x8e\xedKT(\xfb\xc6\x8e\xde\xcd\xbd\xbfv\x82\xc7\x19\x8bnu\xfe\xcb2\xc9\xd6\\[\xe5\xe5\xf3\xc7\xf9.Z\xaeCo\x19\xb2\xa2\xbc\x80G\xa8e\xe2\xae\xb7\x82JD;\x92wM\xdb\xa4\x95\xae\xae\xc6r\x13U#\x88\xc5V\x84\x8e\xbaYkFo\xdd'm\xdb\x92\xedD\xa5\xb2\x1c\xd8\x84$\x0bz \x12H\xa4\x88\x83\x1eH\xddUZ\x82\xd2\xa1\xdc\xa6\xfeNl\xfa \x82@&	\x0b\xbfJ\xd8\x07\xb5\xa6\x98B)&\x07W1\xb6\xcc=\xa0\x8d\xfe\xa1@\x8f\xea\xfcW\x10K\x81\xe6\xa2\xceh\x02B\x0fq1\x93!\xd7\x03\x11\xa7\xbc>\xca\xfd\x1c\xcd\x9f+\xbb\xa9\x83\xe9\xe8.y\xca6\xaf]\xb1e\xf4\x84k9&\xc0\xfb\x88\xcc\xebQ\x89\xa7|\xc7rL\x82\xe7`\x17JA\xd9`\xdfV\x13\xca%\xc8\x8a\xb4E\x14\x95\xeb\xfc\xcbO\xf0\xb3\x98}\x8er\x85\xbf\xf2\xbdb\x95\xef\x1d\x16'\xc751\xca\x1e\xe0*\x8c\xe1\x1d\xa1\xfc\xa1=\xc8\x1f\xb3~\x9c\x92\xd4\xbf\xf6]\xf3)[\xfe\x82gv\xcb\x0b\xb3	\x93O\xf8\xd1\x02v\x1f\x92s<fGrH\x0e\x15\xfd\xf8\xd8\xbbT\xd9\x17*\xba\x16\xc7\xe5\xce\xaea\x03\\\x8bcS\n\x983%4\x14oAR\xe7\x0c\"+BEuB\x8e\x02\x91c\x023\xa6\xad\x8a\xc4\x13r\x91$[&\x0e\xa7g\xc2\xc0u\xcd\"a\xe2tb\x0b\x89\x8c7e\x0c\x03\xec\x99\xa6\xe5\xf5\xce \xb7\x96\xb5gR\xbb\x98P\x92\xb5C\xd3\x17J\xfb\xd4\xb6{\x12=\\E\xaahxo\x0bQ\x90\xa8\x9b\x07\xb9=\x10:\xbc\xea\xc9\xa3\xfd\xab\x1d\xdbC\x04L\xf9}{\x96\xef\xb2\x9c|\x81!\x05\xd8\xe7u\xda\xf6,\x7fVp0s\xda>O;\x9c\x9e\xd6\xc2\xb8rd\xdf\x92\x0d\xf7B\xca\x9d|\x17\xee\xc9v\xbf	\xab\xcb\xd7!\xdf\x83\x95\xd5\x8f\xd8\x92\xe7\xf7\xab\x15\x08\xed<-llk&c\x80\xb1+\x03\xbd#?\x18\x1a\xe5\x0fI\xb2IC\xde ~\xdbEp\x83\xc4\xa6\xd7\xd8!\xaf\xf2\xacv\x15\x03_B\xec\xbfcxM\xc4\xefW\x96\xa1\xd6\xe8\x87-gv\xe4\x80\xd7\xd8\xbe%\xd4I1Y\xfe)\x9fE\xb5LG\xca\x10c\x12\x96}\xcf(1<\x1b\xa3_r\xde%\x7f\x8c\xac\xd6%\x81\xb0\xea\x05n\xd1\x85\xfc\x875RO\xec\x18\xb7M\xcd\x18\xacb\xe8\xefq\x13\xd5\x90\x1e\xda\xd3x\x08\xdc\xda,\x0c7\x06}\x04\x81d\x0c\x81?\x04\x01	E%\x1eA\x13\x072\xbc\xaa\x8d\xae\x05\x1f\x84\x9d9\x850\x06?\xfa\xc5_\xc5\x0e\xbf |\x8d }\x94\x7f\x05\x14\x18>\xbb\x10\xf66\x11\xc1b\xf4{N\xf7\x8d\xa1>\x1f\x0e\xa2\x8f\x03'\x7f\xc5\x91x\"R\xb1\xda\x15Q$\xe3\xe1\x8a	+\xe7p\x83\xdb\x80\x1a\x99\xad;:\x1aB\xd23E\x00\xe6 )Z9\xd5\x17]\xe9m\xbek\x8c\x0f\xb8\xec\x97\xf9\xae}e\x0fp\xe3\xef\xa5\xcf(\xd8\xaf\xca9{\x15\xe1\x9e\nC\xe9\x9d\xe8\xf7\xf9\xc3\"\xc0\xb6\xe3+\xb0PP\xff\x8e\\Z\xe5\x06\xf2\x07\xd5@\x8a\xc4\xdd9\x1c\xd5H\xcbE\xa5\xb5\x0cK\xf2?S\xa1\x84e\x8e*a\x95\xee\xb7Yu\xa0/\xe2\x93\xa0\xc4MOlJhK\xa9\xa7\xe3P$]K\x0c\xd1|lA\xc1\x99\x17Q4\xef\x13f\xaf\x8d\xa8\xbe+CD\"e>\x08\xa3\x05\xca\x00\x95\x18\x80&C_\xa6\x82f\xd5\xd7\xbc+\x83,N\xd4\xa6\x8c\xaa\xa8\xacl#\xde\xa1\xa7\x10\xc1\xcf\xdc\x96KH\xc5r\x10)\xc6\xf1\x02\xf8\xfcyj\xecQ\xaf-\xbb\xde\xcb\xfa\xedJ\x99\xec\x0b\x12[w\xfe\x93\xc9\x0dO\x1e\xa6\xf3<\xc7\xa4\x1f\xe7\x94\xa5\xfb\xcdZ\xcfl\xfd\x96}\xb3\xf3\xf1d\xf9+J\x15\x13&V\x7fD\xe9\x8c\xf0\xd2\x94\x0f{\xbfd%\"\x99c(\xee\xf2y\x1e\x98\xc1H\xdd\xbe\xf2\x05\xd55\xb9\xf4\x12\xc2\xe5\x8f~\x12\xf4\x84\xae\x96T\x95\xd4\xdc2]U\xc08o\x00\xaa\xc8\xcf\x0c:\xc6Y\x8e\x88Xa\xab>\xad\x0f\x15\x1c\x9b\xe1\x03\x9cAwv\xae\x0e\xcc)\x1e&`\xb3\x8f\x01n\x19\xf3\xa2u\xf1r\x02P\x99\xc7\xd6\x04\xc7w\x8dI\xefl\xca8V\x9eq\xb8\x89=\x10\x1b\x93\x82)\xfa\x98\xc5\x9b\x1d\x18\x9a\x0c\xb6T\xd2C\x81\xe9\xa1\x9c\xb0qp\xb0\x19\x129\x10\x18\xe7Bd\x02\x02\xbc\x10\xa9\x0d\x0c\x0e\x97\x96o7Aj\xb4\xad(\xd8\x9d,\x8ax	\x11\x86\xb1L\x12\xe8\xc9X\xb6\xe0\x96D=\x93\xfdA:\xc4\x150f\xc3\xe1.\xc6)\x06\xb7\xe0\xda\xb6\xe8\x0f\"y\x05\xe6zi:H\xae\xcc\xcf3\x1f\xad\xbe\xecf\x81@\x9007\xb1\xd7z\xcc\xcc\x9b|\x9fxy\xc0B\x06B\xf3^\xff\x9e\x01\xb2\xea\xd7\x9d\xf1g\xf6t\xd2\x15{M\xefv\xc4\xf6\xb0t\xc3\xbe\xebS:RZ^W\xe9\x84`\xe6\x8f\xedQ\x99'y7\n\x9a\x01\x7f\x80a\xd1)\xc6\x17\x85\x11fQE\xe5\xbd\xcfz|b\x0f\xec\x1bv\x85\x94/\xae\xf3<\xb6\xc7\xfeI\xfc!\xac\x80\xe6\xcd_./\xbe\x97'!d\x88S\xeb\xa8\xf0\xf7\x82R\x93\x95\x91\xfay\xd1\xd0\xf4?\xcb\xd0\xea\xe6r\xd2\x82b\x0c\x04B\x83Ao\xc8\x96\x9e\xcd\xa5\xc7jT\x8b\xb4\xbf\xa9\x07\xd6\xcfkg\xc2aw\xbe\xcb\x85\x07Nd\x7f\x8an;\x7f\xe0\xdfQ\xa4\xf4F\xe2\x83\xa41%\xe0\xcf\xf2\xbd\xfcq\xe9\xcf\x10Y\x14V\xa4X\xed+W\xb5\xa8R\xe7\xf6\xc8\x951\x9e\xf1\xa5PZ\x8c\xf2_\x18\xf4\xf8\x12t\xc8\xab\xe0\x1a\x9e\xd7\xf4W\x81\x9f\xfe\xf4\xa7\xf3?FK8_\xf1\xda\x17I`*aZ\xe1O\xe6\xc7\x85G<\x04B\x86T\x93SI\xf3\xca\xf9\x85e!ut\x1a/\x12R$\xb7\x927=\xcd<\x0d^\xa1O\xc2)R\x17\x1c\xdc\xd4\x1d\x13\xf7+=\xc4\x1fe\x0e\x80\x11\x0d\xd5d\xbc\x99\xe8\xa4;*NR\xd8T\x89\xaa\xad\xb7\xfd3\xb2\x02\xd5\xcd\xfc\x85\x8b\x0e\xf9\x0e\x9d4\x06\xb5\xe5Y\xe6Og\xc7\x15\xdfP\xdc\xc99\xd5\xc2\x1d\x8d\xfc\xe9\xa3\xd9\nV\xc1\xdcZf\x89\xfa)\x1e\x98\xf6#>\xe7k\xea\x8fr\x16\xdf\xbe\x96\"\x0e\xbb\xd0\x1b\xcd\xe2\x1b\xdd\xe8\xa4\x9aO\xa9\xe4>\x102\xc5\x00\xfb%\x9a#\x17\xb2\xfc\xeb\xca\xe2\xbf\xd58\xbc\xa7\xc2\xb47\x93E\xb6LG\\\x98\x9bq6\xef>Zo\xfa?\xe2he7[\xffU%\x84\xfc\xc9\x0c\xadb\x00\xc1)`FyE9%\xe0\x9aL\x02J\x93K\xa5@\x83JL\x93\x84\xd7z&N\x83,\xfdG,`B\x19\x8b\x9aY\\\xa9\x9a\x9a\xf5\x86\xbai\xac\xc6\x91q\xf4\xedb\x13\xdaB\xbb\xa8)\xa1\nZ\xa8\xc8_f\x08\x12\x8a\xf6\xf6\x105Q\xc5\xd0\xd7\xb2o\xb4\n\x88n\xc5\x9b\xaeUq>\xb3\xa7s\xd5-\xf3\x97C23#\x1c-\xb7\xca\\IA\xe4\xb6\xdc*\xb3$Sh\x0d'\x98$\xf5C7\n\xb3\x11%\xbe\xa8\xe7\xc8\xbe\xa8'\xc5\xca\x88|fvL\x9bT\x05\xdeF^p:\x8a8>\xae\x15`\x1d\x9e;\xb6\x07\x17\xd5\xa4\x0f\xc0\xcbf\x8d\xed)W\x9d\xd8\xf7r\xca9\x7ff\xf7\xc9\xd0{\x98\xd0\xd9\x16\xf43\x0f\xa9\x10\x9e\xef\x10v@\x97t\xec\xc7C\xc8jG1F\x9bbxjEV,\xd4\xfc\x97v\x8c\xc1\x17\x1a\x02t\xe5{\xf6\xa4\n\xcavH\xed\xeae\xce\x8b\x08\x16\xe9\xef\xfc3:\xd82\xca\x03\x9a\xfb\xb8<\\ 32\xe6\xaa\xd4\x19\xd5r8\xa4>t\xa5\x1f\x17\xf6>\"\xbc\x81\xf8\xe2\x89/Wf\x0bu\xa6\x16#<\xb7#R\xedC\xe6\xceE%\xde!.\n\xad\xcb\x04Wm8-\xfe\x8a\xaaN5\x1c\xbe\x97?\xf0\x8fi^\xcc\xdb\xd7\xf9\x1en\x86\x99\x97\xa1\xd4M\xbe\xe8\xc73_!\xb8l\x0f\xbfb\xa0j7\xe77\xdc=V\x1b2Q8%\xeaw\xa2\xf0bQ/\x10\xfc\xd2\x8c\x8aW\x81\xfc\xa1(}\xadW\xf9\x90\x151\x18 \xb65\x9d2`5T\x89\x9ap\xd2E\x1d\x8c\x8a6\xf8]\x16\x90'\x93\xe6fKK\xcf\xf6\xdc\xe1\xcff\xf5Q\xb79\\9\xe6I\x1c}S\xcc\xc0\x99y\nW\xa3\xa8H\xae\x1b\x9d\xc6&BC\x95%\xe9t1\xcdh\xe9\xf11\x98<\xbf\xd5\x8b\xce\x0e\xad\xd7\x92K\xdc\xd7\xa3\x07\xb4\x1eeN\xff\xbd\xa2\x882q/\xca\"\xf7\xdf3\x90@z7	\xe9_\xa3\x8ch\x05\xa5\xa9\x91\xd2\x1bpK\xe9\x0d\xa0\xfe\xa4*E\xb8\x01\x08Ae\xc8\xa1\x7f\xa6C\x07\x9d\xe8\x13\xbb\xf0z\x83H\x05\x1b-\xb8\xd7\x93\x1a\\\x05$l\x82\x16\x9b\xaa+R\xc9\x02\xd2\xabSR:I\xa5\xf0\xa5`\x10gZN\x14MV\xb1\xad\xa8f0W\x9c\x96)E\xaf\xcc\xc0h\x83?B\xaa\x9bT\x8f\x05:&\x86\xdb\xf4\x92`b\xa1\xf5^,\xc5\xd4J\xa9k\xad-\xe5\x1a/X\x8b\xd7Qzz\x99aVR_\x8b\xb2\x14\xa4\xc6\x83)\xcc\xc7\xb2\xd1s)\x88d\xa3\x9a\x11\xb7\xd7\xe5v\xe5{\xee\xcap~1\xca$P\xab\x9b\xc5\xc8\x19b\x19\x92Kmc\x7f*\xb7SP\x1d\xdcl_\xa4T\xdcRi\x0f\xe6~\x17\xcf\x81\x89a\xeewm/\xe2\xc5\xbd\xdc\xd1^b\xc8\xfe\x85r\x02\x07\xe0\xaaA\xb5W8\x13\x19\xc9\xc9*z\x95Y\xac\xbf\x07\x88eW\xa1\x7f\xf7\xf6\xe2\x1a&5)\x96\x91H\xd5\xa6\\W\xbe\x9fg\xdfzFa\x07\xd5\xea\x1fQ\x06\x95<\x06\x83\xc87U\x19\xe9\xbd\x06\xd7\x18m\xc1\xb5\xed\xd6\x15\x98\xb3_r\xe2\x14\x03Z _s\x9a\xef\xd6\xcej \x85\x7f!\xf49\xbd\x85A4\xbc[\x0b\x07~\xe3\x98:\xf7\xdc\xfc\xd1o\xe9\xf5\x19\xb9\x8c\xfdzV\xfbA\xbe[\xc5\xd4\xb5\xdc\xd7l:\xe5{\x0e\xa44\xf681&\xfd\xf8\xee\xad\x8aacR\xc0\x86f\xed\xb3\xa8\x9fS\xd2\x82\x8dq\xf5\xe6\x005&1}\xd7[\x14C\x07\xb1A\x1c\x19\x93.o\x99x\xc3S\\\xaa\xd0{H\xc1\xf1E\xe1b\xb9K\xbf\xd1{\x823\xab\xc2\xfc\xb4r\xa0\xfb\x93\xcf\xef\xe8\x86\x8a\x07xx\xd9\x15\x1b\x89\x1f<\xaeU5\xe6eC\xfa\xda\xce\xfa\x03\xda5\xabsa\x93\xbc\xe7\x04\xec2\\F\xbf\xb4\xe1-\x7f\x85\xc8C\x86\xdf\xf3\xeb\x98b\x87\xee\xd3{wx`\x0f\xed\xb9=\xc8\x1f\xb9\xe0\xa1\x9e\xae\xe7gk;\\\x81?\xa3\xa4=\xc1\x17\x1aN\xefw^\x91\x92\x1dTOYZ`\xffj\x0f\\\xfd\x13\xfb^{\xe8(\x7f\xc8X\x07\xecK\xf8q\xfd\xad\x8e\x8f\xee\x12\x91\x05R\x0b\xe3\x9dT\x16\xc8\xdb\xc2\xf8\xf2\xf8\xee\xac\n\x144Z\x81\xe9{\x94e\x82\xcec\xe2\x99\x97\xfd/TF\xc0\xfd\xe6\xcf\x91\xab\x03z\xc2\xf7\xbcX\xceu\xef\xd3\x9b\xc1\xfc\x99+gq\xdajd\x8f\xc9\xf0\xa0r\xec\xb8r\x16\xa2\xdf\x91\xddgeue\xacG|\x00\x1c~\x9e\x10\x10\xdb\xa3#=\xf3\x95#\x19\x18\x15\xf98\x83\x1ej\x1c3\x10&[\xc1\x85-\xaa\x9c\xe0\xa9{F\xb02\x81$\xc3^E\xa6\xcc\x88\xfc\xe0\x07\xde\xd5\xd3\x13U\xbe\xe1\x1a\xcd\xba\x95LR\xd1\xe9\xd4\x9f}\xada\x13p[\xdd\xe2\x0fM\x16\x03\xcd\x80\x14\xbb\xd1\xe5\x94\x8e\xb8'E\xe8Gt\xe8\x11.0\xf2\xe5\xb3\xd9\xd9&~f\xe8K\xa1E\x91A\xdc\xbb\xf0]\n\x92\xf1x\xa0G9\xb5\xc3\xf6\x1e\xc9\xe0\xaa$[d\xf4\x96\xd6\xd6\xdc3\x1a\x1f\xd2\xb2\xa6\xb2@\xad\x88\xed*8\x15\xdb\xaa/\xa2B\x93\xff?o\x08\x1d\x89Y\x8f\x08k\xab\xab*\x14ZQ\xfa\x9f\xb1\xfa\xec\xd7\x80\x17\xbd\x04\xf4\x03G\x8a@O\xcb\xe0\x92>\x0fk/\x05k\x14\xaa%)G\xb7\xc6jR\xb7\xe7\xae\x8a\xc3W}A\xb9\xc2\xcf\xbf\xbc \x05\xc2\x0b?\xae\x1c\xdb\xf9{\x12\xf2\x93*\x88t\x17ox\xc0\x80C\xb9\"\xe3\xf4)\xda\xcc\xe9X\xf6]\\\xec\xbf\x17\x85	\x06ff&=f\xa8$=\xa2j\xce\x11Q\x18\xb9\x88\xefo\x91\xdf\x12i\xd0sX\xf4\x8e\xbe\x8bH\xa2\\\xe6\x1e\xf6\x95\xe8\xd3h\xaa\xc8\x15/+\xaf\xf2C&\x15\x88(\x1a\x82\x08C\xcf\xaf\x11Q\x19NO\xad\x96\x95\xed\xfe{\xd5\xa4p\xa0[\xf1\x9el\xafp]\x7f\xb2\xf2\xf4\xf4\x7fUy\xf2\xb8\xe8\x89\xf4\xaa\xf6\x81\xdf\xbd\xb2\x85\xd7\xc4\x11\x02\xbf[]\x01\xf4\x03\x92 \x96R'=\x93:l\xe2\xd13\xf1F\xad\x98M\x9b\xf2\xf2\x12\xf7\xa9\xecpdO}XR\x8a$[\xbf\xd9\x05\x8dYR3\xbd\xf0\xc4{\xc3\x7f\xd6\xf2C\xa1CY\x99\xc7_\xf3g\xd3\xfb\xa8\x819\x9ax\xf9\xd2'\xcd\xcb\x97\x1af@OU\x8b?\xc1\xd0\xfcN\xaca\xdf\x10\xea:\xe3\xda`\x83\xbd\x15\x1d<\xf5\x9e\xf3;.\xfb\x8a\n7E:\x07\xc8\xb3\xdc'\xdf\xc1\x7f\x92\xd1\xd8r\x99\xdc\x0b\xa7T)Y\xaa\xf6\x13O\x89\x89S<\xcd\xc2S\x97 \xe1%\xb9\x98\xf3\x82x\xd1|\x08E\xea\x8fN\xec	\xa3\xa7\xd9\xaf\xce\x8aI\xd3\x91\x8c\xeb\xfe\x9aa\x1cz\xef\xa2\xd2<\xe3\x01\xd9\x85\x83}/\xd9\xb8L\xf7\xd3HE\xbb\xda\xca\x97\xa5\x11.\xfe\xee\x02\xa1\xe3\x0e?\x0eq\xdf\xef\x82`n\xc8\x7f;==v\xe1Z\xb1\x94\xec\x0f\"\x0c\xb9\x8b\x0bm\x0b\xbd\xea\x9b\xae_\x9b\xcc{\xb0\xd0\x16Z#\xe4\x8fM\x1f\xe6?H\xe6\xa1=\x84\x0f8h\xa6R\xac\x89\"\xb3\xa5t\x17b)\x12\xa3\xaf\x142\x85P\xa4-4\xa7 \xc8\x01J42\x1f$\x18n*\xfc\xd1*\xccT\x10\x98\x983\x18\x06\x08\xc1\xd0S\xb0&\xc3\x99\x9b\xab\xe8\xfb>p\xf9^w\xfd\x99\xaa\xffAP\x83\xffL\xc7\xbd\x18h\xb8T\x10u|^\x03\x88E\xb4wF\x86\xe7\x0d\xff&\xe0h\xfd\x07\xff\x0d\x11\x864\xdb\x95\xc8\xcap\xb7!\x06l~\x9db\xda\xb7\x84H\xab\xee$Q]M\x08\x0d\x07]\xa5O\x86d<\xa0-H=\x16\x85.\xbf\xb5\x8c\xd7\x062HhB\x10\xc8A\x9a@6\xc0\xc3\xf8\xd1\x02\xfc|\xf5\xda\x8d&\xac\xde\xbe\xd1\x84\x1b7\xaf\xe3\xd9\xdd\xbb\xb6\xb8\x02\x1d\x15I\x86\x0d\xd0G\xa4\x93\xf5!T}I\xa9\x99\x04a\xc5G\x0b\x0b\xdb\x1f.,4\xcbn\x9c\x01\x89\xfa#=c\xfepa\x01~\xb1H\xd9\x01M\x0f\xd4u\xd8r\xfc\xd0\xe6\x16\xd9\x85x\x8f\xef\x8b\x07\xee\xae\xbd'tWz\xb9\xc4\xa9\xd2\xe59%>\xc7\x95\xc4\x06\x11\x9eM\x80c\x8b\x9f\xae]\x8a\x98\x16\xa0\x1f\xdcftGu\xb3\x98S=D\xfe[\x97\xa1}\\/d\xbbk+\xd4\xbd\x9c\xb8\xc6\xcf\x0c/\x9eIQ\xc6}N\x92\xba\xb9\x18\xccy+~_j\xff\xd1\xcc\xb5B\x91\xd2\xc1,\xe3\xff\xdcB\x00\xad\xa4\xe0g\xa1\xfc\x01\x05P(\x06\x90\xdd\xf5\x07\xb8\xeaB\xd1O\x7f\x0f\xc1\xddY\\\xa4\xdc\x1b\xcb\xc5o\xeaa\x05#\x11\xba\xe6~R{\xf1\x90\x8ev\xc4y\x82Wh5\ny\xedd\xc1\xc6\x1d\x96\xd5\xeb\x1f/\xfd\x02\xee\\\xbf\xce\x1d\xdd\xc8\xb4E\x84\xed7\xf8\x17\xb5\xd2K\x96\xc6\xcd\xe5\xe2K\xeaT\xa5\xac\x0e\xdfQ:\xe5\xa5\xc3\xfb\xce\xd6\xb9qQ$\xbbL\xec&\xff\xac\xd5]\x1b\x91\x11\xe1\x92'i\x91\n6P\xfc\xb1\xbd\xc8VV#\x95\xeeN\xa9\x8b\x98R\x8e\xbf\xa5\x1b\x1f\xfd\x1ft#2]\x93\xb1U\xc1\xd0\xe4m\xa5\xf6\xdc\xc3\x0fI\xbd\xce\xa2\xa2R\xfeEZ\x19\x0f!,z\x96?\xe4\xd9\x13\xd5\x94\xc6m\xff\x9bFT.\xb8a\xbf\xb3\xe7\x1c\xea\xbb\xae-}\xdb\x94)QZ\xdc\xbd\xfd?\xe5B\x03\xfe,\\5\xd5'\x9d\x1c\xbbM\x0d\x9c\xb0\xad\x96R6\xc8\xe2n)\x83\xab\xf8A\"8\xef\xc9)\x83\x84uc\xa2T\x0dHxT\xc4\x8fs\x18M\xb6\x87\x90d\xed\xdf\xcb m\xba?\xed\x89]\xeaD\x8b\xbe\x04\xa9\x03\x91\xf0_-\xa2p&\x91Hz2iAq\x9dwe7\x8bD\x8cn#\xe6\x8c\x81s\x16\x8dD\xa6\\`\xa1\xcd\xae\xc9\x94\xfd\x93+\x05`\xf7-\x16\x91\xa2\xdbI\x0c\x01C\xef@\x93\x9e\x08\xcd\x16\x0er\xbf\xbc\xd6E\n\xe4\x1ak\xf4\x01mULq\x90\xa3R%*\xc1\xd7_\xd6\xd0\xd5\x1ep\xd6\xea\xb0p\xde.\xabP\xea\xbd\x0b\x9f|\xc5w\xa7E\xb4\xfd(\xb8\x91\x0cuP\x9a#z Lp\xc6\xfdQ%\xbdS-\xc7-\x15N\xefc\xad\xfe\x90\xd1\x13\x05\xcd\x7f\xfc\"\x82Tm\xca\xf9\xd4\xa4\"B\x8f\xca\xee\x91&\xa6\x9cNn\x94	\xe4F\xcah\xbb\xb1^`\xecF:\x1cp\x0b\xfeO-\x99v\x8e\xecc\xfaq\xf9\xd2'\x97?\xf9\x9f\x00\x00\x00\xff\xffPK\x07\x08\x0d\xff\xce\xc2\x1e\x15\x00\x00\xad;\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xb6+rfl\x90\xcdj\xc30\x10\x84\xcf\xd2S\xec\xc1\xe0\x04\x92@\xcf\xa6\x85>I\xd8HcG \xaf\\\xed:\xcd\xe3\x17\xff\xe4P\x88.\x92V\xfbiv\xe6|\xa6oUT#\x0e\xa1\xccb\x14XhB\xedK\x1di,\x11\x95-\x15!\x0e\xcb\xe6C\x05\x1b\xa8\x9f%l\xe5\x15\xbe\x06\x96\xeb\x0e\x1d^\x1f\x19\x9ev\xa2[\xe1\x1a\xf7s\xc6\x03\x99t\xe4\x9c\x93\xd8\xd1W\xd8\\E\xe9QR$Vj\x1a\x1f\x112Wx\xb7\x8cq+%w\xfe\x86!\x89w\x8a\x8c`\x84gRS:\xec\xd7\x0f\xef\x96\xd5\xd72\x92\x1a\xf7=\xe9V\xf9\xbd\xa3\x82\xf4\xb2\xe9'\xa1C\xcb9\xb7\xa77\x13o=\xc7\x8ds,\x91\xf42\x15M\xab\xc1\xaf\xcfw\xc4\xea\xe4\x1f\xf0r\xfd\xb6}\x7f\\$\x92XY2\xee\xbcK=I\xd9\x02\xb7;\xc4;W9)\x08\xcf\x80i\x15o9\x04\xa8R\x84$\xc4\xb6\xf3\x0e\x12)\xf5\x9d\x87\xc4\xce7\x0de\x96a\xe6\x014\xe5i\xd0\x9f\xdc\xf9\xbf\x00\x00\x00\xff\xffPK\x07\x08A\xbd\xd5h\x05\x01\x00\x00\xd2\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xb6+rfd\xcfMN\xc30\x10\xc5\xf1\xb5\xe7\x14o\x91E\"!T\xb6\x98\x9c\xa5r\xecIb\xc9\x9d1\xfe\xa0=>\x82\x16\xb5\x15\xdb'\xeb\xef\xdf\xf8\xc2\xae1\xb4\xa0pN\xce3\xd6.\xbeE\x15,\xfd\x94\x8fm/\xec\xc2\xa8\x19K\xdc\xa2\xb4\x97\xdb\x1cO\x8cE5a\xc6\xeaR\xe5\x89\n\xb7^\xa4\xe2Kc W1\x0c\x14\xd8'W\x98\x8c\xe8\xf9\xd8%^n\x11\xbc\xcf\xe0K+\xce\xb7\x91\xb3\xfa\x1dk\xd1\x13D\xcf\xe34YZx\x8bB\xa6\xe7\xf0C\xbb\n*\x19S\xb9\xe1:^\xff\x9f\xf1\xd7%c\xce;\x17F\x0c\x98\xa1\xd9\x92\x89\xeb\xe3\x01\xafw\xb5\x93\x80\xac\xb5\x1d\xbdvi\xe3\xe3#\xcd\x13>\xf0v8\x1c\xd0v\x162\xff\x0d\xbf\x88{\xec\x89\xf0hx\xceZ2,\x01q\xb5\xc4\x12,\x0d\x03\x92\x93\xad\xbb\x8d\x91S\xde\xeag\xb2\xf4\x1d\x00\x00\xff\xffPK\x07\x08s\xdcw}\xe3\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xb6+rft\x92\xe1j\xdc0\x10\x84\x7fKO\xb1\x84\x83\xf8\xe0\x08\x84\xfe\x8b9\xfa \xa5\x98\xb5\xb4\xb6\x95\xca+UZ\xa5=\x9a\xbe{\x91\xac\x14\x92\xd2\xbf\xe3\xd9\xd9\x9d\xcf2\x89P\x08B\x82D\xd1\xa3!X\n\x1bq\x81\xc1\x92'\xa1\xc9\xed\xb8R\x1e\x9c\xcd0\xbb\xd5\xb1|\xf9z\x014&\x14\x16\x10\xfa)g\x9dHJ\xe2\x0c/\xc1Y\xc0\x0c\xa7\x93\xb6d<&\xd2j\x0e\x98l\xf3\x8dZ\x99\x8d\xcc7\xb2S\x133<\xe7\xc03\\\xe1\xfe\xd7\xef\xfbQ\xab\x18\xb2L\xce\xf65\xa3\x9eiu\xac\xd5\x12\x12\xa1\xd9\xe0\xed\xb3c\xc0\x94\xf0\x06\xf5$\x1fB\xd4Je\xf2d\xe4\xb0\xb4\xf0!>8{\x06\xc7\x12\xa0	Z)\xb5\xa4\xb07O\x86:\xa4~l\x94\x08\xaa\x13\xaeo\xf1UW\xc8\x16\xe2C\xde\xf0\x11\\\x06\x0e\x02\\\xbc\x1f\xb5Rn9\xe2\x9a^\xbc\x07\xd9\x88\xeb\x8c	,\x8e\x0bU\x13\xb1\x05\xb7\x8c\xfa\xf0\xd7\xf1\xf7\xc5?w(}4RZB\xda\x01s\xa6$\x93A\x9e\xba4t\xce\x97c\xe9\x05\x1e\x9f\x9e\xf2\x8e\xde;\x96\xf3\xd8\xd6\xbe'z\xfd\xb0	^_\x0f\xca\xd3\\\x9c\xb7S\x98\x9f\xc9\xc8\xd0\xd3$\x15:\x7f8\xb8D[\x1f\xc4A	;\xa8L\x02\x8d\xc6\xb5\x95\xfe\x1f\xbb\x06\x88k\x89\x03\xfc\x1e\xec\xe4\xc3\n\x83\xdc\"\xfd\xed\xd0\xcd\x17\xb8\x9bow\xe7\x9a\xf5\x82\xbeP\x86\xe1\xd3\xbf\x9e\x0e\xa0^Y\x8f\xac\xbf{\xd4\xc4v\xd4\xa7\x13x\xe4\xb5\xe0J\x10}\\\xf3w?\xea?\x01\x00\x00\xff\xffPK\x07\x08\xee%\xd00\x86\x01\x00\x00\xcf\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00f\xb9\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\x10\xb7tfd\x92Qk\xdc0\x10\x84\x9f\xa5_1\x84\x83\xf8\xe0Rh\x1fc\x8eR(\xf4\xad\x7f\xa0\x14#Kk\x9fR\xdd\xca\x95\xd6i\x8f\xa6\xff\xbdH\xd6\xa5$y]\x8d\xe7\x9b\x9d\xb5Md\x84\x10\x13\x12-\xc1X\xc2\xb4\xb2\x15\x1f\x19\x8e\x02	\x0dK\xcc\x92;\xef2F?{\x96o\xdf\x0f0\xd6\xc6\x95\x05B\xbfe\xaf\x13\xc9\x9a8\xe31z\x07\x93\xb1\xdbiG6\x98DZ\x8d\xd1$Wu\xbdV\xf6D\xf6\x07\xb9\xa1\x0e3\x1er\xe4\x11G\xdc\xfe\xf9{\xdbkU@\x83w\x0d\xd3\xeb\x91f\xcfZM1\x91\xb1'\\\x9f=\xc3\xa4d.(\x91B\x8c\x8bV\xea\xee\x0e_H\xaa\x04\xd5\\+\x95)\x90\xddf\x1b\xb0[\xdey\xb7\x87g\x89\xcf\"5\xa5x\xae\x9a\x8cb\xa4~\x9d(\x11\x8a\x12\xc7+\xb2\xcc\x95a\x07\x8e\x02\x9f\x87\xad\x98\xe6\xd7\xeb\x8d\xff5\xe2l\xc4\x9e\xb4R~\xda\xfc\xe13x\x0d\x01r\".&6\xb2x^\xa9\xd7J\x11;\xf8\xe9\xfa\xf5\xa7\x9c)	\xd6L	\xd6\\\xbbo\xc1\"o~\x07\xf8\xa9f0!\x91q\x17\xb4>7dyxY\xf0\xc7V~\xa3/\x94\xa6\x98\xce0\x955X\xc3C\x1bu\xed\x9e\x87+\xe7\xfd\xfd}>\x9b\x10<\xcb\xbe\xaf\xc9_^\xee\xf8\x8a\x84\xa7\xa7\xed\x9a\xc3\xb8\xfa\xe0\x868>\x90\x95\xae\xb9IZi\xffv\xe7\xcf\xffw,\x1bpm\xa0^\xe7\x1c\xdd\x10\xe2\x8cN.\x0b=\x87j\xd78\xe0f\xbc\xdc\xecK\xaaG\x13V\xca\xe8>\xbc\xd5\xb4\x8d\n\xb6P\xcb\x7f\xd2kb\xd7\xeb\xdd\x0e\xc1\xf0\xbc\x9a\x99\xb0\x84e\xce?C\xaf\xff\x05\x00\x00\xff\xffPK\x07\x08\x89\xdd\xb4\"\xa2\x01\x00\x00\x07\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01\xb6+rf\x94S\xcdn\xdb8\x10>\x93O\xf1!0`	+\x1b\xbb{)j!\x05\n\xb4(\x82^\xf2\x06\x02-\x8ed\"4\xc9\x92T\x12\xf7\xe9\x0bR\xb4b\xbb9\xb4\xbey8\xfa\xe6\xfb\x99\xd9l\xa0\xc9\x8c\xf1\xb0\xc3\x13\x91\x83$MQ\x99\x11\xce\x86\x18`\x07\xc4\x83\nxx\xc4`=\xe4\xe4ET\xd6@\x19\x04\xea\xad\x91\x81\xf7\x9eD$X\x0fON\x8b\x9e0L\xa6\xcf]\x19\x8c\xba\x0c\xd5\xedO\x9dr\x95\x92\xd8\xabQ\x99\xd8@\xf4\xbd\x9dLD\xa4\xd7\xd8p6\xd3X^=\x89`M~\xac\xb9\xa78y\x13\xf0l\x95\x84\x08X\xad\xb8\xa4^\x0bO\x9cE\xe1G\x8a\xdd\xde\n/s{\xbb\xd4\x94\x832\x94\n\xcb\xd8\x96\xefiT\x86\xb3\xcd\x06\xdf(f\x99\x98\xbf\x15F\xe2\xe1\x91\xb3@\x9a\xfa\xf9eF\xad\xdcV\xc9\xba\x81\xdbf\xc0hq9\xb3\xc12\x8d36x{,\xde\xa5\xbf/\x07\xf2\x94>\x94\xb8\x7f\xc7\x8e\xad\x92-\xcf\\\x1e\x13\x8f\xd1\x9al\xa4\xd0\x9e\x84<\xc1\x89\x10\xf1\x01R\x9c\x02\xac\x96\x9c\xa9\x01\x17\xd2\x02\xcc\xa45\xe2\x81\x0cgl\xf6\xa8\xe5\x8c\x8c\x84\x1a\n\xee\xe7\x10\xc8GL\x81<zq\x8e\xe4\x9c\xae\x99\xa5s\xe6\xc8\x0f\xd6\x1f!r{\xd7\x0b\xd3\x95RUbjnD\xff\xb7\xdb\x85\xa3\xd0Z\x99X\x97Y_f\xecx(\xf8\x9c\xa5\x9dQ2mKuvu\xab$g\xec7\xa3.\xacr\xb8\xbf\xb2\x941\x96\xa2\xb9\xcd\xe3\xad\xabhH\xbf\xcd\x06_M\x98<\xc1\xd8\xb8\xf88\x8b\x96oX\xe9Q\x85\xae\xd4g\xbc\x9a3mm\x1a\xa8L\xf6,'}\xb4\xb2\xd3vD\x15O\x8e\x1a\x14\xf1\x99\x8b\x92\x0d\xee\xf6\xa7\xbb:\xe1>\x0b=Q@\xf5\xff\xadO\xa9\xabXX\x97p\xd2\x98b\xd9\xf7wn.\xaa\x1c\xaa\n\xa0W\xa7<\x85\x9c{9\x8fO\xf8\xf7\x1c\xf8_\xd2l E\x14M\x01\xba\"\xfd\xf1\x96\xf4\xbb\x9b\xba\xe88\x1fg3\x1bZ\x00\xdb\x1bJ{a\x02*\xe5\x166\x83\xf5i\xcb\x97\xaf\x0b\xab\x99q\x91zEkY\x82?\xa4w\xc5j\xe1\xba\x0e\x07!\xed\xcb\xba\xd4+c_\xaa\x1a\xff\xe0(\x9e\xa8S&\x92\x7f\x16\xba\n\xd4\x07\xec\xee\xcf\xee\xd4\x10\x11Q\x1d	?\xd3M\xae\xa7\xd8\xaf\xeb\x8b\xdb\"#[\xbeZA\x0b3Nb$8\xed\xc6\xf0C\xb7\xfcW\x00\x00\x00\xff\xffPK\x07\x08\xcc\xaa}OZ\x02\x00\x00Q\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xb6+rf\\\x92A\xae\x9c0\x0c\x86\xd7\xf1)\xbc\x18	\x90x\xa3iUU\x95\xd0\x1c\xa0\x9b^\x01\x05b\x18\xbff\x1c\x1a\x87\xf6-\xde\xe1\xab$L;\xed\x0e\x8c\x93\xff\xf3\x87_^\xf0\xab(\xc5\xa4\xc8w\xbb\x12\xb2\xa4\x80\xf4\xc6\x9aXV\xdc\x82&\xb4\xe20R\xda\xa3\x1c=\xaf\x1a\x04\xe6H6\x11.\xbb\xcc\x89\x83 \x97k\xc6\xd2\xd1\xe6s#;\x9cxeI=\xa6\xf0\x9d\x04\xe7\x9b\x8d\xed\x97\xcf]\x8fb\xef\x84?m,\x95\x8f\x97K\xd7\x83\xd1-\xb0\xa7\x88S\x08\xbe\x83\x1a\xa8%kB\xabx:\x81\xa3\xd9\xdbH`JH\xbe\xbf\x9c\xfft\xe9\x060\xce&[\xbb\x07\x98he\x01\xb3o.#f\x18\x05c\x94\x12\xea\xcd~\xc0+\xeeJ\x95t,d\xed3\xfc\xb9\x942\x91\xa9A\xdf2\xec\xf5\x9f\x01\xcfy\x80\xd2\xf1\xa0\xfe\xef\xfbQ\x06c~\xdd(\x12\xb2\xc3+\x1eV\xc0\x98:\xdd\xc3\xb0\x9e\x0bVQ\xff\x18m\x00\xc3\xcb\x9f7dE\xd9\xbd\xc7t#\xc9\xe7-+!\xbd\xcd\xb4\x15\xf9M\xf9Q\x12\x12.a\x17\xd7\x0c`H\x1c\xf22\x00\x18%Os\xc2\x14\xc6\xa2\xa7\xe5\xaeFec`\xcc\x12\xc3\xbd\x06)\xf2_\xe0\xf3\xa1\xea	\xe8X\x82b\xfa\xfd\xbd\xca\x1e\xa7\x9d\xbd\x1b\xc3\xf4Jsj\xc1\x98\x86]\xd3?F\xcd\x8a\x9a\xc3E\xd3\xe3\xf1T\xaa\xd9`S7\xa1\x1b\x80\xc4\x0dp:\xa1\xb7\xb2\xeey\xc76\xbf\xad\xfa\xc3\x0f\xf0;\x00\x00\xff\xffPK\x07\x08\x0b\xce\xa2\xd0u\x01\x00\x00\xa2\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xb6+rfL\xce\xb1n\xc30\x0c\x04\xd0Y\xfc\x8a\x1b<$K\x80v5\xf2-\x81,]T\x02\xb2\xa4J4\xda\xfe}\x11\xdb@\xcb\x8d\xbc\x07\xf0B\xa77\xa2vt\xb6\xec\x03\xf1\xdcJ0\xad\x05:\x1e\x91\x99\xc6x\xd1\x88E\x93\x16\xbbJ\xa7m\xbd\x0c,\xb5f\xf8\x81i\x92\xc8\x90}\xa7\xb8\x93\xef\xd9,\x0b\x93\x16q\x83\x99\xc1\xc0o\x1d6p9\xd77q\xafy\xf6\xba\xa2\xd5a\x8f\xb5Fv\xbf?n\xeb\x11~}\xb0\x13m\xbd\xed@#\xee\xff:\xdd4\x1e\xca\xf9\x12_\xc8~\x1aq\xc7\xfbU\x9c\xd3b\x15'\x9c\xc5\x1d\x9d\xff\x0e,q\x96iB\xf6%m>\x11-\xb74>\xf3,\xbf\x01\x00\x00\xff\xffPK\x07\x08)\x9e\xae\xd8\xb4\x00\x00\x00\x0f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_board.sqlUT\x05\x00\x01\xb6+rf<\x8eAN\xc50\x0cD\xd7\xf6)f\xd1\xc5\xff\x9b^ \xe2,(m\xfcS\xa3\xe0\x04\xc7\x15\x1c\x1f\xa9E\xec,\xbf\xd1\xcc\xdb]r\x08^\xa7\xed\xa1\xdd0\xfa\x8c\xf7\xadg/\x0f-\xd8\xb4\xaa\xc5\x93]\xe2t\x9b\x08\xf9	\xe4\x89e\xe1\"{\xcb.LW\xf8\"\x897\xa9jLS\x9a\xec\x81Xo\xa6\x16\x1d\xd7\xc9D/\xef\x9f\xd7\xca\xc4`\xa2\x8f\xae\x868\\r\x99\x08t\xc3#V-x\xc3X\xfbx2\xd1\xf7!.\x18\x7f\xcf\x7f\xbdUKb\xba\xc5\xee\xf2\xc4b%\xf1\xb2\xa0e\xabg\xae\x82\xd1F\x9d_-\xf1o\x00\x00\x00\xff\xffPK\x07\x08\xad\x9f\xd7\x04\xa3\x00\x00\x00\xe7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_count.sqlUT\x05\x00\x01\xb6+rfL\xcd1\xae\xc3 \x0c\xc6\xf1\xd9>\x85\x07\x86\x977\xe4\x02\xa8g\xa9\x88\xe3P$jS0\xea\xf5+5\x19:\xfe\xbf\xe1\xfbq\x97\xe4B\xc7T\xf6bJ\xcd\x86\xdf\xd9\xa6\xfa\x9f5\xdaJ.\xea\x0bv\xf1\xd9u\\MiP\x08\xb8\x0b\xd7\xd4\x05\x81\xaf=\xe2&\xb9(\xc2\x90*\xect\xde\xfc/T\xd4\x8d\x18\x01\x8en\xcf/1\x10\xe0\xfd\x90.g\xad\xd6\xe8\xf6c\xaf\xd6\"\xc2\xa9\x12G\x14\xdd#\x86@5i\x9e)\x0b\xb5\xda\xf2x\xd5\x88\x9f\x00\x00\x00\xff\xffPK\x07\x08\xbaki\xf9\x8b\x00\x00\x00\xc0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00sql/functions/post_op.sqlUT\x05\x00\x01\xb6+rf4\xcd1\x8e\x021\x0c\x85\xe1\xda>\x85\x8b\x14\xbb\x0d\x17\x888\x0b\xca$\x9e`)\xd8&\xf1\x88\xeb#\xc1P>=\xe9\xff\xea\xe4\x12L\xfb\xa15\xc4\x94\xdcV\xdc\xcc\xff\xa4\xd1&]4\xfeqr\x1cS\xd7\xb9\xa9,J	\x1b\xd7Q&#\x98\x9fG\xc6\x8d\xbb(\xc2\xe2\xc15\xc8\x9cD\xc3\xc8\x1c\x01\xf6i\x8fO{!\xc0\xeb\xce\x93I\x1a]\x7f\xdcEZF\xf8Bd\x9e\x91\xb5eL\x89F\xd1~\x94\xce\xe4\xc3\xfbz\x8e\x8c\xef\x00\x00\x00\xff\xffPK\x07\x08Q\xa7\x96\xff\x86\x00\x00\x00\xb1\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xb6+rft\x92\xe1j\xdd0\x0c\x85\x7f\xdbO!\xca\x85\xe6\xc2\xa5P\xd8\xaf\x86\xcb\x1ed\x8c\xa0\xd8J\xe2\xce\x91<[\xeeV\xd6\xbd\xfbp\x92;h\xc7\xfe\x9e\x9cs$}\xb1\xcb\x84J \x192\xa5\x88\x8e`\xaa\xec4\x08CI\x12\"\xe5!\xac8S\xe9\x82/0\x869\xb0~\xf9z\x01tN*+(\xfd\xd4\xb3\xcd\xa45s\x81\x17	\x1e\xb0\xc0\xe9d=\xb9\x88\x99\xac\x19\x05\xb3\xdf|\xbd5n!\xf7\x8d\xfc\xb0\x89\x05\x9e\x8b\xf0\x08W\xb8\xff\xf5\xfb\xbe\xb7&I\xd1!\xf8cLoG\x9a\x03[3I&t\x0b\xdc>\x07\x06\xcc\x19_\xa1\xad\x14E\x925\xa6P$\xa7\xbbe+\xef\xd2C\xf0g\x08\xac\x02\x9b`\x8d1S\x96u\xf3\x14h!\xf3c\xa1L\xd0\x9cp\xbd\xd57\xdd {H\x0fe\xc1G\x08\x05X\x14\xb8\xc6\xd8[c\xc2\xb4\xd7mz\x8d\x11t!n\x19'\xac\x81+5\x13\xb1\x870\xf5v\xf7\xb7\xf8\xfb\xc3?\x1fP\x8eh\xa2<I^\x01K\xa1\xac\x83C\x1e\x0e\xa9;8_\xf6\xa1\x17x|z*+\xc6\x18X\xcf\xfd6\xf6=\xd1\xeb\x87I\xf0\xf6\xb6S\x1e\xc6\x1a\xa2\x1fd|&\xa7\xdd\xd1\xa6\xb9\xd2\xf9\xc3\xc25\xf9\xf6\"vJx\x80*\xa4\xb7\xf7\x00\xd7-\xf6?|\x1b#nw\xec\xecW\xf1C\x94\x19:}M\xf4\xf7\x8c\xc3|\x81\xbb\xf1\xf5\xee\xdc\xba^0V*\xd0}\xfa\xd7s0h\x8b\xb6=\xdb\x1f\xef-\xb1\xef\xed\xe9\x04\x11y\xae8\x13\xa4\x98\xe6\xf2=\xf6\xf6O\x00\x00\x00\xff\xffPK\x07\x08\xfd\x08\xb4\x18\x88\x01\x00\x00\xd3\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xb6+rf\\\x90\xc1N\xc40\x0cD\xcf\xf1W\xf8P\xa9\xdb\xc3\"\x90\x10B\x8a\xb8q\xe1\x038\xafL\xe2\xb6\x11Y\xb7\xc4	\xf0\xf9\xa8IA\xec\xde\xa2\xb1\xe7\x8d'\xc7#\xbe*+\x96\x15I0\x9cib\xa4\x18\x17G9,\x82yygA\x12\x8f\x89sI\xa2\x98gnk\xbd\xe2\xcb3\xb8\xc4\x94\x19\xc7\"\xae\x1a\x8a\xf2\xa9\x8eO\xd5zh\x007S:<>\x0c\x03\xfcb\xaar\x7f; )v\x1dxv\x91\x12\x83\xd1\x99\xee\xfe\x86\x16\xdex\n\x02\xc6s\xe4-%-g\xfcGW0\xe6k\xe6\xc4\x17\xe2M\xcb|\xba\xbe\xa5\xe9`L\xbb!\xc8ti\xab\xd1A\xf2\x82\xdb\xcb\x82	#6MQJ\x8c[\xf5j\xa7\xa0\x8c\xfc\xedx\xad\x95\xfb \x9f\x14\x83\xdf\x7f\xaf\xd2z\x0b\x86\xc5c\x18-\xecy;\x95\xc5[\xe8:\x8c$S\xd9\xf6\xd7\xb8N\xfa\x11-\xfc\x04\x00\x00\xff\xffPK\x07\x08w\x97\xe0l\xe7\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/boards.sqlUT\x05\x00\x01\xb6+rf\xac\xcfAj\xc40\x0c\x05\xd0ut\x8a\xbf\x08L\x02\x99\xd2}N\xd0S\x04O\xac\x18\x83+\xbb\xb2\xdc\x92\xdb\x17\xe2\x0b\xb4\x90\xad\x90\xbe\xde\xdf\x95\x9d1\xb2B\xb9$\xb73\x8e&\xbb\xc5,p\x87\xb1n\xaf\xec\xd4\xd7-Je\xb5i&ek*\x15\xa61\x04V\xb8\x8aq\xa4\x17\x87(4\x14\xd6#\xeb'J\xd8$[<\xce\xe9q\xddo\xadxg\xec\x1f\x0b\x84\x7f\xde\xa2\x9fW\xa2\xe1\xf9\xc4\x87DC9\x1b\xbe]jLC\x7f\x83(\x96\xaf\xf1\x14\xfd\x82\xb2\xe7&6\xf7\x9d\x8a\xa9G,x\xbfR:\x08\xd2RZ\x89\xc5\xaf4\x8eHNBs\x81QR	\xf5+\xadD\x7fm\xda\xa9w6\xbd\x9d\xe89\xf1-\xc4\x9c\xfc\xff\x88\xbf\x01\x00\x00\xff\xffPK\x07\x08\xae\x07\x84\xd3\xc3\x00\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\xb6+rfL\x90Q\xca\xe30\x0c\x84\x9f\xedS\xcc\xfe\x04\x92\x82)\xfb\x1ez\x96\xe0\xc6\x8akpe\xaf\xacl\xe9\xed\x17']\xe8\xdb 1\xdf\x8c\xb4\ny%\x14\x81P\xcd~%l;\xaf\x9a\n\xc3oJ\xb2<KXr\x89K\xe2F\xa2\xd3\xc5\n\xe9.\xdc\xa0\x92b$\x81o\x18\x06\x1bh\xcd^\xc8\x9aRqO1\xb1\xce\xf6N1\xb15i\x03\xd3\xebZK\xd3%\x05\xfc\xba\xe17\xf4Al\x8d9\xa1H\xac\x05\xc7\xfeY\x02\x89?\xe2\xa7\x8f\xc1A\xdf\x95\x1c~\xee\xef\x1f\x87L\x1c\xf5\xe1\x10\xbc\xfa\x8b5\xc6\xfc\xf5y\xa7\x86\xe9+\xc2\x1dy\xa7\xab\xab\xd3\xd9\xd5\x7fw\xd7\x07a\xb6\xc6\xec5\xf4\x17ts\xeb\xc4F\x8aO\x0f\n\xb8Ae\xa7>\x7f=H\x08\xa9\x8f\xbe\xc2\xfa\xe6|I\xe2xB\xae\xa5\x9e'\x95\xda\xf9\x95d+\xf2D\x8d\x0b\x17M\xdb{\x1a\xbfo\xa50\xba\x0eY\x0b\xaf^\x97W\x9bF7:\x94z\xd6L\xe1\xd2[\x12\x07\xa4m\xb6\x9f,\xf0\x9e\xf3l\x89\xc3l\x87\x01\xd9s\xdc}$\xd4\\c\xfb\x93g\xfb/\x00\x00\xff\xffPK\x07\x08\x99\xbd\x0b\x86\x1a\x01\x00\x00\xd8\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00sql/triggers/posts.sqlUT\x05\x00\x01\xb6+rf\x9cSMo\xd40\x10=\xdb\xbfbT\xad\x94\xac\x9a\xaeT\xaeQ\xb9\x94\x0b7\x0e \x8e\x91\x1d\xbfd\xadz\xed`OH\x17\xf5\xc7#;)\xdb\x82\xe8\xa1\xb9\xc4\x9a\xcf\xf7\xde\xcc\xf4\x11\x8aA!R\xc4\xe4T\x0f\x1af\xdf\xb3\x0d\x9e4\x86\x10\xd1M!q\xea\xacO\x88\\\xefe\x04\xcf\xd1'\xe2h\xc7\x11\x91T\xa2\xddN\x1a\xf4NEH\xc1\xa13p`t\xfaL\x8cGn\xa5\xc6h\xbd\x14\x13\xe2\x10\xe2\x89\xf4|\x9a:>F(S{,\x8705\xe4\x03S~'5b\xdfJqsC\xd7\xb7\x0di\xf4jN\xc8.\xca0\xc8\xa6\x12\xba\x82\x81\xa13\xf8Rx\x1a;\x1f\xd8\x0e\xe7\xba\xf2X\n\xee\xce\xfa\xadU\xd5H!\xd6v\xf4\xf4DUS\xe5_\x89\xe9\xc3\xecy\x83\xb2\xa7k\xba\xdd\xb7\xb2@\xf8T\x88\x94\xa0\x86\xec@\x9f\xbf\x90v\xaa\x7fp61\x8c\x14	\x0e=\x93>\xe83Y\xcf\x81^\x92\x97B\x0c1\x9cH+\x9fHK\x91\x0b\xde+_1eF\xa5\xb1\x0e*\x9az\xff\x82\xe7?\xdc\xc4rD\x04\x95H\xba\xa3zk\xc9\x87b\x91\xa2|\xa5\xcfJ3\x11o\xc65\x91\x0f6\xe7m\xe4\xb2KyC\xfa`\xa7\xcdj\xa7\x8b\x91\xcf\x13\xe8\x8e\xaatT&,\xd5\xc5\x81\xc7\xc9F$\xfaH>,\xf5\x9e\x14\x13\xdb\x13\xe8W\xf0\xa0\xea\xdb\xd7\xfb\xaa\x95\xc2\x0e\xaf\xf8?\xcf\xca\xcf\xce\x11\x1f\xe1W	\xbe[\xe7hP\xd6Q\xe0#\xe2b\x13.\xfc\x1f\xf0'm\x89\x96\x19\x9e\xb2\xaaJ;lr$0\xf5\xc1'\x8e\xcazN\xab\x8e\xa7`\x10U\xde\xd9m\xe8\xa6\x1br)\x83\x011\xc2\xb4R\x88U\xd6uL\x7f%Q\xbde5\x94\x15h\xe8J\x9f\xaf\x8aV?\x95\x9b\x91\xa8\xecFv\x7fh^Q\xcc\x9bZvj\xab\x85,5\xc7\x19\xad\x14\xf0\x86\xec\x90\x17i=\x98,v+\xe1M+w;r\xca\x8f\xb3\x1aA\x93\x9b\xc6\xf4\xc3\xb5R\xbeq\x88j`\xc4\xed\x0e\xe7\xc9(\xc6\x7f\xef\xf0\x1d\xb7\xf6\x8cov\xee-\x80\xbf\x03\x00\x00\xff\xffPK\x07\x08\"\x95\xe1\xed\x0c\x02\x00\x00)\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/threads.sqlUT\x05\x00\x01\xb6+rf\xb4\x90\xc1j\xe30\x10\x86\xcf\xd6S\xfc\x0b\x06;`/{\xda\x8b\xd9\x07\xd8[\xe9\x0b\x18\xc5\x1a;\x02y\xa4\x8eF)\x85<|q\x9c\x16zir\xe9i\xd0\x8f\xe6\xe3\xfff\x12\xb2J\x88\x02\xa1\x14\xecD\x98\x0bO\xea#\xc3\xceJ2\xeaI\xc8\xba<z\xce$\xda\x1e\x8c\x90\x16\xe1\x0c\x15\xbf,$\xb0\x19um\x8e\xb4x6U\"\x99\xa3\xac8\x965\xddV[\xa6\xd7\xdf\xde\x1d\x06c\xaa\xbe\xc7\x7f\xf6\x8a\xe7\x92\xb3\xb7\x0c\x89%\x90*\x99j\xc7\xc3\xb3\xc6\xcf\x14\xadw\x1d\xf2\x14\x0bk\x07\xb9\xce\x03\xce6\x14\xca\xb8a;\xfc\xed\xf0\xe7\n\xdf\x8b\x81K\x08\x83!v\x83\xa9k\x04\xcbK\xb1\x0b!\x85\xb4\xe4\x970\x18\xf3\xb0rI\xce*\xddS\xee{<	\x9d\x89\xb7\xf6\xb3g\xaf\x04\xa1\xa9H\xde\x88\x91\xa1~\xa5\xacvM\xd8\x81\xd9T~\xc6V\x7f\x7f\x8f\xdb\x07\xfc\xfa\x87\x18\xdc\x97HO\xc4\xa6\xfa\xfe\xa6\x15\xb1\x83\x9f\x87\x1f\xd0w\x14\xe8\xbe\xfeG\xbb\xb4\x8c\x1c\xd5\xcfom\xb3\x03n\xfb\xae\xe9\xaeb\xc7h\xc5\xe1rA\xd35\xdb\xd8\xb2\xdd\xe0\xc1\xe2\xef\x01\x00\x00\xff\xffPK\x07\x08\x04AO\xf0$\x01\x00\x00\xaa\x02\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x96\x0d\xdbX!/\xf6\xd0\xb2\x94\x00\x00\xb3\x95\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01\x0d\xc4|fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x18\xac\xd7X\x7f\xc22u\xca\x04\x00\x00C\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xfb\x94\x00\x00lang/en_GB/common.jsonUT\x05\x00\x01\x81\x94xfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x0d\xac\xd7X\xa1d\xa7\"\x8b\x10\x00\x00\x9e/\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x12\x9a\x00\x00lang/en_GB/server.jsonUT\x05\x00\x01k\x94xfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x9eLe*\xf6\x04\x00\x00\x86\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xea\xaa\x00\x00lang/es_ES/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xb0:\xc5J\xa9\x11\x00\x00\x9e1\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81-\xb0\x00\x00lang/es_ES/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xf6\xb8\x13[\x96\x05\x00\x00\xeb\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81#\xc2\x00\x00lang/fr_FR/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\"\xb3C\x86\xf6\x10\x00\x00\x032\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x06\xc8\x00\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xa5\xfeKi\x82\x05\x00\x00\xfc\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81I\xd9\x00\x00lang/nl_NL/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xff\xb8\xf7\x7f\xa6\x12\x00\x00w4\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x18\xdf\x00\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xb2\xb7\x93{\xdb\x05\x00\x00d\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0b\xf2\x00\x00lang/pl_PL/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xc8\xbd\xa6\x93;\x12\x00\x00\xaa0\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x813\xf8\x00\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x9a	\xb1m\x05\x05\x00\x00\x96\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbb\n\x01\x00lang/pt_BR/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x16\x8dz\xdf\xb0\x11\x00\x00\xfd0\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0d\x10\x01\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X'[\x9e\x89\xcd\x06\x00\x00\x7f\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\n\"\x01\x00lang/ru_RU/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X|\x80\x1e\xdc3\x15\x00\x00tA\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81$)\x01\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xa2E\xfc\xcc\xe8\x05\x00\x00;\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa4>\x01\x00lang/sk_SK/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xb4ZGmG\x13\x00\x00\xc31\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd9D\x01\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4XE\xd7Z^8\x05\x00\x00\x91\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81mX\x01\x00lang/tr_TR/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X7\x8fXu\x19\x11\x00\x00`/\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf2]\x01\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\xb3\xff\x16F\x96\x06\x00\x00\n\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Xo\x01\x00lang/uk_UA/common.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x8f,\xd4X\x0d\xff\xce\xc2\x1e\x15\x00\x00\xad;\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81;v\x01\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xde\xbfsfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3XA\xbd\xd5h\x05\x01\x00\x00\xd2\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa6\x8b\x01\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3Xs\xdcw}\xe3\x00\x00\x00\x8b\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x06\x8d\x01\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xee%\xd00\x86\x01\x00\x00\xcf\x02\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81=\x8e\x01\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00f\xb9\xd4X\x89\xdd\xb4\"\xa2\x01\x00\x00\x07\x03\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x19\x90\x01\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\x10\xb7tfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xcc\xaa}OZ\x02\x00\x00Q\x05\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x10\x92\x01\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x0b\xce\xa2\xd0u\x01\x00\x00\xa2\x02\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc5\x94\x01\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X)\x9e\xae\xd8\xb4\x00\x00\x00\x0f\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8f\x96\x01\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xad\x9f\xd7\x04\xa3\x00\x00\x00\xe7\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x96\x97\x01\x00sql/functions/post_board.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xbaki\xf9\x8b\x00\x00\x00\xc0\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8c\x98\x01\x00sql/functions/post_count.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3XQ\xa7\x96\xff\x86\x00\x00\x00\xb1\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81j\x99\x01\x00sql/functions/post_op.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xfd\x08\xb4\x18\x88\x01\x00\x00\xd3\x02\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81@\x9a\x01\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3Xw\x97\xe0l\xe7\x00\x00\x00\x8b\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1f\x9c\x01\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xae\x07\x84\xd3\xc3\x00\x00\x00/\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81^\x9d\x01\x00sql/triggers/boards.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x99\xbd\x0b\x86\x1a\x01\x00\x00\xd8\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81o\x9e\x01\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\"\x95\xe1\xed\x0c\x02\x00\x00)\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd8\x9f\x01\x00sql/triggers/posts.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x04AO\xf0$\x01\x00\x00\xaa\x02\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x811\xa2\x01\x00sql/triggers/threads.sqlUT\x05\x00\x01\xb6+rfPK\x05\x06\x00\x00\x00\x00%\x00%\x00\x88\x0b\x00\x00\xa4\xa3\x01\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x96\x0d\xdbX\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00assets/loading.gifUT\x05\x00\x01\x0d\xc4|f\x00\xb3\x95LjGIF89a\xbb\x00\xbb\x00\xf7\x00\x00\x01\x01\x01\x01\x02\x01\x02\x00\x01\x02\x01\x01\x02\x01\x02\x03\x02\x01\x03\x04\x01\x03\x04\x04\x03\x04\x06\x04\x01\x02\x04\x02\x03\x05\x01\x01\x05\x03\x01\x05\x05\x01\x06\x04\x04\x06\x07\x07\x07\x03\x01\x07\x05\x01\x07\x07\x02\x08\x08\x00	\x04\x01\n\x02\x01\n	\x00\n\n\x04\x0b\x06\x00\x0d\x05\x00\x0d\x0c\x00\x0f\x07\x00\x10\n\x00\x10\x0e\n\x10\x0f\x00\x10\x0f\x01\x12	\x00\x12\x0c\x00\x12\x10\x00\x17\x0b\x00\x1c\x15\x00\x1c\x18\x0d\x1e\x18\x00 \x18\x04 \x1e\x04%#	*(\x0c+ \x0b2.\x0f:3\x17<3\n><\x14C9\x18G?\x1cGA\x0bLE\x11SJ\x13XQ\x14_Y(`Y\x16f_0i_\x1cnf\x1cun&|t#}xH\x81{#\x86\x80J\x89\x7f.\x92\x8c>\x99\x92E\x9b\x93.\xa1\x9a0\xa1\x9aI\xa9\xa1+\xaf\xa94\xb4\xafj\xb6\xae2\xb8\xb1R\xbb\xb34\xc0\xbaU\xc1\xb89\xc8\xc0=\xd0\xc8=\xdf\xd8:\xe9\xe36\xec\xe9Q\xec\xe9b\xee\xecT\xf0\xe7T\xf1\xee?\xf3\xee\x1e\xf3\xef*\xf5\xe9\x1a\xf5\xf1\x1c\xf6\xf0%\xf7\xeb \xf7\xf1\x11\xf8\xf2\x07\xf9\xef<\xf9\xf0\x1b\xf9\xf3\x0c\xfa\xec\x0b\xfa\xef\x0f\xfa\xf1\x06\xfa\xf4\x08\xfc\xf0\x05\xfc\xf3\x06\xfc\xf5\x05\xfd\xee\x11\xfd\xef\x0b\xfd\xf1\x07\xfd\xf2\x03\xfd\xf3\x02\xfd\xf3\x04\xfe\xf1\x05\xfe\xf1\x15\xfe\xf2\x00\xff\xf0\x04\x01\x00\x06\x01\x01\x03\x01\x01\x05\x01\x02\x00\x01\x03\x01\x02\x00\x02\x02\x01\x04\x02\x02\x02\x02\x02\x04\x02\x02\x06\x02\x03\x03\x03\x03\n\x03\x06\x01\x04\x01\x03\x04\x01\x04\x06\x02\x03\x07\x01\x01\x07\x02\x06\n\x01\x03\n\x05\x0c\x0c\x05\x06\x0e\x04\x02\x10\x03\x00\x10\x06\x03\x12\x06\x00\x14\x0c\x01\x15\x0e\x00\x16\x07\x00\x18\x10\x00\x19\x12\x00\x1b\n\x00\x1d\x0d\x00\x1d\x11\x00$\x1b\x0b'\x1b\x021%\x0bKC\x1fOI!VP%ne)sl8\x7fw4\x89\x82=\x8d\x869\x90\x8bQ\x97\x92V\xa0\x98V\xa9\xa4b\xab\xa4H\xce\xc9\x82\xcf\xc9g\xd0\xc8\\\xd4\xceb\xd6\xd0B\xde\xd7R\xe5\xe0K\xea\xe3h\xea\xe4P\xed\xe6q\xed\xe7l\xed\xeb>\xf0\xe6b\xf0\xeb\x1f\xf3\xe9A\xf3\xe9U\xf4\xf17\xf6\xefV\xf8\xed*\xfc\xf3D\xfd\xf1\x06\xfd\xf5\n\xfd\xf7\x11\xfe\xef\x08\xfe\xf1\x02\xff\xf2\x01\x01\x01\x02\x01\x01\x08\x01\x01\x0d\x01\x05\x01\x02\x00	\x02\x01\x05\x02\x03\x0e\x03\x00\x05\x04\x01\x06\x04\x01\x0d\x06\x02\n\n\n\n\x12\x05\x00\x14\x0c\x00\x14\x13\x12\x19\x07\x00#\x0f\x00$\x16\x008+\x10yr7\x8e\x88\x11\xb7\xb0Y\xbe\xb8l\xcf\xc9o\xd9\xd5\x80\xdd\xd8h\xe5\xdf\x8b\xe5\xe1|\xe6\xdf^\xe8\xe1s\xec\xe6~\xf6\xebA\xfa\xee+\xfb\xee\x1a\xfd\xf1\n\xfe\xf4\x04\x19\x08\x00\xd7\xd2e\xda\xd4n\x17\x14	\x89\x82\x1a\x94\x8c#\xc1\xbb`le:\xc4\xbfw\xc6\xc0[\x18	\x04\x1d\x0f\x02\xfd\xf1\x0e\x18\x12\x02@=\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\xff\x0bNETSCAPE2.0\x03\x01\x00\x00\x00!\xfe&GIF edited with https://ezgif.com/crop\x00!\xf9\x04	\x08\x00\xe5\x00,\x1b\x00\x12\x00\x85\x00\x99\x00\x00\x08\xff\x00\xcb	\x1cH\xb0\xa0\xc1\x83\x04\xfa\x1c\\\xc8\xb0\xa1\xc3\x87\x10#J\x9c8\x91\xcf \x01\x02(j\xdc\xc8\xb1\xa3\xc7\x87\x13XA\x992A\x01\x83\x8f(S\xaa\\Y\xce\xc1\x95.\xb1\x9c0A\x07\x01#\xcb\x9b8s\x16\xa4\xf0m\x8d\x983@\x89\x0c\x81\xe6\x0b\xc0\x00_:\x93*\xe5\xc8\x00\x8c\xcfpq\xe4H%\xb2c\xd3&\x1bF\x97j\xdd\xca\xb0i\x9b7O\xc1\x8e\xf1\x02\xe6\xe5\x8c\x18Y\xb9\xaa\xdd\xea\x95\x17T4?\xdf\xf6r\xe3\xe5\x95\xa5\x14k\xf3*m\xfb\x96\x8b\x1a\xa9b\xe9n\x89\xe5\x88\x83\xde\xc3,\xf9\x82\xb3\x92\x05n\xd8\\\x8eu\x11F|\xb3@\x08\x0e\x973\x14\xd0i\xc0i\xd41\x8d\\\xc5\xda\xf2\x15p\xe06\xaf<PF	\xa1\x91\xa3#Zbk\x99\xb5\x81\xb3g\xa8&*\x80\x18\xe5\xf7\xb4\x196bG\x80\x00\xd0\x92\xf8j\x88F\x97U)\x03Sn\xae\xd49\x15o\x19Q-\xd3\x0f8\xbeK\x8f\xe1Bk\xf8\x1c\x03H\x8f7\xff4*z\xec\xe34o\xb0T\x88~\x1b\xac$S\xba8\xd9\xe0\x12\x8b.\xd0\xcf\xf8-ah0@\xfc\xc2\xe4\xa4\x9dw\x1aI8A\xd0\xdes\xa4\xd9R\x84\x0b\xd8\x91\xe1\x1c~`X\xd1\xc31y\xf8'Pr\x83\x88\xc3\xca+=\x99\xe6\xe1_T4P\xe0\x81\xcc\xb9eD\x0b\x01>\x06\xa1\x17\x9c\x14\xa2\x80\x85\x00\x84\x80\xc5\x8c\xdetq\x9f\x87\xb2\x05H\xdb\x88\x90\xe1w\x1f\x16\xd4\x80P\x89-\xe6\xf9\xb8\x0bz\xb6\xd4\x12\xe4qFA\xf3E\x91\xc0\xfd\xf6Uo<\x146\x89d\xb2P\xc0\xa3\x91OE\x98M$/qy\xa3)\x83PV\xd4\x0f\xafD\x11\xd7\x87\x9f\xb1\xe0H'V0F\xd7\x8e\x95\x91(\xa5\x1b\x8d\xb92Bl\xdaq\x19\x056\x10\x1c\x06\x81:\xb0<\xd9\xa3\x8a\xc1\x912\x05\x91\x8d\xcd\xa5\x057w\xd4y\xe8ie\xe9\xd2\x8d6$\x9c\xd0\xe8\x8d+\x02\xba\xd6\x01\xc6dS\x1f\x9b\x805\x96E%\xabl\xea\xa3-\xfd%f\xe7\x15C\xc4\xff\xa0\x83\xa9JP\x13C\x8db\x86s\x05,\x84\xb4\n\x12FF\x85W\x11\x12L\x8c\x9a]\xa9gQ\xa3\xea\xaa\xbe\xaad\xe0\xa4\x80q\x02\xc3'9\xe80+a\xb2\x94(\xe6-B8\xd0lqE\x05[\xc0\x10\xe4\x96\x9b\xccQ\xe1\x1e\xf5_\x07Bh\xd1\xe7\x83Rj\"\xc4\x10\xd9\xa2w$\xb3\x92r\x19\x8d\x0dPX\x05B\x10?t\xd0\x89\x17\xc7~\xb6\xc5&\xe0\x89[\x121\x08\xc4`\x15\x0f\x04\xdf\x1b\x86U\x14W\x85\x96q\xe0\xa6\xb3\x03\x16k\xbeKV\x10\x9ex\x12\x0d\xa3\xb8\xfc%\xe6f\xaeB+\x95|\xccEq\xd6~	p\x9c+dV\x9ces\xaf\x17<@\x00\x0eO\x84I\xaa\x8dN\x11A\x83~\xc1\xc6@\x03\xae3[1\x8d\xcd\x92\xdd\xf93\xca+=\xabo&P\xf4\xf8E\n\x0b\x1cpJ\x83?G	\x14\x18\x88\x14cG\xb0\x99\xa8\xe2\xe0\xcc\x81=A\xc9\nX\x93sM{>C\xf5\xca8\xd6``\x8d\xdb\xa7\xd0}\x0d9\xdd\xa8\xff,\xd9z)\x1b\xb9\x05\x10\x91\\\xd2\xb7\x83V\xb8h\xc1,\x12\xa3\xfd\x97\x05\xe8\xe2!L\x1f2\xd4\x8b(\x97\xf5\xa5\xb6\xc12\xadtsi-R\xa8\xf9\xdb\x15\xdbdh%\x08\x8c\x88\xf0\x87e[\x0b\xd8]\xbe\x98\xaf\x03f\x89\x92x \xc26\xeez|\xf2w\x16\x89\xcd@3g{\xed\xf8O\xb68\x83\xba\xed9\x8b\xf2\x05-\xe8L\xf2L\xee\x0ef\xc1J\xd8\x8f\xa0\xe21\x18\xad,\x00{v\xb23\xd6\xd3`\xb28#\x85\x9dbB\x82\xf30	\xd1q%\x94\x8e\xaf\x1aa#\xab\x1b\xf2C\x113\xc4\x0d\x93\xe6\xac\x18{Z7\xb5m\xef\x9b\x16\xfcs\x06\x87\xbe\xa6\x85\x11,\xa7c\xb9\x9aNM\xe8P\x00`\x1c\xc0\x03VpL\xae\x867\x89\x8b0\xec\x06@+U\xf8\xe4\x04\xaf#\xd1)pI\x93\x02	\x0e\x87\x86+\x88c\x1b\xda\xba\\\x89b\xb3\x0cC\xd4al:[\x06\xc9&\xd8\xb53\x980\x10	\xc1\xa0\xd3\xb0\xc55\xa7}\xe6\x83Q\xdb\x80\xcc\xff\x84\xe7\x960\x88\x856\x15\x18_z<\xc08\x1f&\x90h\xbcs`\xc4hH\xc3H\x9c\xef\x05\xba\x10Co\x12'B*p\x90}@\x8c\xda\x10g6\x9b\x0c%\x88\x03\x96\xb3\x0f\x0di\x81\x89<\xf8\x81w\x19\x08\x1e\x15s\x15\x05\x15L\x0e@g\xeb\xce$\xf4T\x98\xd69\xa7\n\x81\xda\x12\x11\xf1\x13\xbe\xc2H!b\x92\x98\x82\x11\x8bHE0\xd4@7\x0c\x8c\x11\xf9\xe6\x98\x1f\x1c\x06\xa0\x81\x8fH\x10\xd0b\xa3\x9e\n$!\x85g\xc8\x12{\x06\xc9\xc8\xe9MaCM3\x03\xff\xacW0?M\x08\x8e\xd0\xa3\xe4\xb6\xdax\x87Hf2=\xf6:\x92,V\x00\x9b\xc6\x85h\x94\xc7\x9a\x8d	^\xb1B\x9f\x85\xc0\x8f\xb2lQ-\xf7\xe0\x80[\xca2W\x8f\x8c\xe2\xe6R\xa4\x1dh\xf4\xd2`\xab\x88\x000\x89h\x84F<c\x16\xa9\xa9\x9d$\x04H\xb0F s\x8eZP&\xba@@$F>\xd3`\xb2@X\xbat\x13K2D(\x13\xd2\x90X'\xb7y\xff'\xc2Q\x00\x03\xef@U\xe8P\xc9\x9c\xc2|\xd2\x89\xb9Rg\x1f\\H\x82\\\xf4\xa64\xef\xfcM4\xd2g\x11@9\xcf\xa1&\xdb\x05\x0d<a\x9a\xc4q\x86\x16\xef\xe2A	\xde\x88\x88i8a\x91\xeel\x02:\xaa&G\x1a\xa2\xe2\x95\x0e,\x89\xfd\"z\xa4\x89\xc2p\x02\x97\x98\xc9:f\xf0\xc5\x8d\xa2\xd4\xa3\xd1\x01i`DZ\x80\x17\x90\x8b}(\x9d$\x0d\x89\xb0\xcc\x84\x81\x92\xa6fp\x02\x0e~\x91\xaevR\x00\x0798\\\x12\x9e\xd0\xd1o\x89\xb1CA\xb8\x02\x10\xacB\x84\\6\xee\xac\xa4\x9a S-y\xc9\xa4B\xd5'k%)G\xcb\x80\x80\xd5\xfd \x08\xa2\xd3\xa7W\x9d\xa5\x04\xa7$a\x19i\xcc\xa8\xee\xd0J\xc9%\xc0`\x81EiB;\xd5H\xd3\xb8\xc6\xcf,\x160\x1a\x0dR\xe1V\xa0\x8e2\x03+ \xe6`\xddIJY\xd2$\x01\x97\x0c\x06;\xe7\x82PY\x1e\x81\x12\xde\xe2\x1deYEB#\xbd\xee\xa3\xa0\xe1\x06J\xd3\xfaV\x1f\xff\xad#~\x0b\xad@\x169K\xd3\xcf\xaeS\x16\xacZ\xac\x91\\\x11\x88\xa4\xf4\x814\x8b`\x85\x04;[[\xfc\xf86\x92\xad@*M\x9d\xb0\x81;z\x8b\x16\xacE(q\xf7\xc2BE\x12\xb6\xb9\\\"SS\x13\xa2\xd4g\x92$\xa6!\xf1\x06\x07f1\xc3\xd2l\xd7\xb8\xa9Z\xef\x14i\x0b\xde\xa8\x9c7\x92\xc7\xa8\xe7[\x01\x19\x0c\xeb\x16\xf2\x80\x1ezoR\"\xc1+\x14\xd6\x97\x92\xb5\x18/\x1e\xf4\x0bU\xfe\xc2\x920\xabxj\xf6\x96\"\xaeC\xaa\xf0\xc0?T\xb0f\xc1\x8b\xc4\xaa\xea\xa2\x19\xb8\x9b\x14 \xb5\x02\xaa\x85\xd6\x02\x81\x186\x92\x14|gKC5\xd7\xc1\xbckL\xf8\x94\xdbU\x12\xebA\x02\x06.m\x8aC\xa98\xf4R\x81\xb1\x0d\xc6\xed\xd8\xda9\xd3\xd2\xb1E\x02\xde\xbd0\x86\xcb\x02\xa8\xba\xde\x91\x83\xb5M0I_\x88b\xb7P7R\x14\xbeq\x84\xbf\xbbc\xa7\xf0Jg\xbcs\x05}\xd1\xa9\x8b\xd29\xf9\x0e\xc25\xcdm\x8f\\\x00\x00Ct\xff\xc7>2\xb34\x1fZ\xdb\xfb~'\xcd\x9fY\xb3\x8d\x0d\x00\x0bN\xc1Y\xc5\x18hr\xb8\xf0LS;\xa3y\x90\xdd<\x06k0\xb6\xae@\xf4\x99\xcbp\xe6U\x88\xc4E\xe8\x88\x1a\x9a\xd0C\x00\xed\xa2\x13\xd2\x15c\xb4k\xcc\x8e\x9b\x95,%\xcd\x00J\xd7\xf7\xd2\x83\xcc\xb4\xb3\x14\xc6\xe8\xe2l\xd8\xcf\xb2\xccRy\xf1CjSs\xb8\x81\x92;\xb4o\x98\x9a\x18V\x0f\xe4(\xafne\xd24`#\xe6\xd2\x1a\x03\xa8\xbe\xb5\x87O\x83\x8a\xa9\x1a7--\x19\xa0Y\x8d\xed\xdaeHWL\xb5~\xf2\xa9q\xcd\xcc1\xcaA\x15\xe9\x10\xd6\xb3\x89S\x87E\xcd\xd7\xd2\xd6\x06r\xae\xb2\xfd\xc2J\xbf\xf3\xbe\xcc\x08\x045\xcd@\x83C\xd8\x81+0\x14\xc7#Z\xe1\x8aB\xc1\x9a\x8a\xb4\xb96\x97\xd8-\x08w\x9b\x97\xdb\xe9\xf6Z\xbd\xc5M\xe2\xf1=c\x11#\xf0\xc4\x17[\xba\xad\x84\xcf\x91\xe0\xad\xdd\xafI^\xf8\xe3\xc0\xc4\x02-y1@\x04\x89\xe7\x03I\xc0\x00\x9cO\xff\xad\xb8\xc0\x01\x9d\xec\xe6fs\xd0\xa7\xd1O\xc8\x1d\x00\\{\xda\x10\x0b\xa5HD&\xe9\xbcn\x8b\x03|\xbd\x88\xdd2x_^K\xe1\xdeB\xe6\x9f:D\xb6\x06\x0e\xf1Y\x07\\\xdd\xd8\x0e\xc1\xa45\x90\xf1\xb7f\xc9\x01\xe7\x0d\x10\x07\xb2\x07m|\x0f\xa2\xd2\x11b0\xa0W\x1eg0\xad\xf8\x0fH{1h\xb3\xfe\x95\x0d|\xb9\xd5$\x16\xd5\xad\xc9\x0e!\xa1\xae\xd8\xe0\x07_\x1c\x7fj\xa4\x9eU\xd4\x11\xee$^\x16\xb5\x99Nw?-\x02\xef\xa3.\nI\xc6\xa7^e\xac\xc2\x04\x88)\xf8\xdc\xa1\xee8o\xd7\x99\x9e\xa3\xe2\xc2z5\x1f\xf9\x8e\x17^\xe5\x94\xff\xf3\xba\x01\x9c\x05\xf5\x824\x03\x91\x7f\xc7->/&U@b\xb6:\x16}\x02\x95A$\x0d\x98\xa9\x05y\xe5m#\x91 \x03\x9e\x0b[\xf6\xee,`\xedW\x93\x00\xcfw\x90\x8a\xa5\x135\xa8\x81\xcf\xac\xe1\x9b)\x1d\xb9\x17\xec\x1c\xad\xb0\x03\x0e\xc8f\xf9\xcc\x07\xcb',\xd07\xdb\xff\x9a\xd5\x80OL\xff\xeb\xa0\x90\x84\xf2\x1f\x94\x92\x1f\xef\xeb\xbf\xb3\xaf\xfb\xb0u?r\x14\xa5(\x85\x910\x8eq\x84\x82m+\x80\xc6\xac\x19i\x0bK\xd4?\x15\xbe\x17{\x7f\xf6a)P{7\x05m\xc1\x92e\xad\x91\x03\xee\xf2\x12\xac\xf75\xc63\x02b\xf6\x80d6\x06qBq+\x02	T\xe0\x14\x8b\xa033\x17.-10\xbf\x87N{\xd4Co\xd6\\\xd5\x15l\x80V/#\xa0\x16\xb9\xd5u-12\xfbWy\xceP\x1e3\x88`\x98Q\x1e\x08\xa5y2\xd3\x05\xfdcc\xe3a\x03#W2\xb5\x15\x06$\xe0\x08\xd5`y\x1a\xc7\x18#@\nqSJ\xb3\xa3J\x180n7\x16~\xdf\xd7\x02\xbb5\x82\xad\xa4E\xb1\x00\x0d\xa3`CE\xf8\x0d\xb6\xf0\x01\x8a`\x80G\xf0T\xddQ/X\xf0\x08\xe3V\x11\xd0\xa0&3\xa52\xe8t\x03\x19 \x84\xdd x\x95\xa79=\x08\x06\xfaq\x1dQ\x92\x05z\xb2[7d!\x17\xd2\x1a)r\x83	$\x0bk%	\x9b\xa2E\xf3\xff\x95EW0\x18fP\x0b\x85\x91&)\xa4\x05m\xc4\x80e\xd4\x08\x87cB\x84H\x10\xc9q\x1f!\xd2\x0cU\xf7N\xdb\xa1\x05\xd9\x84\x19\xfb\x91H\xe0\xb3\x05V\xd0\"\x9b\x83\x19\xf4\x01{\xa6\xa2\x05\x8b\xe8\x08\xd7gY\x84\x88!\x88T\x08B\x07^\x11\xc2\n\xb3 \x05T\xc0G\xaa\xb8\x08\x9d@\x8cU\x80r\x9b%\x07\xb6`\x05\xa1\xd3\x89\x05\xf4\x89\x85\x88{\x0eH\x18fC\x81\xc3#%\xcf\x18'\x9e\xe33\xd3\x96\x87\xc8C\x8d\x97\xd4\x03\xdb\xf0Ek8oa(\x87\xa5Ue?\xf3Z\xe4h\x08E\xf0j\xb1\xf0\x05\x93\x90?Z\xb8~\xec\xa7\x06\xf5\xc8\x080\xc8$\x03\x90\x0e+\x05%\xb6\x10\x03\xeb v\xfb(KO\x10\x03P\xb4\x8b\x12\x00\n\xfa\xe3\x13[\x80\x03\x8d\x10\x80b\xe2\x0d\xd5\xd2\x88\xda(K\xe6q\x05Mp1\xe2\xe2\x1f\x00\x80	\xd1Pu\x80X\x01\x10\x83}\xdd\xc0\x03\x9b\xf0	a%\x80\xc0H\x04!sX\xdfw\x00\xe2A\x001\xffP\x8a?\xc1{\xe7v|D\xa8E40\x03\xa3\xb0\x04:	\x8cKpZ8\x03\x8a\xff\x18rC\xa2{e\xa1\x7f\x18\x88N\xd0\xe0\x7f{\xa3\x82d'X\xb6\x90\n\xf5W\x7fA\xc0m\x08qcf\xe2L\xa4\xd44x\x88~\n$	\xa3 \x0b\x9e\xe39\xb5P\x94wR\x8f\x7f\xf2O\x96T\x88\x9d\x96zJ8\x80\xceX\x03\x8f\x80<\xaas;\xb0\xf0\x97\x80	\x98\xcb\x08\x0b\x82\xc6i5i\x85v\xd9!\xba7\x80b\xe8\x0d\x8c\x02H\xc7\x18\x990#\x08\x0d\xf4\x95\xe8\xf3\x89\x924x\xee\x14X]\x06\x0e\xba\x04@\x98\xf0\x03\x98\xe0\x10KI|\"0\x84\x9ai\x81Q\x98\x8f\xcb\xa5\x8f\x8c\x15	\x7f\xd7\x03(0\x1e\xa5i\x97\xac\xf9\x13\xfc\x93\x08\x88\x07\x8c\xcaAo\x88Y\x1c\x98\xe9$)\xa7Be1\x8a\xbb\x19\x86\x10D\x06\xf5F\x9az\xb0\x8b7p\x97\x94b\x0b\x83\x81\x05|tc\xa8\xc9\x98T\xd7\x05\xcbI\x8d]\xb1	VYD|\x82\x89\xc7\xff\xb39\n\xa3\x8e)V@I\x14\x06\x0bw\x93\xb5Y\x1c\x9ep\x1b\x9ct?G\x18\x8b\xe1\xb7\x07\xcc\x00\x9d\xf5U@r\xb7\x9df\x02\x7f\xeb\x82&d\x11N\xc21\xa0\xbfy!9\xf6g\\\xf0\x0c\xa9Rz\xfc\x19y`\x96\x80\x06q\x14\xae\x11	\xf3\xc9\x14\xf8\xc9\x9bB\xd5\x06\x8f\xc4\x9e\n\x13\xa1\xbef\xa1\x8bY_-P\x86%\xb2\x90\x05J\x85\x03\x100\x10\x1aT!Z\x84:@\x01\x9f&\x16\x13%(\xf3\xc4\x89\x99\x90.\xd1\x91\x01\x19\xdae-b|g0\xa3!g4#\xf5\x00\xf1\xb6\x04\x1f\xb7\xa2\xbd\xb6\xa3\xe7\xb9\x04!\xe14@\xfa)\x1b\x98\x03\xf8g\x19e\x84\xa4\xabv\xa1V\x87\x01\x96\xd0Zc\xf0\xa4.(\x1a\xa3\xe1\x91\xde$\x06W\xd7\x9eL\xa1\xa3-\x9a\xa5\x11\xf9\xa3\x0c\x87o\xea\xb0\x87\x1d9\x02!\x19DX\nU\xfc\x93d\xd1\xd2\xa6\xf8\xc6\x1bl\x12\x05\x98a\xa6\x14Qti\x1ad\x19\x80\xa7l\xea\xa0=\x10\x87te\xa5\x1e\xff!\xa8\xb7\x89NZ\xd2\x12\xa7\x94;^\xaa\x17\x00\xa0C-U\xa6\x80\xfa+B4\xa8\x11\xc5y\x85xb\xbbP\xa9\x96\x8a\x85\xd2\xe7\x13\x85\xc1\xa8\x15\xc10\x08\x89\x9cJi\x0d_@\xaa!\xc7\x02\xb0\x07\x18\x9e\xd3\x81\x9b\xdahY\xf8\xa8h\x93`;!\x97tp\x93\x91\x00k\xe6A\x0bv4\xa7\x12\x91\x1c>z`\xefc\x99\"\xf9\x08=\xa9O\x97\x905\xf1\xd7\x9e\xc9\xf1%\xe6\xb9m\xff\xb1W\x96j}\x8a9H\xdd8\x0d\x89@\xad\xfd\x05~\xe5\x998\x16\x19\x86\x02\xc6\x9d\x04A\x02\xd9\xba&\xdb\x11\x98\xa2\xa0`\xc02\x92\xae\xb0z\xa9\xf1\xad\x9e:}\xa8\xc7\xae\x07\xa1)\xa9\xf9\x14\xac\xa0\n\x035\xb0\x06k*\xd3c}\xbc\xbaF\xefp\xa20\xd2{\xa1\x879\xd2\xd5\x91_\x86\x88\x94\xc4u\xfe\xfa\x1f8P\xabjWV\xfb\x9ax\x0e\x0b#\x02\xd0	@\xd0\xaa\xb7\x89\xad\x1f\x8b~\x04\x92\xb1\x9df\xb2\x1f\x9b=\xe9\xfaV\xde0\xaf,;\x1e\xf5\xff\xa7\xa8>y(ss\x0d\xa4\xb0\x01\x1c\xabv!\xcb\x9d\xc6\xe0\x8b^\xc4\x8e\xa5\xe2\x18W\x10\x05\xa0\xd3-Y\xd3\x08?{y5\x8b\x1c\xbdB(S\xf0\x97\x9c\x19JU\xdb\nP\xd4\x1f\x06\xd0P)KE\xdd\x00)Q\x9b\xac\xe4JuqB#\x86F\x00\xedz\x9d\xf5\xa5gc\xbb\x11\xf0gA\xe1\x07\xad\xd8\xe7Y\x01\xf0\xb66\xe6\xb2\xa6\xa8\x0e6\x89\xb7{\xe1\x01\xfb\xf7y]\xc0k~\xfbl2\xa2\x85k*&\x84[\xb89\xca\x88\x11{$\xb23\x9c\xa5\xd2\"\x8c\xfblnis\x89\xe6\xb2\x94[\xb99z|~q\x05X\x81k\xeb\xb3\x98b\xc5\xad\x9c\xdb\xa8\xb5\xe4\x8eZ\xe4\x02\xd55\x102\xd4\x8e7P\x02\xa7[ \xc3\x10\x02\x8fh/\xb1P	\xff\xd4\xae\xf8\xb9\xa1\xb3\xdbk\x8e\x10\xad\xe2\xd9j\x14p	O\xf8\x19R\xfa\xbb\x95\xf1\x00\x8e\xeb\x1b9\xd0\x01p\x17#\xd1\x1a\x06H\xa7\xbc\xceB	)\xf9\x18\xfb\x84\x10\x1c\xeb	#j\xbdQ}\x83\"Dt\x94\x06\xd0\x15H\xc5\xa4A\x0b\xbe\xb4\x89E\x8dC\x18\x19\xd1\x15\x94\xc5Hb\xd0\xba\xea\x9b\x12M\xb2\xab[\xf0K\x0e1ZG\x14\xa9\xf5\xbbh2\x90B\x18\xbb\xbfD\xa6\xbf\xff\xcb\x1a\xf3\xc1\x06\x08[\x01~\x80\x1cMtu\x07l\xbf\x98z\x06F\x96\xac\x05\xf5\x00\x11,\xc1\xd1sv\x15\xc1B\x19,\xc1~!\xb6\x81Z\xc1\x1f\xfc\x11\x97\x9a\xb4e\xa2\x11\x0bT\xc2\x08\xec\xb6,L\xa3\x1d\xf0\xc2\x0b\x11\x10\x00!\xf9\x04	\x08\x00\xe2\x00,\x1a\x00\x0b\x00w\x00\xa6\x00\x00\x08\xff\x00\xc5	\x1cH\xb0\xa0\xc1\x83\x04\x01\xf49\x80\xb0\xa1\xc3\x87\x10#J\x9cH\xf1\xa0B\x04\n\x00T\xdc\xc8\xb1\xa3\xc7\x8d\x17-\xe4xD\xe1\xa3\xc9\x93(A^\x8c\xa5%\xd6\x87\x8c)c\xca\x94\x19\xd2\x8c.-\xb3\x80\xbc\x9b\xc9\xb3'\xc7\x90\xdf\xd6\xa49\x13\x06\x8b\xa5B\x1a}*]j\xb0&\x9b8\xe1\x9ev\xb1\x82))\xd3\xab>\x9d\xbe\x11*\xa7\xab\x11\x12!\x1a\xcc\xc1J6&\xd0\xa8Rym\x05c\xa5\x165\xabe\xe3\xfe\\\x99\x0b\xaa\xdd\xa1c\x84\xc6\x92\x81A\xae\xdf\x8ag\xefr\xc1k\xd3M\x17*\x15\x1e\xfc]\xfc0p\x1bpG\xb2\x10\xbd{&V-k\x02\x18knJ\xd7.\x86qe\xc2\x14^[\x94F\x83\xcd\xa8\xc59\x06g\"\xf1\xb9\xc9]\xd1\xeaB\x9cZ\xf3\xea-+DEkBf\xeb\xe3]v1\xd7^\xbcZ\x97\xa5iI6\xd9\xf2\x9d\x96\xcd\x15np\x87\x93-\xde\x0dU\xac!\x99\xc4t\xa9\x9b\x96K\xacK\x1bT\x0f\xff\x90\x8e\xb5\xf8\x95\xed`F\xc9P\x13\x9b\xf2\x19)\x02\x08\xdc\x89N\x9egq\xd1^_\x0c\x0e\xed^\xd75\x08\x03\x10P\xdfR\xf7M\x86E(B\xc8\x82F{\x82\xa5\xd2\xc3X\x03f\xd5Y\x7f\xb9@\xf1\xce$\xcbQ\xd8\x05\x13\xc8\xd0\x17!J\x05\xb6\xd7\xc55\x91x\xa1\xe1\x13\x8c0\xf4\xe1L!~\x03\xc6y\xab<\xa2\x0bl\xeem\xd1\x89/+\xd24!s`\xa4\x02\x8bw\\\xac\xf2\xc2\x10&28c8Ax\xe8\x97\x92:*\x04\x06w\x94%	\x03\x1c\x95EcI\x15\xb2\xb9\x87\x03c!y\xd2H\x06\x82(\xb5\xdao^x\x92\xc9:\x1a\xa0\xc0C\x16\xb78C\xa5\x91OE\xb1\xc2\x92\n5\xa2\xcb+EV#\xe6\x8e\xb0\x05Q\x03\x16\xd9\x80\xf2\xc8\x14\xd9,\xf3\x05\x7f\xfd]\x12W\x80z\x0c\x92JK\xa3\xa1\xc1\x81\x84NB\xd9g\x0d\xb9p\xc1\n\x07\x1bP`\x08$4RV\x03\"W\x854\x089L,h$\x08\x94F\xf0d\x7fI\xfa\xff@\xd4a\x13\xe4\xa1A^\xee\xa9E\x06%\x16\xec\xa9\xc0%\xb1x\x01)s\xb1\xa5\x81\x85\x80\xf6\xf1	\\\x19X\x1c\xb1\x89\x82c\x80\xb1\x8d\x03\x06\x0c\xf1j\xaee\x80\x80\x07\xa3\xdcr\xdb\xe9 \xc5L\x14\x92\x14Ph\x97%\xae\xdb\xbd\xa1\x85\x01=\x8dI\x19\x13+\x9c\xc7F-\x86\xccw-\x85\xb78\xb1\x03	\xc7\x04\x90\x8c\x1fH\x08\x11\x03\x16\xc2bA\x053L\n\x14\x127\xdd\xc8\xbb,W\xe1\\!\xc5\x08\xd9\x85\x81,\x8b\xca\xca\x06/\x7f\xb6\x04\xf1\x82	RD\xaak\x8dH\xfd[\x02-Z\x98\xdb\xd5\x100h`A\x04\xec\x16\xc4h\n\xeb5\xa7\xeaZ\x8f`\xa8\xca\x8b\x0c\xb4{\x11\xb4'6\xe3fh`\xc0\xf2\xcc\x16\x88\x92I\xd9\xba\xdc\x82\xe20\xb1\xb4\xd02Eb\x031\xea\xc9\xd36g\x01\xc6:U\\\xdbE\xaf\xc96\xba\x01-6ww\x99\x0eE\x8eA\xc53\xe9\x8eL\xf2|s\x14\xf3\x80+*\xe7z\xcb'\xa6\xb6\x92!\xb6a\xb8\xff\x80I\x0emC%K\xabd\xc3\xf9\x1b\x17\xaf\xd8\xd0ue\xb4H\x825\x85`\x1e\x10\xf7\x03\x85\xec\x87m\x1c\xb8\xb9<\x85\xe5\xc4n\xf5\xc5+\xb3\x9cR.a\x82\x13^\xb6\xdbeT\xe12\x9eid\x83i\xe7\x82I\xec\xe9/\xf2U\x90\xf6\xe5\xe7\x91d\xc2\xe9j\xbcR\x95#{Cm\xba\xe1\x0f\xbf\x02]\x89h\xb8\xf2\xc1\xc3\xb8+O\x87\x1d\xc20`\xc5\xcd\xb8/\xb7M32\x10!\x05\xc1\xd1\xba\xf0\x89(S\xbc2,e\xdd\x0c\xcf{7\xc7\x93\xc0z+ $\x8d;\x12b/\x14\xfc\xe5kM\x81N:\x05\x0c\"\x0b\x17/\x1c\"\xcdQ\x91#\xc1\xfc\xca\xe7\xb3J\x11O\x0b\x85\xc2B7\x1a\x16\x95n\x1c-T\xd8\xfaB\xff\xa2\x07\x0c\x0eP\x8f~\\Q\x9d\xe4\xa4\x80\x8e)\xe0\x87-\x00\x82E\xec\xcc\xc7\xa0(\xac\xa2\x14\x95`\xc9y\x1cf4\xf6@\xecrC\x08\xc40j'2\x0c\xee\xa2\x1b\x00\\FQt\xa5\x05\x16$bs\x86\xf9\x0d+\xffH(\xc4Q0`\x05mq\xc4$\xa0\xb1\x1b\x13u\xc3\x03.\xb4\xa1\x11\x00T\x87\xb8\xe1\xca\x86wqB\xbdn5\x9aV\\\x03\x82`\x08O\x01\xc7\xa6\xb46\xa8\x02o\xe0\xda\xc0$X\xf1\x04s\xcc\xc0\x12\x92\x99\x05\xa8\x88\xe7\x1e\xf0P\x10?X\xb4\x0b*0\xe0\xafu\xe0E\x0b#@\xda\x15\xd5\xb2.\x12\xc2\xa1\x1b\x9b0\xc0\x1f\x1a\xb0\x02X\xdc\xe2v[\x89B\"\xe0\x88\x0b,\x9aFl/\x80\x9d\x0d\xbf\xe4\x0bD`A2\xc7\xb2\x84\xf8D\x16\x0c\xc2I\xa6\x0c\xd6\x19\xd5\x04\xfe$HHFL\x1bX*#\xb6\xaa\x02=>0\xa2\x866\x04`\x80\x90\x86\x1b\x12e\x80\nW\xdcB\x01Z\x85	\xedl\xeaQQ\xebF\x14\x97I\xa3\xba\xd1\xef\x92\xfe\x9a\xc0\x12\x9c\x89EB,d\x18s|\xd5\x1e\xd3\x16F	%\x03aOZ\x04\x05F\xf7B:\xf2\xcen\x8b\xa4 \x0d\xdeTN\xfai\xab\x96I8\x12p\x80\xb7\xacB\x9an\x03\xd8\xc0\xa39\xdd\x97\xff\xc7\x97u\x88\x18\xeb\xe4'\x06\xbbQKE\xa6\x8c?\x90\x08\xd6ch1\xcc{j\xe3\xa0\xed\xcc#\x06G\xb0\x80\x19\xeea\x06\x11\x1dh|:	\x9e\x80\xba\xc1\x16#\x88M\x0e\xfa\xe0\xab;\xe4\xeeG\xb8\x94\xe8@E\xc0\x87=\x00\x00\x13\x9c\xcb#Hig\x80iL\xa1\x1a\xaa\x98\x0c\x17\xd9 FS\x82\x80n\xccSi?3\x12 %\xc8\xd2\x86\x88y\x1e\x12\xc2@\x15k\x9d!\xa4j\xe9\xe9\x18\x9d!\x0b\x0e\xb4\xc2\x95B\xdd\xe4\xf3\x1aeT\xd4\xd9\xb0\xaa\x15\xa4@K\x82\x81\x8cQ\x04O\xaac\xfc\x81\x05\xac\xd1\xca}f\x15*\xca\xa0\xa9!\x96\xeaU\xad>\xef\x0b\xdf\xb0D\x12t\x004\x9e2\xc5T\x0bA\xc5Q\xdf\x8a\xad\x18\x8e+\xa8\x96T\xa40d\xa4\x82\xa0D\n\xada\xbbf\x05rzN\xc2RF\xb1y\x00\xc6\xf42z\xb9\x19\xc80zyu\xac]\xae\x10\x81\xbf\xe6M\x9f\x96\xc5 \x032[\xd1\xcdfuv\x7f\xe8!\xf7\xecr\x8a\xf2\xff\x1cV\x93\xa9uOEk7\x0b\xce\xba\x93\xac\xc4`\x044\x1c\x11\xb8\xda\x96ja)\xcd\xede'w\x07z\xbeV\xb2\xb9H\xc4\xed\x8cK\xa0\xd32S\xb9\xee\x99\x845'\xe7Z\x95\xc2\xf6V\xcd\x98m\x1a>q\\\xe4^\x17\xbbw\xa1\x97\x1fj7[\xefV\x11z^\x98\x054F;\x9e\x8f\x84\xa4!\x80\xdd\xc6 \xd1\xfb\xb6\xadz\xf0\xb9d\x9d\xc0\xb9bQ_\x8f\x00\xd6\"\xa6\xea-b\xf9+\x14\xb02\xd7\xb7\xd8\x02\xc2\x16-\xd09~\x9d\xa4v\x80MJ\x82\xb1\xca\xe0\xc7p\xc1\x03\xec\xab\xe5~\x87\xbaX\x9bM\xc0,\x19V\x18\xb7X\x87[\x1b\xea\xa0\xab\x18\x04\xe9\xb4D\x9c\xd5c% @J#m\xab\xe2'\xb9\xee\xb6\xf8r/>\xafnC,\x9f\x11\xdb\xd0\xc68\x1e\xd9\x0d\x90\xb1\xe3\xf5\xfe,\xa6A\xac\xac]\x82,P\xc1d\xc0\x15\x186r\x8c\xe3\xa7\xb4\x1c\x98\x16\xb0-\xa8A\x14v\xb8`\xfaQy\xcbD^\x80\x96\xe9\x87d\n3\xc7\xff\xcb\xe5\xbdH:l0\x8dS\xb4B\x99\x83\xc5\xd6\x99\xd9\x8c\x814\xaf\x19w\\&\x8d\x0d\xca2\xae+\xb8\x00\x04#\x88\x86\x15\x1eg\xe6i\x94\xd9\xca\xadx/\x04\xfe\xcc\xb7\xfc\xd9\x929\xd8H\x18\xc6\x14\xc2b-\x08a\x0408\x94<\x1b\xfdh\xfa\xfa\xb9\xc6\x81\xeeJ\"4\x8d\xe2'\xa3\xab\x1b\xe8XD\n\x93;eG\xe7\x19*2.(\xa5s\x85d\x11z\x18)\xd3\xb9-\xf9\xae\x97\x02\x08\xef\x19\xd0\x91\x06\x04\x04\xa8\xf1V$\xa7m\xa4\x84v\x97\xe7FA\x04c\xdb\x1a\xcd\xf5B\xc7\xfcd\xca\xda\x0f6bQ\x17\xc9gn\x8f\x8d-\x90\xbe\x02\x14}\xc0\xf3s\xab\"\x1a\x11\xec\x11\xdc\n\xd1\xcf\xb8\xaf\x8d\xc1\x9dE\xa1\x05\xea\x16*\x80\xd8-\x07\x08\xbc\x1b\xde\x81\xc8\xf7[\xc9\x8d\xc1\x18(x\xdd\x1b \xc3\xba\xec\x19mN\xcf\xbb\xd4\x9d3\x025\x87\x9a	`\xfa\x10\x15tR\x08P'\xeeV\x82w\xd83\x8d\x10$\x0br\xf1\x84\xbf\x84\x04\x1b\x8c\xff\xfeq\xadi\xdd\xe1+\xc2\x11\xe3&\xbf\x089\xebJ?V`\xc2\xc7*G\xef\xcb\x89#sW\x8e\x1aw\x98\x88\x81\xc0?\x9e\xab\x9d\xc7\xbcN\xbca9\xb6\xbe\xf2_\xb7\xa2W\xb60?z\x02F!\x9a6\xfa\xe0\xea\xbd\xc1b\x0eD\xd1V\xa2\xbb\xcd\n\x06\x10C*0\x8ca\xdb*\xe4\x13\xebXG(Z\xc0\xf6)\xc9\xd4\x05\xa7\x18\xc7\xa3\x94\xae\\\xb0sa\x8f\x9d\xe4\xb1\xd91|\x83\x96\xa4\x1cw\xceP\xe2\xe2\xd8iY+\x8cYdW\xa0\xc5)4\xa5\xa2`g\x18\x00;\xe0\xb0L\x01\x19K\xa7\x03:\x93F\xda\x025R\x91\x8bT\xec\xbdvU\xbb\x88&$?yI\x88\xef^YE\x05\x0b2\xd1WBJw\x17Q/)BB\xf2\xa7*\xcb\xd4\n\x92\xa0\x04\x8bS\xbf\x08]4\x81\xaew\xe1D'\xd4\x95\x891\x96}\xf6\xdc\xaa\x99\xe5\xa3<\x9a-\\#\x07\xde\x10r\x9e\x9f`\x83)\xa1\xc8\xa9+#R\x19Z\x16YS9$$-x\xd3\xff\xab\xa4\x8fA\"XU\x16\xadG\xecP\xb4Q\x0dh8aV<\x80\x00\x10\xf2b\x03!\xcc\xc84c\x14\x17\xa3\xcaq\x83Y\x05Q\xa1Be\x0bY\xf0\x12\xcd\xd00\xb6\xb0@dv'\xe8\xa7\n\x96\x00\x0d\x10e,A \x0470\x03#px* \x1d\xa6\"+\xc0\x11\x0520\x03P&S^\xf0\n\x14\xe52#\xd0\n\xb3P\x05\x84\x12x\x1e @`d\x0bJ\xb0\x0es\x87\x05\xe4a*\x92 \x1a\x9eV\x08\xaf!e1\xf6I[ \x82`\xc2)\x87G~\xec\x01\x076\xa0-\x18h*)$;\xf0\xf0w\x12uJQ\x11-`\x84[y\xc1P3\x18\x12\xca'v\x93`{4\x97s[\xc8735\x1c\xa6\xf2,^\xc3\nC\xb3|\x10\x84]\x14(\x1f\xb5a*\x9e\xb0{n@\"?\xe7u_\x95wq\xb3\x19a\xc80\x91\x82\x18C\x87-K@\x04\x1f\x98[\xba\x80\x02\xdfr_\\\x12\x12b\x18)>\xb8k\x99\xe2\x02- \n\xf6\xff\x07\x85]\x88E\x03\xd83\xe2q\x11\x85(l\x06\xf2\x1f\x07\xb7O.\xd2\x80\xa3p\x0e\x7f(eW0\n@\x80\x033\xc0\x19\xa0\x97q\x1a\x87zq\xb2	\x12\x17\x895\x02\x03\x99\x10\x04\xd1\x80}\xe0\x90%\xbd\x90\x8b\xba\x88\x06Y\x00\x05\x1c\xb2]\x96\xb8\x86?sT`\x00\x05\x1e\x95u\x10\xc7C\xbe\x93\x03@0\x81\xbc\x82h.\xe2\x0d\x02\xb8@T\x80\x82\xa4\x90F\x07\x96\x1a!\xf1P-\x06Jg(SM\xd0	\xa3\xd0A\x84\xc2)\xfe\xf4-\x83\x18z\n!\x8c\n1w\xe6\xc4\x84\xcdvH\x02\x18\x8d\xb87D2\x13\x8cv(z\x81#\x87^U7\x0e\xe4\x03\x19P\x04\xf7\x98\x8a\xb6q\x11,\xc8\x85\x0bEz\x85\xd7\x0c\xfd\x86\x8a\xebX\x90\x94\x13/:\x98^e\xd8a\xb8G\x06\"\xf0!\xb4\x17\x84\x13	\x0b\x7fP\x83\x10\xf6V\x13\xe3\x05\x1f\xa0\x91\n\x11\n\xdb\xd6\x8dZ\xe0<\n\xd9lJ$)\nP\x1f\x86\xf8\x04\xa8\xc5YL\x15\x03%\xff@\x00!\x87\x90M\x18Hf\xe0\x012\x89a;SI[h\x0b'\xe8\n\xe4 \n\xd4\"9\x95\x80\x8c\xe8\x05	\xac\xe3\x08U(9\x1c\x80s\x82\x91\x17Z\xb0\x03\xab\x86\x8d[\xa5\x08N\x89]	\xf7\x14\xcfp\x84y\xb7\nB\x16\x0b5\xf0\x8c`2\x90\x16\xa0}\xb08TE\x02v`h\x90\xeav\x80G\xb9\no\xf1\x90\xa8\xa8C\xc9(Q\x00\xd9t\x16\xc3\x8e'\xb9\x1fKf\x8e0C\x89\xf8U'\x10\x85]I\xf0\x07\xdd\xd5\x17\xd5\xa5\x97\xc8\xc7(L0\x04;\xe0Y*\x01\x00|\x89\x16\xca\x15C\xf9\x06\x99%\xa5i\x8f7\x17\x03\xb0\x93\x9c\x99[\x97 \n\xcc\x93\x01_\xd6/t\x10g\x1b\xb0\x98\x80x	>\xc6\x9a\xad)\x99S\x05\x8fB\x15\x03\xe9pV\x04R\x89\x0bP\x0e\xe4\x90\x08\x91i\x07Pu\x9a\x96\x15\x0b)\x00\x00\xe1'H\xa0\xd9$\x80\x95\x02\x8aw\x08%u\x9c\x8ci\x90\xbb\x03\x0e'\xb6i76\x88\xcc@	I\x10\x0bG\xffp\x01z`|5iY\x8d\xd9%_P\x92\x9bv\x0d\xd5\xb8\x95\x9e\x02<\x80T`\xd0i\x9d\xca\xd5\x98\xc0\xe9\x08$\xb5i\xc0\xe4\x0d&8\x05E\xd8\x0e\x83Q	\x12\xc0j\x99i\x9c\xe7\xd9l\xf0\x03\x9c\x10\x92,\xe1\xf3A\n\xb47\xd2\x90F\xadV'_iY\x1e\x99\x10\xb8\xa9#\x9f\x102\xa43\x1a\xbf\x98\x12\xdd\x12\x02\xfb\x98\x9cS\x03p\x7f7V\x1bz\xa0{\xc0\x08\xba)Q\xd9\x10\x00rQ;\x8eP\x0b%\xaa\x8b\xff\xb1\xa2\xfaG\x97})\x89\x83 uV	\x1c \xc4)\xa4y\x11\xa1 \x02-)S!\x00\x91\xd5v^\x9d'\x0bB\xb0[;j\x90\xf4\xc2q\xa8v\x07\x97X\x047*\xa4f\x80\x0512\x9a\x8f\x17\x12p\xd4&B(QTX\x90\xe9\x90\x83\x07\x04\x07\xa6P2a\x9aa\x98\xd0\x9fp\xc8\x93\xaa\x95\x8f\x0b0k\x96\xe2\x1b\n\x05\x0e\xaf\x105\xcf\x80h\"@\x038PP\xc6\xe0)(\xa0\x03\x91!\x1a!FwZ\xffw\x01v\x9a\x02\xe3C\x8c\xb8\"\x8d\xaf\x12\x0b<\xd0\x04\x8b&^\xc5\xa2\x0d\x020G\xca\xd5M\xa8Q'\x8b\xc6|\x8cj\xa5d2-H\x83]\xbaPZ\xda\xa8\x99\x9ft\xa1MxN\xd8\x00E\xaa:\x0b\x06\x1al!0\xaa\xbb\xf8\x96XT(!\xd9OS\xc9\n\xa5\xca\x85'((\x8aXMSi	<\xa0\x85\xbc\xfa\"\xe3\xb9Z2\x82]\x1f6 \xf3Q\x02'P\x91\xb1\xaapF0\\\x91c\x00.\x80\x9cY5\x14\x19i\x92\xca ,F\xe6\x84\xcd\x14\x0b\xb2\xd0\n1\xd0\x9d\x8a\xd9\xa3|\x96#\n\xb1	H\xe4w\xfaDM[\xd0\x1a\xb0U\x89$\x90\xa0*\xc5\x055\x90#*&\x00\xed\x8ahS@\x05\xae\xc0\x04\x9ap\x04\xe6\xda\x83\xa8\xc0	\x93\xc2\x96\xfe\n1\xf0\x88	\x02\xab\xa1p\xeaC\x87I\xa1\xea\xf8<\x9eJ?\xe7\xa0\xa6\xb3t\xb1\x10\x81\x8f\x08\xa6\x15\x97\xa3EA\x10\x87z\x94\x0c${a\xde\x92\\\x897\xa8\x8a\x00c\xee\xfe\x11C/k\x12\xa6\x12y\xb84\"\x9f\x85\x07L\xc0h[\xd0\x039\xab\xb3\x06\x89\xae\xe4\xe3O\n\x01|\x94\xc1\xa9Ek`b\xcaq>\x08\x9c\x1c\x19\x1c\xb7\xfa\xb4\x0e\x19\x00\xc5\xf6\xa1E\xf1 \x03+\x00\x94u\x17\x92\x84\xb5\x1d\x81\x84X\x15\xa3\x1a\xd6s\xcbE\xb6E\xca\x07M\xe9\x1e\x045\x90\x9cGHj\x03Yl\x1b\x11f\x0b\xb7y0\x90q\xda\x1e\x8ar\xb7\x07\xfa\x0e\xaf\xa8\xa7\x04\xea\x90W\xf5\x14\xab\x80\x98\x80+\x11\xa6\xe2v\xaa\xa2\x05ry\xb2\x87\xfbD\xaf\xb9\xb8S\xca\x9c\x90\x84e\x93\x99\x0e\xe2\xc3\x0e\xfbi\xb9\x8c\x1b\xb5<\x94\xb8\x89\xc9i\xc2\x04\xba\x14\xd1\xb8\x88\"\x82|P\xba\x03\xb06\xa8\x9b\xba\x17qs\x19\xc4\x08%\x1b ^\x1b\xbb\xa1\xab\x10\xc7\xa8\x0e\x8a\xfb}\xf4\xa9\xbb\x8dq\x11\xaf\x93\x05S\x13\xbc\xc2\x0b\"\xc4K\x14\xa4\x9b\xbc\x91\xf5'c\xeb\xbc\xcf\x1b\x0bEp\xb5\xd2+\xbb/\xb5\xa0\xd7\x9b\x7f\xdb\x9b\x15\xa8\x1b\x10\x00!\xf9\x04	\x08\x00\xe8\x00,.\x00\x05\x00L\x00\xaf\x00\x00\x08\xff\x00\xd1	\x1cH\xb0\xa0\xc1\x82\x00\x08$<\xc8\xb0\xa1\xc3\x87\x10\x1f&\x9c81\xa2\xc5\x8b\x18\x1dR\xdc\x98\xb1\xa3\xc7\x88\x1b\x13Ry\x01\xe0\xa3\xc9\x93\x03C\x02\xb8\xe5%\x13\xca\x97\x1eU\xca*\xd3$DI\x988!\xca\\#F\x8a\x84\x9c@\x1b\xee\x8cc\x06\x08\xa1\xa0H	\x0e\x95\x13\xc6H\xd2\xa7K\x99\x16\xba\xf94g\xd4\x9e\x1e\xaa\x02\xbdz&V9\xad8\xb9\xa69W\x01\xec\xcb\xa1\xdf\xbc\xedb\x1aN\x95Y\x94h}\x94!\xcak\xcb\xa3\xb7&\xd1&Z2&\xdc\x9b\\V\x04\xe0\x8d\x19r&\xd1h\xcb\xc0\xb0\xcd\x05dp\xc7\xb8T\x86,\xd65\xc81\xc6\xa5\xd2\xae\xfcX\x1c\x07\x9b\xe5\x8bK\xc1\\\xf1\x91\xc5\xcb\x193q\xb4|\xb6x\x15\x8c	]k\xa5R]\xcd\x90\xab\x86\xd2l\x88v+7\x80\xb6\xd0\xc2\xb9x-\xb6\xf1e.\xdd!tf\xfbN	\\x\xec(\x8b\xdc\xfc\xa5\x8b\xa1\xf7r\x84\xcd9\xd3\x9a5\x9d(+\x07\xd7\xb1o\xff4<\xb9K3\xbft\xbd(\n\xaf4;\xcfY\x8aA\x84I\x1f\xe7\x19{\xe6\xe3\x83O\x8fV\xe9\x0d\xab\x1d\xf3\xd1\x15\xcb\x03\xf7\xa1s\xd5&E\xd0rE\x0bj9w\xc5]\xf7]\xb5\xc3+\x13X\xc2\x85&h\xc4\xe6\xc6%\x05JxE\x15 P\x81\x83qD\x81\xd1\xa1{\xb8LX\x06\n\x1b\x94\x10 ])D\x88\xa2\x0f\x92\x94\xf6\x011]\xf0\x04\xa3\x8c\xf99\xd7\x17,Q\x90\x01\xa2-\xf4\xa9B`x\\\xbd\x82\xca\x15W\xf8\x01	j\xe9mpREp\xa9tZw\xde\x982\x17\x07\x16@9]'	P\xa9\x13E\x8f0\x01\xc1Y!i\xa1!j\xd08\xc3\x86\x15\xa5\xa8\xe9\x9cw\x1a\xf81L\x03\x81l Xm\x14M\x11K\x19V\xa0\xb9\x91\x9c\xe9\xc9\xe2\x89iYH\xa1\x1f}\x84\xf4a\x81\x10Z\xc8\"e{\xbe$'\x882\xae\xb4q\xa5\xa0\x14\x11\xea\xe3v\xb9\xc9\xb7&Q\x80\xd8\x01\xc1\x15\x7fi\xc1\x00~	\x95\x00K_\x8bq\xff:\x91\xa7>\x8e`\x8ek\xa6\xe5\x16e\x00\xc5\x1c\xea]\n\x1300\x00ED\xc0\xa6\xebi\x18T9\xe8\xa8\xa8\x8d\x14F7Np\x86K8\x83\x1c \x8c\x08kB'\x81B\x1dX!m\x12dp\xa0l\xa7\xcc\xce2\x89\"^\x80!\x0d\x89t!\xe1\xe8\x01W\x1e7\xec\x06\xaf\xc4;\xd7\x14J\x84\x01\xc2\xb8\x95&\xa0\xd8\xb1f`!\xc9'\x00~c\x0e\x19\xd2\x15\x8a\x80\xa992\xeaA\x15\x003Uc\xb8\xfc&\xb4E\xc2?BC\x05\x89\xbe\xd2\xa7\xafB/\nh\x00\x91s\xeak\xac\x14\x15/\xe0)\x14\x16\x94\xb2\xdd\x9f\xe1D\xa3\x86\xc7k|7@#:\x16Z\x8d\x18\xa8\xbe\xf1J\x0cXhJT\xca\x9en\x82\x8c\x06f.\x92C\x19\x05\xd3\x9c\x870\xc0,\x9a\xde\x0d\x0d\xd0\xb0\x86W\xdc\xc5:e\xbf\xdc\x12:\xcb\x1f{\xf4\xf0\x0c\x18V\xdc\x10\x06\x80B;\xdc\x07\xb3Lq\x11\x8c,$\x8c\x00\x82)\x0d\x8e\x91\xb2\xb7\xb1\xb0\xdc\x80*K\"\xff\xdc\xd7\x0e\xc5\xd2l\xcd/\x00x\xe91\x03\x95\xf8y\x85'\xcal\xbau	\x903\xd3\x81#ex\x02\x04\xa8!\xb3\xa1\n4~\xd3WKr\x99\xef\xda\xf0	$\xb4\x8dR\xe4\xc9(\xd0\xaf\xbe\x07\xdb\xab\xabh\xe8y\xae\xc7\x01@\x94,\xba\x1c\xdex\xcb\x14\xca[s\x0dr\"p\xe4,m\xda\xb2\xf7a\x83\xedtQ\xb8\n\x19\xe3t\x0ea^V\x02\x1fqw\xc3\xf3dKr.b\xecp.\xa5Db\xec\")OPF\xe3\xc4\xd3l~0\xc4\x88O\xfd\xae\\\x00\xba\x8ct\xe2\xf6>\xaf\xc5\x19\xe4j\xfe\xfdo\xa4\x1eu\xf5bL\xa5\x0dl\xd1\x89\xc3\xbe\xe4\xd7\x07\xc91c	\x1c\xb0\x1f\xfeh\xa6':\x08\x8f>\xd8\x80\x9a\x18xa\x98\xf8\x11P\x00\x02\xe8\x81\x05.\xb6@\xf3M\xa1\x0e+\xe1\xdf6\x8e\xc1\x82?)\xa8\x0dY	\x9f\x1d\xe4\x12\xbb\x0e&/L\xf9\xf2\xe0\x004x\xb1n\x9c\xe1L*\xe4\xc1\xfa\\\x18\x07X\x1cc\x181<\xdf1\xff\x98\xe4\x1f^\xc0D%\n\x89V\x86xH\x97\xaf\xd1\x01	;\xa4N\x01(\x03\xbb\xb0X\x89\x89\x87c\xd8\xfd\x16\xe0\x006\x18\x00[F<\xe2\x15\x1f\xc8\xc3;\x981\x8a\xa4\x1a\x80\x0b8\xc0A1\x8e\x11\x8bR\xbc\x03\xfeZ@\x00\x15tih\x84Y\x08\x12\x19\xc0.8>\xadp\xf8\x03\x86\x1d\x85v\x19\x95\x18\x08\x89\n\xf4cr\xb2f>`\xa4\xef_\x19\xd9#\"\xe1\x98\x9e\x08\xd2\x81\x91N;\x80*\x82\xb3\x85H*D\x92\x13A\xdb\xf4\\\xd8\x8a\x07\x00CQ\xe6s\xdb\x008X\x85\xc7|\xf2\x95\xb0LN\xd0FIJGq#\x90\x0e\xb4\xd1G@9,c\xa1q\x81\xad\xb8\x84\x16\x858\xad5d\x00z\x92L\xe4\xfd\xc8A\xc6V\x14a\x98\x99dKe.H.\x176\xcfc\xae\x08\x054\xb3HHY\xcd/\x06J\xac\x1e]\xaeI\x1fgn\x93Q\x02\xa0\x8b\x1bCr\x89\x1f\xc0\x82d\xe2$gz\xcc9\xc5-\xa6S8V\x99\xdf\xec\xac`\xff\x94\x8e\xb5p\x9c\xff\x94\x03=q\xa9N+\xea\xb3\x0ciAL\xcfh&O\xba\x0c\xd4\x9e\x05\xf5f\xa5p\x03\x85P\x14a\xa1\xf4i(Q\x1eJ\xcc]8\xc2\xa0\xcb*\x91#t\xe71\x8d\n\xf4\x99\xc7 \xa8GAJ\x91\xceij\xa4\xc83)G\x9dF	\xa9\xb04!-\x18\x15I3\x1a\xd0U<\x93\x15\x81T\x01\x1e\xd79\x11PL\xd0\x9a\x01\xe5F\x12\x0c\xc06\xeaXb\x0d\x81\xcag\x01\xa6\xdaK\x1e\x9a\x14b\x10\xe8cF-t\x81\xad\xa4\xc9\xaa\x01\x9d\x0b\x0c\\G\x1f(\xac\x00\x0b\xc9\x92\xaa\xa5\xf8\xc0\xc1\x0e\x9a47V\xc3_*d\xb0\nWx\xd5wA\x80\x95V\xd3s\n\xb2\x1a.\x95P\x18\xdf4oZ)\xf2\x88\xb3\x0c\xd78\xec\x02\xe3\xf3\x93\xbbR\xa4u\xbf\xd4\x054p\x83<\x17f\xe0\x0eAQ	'\xf4\xbaD\x8fA#p\x95\xed\xa0\x9e\x90B\xd5\xd2\xd2\x8afD\x90\x99b\xf17\xda\xcc\x02\xa7\x067\xd0\x81\xd4\xd2\xb3\xd9\xc8\xff\xb2v[\xd6Rk\xbf\x90\xa0\x84\x18\xf8\xb6|\xc7\xb9F`9\xcb\xc3\xb8\xcdK\xb7\\\xdb\x82\x16(\xeb1\xd7L\xc2\x97\x8b\x8d\x17Z\x91\xbb\x11\x7f\xe2O\n\x10\xfb\xab\xc7hA\xd2M\x16B\xa2\xd6\x82e'0\xca\xda\xb6\xdeo\x07\xc7#J_q\x88L^\xaaL{\xf7\x83[g\xcd\x17\x89\x11\x04\xe9\x0dOm\xaf!Ur\xd6\xd5JG\n6\x0c\xed\n\xba\xb1\x1b\x9f\x91M\xbf\x1c9\xe4W\x8b\x0b$\xe2\xf5e\x05\xff\xfb\xc6 TE\xb1<&\x98R\xf33,|\xe9s\xbdg\xb0B\x15r\xba\x04%\x18\xd9$\xa0Z\x07*!\xd9\x99\x18\xa2p\x04\x97z\xf0\x11&@\x82'l`\x8aV(\xd0\x16Yx\x05X&\x19\x8a\xd21\xb1\x06>\xe0K\x1af\xeb\x93\x1d\xeb\xd3Z\xf3\xc9\xc0,)9_\xe14\xb6*H|\x85bp\xc0\\&\xd3E\x08\xcaq\xedZ\x01\x00\x03&\xb4\x0dCd\xf4o\x16	\x80\xe2\x90\x9c\xc3o\x13\xa3\xa5\x98\xeb\x82\x83O\x96\xf9\xff\xa0\xa8\xf1\x86QgVV\xd0\xfa7\x1a\x14!\xadJ4\x01\xb30\xd45\xac\xe7\xc8D\xd3\xee\xc7\x85)\xa0TL\xd4\xc5@\x9f\xb3\x90\x88\x00\x0f/\x15\xd1\x88k*\xb3\x81\x87\x85\xbc\x99\"\x99\x90\xee\x08\x14+\x8b\x1ew!-uSn\"\xe6\x80h-\xcf\xef\xa2\xe8\xe1\x82\x0bN\xbb\xddT0b\xd44\xde\x84\xdc*\x00\xcbKw\xad\x85\xa8p\xf1\xfd\x80t\x05\"\xa8`\x08\xb2\xe0F\x04.l\xea\x89\xfc\x0f`\xe4\xc5\xe23\x1a\xe8\xe6\xa4\xf8N!\x94X\xb2\x95\xa9\x97\x08\x10\xbd\xd2\xd6\x00@%pg[FY\x98\x05\x83\x1b\xf1\x00<i\x06.\xdb2*\xaaPV\xc9p\xa3h\x0503\xd1<\x14\xd2\xcaF\x0cXeF\x8d(\xb4\x1e\xf4\xb6\xbcQ\xe4`\x0e\xdc\x9b\x892\xe8\x82\x8e\xd3\xbd\x91i\x84\x99\xce+(\xc0\xbf\x8b\x1b\x8eX\x10\x9ck\xccX7\xb2\xa9 l^\xe9\x9a\xb5\xd2\xc92Q\xe7w\xda!\x83\xa0Z\x96\xba\xb8\xf9P\xc1L3h\x1c\xbc\xcb\xff\xc5\x12\x16^\xc18L\x1f\x9cf\x9a@\xe5\xc9\xa9\xa9\x07j\xc0\xb7\x06G{\xf6\xc2;\xf8\x84W\xf5O\xcf!Ae\xa4`\xa1\x04=(\x98\";_ \xd045s\x04[\xac\xd7\xb3.\x80@\xf6\xbc\xe68\xc4\x00=M\xb7\xf0+E\xa1\\:\x8a\x07\xe9U\x87\x81a\xb2\xee\xca#gBn\x07QIz\x8b\xbb\x88z\x91\xdd G\xa6\x92$\xf9\x04\xcb\xa4\xdf\x16xo\xff\xba>w\x19\x12\xbb\xdb3\x19\xa6\xc8;\x86C\xa2\xf5\x84\xf8]\x88\xc9\xe1\xfb\xb3}Q\xf8\x01\x1c\xbe\xb9\xd7n\xfc\x03(Q\x82\xb2\x83]\xcd\xe9)BJ\x14\xbf\x11\x1dP\xcd\x93\x1by<}4/\xf9\xf0&\x83\x07Zx\xc4\x89A\x12\x92\xa55\x95>\x9f\xe0|H: \xb9k\xa8\n4\x8b\x0f\xdd\xfd: \xf9\x1a\xa0\xa2\x02!\xf883\xcb\xc2\x9a\xb8\xff\xab\x83\xad\x94|,\xbe\xf1\x89U\x0c.\x15g\x08\xcc\xdb\x17?Vs\x87C\xd8\xca\x0f\xd8\xa7av\x85L4J\"\xef\x9a\xdf\xff\x14\xb4k>n8\xfd\x06\xd2\x16\xda+J\x91upS$\x1b\xda\xee\xe0*\xa8\x19\x8a\xcc\x95\xc6\xac\x1a	\x89%\xac\x80\xc9\x05Z\x82\xe6P\x90lb\xa0\x03\xb4\x96v\xb14\x01\xa8\xf0\n\xfd\xc7Z4Wm\x1b\xe6\x0530\x0d'&I\xe37\n\xd9\x85o\xd4!Q\"0n\x8bq\x05\x83\x03J8\xe3Q\xf1\x87?n\xb3q\x03\x90\x02\x17\x07\x06\xac@	\xc1Ri-\x98\x10F\xd0>k\xd1\x00\x1c\xb8k\x84%T\xfc\xe3\x03\xdb\x01	L`\x05\x90\xa0\x03\xba\xa3WwDJ\x84U\x02\xb5#-\xc7\xb7|q\xe6W\x1a\xf0z\xe5DX\x02\xa0C\xc4E~S\x88,kf\x07P\xc8B\x98\x87F\xb6\xf0A\xc5$ZYX7U\xf7\x17\xc1\xf7>.4pE\xd87p\x14\x0b\x9f3\x07\xaf\xe1BTPl\xb3\x83	;\xb5ZY\xf0 \x87\x90\x10uxp\xbc\xe3X\x13\x91	{Hh\x1f\xb4:\xc3#\x86\xd2\x11{r\x98\x10\x98\xb0r{\xa50\x14\xff\xc05\x0dC\x1f\xe7a8\x14\x80m-\xf0T\x18\xe8}[v|Q\xb2I\"\xf3p\x13qV\xa8\xc2}\x0db\x05$\xb1`Ss\x00\x89\x95<F6?\xe5\xd0\x08\n\x88\x02X\xd0\n(\x90\x04>1\x15*\xc1\x89\xbd\xe0\n\xc3\xd2W\x0e\xf5\x16{d\x13kut\x1c\x070\\\x02\x00\xa4\xf0:i\xe5\x18	F\x15\x8b\xa7b/4+\x19X \xc4X)\xb9\x963F3\x11P\x92|\xd4\xa8nC6\x17\xaa\xd2R\xa9\x91\x0d\xd48u!q\x8d\xc1A\x03\xfa$d\x83u\"\x1b\x81\x8e\x94\xe1~\x19\x14\x0e_\xc0\x07\xe5X\x8d|\xf0\x04\xbbB\x112\x13\n\xf7\x88\x8f\xfa(1\xf28\x00\x9b\xd0$\xff\x98\x8bB\x03r\x83\xc2x\xf7\x18w\xfa\x11\x8e)v\x90\x07\x980\x9a\x17w_\xd1\x90\x08i\x1e_'\x91\xe1\xa6\x18\xe8\xf6\x8f\x06\xb8\x11E\xc0\x13\x03\x04\x92!YM\x7f`\x92t'\x8e\xb0\xa0\x92+9+\x03\xe2\x92'9+K \x933\x99\x10^\x14\xb0\x026	w\x85\xb1\x93<\xb9\x11\x88\xe8\x93?iio\x11\x10\x00!\xf9\x04	\x08\x00t\x00,Y\x00\x03\x00\n\x00\xb2\x00\x00\x08\xff\x00\xe9\x08\x1cH\x07\x00\xc1\x83\x00\xfa\x1c\x1c\x98\x90`\xc3\x84\x16\x08~\xf8@\xc7\xc5\x0c\x06\x12=T\xac\xb1!\xe3F\x0e\x1e\xe1q\xf4xc\xe4\xc0\x89t\xa4\x99\x14\x88R%\xc8\x93\x14\xa5\x9d{\xc92\xe6L\x8f2i\xd2iy\x13f\xca\x9e5\xe9\xe8\x00\xba\xd3\x83\x84\x1bD\x8d~\xc8\x91\xd4(S\x9dF\xe1\x91i*\x95j\x1b\xabX\xa1z\xa8\xaa\x95kF\xaf0\xc1\xd6t\xc1\xa6)\x8b\xb2ZU\xa0\xcd\xa8\xb6)\x8a\xb50\xdf\x9a\xbd\xda1\xae\x9b6m\xbez\xd9\x9b\xf1F\x98\xbf\x19\xa5\xed\xf5\x12\xf8o\x18\x89(\xce\x02>	O\x87a\x89\"\xcf\xf0e\xecc0d\xc1\x93Y\xc23\xf7\x98\xb1\xdf2e.oY\\3\x07\x10\x1e>\x1c2p\xb1\xe3\x86\x8e\xbe\xads\xc0v\xad\x9aE\xec\xda\xb7\x19\x12\x80\xc7\xa3\x05&\x847\x92lp\x01\\x\x81\xe2{\xf4\x00\xcfB\x88\xc0\xf2\x0f\xce\x1d\xfa}\x14\x9d!\x0f\xe3\x07?H\xc3.\xd0\xa0v\xee']V\xffg)\x0d\xde\x06\xe5\x12\xb7?P\x98>I\x8a\x12\xd9\xcb\xbb0\x98^\x0d\xd1\x9d\x82\x9b\xa0p\x88\xe2\x86\xf9\x01\xf1\x15\x81N\x0f\xf1E\x12\x869\xf1A\xf1\xc4k\xed\x99\x13C|I\xf8\xf0\x02\x84\x12RxC|N\x10!M|q\xac\x81`}k\x04\xc1a\x17\x9ep\x98C\x0d\x1cV\xc2`x{\xed0\xa2&\x05z\xe1bz!`\xb1\"y\x18 \x91I|\x11`\xb0\x00\x84~\x8c\x07@\x7f7\xf41\x1e~L\xa9\x10\x1f<0\\\x10\x9f\x15\x07<\x00\\\x93\x13\xf0\x88Rzsp\xe1@v4\xdcq\xc1q\x12\xd10\xc5y\x08\xad\x90\x05'6,\xa4\x96\x01u\x114_\x08\xb2\x1d\x04\x8f+\x89-$\x15\x05\xc4\xc9iF%!\xd8\xb9\x849<\xa8\x96Bn\xdd1\xa0\x02\x11\xe7\x0c\xf1\x15\x18W\\\xb1\xa8U78\xc1\x96\x1c5\x98\xc1\xd6\x0ca\x98\xc0\xd685H\xc1\x16\x16\"\x881i\x15\xe2L\xaa\xc5+\xa6:\x1aW\x13\x1c<\xc2\x16\n\xa7\xcc\xff\xa2\x9a\x05\x87T\xb1\xc5BF}Q\x01C,\x85b\x1e\xafE5@\x01\xb0\x03$\xa0\xc7\x91\x05-\xa0\x80\xb2\xf4\x15jd\x00\xcd&k\x88\xb2\x08U \x88\x04\xc7\xce\n\x01\xb5\x05\xd1\x91\x02&1\xbc\xc0\xecN\xde\x9eb\xc4!\xcb\x16\xdb\x81\xb7C|Y\x88\xba\xeb\xf2f\x83\x109\x0c@\xc2\x97\x15\x01\xaa\x83\n\x13\xa1\xb4\xd9\x11:pt\xafF,\xd4`NIJQ\x94B\x14D(q\x91R\x15q\xe1\x1a\x15	W\xb4\x04\x08\xfe\xf5\x8b\x02\x00\x9b\x0d\xe1B\x91Q] \xd5\x0c\xe7\x087\x91y#Sa\xf2V\x1f\x8c\xcc\x04\x0e\x10\xa1\x0c\x07.2$\x12\xf3Vc\x88Q\xf3\xcdR\xa5\xb1s\xc8=_T\x14\xcaO\xf8p\xc4\x0fE\xf1\xcb\xe4\x0c3\x9c\x90t\xcb7l\xc1\x89\x06\xd2F\x00\x8f\n<X\xcbRB\xf0$\xb1sw\x02\xf1;B!\xe5\x80\x1d\xec\xb5\xd9)\xd0\xcb\x12\xb8\x1a\xe0\x84\x15m\xcf\xe1d\xdar\xc7-%\xddwK\xd4	\x9b\xb8J)Ck\xdf\x7f[\xb9PJ\xad\x0e.\x0d\x10\xa4\x18.M\x15\x8a\x7f(\xe7\xe1\x83oV\xa2\x9d\xe68\xee\xd0i\x83\x17\xf4\xe0\xe0\x0d\x05\x04\x00!\xf9\x04	\x08\x00\xe8\x00,B\x00\x05\x00L\x00\xaf\x00\x00\x08\xff\x00\xd1	\x1cH\xb0\xa0\xc1\x83\x08\x13\xa2\x03\xc0\x10\x80\xc2\x87\x10#J\x84x\xa0\xa1\xc5\x89\x183j\\H\xc1b\xc3\x8d C\x1a\x04\xf0\x82\x8aG\x86\"S\x86\xcc\xe4\xe5\xd6I\x87*cJ\x04\x10\xa2I\x19Y/e\xea|(A\x8a\x985\xddr\xee\x1cZ\x90\x10\x103q\xd8X\x11J\xb4\xa9\x910r\x92.=\xd9\x94(\xc32Qw\xc5\xc1I\xb5\xeaN\x0f\xac~\xf2\n\x17\xee\x15S\xaf*\xcb\xc5:#\x96\xac\xd9\xaehSV8\x97f\xec\x18\xa4e\xcf\xc6\x05\xa9\x8alTo\xdf\xda\xe4\x85\xbbw\xe3\xa3-v\xcb\xf8\xb0%\xf8\xad\xc7\xc2 \x05X\xc9\xf5\x86\xec\x92D\x81\x07?\x86\xac\xf1(e9`\x96E\xc3\xcbu3\xe7\x89\x83t\xf9\x953\x84\x8a\x8f\xca\xb9J_<=\x11[\xd2\xdb?\xaeH\xcb*\xfb#m\x89Zn{\xc9\xb2\x18\x0c\xec\xde(\x7f\xf3\x1cq\x17\xaf	\xe3P\xc7\"\x87\xa9\x1c\xe1\x80r\xdd\xd4\xdc\xce\xa2\xc1\x0dl\xe9z\xab\x0f\xffd8$\xf1M\x1bk\xd8\xa8\x07OX\xfcx\x0c\xd1o/\x8a\"X+{\xd3\xee\xc7\x87\x15>\x8b\x16\xde\xf4\xf7\xcd\x96\xdfx\xc3	\xd7L\x17\x08\xe2\x02`l\xe1\xb9\xf7\x8cpI\x81P\x06\x18\xb3\xa8v\\\x83\xd5=\xb0\xd6za\xec\x10V%\xa3e\xc5`{\x0e^a!h-\\AK\x11\x9b|\x16\xa0o\x03\xa2s\x89w\xb7i\xc2\x85%\x13\xbc\xb2\xc3j\x9a	8 t5R\x01B\x15W\xech\xdf\x88\xf89H\x06\x84%l\x80\xc2MF^Hbu+4\x97T\x17\xc4|\x80\xa0$\xaf\x89\x18\xd4\x94\xbf=\xd0\x97p\xb6\xf4DF\x14\xb0\xc4\xf7br\"\xc1h\xd8\x89\x95A\xe2\x87\x89\xa8\xbcr\xe4\x9a\xd4E\xc6\xc4#\x15\x10\xe0\xa6B\x16u\x02\xa1\x19\x16pP\x97)\xde(\xf8_Gz$\xa9\xd1d\xb1L\x81\x87\x8fEm\x10H\x03\xc3\xf8\xa1A*p\xc6\xa1E)\x939\x03\x8d\x8b\xeai\xb1\x00\x98\x18\x89\xe8\x8a2\x82\xd0\xe1*\x9b\x02m\xff \x8b\x16B\x14J\x08\x8d\x10\xfa\x84\x94'\xb2\xb0E\xe6\xa9\x8ejt\xe7\x18\xb04) \x03\xc1\xbdq\x05\x04v\x00\xa2\xdd\xa0\x12\xb2Q\x85\x7fj\xeeb*\xaa3\xd5u'\x11}z\x94B\x15\xb0yRL\x00\x05\xfcg \x18\xe60\xe7\xaby\xc0R\xaa\x91\xa1I\xac\xebV\x07\x8dJ\x00\x00}G\x8a LE<\xa6\xe7Dv0P\xe1\x85\xb9\x82]\x1b,F\x12*1\x85\x88\xf7\x95\xf7\xdd\x19\x15\xf5\x81D\xbfeHc\x9c\"\x93T\xc8a\xc1\xed\xfe\xf9.\x19\xa1evd\x15\x1e\x18:\xe8\x1e\xcdb\xc1\xb0w\xe6\x04\xb6\xc3'\x92\xa8l\x1e\x18	\xf8b\xf3\xcd)\xe9\xaa\xcb2\xd5\x96\x99,\x84\x0e\xf8Y\xad`\x9e\x8c\x0cM\x9aVn\xd11\xac\x1b%\x1d\x83\x9dg\x98X\x0d\xd4L\x0ep\xc7~\x83\x86\x18)-\xa5X\x00\x85\x8b\x06\xbb\x9b\x91\x95\xc4R\xb2\x16\x0d\x0d\xdc@pR\xc0\xec\x0b$\x99;\xe6\xb0\x08\x08{\"\xd3\"la{<vR\xde\x98\xff\x02\xc2\x08$\xe0\xc4\x05Vk\xf7QG!\xbdl\\c\x187\x84\xda\x03\xca\x1a\x8b\x91\xb7\xcd)5\xa7\x8c'&NQ	\x03\xc3\n\xc7\x905+'ED\x94 \xa3\xa2J\x03\xcd@\xb1\xd6T\x07O\x94,	',\x89\xa5\"h$\x064\x1d\xb5PL\x064c\xd6\x07\x95\x14\xb4\x00Q\xb4#\x1d0SN	\xc6\xe6\xec\x97\x15\x89zq\x808\x83\xde\x06DE\xba\x0e\n\xc6\xdb\x0b\xae\x91n\x18\xf6\xdel|	)=\xb2\xdd8d\xac\xf2\x0b\xd5\x83\xda`x\xf5\xd1?k}\"\x84\xfay3\xe5mf\x15I)\xb9\x0c\xb2G\x82\xd1\x1b\xcbX\xf6\xed\x83\x16\x1b0\x85\xad\x88\x98l\x19v\xe2\x82\xd0\x028\x81,\xc9.\x80\x0c,C\x03\x0bH\x91\x08	F\x1bw@\x1c\x03\xc7\xf50\x08BkrV\x0b_c\xc6\xd2'\xdblp\x03\xa1\xf3 \x8d8\xb0\x84\xef\x19\xcej\x04\x10!\x18\xfc\x13\x0b\x16\x1cc\x1b\xf2\x12\xce\x97\x16\xa6\xc2\xe8)\xedq\x02\x08\xa2\x10\xffU\x02\x813d\xe7\x87\x03\xd0F\x07o\xa3\x04\xab\x81\xab\x87\x83\xf2A\xb3046\x81maYy\x18\x1a\x133\x95&(B\x88\x07yxa\xebRe\x8b\x90\x15\xa0\\w\x12\x0e\x12\\\xa51/\xde\xc6	\x04\xa4`DHh\x80\x01.\x00\x82\xc6H\x99\x1bo'\xc79F\x8d\x03.\xb0\x9a\xed\xf8\xd8\x96=JP~bkZ\x84T@\x80\x16\x0c\xd2s\x19L\xa3\x1b#\xf0\x92<\x85\xc4r\x8c4\xd1\x06\xb3hH\x8e\xe5\x91*\x89\x84H\x82,\x05\x0cMFo\x16\x19D\x86\xe2&YI\x8e \x92i\x0fAL.N7\x87\xc1\xb5\x0f\x95\x040a'\xc7\x90\x880V\xb2\x8f\x03y\xa2\xd2\xf0`\xcbS\xb6\xad\x15\x85\xdc\xe3\x0e\xe6\xf0K`\xc6\xca\x0cY\xd0\x85\xabL9(nX\x0d\x87\xbbt\x03\x16\x90\xf1\xaaW:\xf3\x99\xeaaf4\xdbg\x00;\\\x02\x99\xd9\x94&\x0c\xeb\xe5\xcd\xc8\xdc\xc6Uk{\xa7\x1d\x8a\x80N\x08\x91\xc3J\x1el&,S\xc5\xff6N\x92s\x9e\xaeX\"\xf9z\x08B\xbd\xed-\x0eALa?\xe9\x19\xbd\x81z\xd0	1\x88\xd8:\xf7),:*\x14\xa1\x00\x15\xe8*\x17d\x0bX\xfc\xe0\x12\x12\x1d\xe3D\xf0r\x95\x0d2tP\x0em\x9f\xb8\x80\xc0:r\x85P&\xd1)\xe9?Oj\xcf\x8d\xfe1\x1a\xd7;\xe4Du\xe2\x08\xad\x04c\x18\x17U\xc0\x1dh*\x9c\x94\x92\xa9\x08\xa1\xf8\x1a4\x1f0?\x9c\xc5\xa4\x88\xbb\x08cP\x85A\xd4\xdb\x18\xf56Vp\xc4\x86\xe8\xf8\xcd\x84\xdcJ\x0e\x94\x00\xaaI\xebY\xd4U\xca\xa2\xa7\xbe#C\xf7D\xcaOF^\x94\x15\x04\xa8jR\xae*\x1d\x00\xb6\x80\x8a\x8fZ\x03\x8e.\x1a\xd5$p\x03\x80\xe1\xa0k\xfb@\x81\xd7\x8c\\\xe0F\x9c\xc2#\x19\x9eXS(2`\xa2\x11\xf8\n\x16V\xa0\xd4\x00\xc2@\xa1\x82\xa5Xa3\xe2\x8aU\xc8 \xb1\x01\xa4\x01fm\xca\xb0av\x93\x0e_\xc9@\x19T\xb7\xcbShqPAh*\xfddb/\xd0\xff\xe0\xd3\x8d\xd7 \x1c\x04\xa7cI\xb9\xa8\xf6\x91P\xcc\xc2\xa8Th\x8e\xcd\x1a\x16\x85\xd9$\xc2p=\xc8	\xe3\"\x0c\xb9\xbb\x8c\x06\x11$Y\xda3Z\xf7\x8c:\xb1Hw\x80\xdbCNt\xaaF7\xa8\x01P\xbc\xd9\xdb\x90 \x92\x04\xb8\xa2n\xfb\xa0p\x0d\x87E\xef\x121\x88\x81\x12\xd6([\xab\xc8\xecJ\xa4\n\xe0\xce\x9c\x91S\x1fj\xc1\xb4\xf5\x1d\n\xe2z\xc7<\xdd\x06\xb0\n\xec\x83\xa0\xb8 \x1b\xca\x8f\xb96)6 ]\xfb\xb60\x89\xfcN\xb8\x13\xfa\x94_Lva\x89\xcaD\x01p\xf14P\xaft\x07\xa1\x82\xb2\xb3\xc1\x12\x89\xc2\x0c\xdf`\x82nt\xa3J6\xedF\x82\x97\xe8\x9d\x1a\x9e\x16%'\xee*\x81*`\xaa\xc0h\x03\xc6\x0c\x93E\xb1^\xfb>\xa1\x90\xd7\xa0\x06d\x90)gA\x89\x19AS\x15\xacx\x86\x05\xfe\xd7\xc3\xe9\x8c\xc4\xb9\x07yE\x16\xa8<\x96V\x98\xc2\x06\x9e@\x82	\x1e\x815\xd2\x1e\x01_\xd5X+\x92i;\xe3\xba,\xc1\xff\x07\xe2\xad\x9d\xb6.J\x82P\xb0%h:6lz\xf9J\xda0ls`\x87\x08\xe9K\xd1\x02\x00!\x90\xd6\x8dY\xc0\x01\x1af\xc8\x01!\xa2\xd8\xb7\x00\xc0^6mD\x19&\xc0\x00\xcb\xef\xf2\x13\x0e~\x96M\xd0\xc4,*\xe7`py\x9f*\xbf\x10\x11\x94\x08\xf8\x1a\x14(\x9a\x17\x1a\x06W\xe5\x95\xf4,\xe6\x84w\x90	\xba,q\n\xab\x88N,4!h\x8a\xd2\xf6s\xb2N\xab\x19h\x10\x0d\xd0\x0e*(\xe3\\C,0@^B[\xe4\x8a\xba\xfe\x86\xb4\xeb<\xe2d\xdaG]\xf5\xc9\xc4\xd2|M\xc4\x93\x8c`\x13\xa7P_\"\x18\x91\n\xf4M\xd8\x05\xc5\xecB\x11\x1c\x0dC\xafx\x93\x1b\xb2\x18\x82\n\x88\xa0\xa2.\xde6z\xa8\xe8\x17\xb3\xdb\xf9\xeaW>H\xce\xc2\xf6 5\xd5\x83\xc1<\x1b\xd0\"$So''\xfbX\xb6\xc6\xe4\xac|\xde\xa3\x140\x1d\x92\xa5\x848\x80\xf1\xe2n\x99(\xfe.\xb3\xf0\x0f\x8a\x9a\x98\x8cB\xa1\xc0n\xa7zE\x16e\xff\x069t}\xc8'f0u\xcd\x0f\xef\x82\x0c\".\x1c\x1c\x98\x0c\xb0x\x8a\xcbZD@\xe3\x00\xda\x1c\xc8)\x9c\x06\xc7Q\xb3%\"G\xcfV\xdc\xa0\x02\x979\x04\x05\x97\x1b\x9c'H!G\xbe\xbd\x18\xd2h\xf5K\x0brr\xb5U\xea\"\x05M\xb8Q\xdb\x81\xd2\xf2\xdb\xae\x87\x08o&\xa3)\x91\x96\xd6\x13\xdch\xf3\x97\xc4y]\xd4\xc8\xf1l\xb3{\x17o\xc4\x80\xed9\xd1\x83\xc2\xba\x91\xac\x89O\xaa\xa9[\xd7\xca\xdd\xbd\xd8\xf6\xcdXw\x04C\xb8\x82\x02\x87\x0eu1\xc8\xe2\xb2\x84\xd7\xfaxR\xb4\x05Q03\xc0t7K	\xf0>\xa5q\x83\x1d\xb2\x02^<\x008\x7fb\xeb\xc8\xf6U\xb1j\xa5a\xfd&\xd3\x1e\x16\xfe\xd1\xa6\x97<\xc2^e`\x0f\xbe\x1e\xe6\xb1\x1f\xba\x18\x07\x0e\xc1\xdbs\xdb\xab\x86\x00\x04\xbf\xdf5\x90\"\xd0\\\x0e\xbe\x1f\xb5B\x90\xf7r\xc0\xa7\xc4\xf8\x87\xae\x91KaO\x10B\xdc@\x07\x08`<A>q\xef\xf6\xc9\xcd\x99\xd7\xd1\x02\xff\x18\x9b\xcf\xf8\x0e|w\x93\x0e\x8f\x95\x16\xaeQ\x01\xa7w\x0b\xf3\x1aa\xac\xc0	qz\xa2\x93#\x01\x7f\xab\x00*j@I\x1d\x0f\xe0\xafPtir\xc4\x10\x93\x91\n\x19`\x0d\xab\xc0}\xcbV\x7f\x1b\x01\x80=$)\x85\xc5\x10\x99\xa0I\xb1\xa02&\x82Ey\x96kP$\x05\xd9Pr\x02\xc0\x13\xa0baXp\x03\x1e\x18\x12\x1d\xb6\x81V\x80#\xd8BY C0\xa1\xb0o\xd4g\x1d$vl\xaf\x80\n\x13\xb4SW\xa6\x03\xd6v\x05$\xa7}\x0b\x01p\x1eT\x05\xa3\x90\x06\x10\xa8z\x0b1\x0d300+S\x84O\x97vUv\x08Z\xd1\x08\xdb\xe6\x11\xd6\xc0{Z\xb1q\xa0\xa7\x12]\xa4Beb\x1f\\`\x04\xa4t\x12\x94\x90r\x1c\x92\x02\xb2'\x12[\xa8B\x16`.c`\x05:\x00	V\xb0'\xc0\xd3%)\xc4HOG\x10\x0e\xa8\x86\xf1\x04\x07\xe9\xd1_\xd6\x12:@`,\xe9WA\x8e\xb5\x87\xdc\xa58\xe3Wr\xb4u~G7\x05K\xffwq\x8f$E>X\x10\xe6vB{\xe6E\xde\xb0L\xcd&\x13\x02\x13q&\xc0L\xb5\xb0U\xc7\xc7\x83\x82\x88H;\xd1f\x01\xc7:\x93`\x85=w&\x98\xd0kC\xc1}\xe9\xd5<3#|6\xe3\x88\x0f\x14\x84\x9f\x17\x83D\xb71\x07\"IM\x05\x02\xd5\x16@_\x80\x05\xaf8\x89\x07QK\xbes:\"xy\x0d\xc1\x12\x90h	w5E\xedV\x15\xe8\xc3~o\xb7\x1d\xd9wZ/ r\x80Qw\x13\xc2pYX\x15du\n\xb7\x988\xdaXI\xb2\x92\x04(\xd0\n\x93\x05<\x8dP\x0e\xf0\x87v\xc9F\n\x0d\xa1\x84\xf2\x04z\x1e\x11\x02\x17\x10h\x7f\x87{Oe%\xef\x97jd\x91fp\x01\x13\x83x\x8a\xf9\x08\x00wC#68\x8f1\x92\x0d\xc9\xe2\x11?\x93\x06\x0f\x89\x8312\x1e&r\x19\x16!Z\x9fq\x91\xbc\x08\x19|\xf0\x05\xe1\x10\x0d\xec\x86V\xde\x01\x92\x00\xf9\x1bvf\x92\xec\xc6?O\xc0\x07w\xf8jE\x02Y\x91\xe0+1a9\x93\xf4\xf8\x8f\x16\xa1\x84\xdePN	y\x1a\xe6\xf8\x12?c+!\xc9\x19\xf2H\x15\xd0\xe7\x06Fy\x94\x85A)\x140J\x9b\x98\x91\x11\x01) \x80\x8c\xb4\x11-\xeb\xa6\x93\xa7\xf1\x07K\x82\x85N\x99\x1f]\x84\x95YY\x81dI\x1bK\xc0\x1d\\I\x1bUr\x96\xb4\xd1R+I\x95\x03\xf1	n\xd9\x95a	\x11\x01\x01\x00!\xf9\x04	\x08\x00\xe2\x00,+\x00\x0b\x00w\x00\xa6\x00\x00\x08\xff\x00\xc5	\x1cH\xb0\xa0\xc1\x83\x08\x13*\\\xc8\xd0\xe0\x81>\x00\x1aJ\x9cH\xb1\xa2\xc5\x8b\x03\x01(@\xa0\x11\xa3\xc7\x8f Cf|\x94\xc3\x82\xc6\x88\"S\xaa\\)P\xe3\x87XZbQ8\xc9\xb2\xa6\xcd\x8b\xef\x80\xcc\xd2\xa2\xcb\x0c\x06\x9a7\x83\nuX\xc8\x12\x960g\xd2\xac\xf9\xf6\xb3\xe3\xd0\xa7A\x01`\xb2\xd2\x85\x8d\xd58V%\x00\x85\xcaU\xe4\x9c\x06!H\x18I*G\xcd\x9a7I\xb5:\xed\xca\x16#\x00j\xb5\xa8\x92A{\x95W80\x11\xb6\xb6\xdd+\x11\x83\x8cXn\xce\xf6R\x8a4\x1cV\xbcz\xf9*>\xf8\xa0\x02\x95\xaat\xd1r\x01\x13\x19\xf1\xda\xc5\x983Z\xab\x058r\xd9,G\xc0\xb59\xdc qf\xc5\x0dh\x1c\x85l\xd8\x0c\xd2q\x18\\\x936}\xba\xad\xe3\x9e\x81\xcb\xea>\xe7\xa8\x82	\xd1\xb3/\xd7\xb6m\xcd\x0b\xd6]\x9e\x9bD\x13\xb5bK\xe5\xbc\xc2\x87s\x05\xc0\xedJ\xdd\xba\xb66%\x99f	wp\x94\xd2\x9f\x0e\xff\x88\xb8\xe1\x12L\xddW\xabf\x1a\x12\x0bU7\xd9\xdf\xc3\x8b\xbfC@\x80\x14\xb2\x9e\xcb\xca\x18E\xb9\x8b\xf5\xe7\xb4\xc9\xb7R}\x10\\\xe3\xddq\xb9q\xf1Bh\xe8\x01\x18\x9d\x80\x03\xf6\x90\xca\x16Y\xe4\x86 \x1a\xb2\x08\x11\xcaQ\x0d\x1eg\x19x\x10\xb2\xa4\x11\x13\xac](\x86-\x93\xbc\x03E.\xa3]\x17_\x885\x1d\xc0\xc8\x13\x16\x9a\x18\x89\x81,\xba\xe8!t \xc2\xe8U'\x14\xd6x\xe1#\xab\x94q\x05\x18\xdf\xe4\xd8\xe0\x87\xe2\xd5\x06@\x10\xad\xa1\xe1\xe2\x10/\xac2\x19\x17\xb0L\xd8\xa2\x83=\x0e\x98A#\x9e\x0c\x92\x80/]v\x85C\x85v\x99X\x85%\xd1t\x06\x832P\xe2\xb7c\x80\"Uc\x9c\x17\xaf\xe8\xd2\x08\x04\x0f\x0e\xb5B\x14s\x99(\xa53\xb7d\xc1\x03\n\x1a\xac\x93\x89'wn\x99\x0b\x936q \xa5lH\xa6\"f\x9f7]R\x98\xa0_,\x93\xcd\x14\x8f\x80\x92\x0d\x165\xc4\x99\x1f\xa41\xc2'\x18\x1aL\x90#\xe6\x06\x98\xaa\xff\x84H\x0d\xee\xa4i\xa3!3q\xc0\xcad\xa5\x968g\xac\x1e\x11\xb0\x1ar\x9e\x1d\xe9E,\x97lTfJ\x16P\x12h~gi\x90\xc7\x04S@\xe6\xc3\"\xa6F\xf9(\x8f\x91\xf2\xb4\xe9\x18\x8e*\x05\x85\x14\xb0\x02KP1\xafrp\x12D\x8d\xad\x0bB\x19Bz8\x84\x01\x0el\xd3\x9f,\xda\x0d;\xd8\xaf\xcb\x82D\x00R\x99\x8c \xc5\x7f:^\xd1\x0d7\x1d\xd0\xd9\x123T\xac\x86E\x0cB \xe1G2\x01\x1cC\xc2\x0eN\xdc\x02/\xb4\x9f\xa9kH-l\x1c\xb9\x02\x89r^\xc8m\x8c1\xa9\x82E\x8a\xdep\xac\x9b\x0c)\x10H\x94I0\x0c!\xa7\x16\xb4\x94P\x00\xc5\x85p\xd8\xa1\xad\x81Ia\xc2\x0bA\xd8\xd2\x9a%$s\xccE\xae\xfd~d\x01k`T\xb1\x0e\x92\xc4.yl\x98\x8de\xe4\x18-\xb44Z\x19(_U\\\x80\xb7?#\xb8\xc53\xb0\xf4\xe7\x853\xcd@Ap\xbc\xb2\xecl\xeeE\xb2\x98\x98\x03&.|\xab\xaa\x1c\xb6\xb4\xff\xd2\xdb\xc4\x00|\xa2\xb1\xcbb\xb8\xd2\xee\x1e\xf49W\xb2\x8d\x8f\x11\xa6\x039 \xabm\"-\xe5\xcem\xd1{&Bq\xca,\xaf|\xb11.\xe1b\xc9\x80\x06\xd0(.(Y\x85\x1c\x0eL\x06\xber*	-\xd6Em\xc3+\\\x94}\x15\xe5\n\x7f\x84\xf9\xe4\xbdMe\xd6\xaat\x99\xb0\xc0#a\xbc}\xba\x17\x15\xfcB \x05\xd5J.h\x0d\xd9(\xf5\xca\x9eU|^\xf5\x85Xd\x90\xbbG\xbbc\x87\xf3\x14\xa2|\x927\xb8XHA\x84\x0c\xcdl\xb3\x8b\xd1\x84\x1d\x8f\xe1\xe8v\xd0a\x88+d\x8b\x11\xaen\x1f\xb82\xe8 \xdc\xe4i=\xd0\x1d\xdb\x1eF\xbag$\x12X \x04_\xb2\x844\x0e\xf1\x02.\xc4\xad\x00\xe9@G\xf3\\\x86=\xed\x05\"~Hp\xde\xf1@\xd0\x8a\xd1L/\x11\x8b\xe8_\xed\xe2\x15@\xcbYD_q\x80\x85\x98\xfcg\xbf)H\x10\x84\x17\xa8\xde\xe2N\x87\x15\x8f	c\x00/\xf0\x1c\x0d\xcd\xf6\x0c\xcc\x19\xac\x1b+\x8b\xde\xa4\xff\x00\x182\x0b6\xed#\xacx\x96\x1b\xfa\x96\x08\x16\xd4/\x0c\xcb\x80\x88%\x08\xb8\xc3\xe3$\x8f@6S\xd2\x0eI\x00\x8bI\x1d\xe9\x8b\x95(\xc5*\x00ED\x14	\x10'\x1a<\xc35:\xb8%i\x19\xc2	z\xabb\x0d\xc3\x06\x01#$I\x8ej\xf0\x00\xe6\x96\x10\x0dh\xa4H\nVX\x01\x03F\x11\x05\x0d\xe2\xce\x84\x15)\xc6\x13\xc97\x02\xb2\xad\xe3\x18\x15C\x85\xb6\xf0\x08\xc5\xb0i\x8a\x82C\x9a\x05\x19\xb2`\x89\x19\x98\xe3	\xacH\x11\x9f\x8e\xf1	U\x84\xaer7	\x86\xde\xb0\xf0\nKl\xc0a\x88 S#\x8c\x87\xc7\x178\x80Lw\xa0A\xebN\xe7J2\xdaO6\xb7\x80\x85 \xff`\x80M\x10\xd0\n6L\xa5\xe9\xc6@\x85/]\xc3\x89o\xd8\x82\"\xc6\xc3\x80\xeeP\x12A\x8c\xe0C0\xe2\x87\x89]rJ\x1bit\x0d\x85j0\x01e\xd0J\x92|C\xe6\x19-2\xb6\xf6\x15\x02\x9d\xfd\x81\xc40\xe2G\x08Z\xcaq	\xe5\x14\x9b.\xaf\xf9\xff\xbf\xfb\x01\x11v\xa9\x80D\x12\xc5\x80	\xb5\x1c1Xd\xbb\x82#\xf0\xa3\x8b$\x18\xf1]\x07\xc2#\x0d\xf2I\x1f\xb9\x90\x90\x9f\xbfC\x10\x14fr,\n0\x83g\xca\xc4M, \xb1%-\xc4\x92>v\xa0\xa2D\x89!\xb7\xa7\xd9\x0e\xa3e\xc3\xc6\x06\xc6\xe0\x8d1!2\x919hM#s3\xd1d\xe1R\x87\x18\x9d\x01\xe2\xe69\x02L\xe2q\x87Z\xd0\xc6\"h\xfa\x90\x9b&\xf2*\x1a\x08\x94*\xaa\xe1Bz)\x8f}\x18-(\xe2D\xc0B\x1d\x1d\x15\xa9*,\x1eJ\x0f\xea4\xa8&\x85JT\x91\x98\xfc\x1aG\xc4\x1d*\xa1\xa5.\xfd\xaa\\\xc9b8\x9c\x8dU(3\xd5\x8d-F\x81\x8c`\x1c\x85iu{\xe9\xe9\xde\xaa\x87\x1bz`os\xc5\xa3\xdfd\xc1\xb6u\xe2$=\xb2\xd0A\x12\xba\xf3\x85\x96\x1am\xae`Pk\xd8\xc0\x95\xd8\xafn\xc1\x1a\x16\xf8\xc1I\x1f0\x94\xbc\xae\xea\x1b*\xc8\xa3ac\x80X9\xaaSlY\xec\xecQQ\xd1Tv\xff\x91\xd5i\xc6C-Sn\x18\x88\x19x\xb5\x8aVX\x9d\xd8x\"\xdb\xa3\xaa\xe2\x8a\xb6}\xca)\xb4\x85\x05\x15\x98\x94\x9aq\xc4\xe3k\xa7\xc5\xd9\xe2*\xb6\xa5N\xb5\xc8r\x13\xe4\x08?\xb2t\x9b\x8e\xfa\xea,\xdcU]\xeb\x82\x95G\xb7\x05\xc9v\xe9\x92\x88\\\x98\x04\x0f\x10\x99$%\xff6\xadBx\xcd\xbc4DXm\xa1\xf2\x89\xf6\xadLO\xf4\xa4\x80Q95ZBL\xc2\x9b\xf8\xdd\xd2*\xb0\x9b^\x84\n\x06\x1a\x9a|o\x1d\xea\x99\xd8\xec	7\x02\x91K\xf0\x0e\x0d\x87\xcb\xec&\xb23\xd8\x14\x1b\x04\xaew\xd4)\xd8\xd6\x02-#\xb1\x86\xb7\xb4\x0dc\xb8\x98N\xcb\xf3\x17	\\$a \xb4\xef\xab (l\xd8\x02\xbb\xe2\xd3\x8d\xf7\xc5\x0f\xd2\xb1c\x112\x01\x12\xbb\xb1gm\xad\xe2\x02\x08\xe4\xb7\x11\xf6\xd8\xc7\xb8\x02\xca\xba\xa6L\x00\xaf\x18O\xc2>\x93\xeb\x92\xe3\xd7b\xac\xbaU\x07I6n_\xa9\xd9\x92\xe5\x0dY!\xc8\xb8\x81\xc9\xa8\x99e\x1c3\xd9\xff\xb4U\x9c\x06\x98g(\xe6\xda\n\xb9\xc1\x15\xc9)z\xb0<`\x13m\x99\x00u\x05\xde`\xe7,[\x07\x0e\x95#S\x0e\x8a\x9e\xad(\xb76\xcf\xf7\xcd^\xa6\xa1\x9c\xa3[\xc5$E\xa1\x06-\x00\\\xa2\x85b\x03\xeb\xf1\xf9\xb7\x1b\xaco\x93\x05-\xa8I\x87\x99S\xad8\xc54l\x90\x0e\xd5\xe1\xd9-\xd8\x88\xcc4\xc7\xb3L7\xd3g\xd4r4u\xa5\x91c\xb0h\x8c\x00\x04.\xb8\x02\xb9\xc8<\x9d\xf6\xe2\x0f\x97\xb5>*\x90q\x1dgBW\xfa\x0bo\x12\x02\xd9\xa6w\xe6`!y4*\xa4u\x9f\xd7|Cf\x7f\x99\xd2CZ\x04:|H\xd3\x07V\xdb-\x8bF\x9e\xb6A=\xd8Qz[\xd2\xce\xe6\xe5\x08\xb6\x01\xc4\x92\x99\xdb\xc3\x18\x99%\xea\xd6\x8dYtLx\xc1\xc9.u\xbc\x05E\x04B\xb6\xd6\x16\xc8}\xb5\xd3P!\x02\xa5\x08B\xab\xadum\x1f@1\xbdH\x0f\x1a\xdc\xa7>\x8eL\xcf\xed\x16\xda\x96e\x10\x10\xcf8\xa7ZPH\x95	v4\xba\xff.\xb4-9\x8e\xc6A\xf0\xa4<&\xb9h\x15g\xc1\xdam\xef\"\xe5\x9d\xed\xc6\x05\xf1\xed\x11\x86;\x91\n\x01c@?)9\x16\x9b\xe3\xbc\xc2\xb7\xe49Fh\x04Mi\x86 \xe2O~\xc3\xd1\xf9\x99\xd4\xa4+\x1c$\xa8\x00\x835\xcfR\xde\xa8\xabJ	\x03\xf7\xec\xc6\x95\xbet\xad\x0f\xdd\xeb\xe8\xb1\x02&\x06\xda\xd9\x8d\xb2\xfc#Y\xdf:\xdaG\xdeM:#\xd5\x0b\xb4}{\xcf\xb9\xe0\\\x9b\x9b\x17\\\xa0*\xba\xc8\xf7\xd6\x84\x0f\xack>f&@*\xc4`\x00\x8b\xce\xbd2\xa2Hw\x15\xcd\xe1\x83\xca\xd3(\x0c|\xd5{C\xeeL\xcd\xaco\xc0\xf1^\xefO*\xc6q\x8a\xf1\x85\xf7t0h\x81\xeaC\xb1\x8eu|\x02\xbedw\xcb\x84N\x01;AE\x01\xf4\x9d\x85CR\xaa 	G8\xa3B\xf6<\xdd\x17o\xb0\x08\xa6\xc5\x1e#\x8bO\x055\x02nK\x8c\x8bW\x121\x11\xa7\x12\xaf\xb9\x03*\xc7\x98-\xe8LD\x8a\x11$\x8bL\xb0\x00\x9e\xb9\xc7\x19\xff\xf4\xc3\xc9OMtXkf\x86J&(\xd3	N\x08\x1a	M\xd0\xd3\x8a\xban\\J\xbc\x0e\xc1\x94\xdc\x029\x03t\xf8\xd2\xc2\x00^C\x10[W0/O\xa0 6py\xec\x06@\xde\x90\x03\xd7\xa08\xf4gs\xe8#?\xe3\x91\x10\xd6wu\x0c!\x15\xf6#\x04\x9d6\x06@\x00\x01<pGN\x00\x0dT\x05u\x90%\x0b~C\x04\xce\x87Tj\x93i\nS\x81\x16\xb8\x10}\x17\x05#0\x037 \x04E\xe3_\xd0`	\xaa \x0b<(Ra\xc0\x83\xb6\x90\x05\xdd\xd0\x0c\xbe\x91\x05\x16\x97\x7fdQ!\xc4GM\xe9\xb5_7\xb1\x1a\xa9\xb0\x0eJp\x84Ia@\x8d\xe0\x0c\xdb0\x05U0\x0b~\xa3. 0\x02]\xa5b\x956\x032\xe0K\xd7r}\xd2A\x01 \xb0\x81\x195t\x96\xd6{^H;A\xb8H~W\x06\xbc\xd1_s\xd1{\x12\xf8\x82Q\xd15\x0fHj\x81\xe2<h\xa2E\x83\xe7!\xf0p\x1bWP	\x95s|^\x12\x84'\xff\xf7x\xe91	l\xd7\x05\x11\xa8y^\xf2\x08\xbe\x05\x88\x90\xc88\xdf\xf2\n\x9b\x00?\x8c\xe8/tT7\xf8\xb7\x89\xa2!	8\"\x18\xaf\x805\x96(\x8a\xee\x82\x02\x11UhJ\xc2\x05D\xb0\x04b\xc8;l\x85\x16_\xc0\x0d\xad\x86h|\x18\x12<\xe3\x14\x0cp\x02\x83\x98\x80*\x98\x05\x11\xa3zT\x08@\xf2\x14\x86]\x10\x05\x9f\xd8\x7f|\x91~\x051\x038\x00\x04\xfc1D\x8fx;\xe70\n/`\x14w$r\xe3\xd5\x80\xd3\xc7{\x12\xf6\x8b^2eL\xe06\xda\xb8/\xa73\x04\xd1\x10\x04\x99\x00\x03\xcb\xb8CF\xb0	\xbetZJ%\x8d\xa7AGSF\n\xb0\xb0\x85@\x18\x84\xde\xf0\x0d\xc2\x03\x02\x94\x00\x037\x00\x04w\x13\x86Y\xc5\x8e\x04\xd6\x8a^\xb1\x15\x01FQ\xf9\xc4\x01\xd8\xa0\x85\xe80\n\x9d\x10\x7f\xbfD\x88xt\x1e\xedh6\xfb\x18\x8a\xae\xd8%\xa1D\x15G\x11\x84*e\x8c\xbbfw\xd1d)\x12\xe94\xfc(\x0eE\x90\x01>\xff@(\xd7\xf1\x87\xdb\xd8V7v<\xe67\x93nQ\x93\xe2 )\xcd\xd0\x92\x9aX\x88@\xf5\x88i\x00\x06\x06$\x94,\xd1p\x8e\x80{	\xa6'\xf7asZ0L\x85\xe5#\x1f\x80<W)_\xe6%	\x7f\xd0EI\xb9\x93\x97F\x94\xc3\xb1\x11h\xd0{\xf5\xb3b\xbfF?\x1f\xf9\x88\xb6\x10\n\x02`\x92+qX+\x13p\x7f\xb7'%\x10\x03<\xf6\x93\x80\xf8\x04\xbdh\x97\"\xb1P\x18BR=yT\x8a\xd8T\xa2@\x0e\xae\xb0\x85\xf5h\x16Zp\\L( \xcf`V$\x98X\xb3\xb6\x87\xaf\xb2\x03\xde\x12|s1]\xe8(+\x16u\x08\x07V\x96s5/\x89\xe1\x12aQ\x03 v3\x0bv~i\xb9)&\xe6\x03<YaQ\xd4''A\x0d\xab\x10\x90\x9c\x83UC\xa8,\xa3Y\x98\x9b\x12\\I\x90\x99r\xf5\\\xc0\x02\x8a\x15\xa0.j\x96\x147\x89\x96\xd3\xf8,:\x17[U\x99\x9b\xaf&\x15;0\x04L\x90c\xc4F\x81Py.r\xff\"\n\x97\xa4a\xca\xa9p\xfe\x88K\x0b\xb1iQa=j\x17\x8e\x7fw\x9eUVZ\x0c\x00I\x84)\x9e\x9c\x95\x1d5g\x9e\xf2$3x\xc5\x9e\xed\xb9Ie\x90i)\xf0\x9a{\x19`xE\x0e\xe5\xf0gE\x89W\xa7(\x02<s\x9c\xfc\x89\xa0\xa9\xd4\x801\xc3\x9c\xea\x19#_\xd0	\xa3\xa4\x11\x12z\x9d\x14*\"\x17p\x04\xb10Y\x1f\xc5yv\xd9\x07\xf4E\x1e\x1fzz\x9ayW1B\x8c\x9d\xf2g\x89\xa0\x85\xd7p)6\xe1\x8fE\x99Aqi]\xd9$\x94\x1aQ	H\xd2\x0e\x83`\x03Z\xd8\n-\xd3L\x849O b\x1f\xc8\xf9h\xd2y\x11\xd4$\x0d\x81(\x87d\xf1\n&6\x9c\x17X-\x89\x89T\x08\x14\xa5\x89\x94\x01I\xa3*J!\x05\xaf\xa7\xa5\x17(D.\xa9\x82?\xaaw.\x81BZ\xa4Pu\x89\xa6\x17\xe8h\x85\xf6A\xb29\x94\x14\x90\x8a?S\x0b\xf4\xc5\x17!@~\x9aI\x97\x00:\x11\xe31\x95\xe47]\xd3H\x01\x91\xff\xa9e\xb5p\x02LhB'!\x04\xb2`J\x7fH\x04M\x81\x19\x0b\xe0\x87	\xd6\x08\x1a\xe3J\x91JeEY\x81Vb\x06\xa0\xd9\x05\xd1I\xa7\x14\xa1\x01\x06\xea\xa3\x06\x82%Z\xe5\x82\x15h\np \xa8np\x0e\xbd\x98\x19\x17\xc0\x86\x07\xcaF\xb6p\x04:\x80(\xc6g\x0780Q\x1f\x80\x90V\xc05\xa2\xa1\x0b\xad\xea!\x8b\xa9\xaa\x1f6}\xb2%O\xe0\x84XX\xc0JM\xf0\x81\x9b\x84$\xdb\xd7\xa3\xc0\xf3\x0dFx\xa1\xd0\xba\xaa\xb1Xh\x1a\xa0> Y5\xc5\x88\x9a5\xf2\x0d\x81$?N\xa2I\xd7\x19kw\xf7\xa4\x119\xae\x16\xc1\x08\xf0\xe9\xa3j\xba\xa6\xf9\xf7Z\xe1Qd\xd7	\nZx\x9b\xf6\xba%\xa1t\x9f\x0e\xd6\xa9\x11\x1a$\xe9\x8aQ<\x00\xaa\xf8\x9ao\xf7%[.`U	dG\xd2\xea\x92Z\xe0\x0c'\xa03w\x10\"\x1e\xd0\x96\xe6:e1\xd0\n\xb2 \x92V\x93\xae\x94\xa1\x0c\x800\xb1!\xe1\x9a`y\x8b\xa7\xf3\x94\xe4\xffAG&\xa0\x97\x19\x05\x138\xb3\x02\xd1\xe8#\x02Qw\x84sv\xdc\xd6%\x1c\xc0	\xee\xe1\xb0\xc0\xca\x04\xad@\x05\xa0\xf2\x8510\xa7@\x1b\xb4\x18w\x0e\xe7\xb0\xb1\xe1\xb6\x87\xe7\x12\xa9\x1b\xc0\x02>\xd5\xa1\xe1\x99J\x08((A\xb0\x08p4\xb3\xd1R\xa8\x05\x11\xb6l\x01\x00\xd6y!\x84\x85\x03\x85dwb	\xa6S[\x11=\x10pW\xd0\x9d'\xb1^\x82R}\x95y\xb7^Q\xad&\xa2Y\x1a\xc1v\x82\"\xac\n+ \x00P\x1c\x00$\x8341\x8c\x0e)\xa0*\xa0\xb6\x82+\xa5\xf9\x88\x15\x94)\x8cy\x1b]\xe2\xba\xb8\xf21a\x97u\x1c\x9b\x8b\x12\x1a\xd1\xaf[\xf2\xb90\x1b\xb0\x9aD02\xd9#\x83\xa0R\xcf\n\xba\x02R\x9erP\x0b\xb1\xba\xb6\x8fa\"\x12K\xbb\xa1[$V\xe17\xa6\xf1\x07B*'F\xf0\x0ev{\xb9\x86\x9a\x01\xef\xd1\x05\xc2\xbb,\x15\x00z\x94h\xb9\xca\xcbN\xec\xb0\x05\x9e(7D\x06\x97\xab\xb2r\xfeY\xbd\xfed\x82\xbd\x84\x00c\xc0\xdb\x1a,\xc8\xb60\"P\x81\xcb\x18\xd1\x0b\x1fo\x92\xbc\xe0\xbby=\xf0\x9d	\xc1\x08\xcd\xbb\x06\xdd\x10\xab\xab\x0b!\x13\xb8y\xeaP<X1Q\xe8\x1b\xbfD1A\xfb\xe7\xbb\x04\xbc\xb6\xc0+\xc0\x08\x9c\xc0\xe7RH\x07\xbc\xbf\x0e\x9c\x11E\x10\x0b\x11<\xc1\xb6\x81\x04\x990\xc0\x18<3\x12\xdc\xc1k\xcb\x17\x01\x01\x00!\xf9\x04	\x08\x00\xe5\x00,\x1c\x00\x12\x00\x85\x00\x99\x00\x00\x08\xff\x00\xcb	\x1cH\xb0\xa0\xc1\x83\x08\x13*\\\xc8\xb0!\xc3>\x04\x1cJ\x9cH\xb1\xa2\xc5\x8b\n\x05h\xe4\x83\xb1\xa3\xc7\x8f \x172P0a\n\x14V\x13B\xaa\\\xc9\xb2!\x00\x8d\xe8\x988\x89\xd5\xe5\x8a\x83\x968s\x86\xf45\xe0%\xb4!D\xba\x9c\x19\xba\xe6\x1b\x05\x9dH\x93J|ic\x93\xd3\xa0r\xa2\x86\x8bS\x94\x81\xd2\xabX	\xbe\x8c1C\xd7\x150^\xc6\xf0z3v\x0d\xd9.V\xb3\xaaM\x9a\xc2\xd2\xab\xb0\xbd\xcc\x94\x15\x83\x86\xeaX0i\xd7\xea]\xc9\xc1Q\xac-e\xdc\x9c\x95\xcaE\xf0\xd4pxs\x16\xc8\x00\"D\xdf\x02{[\x8e\xa0I\xa6n\xae\xc1f\xb3X\x01w81\xce\x0d\xb3\xb4\x84\x11}\xc4Q#\x08\x91Azx;\x17\xf3\x96X\xae\x1a\x89\xa5\xea\x99%!\xd6m\xd8 \x16ZeY\x06\x00\xa9\x97F|9\"0f\xcbEG56!\x97v^\xbeX\x8eg\xd6\x12\x1bxp\x91\x06\xe6\xbc\xa4UX\x8d\xf47p~d\xff\xaa6\xd9\xac\xf3\x96\x05\xa6\xe8\x96jw\xf0\x96H\x1a\xac_?\xd8\x13\x83\xa5\xd1\xed\xf3\xffm\xaa\xcb\x94$]d\x9dg\x1b\x15\x86y\xf7\xdd+(\x0d\x82L!\xf2\xcd\x97\xc71=l\x06`~\xed\xc1\xe1B\x11\xb6\x98\xb1\x05n\x02\xf25Kn\xafa!\x1a{\x84y#\"\x16\x8e\xcd'\x10I\x9c\xc0\xe5\xa2~[\xb4`DeD\x05\x98Km*Q \x8bP\x93\x8c\xe0\x08\x0fau\xd7\xdcz\xde}\x01\x8d\x82\xd7\x19BM-\x19\xa6\xb1\x0br\xed\xd9R	\x08\xd4DG\xa2]8\xee\xf4\xa1\x1b\x1bZ\xd1\x89#,\x84A\xa3yC\x8a\x11\xc5+? \x19\xd9 \xa6\xbcH$\x96\x91d\xc3\xdded\xda\x98\xe5Gwp3\xe2n\xb6LA\n	n\x96y\xe3\x17\xb0\xa8\x83\x9a^\x10`\x13\x05]\xc6	:\xda\x08\xae\x9c\x91E\x81OVxh\x8e\x19R\xd8F\x16\xabT2\xe9\xa4\xdfE\x15K6\xc6\x1c\xa0\x16\x02\xd8x\xe5(QY\x9c@\x826\xddx\xff\x05\x16\x9d\xc7]\xbaS\xa6\x9afA\x0dWW\xd4X\xe9\x9b\xb10\x81\x84\x02\x15\xf1\xf4\x92F\x0e\xf5\x04K\xaf\xb8h\x8a\x8671P\xa3\x04\xb3:\xc40\x04\xb3$\xde\xe9\xd1\x00B\xea\x97\x8b,C\x08\xa1\x89\x98\xab\xfe\xaa\x85\x10\x1d4\xa8U}\xc6&\x03\xd4\xbbC,v\xac\xb1\x05\xf5$\xc4-\xee4\xeb\xac,\x89\xfc\xa5\xc3\xbf\x9f\xc0\xd0\xa2\xaf\x1d\xde\xea\xac\x81c\xd8\x12\x8d'\x0c[y%a;\xa4C\x92|[\xed\x10\xf1\xc5\x9b\x90[)\x0fN\xc5\x80\x001\xd9=0\xaf\x01\x9b\x00\xf6p{_\xfe\x10\x04\x08\xe9l\x02\x05\x7f\x0fk\xdbQ\x01\xb8\x1e,\x14\x0d\x97\xcc0\x8d\x84\xad\xb5\x06\xad\xc7\xc7ZBCP\xb3j\xec\xda\x15O\xe0@\x80\xc8\xc3\x102\x03\xceO\xf3|rU\xa5\xe6\xbch\x197\x0c,\x9d\xad\xaa\xed\xe9k7\xe4\\\x13\xf6)b[\x83\x815\xe3p\xc8%\xa3\xbc\x80!\xf6\x04mQ\xf2D\xa0=\xdb\xa5J&xlT\x0c\"E\xbf\xffy\xf0)\x07,\x90\xc2\x174\xbe\x1c\x8dP\x08\x17\xac\xda\x9c\xb4\x9a\x05\xb8\xbc\x8c,g\xda \xdb0\xab\x99\x14\xb5\xcc\xd2M7\xad\xf8\xf6\xc8[\x94\x1d\\\xa1,2@\x94\xf7\x1d=!^w\xdd\xb3X\x108\xcf\xdd\\\x12	\x10&\x1f\xa68\x9e\xad\xf4m *\x8f\xb8\xce\n\xa8Nj\xf1\xcc$\xe8\xd0\xf2\x85(\x0b\\P\x81\x07\x1a4\xd6\xa4\xee\xa2\x13\xd5\xccH{\xf3\x11\x8cv\xaa\xfb\xed7u\"0\xff\xcdY\x91\xac\xe3u\xad\x92\xc5Zw,(5\xf2\n\xb6l\x80q\xc5\x0cE\xa4)\xb2\xfa\xee\xd3m\xa3\xcd\xc2\xd3\xf1\xcb\xd2\xc9\xecA\x08$\xd9\xc3\x9f\x14\x9c!\x8b\xd7\x90\xe1\x1b\xb6\xb0\x823\xc4\x978;E\x00=[\xd2\xde\x01\x15\xb8#(u\xe1\x06\x0d\x00\xd9 &\xc1\xb6_I\xf0`V`\x1e0\xe4\x05\x01\x12\xd4\xceI\xa2\xeb\x8d\xd7^\xe1\x8cf\x98/T\\\xe0\x9aj\"(A8(0tg\xc1\xe0\xd2\xda\xc1\xbe\x0fF\xcf\x16\xbeaZ\x1d\xff\x0c\xb1\x8c\x11\x050W\xdb\x10\x87\xe5\xba\xf1\xaa^A\xcfR\x10$\xd8\x01ca\x05*DB\n\x89\x90Pw^p\x0c\xfeEbB\xd1\x8b\x1e\x07F\xa8\xb7\xfbt0z\xadk\x12\x169\x10\x9aP\xad\x07E\xea\x9a\xa1\xa3\x00g\x9a\xd8(\x83\x16\xac\xeaQ\xd0\xae\x16F1\xea\x81]\x98\xc0\xa3\xed\xc2\xc8/\xb0\xbcg\x10S\x18\xdf\xeaP\xf4\x99\n\xe6\x86*IX\xde\x89,'\x87C\x92\x10\x1aF\xebc\xae\x941\xb2\x1a<1\x85\xff\xd1\xcd\x1a]8\x1b\x9be\xe03\x04\x1a\xd3\x15\x8e\xb0\x02G\xb6\xefS\xbd\x0b\x80\xfe\x92\x11HM\xfe\xd01]4@\x84<\x18F\xde\x99oR\xb2`\xc5\x14$\xf1\xbb1Q\x88\x91\xe8Y\x05\xfb\xb4\xc0\xcaLJ\n\x12\x1bh\x9a\x1d\xb4\xe3IA\xd9\x12N\x0e\xf0\x1f\xea\xb465\n\x01\xee\x91_)\x83hX\xc8$\x14\x1e\xf3\x94\xe8	\x81\xc3v!\x8dL\xb820\xf9\xcb\xa0v\x06\xb0\x89\x02\x96\xf2\x9aQ\x02\x01\x19\xf5\xc7\xff	EF\xef\x14\xb2\xf1\x02\x13%\xe1\xa3H hx3\xda\xa4b\xa4\xe6	\x1a\x98+\x17S\xc2\x006&`\xbd\xbd\x1d\xce\x9a\xd7,\x0c	\xb2i:B\xf4\xd3\x8d\x14\x8a\xa4_\xc4\x19\x05V\x94T\x1b\x11\xa5\x80\"\x80\x00\xc6\xe6\x00q\xa1Dj\xa8\xc6\xac0\x83u\xd8\xf4\x12\x14=\xd6E\xa5h\xcb\x9aD \xa7C\xec\x01*\xa0\xd4Kt4\xc1\x82tq\xc24\x10\x11\x08?\x94\x00He\xa2\x05:\xd1#\xb5'$A,\xdd\xc8\x81\xd2\x84\x02D\xea\xfd\x02\x07N\xc0hF\xcb@\x08\x0d\xbe\x04*b\xc5\x1fO\xc7@\x84!pq\x05P}\xa4Taj\xae(\x04A~\x08\x08\x8c'\xa2\xe9\x87\x9e\xa0\x15\xa4}d\xea4\x9b\xfa\xd7\xd5\x8d\x15\x9eD\x88\x18\xed\x82\x00\x97\xb96\x12X\xa9\x80\x9a\x05.q@\x0c$\xe0\x0fd,,>\x0dc\x8b&\x04n\x88\x10\x80\xc1\x12\x00{\xd8\n\xa1\x81_W\x05\x83\x12\x0c@W\xed11C\xa9\xc0\xec<)q\x04\xc3\xff^\xf3\x0b\xfad\x17:\xd2\xbaY\xfc\xad \x03>\xd5I \\\x81\xc3|&\x90\xaf\xf5AG\xdfz[\x96\n\x98\xf5\x0f\xeb\xb8\x1fs5\xc9\x8dE$,\x01HA\xe4 \xa5\x12\xc2\xe3rT\x18\x1b\x08\xabmm\xd9\xb9\xbe\xces\xb7\xd3\xc5'J\xae\x9b\xddH\x1d\xa7O\x1c\xf0\x06\x15\x80\xba\x80D\x8eW\x93x\xe1\x1fOB\xd0\xa6\xf4\xf6t\x98\xa3\x81\x8cp\xdd\xeb\xab\xde\x10\x13\x02\xcf\xad\x821\xa7\xab\x85\\\xce\xd2\xbe\xfe%o|Wq\x87\xf6\x9aS*\x9d\xfa\x8b6\xe7\xa9\xe0\x08\xc7\xa1\xc1\xa8#c-\xce\xe8\xe1\x83%\x11\x16\x91PJ\x00:\\\x16\xeey\xe5z\xf3\xa2!\x83_\xa1 @\\\xf6\x0eRh`\x895\xb5\xc6\xfe\xa9Xm\xac `XH\xa8\x1d\x16\xa7\x17\xb7\xf3|P1r,\xdd\x1d\x8f\xce\xb9\x10Qq\xe5\x80\x05\xcf?*\xf9\x0f\xa9\xf4\xaf\x15\x93\xbc\xb7w:YSI\xfc\xa3\x8a1 \xde\xba\x0d\xf6\xb3#\xd6\xd7tCh\xe5\x8fa\xc3\xff\x80_6\xb1\x04\xfa\x80\x15\x07D\xf7a\xb6\x08\xf1\xc7\xa6\xdcd|\xba\xc2\xab\"\x83\xc5\xb3x\x19\xe7GRX\xcc*f\xe09\x93\x0c\xe1\xf4\xc6P\x96\xec\xdaF\xa3\nm\xda*\xc8\xeb*\xc7xGB]\xa3\xe7\xf4\x906\x8cy&\xe1D\x05}\xdfRK\xf8%\x98N\xc0\x10\xf0\xdci\xf5D8\xd4\xc6\x9a/\xa9\x1fI\xe9\x1a\xc1\xa2\xa9\x03\xc0\x0eH\x00\xb0\xea\x9e\xc1\x9a'\xae\xf6a\x1f\x7f\xcd\x80a\xce\xba\x8f:\xa8Yom\x81\xae\x07$d^\xbb\xd6l\xfbZ\xfd\xe9\x1f\xb2\xab\xd8l\xe4\xe9&\xbd\xbcY\x1a\xd3y]#\xdb	\x0e\x86\x9a-j\xbf\xfa\xdaS\xc0\xc0*xk)n\xab\xb7\xac\x03\x19\x19\xaa\xf9\x92\x0eU\x94{\xc3\xc1>2\xae\x87hlmkj\x19/\xdcl\xf0h\x1c\xefy+\xe6\x10\x0e5W \x98\x81`O\x9f\x1b\xb4\xd8>v\x18\x97!\xe3\xb1\x12\xaa\x0e\xe58\xd6\x98\x13\x8eM4W[t\xc4\xeew7\xdbm?\x90\xc3\xc2\x15\x9d\xff\x13G\xff\xec\x90\x15\x00\xc4\xa0\xb8\xf3\x05\xad\xc4\x99\xdb\x8d\x05UO\xe4\x9a\xa4x\xc9\xbd\x85\x05/\x15\x94\x0bXD\x94\x19YeY`\x8f\x1c\xbf\xc8\x8d8\xad'^q\x10\xce\x02\x06\x92\xf0\xc1\x9eB(t|\xd1\xe8\xd7\xeb\xf6\xf0\xa1\xf9\x9d\xed\xa3\xafG\xe7\xf7\xe5\xc2\x15zW\n+}\x0f\xe8\xd9\\\x0bq:G\x97-\xa84\xdd\x0cpw\xb7\xe3\x03\x02Y\xd7I\x8cM\xd7\x8fc\xaa\xb0\\\xaa\xf0k\x0ej\xef\x1f\x8a\xb1\xea\\\xb8\x1bY\xdf\x85\x90\xc2\xd8\xb3.\xec\xaf\xe7]?R\xd3\xbb\xa9N\xa5\x82(P8:\xde\xd0\xa7\xe1\x1b\x8ft&3\x8eR\x07\x03\xbb\xa3I\xb5\x17\x13P\xd8\x84`\xc0\xe2\xe69\x0f\xeaE\x04\xdc\xeb\x8ew&>\xb3\xc0\x815\xa1\xbd;49M1=\xbc\xce\xbb\xe3]\xf6\x19\x15\xc4\x9a\xcc\xa4\x01\x13\x19\xa8\n\x8d\xe6\xbd\x8e[/w	\x0f\x1f\x88\xca\xfex\xad\xc7\x02	{\x9b:f\xb7xG\x1c\xeb|]\x7fN\x7f\xd82@\x02\xff\x1cZ\x8a\xcf(\xb4`\xfb\xdc\x87\xbe\xbf\xbf\x9f+5\xe8\xc0\x02\x92\x06\xfd\x7f\xb1+\\y7H\x03g\xa8\xf9'v\xce~\xd3j\x81\x03;\x10y\xc3F\x14Q 1\xd9\xa5_K\x93\x80\xc4A\x065\x87\x08\xfc\xd7\x7f-\xa6\x04\x98P\\\xd1\x93\x04K`\x81P\xe08\x0b\x90\x14\x1a\xb7\"\xf2\x960)\x90\x08\xe4wL\xf2Wb\x13\xc2\x05\xa9\x106\x96\xf0<\xa5\x06\x06\xd0\xb0\x020\x18\n\xe30\x0e\xc3\x92\x1a\x0b\x98z\x00D\x81\xa3#\x80\xaf\x86a?wO\xc3\xe6D9\xb0\x08\x0d\x97$t\xc1/\xd6\x07BM\xf5\x80\xcb\x96D\xde7{\x9d\x00\x1c\xe1\x86(\x88\x83\"\xdd\xa2\x1f\xd5\xc1\x83\x0c\xa6\x05W\x10\x1bO\x98QZ\x10\x0d\xea2\x85\x98F8\x9brEts\x05\x7f\xa2\x05!\xb4{\xafF\x1e\x80\xb2`\xd77:6\xe0\x0b\x19\x11\x11X\xf1\x08V\xc2/\x82\xd4\x1a\xa5\xf1\x08\xbb\xd0U\xb6\xf0=\x8ev\x06\xa3\x00\x0d\x94Ab\x02w~\xb9v\x87m\xffv\x80\xd8\xe2\x08\xc4\xc56ir\x1f\xa0\x82\"#\xb0>\xaa\x12\x17r\xb8=\x9bS\x877pD\xcc\xd5+\xe6\x87~\x08\xd1\x81:\x91\x83]0P\x89t\x05C8\x81$b<\xf0\x91f\x06D~}\xd3*\x89@\x01D`O\x0c&N\xb3aI*R/Z\xf4\x7fPF4	sF\x83HP\x8c\xa1\x0c\x9c`\x05!\xb2\x05\x06fY}\xa1L'\xc4z\x14\xc2\x8a\x8f4	\x1f\x13\x8c\x06Q\x1e\xbc\x11L\xcextU\x84|R\xf0%\x8e\xd1\x17\x90\xe0\nT\x809\xac\xf0z%\x88_\xa7\x07\x17>\xe2c\xdc\xa8\x15\x1b\xe0\x01Lva\xeb\x87B^\xf1\x05\x9bc\x05_\x80+\xfa\x88T\x02\xa7\n\xfd\xb2\x1b\xb4\xc0\x88\xf5\xd8\x8d\x9fg\x8d\xec\x06N\x9d!}\xdb\xc6YV\xb0\x0d=@\x12\x0b\xe9\x81\x8c\xd0|\x10\xc8z\xdf\x10d\x01\xc96\xafP\x04\x86\xd0\x88\xf5\x98\x80B37\x0e\xe9d\x03i3\xeb\xf0r\x8d\x13\x05\xe8 1\x93\xa7\"\x08\xe8rM\xff\xd0C\x12\x89_\xe0\x94\x039\xe0\x0d\x9d\xb8I8P\x8d5\x01\ns\x16\x8c\x9f\x15o0\xc00\xd2\x16gYpW\x9b\xc0\x03\xee(:<P\x01\xcb\xe27\xdd\x10\x0d\x98`\x8a\xa7bp\x19\xc7\x13\xb45Z\xbe\xf7j\xdd\xb0\x04\xa3\xf04@\xb9\x8fWB\x03\xe2\xa7\x88\xb1\x83\x876\x88h\xf5b,A0\x83d\x93\n\xd1\xb7|\x10	N\xaf06\x96\x80\x88\x0f\xd9~\x80\xd9gR\xc2\x95c\xf6l\xd6\x91<\xb8\xc6\x0dg\xa2\x83\xefU\x0b\xff\xb89\xca\xb1\x08&\x00gsh\x1e\x9f2\x82\xf9d\x98k2O\xe0\xe6\\\xd8pr\xe4(\x9a\xa4\x99r\xdds\x1a\x8fPM-9\x8a\xb6\x05G\x19	m\x06!M\x0dGQ\x8d\xf1\x18t\xc7w\xeec|\xaby\x99j\xd9b]\x95\x91_\xb9\x10\x98 \x1e\xce(\x80@\xc8\x9b\xfb\"\x8a \xd7;\xaf\xe9\x95\x06\x81\x02\x17\xc9\x02Q\xf0E\x9c\xf8\x8e\xd6\x89t\x0d\xc9yl\xd6\x9c\"\xe1P\xads\x9cq\xd6US\xff\xb9]\xb5\xc2\x99\x81\xd7\x9d\x0c\xe8\x01\xca\xe9d]E\x05_8\x96	i\x9e'\xe9\x9d\xc5\x07\x9eN\xc6fo7I\x9fT'X\x80A\xc0y\x8a\x06@\x9f\xa1Dh%fI\x1f#\x8d\x8d\xa1\x9a_\xf8\n\x9b\x10\x08\xffI\x1f	\x97\x0d\xde\xb8\x92\xb6\x84\x05\x0c\x07\xa0\xf7\x88\x08$\x00:\xbaY\x17`\xb0W\x02\x86\xa16\xe9PY\xb0\x05\x196}\xdb\xc0Z\x0fq\x8e\x05%\x0e\x9a\xd8\x0d\xf2\x83\xa1\x90\x96$5 W\xea\xd4\x9b\xa3\x88\x01\x17A\x1c\xf0q\x1a&\xf9\x99\x83\x15\x1c8\xf9F\x8a\xd0\x02L\x98\xa3\x18a\x7f\xb1)D%\x99$;\x85\x18\xe8\x92l\xf6\xe9_\x8e\xf5\x19	\x88W\xcey*OJ\x06\xf3\xf5Q\x85&U\xf2I\x11\xf3\xe2N>\xca.{\x01\x00[\x8aZ\xe3\xf7\xa5cd\xa5.\x17\x0bK\x00\x02\x0c\x97\x00%\x80	\x1e\x83(ij	\x180\x9e\x0f\x87\x1e\xc7\xe2\x9e\xd3C	0\xf8\x93\xf3\x85\xa7\x04\x13\x0b\x1c\xc0\xa7T\xfa\x1b~\xff\n\x00\xf4\xd3B3\x91\x9b\x7f\x86(q\x15<\xe9\xa6\xa8\x88\xc7\x17\xc72\xa1\xaa\x83\x05\x86r\xa6\xdc\x14\x06\x867+_\xe6\x9a9B\x1c|D+Y\xa0\x1ckrQZ\x809\x83\xb0\"y\xd9\xa7;\xb1\x80rx\x05\x17\xf9m\x86\x9a\x06\xb5\xe0\\\xf1v\x85\x05\xcaWx\xb2\xa9\xdc6\x06\xfei\x83\xd1\xf0\x05fC,\xbfJT\xc1\n23\xc3\xa3\x9a\x99\x0b\xe7\x97$s\xa6R\xf5\x92f\x04\xeah\xbfA\x7f\x16A\xac|\xca\x02aZ\x7ft@\x1f\x1c9\x8a17\xae_\xb9Gi\x05\x1f6Y\xae\xf4\x91e\x14zK\x12\x9a\xa5@:\xa6f\x183^\xc0\x9c\x0f\x9aq\x93\x88\x9c\xb7\xe4\x8c>b\x7f\x04\x9b\x08;\x13\x8e\xef\x88@m\xda\xaf\x19\xd7{\xc1\xfa\x16\xb7\xe0\nxS\xb0\xa20\x05\x16{\x95\xbf\xb8/$\xc0\xb0\xf1\xf6\x0e\xe7*p}QLY\xc0\n$[\xb2%\xa5\n\xacp\xa4\x952v\x1c[p\xad\x10\x98\x19\xc5<\xb3vO\xfc\x08\xac?\xffT:-\xeb\x81\x04\xe6dD`\x0137\xaf$\xa249\x1bo\xc9\x17\xac\xd9\x80\xa3\x80\xa5\x05@\x10\x85?\xda\xb2w \ni\xc9\x9e\x1e\xf0\xb2zy\x9d\x8b6\xb4\xeb\x92\x84N\xb6\x01\xa4 6j\xc3\x9372\x83\xb1\x8a\xb5Z!\xaf&X\xa6B\x909Q@I\x80E(pG\xb6\xf5\xc2\x0d\x98\n\xb2\xac\xc5.\x96\xd0\n'7\x95\xc1$\x9a\x86\x02op\x1b\x9bwv\x9f\x1e\x00\xa4\"\x90H\"\x02\x90\xf8\xa9\x98\x7f\xfbl\x81\xbb\xb52Zc\x8b\xb1\xb8\xc9\xa2\x0e\xbb\x99\xa3\xea*\xb9.\xd1\x94\x0c\xc6<\x98k\x1b\x9a\xeb-\x0f\x99_\x97\xdb\xb9\x19\xe1\xa5\xdd\xb4@\xfb\xd9q\xa4\x9b#\xa1\xbaI\x890#:\xf9d\xa3\xbb\xba\xf4a\xba\xb9\xa2G\x8ba\x03N\x14z\xb3K\xbb\xcf\x19\x8a\xdb\xfa\x1e\x1d\xeb\x02\xb1K#c\xd4\xbb\xbe\x1bo\xaa\xa9)\xc8\xe4\x81\x9e2i\x05\x82K\xc9\xfb\x11+\x90\x03k\x85Ss\xd9\x15<\xe5\x08\xd63\xbd\xdb2t\x14\x9d\xc2\xbdKj\xbd\x0f#	\"\xe3\xbd3\xd3\x02\x9e\xa0\x99\x8ap\x8a#u\x1c<@	\xc8\x8b\xbe/!\x96Pt\x8a\x16\x98I12\xbf\xf4\xbb\x01g\x14[\x19\xf1\xbeC\xc2E\xfc\xeb\xbd\x14\xa0\x0d\xaa\n\x02\x0f\xe1\x9e\xd5\xa9\x0b.0\x12\xe8\x8b'\x0c\xfcL+\x9a;DR:\x05l\xc0\xae\x07 \xad\xe3\x12VY4`P\x87\x11\x8c'\xec \x14\x890\x11\x94S#\xc7:\xc23\xf3\x1aG!\x1c\xf9\xb8\xc2,\x9c\xa4)Z,z\xc2\x05\"<\xc3\xd1*C.\xb1>2\xac\xc3j\xb7\x0e9\x0c\xc4{\x91.\x19\xcc\x10\x01\x01\x00!\xf9\x04	\x08\x00\xef\x00,\x1b\x00\x10\x00w\x00\x99\x00\x00\x08\xff\x00\xdf	\x1c\x08\x80\xc1\xa0\x83\x00\x06*\\\xc8\xb0\xa1\xc3\x87\x10#J\x9cH\x11bA\x10\xd8Daj\x14\xe2C\xc5\x8f C\x8a\x1c\xb9\xd0\x17\x06)X\xc2\x94\xc1be\n\xa2\x84$c\xca\x9c\xf9\xf1\xa2\x166rp\xaa\xe41\xa2\x91\x00\x9a@\x83\x02-\x08m\x86\xca4qt\x82\xb9\xb9\xad\x82/\xa1P\xa3V,\xa8\xe9\xe6.3n\x92\xe6$\xd3\xe5\xcb\xa8KR\xc3\x8a-I\xd5*\xd2\xadh\xc3\xb0z4\xb6\xad\xd4\x82tpx\xd1\x8aU'\xdd6Z\x94\x19\x83\xe9\xb6oL\xb8Usia\xf5\xca\xca\x16\xaeo\xea&\xd6\xd6I\x82\xdf\xb6pI\x02\xbe\x99W/	\xcak\x8e^\x8d\xe5)Q\xa1\xbd\x8f\x83\xc2] \x80\x80\xe4;?\xccu\xc92F\xcb+T?NYq\x03\x06\xf1\xd5pgXB\xf1\x11Z\xb2\xc0\x826\x1e\xe5\xc0\xc2\x8a\xce\xe9Y\xb6\xb3\x86\xa1%i\xd15\xcdvq\xe7n\xd4\x1b$\xd1\x8e0Xa\xbe\xc2\xd7zA\xe4w\x93\x82\xff\x99\xb5Bu\xda\xcc\x9be-:X=\"\x01\x0e\x18\x9c\xe8by8\xb1\xce\xee5\xbf\xa3\x0fO\xa6	'ZeH\xd7\xc6\x19\x03n\x15\x0b\x14*\xb4\xd7\xd0hTtS\x9bbt\x81\x81\xdfT\x05\xc9\xb2\x1f\x7f\xd2<3\x8b7\\\xdcV b[\xdc\xe0\x93\x82\x04\x01P\x82*\xd0\x9d\x97S^#\xc1e!\x7f\xb7]\x91\x0dG\xb1\x10\xe8!\x84\xb6P3Ho\x91\xbd\xc7\x00\x11s)g\xdfy\xdc\xb5X\xe1\x85\xfcy\xf3\x8a\x12B\x98\xa5bk\xdc 0aX\xa3}g\xca\x15C\xde\xa5\xcb\x0eu\x15)\x92\x8b\x1f\xc2\xa8\x927\x95\xb4b\xd8\x83\xfc\xbdR\x83\x06}\x99tQ#\xac\x80sctMd\x90\xc8\x18\x03z\x19\x12\x98\xc9Eg\xe0\x16\x8e\xd8\xc9B\x8ai\xbd\xb2\x81[>\x1a\x82\x0e\x16X\x8a\xc9\xca\x0b\xe5\x14\xd1\xe5\x94\x13\xc1\x95\nkb\xe4\x12f\x18;\x8c\xe2\n,\x86\xb5\xf4D\x0fO\xa0\x89$\x17\xea\xfdDeA\xcc@\"\x9f\x80\xe1U\xffq\xd9	NR*\xd1\x00\x05]\xd2\x04\x9e\xb0\xd2\xb6\x82g\x13\x04\xfb\x08&B\x90\x92A\x83\x10\n8\x1e(zPd\xabC\x89\xc6\xd2(\x1aIj\x00\xa0,\xb5\x1aYV\xaf\xe1\x80\xf1\xab\x1e\x1c\xb0\x02\xc593t\xc4\xce# \xd4\xa8K\x9fx\x92\xf9R\x89%x\x80\x01iv\x98\xa8\xc8K\xecY\x04\x17\x0d\xdc\xd2\xb5\x84\x12\x90\xb0\xab\xe7\x9e&i\x12\xe4\xa6[X\x81\xcd 34\xfa\x0dn.\xd1\xa0nm\xf5I'\x8bKR\n\x10\x833\xb2\xb5\xb4\x8a\x101D\xd1\x85\x17\xb6X\xf1\xc4\xb3p\x9d\xc3\xab\x8dZ\xf6 \x83\xc8D>\xeb\xde\xb6s&\xa6\xcb-C\xcc\xb0\x8a\x93^\x14\x91\xc9s\xde\x00\xe1\xd9\n<8\xd1\x8d\xac\x14D\x90\x8dFR\x1c\xac\x06\xcbY\x96+\xaf\x1f\xbf\xf0\x15\xed\xc8\x8e\xa6\xd3\xa7V\x03{7\x80\xc1\xfdj\x9a\xa73Ud\xca\x8b}Xh\xb8\xd1\x01w`\xc0\x82\x06\x8b\x90}\xf4\x98+\x8b\xb9\xa2\x16\xad\x18\x00L\xbd	\xe1\xff\xda\x80 I\xd4\xfc\xb4\x1b_\xf4\xf4\xaa\xc02\xeb\x0b\x00\xd8gk\xb9Zk\xcc\x95\xba\xa9OsH\xf9\x88\x83\xf4\xed78\x8c\xb1F@\xef0\x0e\xc0-\x88\xd2V\xc1\xa8a\xd9iT\xf1b\xe3\xdd&\xfe\xd0dYvX\x06\x15\xb5\x1c-	\x15 \x98\xe2\xf4t\x06\x11S\xc13\xb6d)<\xe7\\\xa7ZZ\xdb\x96p\x95\xc5+<\xdfU8K98\xd1\xdc\xba\xacw\x9d\xdf\xe2\xbbse\xa8\x9d\x92psB\"\x8bb-F,$@\xd0G\xdb\x92L\xdb\xcb\xf0~V\xfb\x81\xdf\xc8\xab\xb8iR)\x18\x02_\x0c\xd9q\x90\xbd\xeb\xd0R\x95}\x19\x9e\xe1Aab\xe1\x03\x17H\x0e7\x80\xea\x1d\xfa\xa8G\xbc\x06\xb6\x06X{\xa8\xda\x1cT\x90,\x18}!^\x16\xa0\xc2\xae\xc8G(\xfe-\x088\x00\x12\xdbm\x16a\x8d\xd5\xadD|\xaf@\x81S\xdc\x04\x03\x989\xb0\x81\xb4\x98W\xe5|W\x88AQk~C*B0\x04\x01\x9e\xd9\x85`\x7f\xa7\x01\x004\xff\x8e\xc0@5,e\x04\xb0 Tb\xac\x10\x83\x04\xc0\x0f\x02\x97\xb8\xc2\xc3^(&[\x80@o3\xfc]\xf3\x92\xa4CD4Jz\x92\x08\x90\xe6<\xc8\x90D\xfd\xd0.Y`\x01(\x9a&\xa4\xd6\x94\x01\n\x9aX!\xae\x98\x11\x0d\x13R\x91?\xf0\xe1[\x00\n\xd0\x88-8\x0c\x87\\\x99\x02\x0e\xac\xe0\xa0LEb6\xe2;\x9b\xf5\xae\xa7?\xba\xcc\"\x11T\xd8\x1c\xf5\xc2p\x84\x1f\xc8\x91\x0e\x95\xf3\x81\x08\xef\x88G\xd0	\x03t&0\x95\xfcr\xa2\x1e1\xaed\x070(dt\x16I\xa1\x82\xd4\x0dw)1P\x19h\x00\x848N\xed\x93\x03@\x02*\xdc\xd8>N\xeae4[\x84\xd1)\x9ca#[@\xc3\x13p\xd8$\xd7\xc8XF\x9b\xd0\xc5\n\x8b\x88en\xb0\x00\x84K4'\x053\x1a\xcd4*\xc8I\xaeu\xe4\x18|\x08\xc6$\x1e\xf7\xc2	<\x8c|\x8c\x80\x02/O\xc5L\xb2\xb8\xd2yS\xac\x82\x0f\x94@\x01lm\x81\x1c\x87\xc0b\xbd\xb6)\xff\xb8nN\"i\x9eDd/\xb5t\xa70T\x02\x92J\xd4\x89z\xfe\xe2\xcc\x1b\x81\xe1\x06@\x10\x05 \xfe\xc6<0\xe0S\x8f\xcaP\x82$\xbbI\x97e\xec\xcdM\xc1\xe4\x0f\xc06\xd3\x1c\x17\x14\xf1.\x14\x90\xc9h\xb2\x07\x06\x1a@\xe2\x8a\xc1J\x894\xbe\x89\xcbH\"\xa9\x9bG\xfc\\\x04\xa5\xe9\xc0\x0d<\xe8\n\xddX\x85:\x92\x80)\xd6]%\xa5\x0c}\xe7\x9c\xce	\xcd$\xca\xc1\x1c\x97\x9cC\x0f\xd9GE\xeeX@\x9f\x93\xa8XO3\xc5\x85\xae\xae\xe4\x07	Ej\x10\xf5\xa0\x02o\x8c\xd2\x8a\xb3\xf1\x84\x0d\xda\x16N<4\xc0\x8e\x03\xed)\x0d\xeb\xe5\xc2\x9bF\xc8\x08\x898L\x17%\x11\x03'P\xc3\xa9(\x9d\xc9h\xe0\x8a\xb6	`\x0b\x1a\xc8\xc0e\xbd\xb01E\x8e\xde%\x0b0\xdd\xcb\x01\xfe\x07#\x97R\x8fQVPBg\xfe\x10\xbc\xe4\x885\xa9\xddp\xd4\x1f,\xc4\x02p\xc2o\xaa\x8e\x1d\x9c\xd0\xdc4\xba\x8d\x12\xafyi\xb8\xc2&\xec\xa7\x88\xa2\xff\xd2&\x114\xe1\x93\x9f\xbe\xe0\n\x0e8\xc8\x05\x05\x08\xee'\x17\x15W_b\x94\x8da\xbb#\x0c\xe4\x05\x03I~6\x88\xc8U\x8a\x0f\x19\xd1\x0dFP\xeds\xeaJ\xed]\xca\x07\xae@\x8c\x16\xa7m\x1c\xd27\xe0\xa3\x0d\xb3\xb1H\xb0\xac\n\xed\xa6\xae\xf1\x88(xCt\xc7\x1b\xc4+\xb8\xc9Q\xdc\xd9o\x86\xea\xd5\xae\xf0\x0e\xe3\x8cFU`(G\x8a\xdd\x8c\xb4 \xa1=\x16c\x86\xd9\xd5/\x1b\x0e\x1a\xdf\n\x10V\xc1`\x00\xc1\x08\xca\x00\\\x00\x0b\xc0\xa6\xfb9\xc73\xc61\x9f\xabb\xf2x\xb2Sp8N\xf0Q\x93\xecL\xc4\xfcQE\x06\xc2\xc0&\x0bo\x83\xa7\xe1@\x85\x11\xa48\xde\x0f\x1f\x98\x10!V\xb0	v\x18\xc1\xef\xa2\xf8\xb1\xdc\x19\x03\x07Ds\x11d\x1a\xd5\x94\xc1\xb2q\x01`\x9cZ[\x90X\xb2w\x10\xe8\x8fo\x98\x17\x00\x12\xb9 \x9d0[\xcd<\xccV\x01tV\xc1\x88\xe5q\x1e\x08\x90\xe3)\xcb\x01\x0b\x1c8\xaf\x85\xb3<J.{w\xff\x07\xb6\xd5\xae%nl`\xf5\x99\x19\x9aDh\xa7\xe2:1_n\xd58\x8bLN\xed\xdb\xb2\x08\x8d\x04\x9b9\x9f\x0c\x80\xcah|\x1a\xde\xa4$\xd9\x89i\x1e%'\xd3\xa8O\x06\x00\xd6\xcc8	T\x1f\x14\xbdR\xf6=\x1at\x81\xe6\xe8\x9a\x8e[:LCS\xcf3S\xeay>\x1di\x11\x0f\xfa\xb4\x80\x14q^P\xad8\x0f\x84u\xd1\xa1\xee\xe6\xab	\xd0\xdb\xb2\xc5Z\xbfh~@T\x06\xabD7\xb7\xba\xb8\x0e\xdcuS|\x8d\xe9\xfb\x04b\xd8\x0c\xaa\x9b\xa3q-\xe9;*\xdb\xb7v\x05r\xb5\xabE\xebZ3\xc2\xce]\xd8\x80\x98\x8f\xad\xdfk3\x9bx\xd5\xa5o\x15\xed\x04\xad\xa4:1\x84v\xb1.ks\xcd\xc90\x17\xa4\xd7\xdb&\x9c2\xfa\x89n?\xb8\xb3\xdbU\x92\xf2U\xfe\xc96P\xe7;\xd9]\xce\xdb\xb9\xd7-\xed\x17B\xf3\x1dU*8z+P^\xa8\xc9b\xd7\xf4\xb6v\x8f\x87\xa1pd\xeb[\x99\x0e\x8fxA\xae\xac\x0d;c\xfc\xe0\x0d\xff\xb47\xaf\xc3\xe5\xf1t\xe3\xe2\x8eR4Td\xa0-\x88\xe7@H\xe5\xb3q\xf5u\x0b\xd0\xf1#\x87\xc7\xe5T\x84\x85\x14$\xbcC\xb1\x8c\xc6\x07\x8f:K\x98/A\x81]\x8aXz\x08\xe99\x0c-\x00r\xfe\xdc\xa2\xb7#8\xda\x00\xc6r\xf4\xa6\x98\x0d\xb1+\xa0\x028\xbf\xac_\xa8\x82N\xea\xfd\xae\xfa3\xd9[	1>\xdc\xe8\x17\xf1A\x94F\xe6-\x02P\xc2\xbee\xd6\xae\n=\xf0\xc8\x85[\x90\xea/\x87\xd1\x12\x820\x89>g\xa5\xba\\7I	\x9b\xc2<2\x007\xec\xc1%\xbb~U8\x9b\xdbQ\xf5\x99\x80\x17\xd3\x0c\xcc\x13\xa1M'~\x01r\x8f\x1b\xe6~\x00y;\xc0[\xc7\xad\xd8\x8e\x90\xfe\xaevE&R<\x9e\x87\xbb\x02x\xc0\x85*\x9c\x04'\xb1\xf8\x82\xcc\xeb:\xf9V4:\xed\x81\xe7\xe4' S\x164\xe7w)\x9b\xd0Ace\xedZ\xe0\xe3\xd44\x9f\xe7\x81\x19\xd0\xfa\xebf\xaf\xcf\xf9\x93\x86\xbe\xec\xa5O}\x94[\xff\xe7\x13\xf6\xf9\xffkW\xf1\x94\xe8O?\x03\x02\xff>\xcc\xa3\xc1fN6\x05Q\xfe\xab\xee6(\xab~\xbbA5\xbfwD\xc4\xc4\x13\xf5\x1e\x85\xc0E\x93\xa9\xa2U\xf5WN7\xd0p\x8es\x16f\xf0v\xee&r\x06\xf6\x1e\xdc\xf7\x078\xc6o\x03x\x17M\xd0\\PcA\xaf`S)\x90[#W\"\"\xc7}L\x97~\x13X=\xb2@\x0e\xda`	_`\x80\xce\x13	=\xb1\n\xbd\xd5&\xff\x87\x14\xb0\xd4z6r&\xc9\xd5dB0	\xce@\x0b\xdf`gv3k\xe5\x07\x7f\x80\xc0}\x853\x05\x02\xd89\xb5E\x83\xf5U\x0d:@\x7f\x0d$\x05\x0c\xb8*\x81\xa0IW\x01M\xbaCUpP\x1c\x18@`\x1e\xf7B\xab\xb1\n\xd0\x90P/t\x0d\xacU%oA3^\xb0\x08W\xe8s`\xc0\x04\x8e\x10\x0b\xb8\x13'\xb2\xd6\n\x8ep\x19\xd5'&\xe4\xe7\x7f\"\xc7i,P\x80\x89\xb1\x0e\x99\xa02\xfb5S\x8f\x90SGX_\x92PQ\xa2TUN\xf1o\xa3\xff!\x14p\xf1\x08\xbb\x82\x15>\xc0	@\x92\x1c\xe6\xc0\x02C\x94LO0L\xfd\x95\"\x8b\xb8I]U]\xe1\xe7}}R\x0b\x0d\x90j\x90\x18m\xbb\x90\x05\x1a\xc6\x0d=\xe4\x04-\xa0\x02T\xd88\x9e \x046`\x03\x84u\x16E%E\xff\x14.\xa8UU~\xd4\x8aW\xa4 \xc46}\xda a\x0dB\x8a\xb5\x1856 \x04\xe7\x00\x04<\xa0	\xeat\x14\xb1\xe0R\x12\xf6R\xab@H\xeaF<x\xc5\x0d*!\x04\x0f\xd0m\xee6\x05A\x02T\x10\xb4\x8b\x02R	)\xa0\x89\xc4\"\x04A\x00\x04\xd1\x80\x03\xa10\x0dL`\n&\x98L\x12\xf8Z\xf5s\x18C\x90G\xc6\x08&\x94\xd1\x0d\x84tzj\xb7\x14_\xd7\x0e*\xe0\x023p\x03(\x82\x15\xa1\x08^a\xa4)Y\x80\x02\xbfB\"\x05\xb1\x02\xdfSh\\e\x8aUu\x87\xbf\xb6\x83\x9aqq\xe4\xb8\x80~\xd8\x85\x97W\x7f\x96'FO\xb0V\xcd\xd2\x1e>\xf2\x1eK\x10\x91\x13\x88\x80\x926tZ\xff\xb5%\x12P\x92_R%\xa5pb()k\xcf\xb0\x0c\xf8\x07#\xef\x15\x18\xc5T\x04\xd0P\x1d\x0c\x98\x08\xa9\xa7\x82)\x89SM\x90	\xea\x90\n\x0ffT\xaf\xa057tf\xbb\x10\x05\x11\xc0#\x8f8\x1a\xd70\x0b\x04\xf9zS\xd6\x0dG\x00\x04\x9e y)9\x04q\x86\x13\xb0\xb0#\x8f\xf1\x88\x1e\xe8\x19-\x04n\n6\x0b\xae\x90\x0d-\xe0\x96 \xd9%'\xa5P\x800\x973\xa7\x87\x06\xb6\x08QP\x90\xb4\xc3\x96\x12\xc8\x8b\xdbC\x02N\xe8X`\xa0\x0e~a\x86\xaf\xb3h\xe2\xb63\xaaTA\xad\xf1\x0d&\xe0Q\xc0\xe2\x08\xa6\xf4{`\x06\x83\xda\xf7ou\xa9\x00\x06\xe1\x94U t\xaep\n\xd3 \x04\x9c`\x03/P?\xd80\x0bR\xa0:\x9bi\x8a\xbb\xc99^I\"\xbfA\x97\xa9i>\xeb!,) \x039\x00\x04Fp8\x7f)&\x89\xd8\x8d\x06\xf2_\xc0)\x9c\x0c\xf1\x01\xd6\x99\x04\xd0P\x0d\xb9'\x82\xea\xa6\x84\x05\x12(\xa3\xc9oy\xff\x88\x91\x85\xb9 \x1d\xa0\x03\xbf\"\x8b\xeb\xe0\x91^\xb8\x1e6\xd8|t\xb1&\xe4\xd9\x81\xfd\x83+\xba\xe3\x02\xeb\xb9\x8f\x1c\x95@\x15\x80\x03\xadp\n\xa3p\x89\\\xf8X\xc5X\x97\xa6y\x00\x97Y\x10Op\x06\xf8\xb95:7n&\"\x03\xff4\x1c	\xd3\x12h\x00\x06\xcb5\x9c\x05@|\xf4\xd9L\xb8\xb2\xa0\xf8y\x88`\x86\xa0\x8e\x98G\xc1\xc2\n\xaa0\n\xc6\xa0\xa1\xc0	q\xa3\x01\xa2\x8b\xe0t\xfa	sCV)\x96\x89\x99\xaa\xd2\xa2/J\x06\xaa\xd0\x02\xcc9e5\xda\x93\x83Up\xc50\x9dp\xb1\xa0^\xe0\x043\x80\xa4A\xd9Sc5\x00\xb1P\x0d\xcc\x90\x0cE\xa0b\xf3\x89\x07L\xda\xa0\xecI<AJ0\xef\xb1	\x12\x02\n\xe4`\x0bh\x16\x90\x96\xa2\x15E\xc1{\"\x86\x01\xda\xe2?A\x10B\x87b\xa6\x00`\x0d\xd3\xe2R\x9a\x10\x8c\xda5k^\x13\x89uS\x03FZ\x10\xa5\x90\x1c*\x80	\xb5\x00\x9d\xc6\xb5\xa7\xb8\xa2U\x8f\xf4\xa7\x03\xffp\nu\xc3\x02\xd5\xd0\x9b\xfaU\xa0\xad\xf4\x1e\x02g\x00:\n\x17\x81z}j\x80X@\x89b\x94z+\x98i[\xd4\x91\xa9\x80\xba|\x159\x7f@\x1a{\xa2\x1a\x89\x86\x96\x8a\xa6Z\x0c\x9b\xeaM\xaa\xfac\xa1\xaa8\xb82\x89h!\x0b-\x1a\x9c\x80*\xa8\x83\xf0bS\xa6\xa7\xb8\x1a\\\x01\x13!mP\x0b\xbd\xea\xa2\xbf*\x18^\x00\\\xb8r\x95\xfb\xb9B\x1e*r\x02s\x0d\xcb:\x1a\x9b\xba\x049\xc0Z\xd2:ic`_\xd5\xea\xaaa\xb0|\x89Q\xa6\xbd\xaa\xad\xe3\xd3\x02e\xf8\xad\xdd\x04M\xcc\xd2\x948\xa0\x04Sp\x0b\x9c\xa9f\xb1Z\x0dR\xc0\xae\xbej\x8e\x17\xa8]\xa5D\x02/ r\xc9\x93m\x89\x11\x04\xcb\xca\xa2\xcc\xba\x01~\x07\xb0\xcb0\x8c\xe7\x00\x05\xd8\xc2+o\x19!V\x9a\xb0\x0b\x1b:u\xb9\n\x91I\xa3\x8c\x03\x95\xc3\xd3\x0e_\x89\xb1\xbe\xfao\x9f\na\x7f\xf0\xb1(\x89\xaf$\x0bq\x1ej	\x86\xa6_T \x17\xffjW=\xffhe-\x9bj&R\x94\x00\xab\xb2\xec\x83)X0g9\xdb\xaa\xb8\x82\xa7\x8e\x95\x95\xb5\xa0Q\xb9\x11\x9eIq1\xa6\x80\x03C\xeb,\xba\xa5`1D\x1a\xc1\xa1\x99\xeau\xb32\xc4\x93r\x1a]\xfau\x0b\n\xf4&8\x90\x04\xd8\x98\xa3Q+\xb5\x98$\nR\x84b\xc3\x87\x9ag+\xa4P\n\xb2w$\x04o\xcbi\xaeD\x93\xc2W\xb7v\xcb\x0d\x8c\xc9Q\xe2\xa8\xb7W\x86~l\xab\x00\x80ka\xc1\xc0\xb3\x8e\xf5\xb7\x85\xcb\x81w\xe0\n\x86*\xa2\xe21^\x8b\xcb\xb8v\x10\x98+X\x8d\x96\xbb\x0b\x8f\xc0\xb5\x85\xeb&\x18&?\xb8\x93	>`\xafU\x17\x02s0\xb9\xfb\x97kV\xc4V,`\x90\xd2q\xab\xa8\xeb\xa5\x14\x90k2G\xaeH\x12(\xb1[\x8e\x99+v\xbe*\x02GxP\xb9\xebn\x94\xf5O\xbe\n\x00\x91\xf0z\\`\x01\xc1;V\x9f\xdbQ\x86iXvvU\xcb\xab-o8?\xcf\xf6\xbc\x0f\xe9l\xd3+\xa4!\xe0\xb8\x04\xea\xa1%_$$k\xb5\xbd\xdc\xcb{d\xf8A\x06\xd0\x03\xa6$\xb9\xe4+\xbb\x08\xc8\xbe\xd5Z\x88g\xf1\x92\xed\xcbH\xba\n\xbf\xe8+\xbf\xf8[\xbfh\xab\x02D$\x18l\xaa8\xe9 E\xf4\xcb\xbf\xad\x04\x033\xb9\x086za1d\xc0^JD!@!\xa1p\x9a\x0e\xbc\xc0-\xf0\x825Q\xc1\xb2\x0b\x01\x1a\xdc&\xbd\x1a\x10\x00!\xf9\x04	\x08\x00\xd2\x00,.\x00\x05\x00M\x00\xa3\x00\x00\x08\xff\x00\xa5	\x1cHP\x1a\x80\x82\x08\x13*\\\xc8\xb0\xa1C\x82\x00\x06H\x94\xf8\xb0\xa2\xc5\x8b\x0c#j\xd4\x88#Q\x02\x8c C:\xdc\xa8q\x13\xac)1B\x1c\x14\xc9\xb2%I_x\x96\x80	\x83\x85U\x87\x968A\xd2\x81)\x80'\x04\x99r\xcet\x992(\xa7\xd1\x87>Kn\x11\xa3\xa6\xcd\x9805(\x1c\x9d\x9a\xf0\xa5\xc4\x1dB\xcd\xb0I#F\xcb+\xaa`\x07Z\x05\xb0)\xeb\x9b\xb3B\x1f\x05\nKulY\xb4p\xad\xf4`;\xd5m,2K\xcd\x9a\xa9\"\x80\xaeQ\xb7]t\xc1`\x95W\xeb\x99\"~sN\\\xac\xc2\x87\xae\\$\x12\xb9\x08\x13t\xeb\x13\x0c\x89Y\x8e\x9d\xfc\xd4k\xa5+\xb9\x9e\xca\xf96%\xb3\xc8\xcdk\xe2\xa0}\xd5\x0c\xca\xe3\xa0Z\x9a\x193\x8d\x11\xb5\xea\xdb\xd1\xa2QN\xcd\x06\x0b\x11D\xb4-\xda\xbe\x1d\xf4\xc7\x97\xca[\x9f\x0e\x08\x8et\xf1D\xce\xc4\xd9@YU\x06\xf9\xae1\x94\x98\x8ft.\x11:q0\xce\xba\xe9\xffU#\xab\xa8\xf6\x85\xc3\xa3\xcb\x12u\xdc)o#\xe7\xd1s\x0709z\xe5G$v\xf3\xbe\x82\xa2X|\x84\xe9Ew\xc5:\xaf\xb8\xc7\xdb\x17-\xfcW\xd0f3\xcdd\x1f\x170x\x01\xd7V\xe5)(\x16\x01/\x95 \x9e\x81\xb9\xd8\xd2 \x13B\xc82\x1e'\x99\x11\xd0\xd0X\x03\xf0\xc0\xe1\x19\x9eD\x03\xcb\x16Q,\x12Bu\xd7\x9d\xb5\x84\x05`a\xb8\xd1v\xdca5!\x10\x1bL\x90B+\xb2\xc0BX\x8dgIa\"NIA\x12\x888;|ubR\x11\xbd\xb5UP\xe6@\xc0\x80\x0c\x89,\x13\xca\"\xa0]\xa9\xdaM\x9a-\x96N,Z\xb0B\x86\x84<\x92d%/\xee\x11U\x857\xbf)\x82Ih\x06\xa6\x02\xc1i\x1bU\x12E\x98h\xb5\xb9\x91\x8fp\x8a9\xc2#\xd5qQ\x0b;ZX\x97\x855\x17\xa1\x88\x85\x83\xc8	Z\xd2x\xc9i\xe3\xda\x1a`\xd0 \x89\x84`\x00z\xc8\x1e\x15Q\xb9\x13,\xe1\xf0F\x9c\xa5U\x8aV\xe8\x18\\\x90\xffa\xc5%\xdf\x80Z\xca,p\xb4#E\xacd,J\x92A+A\xa4\x87\x8es`c\x85\xa3a\xb2J\xd6\x9a\x06:h\x0b\x13\"\x9e\x15[%\x84\\\xf0\xc19R,3\xc1\xb6+H\xb1\x8e\x06\x0cD\xd5\x97@VI\xc1\xec\x95\\p\xe2\x86\x1b\xca\xaah]\x8bSH\xd2\x84+L]\xc7\x170\x7f\x90\xe2A3\x97Y\xa1D`O\xd1\xab\xc5o\xe4\xe6\xe1\x137\x94\xde\xe6\x8c2\xeb*\xfbf\x1c\xaf\xb4\x10$7=l\xe3\xaa\x92\x86P0\xa7\xb9\xb6\x9c;\xe1.\xb5\xf4\xf5\x12\xaeH\x86Q$\xc3\xecNI\xacDg\xbef\x06kTXa\xc5\"\xae\xf0\x1aK'\x07\x18s\xc9\x8646e\xdfuJl\xeb\x80\x8e\xa0\x88\xa9\x1a\x18\xf4\xd6\xa2\x1a\xab%0\xb3iPT\xf4\x00\xa8\xcb\x99\xf4a\x87\x02\x9a\xf4\xac\xea\xcfj\x80\x01\xeeb\xff\xaa\x07\xc9hK;\xd4\xf4\x03\xce-\xb1.\xd4@\x18\xdd\xf5\x0d\x15\xec\xd4\x00\xa1\x98\n8\x8d\x96\xc4\xe4\xac\xf1\xabM%\xff\x02p\xc3\xca&qe7$4\x81'.Au\x03M\x01\x08\xec\x91\xc1]\\?\x18A1\x12Y\xa1B\xd1\xd1\xb5\x00\x8d\xc7\xcd\xbd$x\xe2 p\xa5\xaa\x168\xdf\x81\xe1\x08	G\xaeZ7\x12\x08\xe3x\xc9\xc4\xad\xa3u\xca*\x93\xf4y\x1bTh\xe0\xe8&q\x9b\xce\x8c\xb9\x88\xab~\xf4+h\xe7\x0c\xfb\xd1\xeb\x10a\xe4\x95\x9d\xdb>x\xe8\xeb^\xd1D\x0e\x14T?GD\x7f\x0b\xafZ\x10\xc3\\=H\xdd6)\x02\x89\xc5\x80\xd7\xbe\xd1\xediD{\xc5+\x9bH\xd2H6|\x1c\x93\x875\xa1\xb9\xaa\xba*\xd5bXJ\xbd\xd1!1\xc1\x10{\xa9L\xf3\xceg\xb4,\xdcB\x126\x90A\x14f\xf1\x82\xeb\x0d \x1b\xd6\x11\x1e\xfe(\xa7\x08\xc8\xd9'\x18L\xd8\x8a6\x04\x188\x0e\x01\xc1\x04\xa3zA\xa8\x90a\xba;\x90O{\xaa\x99`\x1f\xd4d\x98\xdb\\\x01\x0b\x99p\xc2\x15ZA\x9d\xf2e\xc4*@9\x10\x0f\x101\x01\x19\x84\xa1\x14\x93\xbb\xde\x06\xff\xf5\xa2:(80u\n\xfbF\xa8\"\xe1\xb2\x01n\x84g\xee\xd9B\x08\x9e\x00\x87\xde]M?(\xac\xc6\xf5Z\x10\xc1\xa39\x82+\xcep\xc6\xda\xc2Q\xaa\xf9\xb4Br\xe9+!\xe3\xea\x16\xb9B\xc0d\n]l\x8a\x88l\xe1\x8a(\xc0\xa5\x8cr\xe3\xc9\x19\xb7\xa6\x06\x0b\xc8\xc1Ix\x88\x1f\x07R\xf51\xae\x1dAG\xc7j\xe1wba\x81\xd4\xe1\x91${\x14\xd37t\x07\xc8\xab\x99\x02\x85G\xe3\x92\xe3\xa8\x10G\xc2\xc4B\x1d\x12z\xd5#7B/E\xda\x82\x92C\xabC\x05\x00\x85BM\xde\xa1=\x17<\x8b#&U(+\x8cR#\xdc\x80]$@\x00\x05\x10^\xefN\xaf\xd2\x9e+=F\x9cF\xbeP\x8c\xb5\xbc\xe5Dx\x05\x9bE\xb8\xc0	y\xc3W\x81\x82)\xbc\x06\xfaA\x01c\xfcN\x06\xc2\x10\x03X\xfc)\x99\xca\x1c\x003\xf1\xd2\x0c,$!\x00\xad+\x00+\xb5\xe7\xb5\x89\xc4\x11\x1c\xcd\x10C\xbf\xb8\xb8:\xe1X\x05\x0b\x84,\x83M\xca\xd0\xff\x04\xab]\xcf\x82(\x94\xa2\xd5\xa6YH\xfd\x88c\x03\xde\x10\x8a-\xc3	<Y\x9e\xb1\x9f<\x19\xa7\xf6\xca\x83\xce\x8e\xb9Mr\xa4pJ7\xec\xc9\x9dt\x18\xe8\x9b7:b\xf0P8\x02\x83\x0d\x03\x8a\xaa\x9b\x19W\xa8\xc0Q\xeeH\xd4\x0c\x10u\xddKU7*\xca\xc1R{<X\xcaB\xc3\x89\xd27\xc4\xf4\x0f\xeb\x14^*\x1b0\xd3\xc8\xa1\x89\xa5-]\x0cAE\xf7S\x8bb\xd2z\x13\x18i@\x93\xb0\x8cH\x95\x8b\x881E\x02\xff\xd8)\x88@b\x00\x93.<\xa4UM\xe5\xb3B\xc54l\x98\x9c\x05\x05\xfd\x06\xd6\xea\x885\xa9=\xc1^t\xce\xfa\xce\x9f\x11\xefz_l\xabO\xefP\x1b\xabD\x90\xae\x17\xe5Z\xc80\x84V\xb0\x9e\xb3\xafy\x94+\\\x00\x8bI\xf8a(\xa8\xda\xfb\x84N\xfc\xaa\x17\xc6\x92TnH\x14^\x16\xfa0Y\xee\xdc \x946\xd2Ha\xb5\xc78\xb2\xf4\x94\xb4!\x89+I8Y\x19\xcb\x92\x16&Pp*X\x1f\x90Z\xab\xffL\x02I\xae\x15\xeaNRQ+\xbd&\xa3\xb6/\xc9\xabSrK\xd3\xdd\xda\xef\xa9\xc0%	#@K\xdc6\xeeV\xb6\xb7\xb9\x045\x8b\xc9'\xe56\xca)X\x10\xdahu\xab\x07Ud6\x06\x05\xdd\n2\xca\xe4\x1c\xc7\x80C\x96\xaa\xdcnq\xeb\xa0\x8d\xef*2:\x98X\xd9X}\xc2	J\xa9e\x18\xeau.{\xdd\x1b\xb9\x19\x10\x0b\xb1>\x91\x9a\x83J\x8a\xdf\xba^\x90r\xed\xfd\x19x\xfb\xbb\xa3\xf9n\xa4\n\x8d`\x8aZ\x82\xf00\xee&\xd8>\x0b\xe6\x1a\x0d\x82\x05\xe0\x07c\xa0V%\xcd\xddV\x85\xc7\x8dA\xd0\xe1\xc2\xf0\x0d\x8c}r0\n7Vw#\x98\xc8\xae\x84B\xbc\x80\xa2rm[\xde5PtUL\x1cX0\x01\x084 \xafW\xf1p\xa6D\x1c+2\xb9\x83\xaeP3\xb1+\"\x86#\xc3.DT\x1b\x82\xfcb\x0f\xe7\x87u\xac\x00@aH\xea\x84\x1cc\x98\xc7cr\x10\x95\x93K\xac[\xb0N+<P\x92\x1dg\x0b\xda/\xab\xc7\xffgc\xee\xacs\xc4\xe3\x01g-#\xa1`\xbd\xed\x8av\x1c9\xa4\x92Y#\xe6Z\xc4\xb9\x94\xac\xd7\x14G\xee\x06B~\xf0\x18\x04\x1d\xdeB\x1bZ\xc3\x89\x06\xf4\xa2g\xe7h\x0d;\xf9J\x99\x88tD\xa80\xc9\xebVZu-\xbb\xf1|\x81521h\x80\x16e\xfd\xb4}\x9c0\x0d\x97\xd9GY\x1d(^\xa9{x<U\x07\x05\x07N@RtT\xa1,\x7f\x96k\x92\x11\xe2\xa3\xad\xcbPa\x88\x1d\xb9\xd7(:V\x02\x03\xfb^\xb0.\xd0\x08H|F#r\xe7\x92\xabN\x01\x1e\x87\xfb\xce-\x8e\xcbN\x1b0q\xc4q\x98	\x815M\x00s\xd5B\x84\xf9\xbc\xd29\x14\x91m\x14\xce\x02\xda\xcc~\xc3	\x1a\xdca\x9el{\x0b\xd4\xa8B\x17\xb9\xe7\xe9\x80\xd23\xde\x0c\xa0\xb7\x83%\xcd\xcf\x1a\xbe\xca\x1b\x9a\xa0\x82%R-\xbcI\\2\x9b\xea\xb1J\xbd1\x94\x0d\xd0\xcc\x0b\x8b`\x00\x85\x0e\xc2\xf0\x0e|66\xdd\x85l\x05\xa95\"g\xa5\x82\xd1\xe3p\xff\xa2\xc5 t\xc0\x05J\xac\x03}\xccr\x95-\xcel`2\xc0\xe0/\xf3\xa9\x00\xa8\xc4\xf1\x85\xde\x9a`l\xb2$\x01\xc9z+:\xad\xb8\x8fd\\\x03\xa0\xd7pn\x15w]\xe3\x19\x86\xc323g\xd1\x8cnX\xa1\xa1X\xc8\x81\x0c(1\x85\xd3\x12'\x1d\xb1=@]\x82\xfb\x98-\xd8\xe0\xec1x\x9a\xb4\x04\xda\x0c\x1a\xba\xa2\x1a4\x88F\x11\xb0\xc8\xb5WP\x91\x1bc\xb7nh\x0e\x08\x89\x17P\xba\x0cVg$\x072\x90\x1fpG\x8e\x8adxG\xdeU;\x83De\x81\xd0\x1f\x9b\xda\x9a\x8b\xbeg^4^:8:J\xce\xa5L\xf9\x9aO7\xdeGz\x03\xf14?\x16\x1a\x80Y\xc7\xc3\xbeN\x84\xcd\xb0t\xd2[E\xed\x9f\x1e\x83&\x88\xe0\xea1\x19hI\x8aq\x0b\xaa\xe2\xcdN\x1d\x80\x82\xd0\xaf\xa0T(\\?\xac\x95!\x94\xdb\x86\x15\x02\x9e\x1b\xfd\x86X0\xdd\xf8OL\xe4\xe7#\xd7\n\x1b\xc0\x9e\xa6\xb9G\xd1DB\x00Pv\x9e2\xd7m\xcd>\xff\xf4\x8d\x9f\x8a\xab\xb7\x97\xeeh\xb1\x04\x08\x94\xc1Vak%\xb3{\xaa\xf6K\n\xb6\xfdXO\x8c\xb7\x00\xab\x89\x8f\x85\xe0\x8a\x98U\xfe6\xe0\x17LRBn\xe3\xf7\x12\xdc\x10\x04/@`\x16\xc0}\xc4\x04q\xebb\x00\xb5'K\xf2\xa7}\x89\x15\\O`\x05\x16\x95\x05\x0e\xf83\x0c\xd0}\xaaA\x14$'~\xf2\xa5\x02$X\x82\xd4\x13\x0b\xee\xb2\x817\x96\x08\xe8\xf0o\xa0\xf2+\"\xa8#\xd2P\x02)P\x835\x98\x12\xdd`\x03\x9eG\x1c1\x92\x14\xa7\xd0\x02\xcf\x90]\x8b\xf1|\x0d\xe6W9\xb0\x83\xb7\xc1\n#W|\xf3A|!h\x84\xcd&<\nQ\x81	0*1\xb8\x12\xf3\x11\x066\x90Y\xaa3\x85L\x88?\x0bs\x85K\xa8\x11\x1c\xf7w]X\x15\xc4R\x86\x89\xe1WE\x10-`\x85\x86\xaa\x858X\xb0\x86\xdc\xd1\x15\xab\xf7\x86\x00\xe2=\x12attH\x12p\xb2\x01\xa7'Ty\x18W\xbbA\x87\xccp\x88\xc9\xe0\x00Rv\x08\xfd\x86Z\xc2\xff\xb2\x18L\xb1Q~qUh\xf0tQ(j\x17b&A\xa1\x0d}\xb8\x11\xd7\xf5\n\x06\x87B|\x95\x89\xcb\x14\x14\x85\xd0\x89d\xb8:\x85\x87\\\xa4\xd8\x07\x10t\x1d\n\x80\x8a\x8aU\x1e\\\xc8\x81\xad(N5\xc2aaqOh\xa1;`\xc5\x02\n@\x89\xe1V\"TR\x18:\x07V:\x807z\xb8p\x852|\xb2\xe8\x0b*\x96;\xa8\xd0V%\xe6\x89z1\x8a\x93\xf8\x12/8\x00\x86\xe3l\xda\"\x00'\xe0\x86\x1fh\x1a\xbc\xc8=\xdc\xc8|\x02\xd2\x05\xbc\xd5E\x84@\x8e.\xe5\x0d\x14\xd4\x8dO\x85|\xc3\xe8\x8e$\xa1r\x1a\x81\x0e\x11\xd8F\x1bx3\xb41\x1f\x1d\xe1\x13\x90uc\x10\xa7\x81\x91\x11\x1c\xa6\xe2\x1c\x8dHS\x91\x17\x03\xdaQ\x80\x13\xb1TB\xe5	\x8d\xe2qxw\x1e(r\x88'p\x89\x07V\x08\xa2\xc0\x01F\xa4\x8b\xf1\xc1\x8b\x97\xc5\x84\x16\xf2\x88\x8b\x81r\x12t\x92^\xa8\x90\x97\xf6 ,	\x87O\xd4n\\3\x871\xb9 d\xca\x17P\"\x19\x93\x94\xc5U7\x89\x93\x89\xd5\x80\x98\xf8\x93\xf4gr\xa2H\x94\xb7\xa8ev\xa5kfP\x00HY\x94rsZ?\xb0N\x80\xf0\x94P\xa9X\xc5\xb4J\xb7g\x95ch\x07t\x17\x03\x111w\xa2\xb3\x01\\9\x86H\xa7\x1a\x97\xa0\x11(w\x91V\xc9\x1d\xac\x15]\x1a\x11Og\xb1\nei|\x914&\xc4\xe2#t\xc9\x95=\xa9\x1a\xca\x95$e9\x86p\x80\x06\xb7\xe1Rg\x11\x98\xaa\x15HpT\x98>\xf8\x14\x81Y\x0e\xcc\xc0\x84\xd8\xf0\x1a_ \x98g\x80\x98\xca\x85x6p\x95^\x10\x98cX\x0d\xa1\xb1\x99c\xc8\x03\x9e\xf9\x12\xc0$\x9aE\x99\x1d\x98\x99\x14\xa2\xd0\x05;\xe9\x99I\xa9\x05\xaf	\x9bP\x99\x0c\xd0D\x9b\xf2\xb1\x11\x1d\x81\x9b<\x92\x13\x01\x01\x00!\xf9\x04	\x08\x00s\x00,Y\x00\x02\x00\n\x00\xa5\x00\x00\x08\xff\x00	\xcc\x99\x03`\xa0A\x82\x0b\x12\x1e$h	F\x8f\x82\x07/H\xa9`\xae\xc4\xc0\x82\x00>\xd0\xca \x8d\xc5E\x842\x840x1\xf0CF\x0f2\xb6\x18\x80W\xf2d\xca\x95-=\xa0T\xc9r\x8e\x07\x97`F\xc6\x9c\xd9\xc0\xdc\xce\x17I,H\xdb\xc9\x03\xde\xa7\xa16\xcb}\xf8\xe0\x03\xde\x12\x9fI\x97\xfaP\xc1%\xc7\xc5\x17\x17<\xf8\xc0`\xcb*Ax\x03\xb4\xc2\xb3%\xcd\x83MxR\xc7\x96=+\xb3)\xd9\x0fl\xc5\xbe\x8d\xebv-\xca\xb4Z\xa4\x15\xbc\xabu\x86\x15\xbbh=\xb0\x1a2\x05\xf0R\x1agX\xd8U\xca3\x89\n\x9b	g\xbe@E\"*O/\x8fo\xcaLy\xe2\x0cI\xcd\x92\xcf\xa0\xb0\x9c\x12\xde\x1a\x17\xa4m\xc90\xc3\x92\x05\x8a\xc3[X\x80!\x19xf\x88&\x0c\x06\xb0(p\xd8\n\x87&Jw\x1f\x96\xc2b\xc9k\x17a=l\xa1\xf1\xc4\x89\n\xbe\x1aWGq\x01\x9d\xcb\xbb5I\xa8\xa70\xa9\x9c\x02v\xb4\xaf\xc3r\xff\xf1\x9e\xddC\xf8\xeed\xca\x9f\xb7\xde\x04\nZ\xe4\x12\x94\xa7\xcc\xf2\xbe\xc3R,\xabc\x1d_*\xbf\x8c~\xe8F<\x11\x8bc\xd0\xadVEE\x05\x92\x01\x0b\x82\xb5\xa5\xb4\n\x83\xfc9\x08\xe1f\xb6\x14\xb6\x9fLN\xa4\"J\x14\x17\x1e6A\x1c\xbb\x81\xa5\x19Zo\xbc\x97QX$\x9a8\xa2\x05%\xa2t\xe2 2x\xd0\xa2\x88\x00\xe40\xc49q\xa8\x08\x00+\\,1cFsHq\x85\x8f*\xceQ\x8a\x14D\xbahV\x052\xcc\xc0F\x91\x114\xc9\x0b\x9438I\xa5\x95J\x9e\x85X\x91D\x1c\x81\xa5\x88s \xf1E\x0e\xb0@IB\x0e3\x80\x07\xc0\x00\x90\xa1\xa9&\x9b	\xed\x00\xcf\x9bs`\xd5\x84\x0ct\xea\xb0\x84\x13\xb3\xc9\xd4AI^\x0c\xd1Fm%m\x11\xc4\x93~^%\xc3\x12\x84\x82TC\x0d&\xc8\xd4R\x0c54\xba\x81\x14BT\x9a\xe8\x1c\xd24E\x03u\x92\x06	\x82\x0b2\xbc\x16j\x14#\xf8\xa0\x8a\xa9p\x89\xea\x03\x87\x9bN\xffd\x0e\xac\xa1\xcaJ\xabY\xae\xde:\x10\x15B\xc9\xc2\xea@\xc8\xcd`\xdc\xa6\x0f\x8c\xc4\x83\xa9\xa3\xadI\xc7\x9c\xaf\x85\x07\x12\x0f\xc2\x99\x04\xd2\x15X\xd5\xea\x889M<\x17\xea- \xdcp\xa7\xb3slT\x03}2\xb19G+\x18\xdc\xd0\x05\xa8\xe6r\x10\xcb\x16\xb1\x11\x0b\x83\x0erj6i\x95\x9b&q\xc6\x1b\xba\xce\x91\x84\x16lpx\xd2@+\xe8\xe0E,\xd1^\x95\xc4\x10\x8d\x82\x14\x18Ds\xdc\xc0\xc1\x18\x81\x1d\xf4\xc2-s\x86Z\xd2$\x19\xe3\n,\x11\xf0\xcc@\x82\xc7\x04\xc5 CS$\x07\xe0\x80%\x19G\xd4\xc0H`\xb9\xcc\xa4\n\x10#$\x00\x0d\x8fA\\\x0e\x13S\xd8\xd0\xc2B\x97H2\xc2\x00\xe6\x1a\xb4\xcb\xd1\xdb-D\xc6\xd24\xb4jP\xb1\x0f\xa0\xb5P|\x12H\x1d\x11*O\xf0\xe0\xf4@pt\xbd\xd6A]\x84\xfd\xb5\xd1bomS.\xb9\x8c\x0d(/j\x9f-G\xdb\x13\xbf\x01\xb7\x1aj\xdc0ZDr\xbc]\xd3\xd3q\xc4\x99!\xcd\xdek\xff=\xb5\x18i\x08\x8e\xb7\x18\x86?\xcd\x8a,\x89\x97\x84C:9x$\xb3kS\xdbG@\xcd\x9c\x86@\x05\xe0\x03\xad\xf3\xc6\xa8\x0b\xcd\xb1\xc9f\xa1+q\xc6\x04\x9c\xcf\x81\xce\xe9\xa9W\x80\xc5\x05\xa9SE_\xe8,\xb0\x92c\xe8E4r\xfbB\"\xed~\x10(\x1f\xa6\x8eX.\xc2G\xa1Z\xe8\xa6(!K\xea\xeb\x8c\x02\x04I\x0b\xa9#\xca\x0f\x7f.\x94\x02|\xb4\x83\xb9P-[\xb4=\x87%<x\x9f\x15R\x0b\xa5\x03\x8f\x0f\xa1\x13T\x03\x06\xe9\xd7Q\x8e\x1d\xe9\x7f_4\xd0\xf3G\x94~@\x00!\xf9\x04	\x08\x00\xd2\x00,A\x00\x05\x00M\x00\xa3\x00\x00\x08\xff\x00\xa5	\x1cH\xb0\xa0\xc1\x83\x08\x13*T\x08`\xa1\xc3\x87\x10#2\x1c\x00\x80bC\x89\x183bL\x90\x08\x87\x80\x8a )j\x1cI\x92 \x80\x101\xa6\xc0\xda\x94'd\xc5\x9205v`\x85%\x0c\x98%x|\xe9\xdc\x19\xb3'\xc4AS\xba\x9c\x913\x06\xa7K\x9d>\x93\"\xa4P\xc3f\x1b5b\xb6\xb0<\xaa\xb4*\xc1WZ\xc4\xa4acf\xeb\x0e\x8b`/Z\xf5\x19\xe8\x91\x97\xa1o\xd2n\x9d\x1aV\xec\xd8\x98=\xacp]\xa36-[\x97o{\n\xa8\xd2\x95\xd7\xae\xa1[\xc8\xc4\xfaz\xd4m\xde\x91E\xd0\xa6\xdd\xc2\n\x86\xae.\xd1\xda\xbe<L\x12\xc3\x938s\xc3\xb8HD\"\x97.\x1f*$S&\x19\x94\xe8\x18\xcf\x95\xb0n\x05\xb3Y\xf4h\x89\xc6\x9ae5\x0d\xa5\xd9\xabp}\xd7\xb4n\xfb:\"\"\"5\xebF\x8bv\x1a3\xe6\xdda{?\x1c0;\xf3\x97\x1fd\x88\x1a\xd7-\xd9\xb0\xf2\x83\x94\x8a\xd3%\xba\nJf\xe3\xc8\xc1^\xffg(\x0bj\xeen\xce\xc0\xfc\x9dN\xdd\xf5\xf8\x83F\x1eoW\xf3ET\xf9\xf5\xd3\xc3[|o\xb0\x18\x8a+\xd1\xcdG\x82Y\xe6\xb1\xa7\x9fH\xfc\x11\xd4\xc2\x17\xb9\x11\xf5\xca:\x00\xe2\x97\xd9\x81\xd6\x8d\x07\xc0}~I\x07\x03\x17\x01Jw\x86z\xac\x15V\xa1I\x94q\xd2!f\xb2\x08\xc1\x04\x80`\xd8\xe2\xd9t\xe8\x89\xb8\xd0 ;M\x96\x94\x05K(FT\x08\x8bD\xb1\xc5J\x9e\xe8\xd8\x06\x0f\xd5\x8d8\xd0+;\x88S\x16\x8d<\xc1D\x80\x14gI\x97\x05+\xb0\xc8\xd2J\n\x13l\x00\x04n\x19\x92AX\x8d\x08*T\xc6Y4\xc5\x92Nr\x95E\x99\xd9\"\xa1,\x93\x88\x0c\x0c@`\x0e\x97s\xddU\xa3C\x1e\xc6qE\x14\x95\xa0\x99\x11\x04\xa9l\xf5\x14f\x98(\xc2\x01pUTP\xda\xa0P\xd9	\x12\x9e\xf3}\x88\xc5\x02\x1f\x81i\xe4A\xd6d\x91\xa7\x16\xec\xd4\xc2a\x19\x8f\x8c0f\x9ee|\x89\xd7B\x91\xaa\x05\x0b\x06t\xf0\xb6\xdc!\xea\xe9\xff\x19\xab$4\xc4\n\x856\x82}G\x94\xa36&\xe4\x86\x1bWh\xea\x1c6s\x9cjRC\x94\x12\x00R-\xd1q!E;p\xccR\x8a\x8b^|s\x89\\\x9f\x9e\xc8\xeb\xa5\x02\xfd\xcaI\xb6\xc6I\x11A\x1f~~TC\x9c\xebH\xb1\x02\x04Y.#\xc59\x1f\\@H%\x924'\xcb\x8ag\x81\xc8\xa8\xa9!A\xaa\x8c3\xa3\x1a\x07\x067\x96\n\x84\x01pZ\xb8\x92o\x17JX\xf1\xc8\x13\xcdx@\xca\x1f\xc0l\xc0\xd7z\xae4!\xc9\x14\xd1\x04\xa9#\x91\x85\xf9\xab\x0d\x86\x83\xce\xc2\xdb^\x12\xd6\x95\xab\x14`$j\xc8\x00P\x9a\xb6M\x0f\x04o\xb0 {\xdbB\xca\xac+\xb1N\x07J\xab\xca\xf21\x81\x12=w\x89K\x81a\xc4\xd1\xcd%\xc6\x1c\xd0I,\xab\xb9\xd2\xa3\x15T$r\x9b\x94g\xba\xe7\xab\x9er@\xc2,{J\x14\x10V\xd1\xec\x19m\x97\x02v\xf4\x91I\xd2:^\xd1\x03\x15\xc5\x81\x01\x053%$\x13t\xd0\x90\x8e\x9aH\xc0(R\xffp7\x04\xd3|\x93j\xd9s\x99z\x03\xb8P\x01q\x0b\x1c\xd2\x19\x15R\x07%<\xf4\xeb\\\xd0\xb4\xc0(f\xa0\xa8`E \x16QKx\xd9\xb1d\xb0\x07\x02\x05@\xd3\xcd\xe4\x83j|:QIT'9\xeae\xac\xc3w\x87\x95J\xb0\xfa\xe7e\x8f`@\xd0O7\x98\x06\x08\x18\xb6\x1er\xdeU\xc0B\x04\x84\x83\xef\x92\x93\xb2\xaa\xe1\xce\x9e\x14\xcc\x14\xebG\x05\x9b4\xb7#\xdc\xac\xbb\x9e\xf76\x90\x18\xca\xca\xe7L\x0e\x13\x84\x90\xcewQ\xc1\xe394\x11!\x1a\xc0\xa7\xae=\xaa\xbf\xe2:\xc4\x04Hh7])B?@\x88*\xceO\xa7\x9e5-\xe1C6\x1a!\x89M4\x8fd\xc2\xa3\n\xf1\xa2\xc3\x84`\xa8\xc9\x7f\x86*\x060\xf8\xd7\xbf\xe9d\xc3\"/\x98E\x14d`\x03I,\x8em\x7fI\xa0\xb1\xc4\x14\xbfV\\\xc1	\x99\xc0\xc2\xfa\xa6\xc3\nr\xd5\x81\x82f\xfb\xdc6\xee\xd0\xb9\x10\x1d\xc2\x04[\n\x98\xe3F\xb8\xb5\x1de\xe5\x1b\x04\x02]!\xffh\xe8\x9d\n\x86K\x0f\xd2+\x85f\xb2\xc4\x03\xcf\x85\xd0R\xbd\xea\xa1\x1b\x9c\x01\xb0\xdf\xd9/?B\xab\x06\xa9\xcag\x81\xb4\x89\x0d\x0e\x10\x0bL\x83\xba!/\xadI1\n<C\x03\xc9\x9e\x17\x0c\x9dl\xd1y\x12\x10\x9b\x03\xeeP\x1e\x0d\x08\xca\x82\xcb\xe3\xa1\x14\x1fc\x81Xx\xe3\x810\x92\xde\x11\xb4b\xc4pp \x8f\x90\xe8\x1a\xd2\xe8\xd2\n(\xfa\x02\"\xbaR\x87\x1f\xbfG\xb8\xaa\x8dN\x06 \xac\xa0)\xfc\x96\xb6D\xda\x91N\\i\xe4\xf0\x1c\"\x17\xe3L*:\x1cx\xe3\x17hx\x01L\xa6\xc5\x88W8\x9f\xb2<\xa0\x06\x0d8\xb1+R3cBJ\xe9\x97\xf4\x00\xc8\x02\xaf,\x9b\x0b]Y\xc83\x14\xaaX&\xa8M$\x00Y3\x05\x92rr{\x82E\x0c\xc2\x90\x01\xb2m\x07mtx\x01!\x0b\xf9\n\x8b\x10\xc3	\x9b\xb1\x1e\x17\xda\xa8K\x84\xf0rA\x1a\xb8L3\xc0\x01\xbe\xe9\x1d\xc0\x9a\\\xacX\x00\x92\x80\x05\xd9th\x9c\xa3|\xe6\x876 \xff\x8e;^\xf1\x95\xdd$\x97\x18\x0b\x19:\xe95\x01T\x94\xec\xca\xa4\xf2\xb9\x90\xd5MLX*\x13b\x00\x80\xb1\xc6\xfe\xe1S'\x07mE\x15\xe9\".\x86*\x04{\x03\xe0e\xca\xd8C\xc6\x96\x88\xaa\x98\x17%@\x8e\xf6\xd4\xa1\xac\xb9\xea!\xd8\xe2\xc1\x1b	\xb7J	\x1eb\xa6\x84K\xe9A\xf9\x96R?9\x84\n[\x80\xdaH?\x97\xd2b\xea\x89b\x14\xd9\xa9\x87J\xea\xcc\x87,\x83\x9eF\xc5E\x96(\xf5O\xdc\xd9\x82#iS*@\xe5\xe8S\x87\x08\xe2\x08+4\"\xabr\x82\xb8\xf2!\x01\x10I\xd5\xd5\x14\x04\x01E\xd8\x0crv\xf1l\xd5,\x8c\x1a6\x8c\xea*|`rkD+\xe8\x08\x8b\\\xad\x90u\x15\x80V\x8f*#\xdf$\x01\x94F\x0c\xec\xd7\x00;\x8c\x96\x0cV\x0evk*D>!\x1f\x94\xea\xcf\x0e\xd9\xa0kc\x05\xfb\x1d0LT\xb2\xcb\x81(A\xcb\x18\x01\xcd\xe6\xe4\xb1\xe4\xd4\xa3W\xc9\xd7\xbfnD\xc6\x8d\xc5\x0c\xec`o\xc0\xd5~\xffm\xe4r\xb0\x84B\xd0L\x0b\x00\xadZ\xb2`\x7f\x8a\xeaSR\x81\xc4\x01\xf0V\xab\x93\x18\x17h\x97\x83X#\xaa\xa2\xb8\xc7\xf5P_=\xca\\\xdc\x1a\xe7\x12\x83\xb3\x05q[\x15\xddA1\xc2\x912\xc1\xe94+	\xdd\xd8n68\x9e\x19\xabj\x97r7L\xec\xf5\xbapmY\x1d$hZ\x11\x80\xd0\x07\xe5\x9c\x88\xb2f\xb0\xc8\xe9\x8c\xb7l\xda\x98\xaf \xea\xcb(N\xb4\x156 \xa9UU\xbb\xf0_\xff\x05\x98\xbe\xe6\xbd\xc3I\xd5\xd3\x83\x03\xff\x89\x06\x80\xf4os\xe5\xdb4\xa3n\"\x08\xec\x1aS#\\\xb6\xdcW\x8d\"\x07\xf1m0\x1e\x07\xc1\x0d\xa3\x06\xa2j\xa2\x02\xe2s\xa9\x0b\x11\x1a\x00\x81	\xb0\x80\xab\x8a1\xc3\xe1[r\x11\xc6\\\xa9\xe61\xd7\xfb\x10\x0c\xc3\xe1\x11B\xd50\xe8\xa4\x90\x89O\x8e\x16\xc8\x9b\x93J\x1e\x97G\x12\x0c\xf3\xc2\xb3\xc1$\xd4\x86U\xe1\x04'\xc3\xf2B\x8b8\xdd\x80HLd\x87X\x99>f\xdb1\xea2\xc0\xda\xcfEa\xff\x7f25\x03\x06\xbaq\x8b\xe2\xfe\x8d4\xceSs_&\x01W\xab.\x03\xbd\xb4djW%r\x83<7\x17vFu\xe2\"\xc6\xd0\xd1\x12\x17\xd9\xd0\xc2\x85\xe5\xa2\x1b]\xe6\x85\xac\xcd\xbaZ~o\xa4\x8d\xb6\x01F\xbf/\xb8\x98\xd6B:\xb6\xb9i\xa2v\xfa\xb7\x95\x86\x9f\xae03\x0d'\xe0\xb4\xd4\xb4\xb0#\xaa\xfbe\xdb\x88\xc0\xb0lN\xc0A\x83Jm\xa0	\x88a\xd6\x8f+,C\xcc`\x85\x8a\xeab\x13}\xe6\xf5.`\xf0\x87o\xd4\xe2\x00@\xfb\x9b\x0b\x1d=\x10\xa0\x10\x10tF\x18\xd9\xae\x95\x0d\x03\x1b\x8cas\xd1\x16Q\xa5+\x12\xe3\x9cF\xc2\xdb\x8c3\xea\x07\xc9\x07\x8f\xa0<\x9b\xc63\x02\xc9	4\xcd\x060d{\xd5\\<\xc7\xb6c\x91A6P\xfaN\x7f\n\xc9\x8bpg\xb9\xaa\x9az|\x88\xad\x025\x02Sg\x0b\xffD\xda\x15e\x8f)\xf8\\LKPA\x13\x7f4\xda*v\n\xec(z\xf5\xce\xad\xa07.3\xe9\xbcz\x86A\x07\xa0\xff \x1b7vzAis\xeb!\x8e9\xda\x89 \xd8\x0d\x1f\x9f(	\xeb\xc8\x8e\x0e\x06A\x8b\xcc\x98<\x0d\xb2\xe0\xe4\xa0e\xa2\x9e!\x18\xdc\xdf<\xaa\xac\xa0\x04g\x86Y\x0c(J\x900A\xd2\xbe\xf1\x05qPK\x96\xd2\xd6\xcb\x16\xa00j\xe7uc\n\x94\xe0 z\xa5P\xecf\xccU\xa1\xdd<\x9d\xc6\xae\x11\x1d\x90\xb9\xbc'\xdc\x88\xc5\x13\xfe\xea\xbc\"D\x83\x06\xd5p\x85J\"VM\xd1\xee)\x067\xb0\x81\x0d\xc4(\x02xc\xe4\x1d\xd1y\x02\xd3-\xaa\x95\x01q`\x04\xb1\xa0s\x8a\xbb\xf7\xc1\x85R\xdb7Q\xe0\n\x7f\xf1\x0d\xca\xcc\xd3\xa5\x0b\xeb\xf3\xa7\xcal\x91\x05a\xcd \xbf\x1b\xb9\x9a\xb3\xfa\xfb\xea\x18&OP\xa1\x8ac\xaa!\x82\xe4\xae4\xe2\xe8\xad\x87%\x0d\x8a\xa4\x97m.\xbalD\xd0\x04\xee\x8b\xa9\xdb\xcf\xce\xfe!\xa1\xe8\x12\xddM	\n\x1dl;\xaa+\xa9\xdd\xf1\x1d\x92d\xa1\xf4\xd9\x1bB\x88O\x96\x13\xbd\x81\"\xe9\xa1'\x1f\x98y\xff\xf2\xda\x00\x05\x1b\x84\\\xb8e\x177\xde`RY#v\x99Z\xc3\xdfN\xe8@\xe0\xfdG\x95\x04\x02\x03\x17\xd8\xa1\xaf\xf9/K\xccT\xbbd\xb7]v\xe6r\xebG\x12\xcb\xc7\x15\xae\xb6}\xcaG\x05\xae\xa0\"U\xa2B?\x94\n\x8d\xc4O\x1d@Z\x08\"l\xe1\xb5W\x06\xc0y\xfe\x04\x06<\xd2\x00\x8f\xf7\x02A\xd0L\x8eD\x80\x05\xe8\x1b\xcbBj\xa7\x11:\x1c\x98]V\x001\xa2S\x7f\xea\xf7r\x05a|\x00P}^\xd0\x02\xe8\x90\x08y@r\x15\xd4D\x1a\x90\x03*0\x84D(\x0d\xe2\x16\x13`RO-p\nB\xa7\x13\x9eWL6@F1\x90\x02TX\x85F\xf8vHxY\xea'\x0d	UH(\xa6^\xf6G\x80Nr\x08XUk\x05a}P8\x06]t*Xx\x7f\xceP\x013\xe6B\x08\x81iV\xe5m\xca%\x1eW8}bb_C'\x0dt\x88;{#{x\xd1\x86%\x11\x1c\xd2'\x00\x07\xf1\x874\x95\x18\x82h\x7fo\xff\xd1ir6t\x8a\x98;5\x01\x86a\xf2\x16\x19\xa2G\xc65\x89\x02\x03\x89d\x98W\x87\xb1:/e0\x9cgn\"6G\x90\x13=4\x88gr\xa0	Me\x07\xa5\x98;l\xf7\x06\xff\xe6qJQ\x08\xa6\x11'xH\x8a\xb1hA\xaa\x87zZ\xf7\x17-w\x82\xb0\xd8\x8b\xc6A\x02\x18\x02\x8cHH;\xc6RZ\xc6xT\xc9xy1\xb1>-T\x18,\x90{\xc6\xe1d\x9e%\x8d0\x91|\xafd	 \xe8Ei\xe3|\n\x08>q\xe3pcq\x07m\x13n-&r\xd3\x81\n\x82\x80=\xe6\x03\\\x87\x91c0r\x02\x1f\xf1g\xa2\xe7<M@\x11\x11\xa2\x8c\xbdG8\x81\x82^\xeeG\x11\xe3\xb3\x05\xe8\xf8\x16\x04\xd9 {\xe3z\xa0\xd3\x8f:\xe1\x0d\xdb\xa8\x87$\xf1-o\x84\x7f\xc5\xb4\x83\xb3\x14k\xa3\x98\x17'\xf1|\x18\xe0\x90\x12\xb5\x13\x1e\xd1\x91\x87\xa1f^fQ%\xe8\x88\xbd\xd1\x8e\xc1\xe1	\x0dPL\x01\xe5}\x16B\x01\xb5!\nC\xff\x84\x08\xc5t\x02t\x13Y\x02\xc6\x92\xd7\xe1\x12\xcb0Z\xdc8\x1e\xd8SA\x96\xb7\x8b	r\x10\xd6\x13Wf\xb8\x94\x05\xb1\x90Vex\xfcq\x83\x92\x16n\xb6\x08\x95v`s\x843U\xd1\x06\x95K\x91n|U\x94A\xf9|h@R\x00\xf9\x1e\x05\xb0k\"\x95!WE\x96\xca\x01\x08\x1d\x08\x1d\xa4\"h@\x03\x96\x07a\x0c\xc1\x84\x90\x00\x00W^	\x94x\xc9\x8b[Q\x04:\xb1c\xe2x\x82\x81)\x10\xed\xb8\x0b\x96\x87]\x81$}\x89i\x10\xab\xe0]\x18$!\xc0\x16\x99\x051\x99o\xc0/\xf6#JO\x19\x99\xf7\x91\\`\xf1@X\x07\x98\x89\xa9P\xbah\x11:\x92,\xa6y\x9ac\xb0I\xe1\x86\x1fk5e\x98y\x86\xb1\x84\x17\x0c\x82\x19\xc4\x02\x12tS\x9b\x04\xe1\x05\xddg[\xe8\xf6\x04\xbb\xd3\x87\x81\xe9v\xfb!\x0d\xe8V\x0d?\x99\x95\x89I	wC\x10\xe86d\xc9\xe9\x9b3\xd2\x058	\x97\x91y\x839\xf9\x99\xd6\x99\x97]\xe6\x93\xab\x0dh\x9d\x00P\x92J\xf9\x9d\xfa\x95\x98\x01\x01\x00!\xf9\x04\x05\x08\x00\xef\x00,*\x00\x10\x00w\x00\x99\x00\x00\x08\xff\x00\xdf	\x1cH\xb0\xa0\xc1\x83\x08\x13*\\\xc8\xb0\xa1C\x81\x00\xf0\x0c\x9a\x08\xe0\xa1\xc5\x8b\x183j4\xf8!D#L\xa2\xb0\x81\x88\xb8\xb1\xa4\xc9\x93\x18#N\xb1\x82\xa5L\x18,R0\xf8BI\xb3\xa6M\x01\x8dF\xf0\xe8\x12\xe6\x0c\x1b9l\xb4\x8c\xach\xb3\xa8\xd1\x87\xbe*l\xd3\xa5\x05L\xb8\xa7q\xd2\xec\n3\x03Z\xd2\xa3X\xb3\x1a\xbc4\xea\x0bO1@\xa3\x9a\x99\xaaES\x01\xa2Z\xd3\x1a}\xc4\xaa'\xaf\x9fa\xd5H}S\xf6\x0eI\xb5xMFT\xa6\xa5M\xdc\xbfn\xbd\xe0\xa0s7\xe3\xde\xbci%t\xd26\x16\xf0\x16+\xaf\xda\xe6\xd2e\xb6pJ\x01\x0b\x92\xa2E|\x12\x90\xb1B\x89<\xc5\xf2\x19\x97\x0b\x1a\xa1#%5\xadk\xf9\"\x1dVXr<\xb2\xe1\x19\"\xe7\x8d>\xa0\xc8j	\xb5\xb1S+\xa7~\xa0z\xb5\xba\x8b\xb9\x1fv7\x02\xb8\xf2\xf2%+\x18\x19B\xb4\xc0|\xfbb\xa3\xd1r\xdd\xc0\xdd~m\x91$Z\x81\xdf\x92\xff\x995`\xb3k\xb0\xdb\x1f\xb7t\"\x93@\xf5\x85{e\xa1\x07\x0c\xd4\xdc\x8aYN\xc5\xea'\xdf\x1ai\xfe\xec\x8d\xb9\x01F7T(\xc2@\x7f\xef\xbd\xa3\x02\x14\xd8\xd1\xf7\x14-\x9c4A\x86x\xfaE\xc5\x9fy\xfe\xf5\xe2\xe0dL\xa9R\x02\x82\x9c5\xd0\xc8\x0d[\xa0A\xdav\xbbp\xe1\xcd,\xcfH\xf3\xd5\x1b\x15F%\x8b\x1d 2\xb4\x9cv1\xc28\x97\x17D\x1c\xe8\x9e]\x18&F\x8d-\x1b\x02\x15\x0b	\x8dd\xc3\xdcT\x0e\xceX#|K\x9a\xb1\x83.\x13\xd2w\x85)\xdaP\xb0\xd7UxE\xc4MSEj!\x84\x12\xc4\x99\x08`\x8cN\x06\xd9\xd0\x8d~%\x92\x81\x84E\xb2\x92\x13a\xe5uYC\x99\x14\xea\x87\x85\x15\xadT\xe2M\x95E\xb6\xf1\x8a O*\xc4\xa6\x19E\x94\xf3\x82d\xbd\xe9\x89\x05:\x86\x1c\x96\xd8+\x1c2\xb9\x86\xa5,x\x10\xdd\x16QZ\x8a\xe2\x84\xb7TPhB\x87jq\x82.$T\xd1h\x8c\xec1#\xe9Q8\xed\xffV\xe4\x13=<1\x0bK|\xba2\xca\x94f\xe6\x99E*Z\xaaic\xa7\xf2\xd1\xa2A\x16\x9f:\xfa\x88f\xae9\xa4\x07(\xb0\xfc\xb7*\x81\x19\x90\"\x04&\x8fx4\x81\x05\x89\xac \xad_\x935q\xc9\xab\xe7\xf5\xf6\x15$J,\x91,\xb8\xe1\xd0\x90Y\xa1\xe5\x81\xa6\x08\n[b\xe0\xc1\x87hE\xea\xcc\x9fc\x04\xc8\xee\x91\xd9:\x12\xc2\x0c\xe7\xe8\xc6\x81\x1e\x1bx\xbb\xeaS\x95\x8d\x8a\xd0\xa1\x01F!C\x0f\xc8\xe6(\xde9g	\xfb\x0e\x00O\xb0\xe4E\x17\x12\x0b\xb1\xcaJQ\x9c\xe2L\x0c\x98!\xa0\xc1J\xfdBUb~\xb1\xd0\xc0\xce\x14T~\xd3\xd3\x153\x0c\x82\x8d\xc7\x97\xfa\xda\xb0r\x9d\xa2\x98\x0e\x9e:V\x88\x85\x9b\x85E\xe4G\x01\xf7\xce\xf0\xf1\x99\x80\xe22\x96\x14\x99\x84\x14\x01\x05\x90T\xd1\x8d\x13<\xac` \x10\xde\x80qM&E<\xfd\xd5*\x04\xdfR\xb3\x83\x839\xfcp\xd0q9!\xc9\x08^-<V\x12\x84\xd6Yo+`\x06\xff*\x16v\xb7\xca\xe9]\xa6@b\"\xc53\xbc\x15]\xc5\xbe\x93\xf5\xccv\xbc@O\xab\xeax,\xfakt6W\xb77\x0c\x90\x110\xea\xa9\xc5\xd9\xd9\xac\x05K\x03.\xab\xf2\xd5\xe1\xb5\x11\xcb\x13\xdf\xc11\x06\xdc\x15\xfe\xac\x11\xc4~M\"\x89\x139\xd0rt\xdd\x16\x8f^\xb1%\xc5p\x1e\x82\xac'\x82^\xb4\xbf\xb6<#*1\x0cx\x00\xb7) \x14H\x05\x81T\xb8d\x1a\x8et\xc9n\x18\xdc\x1cH\x01C\x0c\x90\x1c\x9c\x82\xe5\xc7\x17\xadB\xbc\xc2\x0c\xd0\xd1\xb7\xc6\xaf{\xc6\x15s\x03IB\x83<Az\x027\xf1\x97C4\xbb\xda\xa7\xc4},YhB\x81\x14P\x02\xde\xb5\xef|\xc1\xf8\xc5\x1e4\xd3\xb7\xf6\xb5/~u\xc8C\xf3J\xf4\x94+<\xc1\x05>\x88\x05dx\xe0&\x97\x94\xaf\x7f\xe5\xcaS\x08f\xe1A\xfe\x94\xad|9\"\x1c\xf3\xe6\xb0@\xf0\xb4\xcc\x81\xa0\x93X\xfa4\x83\x02\xa2\xf5\xc5\x83\xb2\xb0\xc6\"<\xb8\x1dZ\xb4McP\xc2\xdeT\xff\xe6\xe6\x04\xeb!B\x03E\x80]\x8cL\xb5<\x16\x1a\x00\x04D\x82\xa1\xf1p\x06\x81\x04:1\x06V\x88Z\xd1`1\x02,|\xeb\x08Vq\xdbAh\xc7\x13+D\xe2i\xdd\xb0\x02\x0eh\xa6\xc5%n\xa1\x11\x19K\x19\x07\x8a'E4E\xc3U\xe8\xd3\x04\x14\xcap=\x1eJ\x01\x14,\xa8XXB\xb0\xa5\xc8\xf9\xaa\x1b0\xd8A\x14\xa52	\xf9\xf8M\x0c`0\xc1\x036\x17/\xf2\xd5q;> \x84\x13\x15X\x81\x1f\x1c!<n\x91\xde\xf1\x0e&\xc61*\x11\x0e\x9e\x80\xc6\"\x9dq\x8a\xf9\xb4O(\x07\x08\xc0^^xI=\x8d\x01\x15H@_\x1f4\xa5	 \xd0\x80\x8f\x96:Z\xf5xHJ \x06\xd1W]0\x0d\x14\x18\x11\x02\xecL\x80\x96S\x9c\x84\x15wy\xc3Z\xe6h\x1a\x04\xd8d6R\x90\x93K\x80\xad\x9a\xbbc\xd7\x18\x8aY\x12\x008\xd2}S\xe8\xd6K\x12QM\x07Za[\x94\x9c\x84\x83\xac\x89M\x1a9\x91\x1c\x9c\x9a\x91\x12|0\xff\xb9o,\x92I\xe4,\xe7\xd3R8\x82\xef\xbc\xe5\x19J\xb0\xa4\x1b\x9b\x08\x8ce\xb4\xd1\x9ajP\x8228I#|\x0eh\x81\x80\x10\x05\x10H\xc4C\x80\x92k{\x9f{]\x12\xd4\xb1\x8anDi\x036\xcb\x93\xc5\x8e\x86Q}e\x81}\xd6\x1c\xe0\x0cC\xe0\"\x96\x1a\x02\x04\x90\xa0\x01L\x03j\xc8\x18\xfd\xa0%WP\x11\x95,\xf0\xd0\x1c\xbdQ\x96+|f\xea z\xa1'V\xc0\x1cr\xf8\x06\x17y\x86LX\x96\xd2\x94\x9f\x83\x055\x9c\x00>\xef\x94\xed\x8dFp\x1d\x0c\xa3\x10\xcb\x99R	\xa2\xe2L\x13\x1f$b\x03O\xa4\xe1\x9d\xff4\x1a\x02\xaf\xca\x91\x81N\xc8\x16\x7fh\x84'\xc8\xe4\xc5\xc6\x88\xe3\x97\n\x8dQYi\x04EW\xa2\xf5\x1b\"\xb1g\xfaT9\xa3\xb8\xf6\x10i\xc6\x1c\x16\xf6\xb2` Cl\x02v\xedt E\"\xa8\x01 \xbc\x08\xado!O\xa4\xe2\xc5\x023\xcc(\x8b\x8d\xcbS7\xda\x83\x12\n\xd8\x15:\x1e\x80A`\xe7\x99\xa3\x98\xffP\xd2\x0e\xf2D\xa15\xb9\x00\xa9\x99\xba`O\x1cp\x85\x01\xb7#\xd3\xd6\xf6-K\x1cH\xa9Q\x1f\xf9\xd8@\xc4\xf3\xb3\xa0}\x1fC\xfd\xc0\x88\xd5*\x831\x824\x93m#k#\xeb9#\x17\x14\x8c\xae\xd1\xe0I\x98e\x0cS\xbc\xa6\xdd\xec\xbb\xbc\x11\x85G\\\xe3\xac\xfa\xe9\x06% \xd7\x19\x17\x94\xa1\xa00\x15\xafZ1S	\xf7\xd52\x16\xea\x95%\x18\x84\xa2$q\xa6\x97\xae\xa4\nC\x06T\x91Z\xf4\xfem\x15\x06\xd0LC\xed\xb6\xdb\xe9Z\xc0f\xe3x\xc69z\xe5\x93\xe2\xd2d\x8e|\xc9\xae\x83e\xf4\x87\xe0% \x18&\xe8(h\xb9\xa0I\x13\x13FtF@E\xa3\xb0\xb0\x8d\x94\xd5D\x03\xf7\xcd\xec\x88w\xf1\xce\xdb\x9e\xc0\xb1\x10\xb5\xa9\x84wDGOl@\x01\x08~[r\x99[a	*\x0d\x1a\xf0]\xb1\x1c+\xb9\xa1N8\xe0\xa3\xe5\xec\x11jw\xfc\xb7\x16;\xd1\x12v=\xec\x0e\x0e\xe1\\\xcd@-.V\xae\xd3M\x9a\xd7\xe0\x1d\xc7\"\xff\x8cN.\xadJ\x83\x1c\x1d\xce)\xb7B\xafH3w\x91\xb2\xcb\xd9F\x17\x16>Z\xac\x88\x0f[L\xcc\x109.G\xfe\xcc\x9e\x9d\x15\x81-s\xd9\x0cZ\xa8B\x03\x90\x8a\xdb\x1a\xcc\xb9\xc9'6\xb4\xc5\x86\x92dR]X\xb7\x0e\x06\xf4\x11I\xeb8)\xdfV\xa9(\xd4\x9cQ&\x99\xb8G\xb3aq\xae\xc8fR\xe4,^!G\xe4\xd0\xe8\xd1T\xa7=\xad\xe3\x1d\xc3\x1aa\xb3.5\xa1O\x8d\xeb0\xf4x\xd7\x0f[@\xab\xa5\xb8\xec\x18\x06\x17\xd8}\xa0utm\xfd\xe9\xe3\xd5\xc2\x11\x85\x9c]\xb2\xf9u\xc9ft\x03\x86\x92n\x05\xb4\xa5mj\xf4A\x17~\x99[\xb4A\xb2=\x10?8\xba\x8e\xcax\xb7\xc5\xc2=na\xd3\x99\xd8\x9e2V\xa6\xd5]\x10Y\xfb\xbb\"\xc5\x907\x0c3 p4=\xbb\x0f\x8b\xb57\xa6c\x99\xdb\xe6b\xf92\xf52\xe7\xa5\xdbg\x81o\xbb\xf3\xe0\xc1#\xf7\xb0\xcb\xc3\x82sB\x05\xb9\xfc\xb6\xd1\x95\xf7@\x1c%\x82\xae\xe2B\xff\x9c7\xc6\x81\x01e\xf4\nY\xda\xae\x03yV\xce\xd2\x8c\xe8\xc1\x82\xc29B\xf9\xc5\xc5M\xc9\x96\xeb7\xd1y\xf09i\xba\x83l\xf8H\xc1\xba\xc2m\xf3\xa6-\xde>z\x7f\x06\x05ED/*(p	F\x10\xc0\xe7\x8b\xca$}\xb12\x80-Wb\x12\xd7\x88BQ\xc5\xa2\xf3\xa6c\xdc\x1c\xf9\xbd$^\x8fA\x05\xaf\xf9|\x03\xdb\xe0\xe0\xbb\xb4\xf2\x00\x8b\xf7\xeb\x15\x93\x08\xc2\x12\xee,X\xa6\x1bO\xd2\xb3\xd0\x14\n\x98\xfc\xca\x89\xb4\xfd\xea\xf9\x81\xbb\xd6\x8bn(\xc7\x9ac\x06i\xcfE\xd9\xff.	/\xbcs\xf0\x0e^\xfb\xe1\xed{\xd1mXA\x87\\\xe2z\x1fm9q\xc9\xfb}\x8a\xbd\xd0B\x9f\xc6~I}\xb7\xfd\xa7c@\xfa\xe2\xd3\xf2	\xc3\x82\xbaB\x1c8\xbd\x03W\xef`\xb2\x1e\xe2\x15^)#\x06\xaa\xc0\x05\xb9\xbb\x87\xee@Ny\xdf\x95\x8fz\x85\xefV\x07\x97\xfd\xcfj\xbdh\xfc\xc4$\xbf\xf4h\xc8=\xf3]]\xc7\xc6Ji\xeb\\_\x85\xff\x8a\xb1\x7fP\x8f\xfb\x97\xfb\x97\x86\xeb\xf7\x19\x0f\x9f\xa5\x90\xbfB\x9d\x88\x86\xc9\xd1\xdf>\xf5W_-\x88\x08\xf3R\xf1\xcc\x01\xb4\x87\x94\xfe\xedSc\xdfv\x7f\x93\x121g\x16#7\xb0d\x87\x06\x80\xa0sT\xf21{\xed\xf6oe\x85\x12\xe3c\x06\xd3CB0\x04\x03pr{\x0c\xa8#,E$\xc6\xa7K\x11\xc7x\xe5\xe0\n\xabPP\x91`~\xf3f	\xda@\x0e*\xd8\x81\xfcw	\x7f\x12\x82\xf5B\x10\xb2\xd6ZU\xd4\x17\xf3'\x1eAE\x0b\xce\x90w\xbd\xe6r\x05\xc7\x7f\xc3\xc4	\xef\x12r\"\x17qR\xc0\x81\xc6\xa3\x03\xd5\xa06\\\x06K\xfb\xb3D\xe9\x14|\x04\xb8f#\xf8^QvI\xd0\xb0\n\xcd\xb6b\xb0\xe499\x02=\xa8e\x1ce\x86\x85\xd0\xb6\x19\x84 ~\xa0\x85$|\xb3\x801\x15	Tp$L\x90v\xd0c6W\xa8\x17#\xd8o\x15\xb0\x83\x976`\x8f\xd1:\xb5&\x02!\x96-.\xb20\xe7\x90	\xeb\xc0$7\xc0\x02\xec\xff\x07\x11\xf0R\x0b\x80\x12y'\x87\x01&5zJ\xc7>\xa6R	\xa7\xf0\x046\xb3\x04\xd0\xc0\x02\xfeW4D\xc0	>\x00\x17M`:HXN\x85\xf5R\x0drI\xb3pm\xde\xd1W\xefS1\xc5&\x0b6`\x03B\xe0V\xec\xe2\x03*\xd0\x02Q\x07\x07\xb3\xc0\x0d)p\x0e\xc8\xa2Ad\xf6p\x89\xf1\x00B\xf0\x13\xdcp\x08a\xc5T|\xb2\x0c\xe1\x83S:\x05(P\xa0	<\x00\x04\xe7 \x046\x00X\x1de\x86\xce\x90F\x05\x12=7\x84w\xca\xb8\x8c\x1c0\x04\xe0\xa5N\xe3WG\xc2H\x0e\xa6\xc0\x04\xd3\x10\n8\x10\x0d@\x10\x04B\x00\n\x98\xf0\x02,\x90\x02\xfd\xf5P-\x93&\x89\xa0;-\x93N\xe98s+\x80\x02\xb6Xy\xb6\xb7[\xe8\xa1\n7P\x15*\xd0\x0e\xf6uW\x94(\x17\xb4 \x0b\x90a\x1a}ql\xab\xa8\x1c-\xe0q>\xc8z\xe7GG\x14\xf6\x90\xe4'\x15W\x00\x0d\"p\x02^\x13\x92\xb3\x03\x006\xf0\x04\x01\"'\xfa\xff\x07\x83\xef\x07\x17	\x88>\xd5\x11\x11\xa78\x89\xd7\xb5\x7fLH[Q\xb8\x04\x81v|\xb7\x01\x0dI\x14L\x9a\xd0E\xe4\xb7Z\xcf V\xd0\x84~[\xb0\n\xa5\xd0D\x8f\x88\x14b\xf7-C\xf3H\xb2\x90\n\xea\x90	\x1b(5F	\x86<\xb7\x87\x881\x087\xf7)Y\xc0\x8e\x06\xd6\x80\x9e\x00\x04G\xa0{\xbeF D\xa77\xfe\xb6\x96\x0c\xe6 \x19\x19#C\xd0\x02\xd9\xe0\n\x18\xe8f2\x04Y6\x98\x90E\xa1\x0e\x7f\xb9\x93\x8d\x83$\xde\xd6GU\x89L\x7fC\x05\xd3\x93F\xbe\xb7o\xeb\x06ly!t'\x89s\x95\x884\xd4(:\x964`&u\x82\x89\xa6j\x9e\xa6\x98\xad\xd5\x95\xee\x04]\xccF\x8eR0\x8c\x8d\x90\x02/`\x03\x9c0&\xa7`\x82\xb0P\x05\xcf\x18`\xf9B'\xc9&\x93\x92U\x94%	\x0eE\xe9|w\xe5\x04F\xb0Q2\xc0M\xcb@^UD'\xe6Q\x83	\x02\x00l\xb8\x92\xdb\xc2.\x99g\"\x1dY\x0d\xd0\x807\x1d\xff\xb1\x9a\xc4\xa9\x1c\x96\xe6@/\xd1cD)^\xeb\xa0\x81\x15\xb0\x02:\xd0\x01\x17`(\xac\xd9\x19\xe4\x12\x11\xe1\x85#\x03\x06\x16D0\n\xbb\xd96\n\xf8h\xed\xb9\x0b\xd0\x03~\x9b\xb9\x95\x07\xcan\n\x00\x03Nq+\x97(\x1b\x93 \x03\xe50\x82`\xf6\x99\xb54\xa0O\x10G\xe4\xa9\x8e{	\x11\xa3\xa0\n\xac\xb0Y\x85\x86!\x07P\xa1Q\x88\xa1\x12`\xa0\x89\x89\xa0ctb\xc9 )\x11\xe7,_(^Sg_\x19\xcan?\xa94\xcb\x942\x9d6G\x9f\x83^\\\xc5`7\xca\xa2H\xa1\n\x89\xc2\x0c\xd5\x00`\x08\x97M\xca\x91\x93\xd1\xf5\x043\x10uCZ\x9e\xb3\xe3E\xe4\x00\n\x82\x00\x06\x9b\xd0\xa1\x86\xe1\xa4\xa05\xa002\xa5	\x02\x11\x1fC\x0bA \x06c\x86\xa3\x16\x81\x01^\nQQ\xd0\x8f\x1e4u/J\xa4\x0fq\x9en!	\x12\xe8\x1a\xcd\xf4\xa3h5\x0bO	\x8eW\x00zt\xea,\x85\x99z@wU\x01\x00\x02\xef\xd8\xa7\x98\xff\xa0\x02v\x95\x95\xf5IwQ\x03\x92\xea\x96\xa8\x8b\xcaT\xe0\xe9/\xa7\xe0\x93c*\x10\x8d`)Y@\x02\"\xc8g\x97jM\x10\xe6\x99\x90:\xa8\x19\xe2\x1b#\x10G\x03@\xaa|\nQ\xdb`\x02\xd9\xf5\x0d\xa9J\xa5&\xa1\x82M`\x9d\"\xb7\x9e\xa6:?\x7fq\xab\x9d:\x10\x92\xc8w\xe1\xe3\xaa\xf0Q\x0cA\x88V5\x86\xaa\x9a9\xac\xef\xa0\x85\xe5\x03Y\xa3\xca\x87}\x98\x9ccEjK\xb2\xa9\x91Z\x80\xe5\xb3N\x9e\xc1\xab\x11x^\x8ei<j\x95\x03\xea\"\x06\xc2\n\xad\x1b\x13\x84\xb70\x05J\xf0C#\x08\ntc\xa10\xa4\x0b\xfb\xd5\x02D\xc2\xad\xaaz\x11g\xca\x81\xc03i<\xfa\x02$p_/xX\x08\xf9\xaa\x1b3\x92\xd5 a\xec\n\x11}	j\xb6\xc8\x05\xb2\x00\x05\xc6\x98\x06ot}\xff%i0\xca4@\xf7\xb0\xed\xd6\x0e\xa5\xdaF\xfdb\x16\x1a[K\x10\xf6*1\n\xb2\x9e\xba\xacM\xf8\x07'\x0b\x8f)\xcb\xa4+\xca\xb2\x04\xff\xe1\x08\xc0\x84\xadg\xb0F~VGo\x86T\xebf\xb3\x1ca	\xbc1h9\xa2	\xe4\x1a]\xf2\xa5\xa6B\x9b\x108\x80%*\x08\x16\xf9\xa1\x04\xb5\x80\x03\x94\x12je\xa6fM\x9b\xacH\x96\xb3g\x90F\xb1p\x82\xb4\x91\x19\xe0\x81\xb5@\xb2\xb5z\xba\x014\x90\x04\x00Z/P\x88^\xdb\xa5\xb0h\xeb\x1aXV{\xe5\xda\x80\xa2\xc0\xa9sK\x13\xcdx\x96\xd9\xaa\xa2{[\x12}\xebr\x83\x15\xb8\x1f6\xb8\xd3\xf6\x9b\xb8\x1a\xb8\n\xf0\xaf?\xd7\xad\x86\x0b\x1f\x88\x0bZ\xab\x05\xb8\x91\xeb?\x91\x97\x9f\xa0\x13k\x84q\xb9\x9d\xf1\x08|Z3\xcbT\xb6\xc6\xa3X\x9e\xab\x17!\xa0P\xb7\xe0\x03\x99p\x08I\x1b#\x1ev\xba\xca\xa1\xa8\xf4A\x0b\x8e\x18/\xd7'd\xb2\xcb\x8a(yT[r\xb5F\x15,\x8b\xcb\xb8\x01i$AA\x88w\xb1\x00\xaf[%\x9c\xdb\xaf6[m\x96\x12	\xd9\xa6>JTY\xc3\xbb\xb7\xd4D!W0(\xf7\xf91\xb0;\x9d\xd7\x83;\xb7\xd9\x14e\xaa\x00\x1a\xad\x11\x08\xde\xab\x1fV\x15\xbe\xe2k\x03<D\x0b\x82\xban\xec\xe3\n\x84t \xbb[N\xa4Y\x06=\x10aA\x82\x9d\xc8\xc3S\xf7\xdb\xa5\xe0\xa2\x8ajRX~\x85\x0c\xce\xdb\xb4z :\xaah()\xd5\x05\xbb\n\xb9\x01,\xbfW\x90\x0e\x8a\x89\x01Q\x04F\x96;\xc12\x9a\xb0k\x12\x0bK\x00\x1d4\xcb\xc1\xb3\x13\n\x99\xf1\x10\xcdt\x046F\xc2\xe5d\x1d\xae0\x1dZ\xcb\xc2]B^2\xfc\x93\x13\x1c\x10\x00;\x03\x00PK\x07\x08!/\xf6\xd0\xba\x95\x00\x00\xb3\x95\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cVQo\xdb\xba\x0e~N~\x05a\xa0\xd8\x86\x15\xdd{\xdf\x92&]\xb3\x9b\xa6\xb9\xb1\xb7\xa2\xb8\xbb\x0f\x8a\xc5:Zm\xd1G\x92\x9b\x05\xc3\xfe\xfb\x81$\xcavz0\xe0<\xf9\xe3\xc7O2%\x92\x92~M'\xd93\x99F\xb8\xec\x1a~M'\x93l/\xb4F\x99]C6\x9fm6\xcb\x05\xcc\x9f\xe0\xdd\x85}\x07\xb7\x0f;\xb8\xb0\xe1\xf3=\xbb\xb0\xdf\xb3\xec\xd2\xeb%\xd6\xe8\xe2\x80\xc5r\xbd,\x86\x11\xd1\xaf\x1aQ\xe1b\x10\xad\xeeg\x9f\x97\xf0gi\xde\x92\xaa\xd1\x8c\xc5\xf9\xf6a\xb5^\xee\xde\xca5\x1e\xb7d\x9d]\xe9\xe2`P\x84\x01\x17\x124\x1e\xa1\xf5<(\x0d.x\xae\xe2\xfc\x81\x9di\xb9\xf2!\xd9\x87F9\x0e\xfcB\xc6\x11\xef\xed\x07\x10Z\xc2\x85\x84\x10\x8b\xb7\x89e\xc3\x0c\x7f\x18x.\xecL\x85\xd2\x87\xe7\xe7\xdf>\xe4\x05l\xbf\xee>\x0fK\xf8\xc7>\xda\x83\x90t\x9c\xab\xb4\xfb\xf9\xddl\xf1\xf0\x08\xf3\xd5\xbfIB\\\xe6\x9a\xca\x97\x82\xaa\xaa\x8e\xab*\xeev\xcb\xd9\xc2\xe7\xecl\x97;=\xa4\xf8\xeb\xe6<\xc9q\xb6W\x85G\x94\xb9hp\xb5M\xe1\xe7\xf0p\x0b\xc5\xdd\x12\xf2\xd9\xfd\x12V[x\\\xee\x96\xf0m\xb5|\x1c\xa2\xcb\xa6\x93\xdf\x97\\P\xd6\xd7S\xb0\xda\xba3\xa2\xb6\xa9\xbe\xa48e\xd7\xf0\xbf\xe9\x84\xf1eB6\x9bN&\xff\xf7fv\xa0\xce\xf4\xa2`x:\xf2#Y\xa3t\xe7\xb0\x17\xb2\xe9]\xc97\x16\x93v\x87A\x1b,\x96z<RZ,I\xcb^\xca\xa6w%\xdfH|B1\x84\x1a\x8c\xcb\x1eF\x19oJ(\xb0\xb4	B6J\xfb\xad\x9d\x05\xe0\x87d\xa2\"\xcf\xf8O\xb4C\x0c\xe1\xc36\xc5!\x9a\xf4\xa9\xa1\xceF\xba$\xed\x8c(],j/\xb8a\x06\x98\x1a\xb5\xe9\xfc4d5\xf6$\x88\xba\x86\xfd	V\xdb\xa8\xc3\x9f-\xffx\x19\xd1\x88\x1d~\x11}g?8(\xe9S\x91\xdd\xf9o\x18\x14W\x98\x96\xf7Ch\xe5\xc8\x84\x08\xefQI^\xe5\x8f\xce\xba\x0d\x1d=\xeb!h:FGM\xe5K\xaccF\x81mH\xa2\x11g\xf3|\xfc\x18]\xa9\xff\xae\xa1\x87\x91?j\x8c\xbf\xbdC!a\xf4o\x8b8\xabk? \xc7\xb0\x11\xa9\x13c<\xf9!\xc5b\xe3\x99\x14\x94\x0c\x03\xefB\xb3\xe5N\x95/\xbe\xa8\xb3\xd8|`#\xc1\xdd6,\xa4\xc7}\x97\x8d\xf3\xe13\xd3\xa7\xc1\xb7\xe0R*\xb7\xa6\xca\x8f\xf4\x10\x0e\xca:2\xa7(8Q\xe7\x1d\xef\x9f\xa8\xfb\x90\xfa\xce\x9et\xc9\xb5\x98IeK\xd2\x1a\xcb~\x1f\xd8T\xba\xe2E\x9dtyf$\xa1D\xb6\xa6\xa1\x1d3\xa7\x1aL\x85[\x8a\x1a\xb5\x1c\x95\xfc\x17\xa1\xb9\xe2oq\xcf\xe8\xbe\xef\x82Y\x9b\xd0}\xdf\xea_\xba4\xe2KW3\x9au\x15\xa3\x1c[F\x0f\xa5c\xb4\xa1WF\x0b,\x87\xd6;\"\xbe\xf4q\xe4\xfd\xac\xf7\x94P\xd1\xa5\xd3\xe0\x91\xd76\xc9\x8aC\xc7\xe8\xd6(F\xb9p\xe3N\xedTZ\xed\x9e\x9c\xa3\xc6\xef\xf3<\"\xaf\xcfJ\xa1K\x0ces\x13\x11\xb3N\xd41[7\x0c#_+\x7f*\xb3\xd4{=\x01\x8e\x80\xe7a\x15Y|\x14F\xfb\x8c\\C\xf6D\x9d\x01jQ\x87\x8b	\x8e\xcaw)B\x90I\x10\x9d\xa3F8U\x8a\xba>\x81p)o\xfe\x92\x9d\x930r\xd4\xdf\xfb`G\x01i\x9f\xc8l\xe1\xbf\x81yV5\x16Dka\xaa\xe0\xf168\"\xa8\x03\xc3\x1a\xad\xec!\xb6\xe2m\xc2\xc1S\x11U5\xe6\xa4\xab\xb3uE\x1a,\xa5\xe2RMK\xc6\xdd\x901]\x1b\xee\xc3U \xe0Y\xa8\x1a\xe5\x15\xdc\xfa\xbf\x96\xec\xe6G\x83\x17,8^\x96\xdb\xae,\xd1\xda\xe7\xae\xbe\x82\xe2\x80\xd0\x8a\n\xe3\xceh:\x82\xc1\x9a\xfa\x9b^\xe9WQ+y#ZW\x1eD\x98#2P2\x15~\xf3\x82\xd8\x86cP\xe9\xea\x96|Qg\xffAlA2\x07\xcf\xc4\x9d^\x0b\x1b\"_\xfbo\x7f>\x0d\xef\x0e\xee\xfcx\x0f\x83o\xf9A\x85\xb2 \xae\x9fk\xc8\xfc%\x8d\xd2\xef\x13WW\xd05\xfeT\xfa\xa6\xac\"\x9d\x1e\x0d\xe1\xa0\x82\xd7\xc0\xb1\xa8\xb3\xee^\xb8\xd2_c\xd9VX{$#-x\x1a\x9a\xc0\xa7w\xd1\x10\xd8\x06\x8f\x1c\x15;it\xacl\x08P*g\xc1`IF\xa6\xbc\xb6\xa4\xb4+(\x95\xb1\x7f\x01x\xc6\xc7\x9c\xb8\xe15\xc4w@\x10Yg?E\xfbSQ\xacaU\x14Q\xf8WG\xfc\xd2z\xa2\x0e\x0e\xe2\x15a\x8f\xa8\x81\xf9\xa01(l\xbc\xe0v\x111+\x95\xc1\xd2\xcdO\xf1\x90\xdc\xb1\xfd\xf6\xda\x1at\xc3\xd2\xdfj\xc7\xdb`\xf0\xd9\xa0\x0d\x1b\xb9c\xc8\xf3\xb4u8\xcaw\x01$\x8e\x8cc\xd2#f]g8\xde\x80\"\xdb\xd5h\xd3\xe5\x01\xbb`\x05\x87Eab\xe2\xf2\x88\x98\xb5>\xbd\xcb\x9f\xad\xe2\x17\xef\x9a*\xa5\x81y@v\xf4\xf7\xd2\x86\x9c*C\xab2\x8a\x9en\xdf\xa8\x10a\x1eQ`\xdd\xa1k\xf6Z\xa8\x9a\xcf\x94bd_]q\xa78j\x83\x8fZ\xb6O-\xcb\x19\xf1-\x96N\x81T\x9d}\"\x85\x86\xc1\x1bN\xab4\xe4\xe8KrH\xc7\xd7H\x9c\xa5\xa1k}\xd3\xfa\xfe\x0f\x8a`\x81?\x84x\x8e@l\x0dU\x06m\xd8\xd6\xc8\xa0\xec\xe3\x8f\xc4\x7f;\xec\xe2\xfe1\n\xc3\xdf\x04\xf08\xfe\xfdt\xf2{\xfa\xfb\xef\x01\x00PK\x07\x08sDe\xa42\x05\x00\x00\xfe\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/en_GB/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc;]o$\xb7\x91\xcf\xda_Q\x98\x83Ow\xc0\xac$g\xf3\x10\xec\x83\x01}\xee*\xd6\x975Z\xaf\x0d\xc3\x018\xdd5\xdd\x8c\xd8d/\xc9\xd6h\x1c\xf8\xbf\x1f\xaa\xf8\xd1\xec\x19\xed\xc6vry\xd2t\x15Y,\x92\xf5\xcd\xd2?^\xed\xcdV\xc6vn\xf6\x16\xfe\xf1joovq\xfc\xdd\xec-\xfc\xf4jooov\xa9	%\xbc4\x1az\xa1Q\x81\xc7g?\x9b3\xf2\\{+\xd1\xc1\xcaX\xf0-\xc2Rh\x8dv\xdf\xc1\x85\xc5O\x03j\xaf6p\xec\x1e\xb1\x86\xef\x06tD\xc3\x81\x92\xce\x83\xd05\xc8\x82rgj\xa1f\xaf\xf6\xf6~&\xc2\xb3\x9b\xc5\xc5\xc7\xcc\xc1\x8d\xf1\xb0\x10+\x84\x0bc\xe1\xa3\xb1\x8fq\xf1\x13#l\x0dB)\xb3v\xd0	\x8fV\n5\x07\xdf\n\x0f\xc2\"h\xe3\xc1\xd1<o`\x89\xf0$q\x8d\xb4*\x08X\x1b\xfb\x08\xa8\x9f\xa45\xbaC\xed\xc7\x95\x85Z\x8b\x8d\xbb2\xd5c^\xff\x98A@0\"ub\xbc7]\xe4\x81\x81\xae\xb2F)\xa9\x1bB\xf7\xa2AX\xf2\x18\xc0'\xd4\xb0nQ\x83\x17K\x90\x0eZY\xd7\xa8\x8b\xd5\xb4\xd1\x9bN:\x1c\x17\xcb\x90\xb0\xc0\x99t\xbd\x12\x1b\xda&\xf4\xc6y\xb4\x0e\x84\x830\xd1\x0c\xae\xa0e\xabV>\x15\x94\xc27\xe0s/-\xd6\xe0[\x8b\xa2v\x91\xee\xb7\x88\xfd6\x8a\x08\xd3\x98\xd7F\xab\x0dDz\x0e\xa4v\x1eE\x0df\x055*\xf4\xbc\xd1\x16\xbb\x9d\xa5\xcf\xac\xe9\x17f\xb0\x15\xba\xcc\x05\xc1\x12\xa9\x1adG\xa7\xe3\xe2\x98\xb8C\xa2\x89\xb0\x1a\x94z\xed\xe4/\x08+\xa9\xd0\xd1j<\xda\x01s3\xb8xw\x89T\xdc\xce\x01<\xb4C\xb7\xd4B*\xc7\xb7\xfe\x88\xbd?(X\x1bji\xbe7j\xe8\x8a\x93!\x18<\x05``\"\x8c\xa0Ey\x02II78Y\xb1\xa0>\xc9\x1a\x0d\xd05\xa0u\x13\xda\xde4r5\xd2\xd5\x92\xc4\xb0\x86w\x97\x17\x05[\xb3y\x89e\xa4\x1f\x91\x99\xda\xb2\xb9\x1e\xfc\xc8$}\xc0\x89\xa8\x1e\x1bk\x06]\xc3\xf7\xc4D$\xc5\xb8\xa0o\x19\xcfL\x96\xd4\xc2\x84D\xee3\x94\x8e\xebN\xea\xd7\xae\xc7J\xae$\xd6\xd0a\x87\x0ez\xd9c\x0d\xce[!\x9b\xd6\x83\xd4\xde\xc0\xc6\x0c\xfb\x16\xa13Zzc\x8b\x85H\x0b\xcfI\xc66\xe3b\x04\x0b\xd2\xb5\x01/\xf3)\xdf\x0c\xdd\x12-\x9dr-6\x0e\xd6\xd2\xb7f\xf0\xa0q\xcd\xb2\xed`\x89+c\x11\x040U\xd2\x18\x968\xac\xb7\xd6\xbb\x11\xc5u\x86\xd5\xb4\x18\x97\x11\xe1*\xe9\x84\x886\x13;\x80S\xa1\xa12\xda\x0b\xa9a\xe8IW\xbf>\x02e\xd6h+\xe1\x10\xae\x84\x97\x1a\x14z\xd61cA3\xb7\xe5\x853\xa1\x07\xe9\xd5\xf6\xe2\x9eaau\xc6\xef,?\x077T-i\xd8>\x89\xc9\x00\xff\x0d\xd7B7f\xff\x00>D^\x8e\xa0j\x85\x15\x15\xaf\xaf\x8cn&+\xd7\xe5\xf1\xd6\x9b\xb4\x18>{X\x9az\x93\x16\xa4s\x1c\xa7U\xa2\xf7U+\xf2\xcc\xd3\xf8\x1d/\xdf=\x92^YG\xebW\xa6\xebY\x11\x05\xc4Yl\xd5+\xb4|`^\xb8G\xb2\xdd\x8f\x08\x16\x1bI\xb2\xc1\x86\x9b\xf4#\xa8\"T\x16\x19\xb6\xb3\xfc\x83h\xdc6\x0b\xe0	\x187!\x1af!\xd8\x85\xb0ld\xc1\x195\x10Mw\x00\xb7\x1ai\x12	\x85\x15\xba6\x9d\xda@\xd5\x1a\x87\x9ag\xa0\xa8ZhP\xa3e\x15\x8c\x04\x0e\xe0\x1e?\x0d\xc1\xfe\x99|\xfb\xc2\x83B\xe1<\xbc\x81Z\xaeVhQ{\xa2]\xde5\xdd\xc5\xa22v\xbc\xea\xd3t;\xe0z\xd1\x81cd<I\xed%\x03\xd7\xc8\x1acV\xe4\xd1\xe4jC\xb6R\x8c\xf7JvE\xf0\x1d\x1d\xc0\xf1\xca\xa3\x05|\xae\x10\xebhRA\xc9Nz\xfeE\xf7\x02k\xa9\x14h$\x0bM\xa7\xa3\x9e\x8a\xdb)Y5\xd6b\xe5o\xadD\xed\xc3\x15d\x9e\x03*\x1a^S\x8c\xa0\x99{\xb3{\xe3\xc9&\xd1-\xae\x94\xec\x0b\xc3\x04\xa2\xaa\x8c\xad\xa3W#\x96\xce\x7f\xb8\xbc(I$\x91\x8b\xd7\xc6K\x14L\x0d\xce\x9b\xeet\xb1\xc8\xbc\xc4%?8\xb4\xafk\\I\x8d5\x9c.\x16`\x07\xb2\xf7\xca\x88\x1ak0\x1a\xbc\xe93iTX\xd1u\xfa\x16\xbb\x97\x88?\x98\xa6)\xb4\xf1\x94\xe1D5.\x16\xf0t\x9aD\xb2\x1a\xd1\x99T\x8d+1(_2z\x16@q\xd1H\x88\x18\xa0\x93 >a\xb9\x818o\x1e\xfc\xbb6\x1aI2\x1dzB\xd2qUJN\"\x8b8\xe1J\xe8fg%%t3\x88&-v\x15?\xa1\x17\xd5\xe3\x0b\x8b\x96\xdc;\xda\x93\xa5\xb9#Q7,\",0_\x8c:0\xb6In\x18\xc9\x99\x16\xb4\xa4\x13K\x85\xf7fi\xfc\xa8\xafw\x96\xa2\x18\x0f\x95\x15k\x856\xe9l\x02\x8b\xc1\x9b\xe0\xf4\xd6\xb8t\xd2c\x1e8Z\xbc\xb0\x10\xa0n$\x9d\x92\xae\xf1\x99\xf1+k:\x123t\x8e\xc5\xac\x95.\xda\xea\x1d\xa6Hh\xd8\xce\x8f\x8c\x9d\x05v\xe9\xe8_\x0brc\xd1id34a\x94B\xa6\x0d-f\x06\xedA\xf4\xc2\xfa\xb0>]\xd5>\xcf\xdf\xcfhF\x04:\xba\x19]H\xe1\xack\xa3\xc7\x03\xbf\x90Z\xba\x16\xee\xc8\xf4\x12vov\xaa\x8cC0=\xea-\x83\x8c\xb5\xf4Wf\x14\x80{$%\x03\x02C+\xe9&\x93e\xe7\x00M\x802\x0dI.>\xa1\xdd\x84a\x9d\xa8Y\x103u:iC\xf6\x86L\x9f\xb1\x0e*\xa1\xc1\"\xc5+\xd0\x9au48\xb0\x16\x0e\xfc\xa6/})\x1b\xab\xa5P*\xf3\xf3_\x7f\xa1O\x10\xda\xad\xc7\xbb\xbe\xa2\x88\x9dB\xa3\x00\xcd\xd1~\x1c\xdc\n\xd7\x92\xfb\xe8\x84\xfe\x8c\x97=\xcaS\xc9\xd0\xfc\xe9h\xea\xea\xbc\xf1B\x15W\x8e\x9d\x90\xea\xdc\xda\xcc\xd49\x01\x00\xad5\xd6M\xf5\x9a\x87\xd2\x1d\x05\xe4\x0bD\xae\x85\x1cw\xc7\x84\xe2\xf9\x9e\xd3\x8c@\xe0\x85iw\xc2\x15* \x9c[\x1b[\xef\xce\x84>\xa2^\"a\xac\xcf$xeph\x9f\xd0BO\x98]Z\x05\xf6\x05r\x8ba\xf9\"\xb5\xcf\x13r\xc3\xb26\x9d\x90\xba\xa4\xa6\x1b\xd1 \xa5<\xf7\xe8QO|\xc5y\xc6A-\xbc\x00\x9bG\xc4@\x9db\xb6A{\xa9\xa2\xa6\x89\xca\xcb'\xe97\xe0\xc8!8/\xab\x10\x82\xc7\x98\xed\x00\x8e\xe0\x11\xb1w\xa4c\x1d\xeb\xfdJj\xe9QmJ\x8e\x9e[\xf2Zr\xdc\\\x02\x10z\xc4\x7f\xc1p\xe1s/t}\\\x88\xf19C\xe0X)\xb8\xa4)Il\"\x9c\xa4\x96m\xa0;\x80\x8f\xb8\xec\xe6pwv\xc1\xb2y}\xf7\x86\xffn\xcc`\xc1\xac\x83\x82\xd1\xa6\xf4\xbe\x07\xb1Z\xb17:\x80\x9b\x18\xb1\xa6\x14\x85\x12\x0f\xa1\x9c\xa1\x98W\xe8\x1a'\xf2\xf0\xdcO$\xe1\xb9\xbc~\xfe\x00\xd3\xd3E\x90&p\xf23\xce]!\xd6KQ=\xf2\x85\xe7\xdd]Dh\xb8\xee\xb85\xb2\x90\x90\xc6G\x89\xf2\x06\xea\x98?JM\xb7\xc0\xbe5$\xe9\xc5\"j\x12\x9f\x99A{\xbb\x81\x00\x8d7\x1f\x89\xd0\x96\xd1BU\x0e!\x8fM\xf0\xc28\xae\x8c\xad\xb0\xa6Lvd\x99Ap\x9c\xd3\xd6L\x98\\\x0e\xf9g\xcb\x11\xbc\x9b\x83\xb7\xb2\xafL\x8dd\xb1\xeb\xb0\x91\x97\x16i\x84Rh7\xd7\xa6\x1e\x0d\xf1\xbb\x00\x03\x06\xd2\xa8\xbd\xd9-%\x8f\x8e\xcc 3\x99B@\xb2\x19\xe1\xeeF\xb6\x1bc\xcaX\xe2]\xf8\x0cd\xc2\xc7g\x1cg\xcc\xea\x13\x17\xef\xf9\xf3-\x1c\xc5\xb9\xa7\n\x85\x8d\xa9\xff\xf6Q\xb5\xb2\xc6\x13\xa95\xd6\xe5t\x84\x90\x14\xd7\xecS\x92\xf02\"\xd5\x01H\xa9\x84\x87V<!,\x11\xf5n\xa2D\xa4'u\x14\x9eO\x10:\xcdC\xa1\xd4aI\x98U\xdaQ\x14\xdc`M^;\x97O\xc8\xdcs\xc1$\xfbK\x9e\x0c\x1dz\xc1\xb3\xa6k\xdec5X'\x9fP\x8d\x19K\x861\xeft\x16R7/n+\xd6o\xc8}Q>\xea\x0d\x88\xf2\xe8\xc0&Jj3]\x96\xf3\xedQ\x8cy\xb3c4\xbb%\xc8\x02~Z\xb4f\xfd3,\x07\xef\x8d&\xed\xe8\x95\xa8b\xda\xb6\x9b\x9b\xb7\xc6\xca_(uTt!\xb2\x88\xe0\xdeg\x0c$TX\xea\xcd_\xaaVh\xd0\xc6y\xa1\x1a)Fbr\xbc\xeb+\xd3\xc8\x14\x8a\x17\x03H0\xdf\x1b2\xeei [1`\x18\xb0\x11s\x85]\x8e\x9b\xe2i\xd0[\xa4J\x17\xabLK\xe3\xb7\xe8\xde\x1b\xe3o\x9f\xd0ZY(N o\x8d\xf1`\x12.l\xe3rE\xb6\x10\xd6\x14\xe0x\x03ma\xf4X\x1c\x048\xec\x05\xe5\\\xa0L\xc5\x81W\xc8\x12\x05\x9c\x9e\xdd\xcc\x015Y\x0c2=T\xe0	K\x88\xba\xb6\xe8\x1c\xb4h\xf1\x00\xae\x90d\x18\xbb\xdeoh\x05\n\xd5ix\x0c\x97\xd3\xe0\x038\x7f\x16\x94\xa1\xbe\x85\xfd\xd6\xfb\xde\xbd=<\x8c&\xbc\xc3f\xa8\x04\xf9\x87\xfd\xad\xbdN\xf3\xb7\xb0\xc9\xdf\x92\xbb\x91\x90\x92\x85\x10:\xec\xf5\xdf\x99\xac\xc9n\xe2\x0e.\xbb\xc2\x1d\\v\x13w\xc0\x07<u\x08R+\xa9\xf1BN\xfc\xc9D\x1a\xa2\x8b\x8b\x8eIj\x17\x14\x81r	NsiolY-r\xb5m\x92\xe69\xf4\xb4\xf1\xe2\x18\xfb\xdd8\xe1\xf2\xeeK\xa1\xc1\xe5]0\xdc)2r\xe9@\xff@l ?\xd5c\xd0s\xf9\xdd\xd9I\x14J\x82\x7f!\x1e\xf8\xbb\xe0\x1a\xd5h\x0c\xfe\x9a\x00\xf3\xf23\x87\xfb\x97g\xee\x00\xd2\x18\x8e\x9e\xb9\xe4\x18X\x0d\xbc\x17\x17\xa8\xca\x04.ej\x91\xf2i+tC)\x8eG\xbb\"{\x92\x13\xbbq\xba\xd4\x8f#g\xe7\xcf\x1e\xad\x16\n\x028Jd]\xcf\xc1bg\x9e\x90\xeaP\x1c\xf5c\x1a\xf8\xcd7\xdf\x1c\xfe\x99L\xcb\xe1k\xbf\xe9\xa9\x1a\xc3\xf5\x0b\xaa\xa7\xe65:\xe1*3\x8a\xc8u\xf8\x9cD\xceaH\n\x0f\xb6\xa7\xb2\xaa\xe4\xf9q&\x03\x93\x8aR2\xd1&2\xe5t?\x14%\x93k\xfed\xa7K\xb9p\xa4\xc3u\xff\xb1\xcaA?r\xf2HFVj\xae\xe7\x173\xc9\x98\xad\xa4u\x1e\x9e\xa4\x93\x93\xf5\x9e\x8f\xbd\x17UK!\xedx\xac\xd7\xe2YvC\x07\xa2\xc0\xcd'\x18\x9dK\x91\xa4`\xc9\xed\xd0\xdd/1NK\xee\x87\x92S\x85[\x89\\'\x9e\xdf\xb3\xbd\xc8k\x86\xe3i\x19\x18l\xc3\xd6\x92\x11eV0\xf4\xb1\xda\xb1\x1d\x82t\xe2\xf9\xb2V\xf8 \x8b\xf2f\xe2X\xd6\x91\x89\xb2\xa2z-\xf5\xe0)Tb\xf6\x12\xffT\x03\x0f\xf9a*\xb1\n\x1d\xb2G7O5\xd6%\x92\xceW\x94\xa8\xd6)\x8b\x97\x95PjCq;\xf9|m\xc26\n\xd9\xef\xc4\xf3m\x8f\xfaE\xfer>\xfa;\xf8\xfb\x1d\xdc\x94>\xc1\xac\xe0\xcd\x11ta\xefS\xf6\x16\xf2\x97\x1d\x8bOf\xee\xa5\x0ba\xc4\xeeuP4p}2\xa1\xfaQ\xd6\xbe\xdd\"\xbb&\xd8\x8bt\x03\xe6\x8b\xf7\x8c\xcd\xf0\xf0}&x\x1d>c\xc1\x82|y\xa8p\x86\xa0,\x95\xe7\xabP\xe3g\xfe\xc8K\xd38J\xf2K\x17\x1f\x08/Zc}5\xf8\xff\x8f\x05L}e\x9a]\xa7@!w,	S\xc9\xe2\x0b\x0e\"\x16*\xd2H\x8cO\x88\xbf\xdb9\x8c\x05\x8fq\x9b#h>\x05Lm\xfd8\x8e5\xa64\xf4sJ\x8f\xc6\x976]\x93\x89$\x9f8P\x04\x94\xe0\xa1\xc4'-t\x1a\xe9E\xa4b\x1f2^\x83.\xdf'n\xb6^&\xc8\xa8\xb0]\xa2_\xf9\xed\xa3\x98\x8c\xeb\\qH;\xe3\x843\x01\xb7\xe3E\x9a@\xb5\xa7rpQ\x8c\"\x8d\xcd\xeb\x14\xcb\x18/\xab\x82\xcb\xf0\x19h\xb3\x00\x95\x0f\xb4BA\x87\xce\x91:E\x97AV\xc3\xe7\x9c2\xd6k\xfb\x89\xab\xa3\x15V\xb2\x9aV\xa4\xcf\xd0=R\x16zS \xd3u\xbdC\x0fu\xc4\x97\x93](\xb2~\x1a\x0c\xd5\x82\xe96\xc1mt\xb5\x16\xbej\xa9\xea*\x96\xf4\x8cD5r/\xecd\x8bk\xd2\xa62^\xbf1k\x8808	\x89pt\xdd\x83\xa5\xf8H\x05\x91'\xdb\xe8\x8cn\xc8\xf1\xd8CqH\x0f\x86\xec\x8a1<y\x18\xdf\xd2;\x80\xb7(\xba\xf2\x9cv\xf3\xecB*\x8c\xaaw.\xf6V\x8d\xa1R\xe4\xa4\x98\x10c\xf1\xd3\x17\xdeQR\x0c\x0f\x11	\x0fc\xbe~-\xfa\x9ev`V9\xad3\x94\xd9\xb9-\xef\x9d^Wh\x97t}\xd1\xd0`\xbdS\x865k\x8d\x85\xa2\xdd\x86\xcf(_\xf41U0.\xd5R\xfd\x84\xca\x85\x9c\xa3r\xf4\x1fj\xbd\xc4\x04\xe5{\xb1\\k\xb4\xb7F\xb9\x03\xb8\x1e\x9c\xcf\xa5\xc3\xfcDc4\x16G\x98\x8f*\x1d\xc3\xdd\xe7\xce\xae\xa7\xb2\xe4Ck\xd1\xb5F\x8d\x19W\xb0\xddKe\xaaGnD\xf0y\xc4\xe7\xe2\x83\xf0BD\xc7\xb9\x94\xfcD\xe9\xd7\x94o\xd3y\xf5h+\xec\xfd B\x11\x94\xde\xaa\xa9J\x1a\xed>\x0b\x8a\x18\xd7\xca\xef\xdf\xa9~\x1a\x87\x85\xf6\x04\x8b\x7f\x8f\xc5\xa5\xa3\x10~\x06\x80\x03|\x16\x95\xa7x\xa8j'\xce\x8el\xd1i\xacuO\x13\x1d\xd2\xfd\\\x06\xffM	O\xaeu\x8bl(\xfe\x9d9\x0f\xb1z\xc9\x99K\xc8N\xc6\xdb` \x1b+\xb8\x92\xfaq'\xb7\x8d\x03(:\xc6\x9a\x0d%\x0c\xba\x8e\x19%\x7f\x12\x8a\xe4\xb7R\xb2z<\x80\x8fd(\xe2KF=\x07-\x9ed#(B\"\x0dh\xa7\x94bcC\xc9\xa8\x1d4n\xbd3\xdc\x11,\xeaQ<\xc1\xd8\xb2\x90t+\x17b\xa8d\xd2\xf2\xc5o\xf29\x86r\xf9\x0d\xbfxo-\xf4\x10;2\xd2i\x84\x95\xa6}\x1aq\xa9\x08\xfc\xccZ\xb4\x1b\xf6\xda;\xcbl\x86L}\xa1\x06\x0f\xa8\xe9`R\xd1\xf8\xccpY\xd3=\x8e\x8c\xd9%=)\xe7I\xf7X\x1f\x9e\xa8\x01\xe1al\xfaI\xf5\x06zT%\x11_\x12\x9e\x9a\x82@\xaeh\xb3\x9d\xf0$\xeb\x14z\xc2\xfe\xdf\xec>\x19\xce\xfd\xbf-\x8b\xe4\x9c\xb6M\x85\xb9b\x19\xea/!\xc8|\xf2\xb6\xd3\x97\x92\\\xce\xa7\x17\xe8\xa2<r\x9f\x00\xdbV\xc0\xa2\x12^>M#\xea\xfb\x08\x04\x82:/\xba>]l\xc6\xe486\xa0\xa9\xfap\xf0\x16\xf6\xbf\x86\x96\n\xc3\xa21\x93\xdd\xf4(\xca#\xe3\xcf]Nz\xb5\xb9\x9fd\x0c?\xdd\x13\xecg\xb2u\x01\x91\x82\x96'\x04F\xa5\xf2T\x94]K\x83\x80\x13\xfa\x17\xfd\xadE\xaa%\xec\xc6f\xf7\x0c\xffRL\x16f\xfe\x81 \xcc\x1a\xe3?\xdc_\x8d\x9b\xa7\x12\x12\x01\xd2\xf3r\xf8L\xfc\xb2\xfd\x8b\xed\x18\xf9a\x9e\xcc\xa13\xdd\xb4\xba\n\xbd5\x14\xee\x86\xe6\x84\xb5\xb1\x8f\x85\x9a\xf2\x8b\xf1\xb8&\x7f\x95=a\x8c\x7f\xa1\xfaMp\x8a?\x85\x82\xb5\xd4\xb5Y\x8f$]\x99\xf0.\xc6\x8c\xfe\xcc\xb0V/\x87\x8e\x1e\xc7\x93\"\xa6\x1b)B\x1f\xdeU\xaaSg\xeb[0\xed\x84\x1a\x85d\x81\x15\xa5\xb7\x0c\x8b!\x97P>\x1cE@\xa528\xabX\x0e3c\x83\x03Q\x06z;\xec:\xa4\xe7\x86\xe8C\xb2\xd3\xfc\xf3\x97zI\x9c\x18*\xd4\xc2\x8c\xcc\x10\xe0F\xa4\xee\xa2\x84?\xa8L7\xb9\x93b/\xf4dk\xf4V\xdb\xcfq\x0c\x01\"66\x00E\xaa\xa4kd\xa2\xea1\x13\x18\xdc\x187\x04\xd9\x9b\xe4\xa1\x94\x1eP-\xda\x0cEH\xe7z#U\x19\x8a\x04\x87\xbeH\xe0(\xdal\xd8\xe2\xd8\x9d\xec\xcby\xb1ZM\xdbx\x16\x04\x82\x87\xa2\x8d'Y9~	\xe2\x19\xa1\xcb'\xc9\x12[\x88\x16E]fG\x8en\xed\x1a\xbd\xa0\x07\xb4\x82\xb8\x95}<\xc9.!\x93\xc1\xe1*\xcf\xf9\x0f\x97\x17s\xf8\xe1\xfa\xae\x08.\xd3\xc8y\xa8\xa7\xbe\xbb[@e\xb8T\xc7\xfe\x8c\x06V\xa2CK\x15X\xea|L\x0f\xea\xb9\xb8\xf0\xd7\xbb\xf3ws\xb8\xbby\xc7c?\x9e\x9f\xdc\x85Z\xc7\xc8.\x19\xed\x89\x1d&+\xff\x92\x1d\xa6y\x91p9\x9dz\"\xf2.\x1f\x8an\x89\x05\xf7mpw\xc7V\xe7FpdAp\xae\xc5s\x9e\xcdq\x97PI\xbf\xfe\x95\xde\xb1H\xe2\xa5\xe6\xb1\xc9\xea\xb2\xc8^\xa5\xfeO\xac>\x11\xb9\xdd\xce\xb1\x90u\xd5\xe8*+{vP\xbe\xec%\xdbzk\xf1\xdc\xda\x12\x05\xffeu\x986\xc1du\x08O\x81\xb9^3\x92$\x85<y\xb7\xddM3\xb6\x0eN\xe9\xc5n\x9a\x9e\xc4z9\x8e\xd9\xa2\xf6\x9bK\x89/\x91\xe0\x0c/\x86I\xb7\x9a}b&\xf5\x91p\xf1\xb8\xf9\xb5\x83\xde\x8c\x92\xb59\x9e\x18\x12Q\xe7&5r&4\x11\xeb\xdd\xa9\xe3\xb2\xb8\xec\xa6\xef/\x1fqy\xfdO\x9e_x\xc8\xce\xebK\xf6s\x0e^|\xc3\x89\x11Y\x19\x84\xae[\xe1\x8fu\x19\xb0|\xcc\x10:\x950B\xd0\x88\x83F\x00\xff\xa0\x1edD\xedZ\xe3w\x8c\xf5\x86^\xa4GM\xfb1|\xce\x8b\x8f\xcfXy\xf2\xba\x93\xf7Uj\xbe&\xff\x99\x9c#=\xb2\xb9h^S\xad\x84v\xe3\x82i\x7f\xf1F#\xcd\xad\xc6\xad\x7f\x8d\xf2\xab\xbd_\xe7\xaf\xf6f\xf1\xdd$u\xb1SO\xd6\xec-\x84\xbf\xbcx8$\x82\xad\xa8\xcd\xce\xc4S\x0b\xc8u\xac\xf1%\\\xf8\x8e\xa4\x9d\xb1\x9e\xd8N~gv\xc2\x01A\xba\x92\xd9\x15u\x13\xb2\x14\x8d\xd5\xcfY\xca\xce\n\x10\x8bpx@\x0f\x13/\xc8\xb2\x86\xefW\xac\x853/\xf23\xe6\xec\x1d\xbb\xfb\xf0\xc4?[\xf8M\xf4NI\xcb9R\x8a\xc8X\xf2s\x99\x0cv\xbd\"O\x91\x8ec)\xf4]\xa9\x8a?\x9a\xa1x>\xe6bE\x1d\x9e\x9c\x0e\xbfr\x87T\xe2\xfajl\xf5Y\x19j\xb3\xa7$\xd8\xa2pF\xbf\x8d\x12\xf0#yG\xaa\x98q\xab\"\xdbM$]\xfc\x8a;h\xa5\x86\xafb\xca\x1a\xcfq\x90\x89\x9d\xf0?\x06\xe5\xbf\x170\xc9Y\xac#\x10.\xc5\x13$Yl\xfc\xa0\x13:\xb6\xa8\xc4\xc15\xe5\x92\xb3\xe3\xba\x8e\xdfzC\x07\xfa\xb0\xe9\xf9\xe6\x8f\xf5&\xb8.z)\x89#\xfa`Cf\xc7}\xb2\x16\xb3\xd8S\xce\xd0\xf83\x8cuN6\x9aC\x03\xc6\xf1g\x88\x05\xc2\x80\xa5`a:\x11\x91w>D\xbb\xe8\xb1r\x91\x7f\xec\xbd\x8bmP\x7f:\x1a=\xf2\x9c\xbb\xc0\xe9\xdf\x19\xceO\xaec\xc3;\xf9\x14\xe8b	\xb9\x96\x1d\xf2\xab\x1e\x97\x14\xde\x1c\x1d=\x7f}t4\xcfh\x9a\x91\xcb\xd7\xd4_\xf5\xed	\x9f\x92\xa6,|\xd0\xf5A\xe4'\x95\x1e\x92\x11\xa6\x93\"^\xe4\xb4\n\x12G\x93m\x9d\x9d\xc4C\x89\x0d\xb4\x04\x8a\x15\xa6\x08\xe7'\xae\xa2\x8e\x95\x1e\xbd&U\xacY\xa5\xe8\xf4*\x9a\x9e~F8\n\xf2V\xa1+\"\xc2\x8c^\xc9f\xb0!\xf3fd\x82D\xa77\x1d\xb6@K\xef\xda\x93qE3\xd4\x8c\x83\xef\x82\x18\x7f\x96\x94bt6;K\x81\xd8,D\nyJ\x99\xe0\x97\x03\xf29\xc6\x01|\x8e\xe5\x80X\x87M\xf8>Uag\xf5`S\x1dtv\x96~\xa7\xfe r\x0f\xc4)\x07&1\x97\xc9\xbd?\x84H\x1d?\x113T\x8f\xb7A&/>\x9c~\x0b\xb7\x17\x17\x01\xd1(\xb3\x14\x8a&\xbc\x0b\xbf\x18\xda\n\x97\xf9~/\xa2U\x8d(3\xf0e\xbc\xa7\xbfcs\xc2\xec\xf2,}Q*\xe9Y0.\xd3\xef\x80Q\n\x9b\xb0\xd6e\xf89y\xe5\x9b\xc5\x98\x9b\xd0c\x03\xd6\x8c\x02\xc8$Mt}J\x16\xfd\xadI\xe2\xf2H\xa9\x9b\x1d]\x12;\x9a\xf3\xcf\x14\xe7\xcd\x1fP\x1ce\x1a\xca:\xde\x02uh\xd0\xaf\xc8\x13\xfd\x0e}f\x84\xa0\xcc\x84+\x995>\xc9*\xdd\x9b6\xd1\x88\xd4c}gvcv\xfes%\x8d\xbeG7(~\xbb\xa4Q6~E\xe4\xc2\x8b\x8c*\xba\xec6\x18Y*\xab\xe4\xc4UYU\x0f#\x8c\xaa\xd1\x16k\xdc\xd2\xf7t\x99\xd1o\xcen\xe3\xcf\x00_\xeb\x1b\x93\xabad\xeb\xa1\xe6\xc4\x8a\x1a\xe3\xa8\xc6U\x14\xc5f=\x8a\xc7\xef\xe9_\xac87\x9b\xdd\xa1x\x0c\xffr\x15\x932.\x02\x12\x99\xfc2\xc1\x10\x97@i\xd4`\x9b\xacEw\xf4\xc1JtX\x08-\xb9Y\xc9\x9e\x98\xdd\xa9L\x07\x1f\xe2\x9e\x0bk:\xa2\xc9\x7fc\xb2JG\xff\xdd\x80\xfc\x1f*\xb3\xe05c#V1\xe0\x81\x12\xe2\xd9\x83\x99\xc2\x8c\xf2\xb2'\xc4\x85T\xa1\x93\x85/\x8f\xdc\xa3\x1b\x96T\xb2\x9d\xc7\xff\xbf\xb0\xd1G\xd1\xdb\x0f\xa0\xa6\xff)\xe1\xffX\xa2(\xc8).\x0f\x1f@\x12\xe3{l\x06%\xa8\xba\xdaS+\x0c\x1d\x7f\x14=\x87>\xbcK\xf0\x06\x17\xe8\xe3;B\xe6\xd5_\x05\xd5H\xe8\xa8)\x1c\x07\x16f\xc5\xb5\x82\n\x1co!\xfd*\xa0'\x9c\xfa\xcc\x16\xfc\x01\xcb\xd8\x82\x94\x83\x1c\x9a\xb4\xa0F\x94\x14!/\xa3O\x88\x19D\xb6&1\xf9(-\x8aK\x02\xbb\xc8\xd2\x1a\xe7\x86\xd3b\xda\xf1g\x80ot\x15M\xb9\xc6\x8a\xf8gA\x1f\xd2\xb4\x8d\xae\xb8%\x91\xc6|\xd0\xf2\xd3\x80py\xb7\xef\xe0\xf2\xe1\x01\xfe\x87;P\x11\x0eC\x0f\xf1\xff\xcerVK\xa3s\xc92\xa6}\xccV\xd2\xc90\x92\xe27\x1a\x99\xe33\x1f#\x87\x87\x1c0\x0c:z\xf9\x0f\xfc\x83\xe7\x0d\xcc\x07Ii\x94\xf6\xc8X|\x1e\x9c\xbd\xda\xfb\xf5\xd5\xaf\xaf\xfeo\x00PK\x07\x08\xb5\x10\xe9\xb1(\x14\x00\x00W9\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cV\xdbn\xdb<\x12\xbe\xb6\x9fb  h\x0b\x04\xe9}\xee\xec\xd8i\xdc\xf5im\xb7A\xb1\xdd\x0bZ\x1c\xdbl(\x8e\x96\xa4\xe2\x1aE_d_c\x1f\xa1/\xb6 9\x94\x94\x14\xc5\xff_i\xe6\x9b\x8f\xe4\x0c\xe7@\xfd\x18\x0e\x8a\x03\xd9J\xf8\xe2\x16~\x0c\x07\x83b/\x8cAY\xdcB1\x1e-\x97\xd3	\x8c\xbf\xc0\x9b+\xf7\x06\xeeW\x1b\xb8r\xf1\xf3\xb5\xb8r_\x8b\xe2:\xf0%j\xf4i\xc1d:\x9f\xee\xba\x15\xc9\xae*q\xc4IG\x9a-F\x1f\xa6\xf0g\xea\xb6&\xa5\xd1\xf6\xc9\xdb\xf5j6\x9fn^\xd3\x0d\x9e\xd7\xe4\xbc\x9b\x99\xdd\xc9\xa2\x88\x0b\xae$\x18<C\x1dpP\x06|\xb4\xdc\xa4\xfd#:2r\x16\\r\xabJyv\xfcJ\xa6\x15o\xdd;\x10F\xc2\x95\x84\xe8K\xd0\x89i\xdd\x0e\x7fX\xf8\x92\xd8\xd8#\xca\xe0^\xd8\x7f\xbd\xda\xee`\xfdi\xf3\xa1\x0b\xe1\xb7{t'!\xe9<V\xf9\xf6\xb7\x0f\xa3\xc9\xea\x11\xc6\xb3\xbf\x93\x84\x14\xe6\x9c\xca\xa7\x1d\x1d\x8f:E\xb5{\xd8LG\x93\x90\xb3\x17\xb7\xdc\x98.\xc5\x9f\x96/\x93\x9cv{VxF\xb9\x15\x15\xce\xd6\xd9\xfd-\xac\xeea\xf70\x85\xedh1\x85\xd9\x1a\x1e\xa7\x9b)|\x9eM\x1f;\xef\x8a\xe1\xe0\xe75\x17\x94\x0b\xf5\x14\xb5Z7Vh\x97\xebK\x8aKq\x0b\xff\x1a\x0eX\xbe\xce\x92+\x86\x83\xc1\xbf\x83Z\x9c\xa8\xb1-)*\x01Nx\x8fV)\xd3xl\x89\xac\x06S\xb6\xf5\xc9d\xfc\xa9\xe3F\x8d\xa9A\xee1\x1d\x96ddKe5\x98\xb2\xadG\xbe\xa0\xe8\\\x8d\xcau+&\x1a_J,\xb0|	BV\xca\x84\xab\x1dE!,)\xc4\x91\x02\x12>I\x8f>\xc4\x0f\xeb\x94\x96\x98_\xff3\xaabVI\xc6[Q\xfaT\xd3\xc1~\xc7\x080\xd4\xeb\xd2\xf1\xa5KjjI\x10Z\xc3\xfe\x02\xb3u\xe2\xe1\xf7\x9a\xcf\x1dU\xb5V\xc2\xf6\xe1\xee\x8ci\xa4\xbd8\xe1\xa4dHE\xf1\x10\xbeqQ\x8a0\x87\xf7M\x18\xe5\xc9F\x17\x17\xa8$\xfb\xff\xadq~I\xe7\x80\x8a\x13Y\x01\x95r96M\xe5S*e\x96\"Z\x91D+\xda\xad\xb2\x96\x8c\xb9	o\xa1\x15\x13~6\x98\xce\x1e\x93\xb0\x12VA\xe7\xc6C\x1ci\x1dV,\xc8y+,x\x92\xc4\xf7\xe6Nt\xee\x99\x18L#*,\xe1i\x95p\x1f{o\xebU\xf9\x14j\xbcH\xbd\x08.\x01\xdc|]P\xad\xdc6]??!SmZBGN\xa5\xf2s:\x86\x95A\x84\x93r\x9e\xec%\x11.\xd4\x04\xc3\xdb]\xf3.w\xa1\xbb\x98\x92+\xb3\x90\xca\x95d\x0c\x96\xed\x85\xb0\xaa\xcc\x91c\xba\x98\xf2\x85\x92\x89\x12Y\x1b\xc6\xe6,\xbc\xaa0\x97q)4\x1a\xd9k\x80\xa9\xc9\xfdw\x8f{\x96\x16mO\x8c\xf6YZ\xb4\x8d\xff\xb11\xad\xa4Y\x1aq\x0b\x0c\x8a-\xd6,\xadJ\xcf\xd2\x92\x9eY\x9a\xa8\xb2k\xc43\xe2S\xeb\xc7\x84*\xe6\xcc\x1b\xf3\x9b\x1f\x0b\x95\xbd\xfc\xd8d\xe9s\x8bm\xc5\xbe\xdf\xb7\x8d\xca\xd1\xee\xc9{\xaa\xc25\x8f\xf6\xe2\x1b\xd7h)L\x89\xb1z\xee\xa2\xc4\x87\x14\xa5\xf0B\xa7l\xdd\xb1\x98p\xad\xc2\x90\xbe\xeb\x96\x05\x00<\x01\xef\xc4,r\xf8(\xac	)\xb9\x85\xe2\x0b5\x16\xa8F\x13\xdf)8\xab\xd0\xb5\x08\x91&A4\x9e*\xe1U)\xb4\xbe\x80\xf09q\xe1\xcd\x8d\xe5\xde\xeb\xf7}\xd4\x13\x81L\xc8d1\xabj\xb2\x1e\\S\x96\xe8\xdc\xa1\xd1\xfa\x06v'\x84Z\x1c1\x1de\xe8\x0c\x165\xb5/\xe9Ai\xdc\x11\xcd\x85=\xc6-\x82\x0e\x9e\x08tD\xe2\xf6\x07e\x94;\xf1S\x84\xb6RF\xe4\xa6?\x12\x1d5n)\x05w\x17Z\x02ja\x05$\x83\xb0\xa0E\xbc\x0fE<=T\xf4\xf1\x8e\xacmj\xdfs\xfa \x94Fy\x03\xf7\xc1\x81\x92\xcd\xbd\x15\x93?\xc5\xf8\x97!*\xf3,\xb4\x92w\xa2\xf6\xe5I\xc4=\x12\x02%C\xf1\x98'\xc4:\x8eRe\x8e\xf7\x14:\xa1\xf8\x07b\x0d\x9218\xe4\xd1\xa4\x85\x8b\x9e\xff\xfa\xaf\xf6\xaa\xca\x03&\xc4\xde\xfd\xbe\xf0\xc4H\xcf9\x04[\xc7B\xb9\xa3q[\x82k<\nI 4\x1c\xc8\xe4{\xad\xc2\\\xfd\xac\x9c\"\x93\x7f=\xe2\xa8\x85\xe7\x881\xa9q~!|\x19\x1e\xc3b-\x9c;\x93\x95\x0e\x02\x0cU\xc4\xf3\xdfU\xe7\xd7\xb2\xc1g\x82\x07\xa5\xf9 C\xbdi\xb4$@\xa9\xbc\x03\x8b%Y\x99\x07GM\xca\xf8\x1d\xe5\xea\x0f>\x07$\x94z\xc6\xba\x7f*~I\"\xc9y\xf7>\xe9\xefw\xbby\"\xfd\xa7!\xfeW{\x10\x0e\x9c\x92\x04\xa5\xf2mAY\x14.\xbd\x8e\x9b$\xc5E\x16\xa5\xb2X\xfa\xf1%\x8d\xd4\x0d\xeb\xaf\x1f\xbd\x8e\xd7E\xfc\x9a\x9bR\x92\xf7=Xt\xf1\xfe6,2^\xeb8\xf87\xe8\xea\x06\x9d\xe7\x1a\xb1\x18\x8a/\x19\x82\x94g\x84E\xdfX\xf6\xfah\xd1\xb5x\xa31\xbeU\x9b(\xf0+%lJ\xd9\xb8qef:t!\xb1\xd3\xef\xb5\xe2?\xe69\x1d\x95\x01\xc6\x01\xd9\xd0\xbeeK\xf2\xaa\x8c-\xcbR\xb24\xfbJE\x07\xb7I\x8a\xa8?5\xd5\xde\x08\xa5y\x08\xedz\xfa\xcd\x0d\xb7\x89\xa7:\xd8F\xd6\xaa=\x87\xeb/5\xaf@WZ\xb5W\xd8\x16hc\xf2T\xc8\xf5\xf9\x85\x1a8\x89g\x04a\xa0\xb3\xc61\x97_\xccs(\xca.3\x9f\x12\xf0\"#M\x1d\x86S\x18\x02\x91\x115\x08C\x89\xf7\x88\xc0\xdaR\xb8\xe5x\xb3	A\xd9\xc6\x91\x80\x7f6\xd8\xa4{d).\x7f\xe5\xc0c\xff\xf8\xe1\xe0\xe7\xf0\xe7\xff\x07\x00PK\x07\x08\xa3\x91\xb1\x86h\x05\x00\x00F\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/es_ES/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc[Oo$\xb7\xb1?k?Ea\x1e\xfc\x16\x08d\xed&\xce!\xd8C\x00iWZ+Yie\x8d\xecM\x10$\x00\xa7\xbbf\x86\x16\x9bl\x93l\x8d\xc6A>L\x8e\xef\xf8\x90\xdb\xbb\xee\x17{\xf8\x15\xc9n\xb6\xa4ul\xbf\xe4\x9d4],\x16\x8bd\xb1\xfe\xeb\xaf\xcf\x0e\x16k\xe7\xbb\xb0xE\x7f}vp\xb08;\xfej\xf1\x8a\xfe\xf4\xec\xe0\xe0`qn1\xa4\xa2v\x96ze\xd9P\xe4\xfb\xb88\x94\xc1S\x1b\xbd\xe6@k\xe7)n\x99V\xcaZ\xf6\xcf\x03\x9dy\xfen`\x1b\xcd\x9e\x8e\xc3-\xb7\xf4\xd5\xc0\x014\x02\x19\x1d\")\xdb\x92\xae(w\xaeUf\xf1\xec\xe0\xe0\xcf \xbc\xb8\\\x9e}\x189\xb8t\x91\x96j\xcdt\xe6<}p\xfe6/~\xe2\x94oI\x19\xe3v\x81:\x15\xd9ke\x0e)nU$\xe5\x99\xac\x8b\x140/:Z1\xddi\xde1V%E;\xe7o\x89\xed\x9d\xf6\xcevl\xe3\xb4\xb22;\xb5\x0f\xef\\s;\xae\xbf\xd4\xdc\xf5\x9eie\xdcw\x03+O\x8a\x8c\xa2^\xf9\xc8\xd8\x03{\xed|a)\xa1Ph\xbc3F\xdb\xcdS\xb8\xd4r\"\xb0\xd1V\x91\xb6\x8d\x19\x82\xa3fP\xb6u2\xc0!\xaa\x8f\xff\xad\x08\x7f\x89C\xe3l\xab[U\xf1h\x9d\xddw:\xf0\xc8\xe2\xb1uVw\xfa{U\x18\xb9\xc0q{E\xd1\xb5.\x90q\x81z\x17\"\xfb@\x8d\xeb\x1c)\xfb\xf1\x1fVw\xae\xa2\xe9\x9b\xad\xbe\xab(\xa6o\xe2\xfb^{n)n=\xab6d\xf2\xbfg\xee\x1f\x0e\x91\n\x04\x9c\xcf\x9d5{\xca\xf4\x02i\x1b\"\xab\x96\xdc\x9aZ6\x1cq(q\xcb\xdd\xa3\xa5\xdfx\xd7/\xdd\xe0\x1b\x0e#\x17\x80\x15R-\xe9Nm\x98B\xc6I\x9c\xbc\x01M\xa6\xf5`\xcc\xe7A\x7f\xcf\xb4\xd6\x86\x03V\x13\xec@\xc2\xcd\x10\xf2\xcd\x17Ry;Gt\xb3\x1d\xba\x95U\xda\x04\x91\x99[\xee\xe3Q\xc5\xda\xd0j\xf7\x8d3CW\x9d\x0c`t\x97\x80\x89\x89\x84\x81Ee\x02d\xac\x1b\x82nD\xcc\xeft\xcb\x8ez\xa3\xf6\xecCM:\xba\x8d^\x8fdGF \x1eo\xcf\xcfHY\xdd\xa9\xd6\xe5%\x8e\xf1E\xb1 \x85\x8c5\xd1[m.\x868q\x89\x0f:Q\xcd\xed\xc6\xbb\xc1\xb6\xf4\x0d\xb8\xc8\xb4d,=\xd7q\\\xb8\xac\xa9\xa5	\x85\xdc'(\x1d\xb7\x9d\xb6\x9f\x87\x9e\x1b\xbd\xd6\xdcR\xc7\x1d\x07\xeau\xcf-\x85\xe8\x95\xdel#i\x1b\x1d\xed\xdd\xf0\xdc3u\xce\xea\xe8|\xb5\x10\x1e\xf1)\x84l?-\x06X\x12\xaf=E=\x1e\xf3\xe5\xd0\xad\xd8\xe3\x98[\xb5\x0f\xb4\xd3q\xeb\x86H\x96w\"\xdd\x81V\xbcv\x9eI\x91P%\x8dS\x82x\xb4\x0f\xd6\xbbT\xd5}\xa6\xd5\xac\x9a\x96Q\xe9.qB\xa0-\xc4\x8e\xe8\xb5\xb2\xd48\x1b\x95\xb64\xf4P*\xbf|I\xc6\xed\xd87*0\xbdSQ[2\x1c\xe5\x959OV\xb8\x0d\x954	\xa1\x1b\x1d\xcd\xc3\xc5\xa3\xc0\x80s\xb0\x90\xf1G\xcb\x1fR\x18\x9a-\x9e\xd8sH\xc2@\xffI\x17\xcan\xdc\xf3#\xfa:\xf3\xf2\x92\x9a\xad\xf2\xaa\x91\xf5\x8d\xb3\x9b\xd9\xcam}\xbc\xed\xbe,\xc6\xf7\x91V\xae\xdd\x97\x05q\x8e\xd3\xb4F\xf5\xb1\xd9\xaaq\xe6\xeb\xfc\x9d/?\xdc\xe2a\xf9\x80\xf5\x1b\xd7\xf58jR\x94g\x89Qh\xd8\xcb\x81E\x15n\xa1\xfao\x99<o4dC\xf4>\x1eHz\x8b\xd4x\x16\xd8\xa3\xe5o\xd4&<d\x81\"\x80y\x13j#,$\xc5\x90\x96\xcd,\x04g\x06\xd0\x0cG\xf4\xde2&A(<4mg\xf6\xd4l]`+3X5[\xda\xb0e\xaf\"\xb7e\x0fGt\xcd\xdf\x0dI\x01\xba\xf1\xf6U$\xc3*D\xfa\x82Z\xbd^\xb3g\x1bA\xbb\xbek\xdc\xc5\xb2q~\xba\xea\xd7\xe5v(\xf4\xaa\xa3 \x83\xf9$m\xd4\x02\xdc\xb1\xbc\x18\xb7\x86A\xd4\xeb=\x94\xa5\x9a\xee\x15\x8aE\xc9\x1d\x1d\xd1\xf1:\xb2'\xbeo\x98\xdb\xacS\xc9\xe8NG\xf9\x85{\xa1\x9d6\x86,CE\xe3t\xcc]u;5\xab\xce{n\xe2{\xaf\xd9\xc6t\x05#\xcfi(k^Wa`\xe6\xc1\xe2\xdaE\x15Ynqmt_\xeb'\xd54\xce'\xce\x9c\xb0t\xfa\x87\xf3\xb3\x9aD\x11\xb9|m\xb2D\xc5\xd4\x10\xa2\xeb^/\x97#/y\xc9\xaf\x03\xfb\xcf[^k\xcb-\xbd^.\xc9\x0fP\xf8\xc6\xa9\x96[r\x96\xa2\xebG\xd2l\xb8\xc1u\xc6-wO\x11\xbfq\x9bM\xf5\x1a_\x0b\x1cT\xf3bi\x1c\xa7	\x92\xcd4<\x92jy\xad\x06\x13kF\xdf$P^4\x13\x02\x03\x10R\xf0I\xab=\xe5y\x87\xb4\xdb\xb2%\xeb,C2\x03G\x0c\xe2\xb8\x1a\xa3g\x8eI\x9e\xf0N\xd9\xcd\xa3\x95\x8c\xb2\x9bAm\xcab\xef\xf2'\xf5\xaa\xb9}b\xd1\x9a\xfb\x80=y\xcc\x9d\x88\x86a\x99a\x89\xf9\n\xeb\xc8\xf9\x0d\xad>\xfeO\xf8n\xe0V\xc1\x08\xe9\xee\xe3\xdf\xf1n*\xd3\xd6\xea\xa0V\x86\xaf\xdd\xca\xc5\xe9\xe5^y\xbe\xc3Ci\xbc\xda\x19\x98\xc2\xc3\x19X\x0d\xd1\xc1\x89ki\xc7\xab\xa0#\x8f\x88\x93\xee\x0b\x0c\x03Nl7\x1a\xe7e[\xbe\x97\xf1\xb5w\x1d\x04\x8eC\x10\x81\xdb\xea\x90\xb5\xf6#\xa6 >\xa2\xf1'\xc6\xde$vq	\x9f+\x18\xb4l>F\x854c4\x90\xb2{,\xe6\x06\x1bI\xc1\xb5K\xeb\xe3\xd2\x9e\xcb\xfc\xe7\xe3\xb0\x0c$:v3\x19\x93\xfa\xac\x9c\xad\xe4O\xb3\xf7\xe9\x81/\x0ek\x08\x1b\x01\x92Zi\xf6\xb1\xb2\xd3\xdc\xea\xf8\xceM\x12q\xcdxu\x040m5\xae\xb6\xa8zq\xd9\x14\x19\xb7\x81(\xf3\x1d\xfb}B\xebT+\x92\xe9z\xb6\xb2\n\x0e\xdcA\x01A\x17:\xb8\x8c\xca\x92gx0\xb4u\xbb\xac\x81h\xa7\x02\xc5}_\x1bW\xd1^+e\xcc\xc8\xcf\x7f\xfc\x06\x9f\xa4l\xd8MW\xfe\x0e\x11\x00\x9c\xa5\x04\x1d\xa3\x87\x8c\xbcUa\x0b{\xd2)\xfb	\xb3\xfbr\x9c\n\xcd\xf3\xab\x97s\xdb\x17]T\xa6\xbay\xee\x946\xa7\xde\x8fL\x9d\x02@\xa7\xde;\x1f\xe6\x0f]PqU,\x83O\x10\xb9Pz\xda\x9d\x10\xca\xe7+\xe4\x12\x81'\xa6]\xa9P\xbd\x04\x15\xc2\xce\xf9\xf6\xf1L\xea\xf3\xd0S$\x9c\x8f#	Y\x99\x02\xfb;\xf6\xd4c\xe41\xadj\xf4	r\xcba\xf5$\xb5O\x13\n\xc3\xaau\x9d\xd2\xb6\xa6f7j\xc3\x08\xa1\xae9\xb2\x9d\x19\x8f\xd3q\x8cZ\x15\x15\xf9\x11#\xbb\xeep\xe2\x06\x1b\xb5\xc9\x0fN5Q\xdf\xe9\xb8\xa7\x00\x0b\x11\xa2n\x92S\x9e\x9d\xb8#zI\xb7\xcc}\xc0S\xeb\xe4\xf9\xaf\xb5\xd5\x91\xcd\xbe\xe6\xe8~\x0b3\xa6\xa7\xcd\x15\x00\x86\xa7\xf1\x1f\xa5\xc9\xf8\xbeW\xb6=\xae\x04\xfaT \xda#\xb2R\x81\x8c\n\xd5\xbc|t\x82\xc23\x0c%*\xf2\x88>\xf0\xaa;\xa4\xab7g\xb4\xa7\x8b\xab/hOq\xa0\xde\xbb^;y{da\xa9-\xa957Q\xb5.\x1c\xd1\xe5\xc0w9~\x0b\x14U\xb7\xd2\x1f\xff\xcb\nR\xe2N\x03\xab\xde\x7f?\x13\x14\xf9\x1c\xe3\xc2\xf2I\xaeo\xb4\xb3\x1cH\xe5\xa0\xa8R*k\xe6v\xa5\x9a[\x11\x8bq\xe7g\x19\x9a\x84\"\xd3\x83:\xa5\x82\x9f\xe5.:ju\x10u\xa1-\xeeJLrJ\x0dT\x8b\x98\x99[\xe7\x06\x1b\xfd\x9e\x124\xcbG&\x82\x9d\xb3\xa7\xa6F\x81\xa1\x07\xbc\xba\xab\xb5\xf3\x0d\xb7\x88\x84'\x96\x05D\x80\xed;7\x94\xc7^\x14\xbe8Ip\xfc\xc3!E\xaf\xfb\xc6\xb58\x10\xdb\xa6\x8d<\xb5\xc8F\x19\xc3~\x7f\xe1\xdaIk\xbfM0\x12 \xb0\x0e\x16\xef\x11t\x06(Ka\xb2x\x8e\xd0,\xe2\xe7Tlo\x9c\xab]\x90\xb7\xe9\x13C\xe5\xe3\x9f\xda\xdb\xadn[\x9ev}\x9a3\x06\xee\x15\xbd\xcc\x84\xde\xe9\xae\xd7\xcagnJJ\xc1Ullu\xcb'\xdaZnG:_\xea\x96)\xc5\xd7-]a\x1f\x99\x9a\x0c@S'r\x92p\xd9\xaa;\xa6\x15\xb3}\x1cr\x81\xf4,\xa1#\xf3\x01\xc1\x01\xbfP\xc6\xbc\xa8	\x8b.\x80\xa4o6\xdc\xc2\xea\x8fy\x1c\xd8	\xc9\xdc\x8c\xf6V&S\xc7Q\xc9\xac\xf9\x9a\xd7\xdc\x0c>\xe8;6S\xec3\xc2\x84w\xdajx\xa8On+'\x92`\xf7\x10\xd9FG\n\xe8m6\x92\xe4\x0b%\xb3\x9f/+\x81\xfc$\xd9\xb2\xd9\xc9/~ \xdb\x8a\xfe\xb4\xdc\xba\xdd\x9fi5\xc4\xe8,\x1eLoT\x93\x03\xc0q\xd2\xb4\x80\xf3\xfa{\x04\xa1\x06\x17\xa2+_0\x7f\xd3\x84\x91\x97\xbat!*\xb3\xd1\"=_\xfc\xa6\xd9\xaa*\xc4\xd2\xd3u\xbfs\x1b]\xfc\xfa\n\x01\xe2\xfa\xa5\x83a(\x88\xa2\xdf\x02<\xf86\xbb\xed\x96\x94\x98.\xe5\xe1\xa9x\x15?\xfe\xa3P*\x89\xa8\xc1*\xea=\xdf\xe90(\xa3\xbfW\x8d\xfe\xf8\x0f\x9b3a\x0fh<X\xfc\xda\xb9\xf8\xfe\x8e\xbd\xd7\xd5\x9b;\xc7\x14\xf2\xceEre\x0c\x1b:X\x9c\xaf\x91k\xa0\x9d\x0e[\\\xda\xd6\x85\x1c\xbe\x84\xec&R\xe0^!\xca#\xe3\x1aq\xf0R\\\xaa\xe8\xf5\x9b\xcbCb\x0be\x03\xad\x85\x9cRZB\xb5\xad\xe7\x10h\xcb\x9e\x8f\xe8\x1dC\xd6\xb9\xeb\xe3\x1e+ 8\x00zv\xd0\x0b\xf2\x11\x9d\xde+\xc4\xc4\xaf\xe8\xf96\xc6>\xbcz\xf1B6\x1a\x8e:\xde\x0c\x8d\x82\x01z\xfe`\xaf\xf3\x881m\xf2\xc7D\x8b}\xbe|e\xd3^\xff\x95\xe1\xa1\xeef\x06\xe5\xbc\x9b\x19\x94\xf3\xee\xa1Ai9\xb4\xfc\xd8\xa8hk\xb4\xe53=3MI\x8e\xf2\xcd\x89\\\xe5,\x9f\xe4\x0d\xd3\xcbA\x18#\x1166)\x0e\x9fg\xc9\xf4\xcd\"\xcc\xc0\x11\xe2_\xad\xd7?\xf6H\xce\xaf~\xc8	9\xbfJ\xca\xbf\xf8`\xa1\x9c\xec\xcf\xf0B\xf4w\xed\xe4^\x9d\x7f\xf5\xe6$\xef\x11\xf0\x1f\xe5y|\xab$Q6\xe9\x91\xdf\x15\xc0a\xfd9F\x1a\xe7o\xc2\x11\x15\x1c\xf1\xd8%\xf1\x99\x98N\xbb\xa8\xee\xd4\xd4Q\xe4y\xab]W\x92:\xaf\xe1`(R\xc8lHb\x03W!\x08\x15sF\xdb\xdb\x89\xb3\xd3\xfb\xc8\xde*C	\x9c\x85\xb4m\x0f\xc9s\xe7\xee\x18\xc90\x894\xb8 \xfe\xf6\xb7\xbf}\xf1k(\xa2\x17\x9f\xc7}\x8f\x94\x90\xac\xd5\xd4v\xb1S\xa1q\x93\xb0\\\xa4\xcf\x99\xb7\x9eP\x8a\xb3\xf1p\xaa\xbc\x9eq~\x9e)\xc0\xf2j\x11\xc0l\x0b\x99zz\x1c\xaa\xbc\xcd\x85|\x8a	G@\x9e\xe9H\xedbJ\xb5\xe0\xc7\x18\xb7B?k+5\x89j&\x8c\xddZ\xfb\x10\xe9N\x07=[\xef\xfe8F\xd5l\xe1FO\xc7z\xa1\xeeu7t\xa4\xaa\xb1\xc3\xd9\x88\x1d\xf3\xa1\x92\xf4\xce\x16\x0bw\xbf\xe2<\xadX.\xc4\xc5\xe6az\xafS\xf7_\x8a\n\x19\xd7L\xc7\xb3\x15`R\x17\x0f\x96\xccCnMC\x9fS.\x0f\x1d\x9aN\xdd\x9f\xb7\x86ot\x95c-\x1c\xeb63Q\xa7u/\xb4\x1d f9\xae\xcc\xfc#\x13\x9fb\xd2\x92\xe7E\xdc\x0d9\n\x87%\xd1\xbbb\xbc\xfe\xc68\xa4\xf7s\x02A7\xca\x98=b\x05\xb8\x0b\xd6\xa5mT\xb2\xdf\xa9\xfb\xf7=\xdb'\xf9\x1bc\xe0\x9f\xc0\xdfO\xe0\xa66\x13nM_\xbc\xa4.\xed}\xce\xdeR\x7f\xff\xc8\x08@\xe1=u!2\xf0\xf8:\xe0H\\\x9c\xcc\xa8~\xd0m\xdc> \xbb\x03\xecI\xbai\xe4\x07\xef\x997\xc3\xcd7#\xc1\x8b\xf4\x99s%\xf0\xdbS\x9a5\xf9s\xa5F\xd0\xa4B\x83\xf0\x07\xc3\x0d<D\n\\Y\xfdDx\xb9u>6C\xfcw,\xe0\xdawn\xf3\xd8<\xc0\x81\xcfyi\xa4I~\xc0T\xe4\xe4H\xc1\xe4\\\x06\xfd\xc9fbJ\xb2L\xdb\x9c@\x87s\xc0\\\xd7Ox\xf2bjE\x7f\x88`k,\xf8\xc1b\xb6\x88\xa6\xedf\x80ST\xe0)\xcf\xa8=u\x96Q\x96i\xc4\x86L\xd7`\xeb\"\xc9\xe5\x83\xf2\x08\x94\x8a\xe8%\xfc\x1a\x0b0\xd5d\xde\x8dY\x8e\xb2\xb3K\xa0\x15\xe0C?\x13\x13\xa0\x9cFdD\xbdu&\xecx\x85\x92\xee\x04\x9d\x96rQ7\x15\xa7\xe93M\x12!\xaa\x0b\xcd\xcaP\xc7!\xe0Ie\xb3\x01\xcd\x11\xc7(5'\x8e\xfbY.\x1a+\xacu3O\x8d_f`\x0e\xa3[FX\xe5a~u\xa9\xb3]s\xa3W\xc8+~\x1a\xb3\x94}\xd9s\xa0F#\xe4'G\x83\xa5\xb0\xb7\xcdN\xc5f\x9b\xaa\xc0\x8a\xfa\x01e\xb4\x16\xe6\xa5c\x8bJou\x02\xbb+\xa3\xf6u4p\xcd\xbdw\xed\xd0$7[5qP\x86NR\x08\x9eCB\x14jl\x1a\xf7\x19\x1b\x99\x85\xd6\x05&\xb5u\xc8:Z\xf2/\xd4\x0b\x94;\x1d1\"\x9f=9T\x95\xf3\x81\xa6\xc9\xc1\xe1f\xd8\xa0\xe0\xc7\xaa#\xb6\xf8H\xe1>\xf8e\xdb\xe8\xae\xae78\xd3>\x92\x8d\xf7\xa6\xfd\xb4l\x14\x0f\xff\xf5\x13\xf5\xa0\x12\x19P\x1e\xa4\x9b)\x81p\xa1\xfa\x1e\x06\xc2\xad\xc7\xa0\xd2!\xae\x0c\x0f\x1c\x80R%\x82\x85\xc6\xedg]\xc5\xed\xa3$\xb2\xdbY\xae\xde\xea\xfb\xf4\x99c~|\xcc\xdf\xa8$\x9a)MJ\x11\xb2\xc4\x14)S\x0d&\x10m\xe6d\xb3\xb3\xd1;\x13\x8e\xe8b\x08q\xccx\x8e\xa5&g\xb9:\xc2\xf1\xa8\xca1\\}\xea]\xf5\xc8\xa6\xdel=\x87\xad3S\xb0\x97\xd4\xff\xca\xb8\xe6V\xfa1\xe2\x88\xf1)\x17#U\xbap\x9c+-\xa5\xd6\xb8C\xb4\x8f\xf3\xea\xd97\xdc\x8b\x88a5\x14\xdd\x91\xdc\xcd\xa6C\x14\x90\x9a\xd6\x1a\x0b\xf9%\xed\x9b\xd1R\x97\x86\xe7o\xa5d\x03\x03.\x1el\x02\x04\xe2{\xd5D\xb8T\xcdvf/\xa1\"^\xe7L\xfd<|\x82>\x19\x93\xf8?*\x8c\x1a3\xf5j\xd4i\xff\xcaH\n\xac\x9eK\x18\x94B\x9d\xe96\x04(9\x16z\xa7\xed-\x8d!\xf6\xe2\xb0F\x80\x83\xcd\xadhE\x1al\x9b\xe3T\xf9\xc4\x10\xe4\xb71\xba\xb9=\xa2\x0f\xa8*\xe5:L{HV\xdd\xe9\x8d\x82\x93\x95\x8bq5\xa5\xdc\xa1Q3\xea\x07\xcb\x0f\xaa$W\x80\xe5w\x94\xb9\xca\xbd\x17\xe5m\x8di $l\xb6r\xf1\xfb\xc96\xc8u_J\xe5\xfe\xc1B7\xb9\xb5\xa4\x9cFZ)wh\xcc\x97\xca\xc0O\xac\x85\x83\x10\xc3\xffh\x99\xfd0R_\x9a!\x12[\x1cL\x89a\xdf8\xfb<\x92\n\xb7\x13c~\x85\xd2\xf88\xe9\x9a\xdb\x17'f`\xba\x99z\x9fJz\x12\xc5a\x88\xf8\n\xe3\xe8\x8d\"\xbd\xc6f;\x15!\xeb\xf0^\xe9\xf9_\xfcs\x84A\xcf\xff\xb2\xaaB~l\x1b\x99\xc2j\x194\xca\x002OT\xf6\xb5$\xd7\xf3QI\xaf23\xd7\x05\xf0P\x0bx6*\xea\xbb\xb9S~\x1a\xa2\xeaz\x85&	\x8a\xe8qr\x94\xf1T9\xf6\x19\xca\x1c\x03\xf9\x8d\xa3W\xf4\xfc\x97\xc88)R\xd1\x7f\xfc{\x98m\xaegU\x9f\xa0|>f\xac7\xfb\xebY\x0c\xf2\xa7k\x0e\xbd\x83t\xff\x19\xf5*\xa4\xad<O]\x07t10\xd2/(\x1a \xcfD#\xfa\x0c{\xd6d5\xe3\xca\xf9'j\x15\xd7\x02\xff!\x97\xcf\x0b\xc6\xcf\xf0\xf1\x90A\xfa\xfa\xfa\xddt\x12HZ\x01PJ\xe8\xe9\xb3\xb8\x1e\xa2\x1bs\xcb\xc9\xd8|\x80\xb7\x13\\\x97\xd3m\xa5\x0e\xda{\x07o:5`\xec\x9c\xbf\xad\x9e\xb0T\xc5\xa75\xe5+\xf7\xa8\x81v\xae\x9a?N\xd5\x03\x0e\xf7V\x19\xdai\xdb\xba\xddD2\xd4\xf1\xf4r*7\xbfq\xf2\xe2WC\x87\x06\x80\xf2H\x8b\xa6\xa9\xbc*\xd9UI\xaa\x8f\x9a\xb9b:(3I\xcc\x92\x1bD\xcf\x02\xcb\xde\x9c21\x1dE\x1a*9{y~\xa3\x17\x9b\x9b8@\x99P\x0e\xed:\xb6\x88S\x93}\x19\x0d\xea\xaf\x7f\xa8_&\xa8\xa1a\xab\xdc\xc4\x0c\x00\x97\xaaxve\xfc\xa8q\xdd?M\xcf\x07\x94\xa5\x9d}\xd0\xe4t\x9c\x1d\x85<\x9a\xdb\x9d2}\xbcS(\xb2v\n9\x860y\x17I\ng\x01/\xe2\x10\xe4\xcb\xddP\xb9\xc6\xa1w\xda\xd4\x0e\x0b\xd4\x17\x8d\xd0\xfc\xc6E\x17\x96\x1e\x87_\xfc\x02\x07\x95Q\x92\xd1\x16s\x0e\xc5V\x11\x8ej\xbd\x9ew2-\x01\xa2\x9b\xaa\x93\xa9(\xc8\xbd\x1b<Jz\xebujt*U!\xd1j[Vm\x1d\x9b\x05\\\xea\x05G\x85\x92aE\xdc\xeb>\x0b\x7fW\x06\xf3\xfbI9\xa6\xd3?\x9c\x9f\x1d\xd2\x1f.\xaeD\x18\\\xdc\xb2\x1f1\x0fS\x82\xf7\xed\xd5\x92\x1a')C1\x85@lT\xc7\x1e)a\xf4\x8e\x96N\x821\xb5\xf1\xbb\xab\xd3\xb7\x87tu\xf9Vp?\x9c\x9e\\\xa5L\xcb\xc4.\x8ee\xa6\xc2\xe5\x84\x9fP\xe1\x98\x97	\xd7\xd3\xd1\x162\xee\xf2\x86\xc74\xdc\x12\x9d+\x08\x18\x14E\xeeD\xb2\xd0bRM\x84\xf1H\"u\xa1\xeeG\x12\xe2\xb7)S\xde\xe0\xff\xa5\x87.\x93x\xaa\x89n\xb6\xba\xae\x02hm\xff?V\x9f\xc9\xdd\xe3\x0e\xba\x14\xf4\xb5\x12\x8e\xf5\xb0zY\xec\xb2\x82}P)\x8a\xd2\xe2\xb3L\x02?\xee$\x7fO\x15\x8eL\xfb\x18%j5\xbe\x0f\xb6\x9fn\xcd\xc0\x83=y;R<sh\xef\xed\xd9\x07g\xa5\x022\xb5v&\x9akAh\x91\xee\x86\xcdz\x80\xfa\x80\xec\xa7\x93\x9b\xe8\xd2\xf6\x8a\x06\x14c\xa4\xd7w\xfd\xc4\xc2#5	1\xb3\x03\xf6\xde^\xc3\x1a\x8fT?`,_D\x80\xdaF-\xach\xa8\xe3\x99\xf2Q\xed\xd8\xc6\x07S\x84\x89\xdc>\x9e:-\xcb\xab\xee\xc9\xa2R.\n}\xe0\xd5\xc5\xcf\xaf)\x99\xf9\xfclG\x19\x16\x03\xb6a\xf2\xae\x7f\xb0\x80\x85\xa8Y\xdf\xa9\xd6Uva\xb7U\xf1\xd8\xd6N\xd4\x87\x11\x02\x84\x84\x81\xce]>\xda(i\xe1e4\x833\xdb\xb0E\x19S\x1a\x98*z\xce\xdf\xcej\xc9\x17.\xc9@\xf4j\xa5\xbe-\x12\x92*\xba<\xef6 d\xf7\xc3V\xad\xb4\xd1Q\xe1\x90\x9e\xb8\xe9\xa3\xc7k=\xe8y\xfb7\xae\xb8W\xe8\xd0\x1a\xaf\xf8\x8f\xe93\x9dS\xfa\x98\xf932\xf1\xd9\xc1\xdf\x0e\x9f\x1d,\\\x0f\x03>\xfeC\x02\xfa\xe3\x16\xafh\x81\n\xfa`\xd3\xb1,\xd2\xb9\x02\xbcF\xd7\xa3\xcb\x07\x9d\x06w9\xdb\xb9P\xdf\x0e\x01\xf5)E\xca6\xdb\xc1\xc3\x19\x94%\x82\xf3\x11g_\xac\xe3\xe2D\x1c\x98r\x95\x8bw\xe8\xf0\x14\xb9\x9d\x92\xc1\x8b\x12iV y4\xa9;!M<\x83\xaaO\xdf\xcf\xe4$\x16Q\x8d\x05\xe1\xc5[\x88`.\xce\xc2\xbf\xd6&o\xe7\xa4\xb8\x11\xe9\xf38\xaao\xa5@\x9f(p\xd7\x1bX\xadr\"+e\xafj\x15\xf0G7T5x\xc9\xbe\xb4\xa9\xe0\xf9\xe2\xb3\xf0\x02\xc9\xbe\xcf\xa6F\xab\xb5\xc3?Mh\xbbA\xc3~p\xf6U\xb9\x14Xj\xe4\x0e\xa5sT\xd47\xe3\xe1\x7f&\x0d\xcd\xda\xd2g\xe1(\xa3\x1e\xd7\xc57LK\xddC\x87\xc9\xda\x9f_As\x7f\x96\xe3\xf4|\xe0\x83.\xcc\xa7\xff/\xa9\xff\xb5D\xa8.r\xf2\x04c\xc5=\x82\xe1\x15\x8dM\x9d\xb2\xb9\x9d(#\xb7\x08\xa0\x17\xc7m\x9b\xbf\xed\x1e'\x7f\xb3\xefET\x8e\xed>\x19]T\x982F\x9f\xd4\xdb\xe2\xb8/\x8al\x91\xff#@\xa0\xf9g\xc2\x0dAo\xac852&\x9f\xc9\x8bI\x08+%\xa2w\xa22\xefr\xe4~\xd9s\x132\xff\xdc\xc7\x90[\xd6~\xf5r\xf2%\x0e\xd1\xb7\x8f\xf3\xfcpzr\x91\xff]\x01\x86\x90\xba\x9czou\xc7R\x17\x15\xc7\xeb\x8b\x97/\xef\x7f\xf9\xf2\xe5\xe18\x8c\x19c\xda\x1f\xbdp\xbf?\x91S\xb2H=\x0c\xb6=\xca\xfc\x94|K1\x158)\xf0\xa2\xe7\xa9\x9f\x8c\x0d\xb5\xbf8\xc9\x87\x92\xbb\x9f\x01\xcai\xb5\x0c\xdf*\xbb\xe1*y\x87\xa6f\xbb\xe1y\xean\xd1\x18\x9c^\x83\xe9\xe5g\x9anX\xc1\xc4.^\xcb\x8f\x04sv\xad7\x83O\xe9\x06\x19,\x90l\xa9\xe7hK\x11\xb39^\x12\xbd\x8c\x88\x07Z\x11\x93\xcf\x9aR\xf6+\x17o\x8a\x0b\xb9H\xee\xcd8\xa5\xcej\xd4\x08\xe39f\x049\xc7\x1a!\xe7\xaf\xcb\xf8\x98\xbb^\xb4\x83/\xb9\xe3\xc5\x9b\xf2\xbb4ki/\x1a\x08\xd5o\xf9)\xf0\xd2Q\x85-\x94\xbe\xab<24\xb7\xef\x93L\x9e}\xfd\xfa\xf7\xf4\xfe\xec,\x0dl\x8c[)\x83	o\xd3/\x81nU\x18\xf9\xfe\xb2t\xa2\xe5!7\xc89~\x89\xbf\x02\x91f\x90\xc5\xf9\x9b\xf2\x85\xeaG\x14\xc18/\xbf\xd3\x881\xbcIk\x9d\xa7\x9f\xb3\xea\xe8\"W\x041\x9c~	\x14\xaeo\x91&\\\x9f\xd1Usr\x91\xb8\x11S\xdb\xcd\xa3\xb7\xa4\x1e\xbd\x9c\x7f\xf6p\xbe\xf8\x19\x0f\xc7\xb8\x0d\x82\xa8W\x84\x8e\x18\xfc\xca<\xe1w\xea\x04\xc4\x00\x02-I\xdf\xb6|\xa7\x9bro\xd6e%\xd2NI\xad\xc5\xa5{\xf4\x7fG\x05\xfb\x9a\xc3`\xa4\xe6\x0b,\x9f\xbf\xf2\xe02\xaaq\xa8\xea\x88\xdcsf\xa9\xd4\x15\x8ah\x95\x8a\xc4\xa4O\x9di\xd9Wk\xbc\xc7\xf7|\x99\xc9\xd0.\xde\xe7\x9f	\xbe\xb3\x97nL\x01\xc22P+i2\xb7\xc3\x7fm\xec\xebL\xe0\xa2gu\xfb\x0d\xfe\xbdNB\xcd\xc5\x15\xab\xdb\xf4\xefv\xb9]A2\x9f s5\xbe	@B\x01\x15\xac\xc1o\xc6Wt\x85\x0fyD/\xaa\x97\x06{\xac\xd3\x83\xb9\xce?\x05\x9e\x1c\xab3\xef:\xd0\x94\xbf\x15\xfc\xab\x81\xe5\xdf\x8b\x16\xcb\x9c8\x99\xd6L\x13o\x10\xe9/n\xdc\x1c\xe6L\xd4=\x06\xce\xb4IMAry0\xa6aX!O}\x98\xffy\xc6g\x1b\x85\x9a\x19\n\x1e*\xff\xbf\x19\xfa%\x83\x91\x9c\xf8\x11\x151\xbe\xe6\xcd`\xd0*u\xdf\xa3\xab\x08\xc7\x9fE/pL5\x1a\xd9\xe0\x92c.\xa3\x8c\x9b\x8c\xef\xd2\xd3(\xc3\xf9\xa5\x88\xa3Y\xdd{\xd8*dn^Q\xf9UAO$^[,\xe5\x83V\xb9\xe5k\xf4\x860i\x89TXq\xdeW\xd9&\xe4\x90g\xd4&%B\xaa.'\x14\x81]\x8e\xd2\x9a\x07\xd2i	\xed\xe1[\x8e\xe5\x9c\xf7\xb6\xc9\x9a\xdcr\x03\xf6E\xces\x9f\xa6\x0cK_(p\xbe\xb6\xfa\xbb\x01\xc5/A\xe5\xdc,\xcc/\xa4\xd9\x1b\xfeF\xe5\x80!(\xc7\x9c1Y\x9b\x03V\xe1\xad<\xcc\x84	o\x0f\x98\xa37\x17\xb3\xfbp3z\x0d\x83\xcd\xa6\xfek\xf9!\xf3\x06\xe1\x06\xa2\x9aE>\xb3\x97k\xab\x8bg\x07\x7f{\xf6\xb7\xff\x1d\x00PK\x07\x08\xb8\xef>\x02a\x15\x00\x00W;\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cV\xcdn\xe3\xc8\x11>\xcbOQ `\xcc.\xb0\x98\xbd\xfb&K\xf2\x8e&\x92\xadH\x1a\x1b\x8bl\x0em\xb2L\x95\xdd\xecb\xfaG\xb2\xb2\x98\x07\xd8\xb7\xc81\xca+\xe4\xc8\x17\x0b\xfa\x8f\x94=\x19`O\xac\xae\xfa\xaaX\xff\xdd\xbf_\x8c\x8a'\xd6\x8d\xb0\xc5\x15\xfc~1\x1a\x15\x8fB)\xac\x8a+(\xae\xc7\xb7\xb7\xb3)\\\xff\n\x1f.\xcd\x07\xb8\xb9[\xc3\xa5	\x9f\xdf\x8aK\xf3[Q\xfc\xe4\xf1\x15J\xb4Qa:[\xcc\xb6\x83F\x94S#j\x9c\x0e\xa0\xf9r\xfc\xcb\x0c\xbe\x0f\xdd\xb4L\x12\xf59x\xb3\xba\x9b/f\xeb\xf7p\x85\x87\x15\x1bk\xe6j\xbb\xd3(\x82\xc2e\x05\n\x0f\xd0z>\x90\x02\x1b$\x1f\xa3\xfd\xc0\x1d\xabj\xee]2w\x0d\xd9\xe4\xf8e\x155~0?\x82P\x15\\V\x10|\xf1gN\xb0\xc1\xc2w\x14\xdf\x02\x9d\xae\xb1\xf2\xeey\xfb\xab\xbb\xcd\x16V_\xd6\xbf\x0c!|\x93G\xb3\x13\x15\x1f\xae)g\x7f\xf3i<\xbd{\x80\xeb\xf9\x9f)B\x0cs\xc1\xe5\xcb\x96\xebZ\xc6\xa8\xb6\x9f\xd6\xb3\xf1\xd4\xd7\xecM\x96\x9d\x1aJ\xfc\xe5\xf6m\x91\xa3\xb5=\xe1\x01\xab\x8dhp\xbe\xca\xeeo\xe0\xee\x06\xb6\x9ff\xb0\x19/g0_\xc1\xc3l=\x83\xfb\xf9\xeca\xf0\xae\xb8\x18}\xfd)5\x94\xf1\xfd\x14N\xadtZH\x93\xfb\xab\x12\xc7\xe2\n\xfev1\x1a\x8d\x8agv:\xfc2\x92\xa6\xb8\x18\x8d\xfe\xee\xcf\xc5\xceK2l\x87Nc\xc2\x05\xfa\x0c\xd8\x90r\x16{h:zQ\x96\x9d\x83Y\xd9\xdd\x80e2\x19\xc9t\x063X\xb2\xaaz\\:zQ\x96\x9d\xd9<\xa2\x18<\x15Ju\xa7\xfc\xfbx\x88\xd0\x94\x99\xd0e9\x13\xa2jH\xf9\xfc\x8e=A\xc6ja1%\xa4\x105{\xd1\\\xc2\x11Db\x05\x9f\n\xb4\xf9\xc8Q[\xb1:6\xf1\xa7E\xc9\xcajQ\xda\xd8\xe2^\xbc\xeeN\x95#\x8d \xd1\xc4\xae6\xe7\x83{}\x1c\xea<_\xc1\x15Xv\x16\x8ck[M\x0d&g\xf0\xb5M?_\xe33\x93\xaa4\x9e\x0b\x86\x9fu\x7fXT\xd5\xff\xf9\xd9\x8e*_\xa4b\"\xca]\xb6\x1a\x83\xaf\x84J\x0e=\x0bE\x96u\xf0z\xc2\xaa$\xd4u\xfa\xcf\xb33\xf6\x96\x0f^\xd2\xfd\x0b\xe4\x07R\xc6\n\x95\x12!\xb9|\x89\x1d\x9f\xa8`\xbd\xe1\n\xb5\xc8\xf6\x96\\u\xa7\xf3\xfc\xe6i\xbd\x82\x82j\xc5\xba;%/\xf8\xa00\xfa\xb0\xd2\xdcj\xeaNVP\x8e\xd7 \x8e\xa5\xf4J[\x9f\xa6=S2gv\xd1\xbb\xf1\xd3\x13\x0d\x11\x9a\xb8\xcb<>\xad\xb5\x88\xb6aH7\x96\xca\x17?\x0cE\xf7GK\xaa\xee\xc5N\x0d!\xf5t?\x99\xdfTl\xf0\xc2\x0f\xee\xac\"\xbb\xe0\xda\xebz\x12vd,\xebc\xd4?\xb2\xf3\x82\x1f\xee\xd9\x99\x1f\xf3\xb8\x9a\xa3*S\x07\x17\xd3\xeeT\xb2RX\xda\xee\x14U&\xfe\xf8J\xac\xe2qsT\xe5N\xf3\xc7\x8fi\xa7NIc\x99\n\xf1F\xf9\"Lra\xa9\xc1\xdc\xf0\xa5\x90\xa8\xaa\xb3q\xf9,T\x9a\x95\x9b\xee\xb4O\xe4R\xe4\x9d0\xdegj)(Q\x9f\x1de\x9d\xcf\x8ed\"\xc7\xdc\xfd7\x91\x1bl\x13u\x97\xfc\x1a\x15\xb7\x9c\x8d{\x17\x87\xd9= \xbe\xf4\xceL\xa9I\xa0\x85S\xdf\xf8\xb2L\xc5\x19\x15\x9f\xd1%\xea\x1e3n#\x9a\xf31w\x94C~dk\xb9\xf19\xbf\x16\xa9\xc1J\xa1J\x0c=4V\xca\xf5E/\x85\x152\xd6m\x12I\x97\x9a\xae\x94\xe4\xf7\xfa\xa4\xd7\x9bx\x06X\x86d*\xa1\xd8\xe0\x83\xd0\x8aT0\xf2+;\x0d\xdc\xa2\nW\x1b\x1cHJxD\x08\xb0\n\x84\xb3\xdc\x08K\xa5\x90\xf2\x08\"\x150\xad\x04\x16:\x0c\xc6&o\x01p\n\xa1\x95B\x95\xbb\xe4S\xc5\xca\x97\xb5\xd8\xa2nH\xe5\x18\x9eH\xe2\x96y!\xfc\xe0^A8\x83e\x06)\xfaQ~\"Ef\x97n\xa8\xa0\x9d;\xadf\xae%nX\xd5o\x82\x8cl0\x9e\x9f^\x14-k;a\xad]\x1b\xae\xd7\xc5\x87\xc8\x12\x96X\x81\x80\xeeT\xee\xd8u'h}\x0eJ\xe1\x0cB\x85\xe0'\x93PC\xc9Zs\xd3\xba\xd4\xc1Qw\x9a\x02zoKw'g\x0c}\x84\x85\x80V\xd4\x08{\x01\x8d eQ	e\xa1\xfb\x8f\xd5\x08\x1a\xcb\x9d\xd0uw\xc2lS\xed\x85\xa4j\"Z[\xee\x84\xb7\x9bH \xe5\x7f\xdf\x8f\xcc\x0bb\x1b\x1eG\xa4\xea\x1b\xf6\x93Q\xfc\x05\xb1\x85*\xf1\xe0\x89Sn\xa50!\xd8\n\xb5\"\xbf\xa1\xfa\xdd7\xbc~\xeeQkv$\xfb\xa6\x8a{d\xcb\xd7}\x1b\xde\xd0kw\x02\xe1\xe01\xf7c\x83T\xf1=\x19b\x95\xdf+K\xcf\x82}\xe0%\x903v)l\xe9o\xcfb\x81\x06\x1a\xb6\xc6'\xb5\x15\xc6g\x97i\x8f\xca\x86\xd4\xa2iy\xb8!\x14\x1e\x06\xffn\xd9\xedQ80\xee9\xdfb\x8a\xcf\x16\xd6-\x03Vd\x8dO(\xeb\xaa\x7fL1)\xbb\xe54\x16\xde\xd0=j\x03\x12\xa1|;)\xbe\xd3\xcdp\x1b-\xd1\x18\x7f\x05\xc1\xcf\x10\x99\xf03l\xb7\x8b\x18\xd1?\x1c\xa7\xd7\x9f_\x86 \xf6\xf8O\xe8N\xb6;AI\xfd\xf2\xd3(L\xbce\xd7\x82L\xde\x81\x1a\xab\xb0\xf5\xae\x8fq\x0b\xaf\xd3\x19\x84\x9f\xb1#\xccW\xefqC\n\xdec\xe3\xcb-\xe3\x9f4\x9a\x90\xe2qi\x9d\x90dr!5\xb6\xf2\x98\xae\xf3\xf3\xf4j\xf4\x8d\xef\x05\x1b\xaa\x95\xe8\x0b\xaf\xd1:\x1d\x1dG\xcb\xf9Q\xa1\x9d\xcc\x8f\x82\x7f\xd72\xdf\xcd\x06\x85\x8e\x85\x9d\xecP\x9f\xdd^h|\x03\xcc^[J\xcf\xf1\x85\x80\xc4\x04\x01\xe8\xf99Q\xfe\xfe\xbbeKe\xd8\ns\xf5\xc4\xd9\xb8{l(88S{>f\xdbv\xe7\x9aG%H\xa6m\xb5$E\xc2:M&\x0cq\x7f\xbdXn\xbd\xf8\x93p\xa9_\xec\xb1M*\xdd\xa9\xd4\xa1\xeb\xf2\xb36o\x96\xdc\xc5C]\x9d\x82&\xf6\x02\x90\xf2/\x90}\xf6\xdb\xa9\x83\xef\xea\xa1>_\"\xe3M]\\+YT7$Cp_\xc2	\xfcnK6\x02c\xa5\xb9\xd6hBzmw\x92~	\x85\x9d\xd0\xc7\x12q\x7fu\xe8b6\x13\x15l\xbc\xf3\xe2\xe1\xdc\x87\x8b\xd1\xd7\x8b\xaf\xff\x1b\x00PK\x07\x08O(N`\x02\x06\x00\x00\xa9\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xc4;\xedn\x1b\xc9\x91\xbf\xe5\xa7(\xcca\xc1\x0d\xc0\x95\xb4\xd9\xfc8\x18\xb8\x00\xfa\xb2W\x89%sE\xad7\x87 \x01\x9a\xd3E\xb2\xad\x9e\xeeq\x7fP\xe2\x06y\x80\xbcEp\xbf\x96y\x8dy\xb1C\xf5\xc7L\x0f);\xeb\\\x16\x07\x18\xb0\xa6?\xaa\xaa\xbb\xeb\xbb\x8a\x7fyqT-\xb5il\xf5\x12\xfe\xf2\xe2\xe8\xa8zu\xf6]\xf5\x12\xfe\xf8\xe2\xe8\xe8\xa8\x9a1\xa5\x90y\xe0\x13\xa1h\x11sB\xabj\x1a\xe6n\xd0Z\xb6B\xe8\xfe\x0el\xb9\x14\xf5\x1a\x0dp\xa6,H\x06\xaf\xb40\x08g\xfe	\xbe\xf3hi\x93\xad^\x1c\x1d\xfd\x89vV\xb7\xf3W?\xf4\x18\xc2\x07\x0d\x1fU\x17\xe8\x1cB+\x99\xaa\xd7\x08\xcc;m\x84E\xe0\x1ej\xad\x1c*\x0f\x1f\xbc\x005A\xeb\xa0e\x16\x0c\xd6\xbai\x98\xe2\xdd.\"\xf6\nPm\x84\xd1Ja\x83\xca\x01Gp\x86m\x98\x90\x03v&\x1f\xd9\xd6\xbe\xd1\xf5CO\xc3\xbd\xf6\xef\xb57\x16,\xc2R<\xa1\x01\xe6a\xc1l\xa2\xeb\x1d\x1a\xed\x85\x94\x08\x12\x81w\xbb\xa5\x90\x11|\\EX$\x83\x96\xee\xa2\xe9\xfe\xd1 X\x01r\xa2\xd5J\xa2\x03\"\xb6f\xf5\xba\xdb\x15$(\xad\xb6\x8d\xb0\xd8Sp\x96GL\xbe\x0bFw \x11\x94n\x08\x81\xd3\xde\x82D\x0b\xde	),s\xe8Mq\xa5\xcc\xd4k\xb1)\xe0\xc5o\xc0\xa7V\x18\xe4\xe0\xd6\x06\x19\xcf\x07\xfa=b\xbb?\x05\xe1B\x19\xffJ+\xb9\x85\x04\xcf\x82P\xd6!\xe3\xa0\x97\xc0Q\xa2\x13j\x05n\x8d\xcd\x01\xeaK\xa3\xdb\xb9\xf6\xa6F\xdbSAc\x19\x14\x07\xd1\xd0\x0d\xd9\xb4\x86\xc8>\xaa.	&\xc2\xd2K\xf9\x95\x15?\xd2\xf5\xd3!\xf52\xae\xb6\x10\xa8\xf1\x96\xb6\xab\x01T:\xce1\xdc\xaf}\xb3PLH\x0b\xcc <`\xeb\x8e\x0b\xd2<\x17\xfa\x9d\x96\xbe)n\x86\xc6`\x13\x07\xd3\xfb\x86\x0f\xe23\xab\x15\xb4\xda\x1bh\xbc\x15\x1f<\x02:\x90X\xd3e\xc3F\xf0n\xa7K\xe0N\xaf\xc4\xb2\x07\xfcN\xac\x141\xb0\x85\xd7\xd7\xaf\x80)\xd1t\xbb\xfe\x9cgJ4\xc4>q\xb2\x11J0\xe7\x8d\xb0\xdd\xaex\xc4\xc5\xea\xc6\xbb\x81R\xfa\x80sV?\xac\x8c\xf6\x8a\xc3;\xc1Q'x7\xc4Y*\\&\x17*\xf0_\xa4\x0f\xf8\x84\x19#\xba\x9f\x0c~E\x92TB\x8f\x002\xf8\x8fA\xee~j\xd0B\xa3\xbd\xecv\x96\x84[2\x90\xda\x13;\xb6\xcc\x80\x9c0\xde\x085\x05)6\x86Vpa\xe8\x86\x82@\x04)\xdchg\x10\x1a\xad\x04\xdd[A\x80f\x86_\x11Cn{\"\xc2gP*\xc0'^\xf5\xd2\x9f\x8ey\xab\x9b\x85A\xe2\xff$\x9f\x04_i\xbfA\xe6\x9f\xa0\x89\n\xc8\x02\xdb0\xe5\x88N\xeb\xdb\xd6\xa0\xb5\x87\xf0\xc6T\xdc\xb2\x82#n\xa3\x84I\xd6/\x8e\xc8g\xe8]\xd2<\xc2\xc0{o?\xf8I\xf7w\xf8\xfa\x14\xea\xb5X.\x0dq\xa7\x07\x89\xce\xd1\x9f\x8dP\xde\xd6\x9e\xb8\xf7K|\xc2\xa6\x95\x08/\xa1\xd5\xf2W{\xb8\xef\x85\x93\x03\xf2{A\x97\xf5Y\xe8O\xa1f\x86\xd5\x8e\xdex\x84l\xa6\xa5p\xc4\xb6#\x94|\xb8\xed\xa4\xb1\xd3\xf9\xde\xc5wJc\xfd\x8e\x9a\xb5\xae^\xb3~\xd3E\xfa\xce\x02K\n\x97\xb4\xf3\xd3H\x15\xd1\x11j\xdd\xb4\xb2\xdb94\xa4\x89\x13\x9c(O5\x1a\xc7\x84B\x0b\xae\xfb\x9fz\x8d\x96\x16\x93DLP\x19\\	\xebL\xe4 \xbaQ\x06\xb5\xe9v\x03W\x80\xf5\xef\xd1\x1dPx\xcfVv\x9fJp4\x18I\xa5y\xe2\xdfH&\x9aH	=\x90\xd5\xd2\x13\xf4Hu\xa4\xf3\x18\xbeW\xb4;*\xec\xb5\x16V\x00\x93D\x06\x99\xb2@[\xd8_\xaf\xd9\x07\xdf\xef\x82U\xb7S\xdd\xcet\xbbc\xb8\xd0\xca	Z\xa7\xba]M\xb7\x9a\xf71\x0f\x8d\x16\xca\xc27\x84\xc0\x16\n\xaa^33\xaf\xb5\x19\xf8!|\x11Y\xb6eM\x10\xb8\xe1\xb1\xd3\xb9fZp\x0bL9\x11\xd6Hm\xb2	j4\x17KQ\x1777l\xee-dz\xf0c8kM\xf7\x13	\x8f\x16\x06\xf0\xa9\xeevdHI\xdaE#\x1cNAN\x8a\x17\x06\x8e\x1b\xc3\xc0\x10g\x8b\xf2\x81\xcb\xe3hC\xba\xe0\xad\xa1k\x08T\xf4\xe7\xba\x88S\xc9\x08\xe8b\x05\xed<\xaa\xee\xb4c\x0e\x81)\x0eK)Zp\x85b\xafkMZn\x05N\x93\xed\x81\xab?\\\xbf*A\x90\xb9\xa0q\xd2\x865F\x14\x05Q\xde:\xdd\\\xcc\xe7=-	\xe5+\x8cF\x9d.\xdbm%B\x8b\xc6j\xa5\x98$\xbd\x1c\xfc\x1az\x9f\x15q\x0e\xa3\xf3[\x1bl0\xb85\xe9H\xb0\xdd\x8el\x83\xd0Ju\xbbg\xf0\xdd\xeb\xd5\xaa\x90\xf4\x8b\xf9|\x8c!\x91qV;\xb2\xd4\x92\xc1\xf2\xd3\x04\x0dG\xe2\xb8d^\xba\xf2L\xf7\x81\xa6\x042~\x1c\x1c\xa0\xdb-\x99w\xd1;)_VMP\x01\x1b\x9f\x07\x98\xaf\xbd:@\xf9\x86\xa9U\x7f\"\xfa\xf0X\xc2\xae\xa6\xe5\xc4\xf3\xf8K\x98\x96\xde\xc6\xd0se\x98\x97h\xfd<\x8d%\xce\xc0zM\xff\xc8\x07\x0d\x1eD\xb1\xedX\x9bU\x01OX\xb6\x90x\xa7\x17\xda\x0d\x9a\xe1\\\xea\x0f\x1e\xa3\xe0\x9b8\x15\x01_5m\xf7\x0f\x02<\xcc\x00\x9f\xe0S+uo\x92XMb\x81&\xd9\xc1l \xf6Q~o\xd1\x9c\x93y\x1b\xd0\xce\xe2R[\x1a\xcd\x84x\x8e^f;J\x9a/\xbe\x02\xb9\x1f\x82\xfc\xdf\xa0\xf7M\xb7#w\x9a\xfc?\xbfAI$&\xe4\x85\xc3\xc0\xb5\x1an\xee\x1eM#T8h\x96\xf1jZ\xce\x14\x13@@M\xf1\x14\xc8\x85{\xa3\x87\xa7\xbdC\x929\xa0aX\x0bz\xa4m\x02\x16|G\xf2\x08V$v\xb8A\xb3\x8d\xcb\x1a\x16\xdcT\xd0-\x92\x13e\x9d\x9d\x82\xd5\xa4\x93\xd00GJ\xaaf\n\x0c\xb6\x92ma\xad\x1f\x81L\x83u\xf0\xc8,\xb8m\x8b\xbc\xa0F\xac\xd6n\xc1\xa4\xec\xe9\xe9\xa3\x08\xf8\x8f\xff\x0c\x13\xcf\x99\xe8\xafOO\xe1C\xbf\x10\x1d))\xa7\x1d\x93$Q\xbf>\x1d\x1b\xce\x02[\xc3\x84\xbc2\xa6Gv\xa1\xbd1\x02%\xf0	\x1aC>L)\xa9t\xc1d\xbe\xe2\x1a\x0bH\x9a0h\xe0\x85^y<>\x04|C\xf1G\x06~\xc6\x0dZ\x9b\x9f&}A\xbd\x8f\xf2\x1903f\x07\xf6\xba\xd1!\xbai\xd9\x00\xab\x1c\xa2?\x88\xc3\xc6\xd0\x9f\x03\xaa\x8d\xeb\x81\xd2\x07X4\x1b\xf2w3I\x89\xd20\xc7}?\xcd\x7f\x1e\xd1s\xbf\xe8\xc1\xcf\x9f\x87<\xd7\xde~\xc5uC\xfe\xc1\xe7`P+\xb6\n\x86\xf9\x0e\x1d\xaa\x91\xb5\xb9\xea\xe7\x803GV+\xafH^\x0c\xdbZ\xf0\xca		\xc1\x1f\x04FJX\xb8-X2)\xd6\x89:\x06\x14!\xe6A~\x0c\xa7\xf0\x80\xd8Z\xb23\x0d\x08\xc5q)\xc8\xbf\x95\xdb\x92\xa2\xa75\xd9=\xd1\x1f\xf8*\x0f\x14\x9a\xcc\x94\xaa,\xef\x18\xeb1|j\x99\xe2g\x05\xfbw\x7fs\xa8\xb8!\x11\xb6)2J0\xe3\x0c\x85\x87\x0em9]B+\xdf\xf8*|\xf6q\xe6}\xb7\x93\xdd.\xaaiR\x10\x14\xc7\x8b\xa0y\x82\xe6bM\xf7\x93\x1bI\xcb\x12\x91/X\xfdp\xd5\x94\\\x9dEf\x8f\xad\xc3\x13*\xc7\xeaB\xd5,e\xe9\xb7]\x1a\xd6\"\xf3y_\xcc#\x10!<N\x10C\xb4\xf4Z\x1c\xc7V\xab\x00\xa8M\x8d\x9c\"\xe8\xfe\xbe\xe8c\xdb0\x074\xd7[\xda\xcbngY\xb2\xb6t\x8fCF\xc3f\xcb\x1c5m8\xbc_\xc8\xe4J\x15\xa7_1)\xd1lo4\x1f\x14/}\xc0\x8aI4\x02\xf7\xce\xe1\x15\xb9\xe3\xc4\xa3 \xf7`\x02\xdb`\x0d\xfc\x99\x07[i]\xfa\x0d\xaf\xe3\xe7\xc7X(M\xf7\x14\xae\x05\xe7X\xf8]!\xf5\x00/\xe1\xb4\x87\xd0\xa0#s\xf6c\xb73\x9a\x8c\x9a\x14\xd6\x91\xc2\xb0\xd9l\xd8\x94\xb0(hZ\x0b\x8e\xe7B)\xe4=\xe4o\x05G\x88\xf1;\x87\x19\xe9\xfb\x84!L0)\x83z'\x89a\x0e\xd6l\x83\xb0@TY\xa2\xc6\xa0G\x19!\"9hY\xa0a\xb0\xde\xc0	\x93\xf2d/32\x18\xc4t\x95C\x9a\xa8E?$\x87\xc8>\x17@Fx\xef\xb0\xf6\xc6\x8a\x0d\xca\xed>z\xd3\xed\xd2\x1c=_\xc2})\xac\x15\x8d\x97X\n\x9c\xe9v\xadV\x96\xf8f2\xf8\xd7\x07I\x1f\xc2\x17\xd2\x15\xf6\xf0\xa4{B}GN6\xabKq'a${\xb6\xd0\xdei\x05\x7fL\x92b\xfeT\x1cH\x1b\xf1#I\x9b\xa4\xd7\x10\x85\xa36\xa7\xf8\xc9B\xbf\xc0?%D\x83\xbc\xd9\x18d\x05sF^\xad78\xac/\xf9K\x0c\x1cp\xcdI\xa9.\x05\xeb\xef\xa7XF\xe7\xf9Vop0\xab\xd74D\xc1\x10\x19-\xfa\x93D\x9a\x05\xaf]\xd8=\x82B\xe8n\xba\xddFXO\xfex\xf2\xc7H\x07\x88O\x82\x19\x13p\xa7\xb5{\xbbAcD!\xafW\xf1rS\x82p\xef\xeag\x14\xe3\xad\xbb\xdd\x02\x83\xdfZ<\x00\xc7\xd6\x0b\xb2\x1a\x90^'\x02\x10\xd6Q\xf2\xe1\xcb\x0dq\xfd\x7f\xc1\x86I\xf4#\x7f\xb7\x08\xc6\x03M\xe3x/^J\x08\xe4l\x98\xf8D\x8c\xe7\xc1\x05m\xbdAc\x13\xf6	]\x94h~\xa1xN4#\xd3q\xdd\x8cL\xc7E4\x1a^\x15F\xe3y\x9b!\x94\x14\n_\x89\xd2\n9T\xb6\xc8%3\x15rV\xc0'\xdd\xee\xc0\xd6\x91\xba\x92\x13\xa1\\\xb73\"\xf9\xcaIW\x11B\x92\xcf\xe1\x8e\xdbCw\xe0z\xf6)\x0f\xe0zF\xd14\x0fl\xf9\xa8\x0d\xb7\xe4\xd8F\xdd\xf5\xd9.\x80\xf8\xc0\x07\x7f\xe7\xfa\xbb\xcb\xf3\xf4\x9e\x87z\x9bV\x8e\xcd\xfe{\xa6\x04\xf9\xc9\xfd\xfe\x0b\xadjA|\x98\xa5#\xb8\xbb\x16}J?\x87l\x97h\x12\x9bf\xe5=\\\x85<\x0c\xd6\x12\xa0\x8b5S\xab }\xb4\xc6'wQ(\x87f\xc9\xeaB\xda\xa5P\x0f\x03Ao\x04\x92c\xfd\xe4\xd0\xa8\x9e\xa8\xb3\xf7\xda;4\xd3\x82\x1c\xed\xa1\xdbq\xe1\x12e2l\xa3\xe8`\xdb\"\xfc\xf6\xb7\xbf=\xf9M\xbdf\xaa\xd0\xc5\x0d\xb3\xb5\x1e\xb8\xe3&|\xba\x83\x008\xe9\x88>`n>\xb2n\x0fn\x90\xb1\x1ex\x82\x12\x06G\xe9!T\xb0\xf2Tp\xa0\xfcN\x06]\xc2\"\xa5\xd8\x83\xb9H%\x89\x96\x14\x06\xe3^\xba|\xb9\xd7\xc1\xaf\xc0\x83l}\x80\x1b\x93\xa3!\xd0\xabs\xbe\xa8\xb4\\\x03\xb4$\xf4\x04\x87\x14\x8a\xc1&J\xc8FX1\xa6\xeb\xe9\xcc9V\xafI#\x0cou\xc3\x9eD\xe3\x1b`\xc5\xdct4\xa3|\xb3@C\xccN\x05\x0d;\x8d\x96\x9a\x82\xb4\x05\xa6mT\x1e\xd0\x94\x1a\x10T\xcc\x08B1B\xfcm\x88\xd4z\x9c\xdf2O'M\n\xa7\x9a\x8e\x06\x1bB\xcb\xc8\xb3\x1b\xe4\xda\x0d\xdegH\x96\x97\xb0\xaf\xb9\xc4{Q\xe4i3\xdd\x82'R\xc0\xd1t:\x94P\xc1 \xa7\xa02\x9d\x82\xea\x011 }\x14n\xad\xbd\x03\xa6b\xb8j\xa7\xb0\xc0\xa56\xe4\x95PM\xa3\x96\x9a\x8a\x0cTt\xa2\"W\xcd\xa4\xdc\x92\xd7\xbf\xd4\x06\x94\x8e'*\xe4\xbcaOo[T\xcf\xd2\xd7\x07\xc0\x9fA\xdfgPC\x99\xae\x94\x00\xa2\xb7\xfb\xe64\xa4\x9e\x1d\x96y\xc5\x86=\xcd\xc5\x8f\xc3\xd5\xdd\xb3\x90\xe9\x1a\xbdK\x1aC\x057\xe7\x9f\xfd8?\x08\xee\xd6=\xf87d\x08\xf6\xdf=\x0f~\x1eh\\\xf9\xfbw=\xe0\x9b\xf8\x19\xaf\xf0w:(*\x9b*\x1d\xb6H\xcf\x86h\xdf\xb6\xdd\xae\x16K\xf2\xb8s\xdd\"y\x88}\xee3\xd7r\xaco\xd1\xb4\xda\x96\xfeYD=_k\xe3j\xef\xfe\x7fH\xd0\xfc\x8d^\x1d\xda/\x8a0RJJ\xea\xd5\xa7lY\xca\xb6\xe4\x95\xa8\x9c\x11\xf8/\x84\xb2	Ni\x8dn4\xefv&\x15\x1eK{\xf4\xac\x15\x9a\xc2\x82)*\\`\xf4\x91\x84Z\xe5$\\\xa9\x13\x07\x8cj\xaf$\x93P\xdc\xea\x86.\xf2\xbd\x16!\xfee\xbd\xe1/v\xe2\xe3,\x99\xee\xfe\xd5n)\xc1E\x8b\x8b\x9cH\x828\xde\xa8\xed\xf0\xd4\x17\xbaiP\xd5\xb1|\x91\xd1LGS\xe4\xed\xa4\xfa\xd33\x84h'\xea\xe1\x10d\x07\x86\x9br\xc2\xf5>\xcb\xa8\x9a\x9e\x8a\xe7\xdd\x8eDq\xcd\xbcK~q{\x00;\xa7\xf6{\x0c\xb7\xc5`\xc6t\x875\x86\x8c>\xb1\xa9*\x17\x04\x83\x12m\x90\xb7\xd0\xfd\x83\xd4e-\x1c!v\xb0\x10\x94\xb5\x93\x9e\x02\x80\xe0\x87\x0e\xb7\xa4\x1fg\x92m\xcbx\xe2V?B\x1a\x83s\xea\x10\xc8.a\x8a'\x0c\xd1O&\x9e\xea\xa9\x94\x1f\x8b\xc5;o\xc0\x9c\xb0\x13\xaa\xbfj\x0f\xc8Q\x05\xee\x980\xef\xcc~<N\x8b%%\xe6\x0d\xb2\xa6o+\x08<\x15\xac \xf7\xe1\xae\n\x9d\xa7%?`\x833r\xa0\xd4\xa7\xb9@\xa7\xf8\xe0\xe2\x99\xaa\xd2[\xa2,0O\xf4d\xa8|S\xd4\x8c\xd2\xa9gL\x98\x94\x98.bR\xff\x14\x97\x97.\x06\x85\xa4\xd8\x97\x9f\xca\xeaSA\xcf\xa3\xc2B\xecfF\xb7Ft;G\xe5\xa4\x9e\x9d\xb4\xb5\xddO<\x87\xc1H\xc5\xa5V{z\xf7B\xa4\xb2C\xdb\xc3z&iX\xac^3\xbb\xbe_\x1b\xb4k-\x87=\xd1OZH]?P\xa2 \x94\xde\xe3\x8a\x8fy\x13\\,\x97h\xc8\xa8.\x84\xb3\xb0@\xf7H\x81?\xd9\xad\x16M\x8d\xad\xf3L\x02a\xa3\n\xea\x12\x98\x02\xdfJ\xcdx\xf0\xc2\xd9\x80\xab\xef\x19\xc8f/-s\x9al\xa7\xc1\xf7\xa4J\x83B\x0b]\x02q\x80\xfcSV;h\x98\xa3\xdc@q\xb1\xe42\\\x18\x0c\x0c6\x0e\xc2H\x0b@\x9d\xa6\xfei0\xc6\xf1\x99\x12\xe5\x9eR\xf8%\xaakD\xffu\x88\xa4\xaeB\x82\xf0\xb9\xec\xe0\x9e\xdazf&\x89<\xa3L\xaeP\xa9a%i\x9c!\xe5U`5^\xe1^mcN*\xff8\xb8'#\xa6OX\xe7\xc9\"\xf4\xde\xd4(\x07\x96\x05\xe4#\xb5\xfc!\xccN5cP\xe3&\x80=\xd2\xeeSg\xcb\xc7h\x8bi\x8d\x9fCYJ\x80\xfc\x1b\xe8\xda\xfa\x81\x1c\xe9\xa9S\x83\x92PYI\xce\xb4\x0d\xba\x88n\xbd/W\x0c\xbb\xcd\xe2\x1e\x9f\x06\xa3t\x87\xfc\xe4\\z\x840:R\xb3\xa4\x04\x1dEc\xa4c\x8d\xf6\xa1\xaeC\x7f/$R1	\x161 \x8a\xf9\xb1\xc9\x9f\xcd\x84\xf4\xee\xe4\xcf\x8bI\x81\x0d\x19\x7f\xab\x8a\xf4\xd7\x9b\x94\xfc	a\xe63\x89\xd3\x11\xe7\xe3a3F	\x9a\xea\xfaE\x02h^\x14\xfb\xf3e\x94\xcb%\xa3\xc0n\xe4S\xdfc\xd3Z\xe8vuhG9H\x8f1J\xef\xa7\xbe\x14\x047Z\x9c9i\xcc\xd8e\xbf\x84\x90\xb0\x05\x06_\xc3\x1a\xbd)\x9b&\x0c\xb6\xc8\x86'\xb8REff\xb4Jn\xefF\x01\xd0\x1f\xefB.\x90\x1b\xfc\x13\xe9}n\xf4\xe0\x0f_v\xbb\x9c\xd4\xcbY\xbc\x10\xed\x99\xbce\xd8A\x9c!'\xdd\xae6e\x03\x8fAJ\xbf\x1cz\x87wa\xfcS^a\xdc\xf9/\xb8\x81Fk\xf7\xfd\xdd\x9b\xfe&\xe8\xef\xf4\x06\xac\xce\xe5\x9aQ4j\xa8\x03\xa6_\x7f\xd7\xfd\xb4\x92\xbd^x\xd3'\x9aM\x1c\xcfzg\xbf\xaaj\xcbx}>\xa8\xb3\x19\x1aJ^\x13\xdba\xea\x00l(\x0c\x0f\x0e[\x10\xdf\x144\x87\xb4X\xce\xcb\x0e\xc7\xb1L\x0e\xaf:g\xf29\xc8\x06\xc95#'\x90r\x93!	\x1c|\xa4/%E\xec\x1c\x81k\xe1`I\xd6xh\xec\xf8\xcd\xa8\xb4Xp\x92e\xbeF\xc5t\x81\xd5\xd7x\xcb\xf4G\xf3By\xc7q\xad\x8b&;\x1b\x9b\x9a\xfeY\xf7TZ\xf6\x91\xee\xa9\xbeM\x8a\x93@)\x85O\xe4\x84\x14J:\xf4L\x95\xfeyA@\xab\x85,\xfd\x92y\x1c8H\x8c\x17\x19\xd3\xa0v\xe4D\xb7\x81\xc2\x04\xa1\x00\xe9\xd8r9\xee\x86zm\x18\xcfO\x92|\xc9\x941Y\xd1L\xf2\x03'\xa8\xber\xe4\xbf\x12\xfb%\x05]\x825\xa2\xbdA\xc7\xa8\xf27\xdc;\x8d&w\xa2\xc9\x93\xf9\x0d\x1a\xbd\x89]%S\xf8\xc3\xcd,\xb8!\xdaQf>\xaf\xa4n\xb7\x07\x84\xd7\xb39\xd4:4\xa30\xf2\x9eia\xcd\x1a4\x8c\x8a\x96\x82I;\x85\xa5\xd1MrT\x90\xc3\xeffW\xaf\xa70\xbb}\x1d\xd6\xfepu>\x8b\xc9\x96\x81\\R\xe0#\x05Lz\x9e^\xd2\xcb\xe7\xcaV\x87)\xe0\xbefW\x02]c\x83\xcf\xf7\x86\xe4\xd4_\xdf\xc6\xf2lk\x06yy,5\xeb\xdd\xb0\xa7\xfe\x1a\xdf	,\x02\xfaI\x16\xbb\x7fS\xbb\xde~\xa3\xd7\x88\n1h\xbc@\x85P)\x9d\xf4\x8bS1b\xd1\xd0\xb0WM?\xda\xbc7\xbcAh\xff\xc9b\x92\xb7\xf7\x15$\x93K\x19{Y\xcd=q)X\xfc\xa0i\xc3S\xd7\xc9\xeb\x1e\xf4+\xad\xf88c\xba\x07\x1aay\xb8d\x0f\xda\xbf\x92+%\xa8\x03\x9cGr\xbc\x93W\xf6V\xdda[\xf0\xf6\x0f4\xd7w\x1c\xeb\xd8\x0e\xb2\x1d'\x92\x9f\xf1\x1aIfB@\xb5\xa4~0\xeb\xc5FX`\xd1\xc1F\xb5\xd1\xe2\xa3\x1a\xff\x11\x17\xcd\xb8\n\xf5\x03.n\xfeoE(\x0f\x9f\x82\x01_\xa6\xf6?\xe1\x8a\xd7\xe4>\xf5\x8f\x15\xb6\xe1q\xcd\\\xe8\x0b\x1eh\xebG\xb2f\xea\xad\x03\xd5\x13[J@S\x951z\x06\x149\xd3v @\xe1\xaf\xe3\x15+\xc0k\xf3pX\xa7\xce\xed\xf0)\xadA\xa5\xd6O5\x0fd {\xbdl?\x1fT\x88\xf0G\n\xecS<\xb8\xa5\xde\xd2A\xdb\xfcw\xfc\x9c\x16\x1fI\x85[d\xa6^\x87\x8d/\x8e\xfe:}qTE\xb1\xe9\x7f\xc4\xa0bkT\x15\xda\xd8\xa2\x8cU\xb66\x18J\xe3U\xdf\x9cL\xa0\xab\xc7\x94\xcc\xacdLYV	\xa4\xd5\xc6\xd1I\xb3\xd1+\xda\x91\xa3\xe3\xd17xWw\x89\xf3l\xa8\x1b\xa3ry\xe2\x92\xba\x1a9\x0e\xe1b5\xdd\x83\x94w\xeeO\x14Z\x9d\x04\xb4r\xac\xaf\x1cW\xafc\xd7)\x8b\xefX\xcd\xa9}1\xfeyG\xdd\x92\xde\xd4b\xd8\x17\xbce\x87\xfd\xd5,\x98\x9a\x95B\xfe\x8e\xd2Al\x83?B\xb7#O'$Y\x88\x82\x93/\xecI0\x0f_\xd8\xd4;\xcb\xc00AI\x1d\x92A\xa6\x1c\xc2\xcb\xccIH\xf5\x1a\xcbTh \x84\xd6P\xdc\xc9`)(\xe3	_\xd8)\xd4\xe1w\x1b\xe4\xe3\x92\xeb\x14,\xf9\x17\xf68m\x9f\xa3\xd4\x14Q\x91\xa9O\x1dF\xd3d\xf9s\x13\xd3\xf5,\xb4\xe6~\x91B\xfa\xf4F^\xe4c\xc5\x9f\xad\xa4\xeaKq\xd5\xe1B\x14\xf9}\xd5\x85n\xda\xe4\x8fW\x8cS\x00\x9d\xabWiLm_	\x89\xf7\xdb\x96\xa4\xa6:S[\xd28\xb1n\x95V\xb4Q\x9dUgm+)\xae5\xd5\xde\xef/\xf2O/\xd2\xb8\xb5b\xa5\xe6\xe4\xe9\xd0\\\xf7\xb7\x0f^\xb4in\xc1\x02/R\x02M$0t\xf5h\xe6-\xd6\xf4\xd4\xd5YM\xd9\x12\x1cz\xcf\x7f}\xda\xb3\xc5\xe0ZL\xc3o\n\xb4\x87\x1f\xae\xceo\xe8	l\xf8\x1d\xc3\x97\\4\xb1\xc6\n/\xe1\x9b\xd3\xd3\xa7\xafOO\xa7\xe0b\xd6\xffeh\x96\xfb\xfd\xf9\xaf\x8e\x13\xe2\x9cs\xc9\xfa\xbf:\xe3\xa1\xf6#\xc6\xe9\x9f\xb4\x9a4z5c\x89\xea\xd4\x08Lce\xb38u7\xab\x15\x16I\xb9\xc3\x04TUK\xba\xa2\x9a\xf6\xe6?\xd382\xb2\x9a\x15\xfdh!\xe3\xd1j)V\xde\xc4t\x04M^\xe4\x11\x03e\xb3\xffxuh;3{\xcbe\xcfgi5e\x7f\n\xc0\xb1\xffr\xff\x17	U\xf2)\xab\xcb\xd0$\x9b\x85<\xc6T\xfd\xe6\x9c\xffx\x86\xa8\xb8\xb2\xbf\xe4ae\xe9\x0c\xa4U)7].\x1a\xe7w*\xeeM\xee\xb2\xae.=\x91\x1c\xc7\xe3O{\x02\x0b\x05\xe7	\xabQ\xeb\x16\x8d\x8f\x1a\xb6\xaa\xa5\xaf\x1f\xdeF\x16\xbd8\x9b\xcf\xaf\xe0\xfe\xedu\xdc\xb3\x92z\xc1\xa8\x11\xadz\x1d\xff\n\xa3kf\xfb3|\xcb\x92\xc3\x9f\xa6\xb4\x0fw\xfd\xadN}\x93\xb1c\xa4\xba\xbe\xcc_\x14\xbe\xba\xc0A\xb1\x81\xc4\xa5\x06\xad\x8a8s\x15\x91\xe5\xea\xaa\x90\xb2\xdb\xad\xb2\x9eK\x9d\x1a/!f%\xd3\xed\x93\xab\x9d\xf9\x8e\xb6\xa6\x96g\xd9'W\x87eB\xad\x0e\x84\x8b\x02\x96^\xa8~\x9e$}\xf3	I\x92z\xa5C\xf9\xa8\xba,\xa2\xacL\x02\xcd\xc5\xc6\xbe\xd14\xc4kNW\xa8t\xd2!|HnU\xb7\xfa\xe0\xe7Oy\xf5\x1dZ/C\xe9\x97V\x99\xf4\x95&\xe7\x8e\xf5SEs\xe369\xed\xfb\x85\x85QM!\xc2\xd0\x92\xa3)p\xbc\xa5\xef1\x9a\xc1\xf8V\xb3\xa2i0\xe7\xb3ou\x9f@\x8c\xd6\x86\xc4\x82R\xd8;\x8e?\xc6N\xf3=9k\x91=\xbc\x13\xf8\x18#\xcej\x86\xec\x016\xe9\xbbO\x86\xd2L\xf9\x93\x96\x90!\x8dD\xf4\xedhU\xeb\xcd\xaa\x17\xa5\xeeo\x94}\xa5&\xe9$G'\x05\xe7Rk\xb2\x88Rs\x97\xfe\x0c\xe3\xd1\xd7xetC\xa0\xc3\xff\xc5\xf8w\x1e\xc3\xef\x99\xaay\xf8\x0c\xe5\xdd\xd1\xc6{\n\xfe\xab{=\x1e\xd3\xd2\x89\x96&^	\xe9\x0c\x96	H\xb2\xb8N8\x83\xd3L%\xb1d\xfa\x1d`\xba\xa52\x8du\xd2jy\xf2\xab\x0c\xde\x91=I\xd7F\x7f\x86rI?\xf9&\nA/B\x04\xb2\x0e\x02\xd3w\xb9Uv\xcd\xb8~\xa4%\xe9\xafb\xf4<Da\xd5<|\xc0B$\x16\xc9\x9e\x12m\xba7\"UK\x82\xb7\xdef+\x91\x02\x9a^o|$\x12\xa2\xb4@|\xc4y\xcf\xad	\x80_P\xc6?\xe0\xe8\x83\xb0\xcanU\x9dW{\x97:NK\xa1\xa3\x05\x17\xd9\x03\xc8\x1e\xdc\x84\x9a~bw&\xf5r+\x85u\xf0\xe6 \xfa\xa9\x16N\xfa\xb4sX\x1a\xda\xc8\xab>`'\x84!NOC\x83\x98f\x89\x8d\xe31\x95\x19\x9c\xc04\x92\\\x8b\xe0b\x84\x11\xaf\x92\x1fp\xd9\xed\x16\x85+\x10\x89#\xc6M\x8f\xf9}\x18\x08\xfc\x85\xc6V/\x8e\xfe\xfa\xe2\xaf\xff;\x00PK\x07\x08 \xa7\xd9\xa3\xe6\x14\x00\x00\xbc;\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/common.jsonUT\x05\x00\x01.\xb4\xd2jtVKo\xe38\x12>;\xbf\xa2  \x98\x19\xa0\x91\xb9\xe7f\xc7J\x8f\xd3\x8e\xec\xb5\x9d\x04\x9d\x9d=\xd0bEbL\xb1\xb4|\xc4c\x0c\xfa\xbf/\xf8\x92\xd4\x19\xecI\xc5\x8f_\x95\xaaX\x0f\xf2\xef\xabY\xf1F\xbac\xb6\xb8\x85\xbf\xaff\xb3\xe2\xc8\x94B^\xdcB\xf1\\\xee\x16\xf3\xaa*+Xn6;\xf8\xe5\xda\xfc\x02\xcf^\xba6\xf1\xfbgqm\xfe,\x8a/^\x8d\xa3D;\xe8\xbd\xac\x1e\x96\xe5n\x19t\xe2\xbe\xe8X\x83\xcb\x914\xbf_\x94\xe5z\xb9\xaa\xbe\xc2\x84?\xfch\xa2\xb4\xefIH\xd4\xd1\xf6\xeaq\xfe\xb5\x84\xfdv\xb3Z\x97\xbb\xf2\x1f\n\n\xcf[2\xd6\xac\xd4\xa1\xd5\xc8\x82\xca5\x07%\xf0\x8cpD-\xea\xd6\xa2\x02\xa1\xc0R/\xea\x9b\xf8\x9b\xde\xab\xcc\x15_y\x1f\xcd\xa6\x136Er\xcd!\xec\xfdj~\x03\xa68\\s\x08.\xf95%\xdah\xe1\xff(\xfeLt\xbaA\xee}\xf4\xf6\x17\xe5nu\xf7\xc7\x01\x9eV\x87\xaf\xe5\xcbj\x7f\x18\xe3\xf9\xe7	\x9b\x96q:/DN\xcf\xfe\x8f\xf9r\xf3\x02\x8bUU\x95KX|\x0f\xc7\x00\xf71?\xf7\x9f\xd2c\xc3q\xac\xa9>\x1d\xa8id\x0c\xef\xb0\xd9\xae\xee|69\x91\x9e\xe4\xca\xa9\xb1\x08\x9e\xaa\xc5|\xfa\x83h\xee\xc3\x1f(\xdf\xb3\x0eW\xdbI e\x05\xcf\xf3\n\x96\xe5k\xb9\xbe_\x96\xb0\xda\xc2\xeb\xea\xa1\x82E\xf9\xad\xfc6\xad\xa3\xe2j\xf6\xe3K*=\xe3+/\xacz\xe94\x93&W\"g\x97\xe2\x16\xfe}5\xf3\xe5\xc5\x9a\xf0\xe7 \xa1*\xaef\xb3\xff\xf8u\xd1\x92\xd3\x03\xcb9\x9dXNOI\x9dP\xce\xe2@\xf3Kg\x13\xd3/\xecOdR\xb6\x1d\xb9\x8c)\x9e\xa9^\x9eR\x0d\xd6\xa4\xf8\xc0\x8dK,\xbeLW\x13\xfa\x05\xd9\xe8\xeb;c\xd9\xd9w\x96\xbdM\xc7\x12\x8a.\x1f\x03\xe3\x9dP\xfe\x90\xe7A\x08\x96XC\x1eiP\xa2w(b\xc1\x93b\\R\xd4R\xa4\x04v\x91S\x93\xb2\x9a\xd56V\xba\xdf\xbeK\x08$h\xd2\xcc\x8b\xcb\x98\xe0g\xd4g\xf1\xceQ\x03\x93\x12\x0d|0\x05\xabmd\xe3_}\xfa\xf7\x93\xb0G\x8dbp)\xee\x8c?\x9b\xbf\x1d\x11%\x17\xaaA\x05\xee\x13\xb9\x15\xdc\xa7\xa8\xd8\xd7\xad\x132\x0d\x80\x10B\x8e\xfa\x9d)aI\x07\xbf\x1fQpJ\xb03\xb6\xa2\xb3G+\x17!I\xf5)\xd6x\x83F\x92\xcd\x0eu\xc4Q\xb3\xc1F^E\xa5\xdc\xa8\xb70\x88\x11?+\x8c?-E\x83*\xe7\xad0\x88s)=}\x81'\xf1~\x8aG\x93\xf6\xda\xe8\xd0\x81T\xfe\xb7\x89s\xcc\xf3\xd3H\x8bT\x1b\x1aroE}\xf2\xf5^\xc4\x06\x05\x13\x81@qj\x0c\x88\x94m4*\x8e2\xcd\x1e\xdf\x8b\xd3T\xbd\xa2|\xe38d\xc7o\x97\\\xd855^\xdd\x8b\xd0\ncI_\"\xe1B\xceo\xfc\xfa\x9d\xdco\xb9/\xcdE\xd5\xa9R\x8b\x0f\xd4G\xa1|\xd2\xc0\x8b\x9aN9\xa2\xb43\x04xQu\xabI	\x83:c\x0dNP\xd4\xc9e\x1eP\xe4\xc5U\xe8\xe2\xc2\x8a\x0es\xb9\xd7L\xa2\xe2\x93Fy`*\xf5\xc9=\x1e\x93\xf4\x98r0+\xe6}\x96\x1e\xd9%I\x0f.k<8\x99\xa4\xb9\xcb\x03d\x8f}\x926u\x1e\x02\x15}$i\x89\xf5\xd8\xb0g\xc4\xd3\xe0\xc7+e\xab\x8f\x83GK\x91\x84\x17\xcam\xbf\xa4$<k\xf1\x9e\xc4Wf\x83\xd1t\xbaN\xe4`\x8fd-u\xfe\xf8\x17\xc4\x87&e\xaa\xc6PWs\xa5\x9c\x1c\x0f\xb3f\x96\xc9\x98\xc6\xbb$F\x05)\xfc\\\xbf\x1b\xf4\xee<\x00\xd4\x81E`\x9flH2\xf8\xc2\xb4\x12*\x18\xfaNN\x03\xf5\xa8\xc2M\x07g!%\x1c\x11\x02\x8d\x03s\x96:fE\xcd\xa4\xbc\x00\xb39}\xfe\x1a_\x10\xd3\xfc\xe7\xd9p\x0cP\xe4\x90\xf2)-\xbe\xc9\xa1_\xde\x84\xc4\x03\xd1\x9a\xe9&l\x1d\xd1X\x7f\xab\n\xe3\xfdl4Q\xb2\xff&\x940-\xf2O\xfa\x0dQ#qO\xaa\xf9)F)\x90\xbfc0\x11\x08\xa9\xf2D\xd7\x93\xb6w\xa4\xb5\xeb\xc3e\xbb\n\x80?M\xe8\x84\x91\xeedo`\x91\\\xa8\x13m\xa2\xb9L\x01L\xd4\x8c\xabk4\x1f$o`\x89\xd0\xb3F(\x06g\xd2\xdc\x82r@\xbd\x12\xe8\xce\xd0\xa0d\x1cUz[\x08\xf5\xc1\xa4\xe0w\xac\xb7u\xcb\xbc\xc1\x8dzw\xc2X\x84:a\xe1\xa7'\xc4><\x8f\x84j\xee\xc9W\x7f\xf1\x0d\xb1\x07\x9e0x\xcbcJ2\x13\xe2Y3f\x8d\xc5\x04\xfa\x9b}x\xf2\xec\xeb\x96\x9dP\xc6G\x8e\xef\xda41|\x0f\xe7a\xea5\x90\x1fh1\x94\xe0\xd74*\xc1\x8f88\xa2\x1a\xef\x96\xceO\xdbga\x04\xa9\xfcv	\x03\x18>\x02\x96\x86\xab3\xf6\x91\xd9\xda_\x9e\xc5\x0b\xab[{&\xd2\x1c\x15t\x84\xfe\xd9E\x1f\xa8\x11\xd5\x89\xbalW\xe1y\xf4\xba\xf2\xc7\x87\xd1\xe9\xb4M\x93\xc9U\x11 \x17\xd6\x80\xc6\xda\x9bM\xb3\xa4'\xa1\xec\x81rC\xdcB\xb1\xf5\x08X\x82\x8c\x8dO\xb4\xf1*\xf2a\x98\xdf\xe3\xfa\xf7\xc3a\x1dI\xffu\x94\x9e~\x0f\xfe\xbd\xa8,4X\x0b;N.\x8d\xcc\xc4{u7\x9e\x8eF.4\xd6vq\x89\x13x\x97\xd6\xfeB\x80\xe3e\x18\xc5#o\x0c\xfa37>\xd42\xffM\xa3	\xc7\xb9Kb\xc2{\x19\xae\x8a]\x102F\xda&0Wl\xde\xb2N\x07\x9f\x0f\xa8]s\x1a\x07\x8av\xfe\xc2\x1a/\xb0\x1d6(\xf3\x0d\x86L\xc7T\xbe\x12\x0e#\xdf\xa0\xf1	/\xff\xeaEz\x91\xaf\xa9\x11\n\x02\x8e\xbe\xd4\xa4\x1f&\xc9DK\xe7\x8a\xac\xa8C\xbbo\xfa\x0e\xf5h\xc8\x1d;\x11\xfc\xddJ_\xc8\x19\xb7\xad\xeb\x8e\x8a	\x99\xe6\xd3a\xb2\xbe\xb9IMe\xa9\x0f{\xd4\xa7\xf5\xa5Ot{\xc9]\xecT\x1e#\xb9b\x1f\x10Z<Z@_\x8a\x8a\xbd5\xd81v\xb2\x18&_V:\xfb\x02\x1e\xd3\xf3\x14\x81iQ\xba^\x12\xe3\xf7B\x86\xa8\x9e\xc2\n\xd2,KV\x02\xb6\xd5\xd4h4\xe1\xe1\x10\x11\xe4C\x04\x11\xf8\x97C\x17O1IA\xfd\x93\x0b)7\x96zQ\x17W\xb3\x1fW?\xfe7\x00PK\x07\x08TXO,\xfa\x05\x00\x00\xb5\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc{\xcbr\x1c\xbb\xd1\xe6Zz\x8a\x8c\x9e\xf0hCR\xb2\x8f\x17\x0e-\x1cAJ\xa2D\x1f\xf1b6Ey\xec\xb0#\xd0U\xd9U`\xa3\x802\x80\xeaf\xd3\xe1\x07\xf2j^`v\xe7\xc5&>\\\xaaP$\xa5c\xfb\xff\xfd\xaf\xc8\xc2%3\x01\xe4=\xb3\xff\xf6\xf2\xc5bml\xe7\x16o\xe9o/_\xbcX\x9c\x1e\xff~\xf1\x96\xfe\xf4\xf2\xc5\x8b\x17\x8b3\x8d)\xe1%S/4+\xf2|\xef\x17\x07ink\xd8\xd2\xd6\x18K5\x93\x92w\xceS\xc7\x9e\xb6\xcc\xaaa\xe7Y\xd5L[+\x1a\xd6\xc4\x1ak\xe4\x04\xae3\xb5P\x8b\x97/^\xfc\x19\xd0\x16\x17\xcb\xd3\xaf#\xd6\x0b\x19\xa0H%\x9b\x08~\xc7v\x93\xb0\x9e\x18[\x93\xf3Bx\xea\x84g+\x85P\xe4\x0dS-<\xe9b\xa3td:\xf2L;ck\xd6\xb4\xe2\x0doX\x93\xd4\xc4\xac\x03H\xd35\xbc\x95\xba\x99\xc8\x10j'\xf6\xee\xb3\xa96#1\xc7a\x880F\xde\xd0\x89\xf1\xdet\x89\x98[\xb6\x8de]\xb3\"WY\xa3\x14k\xd2BX\xeaE#\xb5 \xa3k\xb6B\xe8\x03z`\xb5v$\x94\xa3\x96=y\xb1Z)Q\xd3\x96\xed\xcaX\xdc\x8ft\x05\x11\xda\xe8}'\x1dO4h\xa3%F,\xeb\x84\xfa\xc6\x18MB)\xa6\xde8\x1fAc\xa3\xe4\xae\x80d\xabVn\x0b8\xf1\x9b\xf8\xbe\x97\x96k\xf2\xadeQ\xbb\x04\xf2G\xe6\xfe\xf1\x14	GXsh\xb4\xdaS\x82\xe7Hj\xe7Y\xd4d\xd6T\xb3b/uC\xbe}\x06\xf5{k\xfa\xa5\x19l\xc5n\xa4\x02c\x19TM\xb2\x13\x0d\x93Kk\"%\xef\x01\x93i=(u\xe8\xe4\x03\xd3Z*v\xc0\x16V;\n\xd4\x0c\x0e\xdb\xf5\x04*\x1d\xe7\x88n\xda\xa1[i!q'\x96i\xc3\xbd?*H\x1bjin\x8d\x1a\xba\xe2f0F\xdb8\x98\x1e7|\xd0Vh\n;\xc09\xdd\xf0 ys\x08v\xde\xca\x9a\x8d\xebY\xb1u3\xe0\xde4r=\x01\xd6\x12|Z\xd3\xc7\xb3\xd3\x82\xae\xc5A9\x1b&\xfd49B[5\xe7\x83\x9f\xa8\xc4\x07\x1dW\xad\x07\xdf\x19]\xd3-\x88H\xb0\xc2d\xcd$\x8a\xf9@d	.n\x18\x89\xfb\x06\xa8\xe3\xba\x93\xfa\xd0\xf5\\\xc9\xb5\xe4\x9a:\xee\xd8Q/{\x86\xecY!\x9b\xd6\x93\xd4\xde\xd0\xde\x0c\xaf,Sg\xb4\xf4\xc6\x16\x98\x8c\xb0\xf5\x07\xb0\xd9~\xc4v\x821\xf0\xfcV\xa8Z\xf8!K\xd1\xb1\xd0^(\xaa\x83\xa2x\x082\x03I\x1evL+\xb6\x124\xea\xa0\x07 \xe2\x90\xdd\x15t\x80t\x00\xb5\x93w5\xdb\xfa\x11\xe2\x0bQ<mD\xab\x85\xc8\xf8.\x84\xe8\xc2\xb3B\x14\x03\xa1G\xf4\xa3\xd0\xe4\x8d\xa7_\xbe\xa1\xcf\xc2\xcb;\xed\x98\x14{\xcf6\xb0]%\xef\xd6\xf8Wj\xda(\x96z\x9a\\\xf1Vx\xcf\xba`\x81\x00\xf2Fz\xf5\x98\x06/=\xabD\xc4\x0d\xfe\x1f\xa9\xc8\xc7\xc5\xce\x03\xd2\xec\x83<\xbf\x02\xf3\x0c\xf4\xbf\xe9\\\xe8\xc6\xbc:\xa2\x9b@\xe1\x1b\xf2\xd0d\x8e\x94\xd0\xcd\x0co]^v\xbd\xcf\xa8\xf8\xde\xd3\xca\xd4\xfb\x80\xae\x8e\x1ac\xdaV\x89\xdeW\xad\x18w\xbeK\xdfI\n\xac\x10\x0d5\xbc\xb2\x83\xdc\xe0\xc0x\x80\xb4\x05\xdauk\x947F\xa6\x17\xa2\x15\xf7B@\xef{\xb1a\xe8=\x83\x83Xn\xa4\xf36\xd8\x11\x8e'\xee0\x1f\x08\xcaJ\xe81A7\xa2q\x8f\x89\"\x8f\xc1t,\xd18\xaa\xe5\xa8\xe0\x13\x8d>\xb0J&\xf1\xd0\xf4\xca8'u\xc3\xfa\x88>\xfc\xf4\x0f\x0d\x10a\x8b\xa7\x9dT\x8a7<X\x89\x13n\xccC>\x05\xab\x0dS\xc3\x0dk\xb6\xcc\xb6\xe6\x0c\xee\x88n\xd9\xb2t\x1e\xb6\xa5\x93\x90^\xa1\xe8\x07p\xa2\xabZ\x80\xd3\xe1\xec\x8d\xc3\xdd<\xc3\x1bU+\xec\xb22vb\x8d\x1f\x85\x15\x1b\xcf\x96\\/:ra.\xc9\x85\xf62\x0cf.\xd9\xc9\xbb\x07\xd9\xa4k\xc3;\xf8\xd2\xa2%Y9\xa2\x0bA&\x12d\xe5]-u\x93\xdf]\xc9\x0e\x06\xb23\xec\xc1\x06\xe3\xa3\xce\xde4^\xd8\x8c\xa1+c-W\xfe\xd2J\xd6^xi\xf4H\xfd\xbb8\x95\xb4\xb8)V\xc43\\\x1b\x0f\xfd&tMk%\xfbB\xc9\x91\xa8*c\x03y\xde\xc0x\xd0\x87?\x9c\x9d\x96  x\x18\x8f\xb6!\xa2(\xb8dp\xdet\xef\x96\xcb\x91\x96\x84\xf2}rH\xa6\xe35\\\xf3Zj\x19\x9f\xf2\xddryh\xb9a\x15\xb9ge\xb6\x89%\x1bv\xac\xb8\xf2q\x99o\xb9\x13\xf4 \xef45\xacD\xcd\xfa\x19\xdc7\xa6i\n1\x7f\x17h\x02\x86DK\x9c\x87\xa5\xc2a\xaaiz\x04U\xf3Z\x0c\xca\x97\xe7Xz\xa1k\x11\x14\x06h\xc8\xa0\xf0\x7f\xf0r\xdc8\x1f\xd98\x91w@;\xa15\xb3%\xb6\xd4\xe0I%\x0cu\xf2\xc4\xa8N\xd7R)\\\xf0\x13\x02>\x0b\xdd<G\x81\x10\xa3\xc6\x02\xab\xf7b\xb3\x81\xd6\xdc\x17T<\xba\x9c\x9a\x1d\xce	\xbfo\x04\xf8\x9e\xdd\xb0Lc\xf18\xc5\xaa#c\x1b\x12\xeb\x15\xb3\n\xec\xf0`x3\x03(\x9dX)\xbe6+\xe3\x0b\x8d`\xc5\x0e\xb67\x88\xfb\xc6t\xa3gt\x9b\xbf\xc3e5,\x06o\xe0\xc2\xba\xf8\xae;^9\xe9\xf9\xb0J\xfb\xb3\x8e\x92\xba\xe6\xfb\xb0$\xc1\x0ctt\xa2j\xa5fw\x00\x1f\xb3\x11\xba\xa1\x8d\x95w\x90AX\x8bZzZ\x1b;tGO\xa8\xfd\xe2\xd8\x06\xcb3Q\xbc\xacZ\xb1a\xf5H\xfd\xc1g=\\q\x9bP\xc3\xb6\xb1\xa6A\xfa\xf9q<e\xeb't\xcd6H\xcf\xa01\x1a\x99\xf7\x95\x80\xcd~\x95\x87K\x83\xe2\xa8\x13bS>\xb9\xd1\xd3\xd3\xdcF\x05\x9e\xd5G\xc2z\xd9O\x1a\x85\x9c\x1a\xa4/\xf9\x9fk\xe9?\x9b\x89_\xae\x19\xa2L\x18\xa6V\xe2\xf1\xb3\xed	>\xa5 e\x1a\x08\x00o\xd9\xee\xe3\xb2N@\xc6\x0c\x19 \n.\xec\x019\x83\xb0\x80\xad\xf0\xc6:\xaa\x84&\xcb\xbd\x12{j\xcd\x8eDXE;\xe1\xc8\xef{.L>\xc3\x1dY	\xa5Fz\xfe\xd7o\xf0IB\xfb\x9d	\xf7\x99\xa8\xf9<\xc6(\xd3\xd4\x18\xc0\xa4M\xadp-U\xa6\xeb\x84N~A'\xee\xa3\x9e\x87\xe9-6\xb2\xa6_\xbd\x99\xac\xf13\xda\x9e;!\xd5\x07kG\xc2>`\x80\xd6f\xf0\xac\xe7*\",\x05\xf7\xc7\xc9g\x80\x9c\x0b9\x9d0\x00J\xa7:5\x83\x8f\xfb\x9f\xd9u%\xdc\xc4\x81_\xe1\x1b\x06\xf2\x9fl\xa5\xdd8\xf7\x1c\x14c\xfd\x08%\xe0&\xc7v\xcb\x96z\xcc<\x01VL>\x03m9\xac\x9e\x05\xf6M8nX\xd5\xa6\x13rv-\xba\x11\x0dw\xac\xfd5{\xd63\xab\xf4a\x9c\x83\xd4\x08\xb2\xe3\x8ad\"\xc4\xde\xd1\xa0\xbdTQBHT^n\xa5\x0fZ\xcdK\xe7e\x15#\x87\x10\xdcp}Doh\xc3\xdc\xbb\xa0\x91\x83\xaa\x80=\xf1\xac\xf6%E\xf7-\xec\xa3\x9c\xce\x96\x070=\xcd\xff\x9c\xbe\xe3\xfb^\xe8\xfa\xb8\xe0\xe7c\xc4y\xd3\x8e\xa8\x1fV\x96\xe5\xc4\xda\xdf[rD_y\xd5\x1d\xd0\xd5\xfbS0\xed\xf9\xd5\x0f\xf8s\xc7\xc4\xc1\x9bH\x92\x9f\xfd(($Z\xf1O\xffWo\x95\xc1\xd9/\x92.I\xcbfH\xd2\x1ec6PY0\xb9,g\x0ct\xdf\xcfX'|\x16al\x1a`K\xa6\xf7\x92\x1d\xfctx\x91\xc1\xbaL`\xd6\xcc\xf5JT\x9b\xc0-\xe3\xb5\x9c\xa6\xd1\xc8\xfc\xe9\x96?\x1cbMHD\x8c\x1e\x80\xcb\xfb\xe1\xb6\xc9\xe0\x0b\x05\xb3\xef\xe0\xa4	\xb5\xa1\x1d(\xf0\xf0\xf9\xb6\xe5K\xacU\xe9\x83~\x86\xe6\xd5\xb4U\xa2i\xc6\x03\x848\x1c\x9a\x89-\xfc\xf1:O\x93\xe9\xf3\x8d\xcd \x1a[q\x8dx~<\xc5G\x0e\x83lk:N\x01\xfc\xc1\xcc^L\xc7\xd0\xa2\x83S\xed\xad\xec+S\xb3\xc33r8\xaf\x9b\xe1\xc3[L8\x1b\xa4	\xec\xfe\xdc\xd4\x93\xd6\xff(\x14[yGa\xf0QFab	\xd6\xe1\x1e\xcb\x17/\xc0\x1aS\xfa>\x1f\xe3g\x84\x15?\xbeg\xd4[Y\x83y3=\xb79\x13\xf2\x96\xde$\x18\xb79\xba+\xd2$\xcf\xdch+k>\x91Zs=\x02\xfb$k\xa6\x98C\xa8\xe9\n\xb6%\x81\x0c\x13P\xf2x0\x08\xb3\xf0\xd4\x8a-\xe2L\xb8\x0bq\xc7tB\x80\x9e'\xa6\x96\xa7_\x035l\xd3\x13\xbf\x068v\xf4z\"\x1a\x93Q\xadD\x07\x13\x01P\x99\x98J.e'\xec&<\xfa\x98\xe1\x82\x9b>\xc8\xe0\x9a\x8f`q\xff\"\x00\x9b\x93u\xcd\xd5`\x9d\xdc\xb2\x9aB\xbe4\xc6\xdb\x18\xe1M\x84>\xa2\x0d\x14\x17/\\K&\x9b\xb6\xaeq&\xdc\xc5\x93+\xa7\x96W\xab\x10ee\x0b8'(\xa46&Y\x992\x1dO\xc8\xf8\xc8\xbc\x0e8\xfe\xb4l\xcd\xee\xcf\xb4\xd1\xa6\x8f\x02(5\xf5J\x08\xefR\\\xf84\x1f\xd2\x1a+\x1f\x0c\xd2\x05xVY\xb8\xac\x9f\xf2\x8cP\x94\xe7\xe2\xb1\x7f\xf8M\xd5\xc2\xcf2\xce\x0b\xd5H1A\x93\x13\xcb\x9cie\n\xa9.\xd6 \xe1\xf4	\xf1\xd4\xb8\xf6\x04\xc2\xb0e\xabX7\xd0\xdf_\x92.\x9eP\x86#\xf6\x96\xb7\x92w\xf10A\x0eJE\x10\xe0^\x1b\xe3/\xb7l\xad,D\xf3x\x12\x1ak\x8c/c\xb9\x91\xbec\xe5h\x98\xc9\xe5\x18\x13BIY&e\xaa\x10n\xc7\x00\x1c\xd7\xfd\xee\xfd\x05\"^O-\xb4\x95> \xa4o=\x0d\xd4J\xb6\xc1\x87\xdc\x1a\xa5\xb8\x96\x0d\x07\xc4\x87\xa2\xb6!\xd5wD\x9f\x91nU\xcc\x0d\xf4'VN\xce\x7f\\\xe4\xa7pK\x1f\x11<\xf0@\xd9[z\xd5z\xdf\xbb\xb7\xaf_\x87\xf3\xba\xa3\x8e\x9b\xa1\x12\xb0\x80\xaf\xe8\xd1e\xcc\xc3\xe2\xe9\x16X\xff\x13\xa1q\xe4\x9b\xf2\x1a\xc6\xfd\xff\x81\x80Xv3\xabv\xd6=\xb2jg\xdd#\xab\x06\xaa\x9e\x185\xa9\x95\xd4|*'\xf3\xf8\x94\x93N\xc0X\xf3\x87\x86\x8a\x909y\xb5e\xad$\x18\xb7\x1eE\x1aF!f\n\xc2\x9a\x90q\x17\xe1q\x1b\xb8\xa9!\x0d\x8f\xe4<\xbc\xcd\x19G\xf6O\x1d\xa9\xb3\xab\xef\xf9NgW\x0e\xecF\xbdp\x0e\x8e@H\x95\xa5t\xf4\xbf\xea<\xc9\xbf\xd6\x93Sx\xf6\xfb\xf7'\xe9\x020\xfes\x0e\xd3\x9d\x08\xd9\xc7I\xfb\xfc.\x0f\x1c\x94\x9fc\xcct\xf6\xde\x1dQ^C\x9bA\xeb\xc9\xb4d\x0b8\xe6\x16g\x99\x10UF\xc97Sl|\xcb6\xc8\x1d\x92\xa2l\xd7\xa2B\x12\xa8\xaco(\xa97\x13}\x1f\xee=[$\x11\xc3h6\xbf\xbc5H9\x1d\xd0h\xf9`c\xd6x\xd7`\x1e8\xed\xfa\xedo\x7f\xfb\xfa\xd7U+\xf4\xebC\xbf\xef\xa1\xbc\xd7\xf0\xa8\xe0>M\x08;\xe1*3q\xd6y\xfc\xcc\xa8B\xc8\x11\x97P-\x1d\x82\xac\xc7[\xcf\xba2\x80_\x1c<\x92\xcaI\xde\xc7\x00*\xa1,\xe0\xf8\xa1Ht\xdd\x1a\xb5\x13\x0e2*uk\x86\x1c\x82\xc4\x12\x13\xfc\xafYJ\n\xd1\xee\x14\xad\x93\x0f\xda\xcf\x05\x8ef\xb6\xc1qc\xf8\x14	\x18\xecd\x90\xda\xed\x88e\x93\x13k!0+\xc9\xba?\xf6^T-b\x87\xe9M\xce\x11\xe5\x0d\x1d\x89b\xee`6\xa3\x87n\x05Gu\x1d\xcb\x11\x07\xd1\x7f@\x98\xba\xe2\xb4\x0d\x85\x13C\x82\x9c\xd4M*\xcd\xcc\x10\x7f\n\xb1\xea\x88\xb3\xb8\xce6\xcc$\x8dT\"\x86AK\x93\xd0\"\x0d\xff\xf4\xffze\xc4\\)\xcc\x90\x9c\xd5\x8aod\x91\x01\xcf\x07\x90u\xa2\x89<\xa6\x13\x12\xa9\x07\xcf.\xc7\xd7\xe98(\x99\xc4\xd8|'}\x8b\x00S\xe8\x18\xb9\xbb\x03Z\xc1c\x85\xbe\x01\x1fT\xca\xa0\x0e\x93\xb3,\x95Pj\x8fxim,i\x13\x0eT\xc6\x80\x9d\xb8Gn\xe1Y\xfa\xc6\\\xc0\xbf@\xdf\xbf@\x0dr\x89)\xdd\x06E\xf5\xc3\x1bdp\x01{N\xdeR>\xf0s/\xd4\xc0(z\xfe\xc6\x13\xe5Y\xd3\xcd\x1e\x06\x96q\x08\xef\x15\xf8\x9e\xceOf\xb8\xbe\xca\xda\xb7\xcf!\xdba\xe2\x1b\xa8\xe2\xdc?\xc5\x0c\xdc\x0c7\xb7#\xfc\xf3\xf8\x99\xa2\x8b\x9eY\x95)p\xc4D\xb6\xceE\x9f\x0d\xc7\xe2\xd1+\x97\x02&\xb8!J\xecQ\x15R\\Tz\"\x8eek\xac\xaf\x06\xff\x1f\xc6e\xea\xcf\xa6yj\xa6\x10\xc5\xa0\xba`t\xc84}\xc7du\xf3\x95\xac\xbd\x95\xfco\x98\xab\x04\xa74:\x89\x88\xc9\xec\x8c\x03s\xc33\xad{dz\xe6V\xe7 E\x95\x0e\x06?e\xe0(\xc9\x07R\x94\xa1N\xe6\xaa6$\x01bv\xb7\x1dt\xb0m\x13\x99\xba,\x84]<*\x81\xc1\xf1\x17\xf0\x8cg\xf1=b>\x14\x08Rd\x8f\xb4g0L\x85\xbf\xafyw\x95L\xfe\xf8\xde\x17\x92w\\$\x91\x12\x97\xcd7\xa1\xeeTl(\xb0\xa6\xe5P\x0d\x8f\xe8) \x18/\xab\xe28\xc6K/\xb3\x1a\xfb\x11\x0eW\xd1`\x80\xa0\xce\x98\xdaU\xad\xe8c\xb0/R\xf1+\x94\xe6\xe7P\xd7\xb2\x9a\x975\xde\xb3\xdbx\xd3\xd3\xc58\xc9\xf9U/\xb5\xdf\"\x0f\xbc\x1a,\x8b\x01e\xfcn\x14\xf6\x9c\x80\xaf\xf9\xa1(KU\x12\xae`(\x96\xc3B\xb9\xbd\xaev\xc2W-5\xf0\xcbV\xdc z-T\xb86\xbb+%\xf6ets1\xd0\n\xb0mx0\x18\xc1 \x1a\x99\xc1\xcf\x91\xfe\xf6\x901\x07\xa9F\xb0\xe6a\xae:\x98\xab\x9e,\xe2IzM(q#\x0b\x8bw\xcd\x89dFA\x97E7\xdd\xdcs	\x92\x82\xa5\x8c\xaa\x9f<\xfe\xe5P\x7f\xef\xe9M\nq\xde}\xa7\xb0\x87A\xba|&\xd49\x17}\x0f;\x03G\x08q\xb0\x0b\xe5\"\xac\xf6&\x14V\x90\x10\x0eU\"5\xe0\x0d\xc9 td\x9a\xaa\xd7a[y\x82\x9d\xe6Bn? 	&\xc4(\xb6\xf9{.\xb5\xe3\xaa\x1c\x08O\xc1R\xae\x0c\xa0\"\x90bk\xe7\x07+u\x83zRx\x99\x90\x0e\xc2\xb3!\xbd\x0f\x15\x80&\x10HZp\xc5\xe9\xa7\x7f\xa00\xf9L\x029{\xd6\xdf\xce\xe0N\xc7\xea\x91\xb9\xbei-\xbb\xd6\xa8iG\xf0\xe3h\xa5L\xb5Q(\\\xfaqEaVf\xdeM-\xd7k\x06\xf9\xb4\x92\xde\xd1\x8a\xfd\x0e|\x8bK\xee\xd9V\xdc\xfb\x01>\x89p-:1\xd6$t2q!\x18\x10\x13\xae\xb1\xbb#[\xdf\xb4\xcc\x1b\x98p\xcbw(\xb6\x05\x85\x1b\xfa9\xe2\x80#\xbe\x17\x15*$\xbejg\xb6\x19\x9a\xef\x9d\xe5 \xa9\xf3`\x11\x8a\xa5\n3xw\xd1=[E]\xc9\xbbG\xa5\x17\xce\xca&\xeb\xbe\xff@\xa8\x08\xa2\xcfB\xa0\xf7!\xa4u\xa7w	\x83c\x966\x97i\x13%\x1b\xd3\xf7\xac\xa680\xadn8N\xa4\"~4\x024\xc5~\x08(\xc0j\x1b%7G\xf45\xa9\xa3\x90\x99\x85&\xdc\xb0B{\x81\xd8\xca\x86c\xec\x0f\xc6O\xc5\xcf\x11r\xbe\x8c\x82\xb5\xec\xa0\xf9Q5+~\x02\xf6N\xc2\xabO\xd7=e\xedR%\x0bV\xe6\"5w4\xe3\x8d\x8f\x14\xb3\x9e2K\xad(\x8cL\xc0y\x93z\x03\xf2\xa5\xdd\x98^V\xdfC\xea\xe3\x02 m\xb8\x1eBs\xd6\x1c\xfd\xcf\xe2\xdd\x0f#\xba\xa5b\x84\xd3\xa8>\xe6\xe2D\xec\x87@>o\"\xd5\xae\xd0b1\xee\xba\xe6\xfa\xf5\x89\x1a\x98n\xa6f\xb9kS3t\xeeJ	X=\xcf\x1b\xd4\xb2\x18yD\x94\x9f\x91#\x0cF\x03]k}\xc3\xa1`\x17b\x9aW\x7f\xb1\xaf \x94\xaf\xfe\xb2zU`dQ_\xea\"\xf7w\x1c\xf3\xb6\x8a\x1fX?J\x1f\xcfy\xfe\x1b9\xe2\xd8\xa1Q$\xb9\xae\xc3\x80-R\xf5%v%\xbc\xdc\xce\x83\x8c\xeb0\x08\xbb\x8bX\xcdy\xeez\x1e\xfb\x9c\xa6\xc9\x84\x7f\xb6\xe8\x88\xb6\xab\xa3\xb7\xf4\xea\x974\x0c(]+X\xa8\xd9i{\x16\xd3\xfd~b\xdbN\xa1\xf7l\x99\xda_\xcf\x02\xac?]s\xaf\xf6\x7f\x0eV\xf3\x9a\xabv\x8c\xe9n\xd9\xa6TcNg\x864d\xf2\x11,\x96\xb2\xdd\x08\xedi\xfb\xbc\xdb`\x199\x9f\xa7\xae\xe8u\x18\xff\x9e\x0b\x1aw\xfe\x1b\xf5%k\x8c\xffr\xfdy\xbc\x07d\x0f	\x03\xb9\xd5\"~f\x8a\x83\xeaM\xedM\xb9k%\x84\xea\xcet\x1d\xecV\xea\xbcc\xf4\xcf\x1d\xf6\xd6\xc0\xdf\xb7c\xd7$\xdb\xcd,\xf5a\x07U\xf4\xf0]\x87\x06\x8a\x849\xa8\x02J=\x15\xa6\x9bWSr\xae*L\xa3\xebS(\x8a\x9eE\xe1\xd8\xbb2\xd7\xb0\xc4G\x82<t=\x1e$\x88u\x90:h\xb7\\\xbe\x19\xdfgl\xe4zF\xc9'UY\x9c\xc4	51\xd3\x92\xab\x01N\x10\xc6\x92\xdc\x08\x95z\x88b\xc2\x9e\xc7z\x0b\x04\xb8\xd3\x8cf\xb7\x8aBs\x10\x98\xfa\x88\x8e\x85^\xf1\xd6\xa0\xfb\xb3l\x0b\xfa\xf5X\x1aF\xda\x08\x91 R\xff3B\x86\x8a\xb50\x131\x18\xb8\x10\xb9\xa3/\xcf\x1fU\xa6\xfb^-\xc5\xb1s\xd2\xe8G\xedv\xc7\xc9}	\xb3\x8c\x84\x96\xf3V\xdemF\x81\xbe\x91w5^'*Go<r.9\xadc]r\x7f\xdc\x18\xda\xbb\xaa\xcdN\xadX\xa3\xbc\xaa\nu\xedz#U\xe9V\x15\xb9\x8de\x9eK\x17\x1c?\xe7q\xf2\\\xa3:/\xd6\xeby\x0b\xdd\x12CtS\xb6\xd0\xa1\xf4\x06\xe6\x18[\xe9\x86\x1d<\x14g4\\\xe1\xe4\xc6\x8e\xf6\xb4\xa0\x15\x0fz\xce^\xa08\\ \xb0\xb2OB\xd1\xe5\xc9$Z\xdc\x99mlP:\xa0?\x9c_\x05G\xc7\xf8\x96\xed\xb8\xf2\x80\x94\xdc0}\xbcZR\x05\xf7Lj\x11\xb2*\xba\xa6Jtl\x05j\xe2R(w@kk\xba\xe4\nqM\xbf\xbb\xfa\xf0\xf1\x80\xae.>\x86\xb5_?\x9c\\\xc5\xf4\xd2D\xae\xe7{?S\xf6\xb0+)O\x99\x08|\x1f[dr\x8e\x99\xb3GVBi\xb9\x88\x05o\x8a\xce\xa2\x1f\x11\x01\xbf[.\xe1\xa2u\x85\x8a\x83\xa7(R\x13\xe7\xb9\xb8\x1f\xf7\x8e\x89\x88(\x95\xb1\xa13(\xf4\x7f\xb7\xa13\x02\xfaFG\xe7\x8c\x0cY\x04\xfdR\xff\x8f\x921\xe3\xc6\xa7\x0d\x9d1\x00]\xc1\xcf\xb2\xf2n\xcbz\xc6\x99\xa3\x86*\x00\x86,l\x12\x86\xef\x88\xcd\xbc\x95,\xc9Y\xe2nd\xc0\xb2\xe7X\xd4m'$\x03\xda\x90>\x8e\xd0C\x14Sv\x10\xcf\x81\xc7~\x80\xd4\xbc^\xf4\x11?\x86\xf7s\xb9\xe1\xd8x?e\x88\xe1\xde\x14\xe0\n\x15\x18\x02\xe1\xe4\xe5]\xea`\xadG\xb8'\xbc\x91w\x9b\xec\xd2!\x8e\xe5^M\x81\xf8q\xa1\x96\xbc\xe9+\x89\xc80\xe5\xd0\x83!oR\xc7\x7f-]58'y\x06d\"\x81W\xdd\xbc\xc0\xf7\x95W\xe7\x14\x86J\xf7<\x1d\x13\x93\x87\xdb\\\xe1b]xq\xa6\xa7\x16\xbb&{\x1b.*\x81*\x8a;\xb1-/9\xe4\xe5m\xb4\xc2\xa3\xe7w\x92\xd3\xaf\xe3\x08\x16\xc4\x15\x02+\x8e\x1aA\xe1\x1f\xfc\xf2\x80Y\xbb\xd6\xf8'\x86ag\xecfV\xf6\xffj\xec&\xe4\xc0\x12\xb8\xb1$\\\xaab\xa4\x938t\xc4\x84 a\xb4\x08\xcfsD\xc6\xf1\xa8\x15\xf2+\xff\xb7c\xda#a1\xa9\xa1\xff\x13?\x0f\x8a\x8f\x99K\x13H|\xf9\xe2\xef\x07/_,L\x0f_l\xfc\x89\x8b\x8e\x0dp\x0b\x04\x04\x01\xc0\"^\"\xc6\xd6\xd2#@M\x03ar\x97\xb2\xb4y.~'\xd0\xceX\x8f;\xce\x96/\xfa,\xa36\\|FA\xdb3\xdc>\xb5/\xc6?20\xd8\xbaX\x9a\x1a\xe1K6\xcfc\xa3b_\xbc\x0c\xf7\xbe\xf0b\xac\xb6/>\x06W$y\xc4\x8b\xa5\xdf\xa7>\x8cR$\xff\x18\x9b*\xc3pN\xd6\xba\x11\x18w\xbd\x82\xbd\xca\x17\xb4\x12\xfa\xaa\x14\xf1/\xb4B3\x15J\xf9\x88)uP8\xaf\x7f\xe1^\xc7\xc4\xe3/R\xc7$~\xef\x13\xca\x8c5\xce[\xb3~\x9b\x9e\xe7\xcb\x8eVB\x87\x0c0=\x04\x07\x10?\x02\x08\x0eS\x8f\xdd\xf8e\x87\xa6_\xb8\xa3\xb4\xfe6@\xc1\xef<\x1e87\x81I\x07\xfb~v\x15\x96\x15o;\xc8Lu\xfc\xe5R\xf1\xa3\xa5\x00m\x91\x1c\x19Le\x9f\x08\xaa2h\xf0N\xe8\xd4)\x96\xd6\xd6\x88\xd0\xa7b\\\x1a\xd5\xfbS\xa9\xf8f\xdfC\x94\x16\xc7z\x1flthDL+\xfa\xa8\xb9\xb0\xb3\x17c<\x9c\x7f\x06\x83\x99\xf4\xe3\x9b\xb4\xde9\xd9\xe8\xe0\xd3`.\xf8;P_(\xdc\xe6\xbd+\x11\x18\xf26_\xfa8\xaa\xd9.{\xae\\:\x11\xf7\xc8/\xfa\xa9O\xf1Wo\x82Wq\x18\xdc\x8a\xc3\x03\xfc\xba\xe4\x10W\xfc\xf5\xc3\xc9\xf9\xe1\xe4\" \xbc\x8c{BwY\x17~\xc6\x93\xde\xf6\x877o\xee\x7f\xf9\xe6\xcd\xc1\xb4 \xeds\xb9\xd0\x81Uh\x88\xdc\x9c\xe06!I\x88\xdc\x06Y\x1f%:s\x16(\x1b\x8b\xc5q\x1d\xaacr\x9e\x90J\xab\xa1\xf6C\xeb\xf6\xe2\xd1\x8f\x12f\xbfG@\xff\xbcn\xb8H@N\xa5\xd7G	\xc8E\xa5p\xc9\x15@\xe4\x7f\xd38\x0b\xd8\xdb\xc5\xa5\xf6v\x90\xb9qxQ\x19\xbd\x96\xcd`c\xa2\x03\x0b\"\x8b\x8c\xe3\xf6\xc9\xcae\xe0L,\x8d\xff=\xb7\x16i\xa9	\xe4\xb9\x10\x9b\xf4\x1b\x16\x91	M^\xe8\xe2}v8\x171,\x1c7\x959\x95iWX3^\xee\xb4f\xd2\xe5	|X\x98r\xef%\xac\"\xf3\xbe\xa8\x07\x9b3\xe0\x8b\xcf\xc6\xf4\x93R\x8a?\x08s	\xc76\xc7IcK\x1e&r#^\x9a\x19\xaa\xcde\xe4\xeb\xd3/\xef~\xa4\xcb\xd3\xd38\xd1(\xb3\x12h\xdf[|\xc4\x7fYa\xb5\xc2\x8d\xc7\xf8$\\j\xf4\x8fSf\x08\xd7\xfb	\x7f\xa7\x9e\x9d\xc5\xd9\xfb\xfc\x85\xf0\xdb\x07\xe69\x8b\xffs\xea\x9c^\xe0\xd7\x18MDw\x16\xfe\x15*\x15\x8b\xd3<p\x86s\x05\xecI\xdf\xc2+\xcf\x1c\xf7\x96\x16\xef\x94\x8c]\x839\xc5\x87\x02\xa0\xc8\x8d8a\xb5\xd4\xcds\xd2\x88G~N\xf0~V\xec~\xf8/\x89\x9d2\x8d	e84\x93\x14=Mi\"v\x95.\x86<\x17\xb0\"\x94 \xd1\xf7\xc2\x8a\xdc\x97\xbc\xd0&\xe9\xabz\xca\xc1-.\xcc\x93\x1f\xdb\xe5\xd5\xd7\xec\x06\x15\xca\xe9Xe\xd3W\x9a\\z1N\x15\x1d\xb6\xfbT\xdb|\\\x83Y\x14e\x97\x08\xc2\xa8\x9am\x81\xe2\x12\xdfs,\x93\x89_\\\x86\xa6\x9b4\xbc\xd3\x17fL].~\x87\x18\xe9A\xfa\x94\x0b\x8c\xe3aa\xcfbs\x8b\"V\x88a\x17W,6\xb4M\xdfc\xfa\x19 \x82$\x8d#.\x0f\xe5U\x83mFaC\xe2\xe1\xf5$\x8f\x8f\xf2\x96\x8bl\xe8\xdf\xd2\xe2\xbapm\x171%sjM\x87\xa9\xf07\xc5\xf9\xb8\xfd\xdf\x0fl\x03\xc3/\xc3g(\x13\xce6\xde \xa1\xb0\xb81\xf31\xa3\xbc\xec1q*\x15\x9aU\xd3\xcf\xae\xc2O9\x86\x15R\xf1\x07\xf1Gb&\xa9\x19B\xf1\x90XW\"\xfd\xce\x12\xad\xb6N\x85\xb4\xff\x11ENwt\xcd\xcd\xa0\x84\xc5\xefF-\x12\x0dF'#\xbep\xecO`\xb7\xe2}\xfe\x11\x01P\xfaLt\xf9\xcfQz\xf2t\x90+\xfc\x80\x11q\xdd\xf8\xf4\xae\x15\xb5\xd9aM\xfa\xaf\x18=	\xe1\xe0b\x19>h%\x13\xeff\x97\x0c\x9b\x96\xa9\x11k\x8c\"\xd2\x92\x98\x83\x18\xf5N\x8a\xb5\x9e(O\x979w9\xb2m\x9a\x88w\x06\x14\x97\x88,wl3\xe8\xbd\x0eF\xe7\x9d\xd1\x9a\xabP\x92\xf0\xc2\x0fy\xdf^W\xef\xe0\x83`\xc9\x17-y\x13\xbc*\x04\xc9\x9a\xe0t\xf3\xfa\xb57\xa1\x8d\xf1\xec\na\x8c\xcfj\x12i\x00l\x1a\xb3\xca)2\x0e\xe4e!\x8d+cs\xc5\xe2fT\xe2>y.7\xa3\xc32\xe8\xe4^|	\xff\x84}\x83\x96\x7f\x1d\x02\xf3\xa6G\xfb\x12\x06r\xc9y\xf1\xf2\xc5\xdf_\xfe\xfd\xff\x0f\x00PK\x07\x08\x0e\xaa	]\x8e\x16\x00\x000>\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cV\xcdn\xdcH\x0e>\xdbOA\x080f\x06\x08<\xf7\xdc\xfc\xd3I\xbciw\xf7\xba;cLv\xf6\xc0V\xd1\xddtKEmU)\x1ai\x90\x8b1\xc6<\xc3n\x1e#\xb7Anq\xbf\xd7\xa2~$\xb5\xb3\x18`O\x12\xc9\xafX\xac\"?\xb2~;>\xca\xee\xc4\x94\xe8\xb2\x97\xf0\xdb\xf1\xd1Q\xb6F\xadIe/!;?\x9b\xcd&\x97p\xfe3|wb\xbf\x83W\xf3\x1b8\xb1\xe1\xf3Kvb\x7f\xc9\xb2\x17\x1e\xaf\xa8 \x17\x17\\N\xa6\x93\xd5\xb8\"\xda\xb9\xc4\x0d]\x8e\xa0\xab\xeb\xb3\xd7\x13\xf8k\xe8\xb2\x12.\xc8\x1c\x82\x97\x8b\xf9\xd5tr\xf3-\\S\xb3\x10\xeb\xec\x95^m\x0daXp\xa2@S\x03\x95\xd7\x03kp\xc1r\x1a\xfd\x07\xed\x99VW>$;/\xd9\xa5\xc0OT\\\xf1\xbd\xfd\x01P+8Q\x10b\xf1\xb2$\xd8\xe8\xe1/\x16>\x07\xd6fC\xca\x87\xe7\xfd/\xe6\xcb\x15,\xde\xdd\xbc\x1e\x8f\xf0?\xf7h\xb7\xa8\xa49\xe7\xfe\xf6\x97o\xce.\xe7\xb7p~\xf5\xff$!\x1es*\xf9n%\x9bM\x11O\xb5zs39\xbb\xf49{v\xcb\xb5\x1eS\xfcn\xf6<\xc9\xd1\xdb\x07\xa6\x86\xd4\x12K\xbaZ\xf4\xe1/a\xfe\nVo&\xb0<\xbb\x9e\xc0\xd5\x02n'7\x13\xf8\xe9jr;F\x97\x1d\x1f}|\x91\n\xca\xfaz\nRU\xd4\x06\x0b\xdb\xd7\x97\xc26{	\xff8>\xf2\xb5\xd31\xed\x7f\x0f\x9b\x1eeJsv|t\xf4O/e[\xa9\xcd\x00\xdb\x88\xeaX?\xfd;\x01\xa38bK\xd6\xb5\xa3\x01\x1d\xc4\x01\x1c\xa4\x03\xach\xb7=\x80\x92\xe5\xa7\xc7|\xc0F\x91F\xbc\xa5\\\xb4\x1a\x16$1\xc2\xa3`GpK8\x06md\x97p\x05\xc6\x00\xd2\xed\x84J\xebo\x03U\xc9\xda\xdf\xf1Y\xf8\xf1\x0b2\xdc\x88\xd78*\xeb\xa4\x08\x11d\xdcK\x12Wh\xd1m)\xb5\x8d\xea\\\xb43\x98\xbbX\xdc\x1ep\x914\x90T\x07t=o\xc7\xecFn\x02\x16\x05\xac[\xb8ZD\x1c\xfdZ\xa5m\xe7\xae\xf9\xfa\xd9t\x87\xeaq\x8fI\x80=\xdba\xcb\xca'#{\xb73\xed}\xea\x01!\xe0&\n\xf7\xa8\xd9\x89	!^\x13+I\xea\xda\xba\x994^[\x99\x8e\x14\xe4\xdb\x86\x8b\xa7\xc7h-$\xdf\xc5\xaaN\x7f)\x9b\x8a\x0c\x0e\xcez)\x1a{>z\x87R\xfa\xfaqi+i4\xc5\xfd\xcf\x05\x8d\x82\xb9\x97\x13\x0b\x89\xce\x8a\xc2\xafY\xc8\x0e\xf7_\xa0\xb1]k\xdd\x8e\xa9gi\x0c1Z\x93.v,\xbfhY\xc9}\xd1\xfbr\x81\x8aK\xc7\xf9\xce\x97|\x16\xa9	6*\x12\x17\xc7\x83\x0d\xff\x03\x07\x0f\xb3\xe4\xf35$\xc7\x13t\xa2\xd8Me\xe3W\xfa_\xd8\xb2ub\xda\x08h\xa5\xf6\x86\xefW\xed\x0f=)m\xab\xf3T\x9c\x99\xa8\xfd\xc3\xd3c\xde\x89N7\xe2L\x83\x10u\xa4\x87\xb3\xb6:\xdf\x1a\xd1\xdca~\x8f\x11\xd8\x8dJi\xb0_\xbe6\xb8\x83gpOe\xcf\xe4\xccqI}\xb1\xe7X\x90V\x07\x1cY\xba6\xef\xc6\x0e0\xad]\x9b8s\x8d\xa6\xa3\x9e\x97o\x1b\xa6\xfc\xa0S\\\xe3}\xb2\\td\xbc1\x89S\xaeFa\xc9d\xaa\x83U\xb7\xa6#{ /p\xff\xa7\xea\x98\x8c\xe6\x9e\xa9S\x7f\x87\x15\xf6\x04\x7fm\xea\xd4\xa1\x06\x8a7D\xbb!\xfc\x19\x93\xb7\x17\x98\xf0\x0b\xd1A\x83\xfb\x07\xea]\xde:1\x83\xb0\xff\x8f\x11\xd5\xa3/\xba\x06\x8d\x1bl\x0b~z\x1c\xa5\xa5\xac\xc5\xe1a\xdb\xa8\xb9\xbf\xc6\xb58'\xa5\xcf\xef\x0cA}\xfd\xbc\x7fH\x1d\x00uN\xa1v/\xe4Ns\xe2^\x8e\x0e\x8bX'o\xd3oD\x17\xec\xa7\xc5\xc5\xb8\xc8+\xc0	$?	%\x96n\xd1h\xd6\xc1\xc5\xcfR\x1b\x90\x8at\x18\x98\xd0\xb0\xef\x1a\x04\x01\xa6\x00k'%:\xce\xb1(Z@\xf7\xac\xe5x\xaa\x1d\xf4\x9bu\x90#@\xb4\xaf\x91\xec\xaa\xac\xc48_WL\xd0\xe1N\xf6\xbf\xfb\x1a%\xb0\xf5.'K\xe5),\x9d\x11\x8d\xd0\x89u\x01\xe5\xc8`\x07\xa2\xf6\x9f\x1a\xa6\xfd\x17\xd1\xa9N\xef\xb8\xa0\x95\xc8\x14\xcd&\xb8\xf628\x11(\x82&a4\xdbm\xec*\xef\x87\xddRIoD6\x05-%\x1e\xfbm\xc1;\xcd\xf7/`\xff\x85\xd6-4\xad\xed\xea\x1d>\xfd\x01\x15\x8b%\xbdK\xe3&\xe3p\x82\x0b1\xa6\xae\xdcx$\xb8C.H\x9d\xc2+\x1fF\x9e\xcc\xe9y\xe4\x01\x97\xcfn\x00l\x9d\xe7d\xed]]\x9c\xc2jKP\xe1\x86\xe2]ki\xc0P!\xc3\x9b\x86\xf5\x07,X]`\xe5\xf2-z\x1f3\xa6\xca`\xc3j\xff \x0dB\x9e,a\xb7\x1dQ\x15\xfa=\xeb\xcd+\xf1D\xcc\xde\x12U\xa0\x92\x0e\xee\xfa\xeeY\xa0\x0d\x07\x98[\x87N\xa7\xd1\xe3\x9b\xd5\xf8\xd6J\xfd,\xbe=\xc0\xdbF\x14\xa9\x95\x9c\x0fu\xfa7\xb2\x8e\xf6\x9f@#X,\xdb\x12\x94\x14\xa9\xc9\x94~\x02\xfc\xc4\x96E\xf7\xaf\xa50\x14\xe0C\xd0%Pm\xdd5\xba\xdcO\xeel!\n5\xc1\x16\xed\xfe\x01\xa1\xacm\xf7\xf4\x08\xeb\xf6\xe9\x0fp\xb8c\xf2;$\xdf\x9a\x9a1\xda\x994-8*\xfb\xaa\xd4r\xd0Ag\x02\xa4\xd8Y0\x94\x8bQ\xc3+NX\xbb\x95\\\x8c\x14Zx\x8d'I\xaf\x1b\x9f\x85i\x06\x86\xe1`\x9d\xfd1\xca?\xaeV\xd3\x08\xfaW-\xe9\xb9\xf9\xdeW\xef\xfe\xc1\xdfH\x87y\x1b*>5nCh\xe3x\xbf\x89\x7fI\xab\xd8P\xee\xce\xdb8\x0cn\x92\xfc\xed\xd0\x1eq\xe3\xb9\xbf\xc5\xc6t\xf5~\xef\x0c\xd9p\xab\xf3\x9eB\xbd\xa5*\xc2\xd0\x9a\xabJ\x1a&\xb5\xffs0\x88	iz\xbf\xd9?\xc8\xfeS\xafv\xb5	q/\xa41_?\xa7K6uAa\xd0\xbeG\x8b*\x9d\xd1\x12\x9a\x98\xca\xdb\xc8\xa3\xd4\xa9,Y\x9f\xf2\xc9\xaf\x15\xa7\xe7\xffT6\xac!\xe9\x81\x92a\x18\xc43q\x9cS\xda\x94QI\xc9\x07\xd3\xab^\x97\x1c\x03E\xd70\x99\xe1\x08n[\x97k\x8d\\\xa4\x9ev\xcd\x9a\xd1\xd5\xa6\x0d#\x8d\xe9\xf44q\xcbI\x95\xba\xec\xe6\xebg\xd3s\xdc\xb5UZX\xb1\xedR\xa9\xd5\xba\xef&C\x15\xa3\xed@3\x8d}\xac\x0d=\xb3\xc77\xbe\x9e\xc74\xbd\x8b\x8ag\xe9\xa9+Ot\xdf3\x02\"H\xe0;Y\xf2\x11\x14\x0b#\x1bC\xd6\xf6\x0f'\xcf\n-\xc3\x19\"\xe8\xef5\xd5\xf1J\xd3_p\xf0M\x08\xb7\x87\x01\x1c\x1f}<\xfe\xf8\xdf\x01\x00PK\x07\x08\xa5\xdc\xef~C\x06\x00\x00\x1e\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc;Mo$\xb7\xb1g\xed\xaf(\xcc\x83\xdf^\xc6\x92\x1c\xe7\x10\xec!\xc0JZ\xad\x15\xaf>\xa2\xd1Z\xf9@\x02\xd4t\xd7\xf4P\xd3M\xb6I\xb6Z=A.\x0b\x1b\xf9\x0d\x0b\xff\x8c\xbd=\xf8\xb6\x9a\xff\xf5P\xfc\xe8f\xcfh\x1d\xc7\xf0{'\xa9\x8b\xc5b\x91,\xd6\xf7\xfc\xe3\xd9\xded\xa1te&/\xe0\x1f\xcf\xf6\xf6&\xa7/\xff8y\x01\x7f}\xb6\xb7\xb77\xb9BI%\x08\xc9\x08\x98uw\xb2\x9bL\xdd\xc0m-L\x07\xebV<~\xbfFI\xb0\x06\x89w\xd9\xfa\xf1\xfd\xe6\x87L\xd0\x1d\xac1\xc7\x16eW	\xa8;\x8bR`%@\x80\x90\x0e\x14	\xdea%&\xcf\xf6\xf6\xfe\xc6D'\x17\xb3\xd3\xdb~\xe5\x0bea\x86\x0b\x82S\xa5\xe1V\xe9UX\xf8H\xa1\xce\x01\xcbR\xb5\x06*\xb4\xa4\x05\x96S\xb0K\xb4\x80\x9a@*\x0b\x86\xe7Y\x05s\x82{A-\xe5 $ \xb4J\xaf\x80\xe4\xbd\xd0JV$\xed\xb02\x96-v\xe6\x8d\xcaV\xfd\xfa/\x1d\x08\x18\xc6\xa4\x8e\x94\xb5\xaa\n<8\xa0\xc9\xb4*K!\x0b\x1e\xae\xb1 \x98;\x1c\xa0{\x92\xd0.I\x82\xc59\x08\x03K\x91\xe7$\x93\xd5\xa4\xe2S04,\xd6C\xfc\x02'\xc2\xd4%v\xbcM\xa8\x95\xb1\xa4\x0d\xa0\x01?Q5&\xa1\xa5\xb3\xa5\xb8O(\xf9o\xa0\x87Zh\xca\xc1.5an\x02\xdd\xaf\x89\xea\xed!&\xcc8\x9f+Yv\x10\xe8\x19\x10\xd2X\xc2\x1c\xd4\x02r*\xc9\xba\x8d.\xa9\xdaY\xfaD\xabz\xa6\x1a\x9d\x91\xe9\xb9`X$\x95\x83\xa8\xf8tL\xc0	;d\x9a\x04\x8b\xa6,?7bM\xb0\x10%\x19^\xcda\x1bp\xdc4&\xdc]$\x15\xb6\xb3\x0f7\xcb\xa6\x9aK\x14\xa5q\xb7\xbe\xa2\xda\xee'\xac5\xb9P\xdf\xa8\xb2\xa9\x92\x93a\x18\xdc{\xa0g\xc2c\xf0\xa2n\x02KI\xd5\x18\x91\x01\xca\x1c\xeeEN\n\xf8\x1aH\xa7\x07\xdeXU\x88\xc5@V\n\x96\xc2\x1c^\x9f\x9d&\\M\xa6\xe9\xa8\x1b\xb4\xc3`Om^\x9c7v\xe0\x91?\xe0\x08\xb3U\xa1U#s\xf8\x86y\x08\xa4\xdc\x98]\x12\xcc\x87q\xc7cJ\xcdO\x88\xe4>A\xe9e^	\xf9\xb9\xa9)\x13\x0bA9TT\x91\x81Z\xd4\x94\x83\xb1\x1aE\xb1\xb4 \xa4U\xd0\xa9\xe6\xb9&\xa8\x94\x14V\xe9d!~\x84\xafX\xc4\xba~\xb1\xe35\x1ah\xbb\x027?H\xf1\xf8>\x13\x08\xf9Z\xe0\xe6]\x136\xf0Fd\xeb9B.\xc5\x14j\x05+\xfb\xf1\x83\xee\xb2e\xc0\x829\xadA\xe5\xb5j\x05\xe5k\x01keXm\x104\xa6az\xb6\xdbZ\xfe\x02\x93\xcb\xbd\xc0u\xbb\xbd\x9e\x87I\xd5R\xa1\xe2\xd0>\x9c\xab\xcd\x8f\x04kl\x05i|\xfc\x17\xe4\n\xbe8\x84\n7\xef\x98\x97RX\xd2\xb0y\x87\x99\xd8|gV\"[\x82\x80\x92\x19O\xa4\xcb\xed\xfeF\xd8rX\xff\xa6\xb3\xcd\xe6\xdd\x16\x03\x018\xe6`\n\x99\xda\xfc\x00w\xb8\x82\xe7,;\x0d\xfc7\x9c\xa3,\xd4\xf3}8a^\x0ea-q\xf5\xf1C;Z1\x1f\x0e\xfa\x88?\x18\xbc7\xb9\xa1\x07\x0bs\x95w,\xc3,\x1b\xac,\x86i\x19\xd66[b?\xf38|\xfb\xc9W\xaa\xd6\xccJ\xb3\xf9\xb1\xb3+\xd5J\xb1BP\xd0v5m\xdeIAR\x10x\n\x1d\xd4z\xdd\x81Ew zM\xd9\x1a\xb3\xa5\xdb\x83\xa6;b\x99\xc9\xee\x10\x04\xd8V\xe9\xb5\x9bh\xa9B\xdb\xec\xb0r\x83\x85\xd9f\x07,\x03\xc3\x86\xb00\xacO\xbd\xb2\x80\x85\xd2\x81\x05\x04\xa3\xca\xc6\n%\xcd>\\J\xe2I\xac[5\xca\\Ue\x07\xd9R\x19\x92n\x061s\x05I\xd2\xeea\x06\x02\xfbpM\xdf6^)*\xc8\x94\xb4\xc8\x9a\xc5BIh,|	\xb9X,H\x93\xb4L\xdb$\xa7\x9f-Q\xcf2\xa5\x87\xeb>^\xa2\xc6\x8cE\xc5\xd4X\x81q\x83\xf1\xc5[\xe1\x80-\xb9w\xa4\x16P\xa9\\,:V\xa0\x08Y?\x93\x17w\xf7\xb5\x0f/\x17L\x8a\x1e2\xa2<\xe8Y(E%\xac\xfb\xaf1\xa4\xa1\x15e	\x92Xm+>\x8a{\x02\xecw\x96\xb0\xaa\xb4\xa6\xcc^jA\xd2\"\x1f\xd7\xc0\xb3\x1f\n\xdaX%\x18\x9e\xf1keYS\xb1\xea[\x94\xa2N\xd4\x15`\x96)\x9d\x07S\xc7,\xbd\xfa\xd3\xd9iJ\"\x8a_\xb86\xb7D\xc2Tc\xac\xaa\x8eg\xb3\x9e\x97\xb0\xe4[C\xfa\xf3\x9c\x16BR\x0e\xc7\xb3\x19\xe8\x86\x8d@\xa90\xa7\x1c\x94\x04\xab\xea\x9e4\x95\x94\xf1u\xda%UO\x11\xbfQE\x91\xbc\xc8c\x07g\xaaa1?\xce\xa7\xc9$\xb3a\xb8'\x95\xd3\x02\x9b\xd2\xa6\x8c\x9e\xa8\xaa\xdb\xfcP\xca\x0e\x8c\xed\xca\xc9t\x04\x14\xc4\x9a\"W\xec\xe7\xb8\xf1)\xdc\xd1\xe6\x87R\xa4O\n\x18\xad\xed\xe6\x82\xf4\x9a@HI\x85\xdaY\xf2\x0d\xca\xa2\xe7|X\xf3\xee\xf1\xfd\xba\x8b\x8eO\xb2*\x93oq@H\xc8\x19\xde\x97\xc6b8\x88\x132\xcd,\xc0<\xff	\xd6\xbe\xd2E\xb4\xcf\xc4V6\xa1%\x0c\xceK\xbaVse\x877{\xa5\xd9\xbd\xb1\x90ilK6\x8c\xd3\x11\x98\xad\xa37\x87-\xcd\x8d\xb0\xd4#N\xc14\xd9\x92\x9d\x0d\xbf\x10\x90,\x84\xe4\x13\xc9\xe9\xc1\x8d/\xb4\xaaX\xd4\xc8\x18'jKa\xc0\xa9\xda\xfd\x1d\xa6Xp\x9c#80v\xe2\xd9\x05\xa9\xe4\xe7\xc8\x06\xce\xcf\x85LS*\xe5\x81Q\xf6\xa5:^L5\xd2\x02\xd6\xa8\xad_\x9f\xa5\xfb\xb9\x9b\xff\xbc\x1fv\x03\x9e\x8e,@R\xebI'f<Wr8\xf0S!\x85Y\xc2\x15\xabb\x1e\xdd\x9b\x1c\x97\xca\x10\xa8\x9a\xe4\x96\x82\xa6\\\xd87j\xb8\xfak\xe2\x87\x06\x0c\x86\xa5\xe0\x9b\x8c\x9a\xdeyn\x08\xa5*Xz\xe9\x9et\xe7\xd1*\xcc\x89\x9feO\x9dOZ\xb1\xcea\xf5\xa7\xb4\x81\x0c%hbG\x06\x96\xaa\x0dJ\x07Z4`\xbb\x9a\xf2\x84\x1bVXs,\xcb\x9e\x9f\xcb\xc1\x1e\xff\xd7\xef\xdcH4\xe5\xc6bj\xadW\xaa\"\x99w\x01k\xd7\xcaV\xb82]\x85Nz\xbf8<L\xa7\n\xf8\xcd\xe1\x93F\x8f*\x14\xe5+\xad{n^1\x00^i\xad\xb4\x19?j\x87\xca\x97Cn\xf0	\"\xe7(\x86m9Ba+\x8e\x9c'\xf0\xc4\xb4+4\x89\xec\xa31\xad\xd2\xf9\xeeL\xa8\xc3\xd0S$\x94\xb6=	\xb72\x18\xd2\xf7\xa4\xa1\xe6\x91]Z\xc9\xe8\x13\xe4f\xcd\xfcIj\x9f&d\x9ay\xae*\x142\xa5&\x0b,\x88\x83\xa0k\xb2$G\x86\xe2U?\x069Z\x04\xddc\x04E\x84\x9d\x81FZQ\x86'\x86\x99\x15\xf7\xc2\xb2\x0eD+\x8c\x15\x99w\xca]\xdc@\xf9>\x1c\xc2\x8a\xa86\xfc\xb8*\xf7\xe0\x17B\nKe\x97r\xf4\xb0d\x93%\x86\xcdE\x00\x0f\x0f\xe3?\xa1\xb1\xe8\xa1F\x99\xbfL\xe4\xf7\x95\x83\xc0\xcb\xb2\x843\x9e\x12\xc5&\xc09\xbcr\xca\xcf\xec\xc3-\xcd\xab)\\\x9d\x9c:\x0bx~\xf5\xa5\xfb\xdb\xa9F\x83j\xfd\xcb\xe2M\xc9\xe7\x16p\xb1p\xa6h\x1f.\xa8\xf5#!h\xe1P\x04K\xa38\xc6B\x99\xd3H\x1e\x1e\xea\x91$<\xa4\xd7\xef>@\xd5|\x11\xce\x01\xe2ph\x98\xbb \xca\xd9\xedw\x17\xde\xef\x8ee\x1aV\xec\xc8\xac\xacj\xa3\xa6\xd8\x82\xb27y\x977w\x8f\xdfg\x1d\x18\xf1\xf8\x1e$B\xf1\xf1\x03[#c\xb5\x92\xe9%,\xca\x91\x7f\xa6\x1aiu\x07\x1e:\x8eLy\xd7\xa4!KQ\xd8b3<Q\x8c\x0b\xa53\xca9\xbc\xed\xb9\xbe\xed\xaa\xc6\xac\x95D\x17\xce\x8aJ\xb5j\xf3\xc3\xe3\xbf\x02\xfd\xdbn\xf3\xee\xf1\xfb\x8c\xd3	\xeb\xb6K\x0d\xe9\xc7\x0f\xed\x14\xac\x16\xf5\x8a\xfd]\x01|\x14\x04\xfe\xfc15\\\x05\x96%\xe9\xee\\\xe5\x83N~\xeda\xe0\x80~\xa5K\x0e0\x0dkD\xc7s\xf4\x08Y\x8b\xf8\xdbL(*\x95\xba\x16\xaf\xfd\xa7'\xe3?>aCC\xe4\x1f\xb9\xf8\xca}\xbe\x80\xc30\xf7\xb8$\xd4!=\xb0}rK\x91\xd3\x11\xbb\x0by:\x9d\xc0\x07\xce\xb93/Q\x9c\xdd@\xcc\x15\xf03C\x0bK\xbc'\x98\x13\xc9\xf8\x0c\x87\xed0\xe9Q\xae\xc5\xcdg\x08\xdf\xe0\x01\x96\xe5AJ\xd8=r\xc3NqA9\x1b\xf0>\xc5\xc2\xce\xb6K\xaa\xf4\xa6\xd3M\x86\x8a,\xbaY\xe35\xaf)k\xb4\x11\xf7T\x0e\xc1L\x0fs\xbc\xf3Y\x08Y<\xb9\xad\x90\xe3aK\xc6A\xabU\x80\xe9\xd1\x81\x8e\x94\xca$R\xe4\xad\xba\xa0|\x90j\xb7\xd9\xc1\xb9\xdd\xce\xb8\xc0_gK\xd5\xfe\x0d\xe6\x8d\xb5J\xb2\x97^\x97\x989\x9f1\x994,\xa0\xb4X\xf3c+\xf9BD\xe2\xc6}\xd5\x8f@\x1c\xf2K}\xf9\xbbl\x89\x12$\xcbmY\x08\x1c\x88\x89\xe1\xae\xdf\xa8BD\xcf<A`\xc1\xfcJ\xb1\xba\x8f\x88N\xaf\x81\x83\x81Sk&\xd1\xd4aSn\x1a\xd4\x9a8\x1b\xe6\x9e\xe9\x92\xf1\xb7\xe8^+e/\xefIk\x91<\x1cO^+eA\xc51\xbf\x8d\xb3\x05kGh\xd9\xd7\xb1\n\x96\x89\x1at\xe2\x80`\xa8F\x0e\xc1\xa0T\x99\xf3\xc1\xa0\x14+\x8e[\x8eO.\xa6@\x92\x15\x08\xfb[\x9c\x04\xf2K`\x9ek2\x06\x96\xa4i\x1f\xde\x10\xcb0U\xb5\xedx\x05\xf6\xdc\x19=\xb8\xe9\x11y\x1f^=`U\x97\xf4\x02\x9e/\xad\xad\xcd\x8b\x83\x83\xa0\xd4+*\x9a\x0c\xd9b<\xdf\xda\xeb8\x9c\xf3\x9b\xfc9\xa1\x1c\x0b)k\x08\x94~\xaf\xbff\xec&\xaa\x91\x818\xab\x12\x03qV\x8d\x0c\x84;\xe0\xb1\x89\x10\xb2\x14\x92N\xc5\xc8\xc2\x8c\xa4!\x18\xbd`\xaa\x844\xfe!\x10\xd4l\xd7l0q2\x07M.#7\x8a\xfa\x0cY\xdexr\x8c\xf5\xae\xe7pv\xf5S\xce\xc2\xd9\x15{\xddy\xef+\x99x\xa0\xbf\xc0[\x10\xdf\xe6\x83\x1bt\xf6\xc7\x93\xa3 \x94\x0c\xff	\x0f\xe1\x0e]\"kP\x06\x7f\x88\x80i\xfa\xd9{\xfeg'f\x1f\"\x8es\xa4]Z\xd2\xb3\xeayO.\xb0L\xa38\x0e\xe9\x9a!\xe6:^\xa2,8\xda\xb1\xa4\x17\xacO\xca8>L\x17r5p\xf6F\xc8\x95\x805\xb5\xf2\xf1\xbd\xd5k\x19	\x9d\xa8\x1c\xef\xa6\x9c\x15\xdb|\x07e3\x07\xca;\xdb\xdcA\xb9\x8d\x0f\xad\x8bI\x1b\xf8\xfd\xef\x7f\x7f\xf0[V9x0,V\xa1\xc9\xd4 +\xe7\xfes\xe4T{\x14\xc8\xbd\x12\xd9\x9e\xea\xdeL??\xcct\xc0\xf8V9\xc0XF2\xe9t\xdb$\xa9\x94\x1bM\\2\x80\xbcD\xc8\x95V\xc6%\xe2\x02\xbd+N;\xd5\x82tk\xd6t\x07\xadXw\x99\xe0\x83\xf7\x05\x84\xe6.u\x108ye=5N\x00\x8d	\xb2\xd7c\xe9\xce\xbb<\"q\xad*|xi\xd9\x81`gx8\xfes|\x10US\x01&c\xd1\xbf\xf2#\xb2\xa9\xe6\xa4Y\x86\xf9!F\xf3\xc422\xa70-\x9a)\x8eg\xcb\xed\xe4\\\x85\x0f_9\xbd\xd2\xaf\xf9\xc6\xa5|\xda\xce\xa8\x95rg\xa2\xe6\x1a\xd7\xab\x98\xaf;\x8f1\x14\xf68\x8f\xff\xe2\xcc\x1c\x99n\xf3\x0e%\xe7/\xfd\x8c\x8f\x1f\xda\xd1\x06\xcf\xf2\x92nDE;\xbb\x13y`\x0b,\x0f\x87u\x84l,\x99\x18(\x86\x1dq\x86\xdd\x07\x99\xad\xb0K\xd5X\x17As\x08j\xa60\xa7\x85\xd2\xecn\xb0\xb6\xc88\xda\xcdc*@dX\x96\x1d\xc7\x00\xec-H\xe5\x95c\xf2j*|\xb8\xacI>\xc9_\x1f\xd4\xfe\x07\xfc\xfd\x07\xdc\xa4\xd6D-\xe0\xcbC\xa8\xfc\xde\xc7\xec\xcd\xc4z8:\x7fIZ\xad+\x81\xba\xf9\xd4\x15u\x11\x03Z\xbdn2\xe4\xacO\xc4\x85\xb6\xd3\xb8\xf9Q\xc9\x0eZ\xa8\xa8\xc09\xde\xa1M\xdd\xc6\n\x1fnEn\x97[\x8b\x9a5\xe9\x7f+\x1a=\xd2\xcf\x92\x0d*\x9a\x9bo\xfae\xce\xfdgx{\xec9\xf8\xf4\xaaw\x01c\xc5 \xf3e\x07.\x119\x9f\x80\xf18&H\x1d\nOx\xb6T\xdaf\x8d\xfd\xbfX@\xe5oT\xb1k\x82\xd8\xc1\xd7\xc1\xd9P\xc5O\x99\xa3j\x8cI\xd2jA\xbf\xc0\x14\x0d\x99\x96a\x9b\x03h:\x06\x8c-\xcb\x80\xe7^YjV\xa60G\x19\xa2,o6s\x8e\xace\xd1\xb0\xbf\x15\xe1\xf3\x8eeXh\xa8$q\x91&s\x16k\xe0M\xa65\x92\xd4\xa5<\xab\xc4\xe3\xfb\xa9\xaf\xc1\x10\xcc\x1f\xdf\xe7\xeb!\xaf\xc8\xae\xe2\xba\xe3\xba\x05\xab\x94\x9d\xf8JR\xdbgB\xe2\x8e] \x1c\x81\xdb^+O`\xd5\x97\"'\xd91~\xfd.\xab6\xd6\x8fRY\x91\x0d\xdc_\x16\x9bw\xca\xb8\xe2BX\xe0k\xfd\xf1\x83]aRL\x86Z\xadp\xcd[\xc0\x9d(79\x14e\xc5Bd\xe3\xec\xf8	\x99\x15g\x9b/\x92\xc1x{\xaf\xc9B\x1e\xc6\xd3\xc9\xc6Wy\xbfm\x94\xe5t\xb5f\x97\xb7\x93Y\xcb\x8f\x99k\x138g5\xc9\xf9z\x8b:\xd1\xfcR\xb5\xfc\xb8\xd2`\xe1B\xb5\x10`p\x84R\xf6\xf9\x9c\xe3F\xb3sV\xfa\x17\xc0\xea\xd5(Y\xb0\x0b\xaf\x0f\xf0\x80+\x9a\x9c7\xcaI:\x19Qv\xc95	\xab	\xabx.\xbcM~\xaa\xac\xecx\x07sG>\x11\x12U\xe6;\xf7yY\x0e~Z\xe0$\x99\x10\x02\x81\xe3'j:1\x80\x800\x087C\xee\xe0\x1c\xeb\x9aw\xa0\x16}L\xa98\xac4[\x1eC\xac\xf4\xf0.\x99\xeb\xa0w(\xdfI\x07\xabVR\xf2\xee.\xfdg\x10+\xfe\x18\xbf7\x972\xe6t\x0e\xd7\xd3]\x80\xecB\x0f\x9fsf&8\xd8\x0cic%\xadV\xa5\xd9\x87\xf3\xc6\xd8\xddr\x91\x92\x94\x1ca\x7fT\xf1\x18\xae>uv\xf5\x12\xcd\xf2f\xa9\xc9,U9\x84{\xdeq\x9a\x97*[\x95\xc2XW\xe7\xf6\x18\xd3\x91A\x1c\x9c\x0e_\xad\xe2\xe3\x9c\x0bk`N\xb6\xe5`\x9f\xcf\xab&\x9dQm\x1b,\x81W\xe3b\xfa\x82#\x96\xa6\xe6\xca\x8a\x13\x14\x1c\xd6\xea\x0b\xf4\xd1\"\x064\xdf?\xc1\xf5\xbd,$\xf1\x9c\xef\xeb\x01\x06\xe8\x013\xcb\x0d\x18\xd9rd/\xf9\x11\x1f\x87\x9c\xfb8\xca\xe2'\xdf\xa7\xe3\x7fV\xb4\xd5\xe7\xdc\xb1\xd7\x0f\xbff\xc0\xc5\xac\x9e\xb9\xb0\xc9\x87F\xc3m8\xa0\xd3Q\xc0~\xf8N`\x1d\x10\xd8\xe7\xa6\xdc\xe9-hd\x1e\xc2Y\xf7\xc9C,\xbfY)\xb2\xd5>\xdc\xb2\xa2\x08\x15\x95|\n\x12\xefE\x81\xecd\xf1\x0bX\x8e)\x85\xce\x8b\x94Q\xddH\xda\xaaw\xbcu\x01\x80/8\xc7\xd4\xdf\x08\xe6\n\xea\x9b\x1f1\x97Q\x8b\x7f\xfc\xd0\x82\xca\xe1\x82K\xf0[\xc4oB\x9bH<\x01O\xc9\x15q\xc7\xc4=\xe8g\xd3\xee\x9a\x9e\xe4\xacl,\x90\xe4\x13\x88\xca\xedD\xb9t\xaaI*Xz\xce5\xed~\xd25\xe5\x07GeC\xe0\xa0\xe3\xac\x06WrY\x96\xe7<n\xb9\x12.\x16,\xc4\x15Z\x16jvS\xe1\xf9\xdf\xf5s\xd6\x90\xcf\xff>OR\x00\xbcWN\xff\xf5\xcb\xdct\xe5Jq+\x80\xca\xb3ug\x9b\xeddd\xa56?\x96\xc2'*\xddQr\xcd/\xcd\xdch*\x04\x9b\xea\x84\xf1\x00\xd8V\x00\x9aJ\xb4\xe2~\xec\x8f_\x07 0\xd4X\xac\xea\xa83\xfb\x91\xde\x0b\xf6\xc3\x9c\xf5\xd8\x7f\x01\xcf\xbf\x80%\xa7\xa8\xb1P\xa3\xfd\xd5\x84\xe9!\xba\xcf]N\xea\xb2\xbb\x1eE \x7f\xbdf\xd8\xdf\x00-\xf8\x81\xe8\xbe\xdc\x13\xb8\xa1\x98\x16\x0bb\xab\x19	\\\"!\x94i\xebQ\xe5W\x13\xe70v\xbd\xb4k\x07\xff)\xef\xcc\xcf\xfc\x05\xee\x98V\xca\xbe\xbd~3l\x9eSW\x0c\x88Un\xff\x19\xf9u\xaa\xcf\xa9\xfc\xa4?\x805\xa1Q\xd58\xab\x0b\xb5V\xec\xf8jg)Z\xa5W\xc9\x0bu\x85\xeb~\xcd\xbf\xa0\xc1\xbeG\xc3\x7f\x84W\xd9\x0c.\n\x07\xe8j\xc5\x05\xb15c\x0c\xb4L\x1aX\xcf\x86\x14\xc2\x89b\xef\x03\xe6M\xc5\xc5y\n\xbdP\xf1*\x92R\xb9\xdbNL\xc6\xf7\x1a7\xe1\xd6`9H\xc7\x11\xadkA\xd9\x9ac\x87\x8f\x1fbYl\xf6\xf1C	k\x9c\x87A\xe4\xfa\x01\xf6\xe9\xf7}\xb8\xc0\xbb\x92jAwS\xd8\xfcH\xf3\x0e*\xde\x1eB\xa6\xb8\x11\xb0\x92\xdc\x03\xf8[_\xd5\x13\xa3\x95\x9b\x8c$\xaa~\xf5\x19\x03.069\xc5\xf1\xfdLU\xa3\xd3OHp}X\xc9\xad\xee\xa3\xdb\xa4\xf1\x88o\xcc\xdc	_\x1a	\x84}w\x12k>\xcc\x96\xae\xff\xe8\x0eW\xa2\xf28`\x1e\xbf\x8f\xf1j\x97\xad\xf9N\xda\xaeT\x85j\xddE%k\xd7J\x94\xa9\xc7\xe1\xed\xf6,\x82\x83\x18;\xb5\x16pw\x8a	\xc6\xe2b1\xee\x1e\x9a1\x08<l\x9c\xb9u\xf5'7\x03,\x0fGG\xcei\x83%a\x9e\xc6D\x86\xaf\xe7\x9c,r\xd9.!\xaeE\x1d\xce\xb2\x8a\x83Q\xb9T\xfc\xb2_\xfd\xe9\xect\n\x7f:\xbfJ|\xc8\x889\xf59\xdb\xd7W3\xc8\x94K\x07:\xb3\xc5\x88\x19V\xa49\xcb\xcb\x1d\x98\xb1~\xef\x9d\x07\xca\xe1\x0fW\xaf^O\xe1\xea\xe2\xb5\xc3\xbd}ut\xe5\xf3$\x03\xbb\x96\x1e\xec\x13Z\xd8\xd2\xca\xd8\xedj\xd0\x90\xe5\xe0\xf4O)\xc6)\x0e\xae+\x0eO\xe6\xc6}\x051v]#\xae\xb7d\xabo\xc4\xbf\x1e/E\xe7\xf8\xd0\xcfv\xa9\x11,\xe3\xebr\xcd\x92]\x9a~\xb8\x18\\0VW1\x1b\x12\x1d\x14\x13\xd3\x0f\x18I\x08\x135\xd8'V\x17I\xf8*\xe4\xff\xc7\xea#\xe9{\xaaw.\xc4VSP\xb50\x8a\xdb\xe8FH\xc3>\\SM\x90\xff\xa7_\xc5\xb8\xfd\xa6\x7f\x15\x92/dH\xf0\x0c$\xb9\xdd\xe9\xe8\xf5v\x1f\xcf\xd0\xca8\xa6\x17\xfax\xd8\xec$\x8d\x91\xdb\xd4~v\xb2\xf2)\x12.\x9e\x0b\x0e\xd2\xa5tf\xb0'u\xcbc\xe1\xa8]a\x85\xcbSQ\xf3\xbfLsN\\\xae\x88wb\x158\xa2\x94\xefN\x1d\x96\xa5y5.\xf5\xdc\xd2\xfc\xfc\xdfTz\x1c\xcaN\xa1\xa77m\x06\x9e,\x17\x05\xb7,u9\xdb%\xda\x972\xf5Qn{\x08\x9f\x8a\xc7@\xc6\xd8/\xb8\x94+*\xe2\x96h\"i\x96\xca\xeeh\xedV\xe9\xd5\xa8\"\xcb-\xdd.\x07\x13\xc8qY\xce\xc4\xe2D\xc8w0S\x86#C\xfd\xf4\xc5\x04\x9a[\x9d_\xbf\x02\xe5\x8e\x8b\xf7\x83N\xf8\xb3\xff\x9c&\x1f\xbb\xc6\xe9\xd9\xde?\xa7\xcf\xf6&\xa1D\x13\x9b\xe9\xa5\xef\x0c\x9a\xcc5\xfaF\xae\x89?$\x86\xe5\xaaF\xd3\xdc\xb1\xdfI+\x8d\xd2?\xbeI\x1bR\x7f\xe9x\x92\xfa\x9b\x84\x85\x8c\xd2\x96w\x1f\x8d\xd1\xe4\xc89\x06\xf1\x82\xbc\xc5\xe3\xdc\x8du\xc6x\xe8\xb8I\x86\x9b\xd8\xb0\xe9-Bl\xd1\xdd\xc6\x8d\xe0$\xb9\xcc70\xb1\xd8\xd7U'\x97\xc5\xc7\x0fe\xa8RLf\xb1Ynr\xdb\x99u\xb3\x12\xec.\x93'\x10v9[\xb1\x8a\xe9zRT\xd5%\x9b\x96xps\x94W\xe9\xa3\xfd\xb3j\x92\x9a\xb6Kb\xe4\xbe\x0ev\xf0\x999\xe0L\xd8g\xa6\x0fa\x17\x8a\x7f\x1f\xc0\xc1\xb1&4J\xbe\x88w\xc7\xe6\x94\x13k\xae\x9d\xd2iW\xe2W\xfb\x99\xe1 AH\xf8\xcc\xec\x07\xd4\x97i\xf5\x8b\xa7\xf96\x9b\xa97\xc9gW\xacX?\x0b\x81o\xb8\x91FD\xe6\xfdO(&g13\x15\x8e%$#x\xe8k\xc5\xfd\xd5\x02\xd6\xa8\xd7\x8f\xdf\xe7kw>^\x07\xf3o\"\x98\x87	\xe6\x1c\x92\xfa\x8aO\x80\xc8\xeeT\x94t\xd3\xd5\xfc\x90&/e\xe7\x8c\xaak\xe4\n\x18\xb5WO\x93\xbf\xa0\xe5\x16\xe7|\xf3?\x93\xad\x1f\n\xc4\xdf\x08\x04\xb81\xa2\x90\xce\x03qc\xee\xd3\xbb\x1c\x1ea\x8eNX\x8fP\xf6\xdf\x92\xf4\xac\xa6\x8c\xef\x8a\xcf\x89jk\xa0\xa9Y\xad\xfd\xe6p0\xfcS\xd7\xf5\xae4\x1b\xff\xf3\xd0\xdf\xcf\xf6\n\xaa\x90\xdd\xcfEE\xae@\xe9\x12\x14_\x1e\x1e>|qx8\xed\x87y\x06\x18\xb1v\xb1\x057\x8d}}\xe4\x14\x83\xe4\x98\xbe\x91\xf9~\xe0'&2\xa2\x92\x9f\xbc\xcc\x9d\x8a\x15\xe3\x9cJ\xc0f\xdd=9\xea&[\x0d\xd2\xa3\xdeh\xee\xf8\x95\x05%Y\xb1\xc9_*A\x9b\xef8\x9f\xb2y\xa7\x02R\xc9g\x97\xf1\xe4\xf8o\x80\x13\xb2-\xf4\xed\x1d\x01\xa6\xe4B\x14\x8d\xf6Q|\x10\x00\x07q\x9d\xd3\xd1\xae\x8eqgN\xe2v\x90\x0d\xe9\x96tx\xb1\xce\xbb\x1f\xa8\xde\x84\xf7Lc\x92\xc1!\x9c\x9cD\xdfo\xe2=\x92~^\xf89\x86\x8b\x1c\xc2\x14\x07\xe9\xcf4 \xb83M\x11Bb7\x8e\xd71\xad;\xc9\x1b\x1d3\xac\x93\x93\xf8\x7fl\x84bS\xc4\xec:\xf7+te\xf5MNa\xc3\x16W\x81\xd4\xa2\xc9V\x97^<O\xdf\x1e\x7f\x0d\x97\xa7\xa7~\xa0(\xd5\x1c\xb9\x1fj\xf2\xda\xff\xe7\xa0K4=\xdb_a\xd0\xfcaH5\xee<\xbf\xe2\xbfC\xcb\xc5\xe4\xec$~q\xa0j\xbb\xc8B\xb8iQ\x96T\xf8\x85\xce\xfc\xbf\xae[\x88\x7fB4\xb4\x14\xb8\x0d%]f\x13N\xb6E\xa9r\xd2 \xfcO\x89\x18\x1e\x9b\xd2\x07L!\x8b\x9d7\x85;/\xe8\xdf=\xa0/\x7f\xc1\x03\xe2@\xc7\xd5h&\xb7\x1c\xf44A\xd7x\xb0\xef\xa6\x8b#\xb0&h\xcd\xba3\xd6\xfd\x12\xa0\xf1\xba\x8b6\xdfMBf=\xa8\x96|\xc8'M.\xd4\xce\xcfw\"\xf65\x99\xa6tEW\xc6\xd2\xe1+\x0c\xce,\xf6CIcaG\xe1\xd0\xd3L<\xb3\x98f\xee=\x86*s\xd2\xc9\x1a\x97\xfc=^f\xb0\xd6\x93\xb7\xc6r\xbbjo\x0bU+/T\x9ft\x9b\\p\xe0\xa1\x8c\xc0\x1cM\xc8}%\xbf+\xf1Sj\xc2\xd57\xfc\x833\x17!N\xae\x08W\xfe\x07h!4t\x19G&\xd6W?\x1c\xc4DP\xc4jt\xd1?\xac+\xfep\xef\xea \x11d\xf6\x15\x85\x17\xb9\xeb\xf0o\x08\x8e\xf9\xacO\xb5\xaa\x98\xa6\xfb\x9b\xc0\xff\xd8\x90\xfb\xc1\xced\xe6>C\xcbY\x82p\xc3\x81\xf9\xe4F\x8da\xaa\xb4\xa2\xe6\x81SQ\xfa\x9e\x1dw\x8blsM3\xbf\xa3\xccN\xc3\x8fPt\xc8=p\xdd	Hf\x18~\xbf\xc5\xde\x9b)].z\x1f\xa2\xc5\xb8\xa6\xa2)\x91S\xb957\xfd\xf0=\x04\x894d}\x11\xc4mpF6\x14-z^\xed\x1b\xffb\xe2px@\xce\x0dM4\x8dYrw>#\x85\xff\x12\xe8\x91\x8b\xba&3\xf7\x01\xf3\xd0l\xd5{U<i\xa64wV\x84\x94g\x1dO\xc5\x07\xfb\xbd\x8a	\xc1O\xaafL\x14\xddY/\xb7a\xae?.&~\xc3T\x03\xb4\x93\xce\x8a0vc\xa0V>\xe8\x1dD\x91\x11\\3&c\xbd\x95\xe2\xdb\x86X\x01I\x97\x99\xf7\xed\xb7t`\x15\xb7\x9a\x9d]\x81+xL\xfa\x10\x9b\xe7\xf4\xd9\xd3\x10\xf6:\xe6\xe2\x1b\xf5\x98\xec72f\xef?\xda\xe0d\xdc\xf4\xbeE#\x83/\xf0\xd6\xfd\xe3\xe65\x8e\x1b\x16\xd6 \xf4\x81\xbdP\xa1\x9c<\xdb\xfb\xe7\xb3\x7f\xfe\xef\x00PK\x07\x08\x8e\xaf\xc1\xeb\xe3\x15\x00\x00c:\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cV\xcdr\xe3\xb8\x11>KO\xd1\xc5*\xd7\xceT\xb9\xbcw\xdf$\x8b\xde\xd1\xc4\x96\xb4\x12\xc7\xae\xa9l\x0e\x10\xd1\xa2\xb0&\xd1\x1c\x00\xb4\xacl\xcd\x8b\xe4\x0d\x92kr\xca\xd5/\x96\x02\xd0 i\xa7\xa6\x92\x13\xbb?|\x0dt\xa3\x7f\xc0?\xa6\x93\xec@\xa6\x11.\xbb\x86?\xa6\x93I\xb6\x17Z\xa3\xcc\xae!\x9b\xcfV\xab|\x01\xf3\xaf\xf0\xd3\x85\xfd	n\xd7[\xb8\xb0\xe1\xf3[va\x7f\xcb\xb2K\xcf\x97X\xa3\x8b\x06\x8b\xfc./\x06\x8b\xb8\xae\x1aQ\xe1b -\xefg\xbf\xe4\xf0c\xea\xae%U\xa3\x19\x93w\x9b\xf5\xf2.\xdf\xbe\xa7k<m\xc8:\xbb\xd4\xc5\xd1\xa0\x08\x06\x17\x124\x9e\xa0\xf58(\x0d.\xac\\\xc5\xfd\x03:\xd3r\xe9]\xb2\xebF9v\xfcBF\x8b\x0f\xf6#\x08-\xe1BB\xf0\xc5\xeb\xc4\xb4a\x87\x1f\x18\xbe%v\xa6B\xe9\xdd\xf3\xfbo\xd6\xbb\x026_\xb6\xbf\x0c!\xfc\xd7=\xda\xa3\x90t\x9a\xabt\xfb\xbbO\xb3\xc5\xfa\x11\xe6\xcb\xff'	1\xcc;*\x9f\n\xaa\xaa:FU|\xda\xe6\xb3\x85\xcf\xd9\x9b[\xee\xf4\x90\xe2/\xab\xb7I\x8e\xbb=+<\xa1\xdc\x89\x06\x97\x9b\xe4\xfe\x0e\xd6\xb7P|\xcaa7\xbb\xcfa\xb9\x81\xc7|\x9b\xc3\xc32\x7f\x1c\xbc\xcb\xa6\x93\xef\x97\\P\xd6\xd7S\xd0\xda\xba3\xa2\xb6\xa9\xbe\xa48g\xd7\xf0\xe7\xe9\x84\xe5\xcb$\xd9l:\x99\xfc\xc5\xab\xd9\x91:\xd3\x93\x82\xe2\xe1\x88\x8fh\x8d\xd2\x9d\xc3\x9e\xc8\xaa_Jkc2iw\x1c\xb8Ac\xaa\x97GL\x8b%i\xd9SY\xf5KimD>\xa3\x18\\\x0d\xcae/F\x1a_J(\xb0t	B6J\xfb\xab\x9d\x05\xc1\x9bd\xa2\"\x8f\xf8O\xd4\x83\x0f\xe1\xc3:E\x13\xfd\xfa/\xad\x1af\x95\xa4\x9d\x11\xa5\x8b5\xed\xd7o\x18\x01\x86F]:?\x0fI\x8d-	\xa2\xaea\x7f\x86\xe5&\xf2\xf0\xa5\xe5s\xf3 )3\xc6\x87C\xe2\xea\x9b#\x8eJ\xfa\\d\xb9\xf5\xd7\x87l\x18\xc3L1\xfe.\xb4rd\x82\x9f\xf7\xa8$\x07\xf1{g\xdd\x8aN\x1c\xbe\x11\xd0\xa0M\x01\xd6T>\xc5zf)\xa0\x0dI4\xa2\xdf*iq1u\xe25\xf4b\xc4O\x1a\xe3\xd9s\x12F\xc2\xda\xeb\xdc}\x88\xb3\xba\xf6\x16\x0fh\xc0\x91$\xbe8{\x8c~\xe5/j\x9f.\xc3\xc6\x11\xe5\xd9<\xad\"\xd7\x85\xde\xdb9U>\xf9\x1a\xcfb/\x82\x8d\x007\xdf\x10O/\xf7M7\xce\x8f\xcfT\x9f\x16\xdf\x91\xb9T\xee\x8e*o\xe9E8*\xeb\xc8\x9c#\xe1L\x9d_\xf8Pt\x1fS\x17\xda\xb3.\xb923\xa9|R4\x96\xfd]\xb0\xaat\xc51\x9du\xf9FID\x89\xacMCsfN5\x98\xca\xb8\x145j9j\x80\xcfBs\xfd\xdf\xe23K\xf7}O\xcc\xf6I\xba\x17\x8a\xa5\xcf]\xb2\xf8\xdc\xd5,\xcd\xb8\x05&\xd9\x0e\x1dK\xeb.I+J;/\xf0\xafC#\x9e\x10\x9fz?\x16\xd40g\x87\x15K\x05\xa7i\x92\xfd\xda\x89^J~\xec\xf0%Ib?\xee\xdbN\xa5h\xf7\xe4\x1c5\xfe\x9a\xb7$E\xfb\xfa\xf7`\x90\x95B\x97\x18J\xe7&H\x1cnV\n'\xea\x98\xaf\x1b\x16#^+?\xa6o\x063\x0f\x80#\xe0\x9d\x98E\x16\x1f\x85\xd1>)\xd7\x90}\xa5\xce\x00\xb5\xa8\xc3K\x05'\xe5\xfb\x16!\xd0$\x88\xceQ#\x9c*E]\x9fA\xb8\x94:\xff\xea\x86Z\x1fu\xfc>\xe8\x91@\xda\xe72[6-\x19\x07\xb6+K\xb4\xf6\xd0\xd5\xf5\x15\x14G\x84VT\x18\x8f\xd2t\x02\x835\xf5o\xe9A\xd5X\x10\xdd	S\x85-\xbc\x0e\x8e\x08\xea\x80\x84\xed\x0fJ+{\xe4\xc7\x08M\xa3\xb4H\x1d_\x11U5\xee(\x06wS\xabo\x9d?\xce\x08h\xd1~\xeb\x94\x15\x06>D\xd2G\x10\xd0\xbc\xfe\xdb\xaaR\xf0H	\xde\xde\x901]\xebF\xee\x1f\x84\xaaQ^\xc1\xadw\xa5\xe4\xe5\x91\xc5\xe2G\xd1\xfe\xcf`\x95~\x16\xb5\x927\xa2u\xe5Q\x84=\"\x02%C\xe1\x98'\xc46\x8cU\xa5\xab[\xf2]\x91\xfd	\xb1\x05\xc9\x18\x1c\xd2\x84\xaa\x85\x0d\x9e\xbf\xfe\xadv\xaaI\xb3\xc6\x8f\x86\xe1W\x86\xa7G|\xda\xc1\xaf\x0d,\x94\x05\xcd\xfbr,\x8cx\x16\x92@\x10\x98qe6~\xc2>(\xabH\xa7?\x910t\xe19`<I;\xeb\xee\x85+\xfd\xdb\x98m\x84\xb5'2\xd2\x82\x87\xa1	x\xfa\xd9\x1a\\[\xd13\x81{\xfdg\xabJ\xce\xa7\xa6\xd1xZ\x11\xa0T\xce\x82\xc1\x92\x8cL\x93\xa4%\xa5]A\xa9\x19\xfc\x8f\x85G|\xe5'l\xf8\xc9\xe2\x97%\x90\xac\xb3?G\xfd\xe7\xa2\xb8\x8b\xa4o\x1d\xf1\xcf\xdb\x03\x95\xaf\xff\x80\x03)\xf0X_a\x06\x85\x8d\x0f\xe66J\xc1\xcc\xa0T\x06K7?\xc7)\xbbe\xfd\xfd;8\xf0\x86\xa8\xdfscf\xd2\xbe\x07\x836\xdc\xe1\x96E\xc6\xdb\xfa\x9c\xa2H\x93\xc1\xa0/@\x8fn\x834\xe0\xae3\xec\xb2#\xa3{\xbc\xab1<[\xdb \xf0\x83%\x0c\xe7\x8c\x1b&\xe1\xd6\xe76\x7fi\x15\xffC\xdfQ\xa540\x0e\xc8\x0b\xfd\xe3\xb6\"\xa7\xca\xd0\xc2,\xc5\x95n\xdf\xa8\xe0\xe2.J\x01u\xc7\xae\xd9k\xa1j\x1eJ\xc5H\xbf\xba\xe2fq\xd4\x865j9\x13\xee\xdc2_\xaaJ9\xa1S\x8a:\x9d&D*\xcf\xaf\xd4\xc1Q<#\x08\x0d\xc3j\x18y\xc9\xe4\xe4krH\xca\x97\x08\xbcIF\xd7\xfaA\xe5\xc7@`\x04\x0d\xfc\x80\xe2=\x02\xb01T\x19\xb4\xe1^#\x82\xb2\x8f!\x02\xbfv\xd8\xc5;d)\x98\xbfs\xe0q|\xfct\xf2}\xfa\xfd?\x03\x00PK\x07\x08\x0f&\xb0\xf8w\x05\x00\x00T\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc{\xcdn$\xb9\x91\xf0Y\xfd\x14\x81\xfa0\x9f\x00C#\xf5\xcc\xac\x01C\x06\x0cH-\xa9G\xb6\xa4\xd6\xa84\xd36\xfc\x03D%\xa3\xaah1\xc9l\x92\xa9R\x8d\xe1w\xb1\xb1\x07\xc3{\xdd\xdd\xcb^\xf5b\x8b\x08\x92\x99LI\xdd\xfe\xd9\xd9\xbdH\x99d0\"\x92\x0c\xc6\x7f\xfd\xe1\xd5\xcel\xe9|\x1bf\x87\xf0\x87W;;\xb3\xb3\xa3of\x87\xf0\xebW;;;\xb3s\xcbS\x18\xb5\xb3\xd0\xa1%\x03\x91\x1e\xe2lO&Om\xf4\x9a\x02,\x9d\x87\xb8&X\xa0\xb5\xe4w\x03\x9cy\xfa\xd0\x93\x8df\x0bG\xe1\x8e\x14|\xd3S`\x1c\x01\x8c\x0e\x11\xd0*\xd0\x15\xe6\xd6)4\xb3W;;\xbfe\xc4\xb3\xab\xf9\xd9\xfb\x81\x83+\x17a\x8eK\x823\xe7\xe1\xbd\xf3w\x99\xf8\xb1C\xaf\x00\x8dq\x9b\x00-F\xf2\x1a\xcd\x1e\xc45F@O`]\x84\xc0\xeb\xa2\x83\x05\xc1\xbd\xa6\x0d1U@\xd88\x7f\x07d\xef\xb5w\xb6%\x1bG\xcah6\xb8\x0d\x17\xae\xb9\x1b\xe8\xcf\xa9\xed<A\xf4x\x8f\x1e\xac\x03\xef\x14v\x8f\x7f\xcdl\xdc\xf288\x08\x8dw\xc6\x80B\xe8\x1e\xff\xbc\xd2\x16+Ph)\xb4\x0e\x1a\xd7\x02\x02.dj\xd9[\xe5\xf6+\xca\xd6\xd9m\xab\x03\x0d\x84\x8f\xac\xb3\xba\xd5\xdf\xa3\xcf\xa4.]\x88\x1e!:\xe5\x02\xb8\x00\x9d\x0b\x11\x95\xf3\x14\x18\xb5\x03\xb4\x8f\xffau\xebB\x85\xd57k}_\xe1L\xef@\x0f\x9d\xf6\xa4 \xae=\xa1\n\x99\xc0/\x88\xba\xa7S\x80\x01\x18\xe6sg\xcd\x162\xbe\x00\xda\x86H\xa8\xc0-A\x91\xa1\xa8\xed\x8aE\xa0}F\xfa\xc4\xbbn\xeez\xdfP\x18\xb8\xe0\xb1\x82J\x81nqE\x102L\xe2\xe4\x84q\x12,{c>\x0f\xfa{\x82\xa56\x14\x98\x9a@\x07\x10n\xfa\x90O\xb4\xa0\xca\x9f\xb3\x0f\xb7\xeb\xbe]X\xd4&\x88,\xdcQ\x17\xeb\xbd\xee\x95v\xdf9\xd3\xb7\xd5\xce\xf0\x18\xdc\xa7A\x86\xda\x99%\x08&*\x0bXv\xda>\xe8F\xc4\xf7^+r\xd0\x19\xdc\x92\xaf7\xbc\x8fn\xa5\x97\x03\xdaKm5\xc6\xdec\x00E\xf0\xf6\xfc\x0c\xd0\xea\x16\x15\x96/\xfd\x04\xc0\x80t\xb1\xba\xec\xe3\xc8*\xbf\xc016w+\xefz\xab\xe0;f\xa5\xa0\xe3\xb9t\x17\x87ya\xb5\xc6\x96\x16\x14t\x1f\xc1t\xa4Zm?\x0f\x1d5z\xa9IAK-\x05\xe8tG\nX\x0e\xf5j\x1dA\xdb\xe8`\xeb\xfa]O\xd0:\xab\xa3\xf3\x15!\xbe\xa1\xa7,i\xdb\x91\x18\x8f%\x19\xdbB\xd4\xc3^_\xf5\xed\x82<\xef\xb5\xc2m\x80\x8d\x8ek\xd7G\xb0\xb4\x111\x0f\xb0\xa0\xa5\xf3\x04\x08\x82\x154o\x17\xcb\x88zB\xef\n\xabCM\xd4,\x8ed0\x1d(\xef\x10\xe3\x16d\xfb\xf0\x06-4\xceF\xd4\x16\xfa\x8e5\xc6\x17\xaf\xc1\xb8\x0d\xf9\x06\x03\xc1\x05Fm\xc1P\x8c\xe4\x038\x0fV\xb8\x0d\x95H	\xa2[\x1d\xcdS\xe2Q\xc6\xb2\xae\xe0\xe7g\xe4\xf7 \xf4\xcd\x9a\xef\xd9\xee\x91\xd5m\x0f\xff\x1f.\xd1\xae\xdc\xee>|\x9byy\x0d\xcd\x1a=6B\xdf8\xbb\x9aPV\xf5\xf6\xaam!F\x0f\x11\x16Nm\x0bA\xde\xc7qY\x83]l\xd68\xac|\x93\xdf\xf3\xe1\x87;\xbe]>0\xfd\xc6\xb5\x1do5 \xe4U\xa2\xf1\x1b\xf2\xb2a\x11\xc3\x1d\xeb\xf5;\x02O+\xcd\xb2!J\x9doI\xba\x90\xd0x\x92\xb1g\xe4oq\x15\x9e\xb2\x00\x91\x07\xf3G\xe0JXH\xda!\x91\xcd,\x04gz\xc6\x19\xf6\xe1\x9d%^\xc4B\xe1\xd1*\xd7\x9a-4k\x17\xc8\xca\n\xc2f\x0d+\xb2\xe41\x92*\xdf\xb0\x0f7\xf4\xa1OZ\xd0\x0d\xa7\x8f\x11\x0ca\x88\xf0\x15(\xbd\\\x92'\x1b\x19w}\xd6|\x16\xf3\xc6\xf9\xf1\xa8\xdf\x94\xd3\x81\xd0a\x0bA&\xf3N\xda\xa8epCrc\xdc\x92\xad\x9d^nYc\xe2x\xae\xac]P\xceh\x1f\x8e\x96\x91<\xd0CC\xa4\xb2b\x05\xa3[\x1d\xe5\x89\xcf\x056\xda\x18\xb0\xc4z\x9aw\xc7\xdcW\xa7S\xb3\xea\xbc\xa7&\xbe\xf3\x9alLG0\xf0\x9c\xa6\xb2\xfau\x15\x04\xaf\xdc\x99\xdd\xb8\x88\x91\xe4\x14\x97Fw\x10+\x95\xda4\xce'\xce\x9c\xb0t\xfa\xcb\xf3\xb3\x1aE\x11\xb9|lB\xa2b\xaa\x0f\xd1\xb5o\xe6\xf3\x81\x97L\xf2\xdb@\xfesEKmI\xc1\x9b\xf9\x1c|\xcfZ\xdf8T\xa4\xc0Y\x88\xae\x1bP\x93\xa1\x86\x8f\x93\xad\xceK\xc8o\xddjU\xdd\xc672\xceX3\xb14\xcf\xbb\xc9(\x9bqz@\xa5h\x89\xbd\x895\xa3'i(\x13\xcd\x88\x98\x01\x16R\xe6\x13\x16[\xc8\xeb\xf6`\xb3&\x0b\xd6Yb\xc9\x0c\x14y\x92\xb7\xab1z\xe2u\xe4\x05\x17hW\xcf(\x19\xb4\xab\x1eW\x85\xd8E~\x85\x0e\x9b\xbb\x17\x88\xd6\xdc\x07\xfe&\xcfkG\xa4\xa1\x9f\xe7\xb1\xc4|\x05\xb5\xef\xfc\n:\n\x1fz\x1d\x90\xad\xd59\x1f\x9b\xad\x8c\x9b\xd2\x01\x17\x86n\xdc\xc2\xc5\xf1\xda^{\xba\xe7[\xd2x\xdc\x186\x86{\x93a\xb6\x88\xec\x9e)\xd8\xd0\"\xe8H\x03\xe0\xa8\xf8\x02\xb1	\x07\xb2+\xcd\x9be\x15=\xc8\xfc\xd2\xb3\xcb\xd44\x14\x82H\xdbZ\x87\xac\xb2\x9f1\xc5\xb2#\xea~d\xec$\xb1\xcb'\xf09\xb25\xcb\xb6c\xd0F\x13F\x03\xa0\xdd\x8ah\xf76\x02v\xe8c\xa2\xcf'\xb6+\xebw\x87i\x99Hx\xecj\xb4$\xf5^9;\xee\xfb-\xf9V[\xf4r\xbf3\xd93b-\xe6d\x08pA>V6\x9a\x94\x8e\x17n\x94\x86\x1b\xe2\x1b\x07<\x0ck\xcd\xc7Z\xd4\xbc\xf8l\x08\xc6\xadX\x8c\xe9\x9e\xfc6\x81\xb5\xa8D*]GV\x88\xf0~;V>\xac\x07\x9d\x0f\xd0\xa0\x05O\xec\xc2\xc0\xdam\xb2\xf6\x81\x0d\x06\x88\xdb\xae6\xac\xa2\xb9\x16h\xcc\xc0\xcf\xff\xfb	\xbf\x02\xda\xb0\x19O\xfc\x82]{\xf6\x96\xd2\xe8\x10\x16d\xe05\x865\xdb\x92\x16\xedGL\xee\xeba)k\x9d/_O\xed^t\x11Mu\xf0\xd4\xa26\xa7\xde\x0fL\x9d\xf2\x00\x9cz\xef|\x98^r\x01\xe5\x93\"\x99|\x01\xc9%\xea\xf1\xeb\x04Q\xde_A\x97\x10\xbc\xb0\xec\x1aCu\x110\x84\x8d\xf3\xea\xf9J\xe8\xf2\xd4K(\x9c\x8f\x03\n\xa1\x0c\x81\xfc=\xb1\xb0\xf8\xf8\x9c\x8bz\xf6\x05t\xf3~\xf1\"\xb6\x8f#\n\xfdB\xb9\x16\xb5\xad\xb1\xd9\x15\xae\x88c\xa3\x1b\x8ad'\x86\xe3t\x98\x03\x85\x11\xc1\x0f\x10\xd9wg\x07\xae\xb7Q\x9b|\xdf\xb0\x89\xfa^\xc7-\x04\xb6\x0e!\xea&y\xe5\xd9\x81\xdb\x87\xd7pG\xd4\x05\xbei\xad\xdc\xfe\xa5\xb6:\x92\xd9\xd6\x1c=\xac\xd9\x84\xe9\xf1\xe3\xca\x00O\x8f\xf3\x7f[\x8b\xd1C\x87V\x1dU\xd2|*#\xda\xc3\xadS\x18\x06\xbd\x97wL&\xf9*\xf1\x1c\x86d\xcd\xd8\xedxO\x8bv\x0f\xaeO\xce\xf6\xe0\xf2\xfa+`\x9b\xd4C\xe7\x1f\xff\xbd\xf3:\xdfk\xfb\xf8\xaf\x0e\x02\xff\xc1%q\xb0\x16\xf6\xe1\xca\xdd\xe7\xe0\x8d}\xd8\x84\xae\x85\x88\xed\xe2\xf1\xafm\x02N,jU\x87r\xf4\xd0M$E^\x87\xd8\xb0\xbc\x82\xeb\x1e\xff\xf2\xf8\x9f\xec\xa9\xa3G@\xff\xa1\xd7\xf7\x95bY\x12\xa9\x056w\"\x1a\xc3\x06\x9c\xe5\xd1$\x18\x19%kT(\xf0i\x86\xf5\x89\xd2AT\x86\xb6|^b\x92S\xdc_\x111\x13\xb7\xce\xf56\xfa-\xa4\xd1,#\x19	\xef\x02yhj\x106\xf4<^}\xfb\xd2\xf9\x86\x14\x07\xc4#\xcb2\x04<\xb6m]_.|\xd1\xf9\xe2$\xb1\xe3\x1f\xf6 z\xdd5N\x11kx\x95>\xe4%\"+4\x86\xfc\xf6\xd2\xa9Qq\xbfMc \x83\x0c\xb53{\xc7\x91g`\x85)L\x16\xcf\x91\xb5\x8b\x1ce\xc5\xf6\xca\xb9\xda\x05y\x9b^y\xaa\xbc|\xda\xde\xae\xb5R4~\xf2ih\\\x92\x8aCx\x9d\xd1\\\xe8\xb6\xc3\x92\x0c\x08@\x03\xc8\x04\x0b\x1dkkI\x0d\x98\xbe\xd6\x8a \xc5\xd8\n\xae\xf932:\x99`e\x9d\xbeM\x92)k\xbc'X\x10\xd9\xe7\x11\xd7Z+\x9a$kd=\x8f\xf0\xfe\x1e\xa01\x075bQ\x07\x81\xdd\xe9\x15)\xbeJC\x8e\x86M\x85de\x06\x8b+\x8b\xa1\xa5\x88\xb2jJ\xf3\x86\x9a\xde\x07}Of\x0c}\x861\xe1\x1d\xd6\x9a\x1d\xd4\x17?+'\x89\xd8\xf4q`\x1b\x1d \x83\xabl'\xc1\x17Lf;%+Y\x85Q\xb0\xe5cG\xb7\xf8\x89h#\xfcz\xbev\x9b\xdf\xc2\xa2\x8f\xd1Y\xbe/\x9d\xc1&\xc7\x7f\xc3\xa2\x91\x80\xf3\xfa{\x8eA\x0d\x1f\x88\xae\\A~\x17\x1d\xf1\xf5\x00\x92i]\xf1\x8cYi\x04\xe5\xe0\xab\x9f4k\xacB,=\x9e\xf7\x85[\xe9\xe2\xd7W\x00,\xae_;6\x0e\x05P\x94\x9d\xa8\xa0\xa29[@\x07\xd7\xa8\xfd\xd34T\xe7\x1f\xffz\xafq\xd4a60h\x97@\xa7Dn\x9c\x8b\xef\xee\xc9{]\xdd-A\x0f\xde\xb9\x08\xae\xcc%\n\xe7K\xce)\xc0F\x875\x9f\xce\x9a5i\xce\xfb\x88| \x04b\xf5\x16	\x8ck\xc4\x97K\xf1'\xc2\x9b\x93\xab= \xcbJ\x85\xb5\x13'\x90\x12	T\xcaS\x08\xb0&O\xfbpA,\xd4\xd4vq\xcb\x148\x08`\xf0\xec\x88\x17\xe0}8}@\x8e}\x0faw\x1dc\x17\x0e\x0f\x0e\x12\x1f\xfb-\xad\xfa\x06\xd9\xd8\xec>\xf9\xd6id\x98>\xf2\xef\x89\nY\xf4X\x89\xa0M\xdf\xfaC\x86\x81\xba\x9d\xd8\x8e\xf3vb;\xce\xdb\xa7\xb6C\xd1s\xcb\xa1\xad\xd1\x96\xce\xf4h\x82\xc6\xd4U>7VPF\x9c\xda\xa8;\x11\xa1\xb6\x80\x1c\xfe\xc6^KN\x18\x0f\xe1\x8b/\x7f\xfc\xf0\xc5\x97?\xdec\x9b\x87v\xed*r\xd0	\x90\xfb\xe9o\xec|\x8d\xbe\xab\x80[\x94\x9cOD\xb3F\x85?\xfd\x8dejV\x91?\xccZ\xef\xb9\x89\xfei\xc5}\xf7\xdc\x919\xbf\xfe\x94\xefr~\x9d\xecEq\xddB9\xa4\x7f\xc2y\xd1\x1f\xd4\xe8\x95\x9d\x7fsr\x9c7\x8c\xc7\xff\xb6\xc3\xf2{\x94\xc4\xda\xa8x~^\x06\xf6\xea\xd7!89?	\xfbP`\xc4\xcb\x97li\xe28}B%\x1b\xa6\x8e:/t\n/\xdb\x8c\xfa\xb2W\xe89\xbc(\xe3\x9c\xe0\xd6|\xbd\x96\xd8TQ\xb7\xd1\xf6n\xe4\xef\xf4!z\xb2h \x0dg\x91Wj\x0f<\xb5\xee\x9e8\x85&1\n=D\xf2\x0c\xf8\xb3\x9f\xfd\xec\xe0_X}\x1d|\x1e\xb7\x1d'\x92$\xf5\xd2\xd4\xd6\xb4\xc5\xd0\xb8J\xfa\xd2\xeb\xc4\xcfO \xc5Ey\xbaT\xee\xe2\xb0>\xaf\x94\xc1\xa2\x038\xf4Y\x174\xf5\xf2\xd8W\xd9\x9eKy\x15\xc3\xcfa|\xc6#\xe5\x8c1A\xc3\x0fC\xc0\xcbj][)ST+\xd9F.\xb5\x0f\x11\xeeu\xd0\x13z\x0fG1b\xb3f\x07|\xdc\xd6K|\xd0m\xdf\x02Vs{\x93\x19;dQ%_\x9e\x0d\x1dK\xc0\x82\xf2\xb2b\xf08\xa06O\x93\x82->|-\ni\xa0\x99\xb6g-\x83I\xf9<!\x99\xa7\xdc\x12\xfa.'j\x9e\xbaA->\x9c+C\xb7\xba\xca\xcc\x16\x8e\xb5\xcaL\xd4\xc9\xe0Km\xfb\xc8\xee\x9a\xb0W\xf8\xe7$~\x8afKv\x98\x03v\x96\xa3\xb0W\xd2\xc3\x0bb\x15\xda\x18\xc7\x95\x81\x9cy\xd0\x0d\x1a\xb3\xe5(\x83\xbd\x0c\xeb\xd2gT7\xa0\xc5\x87w\x1d\xd9\x17\xf9\x1b\xa2\xe7\x7f\x80\xbf\x7f\x80\x9b\xda\xe8\xb8%|\xf5\x9a5&\xe3\x9e\xb27\xd7\xdf?3)\\\x15y\xe9@d\xe2\xf9q\xb0\xffqy<\xc1\xfa^\xab\xb8~\x82v\xc3c/\xe2M3\x9f<gZ\xf5\xb7\xdf\x0d\x08/\xd3kN\xb2\xb0\xb7\x9f\x92\xb3\xc9\x0d,\x95\x85&\x95'\x84?v\x03\x18\x8e\xe3\x8b:\x9eH\x88\xe7k\xe7c\xd3\xc7\xff\x0d\x02N]\xb8\xd5s\x0b\xc1n\x7f\xcefs\x82\xe5\x13\xd6\"\xa7U\n$\xe5\xca\xe8?l)\xc6\xf4\xcc\xf8\x99\xe3\xd0\xdet`\xaa\xf1G8\xb91\xb5\xba\xdf\xe3\x10-\x07[\xc9\xaa)\x8e\xc3\xed\xaag\x17\xab\x8c\xa7\xec\xa4\xf6\xd0Z\xe2bN#\x96d<\x06[\x97V\xae\x9e\x14UX\xa9\x88^\xe2\xa7\xa1lS-\xa6\xcd\x90\x1f)_v\xc5`e\xf0\xa9w\xca\x0bX9\x0d\xc0\xee\xde\xd5\xf9\xb3\xa3\x85'\xe8[\xb0\xc3\xf8H\xcbE\xddT\xac\xa6\xd7\xb4L\xa4\xa8.>\xa3\xe1\xf2l\xe0;\x95\xed\x06\xab\x8e8\x04\xb79\xdf\xdcMR\xd8La\xa9\x9biF\xfd*\x0f\xe6\x00\\B\xacp\x17]\x97I\xdf\x92]s\xedw\x02e\xd9\xfd\x11(\xf6\x9e\xd9r|\xe8\x1dg\n\xc0\xf5\xf0\xa1\xe7\x1b\xc3\xd5\xe5\xadm6\x18\x1bI\xa0\xd1\xe3_j\x0f\xdb\xba\xcd\xb5\xc1m\x1d1\xdc\xbaF\x16\xe2\xca\xf9R\xd2\xb9|\xfc\xaf\xa0\x1b\x04\x8c=J\x9d\xda\x1f\xe0\x01\xd7<]\x0f\xc4\xb1\x0f\x81\xeb#\x17*\xf3\xe6\x8c\x9f\x11\xa2'\xe4\x9d\xce\x91>\x84\xbe#\xaf\xebZ\x9f3\xea\xd9\xf1\xbe3\xea\xe3\xc7[\\\xfe7/\x14\x82J\xa8\x00y\x12n\xc7\xcc\xc1%v\x1d\xebx\xb7\x1c\xc2I\xc7\x11exb\xc3Ky\x88\x8d,\x9f_V7\xa4\x9e%\x90\xdd\xc6Ru\xdd\xde\xa5\xd7\x1c\xec\xf3\xcb\xf4\x9aI\x92\x19\xd2\xa2\x14\x1bK\x90\x91\xb2\xd4\xcc\x04\xc7\x999\xd1\xecl\xf4\xce\x84}\xb8\xecC\x1c\xd2\x9dC\x8d\xc9Y\xaa\xae\xd7\xb0Ue\x1b\xae?v5:N\xa5\xde\xae=\x85\xb53c\x94\x974\xf8\xc2\xb8\xe6N\xba,\xe2\x001\xd5\xe4\xa3\x97\x90J\\\xbc\x9d\x0b-5\xd6\xb8\xe18\x9f\xf7\xab#\xdfP'\xb2\xc2\xd4\xb8\xe4\xce\x99\xdd\xac\xfdE\x87\xe0Hk(\xe3\x97\x9co\x06K\xbd\x17\x9e~/\xb5\x1a\xb6\xc1\xe2\x8a\xa6\x81\x00\xf4\x80Md\xaf\xa8YOL\x1e_\xf379K?\x8d\xa7X%\x0c	\xfc\xbf+\xae\x1a\xb2\xf48\xa8\xa5\x1f2\xb4bV\xcf%4\x92\xd8\xb9:\x0d\x19\x94\xec\n\\h{\x072\x1f\xc6<i\x06`\x1f\x99\x94h0\xe89\x9e\x11\x16\xe4\x95\xa7X~\x1b\xa3\x9b\xbb}x\xcf\xe5\xa4\\\x83Q{`\xf1^\xaf\x90\xfd\xa4\\\x85\xab1\xe5\xfe\x8c\x9aQ\xdf[zR!\xb9\xe6\xb1|\x8f\xf2\x0e\xe6\xce\x8br\xb7\x86\x04\x10\xa7j\xd6r\xf0\xdbQ\xbd\xcbq_I\xc9\xfe	\xa1\xdb\xdcXRv#Q\x9a\xb6\x9bdRy\xf0#\xb4x#\xc4v?#\xb3\xed\x07\xecs\xd3G \xcb\x1bS\x82\xda\x13gw#`\xb8\x1b\x19\xf3\x0b\xae\x89\x0f\x8bnH\x1d\x1c\x9b\x9e\xe0v\xech*yI\xae\n\xb3\x88/x\x9e;\x9e@/\xf9c[\x8c,\xeb\xec\x80\xc2\xee\xef\xfc.G2\xbb\xbf[T9\x00\xfelN\x11Vd\xb8M\x86G\xa6\x19\xca\xae\x96\xe4z=\x97\xd0\xab\x94\xccM\x19x\xaa\x05<\x19\x8c\xfa~\xeaW\x9f\x86\x88m\x97\xb22\x91\xda\xceA\x06\xab\x82\xf4\x8fBp\xbec\xff\x10v\xbf\x80\xb5\xe3\xe4q\xf4\x8f\x7f\x0e\x93o\xeb\x08\xeb\x0d\x94\xd7\xe7|uf{3\x89\"~\xcd\xb7\x00\xfdo\xe1\xf1O\xa0\xb4'\x1d\x07\xab$\xe1 ,\\\xccI\xa7\x04\x99\xb3\xd7\x05\xb8j\xab\x9ap\xe3\xfc\x0b\xf5\x89\x1b\x19\xff\x94\xb3\xe6\x05\xe2\x9f\xf0\xce8\x93\xf4\xed\xcd\xc5\xb8\x03\x9c\xbc\xe2\x81R2O\xaf\xc5g\x10\x95\x98[L\x86f\x03\xbe2\xc1\xb59\xf1_J\x9f\x9dw\xec\x07\xa7\x86\x8b\x8d\xf3w\xd5\xcd\x95*\xf8HS\xde\xea\x1e8\x99\x7f!5\xcf\xe3\xec\x98\xa2\x81\x8d\xb6\xcamF\x94\xa1\x8e\x84\xe7cy\xf9\xc4\xc9E_\xf4-\x17\xfc\xcb\xdd,\n\xa6r\x87\xe4\xabJ\x12}P\xc8\x15\xd3\x01\xcd()sj8\xee\x95\xb1\xec\x86\xa1\x89i+\xd2T\xc9\xd1\xcb\xad\x1b\xfc\xcf\xdc\xb4\xc1\x98\x81K\xa0mK\x96#\xccdV\x06;\xfa\xa9\xf6\x98\x80}C\x16\xdd\xc8\x0b\x0f\\ai\x98*\xf3\xfb\x8dk?\x9d\x8d\x0f\\\x85v\xf6IC\xd3Q\xf6\x0d\xf2lnm\xca\xc8\xf9j\xb2\xeeRc\xa0 \x85	q(\x16Y\x02'a*G\x0f\x9c\x1cw}\x15\x96\x87\xceiS\xfb(\xac\xb1`\x18\xcd\xc97Q\x7f\xa5\x9f\xe1G?\xe2M\xca \xc9N\x8b\x05g]V!\x8e\xb8\\N\xbb\x96\xe6<\x04\xb7U\xd7R\xd1\x89[\xd7{.\xe1-\x97\xa9\xa9\xa9\x88\x99(\xb25\xa1\xaa#\xaa\xc0\x07z\xc9%/\x8cc\x93\xd1\x9cG\xb3\xe0\xb7e2\xdf\x9d\x94\x19:\xfd\xe5\xf9\xd9\x1e\xfc\xf2\xf2Z\x04\xc1\xc55\xf9\x01r/%y\xdf^\xcf\xa1q\xd2\x80\"\xd6\x8f\x01\x1bl\xc9sZ\x98\x9b@K\xe3\xc0\x90\x90\xf8\xf9\xf5\xe9\xdb=\xb8\xbez+\xb0\xefO\x8f\xafS~dd\x97\xb7e\xa2\xb5e\x87_\xd0\xda\xbc.#\xae\x97s\x0b\xc8\xf0\x95\xb7\xd4\x16\xf56\xe7.\x15\xcd\x1d \x8e\x95-N\x1bL\x92\xe5K\x02u\x89\x0f\x03\x02q\xd4\xd0\x94\xdb\xf7?\xe9\x96\xcb(^j\x97\x9bP\xd7U\xd0\xab\xed\xff\x05\xf5\x89\xd4=\xef\x95Kq\x9a\xa2\xd0x\xdd\xb1\x99\xcbB\x97U\xeb\x93\xa2P\x94f\x9ey\x12\xf7\xe1K\xf2;X\xccU\xd6\x8c\xfb(\xea{\x1c.\x87\xfdH\x0f\x06\xdf\xd4\xe3\xb7\x03\xb23n\xd8e\xb788\x8bF\x7f\x8f\xaa\xa8\x90\x84.\xb7\xf4N!^4\\	\xf1\xc7\xb3\x91m\xb2~}@\xae\x8c\xb6\x19\xf1\xb8^\x02\xc1\xec`\xbd\xb37ln\x07<\xefy.\xef\xbb\x149\xb9\xcaU\xd4\xd1\xd1D\xd3\xa0\x1a\xfa\xf3\xd8\xe6\xf0BR\xcf\x97\x8edi\xd1~\xa2Z\xf4\x9e\x16\x97U\x01\xe8\xa3\xb5\xa2)\x1c\xeb\xf5\x0f=y\x98\xe0zZy\x02\xf1`&\xfd\xd2\x9b5\xc6#[\xbb>\xef\x87\x91D\x9b!\xb8\x97\x96\xf6W(]\xb7\xc4M\xdaD6\xac\xb9\xec(-G\xd5\xd79\x7f7)\xfdr\xab9[\xcfb\x1a\xb9\xda\x97\x8b\xffC\n\x855n`\x95\xeb\xab~\xdb\xe78\x9f\xb4\xa2\xfd\x00\x98\xb7\xc8=R\xc3A\xfc*\xbd\xa6\xefN/\x13\xf7B\x16\xbe\xda\xf9\xe3\xde\xab\x9d\x99\xeb\xd8\x9e\x0e\xcd\xfe\xdc\x9e6;\x84\x99%\xbb\xee\xd3\x05\x99\xa5m\xe2\xd1%\xf7\x1c\xba\xbcoir\x93\xb3\x863\xfc}/\xae\xda\xe3\x9f\xc0\xa0_\xf5\x9e}3!\x11\x9c\x8f\xbc\x97\xc5`\xcd\x8e\xc5\x9f(G3\xbb\xe0\x06K\x91\xae1\xab:+\xf1^5$\xa2\x9d\x9a\x03\xd2\xc23\xd6\xbe\xe9\xfd\x95\xec\xc4,\xe2P\x90\x9d\xbd%\x9f+\xa3\xb3\xa3\x0e\xfd\xe3\xbf\xd9F'E<\xbb\xce\x86=\xcfr\x11Iz+\x12\x0ej;\xc3\xa6\xa4\xec\xc9\x02\xedu}9\x7f\xe5\xfa\xaa\n.9\x10\x95*\x91\x07\x9f\x85\x03\xce\x9b}6v;-\x1d\xff$\x81cjO\x18\x9c=,\xc7\xc2\xe6\x93\xd3p\xd2\xba)Z\x95\xf8\x82~&\x1d\xc5\xda\xc2ga?\x83\x1e\xd5\xfd\x95\xbc,\xb5\xf0\xec%\x13|~\xcd\n\xf5\xb3\x1c/\xe7-\xefua>\xfdz\xa3\xfe\xe1\x86`\x9d\xe5$\x06\xcf\x15\x9f\x85\xad\xa1(Rh\xd1\xe6\x9e\x9e\x0c\xac8\x90\x9d\x1d)\x95\xdf\xed\x96\xf7\xfev\xdb\x89\xb0\x1c\xd9m\xb2\x84\\\xac\xc9\x10]RC\xb3\xa3\xae(\x9cY\xee\xcb\x97\xd1\xfc\x98`C\xd0++\x9e\x86\xcc\xc9kr-\x12\xc0\x02E\xfa\x8e1\xf3.[\xee\xe7\x1d5!\xf3O]\x0c\xb9o\xec\xcb\xd7\xa3\x81\xdf\x93\x16{\xfe\xa1\xc8\xe9\xf1e\xfe\xd1\x00\xdb'hs\x16[\xe9\x96$\x08\x17o\xe8\xab\xd7\xaf\x1f\xbex\xfdzo\x98\xe6\x15C\x06\x9d\x1b\xd2~q,\xbbd9\x05\xd0[\xb5\x9f\xf9)y\x8f\xa2\xc4y\xa7\x98\x17=M\xc1dhV\xcf\xb3\xe3\xbc)\xb9\xfd\x98\x87rz+\x8f\xaf\xd1\xae\xa8J\xa2qW\xb1]\xd14\x856k\x0c\xef^\xc3\xcb\xcbcZn\x08\xd9\xf2\xcd\xde\xc8C\x1asv\xa9W\xbdOa\xbfL\x96\x91l@\xa7`s\x11\xb3)\\\x12\xbd\x0c\xc8W\xb4B&\xaf5\xa6\xec\xec\xcdN\x8a_7K^\xc7\xb0\xa4\xce.\xd4\x00\xc3>f\x00\xd9\xc7\x1a \xa7\x82\xcb\xfc\x90\x07\x9e\xa9\xde\x97,\xec\xec\xa4<\x97>/\xedE\x07q[\x83<\xcaxii\xe2O(\x8dOy\xa6o\xee\xde%\x99<\xfb\xf6\xcd/\xe0\xdd\xd9Y\x9aX\x19\xb7@\xc3\x0b\xde\xa6'\x19]c\x18\xf8\xfe\xbaT\x9d\xf3\x94\xebe\x1f\xbf\xe6\xff2\"\xdd\x18\xb3\xf3\x93\xf2\xc6\x85\x84(\x82q^\x9e\xd3\x8c1\xb4J\xb4\xce\xd3\xe3\xa4\xd08\xcb\xc55\x9eNO2\xca\xfeh\x91&>>\xa3\xab\xee\xe0\"q\x03\xa4\xb6\xabgw	\x9f\xdd\x9c\xbfuq\xbe\xfa'.\x8eq+\x8el\x0e\x81[R\xf8)\xf3\xc4\xcf\xa9#\x8f'8\xfa\x914\xaa\xa2{\xdd\x94s\xb3.+\x115&\x97fW\xee\xd9\xaf\x7f\n\xf4\x0d\x85\xdeH\xf9\x94\xa1|~\xcb\x93\xf3\x88\xc3T\xd5\x96\xb8\xa5\xccR\xc9\xd2\x17\xd1*\xb9\xfdQ\x9f:\xa3\xc8W4\xde\xf1\xfb\x94\xcchjg\xef\xf2c\x1a\xdf\xd8+7\xa4\xe2\xd82\x80\x92t\x95\xdb\xf0\xcf&\xb6uFn\xd6\x11\xde}\xc7?^\x93\xf8ovMx\x97~\xcc\x96\xeb\xff\x92\x81d4\xd7\xc3\x9d\xe0\x91P\x86\nT\xefW\xc3-\xba\xe6\x17\xb9D\x07\xd5Mc\x8b\xac\xd3\x85\xb9\xc9\x8f2\x9e\\\xa53\xefZ\xc6)\xff\xab\xf1oz\x92\xdf\xf7\xcc\xe69\x931\xd2L\x0bo9\xf6\x9e\xdd\xbaz\xd1\xads&\xea\x8e'\xce\xb4I\xdd:rxlLC\xbf\xe0|\xf1^\xfe\xf5\x8a\xcf6\x8a\xcbO@\x96\x7f\x91\xa3\xd8^\xb2\xc7\x15\x8c\xe4\xa6\xf7\xa1\x88\xf1\x0d\xadz\x83\x9c\xda\xed\xb8\xdd\x87\xb7?\x8b^\xa0x,fD>pN1\x173\x86\x8f\x8c\x17\xe9j\x94\xe9|S\xc4u\xac\xce=\xac\x91S)\x87P\x9e\xaa\xd1c	\xa3fsy\x81E\xee\xb9\x1a\xfc!^4\xe7\xdcTq\xb2\x17\xd9&\xe4Pd\xd0&%p\xa9\x0e'\x14\x81\x9d\x0f\xd2\x9a'\xd2neC\xda\xdbX6zk\x9b\xac\xca-5\xcc\xbf\x08z\xee\x94\x94i\xe9\xccd\x98o\xad\xfe\xd0K\x9f\x02\x83Rn\xd9\xa5\x03i\xb9f\x87\xa3\xf2\xc18T\xe65C\xd64\x07\x92\xc2\\\xb9\x99	\x92\x1d>\x86\x1c\x1c\xba\x98\xfd\x87\xdb\xc1m\xe8m\xb6\xf5\xdf\xca\x83\xac\xeb\x85\x1b\x96\xd5,\xf3\x99\xbd\\\xa7\x9c\xbd\xda\xf9\xe3\xab?\xfe\xf7\x00PK\x07\x085\x9b\xa9yg\x15\x00\x00\xb6:\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cW_o\x1b\xc7\x11\x7f\xa6>\xc5\xe2\x00!	`$\xef~\xd3\x1f:\x16*K\xaaHG\x08\x9a>\xacxkr\xab\xe3-{\xb7g\x86\x08\x0c\x90\x14b7\x95*\xa7J\x81\x06\x85mUM\xd0>\x96\xa2E\x8b\"E\xea+\xcc~\x85~\x92b\xf6\xcf\xedQ\xb5\xdb>\x89\xbb;37\x7f~\xf3\x9b\xd17K\xa5\xe0\x89H\x9aT\x06\xf7\xc97K\xa5R\xb0O\xe3\x98\x85\xc1}\x12\xac\xaelm\x95\xd7\xc9\xea\x97\xe4\xa3\xe5\xf4#\xf2`{\x97,\xa7\xfa\xcfW\xc1r\xfaU\x10\xdcC\xf9\x90EL\x1a\x85\xf5\xf2f\xb9\xea5\xcc;o\xd2:[\xf7B\x1b\x8fV>/\x93\x0f\x8bVZ\x82G,)\nWv\xb676\xcb\xbbw\xc5c\xd6\xde\x11\xa9L7\xe2j#aT+,\x87$fm\xd2\xc2{\xc2c\"\xf5\xcb\xa7\xc6\xbe\xbe]\x89\xc3\x0dt)\xddnri\x1d_\x0e\x8d\xc6\xc7\xe9'\x84\xc6!Y\x0e\x89\xf6\x05\xcf\xc2\x8ay\x0b\x1fP\\\x14\xcc\x92:\x0b\xd1=\xb4\xbf\xb3]\xa9\x92\x9d\xc7\xbb\x9f\xfb\x10\xfe#\x8fi\x83\x86\xa2\xbd\xca]\xf6+\x0fW\xd6\xb7\xf7\xc8\xea\xc6\xffS\x04\x13\xe6\xa6\xa8\x1dTE\xbd\x1e\x99\xa8\xaa\x0fw\xcb+\xebX\xb3\x85,g\xb1/\xf1\xe3\xad\xc5\"\x1bkO9k\xb3\xb0B\x9blc\xc7\xb9_!\xdb\x0fH\xf5a\x99TV\x1e\x95\xc9\xc6\x0e\xd9+\xef\x96\xc9\x17\x1b\xe5=\xef]\xb0Tzv\xcf\x02*E<\xe9S+\xca\x12\x1a\xa5\x0e_!\xed\x04\xf7\xc9\xaf\x96J\xa5R\x00\x970\x82\x99:\xd6\x9f\xd5\xc7\x19\x8c\xe0:X*\x95~\x8dWACdI.\xad^\xc0@\xf5\xac\xac9\xc0\x1c\x86^\xba\xc9\xe3L2o\xfd\x06\xc60S\x87\xaa\x0f\x83\xe0\xde\x9d\xab\x82\x96\x88e\xa3\xa84R=\xf5R=\x0f\xee\xdd\xb9\x80Q\xf1c)\xab\x898\xcc\xf5\xec\xd1\xe8\x98C\xea\x85;\x8c\xfa8\xe0-\xcc\xe1\xd2\x99\x9f\xc2\xc8zc\xb3\xa7\x91\xe8\xb2E\xc3&\x8f\xb1\x06\xf0=\\\x9a\x80\xb4b@\xeb\x02\xafU\x1f\xe6p\xa3\x0e\xed\xa5v(\x80\xb1;\n\xa7;\x839\xcc`\x0c7\xe6\xa5&b\x99\xd0\x9a4\x9d\xa0e\xcea\x08#\xd55\xd9Q\xc7\x04\xc6p\x05s\xb8P]\x18\xc0;\xac\x13\x8c\xd5\xcbb\xcf\xafv<D\xe0'\xb8\x84\x01Lal\x94\x87\xaa\x07#\xa2zD\xfdA\xbb\xf8\x16\xe6dc\xc7h\xb3\xaf[\xce\xd1\xbf\xc2\x00\xae\x16?\\\x94)\xb8\xf7\x1e\xc9\xff\xeab\x83\x87\xcc\xc65Q]u\xe4M\xdb|\x0e\xcd\x87~Cc.Ebrp\x86\xc9\x84\xb9\xfaN\xe7jb%\xb2Tn\x89v\x9e\xed\xa9:\x86	\xcc\x89z\x81\x91\x19\x99H\xd4\x0eL\xd3\xd9_\x16Y!Khn\xfd\x15\x96]\xe7x\x80\x9a\xaak\xa4\x1co\xa0S\xb7\xaa\x0bs\xb8U\x87\xea;\x1d\x8e5/\xda1\xb3FNa\n\x03\xdd7\x08\x9c\xe7\x04.a\xaez0q5O\x19[\x89\"\x1b9\xc6\xd2W]\x18\x15\x8a\xe2\xd8\xc6D\xa4C\x9e\xe8\xdc\x0e|\x8aR\xc3\xc1\xd6\xca-\xcc\xe1Z\x7f\xcez,5\xc7T$\xaf\x1d`/\x07p\xa6\xba0\xc6<\xc3\x08n\x0d\x04\x1c\xd7\xf8\xcc\xe4\xbfs\x8eY\x00\xd0\xdf\xd0W\x82H\xcb\x81\x82<T\x0e\xb9\xdc\x14u4\x80?I\x83\xa7R$\x1d#\xd0\x11\x19>|\x0c\xa7\xea\xe8\x13\xc7>i'\xae\xd9^\x0b\xd0&L`\xaaN\xd4\x0b\xf5G\xd7;\x9a7Fp\x89\xf4\xa0\xd3<viQ=\xbcS\xdf\xea2\xe0=\xa6\xe5\xb9\x07\xfe{\xde\xc7Zv\x08\x83\xdc:\xd6\xb7\xf7?$\x9741\x04\x927\x99k\xf5\x1a\x8dX\x1c\x16y\xe2\x9f0\xb30-\x05\xf0\xb3\xa6\x1fK\x1a\xaf``\x8bQBf\xb8\xf5\x87W0\x80kw\xf8Q\x9d\xc0\xacx\x98\xba\x97\xefa\x08o\xdd\xe1\x1c\xcb\xe6\x0eo`\xa2\xfa\xee\xf0\x1a\xe66\xf4R\x00\x7f\x82\x11L<\xa7\xb5\x19;\xf0\xbe\x9e\"\x08\x9d\xe4\x19\xcc<\xc1\x9d\"\x04\xdd\xe1\\u\xfd\xcb?T\xdf\x87t\xa6\xfa\xde\xd7s\xb8X$\xc5\x8c\xbb4\xed\x0b)E\x13\xcb\x0e\xaf\xb1@\x96\xd0h\\c\x06\xf6oT\x1fI\x1cfE \xd6\xa8\xa4\x91\x81\x11\xfc\x05\xa1\xae\xc9jns\x10\xd4\"\x8e\xa3s-\xb7\xb2\x86\x17D\nb\x0d[)\x91\xb2=\x9a\xc4<\xd6\x96\xbe\x14YBD\x8b\xc5z{ m\x1eEd\x9f\x11-\x16\x12\x9aI\xd1\xa4\x92\xd7h\x14u\x08\x95\x0b\xd4)h\x12\xbe\x8f7m7;B\x0fE\x8c\xf8\x08\xe0\x07\x8dd\xc4\x99\xa5\x84'<bU!6iR\xd7\x12x&R\x08\x12\xe9\x1b+\x13\xf3\xb4a\xa9\xe5\xcf00D\xab~W\xa4\x96\xba\x10\xf5\x88U\x84\x89\x08^k\xb2\xbfAw\x90\xbf5\xc5\xc1\x85:\"0F\xbf0sH\xef\xb78$a\xa6N\xdc\x8e\xd7\x12\x89\\\x13I\x92\xb5\xf4\xc2\x03?\xc2\x0d\xf2\x86\xea\xaa>\xc1\xa1N\xd4\xa1	\x14G\xe9\xa7\x04~F\x98\xc2\x94\xa0\x10\x0c\x91\xa2\xe0\x1d\\b\x8b\xda]\xcd\x98\\w\xf1/\xd8\xbb\xf2\xa1h\x0d\x02\xe7\x881lA\x18\xab\xe70 p\xa1\xbf7Bi\xf4\x15\xed\xa3\xd6[\xd5U\x87v\x9a\x0d\xdc\x97\xe2\xa74\xe2\xe1\x1am\xc9Z\x83\xda,\x8c\xdc\xa8\x81\x81zI4C\xde\xe2\xcaaT\x0e\x18k\xe9\x95\x96\xc7\xf5\x07\x02;6\xf8\x05c-\x12\xda;\xf2D$v0\xd0T:\x9eU=$Q\xbd\xe4\x8cm\x8f\xeaq\xe1\xb7W\xb3\xbc\xd9\xa5\x95\xe0\x9b\x97baU\xacz\xecc9\x17\x08\x97\xc0P\x1b\xber\xd8i2\x1e\x8a/x\xcaE\xec\xf6\xd0GxE\x9e\xea;+\x94\xa5\xf2\x11\x95\xb5\x86ur\xa0\xd9l\nc\x0dE\x98\xc2;\x98\xa9#\xa2\x19s\x08\xb7z\xf4\x14\x06E\xcc\xda\xde{d\x0b\x18\xaa#\xb8&\xba\x1c#\xdb\xe9A,\n,\xbe%\x08\x0b\xb9LI\xc2j\"	\xf3\x85Y\xf0XV\xc5Z\xa1O\xcfl\xe1\xaeU\x1f\xfd\x99\xe82\xf8\xceuqb\xef\xa5\x85MAgZ\xf5\xd5\xd1g\xba\xd3\xbb\xa8\x0d3\x9c\x90\x9fU\xab\x9b&\xec\xdff\xc2\xae\xfep\x8alM\x90Ba\xae\xa9\xbe_\xa0\xf4\xa9\x1b\xab	\xa3\xa9Y\xa6v\xcd/{\x1b\xf2\x84\xd5\xe4j\xc7\x0c\xb1]{&\x14\x99\xa0\x93\x0f2/\xe7\xb3uW\xd6\x14\xdd\xd9}\x92\xb0\xd4\xd4\xe4\x0d\\\xe8\xedmX\x9c\xab	kE\x9d\x9c\xed\x10\xaa\xfd;\xcf\"\xc9q\x07\xefl\xca\x86\x98>u\x8c-\xe8\xbe#\xb3$\xb6\x90\xd7;\x80+Y\x92E,}\xdf\x82`2\x85\xfd7\xc6M\xc4\xd8I\x19Mr\x08\xcd\x0dU\xb8\x97\x14\xc1V\xfe\xba\xc5\xed\xbft8m\xccx\xc4\xc6\x1ac\xa9p\xae\x14\x8c5D{KH^c.~\xf5{\xf5\x12\x86\xbay\n\xb3:\xcd\xf6\x9b\\\xe6I\xc8\xdd\xf2i\x90\x8d\xac\xb9\x1fS\x1eY\xbe\x86\x1f4\xf3!\xaa\xecT7\xd1\x8c`\xa8\x8e\xd5\xc9\xbf\xba\x7fw\xfbM\xcb\xeeZH\x97\xdf\xda\xcbN\xcb\x99AF\xc1\x7f?\xfa0P'vX\x06Y\xec\xe8\xd6u\x1b\xfc\x84=\xa9\xd1\x85\x11k\xe2\x9cY\"\xb2L\x8c\xf4\x85\x0d\x06\xd7\x9a	U\xcf[kcWz\xb8<6\x17\x0b0\xc9Z\x91\xa0\xe1\x03\x1e\xe9L=\xd6'\x82\x83\xc0\xda\xd0\x17;\x89\xa8',5\xc5\xf4\x1cx\x85\xcd\x94GlD\x7f\x99\xb1\xcc\x14\xc9\xfe\xd2f\xee8\xb2Wtc\xa9\xf4l\xe9\xd9\xbf\x07\x00PK\x07\x08\x1a\x07T\x0fF\x07\x00\x00D\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xcc\\[o\x1c\xc7\x95~\x96~Ea\x16\x81\x1d`,\xd1q\x1e\x02?\x04\xd0\xd5\xd6\xc6\x92\x18R\xb6\xb2\x08\x12\xa0\xd8]3\xd3aO\xd7\xa4\xbb\x87\xd4$\x08 \x92\xbee\xa9X\xb6\x12\xc0Fb[q\x12`\x1f\x82\xc5\x0e)\x8e9\x1a\xce\x0c\x01\xef\x1f\xa8\xfa\x0b\xfbK\x16\xdf\xa9\xaa\xee\xea\xb9P\x927\x8b\xdd\x17i\xba\xae\xa7\xaaN\x9d\xcbwN\xf1\xd7\xe7\xcf\xd5\x1a2mg\xb5\xd7\xd9\xaf\xcf\x9f;W\xbb~\xe9\xc7\xb5\xd7\xd9O\xcf\x9f\xb3\xbf\xeb\xf4K\xfdE\x0d\xd4H\xef\xe8]\x86\xc2\xf3\xe7\xce\xfd\x0c\x15\xb5[\xeb\xd7\xef\x16\xcdo\xc9\x9c\xad\xf3\x86`\xd7e\xca\xee\xcat\xd3v\xbe,y\x1a2\x1e\xc7r;cm\x9e\x8b4\xe2q\x9d\xe5-\x9e3\x9e\n\x96\xc8\x9ce\xe8\x97K\xb6!\xd8V$\xb6E\xc8\xa2\x84q\xb6-\xd3M&\x92\xad(\x95I[$y93\x8f\xb7y/{K\x06\x9b\xc5\xfc\xeaS\xd5W#}_\x0d\xd4\xa9\x1a\xea]\xfd\x80\xa9C5QCu\xac\xf7\xdcB\x1e\xe9\x1d5PO\xd4\x91\xea3u\xaa\xef\xab\xa9\x1a\xab\xbe\xde\xd5\xfb\xea\x90\xfe\x7f\xc0\xd4\x88\xb9^\x0cK\xd6\xf7U\x1f\x05\xfa}\xbd\xc7\xd0Q}\xad\x06L\x0d\xf4\x8e:QC\xcc1R'\xaa\xaf\x8e\xd4\x08\x83N\xd4\x80\xe8\xd8UC\x9a\xbe\xef\x11\x9d\xc8\xa4\xd7\x8e2Q\xd2\xfc\xb1\x9a\xa8)FWc\x10\xaa\xfa\xfa}5\xd4\x0f\x1d\xb9_\xea]5U\x07D\xc2\xd7\x8e\xbeC\xbd\xa3\x06\xfa=\xa6N\xd5\x14\x04\xaa\x01\xad\xe3\x90\xa9\xbe7Z\x1f#zs\xa7A+\xda*g\xbed\xbe\x99\xb8\xd7\x89R\x11\xb2\xbc\x95\n\x1efv\xe2\x1f	\xd1\x99\xadb<ch\xf3\x8aL\xe2\x1e\xb3\xe3e,J\xb2\\\xf0\x90\xc9\x06\x0bE,\xf2(i\xb2\xbc%\xdasS_Meg]v\xd3@d\x05\x15(sC\x85,j\xf3\xa6`\x99mc(\xb9\x8a1\x05kt\xe3\xf8\x95,\xfa\x95`\x8d(\x16\x19f\xa3\xd6\x19#j\xba\x99e\x197\x94]\xce\x05v\xa7\xd5mo$<\x8a3b\xb6M\xd1\xc9/x\xa4u\xc3H\xbe#\xe3n\xdb\xdb\x19\x94\xb1-Sh\x880-0)u\x00s\xb6\xbbY\x140\x9e\x84l+\n\x85d\x9d\x98\xf7D\x9a\xf9C\xe7\xb2\x195\x8aa\xe9\xa8\xe9\x98\xcdi\xd1aM\xf4\xbe\x1a\xb07n\\\x7f\x85\x98q\xa0\x0e\xf5\x03\xfdQ\xad\xfe\xc2=\x8ai7\x9a7\xbby\xb9\x18|\xb0\xcb<\xd8l\xa6\xb2\x9b\x84\xec\x1d\x10k\xc7\xa7\xba\xbc%\xd8FYO\x8b\xf1G3\x1d\xdcpKF\xba\x14\xb6\xa3\xe4\x95\xac#\x82\xa8\x11\x89\x90\xb5E[d\xac\x13uD\xc8\xb2<\xe5Q\xb3\x95\xb3(\xc9%\xeb\xc9\xeeK\xa9`m\x99D\xb9L\xbd\x89 $\xae\x81\x17{\xc5d\xea\x11m\xc9X?d\xeak\xba\x1d\x13\xdc\xb7#p\xbd\x1a\xa9\xa1\xdb\xa6\x7fSC\xba\x8bS\xdcN\\\xbf\xa7L\x1d\xa8\x81:.o\x08\xdd\x8e#\xb4\xe8\xabC\xdc)\xbdG7\xf9D\x0d0\xa8~\xe8\x0f[%\xea\x16\xf7xC}\xa6\xc6\xd5\xc6\xf5J9\xae\xdf\xa1\x9a\xaa\xa7\xdexu\xa6\xc6j\n\xa1\xa1w\x99\xdeQSuD7\xb6\xb8\xcf\xa0\xeb\xd5\x15\x06!BRcb\xfa\xe1\x86\x1f\xe8=5\x02\xedCF\x82\xe1]}\x9f\xe1\x13\x9c\xf45\xadu\xcch\x93\x9e`\x0fHV\x0df\xe8\xbf\x13\xe5\xb1\xb7\x00\x08\xc9'j\xaaN,\xa5\xa3\x05\x8bY\xd0\x86\x16\xa6\xf7g\x176Q}bC\xf0\xf5@\xdfg\xdf\xfc\xbd`\xda\x01\xc3aA\x0eM\xd4\x13\xd5\xff\xe6\x84\xf6\xa1\x0f5\x82\xe6zO\x8d\xd9\xab++\xd8\x11\xf4>t\xf3\xf9\xe4\x87%3\\\xc6\x87\xa1\xef\x8e\xb8\x97\xb3\x0d\x19\xf6p!\xc1\xbf\x1d\x99y\xaa!\xe0\x9d<h\xf1\xa2\xa7\xfa#\x11\xf9\x81\xea{\xeb#\xb9\xd9W\x87\xeaD?4\xa7p\n\x02\xf4\x03u\x8c\x95\xe2h\xd4\x80\xd8\xe3)$<\xa8;rJed\xc63\xda\xe0\xc4\x1c\xfb@\x8d\xd4\x94\x18\xeb\xbe\xde\xc7\xc9\x1d\x81\x0fi\x96C5TO\x17\xec\x95\xf9\x98=>R\x00\xd8\xb9!vf\xaa\x8e\x89O\xb1\xa5Cf\xce\x17\x05s\xab\xbd\xc3\x9b\xa5T\xbdbv\x80\xe5(\xb4{\xc6\x9b\x19\x14\xac\x11\xae\xac!Sf\xf7\x89e2\xee\xe6\x91L\xb2\x0b\xecv\"\xd0\x89E\x19Ky\x12\xcav\xdccAKf\"\xa1\x1e\x82\x07-\xd6\x14\x89Hy.B7\xc0\x05\xb6&~\xd95JD\xb2@&9\x87$\xceY,x\x96\xb3\xd7X\x185\x1a\"\x15I\x8e\xb13O\xfa\x06-\x9e\xae\x072-\xd9\xf3J\x8b\xa7<\xc8E\xca\xb2\x0eo\xb3\x8c*\xad\x8cI\xf2\x88\n\xb7\x05\x89\x13\xd9`m\x19F\x8d\x1e\x14\x0egA\xd1\x13\x93\x13K\\`\x97\x1a\x18J\xdc\x0b\x84\x08\xad^bq\xd4\x8er\xfa\xd5\xcdD\xca\xb6\xa38f\x89\x80\x9a\x93\xd8\x8a-\xc1x\xb12\x8fT\x99\xa6\"\xc8o\xa7\x91Hr\x8e\xed*i6UV{I\xaf\x85!|M\xe6<\x17\xa4*\x1aq\xd4a\xb9\xa7\x91\x82@\xa6\x862I$]\xfb\xc9\x8d\xeb\xfe\x10\x8e\xc3\xed\xb1\xd1\x14\x1eQ\xdd,\x97\xed+\xeb\xeb\x05-vJ\xf5\xa5U'\xe0\xc2\x13\xfd\x90\xa4(4\xcdR.\x1f\xb3+\xeb\xebF	\xe1V\x0c!\x8c\xea\x96E\xc1\xafj2;\xca!.<8\x1db\xe1\xc0\x1aI\x10\x13O\x19\xdd\x9d\xb1\xde_@\xe9\x1d\xd9l\xfa\xe2\xe8\xf1\"z\xf4\x03#\x01\xd5SP\xe5\xd6\xf4qaT\x15\xfa\xf0\x8c\x9b;3FAI(\x1a\xbc\x1b\xe7\xfe\xa6\x91u;\x86\xedv\xaa\xa6\x0c\x82\x89\x88\xfa\xc0\x9a}\x85:\xfeL\xef\x94s\xe9=\xacQ\xf5\xf5\xc3e\xdd\xec6\xa8\xfe\xdc\xe4o\xf1\xa4Yn\xc1\x7f\xa8c\xbd\xafF\xcb\x869cv#\x8f\x97\xcd\xfe\xd0\x8c\xeb/=\x03\xc3\xa4\xbcY\x1e\xc0U\x91u\xd7m\x99\x99\xc8kuA\xa6M\xc32P\xb0\x96B\x88?}\xdf(*\xfcVco\x86(\xe3\x1b\xb1X\x93\x1b2/\xc5\x91\xfa\x84\xb4\xcdh\xe6\xdc\xe8\x10\x0f\x8c\xd4T\x87n\x99\x9fZ\x8d2\xc0\x0c\xe6|M\x9b\xbe\x1a\x93F)\xc8\xb1\x1ai\xec\x0fD\x8d\xc8@\xd0\xbbzO\x9d2_\xbf\x0d\xe6\x08};\x13)\xb9'\x1e\xb1\x7f\xb1'<2\xd6\xc2\x11,65\x99\xd3\xe1F.\x17>\xc3B\x1d:\xb3\x0e\xb2\xdb\xd5\xb8\xce\xc8K\x81\xe31\xf0fP\xfd\xfa\xc2\x91\x9d\xe6\x1dx\x9a\xd7[\x88L\xbc\xdb\x84\xcd3\xf7\xf2C\xb7y\xd6\x02\xf2\xb7wD:\x8aj\xb1\xf9\xee\xd31\x135/&\x10a\x94\xbf%Kv]\x13\x10Y\x0c\xc5\xac\x15\x81\x9d\x9cZ&\x9f\x81\xb3X6!\xb5\xc4\x96H{\xa6Y\x9b\x87\x02\xbaGvDB\x829\xab\xb3LBzC\x91\xc84c\x01OX*`B\xb3\x96\xdc\xb6\xe2\x9bm\xf3\x8c\xe5\xbd\x8e\x08=j \xfa7x\x1c\x17\xf4\xfc\xd3\x0f\xf0iV\x82\xa5\xef\xea}K\x8f\xfa\x8a\xfc@\x18\\#\xaf\xde\xd9\x82\xa4\xb8\xc1D0J&\xeaH\xef33\xd6s\xdak+sc\x0e\xd9\xf7V\x16\x184\xd6a\x83E\xe5-\xa4\xcd\xa3\xf8Z\x9a\x16\xeb\xb8\x86\x02v-MeZ\xe8l\x92\x95\x8c\x9aBG\x08\xaa\xbc0?\xc8M\x1e\x95\x1bB\x03\xd9\x11h83\xc0\x82n\xab<+\xf9\x1e\x1f\xdb2\x0d\xe7{\xb2\x8e\xadZ4\x84L\xf3b\x08\x9a\x99e\"\xdd\x12)\xeb\xa0f~,\xafv\xc1p\xeb\xdd\x8d\x85\xa3-\x1f(\xebn\x84\xb2\xcd\xa3\xc4\x1f-i\xf2\xa6\x00^\xb0&r\x91T\x94\xf5\xb5\xa2\x8e\x85<\xe7,-ZXw\x93\xf72\xd6M\xf2(fd\xfd3\x1e\xe4\xd1V\x94\xf7X\x06\x8d\x9c\xe5Q`\x1cI\xf2uEx\x81\xad\xb0M!:\x19\xd4t\x9bEI(\x1aQ\x12\xe5\"\xee\xf9\x14\xddk\xc1l\x88\xca\xc5\xb9\x02T\x97\xf5/,p\xc5\xbd\x0eO\xc2K\xde}P\x7fV}uLW\xe1\xbe\x9a\xe8=\x0f2`\xe4C\x95p\x82u}j\xf5o\xd9\x91\xbd\x0c\xc3\x17\xe6\xf1	>q\xf9\xc8\xcaew\xc5\xc6\xcd:[\xbdz\xbd\xcen\xae\xbeV\x98\xb2\x07\xd6\x1c\x1e\x14n\xac\x93Nz\xff\xbb\xfefu|\xaeR\xffN.\x03Z\x92\xd6\xf1uH\xad\xfe\xcc&D\x91u\x8d\xa6\xea)\xb4\x10<(\xfd\xae\xea\xab\xa7\xea\xa4\x9c\xb6!D\x08G\x98\xf8\xae\xdc\xce/\xcd\xaa\xf5.\x8d\xf3\x10+9$\xd5ZL\xfeXM\xf5\x07\xd0=uf\x95Y\x81\xd7\x94&\x02\xa4\x00	\xe6\xf7\xac\xd7\xa6\x0e\xac\xc14\xa8\xfal\x8d\xb8b\xc9\xcbn\x92\xa7=fJ-\x8bF\x19IJ\xc8H\x91\xb2\xc0o\xc2\xa4\x95\xb0\xde\xbad\x1a\x88\xf0R\xe2]\x02\xf57\xda\xcc\x9dR!\xe3D\x8c-\xe3CH\x13{:\xc5R\x01G\x8d\xd4\x89\xfeH\x7f\xe0\xf4\x8b\xb5\x0eI{a\x8f\x87\xea\x94\xa4*\xe4\xa9U\xd7\xd8\x1d`i{\x15\x7f\xbc\xa4\xb0\xc9\xe3X\xa4\xbd\x9b2\xf4T\xd9\x9f\xd5\x80\x9c\xfe1\x83\xf7\x08O\x0c\xfbT:\xdc0\x1ca}\x1c{\xc8\x1d	\xe2Bw\x17\xbc\xc5\xf4\xce\x12\x0e\xaeBdM)}\xdb\xf4\x0d\xf3\x89*\xf7\xf1B\xc6P+\nC\xe1m\xfaWN\xd3\xaa\xe9\xebl\xc5\xad\xe3K\xdaJ\xec\x89\xd9P\xe2c\xa7\xb5\xf4N\xd9\xa7\xaa?ZQ(.GI\"\xc2b\x827\xa3P0\x03\x97\x85l\x95\x98\x00Mm\x05\x94$8\x06\x82\x8a\xe7\xac\xc5\xb7\x04\xdb\x10\"q\x82\xacB\xb7\xa8\x00\xbb\xd4\x1f%\xe0\xae\x8b<\x8e/\xfa\x03\x93\x98\xcc\xe0\xda5E\x08\x8c\xb0\xc0s\xe12\x12\x82\xdbHe\x1b\xf2\xd1tfm\x91s\xeaU\x9dsM\x04\xdd4\x8b\xb6D\\z\xfdE\x19\xd1\xceZ\x11\x9c\xa5\x85\xcb\xb2\x802\xac\x08 P\xb9d\x1c\xcdCkr\xb0\xd4\x8d\x14\xf7\xaa\xd3\x12@\x98-8&\xd8G\x0b@\xb9\x85\x90\xec\x08w\x05\x9c\xaf\xf7\xd8OK\xde4\"\xe8g$\x83`U\x0c\xe8\xa0\x07\xd5qKjd\x1a\xfd\n\xees\x8c\xd3\x8b|\x1f\xe1\xf7\xb8\xb0\x96\x87'\x905\xc4\xe6\x93\x8a\xcdF\xfa\xe1\x89#\xf3\x0b\x8b\x0d\x9b\x96\xc0\x17\xac\xa3\xf2\xda\x0f\x82\x16O\xcaY\xa3\x92\x83\xd4\x9f\x88\xcd\x86jbG\xf1Z\xc1\xf3|SB\x17\x17\xad\xff\x0c\xc1Z0\xf5p\x89\x82\xb1\x14\xd2\x1e@-\x91OZ\xf1l\xbcqJ\xb3w\xe1}u\x98\xc9\xecX\xfe-&J\xd7\xa4\xccoo\x894\x8d|\x99\xf2\x85=\x00\xc8\xb8#\xabT\xcd\x1e\xea\xf7\xccv-Y\x81\xa3\xf4\x0fd5R\xdd1\x1d\xe7o\x0b]\xb8\xb8\xa3=y\xa8\x05\x90J\x87\x06\x93\xb3\x98P\x0d\xd8\xcb\xf3\xc8\x90\xb1N\xaf\\\xbd\xf5]\xa8\x0d\xb3iC\xb8\x93\x8c\xb0\xa2\xa9\xf5\xe3\x1d\x03\xf4\xd5\x11\xf8I\xef,@\x99\xbe\xf9{+\xcf;\xd9\xeb\x17/\xd2\xc6d\x17\xda\xa2\xd9\x0d8,\x8do<\xedG\x95U,\xe6\x06\x8a\x9e\x0b\x87\xc1\x05\x83\x81\xca\x13\x83P\xfc#\x81\x97\xa8]\xb5\x06>Sc\xa7\xe8k\xf5\xb9\xa2\xaa\xb6\x1f@L\x0f\xd5q\xa1\xed}_<J\xe2(\x11\xd7#\xcf\xd2\x98c\xe8Z\xbdjS\xe1\x12\xf6!\xb0\x9f\xc9\xa7\x87\xd6\x84\xb2\x08\x9fE\x0f=`bZ\x9c#\xdd]\x9f\xb0\xce\xbc\xd9zc\xf5,K\xf5\xc6jF\xf0\x923\xd4)<\x82C\xf9\x16\xa6j\xf4\xcb\xb0\xb4\xc1o\xfc\xf8\xeae\xbb\x07(\x7fa\xf3\xf4\x17\x9c`~O\xbaB4\x8e\xd5T\xff\x96\xae\xad\x879\x7f\xacF\xe8\xad\xf7 \xdf`;\x9c\xce\xb4\x84\x8b\xf52\xfa\xaa'\xb0j\xab\xca\xde\xc1\xf9\x1e\x98;gW\xc6\x8bP\x17w\xc2\x9f\x99\x0b\x0d\xaaH\xa2;\xf4\x04\xf7\x1a\x02\x17\xa6\xdb\xbb\x06\xd2\xf5\xcf*\x8e\x92Mou\x8f`\xdc\xe9\x0f1\x8a\x1a x\xb8\xa3\xf7\xd5\x89\xbf\xcc?@\xa8\x91 \xa4y\xea\x05\xe5v^B\xde,(|\xe4\xe2\x88\xbe\xc9\x0b\x81\xb0l\x12\xec	\xec\xaf>\xfb\xe1\x0f\x7fx\xf1\xfb\x90\xf3\x17KZ\xdb<\x0b\xa4\xc7\xed\x9f\x1bnWS\xbd{\xa6n\x1b\x97\xed\xfc\xa5\x9b\xe1HH\x14c\xfa\xbb\xb9\xe0R,\x1d)\xefz\x18\xb0z\xa4\x8e\xb1b\x13\xd3Q\x83j\xcc\x042rZ\xde\xcc\xcf\xd4D\xbfK\xf7\xd2\x0b\xac\x15<\xb0\x00Z,u\xc8)\x0e\x95\xdc\xf416\x15\xf2\xdb\xca\xd8)\xbe}\x1a\xc6\xd5\xb8\x8d\xa1\x81\n\xfb\xea\xe9\xec\xb6\xdc\xbb\x94\xe7<h\xc1\xff,Y\xe3&\xbf\x17\xb5\xbbm\xc6\xbd\xbaz\xa5&\xe9\xb67D\x8a\x9bK\x11Nk\xcf\x00\x19\xd9\x10\xb6\x9b\xb3k\xb2(i\xc6\xb3a\x8f6\xbf\xf7&\xc1#\xc5\x9c\xea\xf32\xdc\xe2,\x06c\xe4\x1f\xea},\x08\x94/\x91`\xb5\xfa\x8b\x0cq\x8c\x80\x91\xbe\xaf\xf7\n\xa7\xc7\x8a\xb7\xc5c\xfb\x9bu#\x8c\xc5\x9d\xc8\x0b\xb1\xb9\xfd\x88B\xbbD\x96\xa3\xdanV\x94ts\x919\x84\xc8\xee\x0e\x82\xba\x06]\xda\x8e\xf2\x96\xec\xe6\x8c'\x06{\xca\xealC4d\n[\x17\x1a*\x88%\"\xc5\xbc\x9b\xcb6\xcf\xa3\x80\xc7q\x0f.<L\xd5D\x9a\xd8\x80\xa7|\xda\xfc\xde\xed\x8eH\x16\xd2W\xa0Y/@\xdf\x0bP\x03\x8di\xb1j\xf0\xc4k+\xacm\xd6^%o=\xfaU\xb9u\x8bN\xcb\x987\xa5\xc5r\xff\xc5\x0f|\xc1\x10/v\xe0p\xb8a\x8e\x90'w\xe0\xee\x8c~\xaf\xb2\x92\xbbQ\x98\xb7\xce\\\n\xf1.!\x9b0o&\xdf\x9aw\xabC|k\xde\x15\xcd\xee\x9dw\n\x82o\x9aOT\x9d\xab\xad\xc2?7\x114\xe3\x1f\xb9\xd8x`\x02\xec\xc8\x9a`rK\xa4h\x07g^x\xa1p3\xf0zK\xa6y\xd0\xcd\xff7&\x90\xe1[\xb29od\xc0\x01O)\xb6E \xee\x19\x06G\xbb\xdaR$y\x1a\x89oal\x94\x10p\xb1L\xf5y\x11 \xef\xbbx\xeaR\x1ba<\xdbv\xdeJXf\x18\xd4\x1d\x02\xe3\xf4.d\xfe\x10V:}\xdf\xb8:\x97^\xe3AT\xc9|N\xc0\x99*\x14\xf1\xee\x87L\xef\x15h\xbe\x9f\xbdS\x89y'b\xbb@b\x8b-\xf9\xc2\x86\x00\xc8\x93\xeaC/A\xb1\xd9\x19=\xaa\xc46\x1c\xc7%\xfd*a\x80/=\xdc\xffA%\xbao\x17\xed\x8d*\xf3(\xf0V\x0b@\xec_\xf5CB\xfd\xe8R\x94\xca\xf8\x8f\x16)\x83uK\xce`\xa9\x9bMX\x1b\xb9I\x93R\xa7c\x9f\xac\x07\xa5\x06K\xd03\xdb\xd8\x82g\xf3)Y^\x90\x11\x946\xa2\xa0\x1a\x9dU\x7f\xb5\xfe\x13\xac\xc9\"\xdd\xc3Q\x0cs\xf4D\xef!lF\xc7\xae\xf7\x16\xb5v&\x03\xd6\xe0\xe3\x8bf,\x9c\xa5\xb1\xda\xacI1PG \x19\xcb\x7f\x8f\x0ekR\xa6v\xc1\x84\xf4)\xde\x86\xa8\xa8\xb8\xfa\x9f\x94\xb8 \xfb\xe6\xef\x94\xae\xf6\x94\xe8#\x10\xeb	\xb6\x18H\xeb7'n\x0d\x88^\x8e\xf4\x9e\xfe\xad\x0dF\xd2\x94\xd4\xd2\xf8\xd3~\x9c\x12Ik\x13\xfc\x84\xe8\xd3\xf7/\xaa\xfeEx&F\xdc\xc1\xf0\x14\x00Lh)\x18\x86\xe6}\xb8\xe0$\xadL\x7f>HS\xc6\xe1<O\x7f\x05\xe3C\xdf\x7f6WK\xeb\xc6_Y\x90\xe8\xe0\\|\xe62\x1e\xee\x940\xe9M\xde\xe9@\xe5\xcbF\x01QI\xa0T\xf4\x7f7\x13\x80\xaa\xa0d]\xfa\x03\xc0-\xfa.\xb2\x98\xa8\x9b\xa7n\xe5v\"|I\xf5\xc8\xa6\xfdQ\x9e\x88~\x7f\xa9\x94:,\xdb\xe9\x07\xfa}5\xb0\xc9Hd\x0b\x0f\xd9\xcb\x16C%\xf8\xbc\x1a\xbbT\xd3\"j\xc8\xf4\xbb\x18Q\x8d\xcc\xf6\x17qN8\x17u\xea\xa7N\x8c\x9aBT\xab\xafF\x06b\xd8\xc5I\x1d\x80\nH\xca\xa1\x9axR\xcc\xb9\x8b\xe5\x8a\x1e\x9f!]:-\x9e\xb5\xee\xb4R\x91\xb5d\\\xf6!\x07\x80m\xc42\xd8\x8c\xa3,\xa7\xc4;\xd3b\x99yk\xd2Ap4\x1bQ\x9e\xb1\x0d\x91o\x03\x87\xc4\xdewD\x1a\x88N\xde\xe51\xc3l\xc8\xeek\x00U\xe8vb\xc9C\xf2qy9W\x911\xe8\xec%\xdb\xcc\xe4\x91\xa6\xe2\x17\"\xb0\x11\x1a\xca\x114\x05\x19\x13\xf7x\x90#\x115hU\xac)X\x94WRA\xe2\xa3\x8a\x84@\xaa\xb2\xc0V=\x17\"b\x1a'M\xc6Y\"\xb6\xff\xe1\xd9(\x18\xef\x06\xe1\x17\xd7(\x00t\x06\x84Q:\x886\x8f\xab_\xc8\xf82R\xba\x08\x81+\xfa\x8d\xd4p\xb6_\x01~\x9f\xa8!dO\x9dD\x04A#\x83R\n:\xbc\x934\xac\xde\x01'\x8e a-4ju\xc1\xd8\x8b\x0b\xe8=o\x8di7\x11\xb3\xc1\xf9\x8fM\xf6\x9e\x9a\x16\xa89\xe5\xdc\xd2\xad\xc1\x02\xdd\x82\xfeZ\xd5\xfaE\xe0\xdc\xe2qs\xb9Zp\xc8'X\x12\x05\xb4\x0e\xc8k\x9f.Q\xd1D\xd8\x1d\x9b.\xfb\x0c\xca\x8a\x8c-/\xc3\xa1J\x9bk\x81+z\xf8?\xa5\xac\xd7-\x08Z\x8f\xbb9\x13	\xd21\\\xf8\xf4\xaaL^\xca\x19\xcf6\xcb\x1e\xe9\x06\x92\xe9\x8aNk\"\xbcx9\xee\nF\xa5\xd5p\x13\xf2\xbbp\x017P\x9f#\x05/j\xe0\xe6\xb5y\x8e\x9b\x08\xcf\x8b\xbd\xf4\xf3\xf4%&S\xf6\xd2\xcf7^\xf2&\x11<\xbc\x9d\xc4\xbd\xc59\x16\x88\x0e9\x1b\xe0\x8c$\x10\xd8\x06.;i\xa4\xf7\x96\x98O\xa9hFY\xee\x83\xd4\x9f\x92H\xf33\xec*0\x81\x9d\xd1'6\xe6y\xb4U\xf5I\xc9^\xa2h\x18\x98\xd9\x03r\x07\x08\xee\xdb$\xd5Z\xfd\xf9\xdbVXk!j\xfb*sJ\x7fb\xaf\xcb\x91\x8f\xd7\xa6\xa2#\xb8g\xea\xc1\x90\xa1\xabA\x0b,Cv\x95\x1eqo\xad\x02\x10\xfc\x94\xd6\x05%\xbe\xfb3X,n\x7f\x8bD\xc9\xc7\x8bnr5\xda\xe1%A\xf4\xc9\xcf\xb3\x83\xe8=\xfd\x91]\x82\x8dk-3\xdbR\x01\x84w\xde\x1dY\xa3\xf2\xb3\xdc\x10\xd3\xf3[\xf8\x1d\xa9\x94\xf9\xdbko\x95\xdb\xf7G\xda8Df\x81\x07=e\xa8\xac/\xad\x82\xeb\x80\xf6G\xe4F\xa0j\xaa\x0e\xa0nI\xc5\x8e\xcfH\x0b-\x10#\xf8)%\x92\xaa\xfa\xcf\x0e'\xa6\xdd\xd8K\x9cW\x8f\xedY\xc1\xf2,\xcf\xcb/\xac\x1a\x08\x0b\"\xd3&\x97\xef\xd0\xb9Q\x0e!\"<\x0c\x01\xac\x89o\xc4e>\xce\xa7\xbe2\xa3\xb8y\xbf\xc0E\x80\xf5\x07l\xbe_\x91k\xfe\x08\xb1\xc7\xb0\xf0\x15\x86\xea\x94\xa8\"\xa3V\xbf\xab\x86\xfa\x03\xa8\x0c\xac\x1c\xee\xd2N\xc5\"T_\x99\xcfbw\x9f\x90\xd4\x18x9\xb3\xd5\x00\xb4K\xca\xa1@6l`c\x88\x8f\xea\x06]E\x1b\x98]H\xfd1\xb9|N\xfc\x9b\xd2\x81\x1a\xb0\xef/\xcc\xe6!\n\xa0\xf0|u\x95\xf1n \x12.\x8b\x15\xae\xa3\xe0\x16w\xc9\xf6\xae\xfeB \xdb/\x14F\xceD\x96E2Y\x9e\x1b\xaf\xf7H\x13\x181wh\xf3\xffh\xfbpiA\xfe\x99\x99\xf2~V<\x80\x12\xbd\xeb\x9d\x83\x81B,\xf6s\xe2\xa2\x83j\xe8\xcd\x03\xdf\x06\x03x\xf0\xbd\x8f\x87f\x1d\x19\xc5\x15\xb3\x19iY\xc8\xc48\xc1\xd9\xe9\xfd\x85xT\x19r\x03k\xd9\xa0\x9d\xc5\xcc\x99\xde\x99\x1d\xe1\x0c,\xa8d\xbf\x9c7\x1a3\xc9\xf0\x9f\x93\xdc\xa2\xeb7\x0f%\x14\xdc\xbd\x04\x15\x9fi_\xa4\x9b\xda\xd3\xd8\xc5\xb90{\xcd\xa9\xcc\xbfMy\x1aun\x8a\x9c#	\xa9d\x19\x94Z\x9b\xb6\xed*m\x12\xb1h\xcb-\x93\x1f\\g?\xb9\xb9J\xaaX\xe6-\x91\x16-\xeb,\x8e6\x05{cu\x9d\x05\x92\xd2\x8a9A\xa5	2\xb5\xdb\"\xe5\xc8\x99\x8ax\x9c\xd5M@\xdeX\xcb\"d\xff\xbcz\xed\x8d:[\xbd\xf5\x06\xb5\xbd{\xed\xf2\xaa\x81\xa0Kr\xa1\xee\xcf\xd0\xe0\xbb\xeeu\xd8\x19\xfa\xbb\x84\xd9\x8eIo\x14!\xb9\x8a\x05\x83$*1\x97\x98\xeb\x86}\xe42\x8d\xad\x84\xc1\xad\xd5{\xa5P\xf5\xd8\x0en\x08\xb7\x0fJn\xf2{gB\xa43z\xdc\x7fl\xe2\xa4\x98\xea?\xc7c\x93\xc5\xd8N\x05\x15p\xc1\x1ek\xef\xa8\xb1?\xc3b\xe2\xa3R#\xaa\xcf!u\xfe\x7f\x93^\xbd`\xcf\xf3\xda\xc4(X\xe8's\x7f\xd4\xf1Y\x9d\xcaM\xa2\xec\xc8u#^\xca-\xaaH\x97g\x00\xc4\x8ff\x92\x99\xaa\x82\xa5P4^\x92\xac\xc3\x86\xdd&y+\xc7k\x82\xcbo\x94\x84<+\xb3\x1d\xd0\x98\x9a,\xcc-\x7fv\x14kn\x98\x192\x16\x87\xe5\xfe\x86\xa6vS\x97$kx2j\x9b\xe7A\xcb\xfa9\xb7\x935\xd1\xf1\xee\xff]\xd4\x15\x0f\x03\xa5\xc9\xdeu\x89\xc0\x97\xfcx\x06\xe3\xa1{\\\x08\x9f\x96\x06\x15\xe1|\xd7rZ\xb1\xd1~f\xa2	2\x0c\xad\xea\x9cI\x05\xd1\x1f\x9d\xe5\xd4R\xcb\x035\xc6\xe6-\xebO\xf6\xc1\xec\x84\x0b7\x8b^:\xf4\xd5\x08\x05%\x04C~\xe4\x81\xcb\xb0>,x\x0c}\xbc\x0c\xe0\xed\x16\xcf/%\xbe\x97q\xb7(A\x03\xd3\x82\xa3\xc5\x85&\x9f7\x19\xfaV\x12\x0c^1\xa4B4\xe8\x0f\xd5\xb4\xeaEo\xcbtsib\x1dr\xe6w =\xed\x84e\x8a\x9a~\xb0p\xc5\xfaa\x91\x82\xff\"<\xe9\x88\x98}\xfc\xf1\x7f@J\x8f'\xa1(\xd5\xc1\xbf\x98\xcf\xba\xf7a\xf5o&\xf0\x96\x94:\x9e?\xf7\x9b\xfa\xf9s5\xd9\x81\x17R\xbc\x94NL\xe6}\x0d\x92\xd4F\xd0kY\x90\nJ\xf9\xab\x11a0A\x9f\xa8\x89\x17\x90f\xfaw`+\x1c\x9e}\x93\\\xdb\xb6q\xaf\xe5]\xcap\x15n'\xd1\x92\xc94\xc7\xb1:\xb3\xaa\xf2V\xb20\xc4k\xf5\xd9\xaaS\x1bV\x1f\xd8\x97\x92$\xcc<\x0fn\xae\x87\xf7\"\xa1\x8c\xadU\xb4`\xd9\xdba\x1c~\xf5\x8c\x9a\xc7\x15\xaf\xe5\xbc\xc8\xb7\x83g\xbdce\x12\xfe\x1d\xd8\xf1\x81\x0e\xc3\xb3\x19\xda\xef\xc7\xceSZr\x11m\xb3\xdfC\x95\xe8\x87\x10\xe8\x10p#\xf8Fd6~H\x19bfv\xd1\xee\xc4\xb0\x8c\xdcAn\xf0d\xb5\xe2\xdc<\x82Ii\x90\x96\xa1\xd1F\xd8Ql\xd7\x04506/~'\xbb\xb88\xfc4f\xdf\xc9\xec\x0d\x85\x05\x04S\x9aN\xeeu\xc7\xd8\x8fT_\x7fh\x83O\xccBE\x03\xfd\x81\xfeD\xefRW\x1bJ8d\xdf\xc9.T\xfb\xdcXe\xffu\xff\x0fT\xee\xb1e7r\x0b\xc1\xd3\xfc\xd7Y\xf1l\xbf\x86\xd7e\xdd\x04>WM\xfd\xb5p\xee\x0b\x0d>k\xb4O\xed\x03\x1b\xe75N\xad\x11^\xe3!\x00\xc6\xb9\xdc\x15[\x99\xf4\xaeG\xb1\xb8\xd3\xeb@\xc6\xd4.%=2\x1e\xe9\xe1\x86m\xd11\x9a\xc3\xf8\xac._\xd8\x1f\xa2x\x9a\xee^\xa5\xdb\xf2,\x8b\x9a\xc9:\xecv\xd4\xa9/\x08\x08\xc1\xdd(\xb2\x8f\x97Y\xed8T\xeaC\xd1\x94\xa2(\x11\xe9zG\x048zl*8\x1b#\xe0%\xed\xd4C&\x0b\xd0\x90}o\xa5\xb4\x8e\xebx\xa7\xed\xe2$w\xaf]\xbeYanJ2\x1e\xcf\x1b\x97\xee\x85R\x9f\x12J\x90\x1cd\xb7_\x8d\xd9k++\xff\xf9\xe9\xab++u\xe7a\x8d\xedS~$\xa4\xd0\x13\x93\x1f]\xa6`\x8c\xb5\xd2\x8e\xd5\xa1!\xd2.\xc8\x01\xe1N\xdb\xd7.A\xd7J+\xc1\x8aj\xdb\x1a\xda\x1b\xf7\xcd\xca*\xfb\xc6\x91\n\xab/d\xf1.3i\n/f\x03\xcd\xe0\x1d\xdb|\x18\xb2\x16\xc48\xad\x00\xa3\xb9\x9f\xb6\\\xf0\xd4N\xec [\xef\xe8\x03\x994\xa2f75@\xaf;e\x8b\x15M\xddl\x96#\xf5\xdeL\xa7u\x91\x9a\x84\xd4\x85\xbd\x1cF\xe3z\x01\x92\xf7\xe6\xf9\xca	\xb6\x85sX\xbf\xacv\xd5\xb9`5\x030\x95\xfd-\x8c\xbb\x84F\xd3\xba8\x98\xd9\xd6\x8b\x84\x98\x95}\xa6\xa7\x0d\xe4\xceu\xb4\x86\xa7m\xdaM]\xb0\xb3v\xd5\xfdvo(\xa2\x94\xb4\x03\x99\x96d5\x02W(4\x95{\xed@[\xf1\x8c7\x0e\xb5F7\xd8\xbcm.\xe1\xf5\xb7\xaf\xfc\x88\xdd\xbe~\xdd\x8c\xd2\x8c\xe5\x06\xc7c\x89\x9a\xfa=\xc9\xf8\x03\x87\"!\xeal\xda\xb4xVl\xc3\x9b<\xb3oXM\x95\xec\x12o\xbc\x89\xff\xcbd\xe4\xda\x8d\xab\xee\x0b\x90`n\x18\xf7O$K\xabO\x12jQ\x1c\x8b\xa6\xa1\xe0\x86\xf9I\x0f\x90\xf1\xa7B\xca\xb4e\xda\x06\xa2\xc1\xe4\\\xd5\x10L\xbaRa\x7f\xc09\xc8\xe1\xb6	`\xd6\xf0\xaf\xf8\xacjX\xbc\xfcvj	\xe3DI\xb3\x10)\x97\x02D\xaf\x90\xfd\x03I\x81\xbf\x82\x02)A\xe2\x90\xe0\xf1\xb6M\x1d\n\xa3\xb6H\x00\xeaP|\xeb\xb5\x95\x95{\xafA\x08\xb8j\xea@\x7f\xebB6\x9c\x14\x80g\x9e O\xb6\x9b\x84\x17\xdc\xecMI\xf9!\xd0\xf0\xfb\x06~\xf4k\xcc\xab \xf7 \xc8\x03o\xaca\xaaw\xd4`\x1e%\xaa%\xd2\n\xe1\xb0\x8cq\xd4n\xc9\xb9?\xad\xe1Z\xaf\x89\xac\x1bS\xa6\x1bZ\xa5\xf6\xcbV\xae\xe7\xbc\xa8\xf2\x1eP\xf5\x84=\x9f\x99\x90\xfd\xc2h\xbd\xbb\x182\x0eE\xea\xcdv\x1b\xdf\xd5	K\x13\x0dL}\xaa\xdf/\x97%\xb7\x93[\xb2\x08'Y%_<_2\xe1Y\x181\x03\xf8\xf1C\x00\xa0G\xf65\xf3\xbcB\xec\x08\xbe\xf9\x0e\xfeL\x0d\xc1Z\xb5U\xc17\xcd\x9f\xad\xb1O\xf7(>G\xb3<\xf6\xaf+JM\x87\xe2qF\xad\xd3M\x9b\xc5e_\xc5\x07\x05\x0b/z\xb7\x04\xfeQd\x98x\xcd\xfe\xa4rc\xa1^Oe\x1bc\xd2\xff^\xf9\x8f\xbb\x82\xfe\x94Fm\x9d>\xed\xab \xaf\xc1\x1d@\x95\xb5;\xb2Z&\xe3<\xea\xa0B\xfd\x0d\x9aN?\xb0\x92\xd89P\xce\xe7w1@\xfb\xc6yP\x9f\xf9\xcb\x16\xd8D\xfd\x11)\xafB\xe3\x97;\x89\xa0\xb7s\xa6\xc6\xeaD\x7f\x82X E\x0c\xd4\x01Y\xc8;\xeaD\xffN\x7fH\x86\xec\xf0\xbb6\xbe}j\x91Nd\xd3\xec\xdb\xbf\x9b\xa0\xf7pU\x01\xd0[4[\xef\xcf$l\xd9\xe5\xe5\x97yb\xc3\xf7s\x96\x8cK\x05\x82%\xef\xd2\x8dj\x99\xc8\xdf2\xf7\x1b\xdb\xb1.rf\xaf;\x83O\xe6\xc9\xda\xac\xc5C\xb9\x8dF\xf6\x97Wz\x99\xd0\x9b\xda:}\xb0\x8d\xc8\x8agg\xbe[\xd5\xba\xe8\xd9\xda\xccF\xdb~\x06\xed(\xd5\xca\x0c\xdaa\xe5\xd6\x02\xedb\xc3S\xb5\xcc]\xc8\xf5\xe26\xda\x8a\xee\x06\xe2\xe5D\x91}\x0fo+z	\xa9u\x9b\xb1a`M:\xec\x01\x85;&3\x13\xf4\x92\xe0\x8a3;\xdfN\xa2_v\x05DrB\xb1x\xf3\x9aR\\\xcce\xcec\xd8\xb3\xf4x\xadV`\x8c\xc5\xec%\x9ah\x91<\xa2\xd9	$\x8b4\x91\xeb\xec9+\xb6\xdcZ\xa2\xea/\x88/\x98\xb2n\xe2\xecA\x93\xc9\xefe~\xb9\x06 \x14\xf7\xd0\xb2\x88\xa5\xbccK\xce\x9f\xfb\xcd\xf9\xdf\xfc\xf7\x00PK\x07\x08\x18k\xc8\xfa\x9d\x19\x00\x00-K\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cV\xbdn\xe3H\xf2\x8f\xed\xa7h\x100v\x17Xx\xf3\xc9d[^k\xc6\x96\xf4\xb7\xe41\xd6\xff\xbd\xa0\xc4\xae\x91\xdalv\xf1\xba\x9b\xd4J\x8b\xc9/\xf1\x0b\\t\x0c&\x98`\xa2\xcb\x0c\xac\x13J\xefu\xe8\x0f\x92\xb2\x17{\xb8\x88\xd5U\xbf*V\xd7g\xff~|\x94|\"\x9d\x83M\xde\xb1\xdf\x8f\x8f\x8e\x92\x05(\x85<y\xc7\x92\xb3\xc1x<\xbc`g\xbf\xb0\xefN\xccw\xecrr\xcbN\x8c\xff\xfc\x9a\x9c\x98_\x93\xe4G\x87\xe7(\xd1\x06\x85\x8b\xe1\xf5p\xdek\x04\xb9\xc8a\x89\x17=ht3\xf8y\xc8\xfe\x1a:+HH\xd4\x87\xe0\xd9t2\xba\x1e\xde\xbe\x85+\\O\xc9X3R\xf3\x95F\xf0\n'\x9c)\\\xb3\xc2\xf1\x99P\xccz\xc9i\xb0\xef\xb9\x03\xc5G\xce%3\xc9\x85\x8d\x8e\x9f\xf0\xa0\xf1\xbd\xf9\x81\x81\xe2\xec\x843\xef\x8b;S\x84\xf5\x16\xfeB\xf15\xb0\xd4K\xe4\xce=g\x7f:\x99\xcd\xd9\xf4\xee\xf6\xe7\xfe\n\x7f\x8a\xa3Y\x01\xa7\xf5\x99h\xa3?\xbb\x1a\\L\xee\xd9\xd9\xe8\x7fIB\xb8\xe65\xa5\xd9\x9c\x96K\x19n5\xbf\xba\x1d\x0e.\\\xce^E\xb9T}\x8a\xef\xc6\xaf\x93\x1c\xacU\x02\xd7\xc8g\x90\xe3h\xda\xba?c\x93K6\xbf\x1a\xb2\xd9\xe0f\xc8FSv?\xbc\x1d\xb2\x8f\xa3\xe1}\xef]r|\xf4\xf9\xc7XP\xc6\xd5\x93?\x15\xb2\xd4 M[_\x1c6\xc9;\xf6\xff\xc7G\xbev\xf6\xff\xf0\xbf<J\xb8j\xbe%\xc7GG\x7fs\xc7dE\xa5\xeeP+\xe2BA\xc4\xad\x887\xdfT\x8f\xcc\x85*-v\xd8\\\xa8\xe6\xd9\xb6\xe0p\xda\x1c\xa0I\xd9U\x0fF# m\xb1\xfe@U\x0f6\x98\x92\xe2\x1d:\x1e\x03:\x1cL\x0f\xde \xf4\x1ek\xca\"NS\x16M\xc6\xd8\xf8:kc\x01<\x17\xcaEx\xe0	\xa7\x92\xc0\x92\x1c\xa7\xd0m)\x81\xf7!\x81\xf6D^\xe3\xbd#69\x95&\xf0SRVCjCm;\xc4C\x8ej_\x0bF\x0b\xdd\xd4\xdbls\xd8\xaeg\x9b>\xbb\x0f9\xec_X\xb5\xaf\xd1f\x1b\xb6e\x9a*\x05\x19>\xba$\x03\xd7h\xa2&\xfeVDW\x86\x9e\xa2\n\xf6_\x0eE\xfd\xaf\x03\xa0||\xf3\xef\x95\xe0.S\xc9,]\x1d(\x87\x08T\x01\xf2\x08JX\xd2\xfe\x02\xcd?\xb9\xde\xbf,\x9aZ\x8b(,\x8d\x1d\xd3\xda\xc9\xa6\xba\xa9+d\x165l\x83PR\x9a\x85\xba\x8fTL8G\x0d\xad\xc5\x1bwjjK\xad\xc5\xb6g\x9d\x03\x1b\x85\xe9\nT\xf35\x8a\xd6\n\xa3\x16<\n\x8b\xbb\x17\xc6\xc9\xb4a4\x88\x03)\x9d\xde\x03-4l\xc5\xfeK\x1b\xc2\xb6\x9di\xfdJ\x1c\xd9a\xba9\xc58\xe8\x02\xdf\xfa\xb6\x9dY\x91f\xae=\x92\xa9\xc6B	f\x02#\xf6m\x7f\xc5\x8e\xee\xfa\xf50\xa3S\xe2\xbb\x17\x88\x89l\xfeHW1\x95\x02c\xb1\xb8\x06\x1fra\xafi\xe9\xac9\x92\xad\x84\xb1\xa4\xa3\xf7\x1b*\x9d\xe0\xfb\xf9\xe6\x87\xb6\xa9\xcdF\xa5\xb1\xc0\x13\xe2\x05=\xa2j\xfe\x88SQ\x8b\xa2\xa9\x1f\x81\x99X\xa4\x0e\xbb\xd2\xa4\xc4\xb6|\xc4\x8e\xbb\xa5\x9eO\x15t\xea\x1c\xff\xc4?\xf6]\x95X\x91c\xdb+)HT\xfc\xa0\xc9\xde\x83\x8aMv\x89\x8bH\xdd\x80\x8e\xd4\xa0h\xa9\x9b\xa6~\x8c\xe4\xfb\xe6\xb9\xd5y\xdf<\xcbH\x0e\xcae\xa4fXDj\x92\xd9H\x8d\xa9\x8a\xd4\x05\xa6}\xbf\xaf\x11\xb3\xce\x951r\xdc\xbd\xb4SgJ\x8a\xa3\xecF\xc0\x9d\xa5~\x1e\xcc\xacF\xde\x02\xf7\xff\xb2\x95\xb6\x9dl*\xa0?\xcchA\x16\x0e'G)\xdaP,\xc8Z\xca]\x82.H\x96^!IA\xa5\x18\nR\x97\xfb\xba+\xb8\x14,\xc8\x90\xe6\x0f\x8el\xfe\xbd\x8c\nR\xb8mq\xde\xe9\x9d;\x06\xb3\xc4\xa2\xa9\x88\"\x83\xf7\xa0\x95P\xde\xc6/TjF\x05*\xbf0\xd9ZH\xc9\x16\xc8<\x8c3(-\xe5`E\nRn\x18\xd8W#\x87@\xfbF{\xc8a\x0b\xfb/\xbe\x9b\xa2\xf3\x9c\x94\xcbs2\xca\x0b\xd2\xd6\xd7\xc6\xd7S6\xb3\xba\xa9U\xe6\xca\x8a)\xd8=5\xdf,\xb0\xad\xa2\xaa\x8c\xfb\xfc\x93\x908'\xba\x06\xbd\xf4\xea\xee\xcc,\x11\x93\x9e\x131J\x98U\x18\x0cWd\xa9\xa2\xc0_\x12-%\xce(\\\xeb\x83\x14\x99\x12\xac\xd0\xc8\xaa\xcd\xb2y^JT\x02Y\x81F\x89\xddS\xdb\xf4\xc2\xbbwNZ\x97\x85\xed\xfde[\xb9Y\x81<eS\xda\xd7\x19q\xd7\x18\xcc4\xcf\x0b\xd2\xd1\xd1\xa0x\x11o9\x06\xf1\xe6\x9eM\xad\xff\xdb=\x85\xaa@\n~\x0e\x85MW\xe0M`!\xc1\xaa\xa6f\x19\x14\xbb\xa7\xd8`\x19b\xe1_ZB-/I;\xe0\x07\xc4\x82\xf1\xc8c\x9f(\x8e\x1b	\xf1aBF\"\xeff\x9e\x1b0\xfd[*\xce\xa0r\x0by\xa6J+\x90U\xb2\xa9\xb3\xb8\x8a\xe3\x98\x9d\xd3YW\x8d\x0f\x01\xd8|e\n\x98)\x88\xc7jNr\x14\x9c>\n#H\xb5O\xa2\x1b\xc7b\x95\xe7EPi\xec\x0d\xd8\xd4\xed\xe7\xe4\n\x8dlj\x17\x91\xbc4\x02\xd8vE\x87\x0bG\xe1\xba\xf7sLU\xf35\xfa\x16\xb3\xab\xe8`\xbc\x8d\x89!\x17\xd60\x8d)i\xde=\xd1H(;\xa7\xf3\xbeA\xa6\x8e\xe3:\xa0\xe5\xf5o\xbe~\xb7M%,\xdd\n\xa9~\x9a\x84\xc5J\xd5O\xf3\xf9u\xc0\xfe\xbd\xa4\xf8\xa4\x1c\x0b\xcc,\xb1\xfd\x17`\xa9p%-c-h\x04\x13V\xf8m\xa0\"\x97\x0b\x8d\xa9=\xdb\x84\x11~\x1b\xcf\x0c\\\x83m\xd8h\xfa\x16\xd7\xdf\xff-6<\x06[\xfc'\x8d\xc6\x87t\xb2PTusAc!\xfd\xa6\x99\xf0\x82*\xe4\xdd:\xd6\xe8j\xd3I\xc6\xb0\x92Mm\x0eTl\xa9\xbd\xe7\xe3\xa6\xaet\xdb\xde\xba\x94\xe8\xf7\xe4TC%\xb8l\xea\xb8\x04\x10tL\xe6\xee\x05\xfa\x1f\x184.\xe9\xc3\xdf\n\x11_\xf93\xe4\xbe\xdd\xaaM\xa1\xf75\xc8\x98D\xb7B\xc7dE\xea\x9b\xfb\xae\xa0-i\xe5\x80Q\\.r\xe1\x1d\x9dp2\xb2\xb3oWe\xbeP d\x1cY\x13n%\xec\x9e\xb2\xf2\x11\xf3\xd3\xd3\x98\x05K\x85\x93}t\x1ezkvSD|\xd1|\xdb\xd7\xf1\x1f\xa5j\xe7GW\xb7Mm\x98BN\x19\xa9\xdd\x93o\xf5\"TD\xab\xb1v5\xdcg\xe7.0^e\xa5,$\x01\xbf\x142\xde\xcc\x9d\x98\x9b^\xd1\x86gL5-5\x1a\x1fZ\xf27tS\xb1\xbd@\xc0\xfc_\x89e\x88a\xa4\xbc\xec\x8d\x07\xf7\x87\xff?>\xfa|\xfc\xf9?\x03\x00PK\x07\x08$\x1fI.S\x06\x00\x00\xf6\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc{\xcdn$\xb9\x91\xf0\xb9\xfb)\x02\xf5a\xbe\x06\x0c\x8dZ\xe3\xf1\xc1\xe8\x83\x81VK\xea\xd1LK\xaaQi\xd4\xf6\x186\xc0\xca\x8c\xaa\xa4\x92I\xe6\x90\xcc,e\x19\xbe\xefa\x1b~\x85\x15\xe0>\xcc\xa1O\xbb\xa7\x01Z\x97\x92\xdek\x11\xfc\xc9d\xaa\xd4\xb3\xf6\xc0\xbb')\xc9`0H\xc6\x7fD\xfd\xe5\xe9\x93\xc9B\xe9\xcaL^\xc0_\x9e>y29z\xf9\xed\xe4\x05\xfc\xf1\xe9\x93'O&\xc7\x92\xa6\x98\xe5JB\xcd$\n\xb0xm';n\xf2PZ\xcd\xd1\xc0Bi\xb0\x05\xc2\x9cI\x89\xfa\x99\x81#\x8d?4(\xad\xe8\xe0\xa5)1\x87o\x1b4\x84\xc3\x80\xe0\xc6\x02\x939\xf0\x04s\xa5r&&O\x9f<\xf9\x13!\x9e\x9c\xce\x8e\xde\xf6\x14\x9cb[\xa8\\n~\x84\\A\xad77\x19\x86\xed\x0f\x94)\x19\xd4\xaaUw\xb7\xcd\x15B\xc5,j\xbe\xb9\x11;PZ\xa57\x1fAr\x84+\x849\xaek\xbc{G8j\x8dk\xd4\xec\xfe=\xb4Pk\x96\xa9V\xaa\nj\xad\x8c\xd5\x98o>\x0cD0\xb1b\x9dy\xa3\xb2\xb2'\xe5\xf2\xfe6\xef`\xcd\xaa\xcd\xc7\x92]A	\xa6Vy\xd9\x04r\x08\x14L\xa6\x95\x10\\.\xc1*\xa8\xd9\x12a\xae\xacU\x15`\x8b\x12V\x05J\xb0l\x0e\xdc@\xc1\xf3\x1ce\xb2\x9fT\xb2\xab\xb8\xc1~\xbb\x97~d\xdd\\\x85\x1d\xbeWs\xcd\xd6\xd0\xde\xdf\xa0-7\x1f\xb3\x02j\xcdM\xcd\xb1e\x16\xefnU\x0b\xacT@\x886\x1f+\xd9eE\x82]g\x05o\x13\xdc\xfe\x1b\xf0\xba\xe6\x1as\xb0\x85F\x96\x9b\xb0\xd17\x88\xf5\xc3)`\x06\x08\xe6s%E\x07\x01\x9f\x01.\x8dE\x96\x83Z@\x8e\x02\xad;z\x81\xd5\xd6\xd6\x07Z\xd53\xd5\xe8\x0cMO\x05\x8dET9\xf0\x8a\xee\xcb\x04\x98\xf0\xc6\x84\x13a\xd1\x08\xf1\xb9\xe1k\x84\x05\x17hh7\x07m\xc0Q\xd3\x18Z.\x07T\xe18\xbbpQ4\xd5\\2.\x0c0\x8dPbmw\x13\xd2\x9a\x9c\xabK%\x9a*\xb9\x19\x1a\x83\xd6\x0fz\"<\x04m\xea\x16\x00\x97P5\x86g\x8e\x8f[\x9e\xa3\x82Z\xb0\x0e\xb5IQ[\xb5\xe4\x8b\x01\xad\xe4\x95j\x19\xf1\xe0\xeb\xe3#\xa8\x99\xc8\x94\xc4\xac\xb0]\xd8\x84\x00\x9a\xab\xad\xc9\x1e\xe1|y\xd2\xd8\x81L\xfa\x80}\x96\x95K\xad\x1a\x99\xc3%\x91\x11P\xb99/\x90\xfd\xbc#3\xc5\xe6\x17Dt\x9f\xc0\xf42\xaf\xb8\xfc\xdc\xd4\x98\xf1\x05\xc7\x1c*\xac\xd0@\xcdk\xcc\xc1X\xcd\xf8\xb2\xb0\xc0\xa5U\xd0\xa9\xe6\x99F\xa8\x94\xe4V\xe9d#\xc5t~H\\\xd6\xf5\x9b\xdd\xfd;3\x9e\xbd67\x19G\xc8\x95)\xe35L\xd5\xdd;\xb4\x90\xcb\xcd\x07\x92[\x90\xaa\x0d\x9c\xbe\xf9`jlKE\x82\x8b\xb9\xdd|\xacv@\xe2\xfd-\x18\xe600XW\xec\xfe\x16\x1f\xec}\xca\x92\xc7=\xdd\xdc\xacU;\xda0\x0cI\xd5\xe2\x95\x9f\xd8\x85\x93\xcd\x7f\xdd\xdf\"\xa8\xb9a\x85jI[T\xec\x9aW\x9b\x1b!\x11\xbe\xd8\x83\x8a\x89\xcd\x8f \x98\xe5\xd2\x94\xa4U6\x1fL\x85\x92\x01\x138Wp\xf7n\xf3\xc1\x08\x960\x9a\xbb\x85\x0bn\xc5@\xca\x05\xb7\x9b\x9f\x84*G\xc4\xf4\x83	9N\xa8%\xab\xf5\xe6C)X\x0e\xcf\xa6Jp\xcbK\x06\xff\x1ff\x9aI\x8e\xcfv\xe1$\xa5o\x0f\xd6\x92\x95\xaa\x1d\x11\x90\x0f\xf7\x7f\x81\"\xb2\n\xfd\xebTzC[n~,\x1c+/77\xb6\x19\x16g\xac\xb6Y\xc1\xfa\xf5\xdf\xb0\xfa\xee\x1d\x0b\x18\xa6\xea\xfe\x96\xb3\x9c]As\x7f\xbb\xf90\xa8\xa2y\x07mW\x0b\xc9\x05\x87\x92V4\xf4p\xd0\xe8\xbbw\xdcn~\x04:h\xe1Ow\x85\xa0q\xc9\x8du\x0c\xc1\x80A\xdb\xd9vs\xe3N\x07\xad\xd8\xdc\x94(\xb7\xe8\xb9`\xcbA\x95\xbc\xf24\x82\xa5\xc1p6\xb64\xa4\x86\xbdFqV*\x9c\x04\x8c\x12\x0dY\x1e\xb3\x0bg\x12i\x11\xa9d\xcdd\xae*\xd1AV(\x83\xd2\xad@\x96\x15\xb0D\x89\x9aY\xcc#\x82]8\xc7\x1f\x1a\xaf9\x15dJZF\xea\xc7\x82@f,|	9_,P\xa3\xb4\x84\xdb$\x0f\x91\x15L\xcf2\xa5\x07FxU0\xcd2\x8b\x1aL\xcd*0n2\xea\x04\xcb\xdd\xe0\n\x9d\xa4\xa9\x05\x99J\xbe\xe8H\xcb2\xc8\xfa\x95\xb49\xd4\xca\xd8]x\xb9 Tx\x9d!\xe6A\x19\x83\xe0\x15\xb7\xee\xbf\xc6\xa0\x86\x15\x17\x02$\x92nWt\x15-\x02\xebO\x96\x90\xaa\xb4\xc6\xcc\x9ei\x8e\xd2:\x17`\xa0\xd9O\x05\x95\xad\x12\x08O\xf8\xb9\xb2\xcc\xa2\xd3\x8f\x0b\xc1k\xb0\x89\x1a\xce2\xa5\xf3`!\x89\xa4\xc3\xdf\x1f\x1f\xa5(\xe8\x944\x1e\x9e\xcdm\x91\x10\xd5\x18\xab\xaaW\xb3YOK\xd8\xf2;\x83\xfa\xf3\x1c\x17\\b\x0e\xaff3\xd0\x0dY\n\xa1X\x8e9(	V\xd5=j\x14\x98\xd1s\xda\x02\xab\xc7\x90_\xa8\xe52\x91\xd5K\xc1\x8c%\xd5M\xfb\x06\xb6\xd7XK\x0e\xb5\n\\O\xaeF\x1b\xc0\n\xe5\x00{\xb49.X#lJ\xf4%)5\x95\xaf9\x03\xbb\xf9\xb1\x8a\xc2tA\xff{\xef\xe5'\x90\x8c\x14\x89e\xf7\xefw\xa0\xc4\xbb\xbfA)\xe8\x92\xc0\xc9\x9b$\x00\\\xb7Jp\xb1\xb5\xd3\x1b&\x97[[m>\xc0\x15[we\xd8\xeak\xfa?:J\xe1\x18\xf7\xef\x9d\xb2i\x03q\xa9;\x94\xa3\xa1\xcb\xd1l9\\\xcb\x01\x9af\x16\xc6<\xd2\x04jW\xe9e4\xe9H\x869\xc1\xc5\x0d\x9b\x0b<Wse\x07	\xfe\x9e\x95\x9b\x9b\xfb[X\xe1<\xd3l%P\xab6\xa0\x9djr\x9f,\xb0\xc6*\xf2\xf2r\x022\xdc\"\x04H\xb3\x03\xa6\xc9\n\xf2Q\xfcf\x80r\xc9%\x02\x979^\xbb\xf9\x85V\x151\x1f\x1a\xe3\x98\xaf\xe0\x06\x9cZ\xde\xdd\"\x8cXi\x9f\xa6\x12\xe2ts\x7f\x93h%gr8\x96 \xf1sFF\xb2\xe2\x81\xd6\xef\xd9\\on$\x07\xab\xaa\xc6iAVn>\x96\xea\xee\xb6\xc5\x12\xb8\xdc|\x84\xcdO\xce\xc69\xe3\xe5\x16C\xa5\n%z\xf4\xf7\xef\xbd2\x0e\xa6a O\xc9\xe4\xf2U\xa9\xe4\xdd;~\xff>j\xec\x9e\x00\xdb*M\xe3\x8a\xfeA\xda1B\xf4\xa80\xe7\xf6\x8d\x1a\x98\xe4\x1cI*\x81\x86\xa1\xe0\xf4\xd0\xd1$9_\x90\x81PK\x92\x1elQw\x1e\xacb9\x92\x0c\xab\x1a\xa5\xd3=\xf4\x08\x8a\x14\x14\xe9J\xa5\x0ddL\x82Fr\x8d\xa0P\xab\xa0\xa1`\xc5\x0c\xd8\xae\xc6<\xa1\x86\xb4\xdb\x9c	\xd1\xd3\xf3\xff~K\x9f\xa0\xf2Z\xb5x\xf7\xb7\xc8_o(z ?L\x9a\x15\xea!\xf2\x08\xe0\x053\x05d\xaa\xaa\x98\xccw\xe1\x15\x93\xbdjnj\"\xf5\x8b\xbd\xbd~)\xe9\xa6_\xef\xed\xed\x0dZ\x94\x8c\x85e\"\xe1\x07\xac\x18\x17\x87Z\xf7d\x1d\xd2\x00\x1cj\xadtoe\x9c\xaa\x00\x07J\x8c\x85n\xf2\x11$'\x8c\x0f\xe7s\x88\x02\x06\x87\xce#xd\xd9\x94\x99\x81\x0d\xe9c\xa5t\xbe\xbd\x12\xea0\xf5\x18\n\xa5m\x8f\xc2\xed\x0c\x06u\x8b\x1aj\x9a\xd9\xc6\x95\xcc>\x82n\xd6\xcc\x1f\xc5\xf6iD\xa6\x99\xe7\xaab\\\xa6\xd8\xe4\x92-\xb1Bi\xcf\xd1\xa2\x1c\x99\x97\xc3~\x0erf\x19\xe8\x1e\"D\x05\xac3\xd0H\xcb\x85\x17a`\x99\xe5-\xb7\x1d\x18\xb2!\xc6\xf2\xcc\xfb\xfb.$\xc1|\x17\xf6\xa0D\xac\x8dS\xf8N),\xb8\xe4\x16E\x97Rt]\x90\xa1\xe3\xc3\xe1\xe2\x00M\x0f\xf3?\xa3\xd9\xf0\xbaf2\x7f\x990\xf2\xa1\x1b\x81\x97B\xc01-\x89l\x13\xc6\x89k\x9d\x924\xbb\xf0\x16\xe7\xd5\x0eL\x0f\x8e\x1co\x9eL\xbft\x7f;\xd5hP+/bt(\xf9\xcc\x02[,\x9c\x01\xdb\x85S\\\xf9\x99\x10\x0fQ\x94\xc3\x84Q\xe4_3\x99\xe3\x88\x1f\xae\xeb\x11'\xb8O\xe7\xdfNv\x1e\x8e\x80d\xc6\xb2\x16\xbd\xaa\x03\xb3\xf9i\xaet\xe2\x13.\x10\xf39\xcbJ\xc7\x00\xc3i\xe9\x0b$\x03So\xfen\xc9F\xb5\x9b\xbf\xaf\xe71D&\xc5\nqe\xe05\xab \xe7\xc6)	.\xe9}\x9c\xa1\xf6\xa9\x84d;\x91:{\xdfh~%U+\xd8\x95\xba{Wv\x0f\xc2\xe30\xdc@\xa9\xd9\x15\x97]\xd4~,A\xa7t\x869\x05\xd8=\xe9\x97\x9d\xdc\xfcdQnn \x84\xe26Ze\xaf\xfc\x9b\xfb[\xee\xbd[\xe7\xf3W\x04\xbaC\xb6R\xa0U\xe5\xe6?\xf3\x0e\x98?SG\xc9\x05\xafqUj\xf7\x96L\x08\xd4\xdd\x89\xca\x075~\x8e\xf7\xb7\xbc\x82%E\x14\x9aG=\xe7#\xfd\xcd\x07\x10(\x87\xd0\xa7\x03\x03\x8a\xac\xcb\xbad\x15O\xf0*\x95z+\xaf\xfd\xa7\xbf\x14\xff\xf1	C\x1cr\x10\x91\x96YF\xd1\x8e$\xeb\xff\x02\xf6\x02\x82\xcb\xee\xee\x1dI\xd4\xfd{X\xab\xb5t\x1ej\x0f\xd6\x1f\xb3\x1d\xa8)x\x8e\xfb\\J\xcc{\xc4_\xf1\x1c\xc1\x87\xf29L\x95\xb1Q\n\xdc\x04\xc9\x00\xb10I'\xb3P\xb0\x96R6(\xa3\xf4\x8eQ\x8f\x92Cn=\x8d\x90\x83\xf7\x9c	\xf1<E\xect\x83!\x0f|\x899\xf9\x06RY0l\xe1c\x81\x95\xd2\xa5\xf7\n\x88\xe9\xdcb\xa8\xd02\xb7j\xbc\xe79f\x8d6\xbcE1\x04Q\xfd\x98\xa3\x9d\xd29\\.\x1f=\xd6\x8e?\x17YB\x8a\xa1\xad\x02\x16\xb2?n\x1et\xc4$\x12[O\xb7\xe8\x92\x17\x03\xd7\xfb\xe7!\xe7`sS\xdc\xdd\xb2<2\xfeA\x90\x1f\x06\x7f\x9c\x15j\xf5'\x987\xd6*I\xf2T\x0b\x96\xb9\xcc\xc5\xe0\x81'{(\xcd\xd7\x14\xbb\x08z\x13\x9e\xf8\x8c_\xf53\x10\xa7\x88\xaa'\x93/\x7f\x9b\x15L\x82T\xc62\xb1\xe4\x89H\xf1\xe1\xb9\xdf\xa8%\x8f\x91@\x02@\n\xea+E\x86\"\x02z%\xe8\x0f\x15\x18\xbb\x83Z\xe5P6z\xad\xb4\xaa\x1e\x8aC<y\x84n\xc6\xd0\xe3\xbd\xce\x95\xb2g-j\xcd\x13ysJ\x18\xb4R\x16T\x9c\xf3\x9b\x1c/H\xd7\xc2\x8a\x9b\x82\xde\xa8H\x94\xaa\xe3\x12\x06\x06kFa \x08\x95\xb9x\x08\x04/)vzup\xba\x03()\xf0\"^\xa2l\x95\xdf\x82\xe5\xb9Fc\xa0@\x8d\xbb\xf0\x06\x89\xb5\xb1\xaamG;4\xc6giBx\x10\x81w\xe1\xf0\x9aU\xb5\xc0\x17\xf0\xac\xb0\xb66/\x9e?\x0f&\xa2\xc2e\x931\xb2?\xcf\x1e\x9cu\x1cR\xfaC\xfe#\xe1$1 y-L\xfa\xb3\xfe+\xe3G^\x8d\xcc\xcdq\xf5\xd0\xdc\x1cW\x8f\x9a\x9b\xf5#\xe6\x86K\xc1%\x1e\xf1\x91\xf5\x8a\xbc36\xa9\xc1\x10riH9\x10\xf95YM\x1b\x0c\xa8\xccA\xa3K%\x8e\"Q\x83\x96.\"\xb9\xd6z\xdb/9\x9e\xfe\x9c+r<5\xcebGO\xcc\xc4\x0b\xfe\x05\xbe\x08\xff!\x1f\x9c\xac\xe3o\x0f\xf6\xc3\x19i\xfcg\xfc\x8f+\xe6\xd2o\x83\xce\xf8:\x0e\xc4\xa0\xcf}\xba\xa37\xd2\xc2\xf1\x81\xd9\x85\x08\xe3\xfcu\x97O\xf5\xa4z\xda\x93\x07\x15iX\xf9u\x12K\xbe*\x98\\R\xb0eQ/H\xe1\x10d3\x8a\xdb\x05\x97\xe5@\xd6\xe1\xb5EM\x01\xb5\xcaK\xb6\x8ezl\xaay\xee\xa2\xddu\xc5\xd6\xc4\x14>\x9dF\xd1\x86{g\xc0\xb8\xecw\xbf\xfb\xdd\xf3\xdf\x90&z\x1e1\xf4DV\xccdj`\x93\x13\xff9\xf2\xd5=Ht;\x1e.u\xc2\xd3\xaf\x0f+\xdd`\x14Z\n`\x8a\x88&]n\x9b$\xafs\xe2>]\x04\x822z\xd9\xbe\x9e2\xa4b\xe8\x9f>\x96%U\xcc\xa5\x81j\xb4\x92L\xdb\x82kc\xa1\xe5\x86\x8f\xf6\xbb~i-\xcb\nr\xa2\x87\xbbu\xa9\xc0\xa6\x02\x96\xcc\xed\x8cfdS\xcdQ\x13w\xbalz\xb0O\xf4\xfas\x0c\xcb\xa2\x9d\xa2XYxN\x18m\xfc\x95\xd3 \xfd\x9eo\\\x82I2\xb8\xbf\xd9|\xd0e\x13\xf53{\xb0s\xd1\xe7\xb1\x9a:df\xbc\xb8\x8e\x90\x1f\xe7\x02/x\x92\xb6\x8d\x84\xf3<\xd0\x02\x96\xa6\x03n.\x1b\x8b&\x86\x97\xe1\x18\x94\xe9\xf7\xa1\xe9\x8a\xdbB5\x16\x98\xf4\x81\xab\xd9\x819.\x94&'\x83\x84?\x13\x8a\xca\x07!\xb7\xc03&DG\x01\x03e\xff\xa4\xf2\xba/\x11\x82\x8a]\x9f\xd5(\x1f\xa5\xaf\x0f\x85\xff	\xfa\xfe	jRc\xa1\x16\xf0\xe5\x1eT\xfe\xecc\xf2f|\x8d\xdb/\xd3\xe2\xddm\xa9\xcc`i\xfb\xecJ\x9f;~\x04\xa8\x81\x16N\xf6G\xe8\xdf\xf2\xdc\x16\x8f\xe0\xdf|\xbc\xbf)\xbbO\xbd\xfc\x8aV\xfd\xfc\xc3\xe3\xb2\xb9\xb8\xec\x11\x9f\xf8\xcf\xa0\x19\xc8\xbd\xf1\xe9Y\xef\xd5\xc5\x9aD\xe6\x0b\x1bT\x87r\xf6\x9c\xe0(\x96Hc\x07\x8fxV(m\xb3\xc6\xfeol\xa0\xf27j\xb9m.\xc8\xd1\xd7\xc1QP\xcb\x9f3\x1d\xd5\x18\x12Ca\xf5\x9f6\x1bC\xf2e8\xe60\xb43\x1e\x18[\x81\x01\xce\x89Pj\x02v(\x1cs\xff\xc6\xc4IN\x11\x81\\6\xe4+\xc5\xf1yG\x0c\xca5T\x12\xa9\x0c\x949\xeb2<\x83L\x0b1'(c	\x82\n4\xa4Y\xbd\xe2\xa1\xffd\x88i\x13\xad q\xd5\xe7>\xe2\xc9\\\xe8\x1b\x07\x1fz\x9b\xb4\x80\x94V\x0fLu\xa4\x18\xae\x04\xe8\xb3\x98(\x93\xa3\xc9aSey6\xd0|\xb6\x96\x9b\x9b\ne\x1f\xa5}\xa377\xb6d\xb1\x94M\x15\xe6\x1b0T\xa3n\x19\xac]\x08GA\"\xe9\xc5VgE\xf30\x9fG\xf8\x17<\x1b\xa7\xd6\x0f\xd0\x94\x14\x01\x9f&\x93\xf1\xe9^Se,\xcc\xa7\x8b\x8d\xaf,\xff\xd0(Jj\xd3\xcb\x82\xe9d\xb6b6+\xa8\xb0\xc1\xe6\xa4\x00)\xd9o\x99\x1e\x1dpE\x92\x95z\xfe\xa7j\x05a\x0c\xf6}\x10\x1el|\xa3\xc9\x8b\x12.\x9cv\xd3F\xc9%\x19'\xfd\x9c=\xa7\x9a)\xa5\x8fr\x94\x8eA\x94-\xa8\xa0a5\xb2*\xde\x0f\x1ds;\xc6O8D\x89|\xeb\x91\xcf\xc4\xe0P\x05J\x92\x05\xc1\x83\x7f\xf5HA(z\xfe\x10+C\x17Ce\xe8\x84\xd55\x9d@-\xfa\x18QQ\x98h\x1eX\xf8X&\xa2S\x92\xf2\x0dJ\x07\xf3\xad\xcc\xb1ZIL\x84\xee\xcc\x7f\x066\xa3\x8f\xb1\xb0\xb9\xec2euH\xa0\\\xc0\xebb\x06\x9f\x9e&\"(xt{8\xffA+av\xe1\xa41\xb6Oh\xf6\xb5&%1\xb9\xc2\xfe\xaa\xe25L?uw5%K/\n\x8d\xa6Pb\x88\xdd\xbc\xa33\x17*+]\xab\x86\xed!\xc6\xfa|\xf0!|\xa9\x8b\xaes\xce\xad\x819\xda\x15\x05\xeft_5\xea\x0ck\xdb0\x9f\x9a\xa5r=\xe5n\x83\xf1w\x8c\xc2\x86\xbd\xfa\x16\x80\x98\xd5\x0d`V\x91\xc1\xd4x\x15R^{\xdeI\xf5\x03THf\x99%\x9f)+F\x96\x904\xc8+\x8d\x8e\xed\xc6\xe1\x11)\x06\xc8\xc2\xd4?\x14&y`\xb9\x04\xd6+\xa7\x7fe\xa4D\xa4\x1e\xbb\x08\xc7G\xc4\xc3k\xb8A\x17\x83\xc3\x1b.Kp\xf3f\xc8\x84\x06\x00\xf2\xae1w\xaa\x18\x1a\x99\x878\xd4}\xd2\x14\xf1o&xV\xee\xc2[R\x14\xa1\x00\x93\xef\x80d-_2r\x9fH\x02\x8a1\xa6\xd0\xdb\x91\x12\xaa\x1b\x89\x0fJ#S\x1a\x0br4\xee\xda\x88\xb2\xd5gu(\xffR\xb8\x87\xef\x06%\xef\x9e\xfb\x14r\xd6%\xfa\xdemt\x11\x9aR\xe2m\xf8\x9d\xc6\xad*\xa1A$\x0c~b/:\x8d\xb3\xe0[\xdbtM\x8f}&\x1a\x0b(\xe9bb*\xfb@\xb9d\xab)\x07\xc2\xf4\xfc\x82\x1a\xa0\xe2\xa2s\xcc\x9f\xef\x8b\x06\xe1bh\x8b\x8a\xe9\x18\xaa\x0e\x13\x8b\xcfi\x9ej\xec\xc0\x17t\xd8\x8aY\xe2u\xf2K\xe1\xd9\x9f\xf53R\x9c\xcf\xfe<OBz:\xf6\x99L\x92MoP\x92\x15\xf1\xf5\xc0\xc1\x00\xb9\x92\x99\x8b\x94\xfaF \x9aL\xf0PQ=I\xb8\x9c\xc7\x81\x87\xda@\xa3`\x96\xb7c\xb7\xfb<\x0c\x02\x8d\x1a\xcb\xaa:>p?\xd3;\xbb~\x9ar\x17\xbb/\xe0\xd9\x17PP\xda\x9a-\xd5\xe8T5\xb2\xf4\xea\xdc\xe76%\xb5\xe8\xceG\xd1\xc5\x1f\xcfi\xecO\xa4\xf3\xfcDtdZ\x047\x15\x13^\x81\x875\x01\x81\x0b\xffC\xc1\xb7\x1e\xc5\xa2\x1a)\x13\xb1\xed\xaf\x9d\xbb\xf1\x9f\xf3\xd3\xfc\xca_\xe0\x98i\xa5\xecw\xe7o\x86\xc3S\x02\x8a\x06b\xbd\xdc\x7fFz\x9d\x1et\xe2\x93t\x1a\x90\x9c\x18U\x8d\x93\xb9\xd4\xbbF.\xb0\xab^\xb9\x9cf\"\xae\xae\x04\xde\xef9\xd5\xac\xdd|\xb0}P\xe6\xe48\x94\xc9\xb7\xb3\xf04N~)\x13\xb0\xe22W\xab\x01\xadI\x03\xe4\x19}Dqq\x12>o*\xaa\xf8G\xa1\x8c\xaf\x92\xd4\xdf\xdd\xc9H)\xd5\xa9&N\x087L\x0c\x8c\xb2\x1f\xdb\xf7n\xc0\xa8\xbb\xdb\xb0\xd9\x8c	\xeb\xaf\x04\xb3F#X\xcd\xebLQ\xdeU\xe6\x83\x0b\x1a:7\x08;P\x8d\xb3\xaa\x90\x8a\"\xc1\xa6\xf4F\xf47\xbe\x0cH\x06F\x1b\x10J.G\xc44\x19J\xa6z\x82f4p\xca\xa2\x03\x1b\xe7w3U\x8d\xde&AAUg%\x1f\xf4A]v\xb5\xbe\xbf!\x91\x05C\x9e\x13g\xaeC\xc6\x97\x87\x03r\xd7+\xd5B\xad\xee\xdeY\x84\xfc\xfe\xdfTVP;@\xdeQ\xdf\xd3\x83\x9a\x04\xad\xb4\xdd\x10\xcaR8\x96\x17bsc\xd2L\xad\xa9\x15\x17\xa9\xb7B\xba\xcb\xd5\x9bM\xad\xae\x04\xf6\xf9\xc2\xa9k\xad\x1c\xf78\xfc\xeaW\xa4\x88\x02 \x95\x018\x92\x05\xa7\xb6,RqI\xca\xceX\xb6X\x8c{\x9eB\xaf\x95Vb\xab\xd0a[u\xd5\xd0\x0cE\x9c\x85`-\xbf{Wbt\xd8Gh5\xafO\xd02\xaa\x0c\x0eOB\xa3\xe1\xf2\xab8\x19uUE\x8a\xe2\xf0\xf7\xc7G;\xf0\xfb\x93i\xe2\x9fF\xc8\x1d\x9f\xc8}=\x9dA\xa6\\N\xd0\x99D\x02\xccX\x85\x9aR\xbf\x9a3\x11\xdb\x08\xfa\xe4\xc5\xd7\xd3\xc3\xd7;0=}\xed`\xdf\x1e\xeeO}Je \x97.eK\x95'=\xb4\x83\nW\xae\xa3@\xb8[\xf6\xe5\xb6\xb4\xaaBQ\xdf t\x17\xee+\x08\x82kfq-/\x0f\xdaY\xbcQ\xf4Lw\xc2\xae\xfb\xd5\xce\x87c\"\xca\xa7\xeb\xc4\xeb\xd2\x94\xc5\xe9\xe0\xdc\x91\xee\x8b\x19\x94\xc1d\x87\x94\x05\x8b(\xb8\x89\xea\xf0\x13\xbb\xf3$*\xe6\xf2\xffb\xf7\x11\xe3=\xdal\xe7\xc37\xf2\x0ej\xa2_=\x0c\xcf\xac\xab\xec\xcf\xbc\xb4\xf4\xe4\xcf<\xeb?\xccs\xb8~\xa0\xcdO\xf7\xef\xc1<\x00\x80\x96\xe2K\xea2\xf6\xf1eb\x88\xa8\x1bk\xffu\x8f\xfa\x15\xf5\xceTIW\xe7\xb8\xc5\xc0\xb7>\x85\xae\xe2\x01\xe6\x01\xb6\x7f8}\xf9\x18\n\x171\x06\xb7\xebL:\xdb\xda\xa3zKs\xe1\xca\xa9\xef\x96\x8c\xa1\x88\xa9\xdb\x97i\xbe\x8a*\x19\xf1m\xc8(\xd1B\xcc\xb7\x97\x0e\xdb\xe2\xbc\xfate\xe8-\xceO\x1e\xab\nE?\xcb\xcfkl9\xae\x1ca\x05\xa1\xea\x0d\xa7\xf1\x85wp\x1b\x0c\x0etp\xf5R\xefvU0\xfbR\xa6\x1e\xd0\xdb~\x84\xae\xc7C0\x82\xd8]2p\xffP\xc77\xa24\x85\xb2[Z\x9f\xac\xf1\xa8\xd8\xfb\x96J\x8e\x94\xa3	\xe8\xa8|ib\xc1\x824Hp\xcc\x0d\x05\xa1\xfa\xf1\x17\n8\x1ft\xa8M}/\xfb\xe6#hWP\xfe\xe5\x1bt\xd450\xe8\x8a?\xf8\xcf\x9d\xe4c\xdb\xc6=}\xf2\xd7\x9d\xa7O&\xaa&C\xdb\xff\x8e@\xfav%\xff\x97\x10L\xfc]\xd1\xd8\x82\xfa\x11U\xb8<?\xb9\ny\xc58\xe7\xbf\x03j\xa3\xb4\xa5cG\xa359\xd0K\xd9X\xee\x95<Et\x02\xe9G\x02\x85\x1a\xca\xe5\x8d\x9f\xf3\x16\xb4\xa3\\\x0f\xcaa\xc1\xdd;\xdb\x84\xfe\"\xea\xfb\x0fh\xdch\xa2}\xe9\xd2'\x96\xf5U\xd8\xc9\xe5\xfd\x0d\xaa9fr\xf3\xa3_s\xff\x1fv\xf3\xd1\xb7\xf2L.;W\x9d\xdc\xdcx\xbb8N\xb4Nf\xa5f\xb6\xecz\xacX\xd5\x82\xecL\xbc\xaf9\x93\xd3Tv\xff\xa0\x9a\xa4\x18\xee\xb2%\xb9/E>\xff\xcc<\xa7|\xdbgC\x07\xd4B	\xa1V\x14\xa2jdF\xc9\x17\xf1\xc9\xc8\x0f\xa7\xf4\x9dk\xfat\xca\x16IF>3\x14vp	\x9f\x99\xdd\x00\xfa2\xad\x87\xd12\xdf\xd6\xb3CeQ\x0d\xc7S\xd2\xb3\x9f\x85\x08;<K\xc3#\xf1\xfeG#\xa1\xbeA]\xbc\x9e\xc7'!\xedAs\xb3\x90\x19s^J\x03,\xe8[\xda|\xc2r\nzc\x05(\x8c\xc9\xee\x88\x0b\xbc\xe8j\x92\xa0\xc9K\xd99\xe3\xea\x9a\xc7\x02DM\x1a\xe8\x05L\xa6\xd4\xbf\xc8\xfb\x85\xfd\x8f\x1d\xe2\xef\x1c\xc2\xb81|)g\xe4\x97\x84\xcd4\xbb\xfb\x1b(\xc3r\x94\xc6\xb6\xde\xa1\xa3w\xa0\xe9}\x16\x18\xd3\xdd\xbc\x9e\xd5\x98\xd1S\xd15amMh)\xfb\xf5\xde\xe0\x04\xec\xb8\xee}\xa5\xc9\x118	\xbfT \xeb\xe5\xbb\xc7\x9b\nr^\xa1\x8b\xde]\"\xe4\xcb\xbd\xbd\xeb/\xf6\xf6v\xfaiZ\x01\xae4\xa9\x16\xaeW\xed\x9b}\xa7\x15$\xe5\x0e\x1a\x99\xef\x06zb\xc2$\xaa\xfa\xc9\xcb\xdc)Z>\xce\xdd\x04h\xd2\xe0\x93\xfdp\xcf\xa1\xcb\x97\x86\x92.n\xeaJ\x96KL\x92o\x93\xef)\xd1y\xff\x1e\n4\xa1Y|\x92	\xba\xc0\x8c\xd6\xc6\x7f\xc382\xb2\x8f}\xabH\x18Vr\xc1\x97\x8d\xf6\xf9\x02\x9a?u-E\x84\x95^\xbey\x006s\xdc6\x86\xf3\x1c\x18\x00)\x073 \xbb\xecb\xf66A\x16|\xc2\xc9At\xff&\xde-\xe9W}\x1f*\x8b\xe9\x1a\x07\xd1\xdfe\x84\xf0\xc2\xab\xca\x14(d\x94{\x98`\xd1\x03H\xa3c&wr\x10\xff\x8f}Wd\x87\x88j\xe7\x8c5W\x81!c+\x14\xcd\xcc|\xc7\xd4\x8d\xef\x98\n\xd4/\x9a\xac<\xf3\xdcz\xf4\xdd\xabo\xe0\xec\xe8\xc8O,\x85\x9a3A\xe3\xaf\x85\x9a\xbb\x1f&\xf8\x89\x82\x99\xfe(_\xb1\xa0\xfb\xc3\x94j\xdc\xf5~E\x7f\xdd\x88\xeb\xd9\x98\x1c\x1f\xc4/\x8a\x87\xad\xe3\x97c\xff\x7f \x83\x0b\x81K\xbf\xdd)\n\\\xd2~\x9d\xffyD\x00\xa0]\xdc\x01\x93&\xb7	%\xf9B\xf6\x95\xa6\xbe\x11\xbc\xa4\x96i\x8d}k3\xf9\xba\xee\xb7\x01\x81gh\x05\x97\xcb-Yc[\x92\xf5?	\xd6\x97\xbf@\xb0\x84Z*W \x9a\x9c\x85\xa8)j\x14?\xe3\xfb\xfa\x92IX\xab\xf4\xd7Xk\xa69\xa9\x92hN\xa4\n\xea'\x1f2Y\x93S\xb5\xf53\xa5\x08}\x8e\xa6\x11\xae\x92KP:|\x85\xc9\x99e\xfdT\xd2\xe5\xd8a`\xbf\xb4\x1e@T~W\xab\xb5\xd2r0yJ\xe4\xa8\x93-\xce\xe8{\xbc\xcb`\xc0'\x97\xea\xeev\x1e\x94\x86Z\xc9S\xd5g\xfd\xe8\x17y\xbe\xd1\xfd\xc3\xfdMhFO\x7f\xc33\xa9\x91\x95\x97\x1c\xa9A\x97\xc0\xa7\xc8Jh\xc3w\x9f\xeet3\xa9\xf88\xd7\xde\x8d\xf6\xfda\x93\xba\xd1\xcb^\xe8\xa6\xf4\xe1\xe2\x8f\xe7	S\x93'\xc9=\xeb\x9d\x87\x7fC\xc4M\xb7|D=D$<\xd1k\x9cx\x1f\xed\xdb\x06\xdd\xef\x91&3\xf7\x19\xaaM\xc9\xc2\x0b\x8a\xf6'\x17j<\xa6\x84\xe55M\x1cq\xe1\x9b}\xdc\xfb\x91)6\xcd\x9c\xf2\xd3;0WyG6\xc0\xe78\xa8\xe8\x05(3\x16~\xa1F.\x9d\x11.\x17\xbe\x0b\xd1\x92\x9c\xe3\xb2\x11\x8cR\xc95u\x0b\xd1\x13\x04\x8e4h}\x11\xc6\x0cz1\xd4M\xc2\xdb\x18\xb4o\xbc\xd0$\x10\xe4\x99\x92\x0dn\x12A\x0b\xa2l\nF\xb9\x9c\x17\x10\xffKF\xf7]\x946\x99\xb9\x0f\x98\x87\x1e\xae\xde\xf7\xa2E\xdf+\xcdr\xd2\xe0\xeeG9\x92j\xee\xf9\xddm\xc4\xedC\xa5^\xff\x84\xd0)\xd5A&r\xf1\xacg\xe1\xb0\xd6\xdf\x1f\xed1\xd5\x98W\x91\xad\xa9\x8eE\x833\xcb\xe8\x87_\xbcVW\x03K\xd3\xe4\xab\xe8^|'\xf9\x0f\x8d\xeb\xa2\x90\x94\xc7	M\xc1\xf8\xdc5u\x93\xfb\xe2\xea/\x93>*'\xac}\xd66\xc4\xca\x8e\xb4(\xac\x1e\xd2\x87\x03\xce\x8b\x0c#\xc1\x1f\xb9\xe8j?\xd0\xc8\xe01\x9c\xe5s&\xc3\xaf5'\x8d#\x87\x987\xc8A\xa0/\x94K'O\x9f\xfc\xf5\xe9_\xff{\x00PK\x07\x08\x86\x93P3\n\x17\x00\x00|;\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cVOo\xdb\xb8\x12?\xdb\x9fb  h\x0b\x14\xe9=7\xdbQ\x12\xa3I\xecg\xbb\x0d\x82\xd7w`\xc4\x89\xcc\x9a\"\xf5H*\xaeR\xf4\xbc_b\xd1\x1c\xf7Z_z\xda\x9b\x9d\xef\xb5 9\x92\xec,\x8a\xdd\x93f~\xf3\xe3p\xc8\xf9C}\xed\xf7\x92{m\n\xe6\x92\x13\xf8\xda\xef\xf5\x92;\xa6\x14\xf2\xe4\x04\x92\xe1\xe0\xfa:=\x85\xe1-\xbc:\xb2\xaf\xe0l2\x83#\x1b>\x9f\x92#\xfb)I\xdez>G\x89..8M/\xd3E\xb7\"\xdaE\xc1r<\xedH\xe3\xab\xc1y\n\xbf\xa6\xceK-$\x9a}\xf2|:\x19_\xa6\xb3\x97t\x85\xeb\xa9\xb6\xce\x8e\xd5bi\x90\x85\x05G\x1c\x14\xae\xa1\xf48\x08\x05.X\x8e\xa3\xff\x80\x0e\x14\x1f\xfb\x90\xec\xa4\x10\x8e\x02?\xe2q\xc5k\xfb\x06\x98\xe2p\xc4!\xc4\xe2uM\xb4\xce\xc3/\x16\x1e\x12+\x93#\xf7\xe1y\xff\xd3\xc9|\x01\xd3\x0f\xb3\xf3\xee\x08\x7f\xbbG\xbbd\\\xaf\x87\xa2\xb9\xfd\xf9\xc5\xe0tr\x03\xc3\xf1\xbfIB<\xe6\xa5\xceV\x0b\x9d\xe72\x9ejq1K\x07\xa7>g\x07\xb7\\\xa9.\xc5\x1f\xae\x0f\x93\x1c\xbd=\x08\\#\x9f\xb3\x02\xc7\xd3&\xfc9L\xce`q\x91\xc2|p\x95\xc2x\n7\xe9,\x85\x8f\xe3\xf4\xa6\x8b.\xe9\xf7\xbe\xbd\xa5\x82\xb2\xbe\x9e\x82V\xca\xca0i\x9b\xfa\xe2\xacNN\xe0\xbf\xfd^\xaf\x97\xe4\xdb?U\xd82\x8a\x12M\xd2\xef\xf5\xfe\xe7\x91d\xa9+\xd3\x12-c\x8e\x88^< \x16BU\x0e[*g+\xb1b\xc9\xdb}\xa5uZh\xe5\x96-\x95\xd5DcuG\xb1\x98i\xc5[\x0e\xa9\xb4wPlG\xae\x91uA\xd6\xbb\x8d$b\x10=\x8bn$TWs\x03\x8c\x17B\xf9{\x1d\x04\xc1\xafHX\xae=\xe2?Q\x0f!\x84\x0f\xe9:.\xf1\xdf\x80dZ9\xc32\x17\x8b\xd9\xdbF\x84\x00A{\xed9\xac\xbbl\xc6^\x04&%\xdc\xd50\x9eF\x1e~)i\xcfsT\xe2\xf9I\xa2\xdb7t\xbb\xa4\x81x\xb0\xc7Rp\x7f\xff\xc9\xb9x\x94H]\x1f\xa2mN\xf7\x99)\xe1\xb4	Q^\xa1\xe0t\xc8\xcf\x95u\xd7z\xed\xd1\xe7'Qp\x11a\xa9\xb3U\xac`\x92\x02Zh\x8e\x86\xb5^\x1a-\x1a\x9b\xde;\x81V\x8c\xf8Za\xdcv\xa8\x99\xe10\xf1:\xf5\x1b\xe2@J\xbf\xe2\x02K+\x94\x80|\xfb\xd3\xba\xd6\xbc\x8c\x81\x9d\x1f\x80q6\xf9E4\xa6\"\xeeB\xd3\xcd\x9d\xc8V\xbe\xb8\x93\xd8\x84`#@]\xd7\x1d\xab\x95\xdbn\xdb\xcf\x8f\xcfT\x9b\x16\xdf\x8a)\x17\xeeR\xe7~\xa5\x17a)\xac\xd3&\xd6nR\xeb\xca\x1b^\xcfQ\xbdi\xfa\xcf\xd6*\xa3\xaaL\xb8\xb0\x99V\n\xb3\xf6NH\x15*\xa7\x93\xd6*;P\x1a\"G\xd2\xfa\xa1\xda\x13'\nlj8c\x12\x15\xdf+\xfeI\xd6\xf4\xdc\xf3\xf7\xea\x8e\xc4+fH\xba\x16\x96\xa4\xab\xb6\xeb.\xd8#I\x0b,H\x1a\xec\x9e*\x12\xd3\xba\xe9\xa6t%Hz\xcf\x1a7\x03\xc3\xba6\\#\xae\xdaH\xa6\xad\xdb\xe9c34\xe6\xac\xf1\xb5\xfd\xcd<?\x91<\xed\xc4Q\xb3\xeb\xc8\xd9\xfd\xc6\xadDs\xe2;\xed\x9c.\xfc]\x0f$\xb5F\xc6T\x86\xa1\x84v?JG[$\x19sL\xc6t\x8dH\x8c\xb8\x14~<\x8f\xdaE#\x0f\x80\xd3@~\x88\xa5-\xde0\xa3|JN \xb9\xd5\x95\x01]\xa2\n/\x14\xac\x85o[\x84@\xe3\xc0*\xa7\x0b\xe6D\xc6\xa4\xac\x81\xb9\xfd'9T\xfc^\xc3\xdf\x05=\x12\xb4\xf2\x99L\xc6E\xa9\x8d\x03[e\x19Z{_Iy\x0c\x8b%B\xc9r\x8c[)\xbd\x06\x83R\xb7o\xe8\xbd\x90\xb8\xd0\xfa\x92\x99<\xb8\xf0:8\xadA\x06$\xb8\xbf\x17J\xd8%\xfdD\x08\xe7\xa8\xafs\xads\x89s\x1d\x0f\xf6\xfc\x9d\x99\xd5nS\xef6\x10\x0d\x9c\x013\xcc\x15l\x05b\xfb\x87\x7f\xbbw\x9b\x95d\xf5nCSD\x84hG\xda\x98\xaat{\xe1\xdf3!\x91\x1f\xc3\x99\x0f%#\xf3\xde\x8a\xd3_\x9d\xf6\x1f\x0f+\xd4\x03\x93\x82\x8fX\xe9\xb2%\x0b>\"\x02\x19Aa\x9b\x15b\x19\xa6\xaaP\xf9\x99\xf6=\x91\xbcG,\x81\x13\x06\xf7\xcd\x9c\x92\xcc\x86\xc8\xe7\xcd\x14\xf7C\xa1\xfb{\xa1\xb9\x11_s\xf0\xb6\x8e\x85|\xa1\x87]\x0d>?\xb1\xdd\x93\xbf\xbb\xedO\xc5\xd1\x08\xd9L\xcf\xc2\xcf\xd7\x8f\xc2\n\xad\x9a\xdf\x8f0r\xe1!`D\xaa\xac\xbbb.\xf3oa2e\xd6\xae\xb5\xe1\x16<\x0cE\xc0\x9b?\xac.\xb8[T\x02VZUd\xd3{s\xe9Z\x03r\xe1,\x18\xcc\xb4\xe1\xcd\x04)\xb5Pn\xa1\x9b6\xf0\xbf\x12\x1e\xf15\xdf`\xddo\x15\xbd(\x81d\x9d}\x17\xf5w\x8b\xc5e$\xfd\xbf\xd2\xf4\xbb6\x14F\x80\x15\x8f\x1c\x150\xb9\xdb(\xb7\xdb@\xcdJ\xb7\xdbD\xaaAf\xe3{9\x8b\x12\xa1\\\x18\xcc\xdc\xb0\x8e\x83vF\xfa\xcb\xa7\xb0\xe3u\x87\x7f\xc9\x8d)j\xfc\xde\x1b\xb4\xe1*g$\x12^\xca\xf0\x1c\x8c\xf0\x81\x95\x92\x8a\xc5\xa0\xafB\x0f\xef~\xd8R\xd4Zu\x16W\x99\x10\xf59\x1a\x01\xa7\xdb\x9f\x94-SI\x0c\x8f\xd8,\x08\xf4|1\x13\xd3\xe7'!A\xd6g8\xfdR\n\xfa}\xbe\xd4\xb9P@8 \x19\"y\xa9\xd7\xd7\xda\x89,t1I\xd1R\xdd\x15\"\x048\x8fR@\xdd\xb2*\xee\x14\x13\x92\xe6\xd2bO?>\xa6~q\xba\xf4\xb6\xed\xef\x96\x1a\xd0\xd5%\xf1k\xf6\xb8\xdb\xd4M\x1fT\xaa\x99\x11M\x8d\xde\xea\n\x96\xec\x01\x81)\xe8\xaca\xe85K\xd6\xbe0\xbb\x94|\x88\xc0A*\xaa\xd2\x8f*?\x08\x02#h\xe0G\x14\xf9\x08\xc0\xd4\xe8\xdc\xa0\x0d7\x1a\x11\xe4\xed\x11\"\xf0\x9f\n\xabx\x85$\x85\xe5/\x02\xb8\xd9\xdf\xbe\xdf\xfb\xd6\xff\xf6\xd7\x00PK\x07\x08\x0e\xdf\xee}\xa7\x05\x00\x00N\x0d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc{\xcdr$\xb7\x91\xf0\x99\xf3\x14\x19\xfd\x85\xbe\xd9u\xb48c\xcb\x87\x8d98\x82\x1c\x923\xb4H\x0e\xc5\xa64\xf6:\xec\x88\xec\xaa\xecn\xb8Q@	@\xb1\xd9r\xf8\xbc/\xb1\xb1s\xd4\xd5\xbc\xf8\xa4\x1b9\xef\xb5\x91	\xa0\n\xc5\xe6\xc8?\xab\xddSW%\x80\xcc, \x91\xff\xfd\xa7g{\x93\x85u\x8d\x9f\xbc\x82?=\xdb\xdb\x9b\x9c\x1c|5y\x05\xbf{\xb6\xb7\xb7795<\x84AY\x03-\x1a\xd2\x10\xe86L\xa62xl\x82S\xe4aa\x1d\x84\x15\xc1\x1c\x8d!\xf7\xdc\xc3\x89\xa3o;2Ao\xe1\xc0\xaf\xa9\x86\xaf:\xf2\x8c\xc3\x83V>\x00\x9a\x1aT\x81\xb9\xb15\xea\xc9\xb3\xbd\xbd\xdf3\xe2\xc9\xc5\xec\xe4}\xcf\xc1\x85\x0d0\xc3\x05\xc1\x89u\xf0\xde\xbau\"~h\xd1\xd5\x80Z\xdb\x8d\x87\x06\x039\x85z\na\x85\x01\xd0\x11\x18\x1b\xc0\xf3\xba`aNp\xa3hCL\x15\x106\xd6\xad\x81\xcc\x8dr\xd64d\xc2@\x19\xf5\x06\xb7\xfe\xccV\xeb\x9e\xfe[r\xf0\x1d6h\x00?~\xc0\x87\x0f\x0fw5\xc2\x1a\xf5d\xfa\xe3\xc3\x03Nc\xcd\xb6Q\x9ez\x94\x07\xc6\x1a\xd5\xc0\x16\xdb\x01\xc9\x9a\xbc\x02\x9e\xaa\x1aX\xde\xff\xd5\x07r\x05\nW\xad\xd4M\x81 \xbe\x03\xdd\xb6\xcaQ\x0da\xe5\x08k\x9f\xb0}I\xd4>\x1e\x02\xf4\xc0s>\xb7Fo!\xe1\xf3\xa0\x8c\x0f\x845\xd8\x05\xd4\xa4)(\xb3\xe4\x93lvH\x1f9\xdb\xcel\xe7*\xf2=\x17\x0c\xcb\xa8jP\x0d.	|\x9a\x1399b\x9c\x04\x8bN\xeb\xcf\xbd\xfa\x8e`\xa14y\xa6&\xb3=\x087\x9dO\x07\x93Q\xa5\xcf\xd9\x87\xebU\xd7\xcc\x0d*\xed\xe5H\xd7\xd4\x86\xfd\x82\xb5\xaeV\xf6\x1b\xab\xbb\xa6\xd8\x19\x86\xc1M\x04F&\xe2\x0c&*\x0bX\x04\x9a\xce\xabJ\xa4\xf0F\xd5d\xa1\xd5\xb8%\xe7K\xd4\xc1.\xd5\xa2G\xfb\x16\x1d\xad)h\x05oNO`}\xff\xc3\xfd\xf7\xf7?\xac\x1dy\xd5hr*Qzr\x0cVq-P\xf0\xca\x0c$\xe6\xcb\xf3.\x0c\x8c\xf3\x0b\x1cb\xb5^:\xdb\x99\x1a\xbea\xc6\x12^\x19\x8b\x17\xac\x1f\x17\xc6KlqAF\xf7	L\x07u\xa3\xcc\xe7\xbe\xa5J-\x14\xd5\xd0PC\x1eZ\xd5R\x0d>8T\xcbU\x00e\x82\x85\xad\xed\x9e;\x82\xc6\x1a\x15l!\x8as\xbev\xc7,w\xdb\x81\x18\xc3\xa2\xc4m!\xa8~\xe7/\xbafN\x8ew\xbe\xc6\xad\x87\x8d\n+\xdb\x050\xb4\x81\xd6\xfa\xe0aN\x0b\xeb\x08\x10\x04+(\x1f\xa5\x90\xeaG\xf4.\xb08\xe2H\xcd\xe0@\x06\xe3\xf1\xf2\x0e1nA\xb6\x0f\xaf\xd1@eM@e\xa0kY\x0d\xfc\xfc%h\xbb!W\xa1'8\xc3\xa0\x0ch\n\x81\x9c\x07\xeb\xc0\x08\xb7\xbe\x100At\xad\x82~L<\x08\x8c\xe7\xecMd|\x87\xfc\x14|W\xad\xf8\xd6=?0\xaa\xe9\xe0\xff\xc39\x9a\xa5}\xbe\x0f_'^^B\xb5B\x87\x95\xd0\xd7\xd6,G\x94\xebr{\xebm&F\xb7\x01\xe6\xb6\xdef\x82\xbc\x8f\xc3\xb2\n\xdbP\xad\xb0_\xf9:\xbd\xa7\xc3\xf7k\xbek\xce3\xfd\xca6-o5 \xa4U\xa2\xc6+r\xb2a\x01\xfd\x9a\x95\xf5\x9a\xc0\xd1R\xb1l\x88\xa6\xe6;\x13\xaf'T\x8e\x04\xb6C\xfe\x1a\x97\xfe1\x0b\x10\x18\x98>\x02\x97\xc2B\xd4\x15\x91lb\xc1[\xdd1N\xbf\x0f\xef\x0c\xf1\"\x16\n\x87\xa6\xb6\x8d\xdeB\xb5\xb2\x9e\x8c\xac \xacV\xb0$C\x0e\x03\xd5\xf9\x1b\xf6\xe1\x8a\xbe\xed\xa2N\xb4\xfd\xe9c\x00M\xe8\x03|\x01\xb5Z,\xc8\x91	\x8c\xbb<k>\x8bYe\xddp\xd4\xaf\xf3\xe9\x80o\xb1\x01/\x83i'MP\x02\xdc\x90\xdc\x18\xbb`\x13\xa6\x16[\xd6\x9f8\x9c+\xeb\x1a\x943\xda\x87\x83E \x07t[\x11\xd5I\xcd\x82V\x8d\n\xf2\xc4\xe7\x02\x1b\xa55\x18b\xad\xcd\xbb\xa3o\x8a\xd3)Y\xb5\xceQ\x15\xde9E&\xc4#\xe8y\x8eCI\x19\xdbb\x06\xaf\xdc\x9b\\\xd9\x80\x81\xe4\x14\x17Z\xb5\x10\n\x05[U\xd6E\xce\xac\xb0t\xfc\x9b\xd3\x93\x12E\x16\xb9tlB\xa2`\xaa\xf3\xc16\xafg\xb3\x9e\x97D\xf2kO\xee\xf3\x9a\x16\xcaP\x0d\xafg3p\x1d\xdb\x00m\xb1\xa6\x1a\xac\x81`\xdb\x1e5i\xaa\xf88\xc3\x8a\x9a\xa7\x90_\xdb\xe5\xb2\xb8\x8d\xaf\x05\xceX\x13\xb18\xce\xbb\xc9(\xaba\xb8GU\xd3\x02;\x1dJF\x8f\"(\x11M\x88\x98\x01\x16R\xe6\x13\xe6[H\xeb\xa6\xb0Y\x91\x01c\x0d\xb1dz\n<\xc8\xdbUi5r%\xd2\x8234\xcb\x1dJ\x1a\xcd\xb2\xc3e&v\x96^\xa1\xc5j\xfd\x04\xd1\x92{\xcf\xdf\xe4x\xed\x80\xd4w\xb3\x04\x8b\xcc\x17\xb3\xf6\xad[\x82\xd8\"@\x87\x0d\x16\xa8\x94\xc7\xb9\xa6+;\xb7a\xb8\xae\x97\x8en\xf8vT\x0e7\x9aM\xe2t\x04\xc6.X\xf6\xb5j\xd8\xd0\xdc\xab@\xfd\xc4A\xe1ybC\x0ed\x96\x8a7\xc9\xd4t+\xe3\x0bg\x1b\x962\xf2^\xa4l\xa5|R\xd5;L\xb1\xcc\x88\x9a\x1f\x18;\x8a\xec\xf2\xce\x7f\x8el\xc5\x92\xcd\xe8\xb5\xd0\x88Q\x0fh\xb6L\xccv&\x00\xb6\xe8B\xa4\xcf'\xf5\\\xd6?\xef\x87e \xe21\xcb\xc1\x82\x14\xee@m\xcd\xb0\xdf\x87*(\x97\xc8\xad\xb1\xc5\xe2x\xa8V\xe1\xcc\x0e\xe7}E|\xa7\x80\xc1\xb0R|pY\x91\x8b\x8f\x86\xa0\xed\x92\x05\x95n\xc8m\xe3\xb4\x06k\x91;\xdb\x92\x11\xcd\xc1;kY\xbd\xb0\xa6\xb3\xceC\x85\x06\x1c\xb1\xcb\x02+\xbbI\xfa\x056\xe8!l\xdb\xd2t\x8an\x9a\xa3\xd6=?\xff\xef\xdf\xf8\x15\xd0\xf8\xcdp\xb6g\xec\x91\xb3w\x14\xa1\xbd7\x9f&\xaf\xd0\xaf\xd8Z4h>aT_\xf6KY\xaf\xfc\xe2\xe5\xd8\xb2\x05\x1bP\x17GL\x0d*}\xec\\\xcf\xd41\x03\xe0\xd89\xeb\xfc\xf8\x1a\xcbT>\x13\x92\xc1'\x90\x9c\xa3\x1a\xbeN\x10\xa5\xfd\x15t\x11\xc1\x13\xcb.\xd1\x17\"\x8f\xdeo\xac\xabwWB\x9b\x86\x9eBa]\xe8Q\x08e\xf0\xe4n\xc8A\xcb#\xbb\xb8\x8a\xd1'\xd0\xcd\xba\xf9\x93\xd8>\x8d\xc8w\xf3\xda6\xa8L\x89\xcd,qI\x1c\xd2\\Q 32\x0d\xc7\xfd\x18\xd4\x18\x10\\?#\xf9\xea\xec\xa2u&(\x9dn\x16VA\xdd\xa8\xb0\x05\xcf\xfa\xdf\x07UE/<\xb9h\xfb\xf0\x12\xd6D\xad\xe7;\xd5\xc8=_(\xa3\x02\xe9m\xc9\xd1\xed\x8a\x8d\x94\x1a>.\x03xx\x18\xff\xb4\x9e\xa2\xdb\x16M}PH\xf1\xe1\xfd\x0f\xe1\xfe\x07\x03\x83\x8b\xbd$\xa3>~\xd0\x94\xf7\xfd\xb7d\x14,\x95\xab\x95&\x075\xae\xf8\xa3>\xbdJ\xb9\x11\xc7my\xb4G\x0fw\x1f?\xa0{\xb8\x83>\xe4;\xd8\xa2\xd3\x02\xaa\xad\xdf\xe26\x8e\xf5\x08\x16D\xf5\x1c\xab\xb5\x1cc\xcf\xf4I\x82\xc6CL\x98X\xcfA\x9e\x9f\xe4$X\xa8\x95\x97\xeb\xad\x0c\xef\xad\x18\xc8\x18Z\x17D\xf4\xc8\xc9\xb2\x9d	n\x0b\x11\x9a\xce3!a\xed@\x0e\xaar\n\x9b]\x86\x17*na]E5G\xa6\x03\xcb\x02\x02\x86m\x1b\xdb\xe5\xcb\x995\xb1\xb8,\xec\x86\xfb)\x04\xa7\xda\xca\xd6\xc4z\xb7\x8e\x1f\xf2\x14\x91%jMn{n\xebA\x9d\xbe\x890\x10 \xcf\xda\x9b\xbc\xe3\xa8\xd0\xb3r\x13&\xb3\x1f\xc7\x9a@\xbc\x8e\x82\xed\xa5\xb5\xa5C\xf0&\xbe\xa6pL^\x9e\xb6~+U\xd74|\xea\x1b\xf5\x9dV\xaf\xe0e^\xc9\xaf\xbd\x00)\x08\xd4\xa8\xeft\xe1\x90\xacTM\x87\xca\x18\xaa{\x14oUM\x10\x03\xde\x1a.\x99\xef\x84L\x06X\x93\xc6\x8f\x91\x04\xc5\no\x08\xe6Df7\xe0a\xd4\xa3\x04\x88\xacg\x08o\xe8\x0b\xd4\xfaE\x89X\xee\xaagovI5\x9b\xdf>\xef\xc1z\\2\x1d\xbd\xe1\x93\xc5\xd0P@Y5\xa6yEU\xe7\xbc\xba!=D\x1e=Lx\x87\x95b\xff\xf0\xc9\xcfJ\x89\x17\xb6K\x1cW\x06\x0b\xc8\xd3\xebd\xc4\xc0eLz;&+!\xfe \xc9\xf2\xb1\x83W\xfaH\x96\x11~7[\xd9\xcd\xefa\xde\x85`\x0d_\x90Vc\x95\xc2\xaf~\xd1@\xc0:\xf5\x1d\x87\x80\x9a\x0fD\x15\x9e\xd8\xb1a\xe7$\x9f\xf0d\xfa\x14\xb0G\xa3\x86S>\xb3K\x95\x9d\xe9b\x02K\xe5[\xcb\xfa:O\xbc\xffO\xcfJ\xaa\xa6\xed\x9a\xcc\xa0\xa0\xfe\xe5\x8a\x95\xd6\xbf&\x0c'\x9c\xd5\xb8\xffAf\x12,I\xd7\xea\xe1\x03k\xd2'u\xdb\x98\xdc\x95\xb5\xe1\xdd\x0d9\xa7\x8a\xcbt\xca\x8c\x80\xb36\x80\xcdc\x91\xd4\xe9\x82Cz\xd8(\xbf\xe2\xd3YY\x1fr\x12F\xe4\x03\xc1S\x8b\x1cL\x81\xb6\x95\xb8T1\xfcCx}t1\x052\xacEX\x1dq6'\x92\xc0\xbav\xe4=\xac\xc8\xd1>\x9c\x11\x0b55m\xd82\x05\xf6\xc1yz\xf2\x83\xf3\xe4}8\xbeE\x0e=_\xc1\xf3U\x08\xad\x7f\xf5\xe2E\xe4c\xbf\xa1eW![\x82\xe7\x8f\xbeu\x1c\x98\xc5\x8f\xfc{\x822\x16=\xd6\x1ah\xe2\xb7\xfe\x94Q\x98jFv\xe2\xe1/\xf7\xdf\xb3)\xfa\x94\x95\xa8\xd1\x8c\xac\x842Z\x19:Q\x83\xa5y\x93d$K\xe3\xc3_\xf4\x9aS\x81\xa6&>\xc7\xb5zJ(\x80c\xc4h\x8f\x90';\x82\xb9\xddvA\xa3\xa9\x1f\xee\n\x83\xa1\xda]\x97\xe0\xf4\xf2\xc7\xbc\x80\xd3\xcb\xa8\xcd\xb3\x13\xe4\xf3\x8e\xfe\x13n\x80\xfa\xb6\x1e\xfc\x9b\xd3\xaf\x8e\x0e\xd372\xfc\xd3\xa6\xff\x8f(\xc9\xa7A;\xfc:\x03\xa6\xe5k\xef\xc8\x9f\x1e\xf9}\xc8s\xc4O\x96\xfcb\xe44\xb2^\x1c\xa0\x1eEf\xbd\xe7x\xa4\xb4\x82\x9a\x1e>\xa8\x8f\x1f\xd8\xd1\x1f\xe6+\xb3\x1eX9\xbe\x0d\x8e\x0cj\x88\xe0$\x82u=\x05G\x8d\xbd!\xce(\x89CO\xb7\x81\x1cO\xfc\xd5\xaf~\xf5\xe2\x97\xd5\n\xcd\x8b\xcf\xc3\xb6\xe5+.\x99\x88\xaa4g\x0d\xfa\xca\x0e\"q\x1e_GNq\x9c\x92}\x84\xc7K\xe5n\xf4\xeb\xd3J\x01\xe6;\xc9q\xc2*\xa3)\x97\x87\xaeH~\x9c\xcb\xabX^\x8ej\x13\x1eI\xd9\x0f\xf9\n~\xe8\xe3@V\xb3\xcaH*\xbeX\xc96k\xa1\x9c\x0fp\xa3\xbc\x1a\xd1\xbb=\x08\x01\xab\x15{\xab\xc3\xb6\x9e\xe3\xadj\xba\x06\xb0\x18\x9b\x8eFL\x9fT\x94dr2<|\xd8sJ\xcb\xb2\x01\xe28S?\xce\x915x\xfbV\x14DO3n\xcfJ\x80Q\x19<\"\x99\x86\xec\x02\xba6\xe5-\x1e\xfb!\x0d\xde\x9e\xd6\x9a\xaeU\x91\xa8\xcc\x1c\xab:1Q\xe6F\xcf\x95\xe9\x02\xfbK\xc2^\xe6\x9f3\xdc1\xf4\xcb\xc9R\x8ecY\x8e\xfc4gK\xe7\xc4*\xad\xd2\x96\xd3\xe6) W\x15j\xbde\x97\x9c\xad\xbe\xb1\xf13\nao\xf0\xf6]K\xe6I\xfe\xfaP\xf3\x1f\xe0\xef\x1f\xe0\xa64\x02v\x01_\xbc\x84&~\xfb\x98\xbd\x99\xfanG\xc5s\xc9\xe0\xa9\x03\x91\x81\xdd\xe3`\x7f\xe0\xfcp\x84\xf5\xbd\xaa\xc3\xea\x11\xda\x0d\xc3\x9e\xc4\x1bG~\xf4\x9ci\xd9]\x7f\xd3#<\x8f\xaf)\xf7\xc0\xeev\xccUF\xb7,'\xda\xab\x98\xad\x17\xfe\xd8,\xf3<v\xf0K\x1f#\"\x9e\xad\xac\x0bU\x17\xfe7\x08\xd8\xfa\xcc.w\x8d\x00\xfb\xdd)\xb9\xcb\xd9\x88\x1f1\x08)\x07\x91gR\xaa\xfe\xfd\xc3\xc6`\xc8e\x0c\x9f9\x80\xa6c\xc0X\xb9\x0f\xf3\xe4\xc6\x94\x9a}\xca1R\x8av\xa2\xe1\xaa9h5\xcb\x8e]\x9e\x0c\x8f\xc9:\xe5\xa01\xc4\xb5\x8dJ\x8c\xc6p\x0c\xa6\xac4\\<\xaa1\xb0R\x11\xbd\xc4O}\x15\xa3XL\x9b>\x99\x90\xbf\xec\x82\xa7e\xe0c\xbf\x91\x17\xb0r\xca\x93c\xdc\x9aL\xfed\xba\x0b\xdb*\xc0\xfb\xef\x0b\x8a6\xa8\xaa`8\xbe\xc6\x85\"Ke\x99\x1554\xe4=\xdf\xacd=X\x81\x84>\xc6LI\xd8v\x94\xd7e\n\x0bU\x8d\xd3\xcc\xe7\xe81\xba\xad\xd0m9\x12\x96p8\xd1=\xd0\x0fw&<\xdcq}\xf3\xe1N\xd7\x0fw\\455\xc2\x0dm\x11\xfc\xd6T\x1b\x0c\xd5\n\xe6\xf8\xf1\x83\xc6b\xbc\xc05\xf2\x95\x8c\xdd\xf0\xdd*\xfd\xf7\x8f\xff\xa5\x9aZ\xc1\xfd\x7f S\xc3,\xad\x19\xfc}\x02\x03\xc7\xee\xeb\x87;\x8e\xd1w\xaa\xaaV\xd7;\xc7\xf5N\xd7\x9f>\xae\xecR\xbf~\xa2\xce\x91]qH\x83p=\x84\xe2\xe7\xd8\xb6\xac\xb3\xed\xa2\x0f\xd7,Gl\xfe\x91M\xce\xd5\x0f6\x9a|\x12I}P\xbd\x93'\xb5\x1bC\xc5\xf5y\x17_S\xf4\xcc/\xe3k#\xb9T\x88\x8bb\xec)N|L\xc62\x13\x1c\xc7\xa5|\xaa5\xc1Y\xed\xf7\xe1\xbc\xf3\xa1\xcf\xf5\xf5%\x14k\xa8\xb8.\xfdV\xe5m\xb8\xfc\x94\xa8\xb7\x9cG\xbc^9\xf2+\xab\x87x*j\xe4\xb9\xb6\xd5Z:\x03B?\xe3SV?Vpx;\xe7JJ\x88a\xc3q4\xefWK\xae\xa26t\x18\xb3\x96$\x1e+\x9a\xa4\xcdE'\xe0@\xab\xafY\xe7\x84g\x9a\x16\xfb\x05\x1c\xfdQJ\x11lS\xc5\x8b\x8c\x00\x0ft\x8bU`/\xa7Z\x8dL\x18k\x98\xd7)\x19=\x8eW\xf8\x8a\xf7y\xea\xbf+n\xe9\x93\xd1\xd8\xab\x99\x9f2taVO%\xfe8\x96\xe4\xdap\x1a\x02\x94\xec\x05\x9c)\xb3\x06\x19\xf7C\x920M`\x9f\x97jQ\x7f\xd0q|\",\xc8+\x0f\xb1\xfcVZU\xeb}x\xcf\xd5\x92Tj\xa8\xa7`\xf0F-\x91\xfd\x9eTd*1\xa5f\x84\x92Q\xd7\x19zT\x08\xb8dX\xbaGi\x07S\x9bA\xbe[}\x82\x85S!+9\xf8\xed\xa0\xae\xe5\xb8/\xa4\"\xfd\x88\xd0u\xea\xa2\xc8\xbb\x11)\x8d{+\x12\xa9\x04\xfc\x04-\xde\x08\xb1\xc5;d\xb6]\x8f}\xa6\xbb\x00dp>D|G\xd6<\x0f\x80~=0\xe6\xe6\\\xf2\xed\x17]Q\xfd\xe2Pw\x04\xd7C\x17NN\xf4q\xd1\x93E|\xce\xe3\xdc\xa5\x03j\xc1\x1f\xdb``Yg\x87\x12\x9e\xff\xc1=\xe7\xc8\xe4\xf9\x1f\xe6E\x8c\xcd\x9f\xcd9\xb7\x82\x0c\xf7\x840d\x9c\xf2kKI.\xd7s\x85\xb8H~\\e\xc0c-\xe0HcP7c?y\x86\x18^\xfc;6\xbd\x16\x97\xe7\x87;\xf89x\xc4\x00\xf7\x7f5\x15mkN\xc8\xcd\xd5\xae\x1aw\xd4\x12\x96[$\xaf\xbb\x94[\xbd\xbd\x1a\xf9\xfd\xbf{M7\xd8j\xfc=x|\xf8\x00\x01\x1d.B.\x96\xa71\x08\xdd\xc7\x0f\x9d\xc18\x05u\xc0\x1c\x92\x8f\x18\xb0\xee\x89\x8c\xfb\x95\xc0\x7f\xcc\xa3r2\xe3\x9fp\xa18\xfd\xf2\xf5\xd5\xd9\xf0\xd1\x9c\xf1a@.\xf3\xc6\xd7l\xd2E\xcf\xa5\xb6\x88\xbe@\xce\xf7\xc0\xdb&Urs\xd9\xaeu\x96\x9d\xd5\xd8$\xb0\xb1n]\\G\xa9\xdc\x0e4\xe5\xadl\xc6\x92\xf1'\x12\xd8\x0cg\xef\x115l\x94\xa9\xedf@\xe9\xcbpu6\x94D\x8f\xac\xdc\xdey\xd7p\x91:_\xb8\xac5\noE\xbe*\xa7\x9a{-[0\xedQ\x0f\xc21\xa3\x8a\x83S\x81%/	u\x88[\x11\x87r&[\xaeR\xef$\xa6F\x03\xc6\x0c\\\xd4k\x1a2\x1c\x06F[\xd1\x1b\xc7_\xfeXO\x87\xc7\xae\"\x83v`\x86\x01\x17\x98\xbb|\xf2\xf8~e\x9b\xa7\x93\xd6\x9eK\xa7\xd6<\xea\xbe9H\x96>\x8d\xa6>\x9c\x84\x94/\x1ak\xa2zp\xe3%o/\xee\xc1<\x89\xde(\x88d\xdf\x9eS\xc9\xb6+\x82f\xdfZN\x89\x0e\x87\xcf\xfa\x07zhN\x9f\xe2|(\xbe\xff\xecg\xbc;iJ\xb4\xbab\x8fY3\x15\x88\x03.\x16\xe3\x16\x9b\x19\x83\xe0\xbah\xb1\xc9\x1ank;\xc7\xd5\xa8\xc5\"v\xe0d\xf9\x12\xb5\xb4\"\x1c\xddJ\xcf'yN\x01\xb9\xdaU w\xaaM\x12\xdf\xe4\xc1tib\xde\xe6\xf87\xa7'S\xf8\xcd\xf9\xa5H\x80\x0d+r\xfd\xcciL\x89\xbe\xb9\x9cAe\xa5[Bl\x19O\xac\xb0!\xc7ITnC\xcc\xd5\xee>]\xf0\xeb\xcb\xe37S\xb8\xbcx#s\xdf\x1f\x1f^\xc6\xec\xc5\xb0\x11\xbc-#\x1d,;\xfc\x84\x0e\xe6u	q\xb9\x9c\xfb\x15\xfa\xaf\xbc\xa6&\xeb1~d\xef\xd7S\x197D\xfb\x15\x05\xe9\x1co\xfb\x85\xe2n\xa1\xce\xd7\xed\x7f\xd2\xd2\x95P<\xd5\xd35\xa2\xae\x8aPT\x99\xff\x0b\xea#i\xdbm\xe8\x8aqSM\xber\xaaec\x95\x84-\xe9\xd2G\xa5\x93 \x1d'\xb3(\xe6\xfd\x97H\x96?\x0b\x7f\xc6\x9b\xae\x02\xad\xcbJ\x12\xdf\xc6\xc37\xfd\xc2/\xd5\xc7\x0f\xca\x93\x06tk\xb6?\xd9$\xee\xc09D\x92\xbc\xefcTOf\x00\x0f\x126P\xf7\xdf\xabT\x03}$\x12\x12\x93%\x17\xe8\x9d\xb9bs\xd9cy\xcfciO\xa5\xae\xc7u\x9e\xacb\x0eF\xda\x03\xeb\xbeA\x8c\x0d\x08/\xa4zwi\xcf\xf4\x86\xe6\xcd\xdfW9yO\xf3\xf3\xbf]8\xe1Y\xe3\x9a0klQ\xa6\x12]r\xfc\xfcp\xb7\x06\xab\x1b\x8e\x11\xc7I\xf2\xcd\n\xc3\x81)}\x93\xf7=\x84'\xc4\x19\xc83\xf6\x97\x08\xf2\x00\xberD\xc6\xaf\xb8\xee&\xad/\xc5\xc7Y\xb7\x1e\x15;\x1f\xfe\xf2\xf1\x03[\xc2.\xa1\xbb\x1a2\xfa\\`tS.5\xca}\xe5\xbc~:4\xbe\xbd\x0fw\x8b\x87;\xae'\xec\"\x7f\xd4\x13\xf5S\x92\xd8\"w\xef\xf4'\xf3\xdb\xf8\x9a\xf2\x12\xf22r\x1ed\xe1\xb3\xbd?O\x9f\xedMl\xcb\xd6\xb2\xef)\xe7\x86\xa9\xc9+\x98lm\xec\xdf\x9e\xc4]c\xd0\x82[\xe0l\xda\xc68(y8\x1e\xcb\x87\xd8\xd0\x96\xc0s\xa6 \x1d\x98\xd0\xf0\xd6\x05\xde\xdel\x96&\x87\xe2.\xe4\xd3\x9a\x9cq\xcf\x9f\xc8\xdb\x90\xd9\x9c\xe4\x18\xad\x00\x89\xb0\xc7\ny\\x\xc2:6\xbe?\x93\xad\x98\x04\xec\x8b\x94\\\xa8\xa1X-\x98\xccB\xaa\x1b\xc4m>pQ\xedN\xbe|\xb8\xf3\xb8\xb5:\xeef\xc4@M\xab\xd9\\\xe4-\x99\xa3\xb9,\xaf\xeaomW\xd4\x85\xa5\xe0_\xc7\xda\xdc\x8b\xcf\xfc\x0b\xce\\}64\xe7,,7\xbes\x14\xec\x08\xbd5\xaf\xf2\xa9\xb0\x89\xe4D\x98\xf4\x12\x8a\x06%\xbe\xb0\x9fI\x8b\xab2\xf0\x99\xdfOS\x0f\xca\x86?^\x16;N\xa6\xd1\xcc\x9e^\xb2\xf2\xfc,E\xb8i\xc3;\x95\x99\x8f\xff\x11(\xff\x1e X')\xed\xc0c\xd9/a\x8b'J\x13\x1a4\xa9\x05%M\xae9\xf4\x9c\x1c\xd4uz7[\xde\xf9\xebm+\xb2r`\xb6\xd1\xdaq\xb9$\xcdh\xa3Z\x9a\x1c\xb4Y\x01MR\xdb\xb8@\xd3c\x9c\xeb\xbdZ\x1a\xf1&dL^\xa3\xfb\x10'\xccQ\xe4\xef0)Y~7\xe4f-U>\xf1Om\xf0\xa9\xcd\xe9\x17/\x07#>\x95.p\xfe;\xc2\xf1\xe1y\xeaig[\x04M\xca#\xd7\xaa!	\x9b\xc5\xe3\xf9\xe2\xe5\xcb\xdb\x9f\xbf|9\xed\x87yE\x9f\xc3\xe6\xfe\xa9/\x0fe\x97\x0c\x07\xed\x9d\xa9\xf7\x13?9S\x91U:\xef\x14\xf3\xa2\xc6I\x934\x9b\xd5\xf5\xe40mJ\xea\x87ePJH%\xf8\n\xcd\x92\x8a\xb4\x17\xb7\xb9\x9a%\x8d\x93^\x93J\xf3\xeeU\xbc<?\xc6\xe5\x9a\x90\xad\xdc\xe4\xb5<D\x985\x0b\xb5\xec\\\x0c\xd4e0C\x92\xb1\x1cO\x9b\x89\x98\x8d\xe7E\xd1K\x13\xf9\x82\x16\xc8\xe4\xb5\xc4\x94\x1c\xba\xc9Q\xf6\xdd&\xd1\xc3\xe8\x97\x94\xf9\x80rB\xbf\x8fi\x82\xecc9!%c\xf38\xfb\x14i\xb8s9\x03:9\xca\xcf2\x12\xaf\x98\x08\xccqz\x1cu\x01\xf1@\xee\xfdI#]\xb5~\x17e\xf2\xe4\xeb\xd7_\xc2\xbb\x93\x938\xb0\xd4v\x8e\x9a\x17\xbc\x89O\x02]\xa1\xef\xf9~\x8b\xbedze;\xd9\xc7\xb7\xfc;t*LN\x8f\xf2\x1b\xa7\xf2\x83\x08\xc6i~\x8e#Z\xd32\xd2:\x8d\x8f\xa3R\xdf$\x95\xb7x8>	\x94}\xce,M||Z\x15\xed\xaaY\xe2\xfa\x99\xca,w\xee\x12\xee\xdc\x9c\xbfuq\xbe\xf8'.\x8e\xb6K\x8e^^\x01\xb7k\xf0S\xe2\x89\x9fc#\x19\x0fp\x84#\x89\xcf\x9anT\x95B\xd9\x89\xb1I\x89\xd4C:hraw\xfe\x9c\x92g_\x91\xef\xb4\x140y\x96Koip\x16\xb0\x1f*\xba\xe8\xb6\xa9Q\xedqr}rQ\xbe\xcb\x0c\xabkr\x05\x8dw\xfc>&3X\xda\xc9\xbb\xf4\x18\xe1\x1bsa\xfb\xe4\x19[\x06\xa8%\xc1d7\xdc\xc7\xbf-sh\x93\x96p\xfd\x0d\xffEJb\xbc\xc9%\xe1:\xfee*\x15\xdb%g\xc8h.\xfb;\xc1\x10\x9fAyV\xe7\x96\xfd-\xba\xe4\x17\xb9D/\n\xa1e{\xac\xe2\x85\xb9J\x8f)\xb8\xe5->q\xb6a\x9c\xf2[\xc0\xbf\xeaH\xfep2\x99\xa54\xc5@3:]\xd7\x1cWO\xaem\xb9\xe8\xdaZ\x1dT\xcb\x03'J\xc7\xfe\x159<6\xa6\xbe\x9bs\x86w\x9a\xfeN\xe1\x92\x8d\xe2\x02\x10\x90\xe1\xbf\x88\xc8\x9f\x92\xf8\x0e{-\xd9\xe4}\xc8b|E\xcbN#'c[n\x80\xe1\xedO\xa2\xe7)\x1c\xb2\xe5\x8e\xdb8\xa3\x90:\xf7\xfa\x8f\x0cg\xf1j\xe4\xe1tS\xc4\x97,\xd4\x8a_!\xe7I^A~*\xa0\x87\x122Mf\xf2\x02\xf3\xd4\x8f\xd4{C\xbch\xc6\x89\xa7\xect\xcf\x93MH\xe1H\xafMr@R\x1c\x8e\xcf\x02;\xeb\xa55\x0d\xc4\xddb\xdc_Z\xd3%\xe0\xd6TI\x8f\x1b\xaa\x98y\x91\xf2\xd4)(\xc3\xd2\x99\xc8s\xbe6\xea\xdbN\xda\x04x*\xa5\xf6Rz!\xed\xc1\xecm\x14\xee\x17\xc7\xc2\xbc\xa6Or\xa6\x88Q8\xcb\xd72\xced_\x8fg\xf6\xbe\\H\xce\xc3u\xef3t&\x19\xfa\xaf\xe5A\xd6u\xc2\x0d\x0bj:\xa9\xc4^*\x13N\x9e\xed\xfd\xf9\xd9\x9f\xff{\x00PK\x07\x08\x12T\x02\x91\xbf\x14\x00\x00\x199\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00!\xbcP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/common.jsonUT\x05\x00\x01.\xb4\xd2j\x8cW_o\x1b\xc7\x11\x7f\xa6>\xc5\xe0\x00\xc1	`(\xef~\xd3\x1f:&*K\xacHG\x08\x9a>\xac\xeeV\xe4V\xc7]\xf6\xfe\x98!\x02\x03\xa4\x08\xbb	`D\xadb\xb0@\x02\xc7U_\xdaGF\x7f*F\x12\xa9\xaf0\xfb\x8d\x8a\xd9\xdd\xe3\x1e\xd5\xba\xe8\x8bt\xfb\xdb\x99\xdd\x99\x9d\xf9\xcd\x0c\xbfY\xa9\x04\x87*\xe9\xb0,x\x02\xdf\xacT*\xc1\x01\x93\x92G\xc1\x13\x086\xd6wv\xaa[\xb0\xf1%<ZM\x1f\xc1\xd3\xdd=XM\xcd\xbf\xaf\x82\xd5\xf4\xab xL\xf2\x11\x8fyf\x15\xb6\xaa\xdb\xd5\xa6\xd7\xb0\xfb\xa2\xc3Z|\xcb\x0b\xd5\x9e\xaf\x7f^\x85\x8f\x8b6\xbaJ\xc4<)\x0b7\xea\xbb\xb5\xed\xea\xdeCq\xc9{u\x95fiM6\xdb	gFa5\x02\xc9{\xd0%\x1c\x84\x84\xcc\xec\xac\xd9\xf3\x0d\xba.\xa3\x1a\x99\x94\xeevD\xe6\x0c_\x8d\xac\xc6'\xe9\xa7\xc0d\x04\xab\x11\x18[h\xad\x9c\x98?\xe1#\x8a\xcb\x82y\xd2\xe2\x11\x99G\xe7\xd7w\x1bM\xa8\xbf\xd8\xfb\xdc\xbb\xf0\x1f\xef\x98\xb6Y\xa4z\x1b\xa2x\xfd\xc6\xb3\xf5\xad\xdd}\xd8\xa8\xfd?A\xb0nn\xab\xf0\xa8\xa9Z\xad\xd8z\xd5|\xb6W]\xdf\xa2\x98-\xbdr.}\x88_\xec,\x07\xd9\x9e\xf6R\xf0\x1e\x8f\x1a\xac\xc3k\xf5\xc2\xfc\x06\xec>\x85\xe6\xb3*4\xd6\x9fW\xa1V\x87\xfd\xea^\x15\xbe\xa8U\xf7\xbdu\xc1J\xe5\xd5c\x97P)\xe5\x93Yu\xe3<aqZ\xe4W\xc4\xfa\xc1\x13\xf8\xddJ\xa5R	\xf0\x12\xafp\xa6\xdf\x9ak\xcdr\xa6\xc7\xc1J\xa5\xf2{\x02\x82\xb6\xca\x13/{\x81s\xbc\xc4)\xcep\x12<~\x08M\xbdVG\xc8<\xe3\x0b=\xfd\x1a\xcfq\x8a\xb7K\x9ae\xb0\xa4\xa9d\xd6\xf6\x17\xde\xe9\xb1\x1e\xea\x13\xfd\xc6\x1b\xe8\xa1\x92\x99)\x0f\x95\x8c\x16znI[\xc5^\xea\x85\xfb\x9cy\x9f\xf4@\x8f\xf1\xc6\x89\xea\x01\xce\xf1\xc6y\xe2^\xd2de\xf1r,\xea\x08I\xf1X7\x1f\xa4\x15\xb0\x96\"D\x1f\xe3\x1c\xef\xf4\xc8\x81\xc6\x1a\x02'\x05\xa0\x8c\"\xfe\x19g8\xa7G\xc6;\xbb\x13*\x99%,\xcc,%Hf\xd3!\xe0\xa0\x12\xc97\xfa>',\xa3\x81\xc51\x1c\xf4\xa1V\xb7r\xfc\xeb\xae\xbb\x1c\xff\x86s\xbc\xa6\xa0\xe9\x01\xce\xf4H\x1f\xe3\xb4,\xe3/\xac\x1a\x9d\xa5\xeb\xda\"\xa2\x00\x06x\xa6_\xe3\x1c\xcfq\xe2\xd5\xed\x13\x14\xae\xfe\x81I\x91\xa9\xc4X\xfe\x9c\x8bH98O\xb3\x1d\xd5#T\x7f\x87s\xfc\x95\xfc\xb6[\xb1\n\x8f,?\xdc\x97\x0b}\xc4\x13\xb68\xa9X\xd9\xcd\x82\xd9O \xc0{\x13\xa6{=\xd2\xdfQ\xee\xfasUOrk\xc7\x86bI\x04\xbb\xb4v\xd4\xe6|=\x8e\x8d\xfa\x07\x13\xe3	^[\x97\x00\xcf\xf5\x10\xaf\x8a\n\xa0z\xffM\xc8\xed\xda\xbahN9\xc3{\xe3\xd4-^\xe9\x81\xdd\xce\x0c\xef\x1b\x99\x08\x8f\x88_\x81\xad\x03\x90Z\xc0\x11\xdf\xfb\xbe\xf8^\x10\xbe\x1c\\\n\xf3\"\xa6T\x0d\xaa\x91\xc8\xb6U\x8b4\xe9\x13\xda\"\xcdT\xd2\xb7\x02}\x95\xd3\xc6'x\x8a\xd3O\x8b\x1a\x90\xf6e\xe8\xf2<\xc0S=\xc6\xcbG\xfa\x1d^\x12{\xfd\x9b\xe1\x07=\xc0\xa9\xc5\xf5H\xbf\xc3;\x9c\xeb\xa1\xa3\x1b\xb99\xc5\x99~m^\x9cr\xf6\xba\x90\xf9\xd8\xbe\xcd\x95\xd2\xf9&	\xf5\xf0\x7f\xc8\xad\x18^\x06\x99\xe8\xf0\x82g!\x8b\xb9\x8cJ4\xc53=\xd6\x7f\xa2p\xeb\x13GV\xfcI\x7foHw\xe1\xee\xaa\x04\xf8\x17\x8a\x06^\xe1uY\xeeG<\xd7c}\\\x86\xfe\xae\x078\xc1\xf32\xf4\x0f\xa3\xba\x04\xfd\x84S\xbc/\x03gFf	:5\xd0\x95\x1e\x96\xc11\xbd\x82>~`.N\xf5\xd0\x18|\x8f\x13\xbc\xf4%\xf4\x07=\xd0#W\x87O|\x99\xeaq~\xe4\xfd\x7f\x8f\x97\x85\xfc\x07\x9c\x15\x9f\xa7\xfa\xb8\xf8<siH\xaex\xf4CI\x00\x7f)\xd7\xb5\\\x14\x8f}\xa0\xb2Lu(\x7f\xf0\x9d\x0f\\\xc8d\xc8c\x97\xeb78\xd1C=Z.\x03!\xcbXl3\x12\x7f4d\x9a\xe0-\xce\xf1\xc2m\xc7\x82z\xe1\xe6\xe2\x9cM\x02 S\xe0\x8evR*\xe5\xfb,\x91B\x9a\x93\xbeTy\x02\xaa\xcb\xa5\x19\x07\xa0'\xa8\xbaq0b\x11\xb0<S\x1d\x96\x89\x90\xc5q\x1fX\xb6T\x1a\x89\xf3\xa5\xbax`\xd6V@IJ\xad\x00\xdf\xe0\x1d\xf1V\x0f\xf41\xe8\x91\x1e\xe2\xbd\x1e\xeboq\x86S\xfcu\x0d\xf0\xaf81\x89q\x0d6R\xa69\xcc\xc8{\xc0\xfbEjQ\xe2LpF\xb5\x1d\xff\x85S}\xac\xdfRGr\x13\xce\xa1\x88yS\xa9m\x96\xb4\xcc\x9d\xb4\x86L)\x88\x0d\xe2d\xa4H\xdb\xae\x9c\xfd\x80s\x93\x15\xe78wG\xb4\x94j\xc5\xbc\xa1d\xf1\xb6\xb7\xd4\xa3\x88_t\x17\xe0%\xde\xea\x13\xc0\x0b=\xc2\x0b\xbc\xd5o\xc1\xb8A\x198.\x06\xbe\xaeJ\xb2M\x95$y\xd7L?5\x03\xc0!\x131\x8f\xd6\xe0)\xd9\x14\xba\xed\x92\xc6\x96{''\x9e\xe6a\xc8\xd3\xf40\x8f\xd7\xa0\xd9\xe6\xd0e-n#\"U\x0f\x12\x1e\xab\xc5\\'\xe4K\x16\x8bh\x93u\xb3\xb0\xcd\xcc\x19\x16\x81\xd0A\xe6\x9a#\xce\xbb\xa6m	\xd9z\xaa\x88\xde\xc1o8\xefB\xe408,\n~\xccRc9\xfelBA\x95b\xe1\x1e\xd5P?o\xba2k\xe7/\xa0\xbdr\x97i\xaa\x0d\x9f\xde\xa6\xd6\xe1\xf9#}b\xc28\xc39=\xa6\xf9S\x8c4A\x87Z\xd8\x17\"\x15J\x16\xb3\xa3\xe9j\xf0\xd2`\xaeW\xe5i\xf6\x9cea\xdbu\x14J\x9b9\x85	\xf0\x0e'T\x97\xf4[\xb0\xe9\x85\xe7\x05\xdf}\x03\x95\xbc\xe7\xed\xc7\xf7T-(\x03A\x1f\x9b\x14\xb3L\x0f\xa4*\xd5\xfc\x1d\x05<\x12Y\n	\x0fU\x12-\x86\\%d\xd6T\x9b\x9e\x8auB\x88e\x05\xe6\xa7f\xd7\xdfi\x9c\xa4\xe5gv\xfdY\xb3\xb9m\x85\xfe\x98+7\x8d\xe3)\x11\x1e\xf0\x17=\"JS\x82\x0dp\xae\xdfP\xb6?\xac\xf0	g\xa9\x9dm\xf6\xec\x97C#\x91\xf00\xdb\xe8\xdb~\xb6\xe7\xd6\x0f\xc7\x15/\xe7_\xe4\xa1\xac\x0dmq\xeea\xc2S\xfb\xee?\x93\x114Z\xfa\x97Mx76\xcd\xd76<\xd3\xa4\xa9\xfa\x0f\x97DTb\xe2\xea\xf8~U\x94\x84\xe5\x83\xb2<\x91.\xbct\xc9\x95\x1f\xa2\x88\xed\xce\x9a<\xe6\xe9\"\xb5&n\xa2u\x99\x94r\x96,2d\xae\xbf\xd5#7h\x06)O)\x97\xaa_w\x85\xfb\x95\xb5\xadZB\x82\xc3\x81\xbb\x8d\xc5L\xb2\xa32\x11\xf2\xe2(\xf2\x88\xf2\x16\xefh\xfc0\xccp\xf6\xa4\xf9AGX\xdf\xdeS\x93!\xcf\xf1\xb6\\\xb4\xb3v\xde9\x90L\xc4\xae\xde\x1aN\\\x11'\xbewa\x9d\xe9\x935\xc7\xeaLu\x8d\xcc?q\x82\xf7T\x06\x1d\xdc\xef\x16\xda\x97\xa6w\xdd\xe8\x91\xcd\xf9b\xca)\n\\\xc1 \x9aK,5\xdeQ[\x04\x9c\x99&=\xc1\x1b=\xc6\x99m\xec\x86\x01&\x14C\xd7\xb4\x82\\\xf6\x88d>3^X`)#\xf2.\xd5!*iF\xc2\xac\x80\xaa\xae;\xc3\x00\xf5D\xb5\x12\x9e\xdaX=,\xe1\xee	\xd7\n\xb7\xad\xcaos\x9e\xdb\xe0\xb8/\xb3\xf7\xc0\xa0\xfd\xb29+\x95W+\xaf\xfe=\x00PK\x07\x08\xaav\xed\xa3	\x07\x00\x00\xcf\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce\xbaP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xb5\xb1\xd2j\xbc|\xddn\x1c\xc7\xb5\xee\xb5\xf4\x14\x85>0xN0\xa6\x948\x17\x81.\x02\x88\xfa\xb3\x12QbH\xdaJ\x10$@Mw\xcdL\x85=]\x93\xaen\x92\x93 \x80(F\xb6\x02\x08V\xe0\xf8P\x80\x93X\xf1\xc6\x06\xf6\xbe\x1cQ\xa4DS\x1a\xea\x15\xaa\xdfh\xe3[U\xd5]=\x1c*\x8aw\x92+q\xeawU\xd5\xfa\xfd\xd6j\xfd\xf6\xfc\xb9\xa8\xa7\xf2\xa1\x8e.\xb1\xdf\x9e?w.\xba~\xf9'\xd1%\xf6\xf3\xf3\xe7\xce\x9d\x8b\xcc'fZ\xfd\xde\x9cT\xf7\xcck3\xa9>\xa9\xf6\xcc7fj\x8e\x98yf^\x99\x13s\xcc\xaa\xfb\xe6\xd0\x1cW;\xd5\xfdj7\xea\xd8IO\xcc\xc4\xbc1G\xd5\x0e\x06\x1e\x98W\xd5c\x8c\x9f\x98\xa9\x99\x9a\xc3\xea^\xb5\xcb\xaa\x1d7\xe0\xb8\xdae\xe6?\xcd\x1f\xcd\x97Xi\xc2\xaa\xbd\xb36|mN\xcc\x81\x99\x98W\xd5#4T\x0f\x98\xd9\xaf\xf6\xcc\xb191\xd3\xe8\xfc\xb9s\xbf\xc0\xe6\xd1\xed\xb5\xebwk\xeao\xab\x82\xad\xf1\x9e`\xd7U\xce\xee\xaa|#\xc2\x98s\xd1\x92\xe2y\xc2x\x9a\xaa-\xcd\x86\xbc\x10\xb9\xe4i\x87\x15\x03^0\x9e\x0b\x96\xa9\x82i\xcc+\x14\xeb\n\xb6)\xc5\x96H\x98\xcc\x18g[*\xdf`\"\xdb\x94\xb9\xca\x86\"+\x9a\x9dy\xba\xc5\xc7\xfa\x96\x8a7\x9a\xdb\xc3E\xec\x9b\x17\xe6\x00\x17\xf1\xa6\xbag\x8e\xcc\xfeB\xf5\xd8\xbc\xacv\xcd\xbe\x99T\xf7\xd1~`N\x9890S3q\xe4\x99/\xcd\x89y\x85\xae}sl^\x99\x8990\xc7f\xc2p{fb\x8e1\xcd\xeccB\xe7\x9dVmH\xccT6\x1eJ-\x1a\n\xffh\xa6\xb8\xc0j\xcf\xbc\xae\xf6B\xba<-O\xf1\xcaf\xd2&y\xbf\xda\xa9\xf6\xf0\x02o\xccI\xb5\x03\x16\xa8\xeeU{f\x9fU\x8f\xcd13\x93\xd6\xa2\xfb\x01\x01y<\x90\x9b\xcd\xf6\x97\xedo&\xb6G2\x17	+\x06\xb9\xe0\x89v{\xffX\x88\xd1l\x17\xe3\x9aa\xcc\xfb*K\xc7\xcc\xad\xa7\x99\xcct!x\xc2T\x8f%\"\x15\x85\xcc\xfa\xac\x18\x88\xe1\xa9\xad\xaf\xe6j\xb4\xa6\xca<\x16\xba\xa6\x02m~\xa9\x84\xc9!\xef\x0b\xa6\xdd\x18K\xc9U\xac)X\xafL\xd3\xf7\xb5\xfc\x8d`=\x99\n\x8d\xddh\xb4fDM\xa9\x1d\x97\xf8\xa5\xdcq\x16\xd9\xfa\xa0\x1cv3.SM\xfc\xb5!F\xc5b@Z\x99H\xf5\xb1J\xcbap3hc\x9b\xb6\xd1\x12aG`S\x9a\x00~\x1c\x96Z\xc6\x8cg	\xdb\x94\x89Pl\x94\xf2\xb1\xc8u\xb8t\xa1\xfa\xb2W/\x8b\xf7\xc6\xb3\x98\x13\xf0\x9f\x99V{\x96\x83\x0e\xc1\x97\x9f\xb1\x1b7\xaf\xe3\xb9\xa3Nkp\xf8\xf2s\x06\xd7\x9bu\xfb\xcbe\xd1\x1c\x01?\xd8\x12\x8f7\xfa\xb9*\xb3\x84}\x0c\x12\xdd\xd2\xd4W\x0c\x04\xeb6\xfdt\x84p5;\xc1/w\xc6J\x97\x93\xa1\xcc\xde\xd7#\x11\xcb\x9e\x14	\x1b\x8a\xa1\xd0l$G\"a\xba\xc8\xb9\xec\x0f\n&\xb3B\xb1\xb1*\x17r\xc1\x86*\x93\x85\xca\x83\x8d\xa0\x0d\xae\x81\x03\xc7\xcdfh\xb3\xbc7f\x85\xac\xdf\xe0v9\xec\x8a\x1co\x90\xf0\xb1f[\xb2\x18\xa8\xb2`\x99\xd8b#\xa5\x0b\xcd\xba\xa2\xa7r\xc18\xa3U\x99\xd4\x96\x1fE2\xb3\xdfm\x1e<\xb6\xf9+\x04\x0c/\x02=	e{\xd0\x08`\xd0\x07\xa9\xda7'\xd5\x13\x12\xef\xea\xa196G\x8b\xcc\xfc\xc5\x9c\x98\x17\xe6\x90\x99}\xd2\x88\xd0\x9b\x7f\x08\x95\xcbw/24\x9aW\xe6\xd0L\xabG\x98\x05\xd9}e\xc7\x98i\xb5\x134V{V\x9cg\xe8]\x97E\x1a\x10\x0c\xc5\xf6\x9c\xb4\x14\x18	v\xa0\xa1(\xea\x9c9f\xde\x01p\xac\x89ScN\xdb\xb1\x85\x9aO\x0f\xadY0\x7f!\xa5\xf2\xdcL\x16\x16\x99\xf9+\x0e\xfb\xac\xda\x83-\xa8\x1e\x9aC\xf6\xdd\x8b\x17aR\x8e\xcck,o^\xb5\xb5NW%\xe1\xcb&cG\xe1\xba\xd8.\x18:\xf1\x9e`F<as\xee\x98\x8f\x8ax\xc0\xeb\x99\xe6K\xa2\xf3\xd3FO?5G\xa0\xceJ\xc61=\xdc\x11\xd4\xa1\x13\x98OI%\xda\xa31\x92\x9b\xe7\xd5\x1e\xf5\xdf\xb3\xb6\xb4z\xe2\x8c\x1eT(\x1e\x16c\xa6$\x95\xd5\xfd\xea\x01\xfd<\xa8vO\x91\xb4\xce\xfb\x8d\xf6\xbab\xc9d\x05\x1a\xdd\xc1x_\xc3vY%\xc6z*g\xee0L\xab\xb4,\xa4\xca\xf4\"\xbb\x93	L\x02\x8f\xe6<K\xd40\x1d\xb3x\xa0\xb4\xc8h\x86\xe0\xf1\x80\xf5E&r^\x88\xc4/\xb0\xc8V\xc5\xafK\xab\xac\x15\x8bUVph\xbc\x82\xa5\x82\xeb\x82}\xc0\x12\xd9\xeb\x89\\d\x05\xd6\xd6\x81\x96\x8b\x07<_\x8bU\xdep\xd1\x95\x01\xcfy\\\x88\x9c\xe9\x11\x1f2M\x9dN\xaa\xb3BR\xe3\x96 \x01V=6T\x89\xec\x8d\xa1\xd89\x8b\xeb\x99\xd8\x9c\xdem\x91]\xeea)\xb1\x1d\x0b\x918\xfd\xcfR9\x94\x05\xfdUj\x91\xb3-\x99\xa6,\x130'\nW\xb1	I\xf5'\x0bHUy.\xe2\xe2N.EVp\\WC\xb3\xedrVB\x05#,\xe1\xab\xaa\xe0\x85 \x95\xdcK\xe5\x88\x15\x81\xe6\x8fc\x95[\xca\x14\x91t\xed\xa77\xaf\x87Kx6t\xcfF[\x04D\x95\xbaP\xc3+kk5-n\xcb\x8f\xb4\xc8\xdfODOf\"aW\xd6\xd6X^\xc28\xa5\x8a'\"a*c\x85\x1a\xd5K\x8bT\xc4x\xceb \x86\xf3\x16_W\xfd~ \xe8W\xa8\x1d\xab\xba\xcdl?n\x13K\xc6Mw\xbdT\"z\xbcL\x8b\x90P\xf3\x859\x84\x17i^U\xf7!\xed\xe0\xfaC\xa8$\xb7\xa8\xf9\x0f\xfb\x93U\x7f\x80\xd7\xf2\x92\xbc&X\xa7\xfbfb^T\xbb\xd5\x17\xd5\xfd\xeaQ\xb5\x03?\xf2\x0dF\x1c4\xebU\xbb\x1df\x8ek\x87i\xae\x1c:\xdf	\n\x92\\\x19\xacmN\xa01 t\x9f-\x9e\"\xfe\x16\xcf\xfagS\xef\xcd\xa7\xa7\x1e\xfa\x17\xfa\xd9R\x0f\x15|`N\xcc3H\xb9ya&o\xa7>\xdc[\xe3:s\xdeo\xee\xff\xaa\xd0\xe5\x9aks\xbb=\x85\xe2\xacv\xa1N_\x06\xdb\x90j\xb7\xd7\x13,\xb4\xa8\xf2~\xb0\x83\xd4\xbc\x9b\x8aU\xd5UE\xa3@Vr\xb1	y\x8ds\xbe\x95\xc2{\xe8\xb4\x9ayY(\xf8\xc8	\xdb\x12]-\x0bQ\x0f\xec0]\xc6\x03xdZ\xc0\xe7a\"\xeb\xcbL0\x99%b\x9b\xfa{\xb9\x1a\x82\xef\x85\xd6\xc4\xf7\x03\xa9\xada\x0c\xaf\xdc\x12\x05.&\xab\xdb\x10v\xd5\xf6\xb0Le\xefs\x98ygT\xe3\\\x84\"\xe7\x08\xd5\x8cgcl\xa6\xca\xac`|\xc4\xf3\xc2\xee\x0fQ[\xa0\xf9\x0bu7u\xd8u\xb2>\x99oZ:\xf0\x9c\x12\x955\xcf@\xf6\xee\x98b\x93OI\xe7\x1f\xd5\xaeo\xd4	G\xdc\xf3\xbd\x96\x0b\xea\x06\xf3M3\xa1\xdeB$\xb2\xb8\xa5\x1aF[\x15P\x10\x0c\xcdl \xc1\n\xdeR\x91'\xccY\xaa\xfa\x90:\xb1)\xf2\xb1\x1d6\xe4\x89\x80\xa6W#\x91\x91\x1a\xc4\xa3(\xe8J\xa8m\x95k\x16\xf3\x8c\xe5\x02\x8e!\x1b\xa8-\xa7,\xd9\x16\xd7\xac\x18\x8fB\xb7\x84\x14m\x97\xa7iM\xcf\xff\xf9\x01~\xfa\xb3\xbc\x01\x97\xe3\xafj\xcfQe\xbevA\x1c\xd9\xff\x99Q\xe6\x10g\xb6\xc1\x9f[\xa8z`\x0e\xab\x87$\xa1\x10~35\x07\xa1\xf7\xf2\xda\x1aG\x7f\x83\x08\x8b`\xd7\xe7.\x0c\xcd\xc0\xbew\x11\xdd/!\x93\xf48\xfb\x8cD\xfb9\x82Ds\x12\xb0\x98\x18r\x99^\xcb\xf3\xfad\xd7\xd0\xc0\xae\xe5\xb9\xcau[\xb1\xd1P\xf0\x84\xa0\xce9\x8b,s\xd9\\\x11-\xe4\xae\x83\x96\xb3\x0b\xcc\x99\xb6\xc2u r\\\xeb-\x95'\xa7g\xb2\x91\xeb\x9a\xb7\x84\xca\x8bz	\xda\x99i\x91o\x8a\x9c\x8d\xd0sz\xad\xa0w\xcerkew\xeejg/\xa4\xcbn\xa2\x86\\f\xe1jY\x9f\xf7\x05B\xe1UQ\x88\xace,\xaf\xd5},\xe1\x05gy=\xc2\x85U\xf0\xa1\xcb\xac\x90\xa9\x93l\x1e\x17rS\x16c\xa6a\x11u!c\x1b09\x1fz\x91]d\x1bB\x8c4dzHz\xa6'3Y\x88t\x1cR\xb4=\x80\xd9\x96\xcd\xe1|\xc3;kP\xbfD[}\x8a\xed\x11\xcf\x92\xcb\x81\x84\x98\xbf\x99\x13\xf3\x12^nu\xcfL\xab]\xeb	~\x8e\xe8\x98\x99'm\xedl\xa6\xd5\xe3\xa8\xf3\x96i\x14T\x9fR\xea\x98\xb6\x08\x88db\xbe!P @G\xeeW\xbb\xec\xae\xe8\x0e;l\xe5\xeauV\xed\xb1\xe5\x95\x0f\xe0N\x1f\xc2\xdb\xacw\x80\xacT\x9fy\x1b\xb48\x970\x06\x18\xc6z\xe7Ga\\?\xa9\x1e\x90\xac\x91\xcc\xbe`\xe6Y\xb5\x0b\x8f\x14\x8b\x9d\xde\x03\xa2\xbbS=n=\xc5(\xe4Y\xf3\xff\xcd1!?DU\xd4\x99\xd3\x18\xc6\x9aS3q\xde\xf2\x89\xf9\x06\x91\x85\x85\x17\xaa\xdf\xdb\xbbh\xb6\xe9	\x91 \x90$.n\x1e\xe7\x89\xf3\xa9O\xe0u8\xeb[=\xc4q\xfc\xd6O}\xc3\x99n\x83C\xaf^\xb6\x96zdNp\xb5x\xab}\xa09@\xb1\x02j\xd2\x96{\xae\xca\xac\xc8\xc7\xcc\xb6:\xbe\x97\x9a\x142T\xb1\xc8Y\x1c\x0e\x81\xc3\x86\xf6\xc0\x14\xf5T\x1e\x8b\xe4r\x16H\x16\xa2B\nx,\x1av\xc2B \x07\x0d\xfe\x84\x9fSPt\x04\xbf\xa7\xfa\x02\xe8\xda\xeb\xea\xf1\xdc\xc3\"V\xe9 \xec\x00\x90\xf3\x06#\x10\x84\xba\xe8\x0b\xde\xd9\xa1\xe3?\x7f%\xce\x9c\x81E\x1aR\xfb<ME>^VIc<o\xd86F\x8d\x96\xac;\x80K4\xec\x11\x1d\xd5\xc7\x11\xd0\xbb\xe4\xf5\x06\x87\xef+\x15:\xa4\xe6O\xd5\xaeyn\x0eq\xf0w\xf2\x88\xc0\xd8\xcf\xeb9{\xcd\xc2\x03\x99$\"\xb8\xd1\xaf\xab\x07\x1e\x151'\x97\xd8E\xbf\xfaWd\xf2\x1b\xc3T\xed4\x03\xab\xbd\xe6\x1e\x8eZK\x8b%\x99e\"\xa9\x97\xffP&\x82Y\x18)a+8\xb4[\x9f:`\x1d\xedM\x10\x069\xe0\x9b\x82u\x85\xc8N\x83\x07\x03\x99\x88\x16\xc6I\xf3\xd1\x02\xc6\xb9\xc0\xd3\xf4B\xb80\xa9U\x8dP\xac/\x12xj5\xb4\x89\x10\x8f\xc0\xcc\xdaG\xa2\xc9l(\nN\xb3\xda{\xae\x8a\xb8\xcc\xb5\xdc\x14i\x13J\xd7mD;\x1bH\x047s\x8f\xe5\xb0U\xf8!\xc0h\n\xc58\x86'\xceia\xb9_)\x1d\xb7\xb7%\xe0\xac\x11&\xf3\x94\x1c*w\xff\xb3\x88\xd4[!\xcbc<+\x18\xbb\xdae?_\x1b\xa8\xad_@|'\xde\xeb\xa8\x1e\x85\xd8V@\x84\xca\xe5o\x10\xe5\xa6x4\x19\x86\x05\x7f\xb2BD<7\x85\x9cxh:p\xf6\xcc\x91\x99\x9a\xe7\x01a\xbe\xc9!\xa5\x08\x86>\xf8A<\xe0Y\xb3\xa5l\xb8\xe6\x96\xeaK\x1fY\x06\x03 \"\x1f*\x98\xeay\x86\x88H!\xbb1G\x1e\xdcb\xf3m\x80S\xe8\xf8\xb7\xba?\x13\xb8\xe0\xe2a[\x10\x9a\x1d\x9a\x83\x1a\xa80\xaf\xcdQ\x00\xe4\xb0F=\x06v\x80(^U\xaa\xb8\xb3)\xf2\\\x06\xca\xe1&zX\xaeT\xc1\x94\xef\xc3\x94s\xd1\xcd\x1e\x10;\xb6%\xf5\x00\x0c3P\xdaE\xdd\xda\x85\x15L\x8b\x11\x078\xc1R\x15S@\xc0R\xb9\x81\x88\xfe\xca\xd5\xdb\x1d&2\xe8V8\xff\x80m\xed\x16<Ir\xa15\x1b\x88\\,\xb2[\x02r&\x86\xa3b\x8c\x1d\x10\xd3b\xb8\x0b`\xfd\xe0Evm\x9b\x0fG\xa9\xb8\xc4\x16\x06E1\xd2\x97.\\\xa0\xf3\xe8\xc5\xa1\xe8\x971\x87\x93\xb00s\xd66\xd0a\x0f\xf9. \x07\xa4\x01Z\x90g\xf6\xac\xffLTC\x0e\xdb\xc6\xf8\x13\xf3z\xd6\x14\x07Mo7\xc4\xe6em\x86C\xd3'\xb3Tf\xe2\xba\x0cL>1f\xf5\xd0\x1cU\xf7\xde\xc9\x0b\x9a\xe3\xfe\xc0\xb31/!\xa9\x94\xf8\x818;V\x85\xf0\xdeC\x1f<\xfeC\xf3\x022\xee\x12*m\xe7\xe1\xc4\x1c\x8771:\xed\xab\xde\\y\x9b{zs\x05\xe1eR{\xe7\xda?\xd6\xb7\xf0O\xe5\xaf\x93\xc6\xf1\xbe\xf9\x93\xabK\xefd\xc7\xf0.\x0cS\xdb>\xe9\xaf8\xa1\xd9\x8d\x8a\xfc\x91o\xe8\x84?\xeb\xc0\xf7\xe6U\xbd\xc8\xfc\x18\n\x0e)ua\x0f`O\x14\\T\xda\xc2A\x1c\xce\xe1\xc9}\xd2z\x11\x0b\x8a \xb5\x87(\x99 \xe4\xea\xf7p\x1a\xaa\x9d\x90CR\x99m\x04\xfa\x1cj\x08\x99\xaci\xb5W=\xac\xad\xa992\xaf\xbc\x0e\xf3\xbb}\xe1\xd2\x7fM\x0e\xca\x1c\xb9|\xe0cj\x80\xc6\x02_@9M\xc8\xe4\xd7C\xc1S\xfbv\x0f3\x9d\xbb\x0b\xfb\xe1\x0f\x7fx\xe1\xfb\xd0\xc3\x17\x1aZ\x87\\\xc7\xaaa\xe5e\xfb\xb3\x15.\xda!,\xb1\x0e\xdd\xecT\x92\xfbz\xbe\x9bI\x8d^\xdf \x0c\x1f\xf8e\xc2\xe9E\x19\x00\xa5\xcb\xf4\x93\xbc$\xa4\x1c\xdd:\x19\xb2\xb6\x0d\xb6\x89?j\x84\x06\x16Kf\x94\xdc\x0cf\xc2E\xe8\xc9\\\x17lSj\xd9\xdao\xfbrQ\xf0x\x808\xaey\x9ee\xbe-\x87\xe5\x90\xf1\xa0\xaf\xd3\xea\xc9\xea|\x08e\xc4\x9c\x9d\x07[u\x85\x9b\xe6\xed=\x10\xa0t\x16c\x1f\xf2\xed\x0fI\xf9\xd5{\x9a?\xdb\\du\x1fp\x1d\xa1\x0b\xf5#V\x9f\xb5\x15\x83#\x86\x92\x03\xc7\x0e\xff\xf7Vx\x12N\x9f\xcc\xd7+\x8dw\xdf\x02\x1ciy\n\x83f&U\x8fZWv3I\xc5\xba\x0c\xf28\xfeVd\xe2\x0e\x1a\xa6\x8e\x96eV\x16B{\x04\xc6\xdd\x11R\x81\x16\xbd\xf1\xb9$\xa0X\xc0vt\xc7'\x93\xba\x02&!N\x15\xf2\x8b\x0e\x8e\x931O\xd31\x02b8r\x99\xb2Hw \xbaC\xbe}g$\xb2\xb9\xf4\xd5h\xd1?@\xdf?@MhDU\x8f}p\x91\x0d\xed\xd9\xdb\xe4\xad\xc9\xdf\x88y\xcf\x1e\xea\xf6jw\xde#\xbc\xe5\xd9\xe1|\xcd\x1a\x87\x997$\x94\xf8\xb59$\xa0\xe8\x19\x8c\x188\xa4z\xc0\xfe\xef\xf2\xd2\xffkQxW&\xc5`.\x89\xd6\x9e\xc1\x93;\x9a\xcbZo#q\x12N\xffWp\xa6\xe8\x97\xeb\x1f\xd7d/\xdb\x9f\x0e\xd4E\xd8i\xb3=66\xf0\x99\xd3\xd8\xa6_\x91I'G\x0c\xe3\x10\xa3\x8a Qj\x17^\x1b\xa8\xbc\x88\xcb\xe2_\xb1\x81Jn\xa9\xfei\xdb\x8c\xc81w\x1e\x9e\xea\xbf\xcdN\x0f\xdb#EV\xe4R|\x0b\x1b\xdd\x00\xa8\xcd1\x9b\xa6N\xbb\xa1m\\\x9bq$\xe3\xa1e\xed\xb0.\xcf\\\xd4o\xfd\x89D\x92\xbfW\xc2\xc9\xf5\xed\xdd1$H\xe6l\x98	$\xabc2\xda\x0dmY+u\xfc\x89y\xbd\xd0\x00K\xf6\x97\xf3\xc4\xe7F\xe8\x99\xd8\xaa\xd1G\x7f\xb4\xdbb\xab\xf6l\xdcJ\xed	\xd0\xd9~\xb0\xf9+YS\x08\x1a\xe1'\x80\xb4\x1d\xb7\x7f\xde\x06\xbdkDi.\xfa\x9d\xa9B\xc6\xc1APh\xb2\xef \xe0\xe3\xc6\xcb\xf8\xb2\xc6]\x8e\xcd\xe1Y\xe5A\x87,\x00\x9e\x01.\xbf\xaa%\xc9\xa5\x94\xaa\xc7f\x1fPN\x18\xd38#\x81\xda\x95\x07p\\\xb0\xf3	\xe1\x1fS\xa0MmR{2ng\x02\xcd\xd33v\xa4uI\x07=3'\x00\x0f\xa0\x92\x80c\xed\x99W\xfeT_\x01h\x81V\x08\xdd\x94\xb3\x8eP\xa7\xb7\xaaO\xc8\xd9\xaaQ\nP?\xa9\x1e\xd6/\xcdH\xa1Qb\x0bi}$\x90\x11\x9a\xeeA\x8d\xe0\xae<\x06\xf6)\xd4%\xf9\xc7\x8fB\xbeR[P\x0f\xad\x18\xf7\x89\x99\x90\xea|i/\xd8\x15\x02\x05\x97\xb8\xc4\xb3\xac\x86\x8c[\xc3is\xc4\x923q\xe4^\xb5\x13^\xd3\x05~\xc1\x1cT{p\xd9-\xf1\"\x11Y\x07H\xd5\xb4z8\xb7 \x0c\x8f\xba\xeb\x0b\xca\xf0x\x04\x9a\xd6\xef\xd8\x1cH\xa5\xc9)V\xbf\x936N\xbc\xa3:\x98\xe0\"\xd0+s\xd2\xec>re>\xdf\xbe\xde\xe0y\xcb|4\x82\x89V\xbd\x1apQ\xc0\\\xf4\x8c\x9b\xe7\x93\xef\xf0\xc3`$\x9d\xee\x15\xc9\xa9\xa4\x98\xda\xcaD\xa0{\xee\xd8\x9f\x0e<\xc3\x8f\xb6\xce\xa1\xc4\x19\xb3\x93,zD1\xaf\xcd\xbc\x81\x08 1.y\xa6\xb2\"W\xa9^d\xcb\xa5.Ng\xf0U&\x02\x9e\xa8\xaf\xca_\xc3\xcaYw7\x1ap=X\x1f\xe4B\x0fT\xda \x18\xd6\xdb\xed\xa6*\xdeH\xa5.\xa8$\xca\x8e8\xcb\x91\xb4\x05\x04\xb8\xce\xae\xa4\x82\x9ab\x0bH\x18\xeek$\xf2X\x8c\x8a\x92\xa7\x0c\xbb\xa1\xee\xaa\x87P\xb9\x1c!\xd9M\n\x957{\xd5\xb5\\\xde'q\xc3lQ_.~E\x99p\xb8P\x14\x02\xd9\x06\xcd\xc46\x8f\x0b8\xce\xf1\xa0\xe5\xb1@=_q\x99\xc7vx\x0f\xf5X'%\xdf)\xcc\xaf3\x8f\xbc.\x1d\xfagF\xfa \xf5&\x85\xe2\xd7(e\xd1\xbc\x065\x12\xfe\xc8n\xc9l\x83Q\xbfn22n\x00\xa24\x91\x90Ebe\x968\x1c\x85~\xa2\x0b\xfc\x1b\xa72\xdeXdw\x07@)m\xa26\xe9\xb0\x8co\xca>\x87\x9b\xebj\x1c\xc2\x95\\\x91^Hh^fb&\xeb\xbb\x826'G\xed\xf2;/[5D\n0s@\x0f?\x0eJ\xb0\xf0\xdc\xb7\xa9>kf\xa3uW]\xe8o\xc3\xee\xd4\xae9t\x95~\xae\xf1\x8c\xbdp\x11\xe4\xc8\x9c\xdaf\\\xd6\xab\xaf\xa5e\xc1D\x86\x84\xbb\xd7\x8fWU\xb6P0\xae7\x1a\xc2\xf2.\xaa\x90\xeaI\xab\"\xb9\xb0\x94\x96\x82Q\xab\x9b\xe5\xb2\x05\xa8\xb9\x01\x8bw\xd1_\xa0vI\xf6p\xd8!/\xc0\xeb\x88\x1f\xd8\xc2/\xf3\x05\xa6r\xb6\xf0\xcbn\x00I\xe1\xd8\x80\xdc\xebm\xcc\x9f	\xb4;d.\x9d\xdd\xaa\xf8\x9cI\x19\xcc\xd6&9\x1b\xb2S\xddo\xd7v\xe6\xa2/\xe1\xd8\x04gq\x0d\xb3\xaa\"\x17)/\xe4f;vr\xce\xc3\x14\x96\x0c6\x0b\xa4!\xc5A6\xd6\xfa\xeb/\x9a\xcc\xf3;\x0dn\x11\n\x18o\xf1\x12[\x00l\x8b\xcc\x06\x10'`\x06'\xe6u\xb5\xdb\xba\xab\x91\xe0\xe1\x83\xd0\xcf\xd3G\x18\xa5\xe3\xd5V\xe0\xfasG\x94\xb3\xe2\xb8 s\xf4\x0b\xd8b\x98Br\xe3=\xf5\xd6m\xdamR\x7f-pz\xfe:u\x95\x1cV:i\xd1\xab\xf29\xb9\xd7Uj\x7f\x9b\xaf\x9c\xd3\x88o\xe1\x1c\x03J\xfdh\xf5VsG@o\xd1\xe0K\xa0\xecO_sDJ\x98d7(\x1e\x83\x90j5tUN\xbe\x80d\x94+\x84!9\xd9\xac-\x95o\x04\xba\x82\xaa\x9a\xea=)\x13\x80\xab \xa8(\xea\xcci\x0cj)}\x11A\x13h\x05\x19Q\x17\x0f\x86\xd5\xe5\xc4\x14\xbe\xbe\x1c\xfc\xd5\x90\xa1CHg\x0d?\xdc\xc5*RG\xddr\x88\xa2/\xafA\xbc\x1a\x0cJ\xb0\xe8&|\x96\xaf6\x1b\xc1A5O\x1b\xfe3_W{a\xae\xcb\xfd\xac\xa1\x8bg\xe6\xd0\xbc4o\xcca\xf5\xa9\x03-\xda)<\xb0\xbeK\xe2!/\x88D\x1e\xb9\x844\x9c\n1\x91\x18#?\xca\xd7$.2\xf37s\xe8\xaa4\xe0\xd4\x1e\x04\x1e'r/\x90Lf\x9e\xb1\xef\xb7\x8b.C\x8fY\xf32\x16\x19W\xf59\xd6\xd0p\x9b\xfb\xfa\xdfw\xc0:\x99_c1VA\x1d\xb7F-\x91\xcaf\xeau\xcd\x7fA\xfe\x19\xce\x8b\xa2\xca\x1ds\x88dz\xf5$\xea\xb4\xba\xf7qo\xd3\xea\xb1Kq\xc3\xcb\x87\xcf|\x0c\xc7\x96\xe4\xf1\xbeKq#\xa2\x98\x98}\xab\x1f\xacz\xb47\xe6\xc1\x05\x14\xbe>\x87oJ7\x14\xc8\xa3\x1e)\x99\x86\xbe\x1b\x15\xb7\xd1\x07\x12N\x97A#\x10(\xfd\x8an\xbd\xae\x97\xfd<H\xd4\x12c\x07Y\xda:\xa3\xf3\x9d\xef\x04O\x0f\"l\xe1K{\xcd\xb6b\xd6\x05\xef\xf5\xda\xf5\xbbkhb\xb6\xadmd\xc6\xaa\xccQ}\xd1\xeb\xb1\x02\xdd\xb0r\xbe4\x96\x0d\x04O\xc2x]\x17\xb9\x1c-\x8b\x82\xa3\xba#X<\x97#'\xd7C\xdf\xe9T\x83\x18\xaaM[\xf8\xd8a?]^!{\xa6\x8a\x81\xc8\xeb\x91\x1d\x9b\xc4\xb9\xb1\xb2\xc6bE\xf5\x92\xe4N``\xcc\x87\"G\xda\x07\x9fk\xf8\xea2\xeb\xd4\x89\x84\xfdh\xe5\xda\x8d\x0e[\xb9}\x83\xc6\xde\xbd\xb6\xb4b1\xc9\x86\\\xd8\xcc3\xcc`\xfd\x11\xcb|#8\x17!D\x10S'A f\xf3\xd2\xea\xc80\x86\x82\x81\xc2\x95 \xe8u\x85\x8f\xe1\xae\x84\xea\xc0\x1eS-'Q\xf6:D\xd0\xadkb\xf9\x7f\x99o\xd7k\x91'\xcdS\xafx\xfe7\xb5\xebn\x89y\xc5\xeb\xad\xdde\x00\xd1\xc8\xec\xdf\xb1{\x8b\x8b\xeb\n\xf3\xb3*\xd0C\xec\x00Qx]b\xe6~\xbet\xf3!G\xfb\xed\x1a\xf6\xe6\xc0T\xf0\xbaf\xa5zn\"\xda>\xb5\xf7\x8d\xde\x02\x06>%y\x87\n>nL\xff\x9bw\\(D\xf8P\xcb\xbct\xa3!\xe6s\x98;xBt,\x14\x08\x99\xe9i8\xe3mT\xec\xbf\xd3\n\xf5\xa5X\x02\xe6\xa67\x02b\xa8\xaafB	i \xa5\xee\x13\xa6\xc9\xdf_~\x8b\x17\xf1\xc0y\xebw\xb2U\xf8Z\xf56w\xd1\xe7x\x84\x8aePT\xe0\xeb$/\x87p4\x12\xb8\x9e\x99\n\xc5hQ\x91\x9c\x9eZ\x9fjKt\x87\xef\x94V\xc7@\xb7\xe3]\xd1]\x06\xc3\x9cN\x9e\x7f\xf6\x0f%\xcf\x03*\x06\xbc\xb8\x9c\x85\xae\xf1\xdd\xba\xc5\xee\x89\x11\x1c#\x16\xfb\x9c\xd1\x1fL\xc7\xb9\x10\x99\x1e\xa0\xba\x83jq\x83\xf5T\xbe\xd1\xaa\xc71\x7fkCS`\xc9\x17PwQ\xe7T\x91\x05j\xaf\xe7#\xd4\xd5\xde|\xae9\xbd\xf1LI\xf9\xbfk\xfb1G\x19r\xfd\x94?\xb3?\xd1\xe5\x7f\xb4|O\xa2\xfb\xfc\xb9\xdfu\xce\x9f\x8b\xd4\x08\x1es\xfdAdfK\x81#\xf3\x82\x9cD\xd4\x91<w\x15V\x91\xbdx\xea}\x03\x17\xb3\xda3\xcf\xcd\xb4\xaeM\x01\xac\x05\xeb\x0f1\x9e\xba\x0f$\xa3-\x97V8sJ\x93\x1f\xa0\x82%\xa2I\xab\xbc\xc03z\xc7\xa2vy\x90\xc1xm\xde\xf8\xc5}\xf3K\xe6\xd1_\xba\xb1G\xd6\xd9\x9b\xa9\xa5\xad\xf6Z\x93N\xc7zn\xcd/\xad\xd7	g\xc3\x17\xcb\xcc\xac\x84L\xef\x99\x83C+\x19\x9d'\xf6\x88\n^\xd7\xf5D\x14\x93Y%\xec\xbf\x82\x84\xe3\x0b\xb5\xe1]\xdf\xb7{\x8cn\xe7\xffv\xe5\x8e\xc7.\xa0\xf5[\x89\xe1(\x85\x17\xe1\x1f\xb4\xcb\xb3\x95Pu\xfdL\x95A\xcdU\x17\x90fb\x8bL.\xbc\xa7/\x00\x90\x7fO\xd7(RO\xe1\xbbQ\xe0S\xb9\xe0Ze\x97<O\xc1s\xea\xf2\xcc\x824d\x00\x05\xf4\xd3{\x1a\x01\xb9\xcc\xd8{z\xd1\x0d\xbd\x1c~	\x82i\xb6\xf0\xb6c\xbd\xaf\x9b+\xb0}\xef\xe9\xc5\x90%K\xe9\x89\xb7\x9f\xe7F\xf6\xa3YW\xf4\x1494\x90:\xfe\xd8\xf2g}<\x0cG\xf5\xc0\xbc\xb0\x7fW\xf7g?4\x8bx\x02\x98\xa8\xce\xb3\xd7\x80@\xc4\xb3\xf1u\x99\x8a\xf5\xf1\x08*$\xba\x9c\x8d\xc9\xaf\xa2\xf2q7ud\x15\xb4\xb3\x8a\xf8B\xd8\xe6\xe4\xa3\x99\x8f>\xfd\xf7\x9e\xae]k\xd9\xcf\xc8\x15\xa5>\xfai}O;\xa0\xcbI\xb0\x96xV\xff\xceD\xbe6\x121^\x12\xb7(F\x85f\xe5\x08\xea\xfd{\x17\x1b\x0f\xb0\x83\x0f\x19q\xebw\xaf--\xbbo5\xe1p\xb0\xa1K\x80&r(\x08\xf6\"\x04\xf1\x83\x8b\x17\xb7\xbf{\xf1b\xa7\xee\xc6\x0cF\xdfy\xaa\x1e\xd5\xa0\xffx\x89\x9c\xca\x0c\xa0[\x99%\x8b\x8e\x1e\x8f4zC\x18]N\xc8\xd4\xc86\xe8\xe9F\xc3\x86EK\xe3h\xe6\xe32\xff\x01\x97k\x1f\xf0\xac/\x02\xd8\xfaT\x9d\xc5\x1b\x98T\xfa\x1a\xc5\xb1}\x9c\xe2&c,\xe5\xfft\xed\x82\xc3c\x89\xae\xd0\x1f\xee\xcb\xa6\xac'\xfbenA7t\xa2\x92\x94\xf4\xe8\xc3\xba\x16\x94\xb6q\xecQ\xed\xceL\\#V}\xcb\xccj\x87\xdc\x1c\xc2\xe3\xdd\xa6@;\x83\x1d\xbf\xae\xb5\xcc\xd1\xdc\xbd\\4\x11]\xf5\x81Cd\xdd\xd0z\x05\x07\xd2Q$\x1d\x0e\xa8\xdf\xc1\x0d\xa0w\x08\x07\xb8D\x96\xef\x87\xe3\xe9\xba\xcb\xdcgw\xa2\xab\xfeo\xea\xb1\x82L\x0cw\xcd\xfd\xd9\xaa9F\x87ClPs\xe2\x1d\xad\xa8W\xc6\x1bw,g_\xff\xe8\xca\x8f\xd9\x9d\xeb\xd7mG?U]\x8e*\xe5\xe8\x86\xfd\x8bZ\x07\\\xd7\xd4\x7f\xc8uH\xfa@\x95t\xdf\x1f\xe2\xdf\xa6B0\xbay\xd5\xffB\xba\xb4 \xf62_\x11d\xf6\xcc}\x87\xe8\x14\xb0\x1b\x97\xa6\xa2ow\xbei\xfflU\x94D\xae\xf2\x16\xdd\xf6/j\x05\xb2\xee9\x94xI\xc6\x1b\xe0q\xb4\xfbO\xe7\x9a\x912\xeb\x9f\x92O~J\x1a\xff\x9e0~\xf0-\x841U}EIix\xa0G\xe6\x9bF\xff\xd8\x1e[\xb6\x1ft\xba$i\xf3){]\x11}\xcf\x9c\x00\"\x88\\\xee\xcf)\xad\xa4\x81\x8f\xa3\xdb\xea\xd4G\xde~\xf4\xaa\xd0eJ54\x18\x95\xbb_\xaes\xad\xe0uW\xf0\x89\xc3X86\x9cI4F\xb7\xc3\xc4\xa3\xcfi\x89<\xd8\xe3\x0e~\xb7\xb7i\xbc\x97\xc8|e\xdeP\xe2\xef\x89\xeb\xda\xcan\xab\x1ao\xa7\xcbp_\xc6\x01\xe4\xf8\x02\xc1&\xab\xbd\x1c\x80I^4\xdd%\x8f\x04\xdf\xf8\x18\xff\x1bBN\xcb\xaf\x08\xbea\xffw\x04WoF\x99\x07\xea\xa9%\x0b-\xda7\xf9Qe\xde\xafeq\x05?H\x14/\x04L\x0f\xd7\\Z\xb1[u\x7fR\xbb\xf5n\xaf\xe7j\x885\xe9\xdf\xa0\xfd'\xa5\xa0\x8f\xb8\xa35\x87'6{\xda\x89\xeb@\xa4\xa2u\x15NZW*-\xe4\x08\x1d\xd7ej\x8bF\xe9Ia\xf8u\xd9E\x9e\xa8\xe3\xbe\x13\xce\x1d|\x87\x1c<\x13Y\xcc\xdd'\xff\xd0\x04:\xa5\x9c\xd4\"\xf3\x86iU\xf4\xcb\x94#\xa53B\xd5)\x1e\xc5\xdd\xa3\x16\x85M\x9c\xd2\x01\xd7D\x01\xc7\xa1N\xf1\xa1\xfb\x96\x15&\xdf\xedd\x8b\xbc\xfd@9\xe9\x01O\xd4\x16\x06\xb9\xbf\x82\xd6%\x8a\xce\xa35\xfa\xc1\xba\xae\x8e\xb8\xf6#\x1b\xbd\x05\x8d1S\xf1IBp\x08\x98\x1c\xde\xf7$\n\x91\xadZM\xb9\x988TU\xda\xf3\xf7Z\xcd\xdcn\xae\xbdF\xda4\xc4<\"=\xce\xc8r\x91Q\xa0/Z\x80\xd3\xbdt\xffM\x06}XQ\x8f\xa3O)0\xf8\xa3L\xfe\xba\xa4*\xb7\x8crx\xf6\xbb!q\xa1P\x05O\xe1?Qj4\xaaA\x1f\xcc\xa9\x13*\x0e\xc2 2\xbdH\xdb\x916\xe6\x8a\xd6}\xa4\x15\x15\xce\xe7Y\xaf]\x9d2sN\xc9G\xf4\x07\xcd+\x89\x1a\xb0\xb3\x13\x0bG\x9e\xab\xe7\x88\xce\x9f\xfb\xdd\xf9\xdf\xfd\xcf\x00PK\x07\x085lsC%\x19\x00\x00fE\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xb6+rfl\x90\xcdj\xc30\x10\x84\xcf\xd2S\xec\xc1\xe0\x04\x92@\xcf\xa6\x85>I\xd8HcG \xaf\\\xed:\xcd\xe3\x17\xff\xe4P\x88.\xd2J\xfbiv\xe6|\xa6oUT#\x0e\xa1\xccb\x14XhB\xedK\x1di,\x11\x95-\x15!\x0e\xcb\xe6C\x05\x1b\xa8\x9fe\xad\x89W\xf8\x1aX\xae;tx}dx\xda\x89n\x85k\xdc\xcf\x19\x0fd\xd2\x91sNbG_as\x15\xa5GI\x91X\xa9i|D\xc8\\\xe1\xdd2\xc6\xad\x94\xdc\xf9\x1b\x86$\xde)2\x82\x11\x9eIM\xe9\xb0\x97\x1f\xde-\xab\xafe$5\xee{\xd2\xed\xe6\xf7\x8e\n\xd2\xcb\xa6\x9f\x84\x0e-\xe7\xdc\x9e\xdeL\xbc\xf5\x1c7\xce\xb1D\xd2\xcbT4\xad\x06\xbf>\xdf\x11\xab\x93\x7f\xc0\xcb\xf5\xdb\xf6\xfdq\x91Hbe\xc9\xb8\xf3.\xf5$e\x0b\xdc\xee\x10\xef\\\xe5\xa4 <\x03\xa6U\xbc\xe5\x10\xa0J\x11\x92\x10\xdb\xce;H\xa4\xd4w\x1e\x12;\xdf4\x94Y\x86\x99\x07\xd0\x94\xa7A\x7fr\xe7\xff\x06\x00PK\x07\x08A\xbd\xd5h\x02\x01\x00\x00\xd2\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xb6+rfd\xcfMN\xc30\x10\xc5\xf1\xb5\xe7\x14o\x91E\"!T\xb6\x98\x9c\xa5r\xecIb\xc9\x9d1\xfe\xa0=>\x82\x16\xb5\x15\xdb'\xeb\xef\xdf\xf8\xc2\xae1\xb4\xa0pN\xce3\xd6.\xbeE\x15,\xfd\x94\x8fm/\xec\xc2\xa8\x19K\xdc\xa2\xb4\x97\xdb\x1cO\x8cE5a\xc6\xeaR\xe5\x89\n\xb7^\xa4\xe2Kc W1\x0c\x14\xd8'W\x98\x8c\xe8\xf9\xd8%^n\x11\xbc\xcf\xe0K+\xce\xb7\x91\xb3\xfa\x1dk\xd1\x13D\xcf\xe34YZx\x8bB\xa6\xe7\xf0C\xbb\n*\x19S\xb9\xe1:^\xff\x9f\xf1\xd7%c\xce;\x17F\x0c\x98\xa1\xd9\x92\x89\xeb\xe3\x01\xafw\xb5\x93\x80\xac\xb5\x1d\xbdvi\xe3\xe3#\xcd\x13>\xf0v8\x1c\xd0v\x162\xff\x0d\xbf\x88{\xec\x89\xf0hx\xceZ2,\x01q\xb5\xc4\x12,\x0d\x03\x92\x93\xad\xbb\x8d\x91S\xde\xeag\xb2\xf4=\x00PK\x07\x08s\xdcw}\xe0\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xb6+rft\x92\xe1j\xdc0\x10\x84\x7fKO1\x84\x83\xf8\xe0\x08\x84\xfe\x8b9\xfa \xa5\x18YZ\xfb\x94\xca+UZ\xa5=\x9a\xbe{\x91\xed+\xf4J\xfe\x8eggw>\xcbf2B\x88\x19\x99R0\x960U\xb6\xe2#\xc3Q \xa1\xc1/f\xa6\xd2yW0\xfa\xd9\xb3|\xf9z\x82\xb16V\x16\x08\xfd\x94\xa3\xce$5s\xc1[\xf4\x0e\xa6\xe0p\xd0\x8el0\x99\xb4\x1a\xa3\xc9n\xf5\xf5Z\xd9\x0b\xd9o\xe4\x86U,x-\x91G\x9c\xf1\xf8\xeb\xf7c\xafU\x8aE\x06\xef\xf65\xbd\x1ei\xf6\xac\xd5\x143\x19{\xc1\xed\xb3g\x98\x9c\xcd\x15\xed\xa4\x10c\xd2J\x15\nde\xb3\xac\xe1]z\xf2\xee\x08\xcf\x12\xb1\nZ)5\xe5\xb8\xac\x9e\x826\xa4~\\(\x13\x9a\x13\xe7[|\xd3\x95a\x87\xf4T.\xe6\x19\xbe\x80\xa3\x80k\x08\xbdV\xcaO[\xdc\xaa\xd7\x10 \x17\xe26c#\x8b\xe7J\xcdD\xec\xe0\xa7^o\xfe6\xfeo\xf1\xcf[\xc4m4Q\x9eb^`J\xa1,\x835<\xecR\xb7s>mKOx~y)\x8b	\xc1\xb3\x1c\xdb\xa6{\xa2\xe7\xbbMx\x7f\xdf(\x0fc\xf5\xc1\x0dq|%+\xdd\x9e&\xb9\xd2\xf1\xee\xe0\x9a\\{\x10\x8dF\x81\xd9A\x15\x12\xac4\xcek\xe9\x8f\xd8\xb5(\xcf\xad\xc4\x06~\x89n\x08qF'\xd7D\x7f;\xec\xe6\x13\x1e\xc6\xeb\xc3\xb1e\xbd\x99P\xa9\xa0\xfb\xf4\xbfg\x07\xd0\xaelT\xdb\xef\xee5\xb1\xeb\xf5\xe1\x80`x\xaef&\xa4\x90\xe6\xf2=\xf4\xfa\xcf\x00PK\x07\x08\xee%\xd00\x83\x01\x00\x00\xcf\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00f\xb9\xd4X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\x10\xb7tfd\x92Qk\xdc0\x10\x84\x9f\xa5_1\x84\x83\xf8\xe0Rh\x1fc\x8eR(\xf4\xad\x7f\xa0\x14#Kk\x9fR\xdd\xca\x95Vi\x8f\xa6\xff\xbd\xc8\xd6\xa5$y]\x8d\xe7\x9b\x9d\xb5Md\x84\x10\x13\x12-\xc1X\xc2T\xd8\x8a\x8f\x0cG\x81\x84\x86%f\xc9\x9dw\x19\xa3\x9f=\xcb\xb7\xef\x07\x18kca\x81\xd0o\xd9\xebDR\x12g<F\xef`2v;\xed\xc8\x06\x93H\xab1\x9a\xe4V]\xaf\x95=\x91\xfdAnX\x87\x19\x0f9\xf2\x88#n\xff\xfc\xbd\xed\xb5\xaa\xa0\xc1\xbb\x86\xe9\xf5H\xb3g\xad\xa6\x98\xc8\xd8\x13\xae\xcf\x9eaR2\x17\xd4H!\xc6E+uw\x87/$\xab\x04\xab\xb9V*S \xbb\xcd6`\xb7\xbc\xf3n\x0f\xcf\x12\x9fEjJ\xf1\xbcj2\xaa\x91\xfau\xa2D\xa8J\x1c\xaf\xc8:W\x86\x1d8\n|\x1e\xb6b\x9a_\xaf7\xfe\xd7\x88\xb3\x11{\xd2J\xf9i\xf3\x87\xcf\xe0\x12\x02\xe4D\\Mld\xf1\\\xa8\xd7J\x11;\xf8\xe9\xfa\xf5\xa7\x9c)	J\xa6\x04k\xae\xdd\xb7`\x917\xbf\x03\xfc\xb4f0!\x91q\x17\xb4>7d}xY\xf0\xc7V~\xa3/\x94\xa6\x98\xce0+k\xb0\x86\x876\xea\xda=\x0fW\xce\xfb\xfb\xfb|6!x\x96}\x0d\xfb\xfar\xc7W$<=m\xd7\x1c\xc6\xe2\x83\x1b\xe2\xf8@V\xba\xe6&\xa9\xd0\xfe\xed\xce\x9f\xff\xefX7\xe0\x9aj\xbb\xce9\xba!\xc4\x19\x9d\\\x16z\x0e\xd5\xaeq\xc0\xcdx\xb9\xd9\xd7T\x8f&\x14\xca\xe8>\xbc\xd5\xb4\x8d*\xb66]\xff\x93^\x13\xbb^\xefv\x08\x86\xe7bf\xc2\x12\x969\xff\x0c\xbd\xfe7\x00PK\x07\x08\x89\xdd\xb4\"\x9e\x01\x00\x00\x07\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01\xb6+rf\x94S\xc1n\xe36\x10=\x93_\xf1\x10\x18\xb0\x84\xcaF\xdbKQ\x1b)P\xa0E\x11\xf4\x92?\x10hq$\x0fB\x93\\\x92J\xe2\xfd\xfa\x05%JI\xbc9\xec\xea&\xce\xcc\x9b7\xef\xcd\xecv0d\x87t>\xe0\x89\xc8C\x93\xa1\xc4v\x80w1E\xb8\x1e\xe9\xcc\x11\x0f\x8f\xe8]\x80\x1e\x83J\xec,\xd8\"R\xe7\xac\x8e\xb2\x0b\xa4\x12\xc1\x05\x04\xf2Fu\x84~\xb4\xdd\x945\x81Q;A\xb5\xa7k\xcb\xbeb\x8d\x13\x0flS\x03\xd5un\xb4	\x89^S#\xc5Lc\x8d\x06R\xd1\xd9)X\xcb@i\x0c6\xe2\xd9\xb1\x86\x8a\xd8l\xa4\xa6\xce\xa8@R$\x15\x06J\xed\xc9\xa9\xa0\xa7\xf4\xe3\xfa\xc6\x1el)?\xacm\x8f\xf2D\x03[)v;\xfcGi\x1a\x13s\xad\xb2\x1a\x0f\x8fRD2\xd4\xcd\x91\x19\xb5\xf2{\xd6u\x03\xbf\x9f\x00\x93\xc3\xfb\x9e\xcd\xf2\xc7^\n\xd1\x07w)\xda\xe5\xdf\x973\x05\xca\x85\x1a\xf7\x9f\xc8\xb1g}\x94\x13\x97G\x17\x13\x06g'!\x95	\xa4\xf4\x15^\xc5\x84?\xa0\xd55\xc2\x19-\x05\xf7o\xcd\xc0\x11v4\x06\xe9LV\n1kt\x94\x82\xac\x06\xf7\x05\xf7\xef\x18)$\x8c\x91\x02:\xb5X\xb2\xb8k\xe7\xd1\xa5\xf0\x14z\x17.PSz\xdb)\xdb\x96\xa7\xaa\xd8\xb4\x8e9U4\xf8\xedp\x88\x17e\x0c\xdbT\x97^\xff\xcc\xd8\xe9\\\xf0\xa5\xc8;\xc3:oK\xb5\xa8\xbag-\x85\xf8N\xa8wRy\xdc/\xbd&I\x85\x10\xd9\x9a[?\xde\xb2\xca\x0c\xf9\xdb\xed\xf0\xaf\x8dc X\x97V\x1dg\xe1\xf5\x1bV\x0erl\xcb\xfb\xeco-\x85q.7d\x9bE\x98\x9d\xbe8\xdd\x1a7\xa0JWO\x0d\xca\xf0\x13\x17\xd6\x0d\xeeN\xd7\xbb:\xe3>+3RD\xf5\xfb\xadN9\xabHX\x17sr\x9b\"\xd9\xff\x9f\xdc\\\xe2\xc9T\x8e\xa0W\xcf\x81\xe2\xe4{9\x8f\xbf\xf0\xebb\xf8O\xd2l\xa0URM\x01\xfa@\xfa\xcf[\xd2\x9fn\xea:\xc7r\x9cM\xc6\x10\xe5p\xeb\xe3\x0d\xa5\x93\xb2\x11\x15\xfbU\xb4\xde\x85\xbc\xe5kua53.\xa3~\xa0U8e\x88\x1f\xa2\xf7\x81\xd5\xcau\x1b\xcfJ\xbb\x97ma[Y\xf7R\xd5\xf8\x05\x17\xf5D-\xdbD\xe1Y\x99*R\x17q\xb8_\xd4\xa9\xa1\x12\x12_\x08_\xf3Mn\xc7\xd4m\x17\xfb\xf2m\x91\xd5G\xb9\xd9\xc0(;\x8cj x\xe3\x87\xf8\xc5\x1c\xe5\xb7\x01\x00PK\x07\x08\xcc\xaa}Oa\x02\x00\x00Q\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb8\xb8P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xcd\xad\xd2j\\\x92O\xae\x9c0\x0c\x87\xd7\xf1)\xbc\x18	\x90\x98\xd1\xb4\xaa\xaaJh\x0e\xd0M\xaf\x80\x021\xe0\xd7\x8cC\xe3\xd0\xbe\xc5;|\x95\x00\xfd\xf3v3\xc6\xf6\xef\xf3\xa7\\\xaf\xf8U\x94bR\xe4\xa7\x9d	YR@zeM,3\xaeA\x13Zq\x18)mQ\x8e\x9e\x17\x0d\x02c$\x9b\x08\xa7M\xc6\xc4A\x90\xcb\x9a\xbet\xd4y\xaeg\x87\x03\xcf,\xa9\xc5\x14\xbe\x93\xe0\xb8\xd8X\x7f\xf9\xdc\xb4(\xf6I\xf8\xd3\xc6R\xf9x\xbf7-\x18]\x03{\x8a8\x84\xe0\x1b\xd8\x03\xb5d\x0dh\x15/\x17p4z\x1b	L	\xc9\xfb\xcb\xfc\xa7{\xd3\x81q6\xd9\xbd\xbb\x83\x81f\x160\xdb\xea2b\x86Q0F)\xa1.\xf6\x03>pS\xdaI\xfbBV\xff\x0b\x7f+\xa5Ld\xf6\xa0o\x19\xf6\xf1\xdf\x81\xb7|@\xe98\xa9\xdf}?\xca`\xcc\xaf\x85\"!;|\xe0a\x05\x8c\xd9\xaf;\x0d\xeb\xad`\x15\xf5\xe7i\x1d\x18\x9e\xfe\xfcCV\x94\xcd{L\x0bI\x9e\xb7\xac\x84\xf4:\xd2Z\xe4Wy5JH8\x85M\\\xd5\x81!q\xc8S\x07`\x94<\x8d	S\xe8\x8b\x9e\x9a\x1b\xbcb\xb5.V\x97j\x0f\xcd\xee\xc0\x98)\x86\xe7\x1e\xa9\xc8\x7f\xd1o\x87\xb4\x13\xa6\x83\x83\xbf\xcc\xe1\xdb\xdb\xae\xbd\x1f6\xf6\xae\x0f\xc3\x0b\x8d\xa9\x06c*vU{\x1e\x9deU\x87\x95\xaa\xc5\xe3W\xa9f\x97\xd5\xfe&\x9a\x0eH\\\x07\x97\x0bz+\xf3\x96_\xdb\xea\xd7Y\x7f\xf8\x0e~\x0f\x00PK\x07\x08=a\xc5\xaaz\x01\x00\x00\xac\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xb6+rfL\x8e1n\xc30\x0cEg\xf1\x14\x7f\xf0\x90,\x01\xda\xd5\xc8Y\x02Y\xfaQ	\xc8\x92*\xd1h{\xfb\"\xb6\x81\x96\x1b\xf9\x1e\xc0\x17:\xbd\x11\xb5\xa3\xb3e\x1f\x88\xe7V\x82i-\xd0\xf1\x88\xcc4\xc6\x8bF,\x9a\xb4\xd8U:m\xebe`\xa95\xc3\x0fL\x93D\x86\xec;\xc5\x9d\xfa\xcefY\x98\xb4\x88\x1b\xcc\x0c\x06~\xeb\xb0\x81\xcb\xb9\xbe\x89{\xcd\xb3\xd7\x15\xad\x0e{\xac5\xb2\xfb\xfdq[\x0f\xf8\xf5\xc1N\xb4\xf5\xb6\x0b\x1aq\xff\xd7t\xd3xX\xce\x97\xf8\x92\xec\xa7\x11w\xbc_\xc59-Vq\xc6\xcf\xe2\x8e\xe6\xbf\x03K\x9ce\x9a\x90}I\x9bOD\xcb-\x8d\xcf<\xcb\xef\x00PK\x07\x08)\x9e\xae\xd8\xb0\x00\x00\x00\x0f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_board.sqlUT\x05\x00\x01\xb6+rf<\x8eAN\xc50\x0cD\xd7\xf6)f\xd1\xc5\xff\x9b^ \xe2,(m\xfcS\xa3\xe0\x04\xc7\x15\x1c\x1f\xa9E\xec,\xbf\xd1\xcc\xdb]r\x08^\xa7\xed\xa1\xdd0\xfa\x8c\xf7\xadg/\x0f-\xd8\xb4\xaa\xc5\x93]\xe2t\x9b\x08\xf9	\xe4\x89e\xe1\"{\xcb.LW\xf8\"\x897\xa9jLS\x9a\xec\x81Xo\xa6\x16\x1d\xd7\xc9D/\xef\x9f\xd7\xca\xc4`\xa2\x8f\xae\x868\\r\x99\x08t\xc3#V-x\xc3X\xfbx2\xd1\xf7!.\x18\x7f\xcf\x7f\xbdUKb\xba\xc5\xee\xf2\xc4b%\xf1\xb2\xa0e\xabg\xae\x82\xd1F\x9d_-\xf1\xef\x00PK\x07\x08\xad\x9f\xd7\x04\x9f\x00\x00\x00\xe7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00sql/functions/post_count.sqlUT\x05\x00\x01\xb6+rfL\xcd1\xae\xc3 \x0c\xc6\xf1\xd9>\x85\x07\x86\x977\xe4\x02\xa8g\xa9\x88\xe3P$jS0\xea\xf5+5\x19:\xfe\xbf\xe1\xfbq\x97\xe4B\xc7T\xf6bJ\xcd\x86\xdf\xd9\xa6\xfa\x9f5\xdaJ.\xea\x0bv\xf1\xd9u\\MiP\x08\xb8\x0b\xd7\xd4\x05\x81\xaf=\xe2&\xb9(\xc2\x90*\xect\xde\xfc/T\xd4\x8d\x18\x01\x8en\xcf/1\x10\xe0\xfd\x90.g\xad\xd6\xe8\xf6c\xaf\xd6\"\xc2\xa9\x12G\x14\xdd#\x86@5i\x9e)\x0b\xb5\xda\xf2x\xd5\x88\x9f\x01\x00PK\x07\x08\xbaki\xf9\x88\x00\x00\x00\xc0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00sql/functions/post_op.sqlUT\x05\x00\x01\xb6+rf4\xcd1\x8e\x021\x0c\x85\xe1\xda>\x85\x8b\x14\xbb\x0d\x17\x888\x0b\xca$\x9e`)\xd8&\xf1\x88\xeb#\xc1P>=\xe9\xff\xea\xe4\x12L\xfb\xa15\xc4\x94\xdcV\xdc\xcc\xff\xa4\xd1&]4\xfeqr\x1cS\xd7\xb9\xa9,J	\x1b\xd7Q&#\x98\x9fG\xc6\x8d\xbb(\xc2\xe2\xc15\xc8\x9cD\xc3\xc8\x1c\x01\xf6i\x8fO{!\xc0\xeb\xce\x93I\x1a]\x7f\xdcEZF\xf8Bd\x9e\x91\xb5eL\x89F\xd1~\x94\xce\xe4\xc3\xfbz\x8e\x8c\xef\x01\x00PK\x07\x08Q\xa7\x96\xff\x83\x00\x00\x00\xb1\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xb6+rft\x92\xe1j\xdc0\x10\x84\x7fKO\xb1\x84\x83\xf8\xe0\x08\x04\xfa+\xe6\xe8\x83\x94bdi\xedS*\xef\xaa\xdaU\xda\xa3\xe9\xbb\x17\xd9\xbeB\xaf\xe4\xefxfv\xf7\xb3|A\xa7\x08\\\xa0`N\xce#L\x95\xbcF&\x90\xcc1a\x19\xe2\xe2f\x94.\x06\x811\xce\x91\xf4\xcb\xd7\x138\xef\xb9\x92\x82\xe2O=\xda\x82Z\x0b	\xbcq\x0c\xe0\x04\x0e\x07\x1b\xd0'W\xd0\x9a\x91]	\xab\xaf\xb7\xc6_\xd0\x7f\xc30\xac\xa2\xc0\xab0\x8dp\x86\xc7_\xbf\x1f{k2\x8b\x0e1\xeccz;\xe2\x1c\xc9\x9a\x89\x0b:\x7f\x81\xdb\xe7H\xe0JqWh+%\xe6l\x8d\x11L\xe8u\xb3\xac\xe5]~\x8a\xe1\x08\x91\x94a\x15\xac1f*\xbc\xac\x1e\x81\x162?.X\x10\x9a\x13\xce\xb7\xfa\xa6\x1bG\x01\xf2\x93\\\xdc3D\x01b\x05\xaa)\xf5\xd6\x988mu\xab^S\x02\xbd \xb5\x8cg\xd2H\x15\x9b	)@\x9cz\xbb\xf9[\xfc\xdf\xc3?\xefP\xf6h\xc62qY\xc0\x89`\xd1\xc1;\x1av\xa9\xdb9\x9f\xb6\xa1'x~y\x91\xc5\xa5\x14I\x8fm\xd2=\xd1\xf3\xdd$x\x7f\xdf(\x0fc\x8d)\x0c<\xbe\xa2\xd7no\xd3R\xf1x\xb7p\xcd\xa1\xbd\x88FC\xda\xbf\\A	\xea\xed=\xc0y\x8d}\x84\xaf\xb5Ejwl\xec\x17\x0eC\xe2\x19:\xbdf\xfc{\xc6n>\xc1\xc3x}8\xb6\xae7\x97*\nt\x9f\xfe\xf7\xec\x0c\xda\xa2\x0dl\xfb\xe3\xbdE\n\xbd=\x1c 9\x9a\xab\x9b\x11r\xca\xb3|O\xbd\xfd3\x00PK\x07\x08\xfd\x08\xb4\x18\x86\x01\x00\x00\xd3\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xb6+rf\\P\xbdN\xf40\x10\xac\xbdO1E\xa4\xbb\x14\xf7\xe9CB\x08\xc9\xa2\xa3\xe1\x01\xa8O\x8b\xbdI,|\x9b\xe0\x1f\xe0\xf1Q\x9c\x808\x1ak5\xb33\xb3\xe3\xd3	\xcfY2\xea\x02V\x84\x0b\x8f\x02\x8eqv\\\xc2\xac(\xf3\xab(X=\x92\x94\x9a4\xa3L\xb2\xad\x1d2\x9e\x1e\xc9%\xe1\"\x18\xaa\xba&\xa8Y\xce\x8d>7\xe9\xb1\xbdp\x13\xa7\xe3\xfd]\xdf\xd3\xb7MCn\xff\xf7\xe0\x8c\xae#/.r\x122y\xe2\x9b\x1f\xd2\xd2\x8b\x8cA\xc9x\x89\xb2\xa6\xa4\xf9\x82_\xee\x99\x8c\xf9\x98$\xc9\x15\xf8\xafqx\xf8{\xcb\x86\x931\xdb\x0dA\xc7kY\x8b\x0eZf\xac\x93%\x13\x866!dh\x8dq\xad\xde\xe4\x1c\xb2@>\x9d,\xad\xf2!\xe8;\xc7\xe0\xf7\xdfk)\x07KF\xd4#\x0c\x96\xf6\xbc\xddU\xd4[\xea:D\xd6\xb1\xae\xfbK\\\xc6\xfc\x16-}\x0d\x00PK\x07\x08w\x97\xe0l\xe6\x00\x00\x00\x8b\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00sql/triggers/boards.sqlUT\x05\x00\x01\xb6+rf\xac\xcfAj\xc40\x0c\x05\xd0ut\x8a\xbf\x08L\x02\x99\xd2}N\xd0S\x04O\xac\x18\x83+\xbb\xb2\xdc\x92\xdb\x17\xe2\x0b\xb4\x90\xad\x90\xbe\xde\xdf\x95\x9d1\xb2B\xb9$\xb73\x8e&\xbb\xc5,p\x87\xb1n\xaf\xec\xd4\xd7-Je\xb5i&ek*\x15\xa61\x04V\xb8\x8aq\xa4\x17\x87(4\x14\xd6#\xeb'J\xd8$[<\xce\xe9q\xddo\xadxg\xec\x1f\x0b\x84\x7f\xde\xa2\x9fW\xa2\xe1\xf9\xc4\x87DC9\x1b\xbe]jLC\x7f\x83(\x96\xaf\xf1\x14\xfd\x82\xb2\xe7&6\xf7\x9d\x8a\xa9G,x\xbfR:\x08\xd2RZ\x89\xc5\xaf4\x8eHNBs\x81QR	\xf5+\xadD\x7fm\xda\xa9w6\xbd\x9d\xe89\xf1-\xc4\x9c\xfc\xff\x88\xbf\x03\x00PK\x07\x08\xae\x07\x84\xd3\xc0\x00\x00\x00/\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\xb6+rfL\x90\xd1\xca\xeb \x10\x84\xaf\xf5)\xe6\x94@R\x90r\xeeC\x9f%\xd8\xb8\xb1\x82]=\xeb\xe6\x94\xbe\xfd\x8fM\x7f\xe8\xdd\xe82\xdf\xcc\xee*\xe4\x95P\x04B5\xfb\x95\xb0\xed\xbcj*\x0c\xbf)\xc9\xf2(a\xc9%.\x89\x1b\x89Ng+\xa4\xbbp\x83J\x8a\x91\x04\xbea\x18l\xa05{!kJ\xc5-\xc5\xc4:\xdb\x1b\xc5\xc4\xd6\xa4\x0dL\xcfK-M\x97\x14\xf0\xe7\x8a\xbf\xd0;\xb15\xe6\x80\"\xb1\x16\xbc\xe7\x8f\x12H\xfc;~\xfa\x18\x1c\xf4U\xc9\xe1t{\x9d\x1c2q\xd4\xbbC\xf0\xea\xcf\xd6\x18\xf3\xdf\xe7\x9d\x1a\xa6\xaf\x08\x87\xfe8\\]\x1d\xce\xae~\xdd]\xbf	\xb35f\xaf\xa1\x9f\xa0\xc7\xb5Nl\xa4\xf8\xf4\xa0\x80+Tv\xea\xff\xcf;	!\xf5\xaf\xaf\xb0>9N\x928\x1e\x90K\xa9\xc7J\xa5v~%\xd9\x8a<P\xe3\xc2E\xd3\xf6\x9a\xc6\xef])\x8c\xaeC\xd6\xc2\xab\xd7\xe5\xd9\xa6\xd1\x8d\x0e\xa5\x1e5S8\xf7\x96\xc4\x01i\x9b\xed'\x0b\xbc\xe7<[\xe20\xdba@\xf6\x1cw\x1f	5\xd7\xd8\xfe\xe5\xd9\xfe\x0c\x00PK\x07\x08\x99\xbd\x0b\x86\x18\x01\x00\x00\xd8\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00sql/triggers/posts.sqlUT\x05\x00\x01\xb6+rf\x9cSMo\xd40\x10=\xdb\xbfbT\xad\x94\xac\x9a\xaeT\xaeQ\xb9\x94\x0b7\x0e \x8e\x91\x1d\xbfd\xadz\xed`OH\x17\xf5\xc7#;)\xdb\x82\xe8\xa1\xbeX\x9a\xcf\xf7\xde\xcc\xf4\x11\x8aA!R\xc4\xe4T\x0f\x1af\xdf\xb3\x0d\x9e4\x86\x10\xd1M!q\xea\xacO\x88\\\xefe\x04\xcf\xd1'\xe2h\xc7\x11\x91T\xa2\xddN\x1a\xf4NEH\xc1\xa13p`t\xfaL\x8cGn\xa5\xc6h\xbd\x14\x13\xe2\x10\xe2\x89\xf4|\x9a:>F(S{,\x8705\xe4\x03\x93\xc7rHj\xc4\xbe\x95\xe2\xe6\x86\xaeo\x1b\xd2\xe8\xd5\x9c\x90]\x94a\x90M%t\x05\x03Cg\xf0\xa5\xf04v>\xb0\x1d\xceu\xe5\xb1\x14\xdc\x9d\xf5[\xab\xaa\x91B\xac\xed\xe8\xe9\x89\xaa\xa6\xca_\x89\xe9\xc3\xec\xb9^}{\xba\xa6\xdb}+\x0b\x84O\x85H	j\xc8\x0e\xf4\xf9\x0bi\xa7\xfa\x07g\x13\xc3H\x91\xe0\xd03\xe9\x83>\x93\xf5\x1c\xe8%y)\xc4\x10\xc3\x89\xb4\xf2\x89\xb4\x14\xb9\xe0\xbd\xf2\x15Sf\x94kv:\xa8h\xea\xfd\x0b\x9e\xffp\x13\xcb\x11\x11T\"\xe9\x8e\xea\xad%\x1f\x8aE\x8a\xf2J\x9f\x95f\"\xde\x8ck\"\x1fl\xce\xdb\xc8e\x97\xf2\x86\xf4\xc1N\x9b\xd5N\x17#\x9f'\xd0\x1dU\xe9\xa8LX\xaa\x8b\x03\x8f\x93\x8dH\xf4\x91|X\xea=)&\xb6'\xd0\xaf\xe0A\xd5\xb7\xaf\xf7U+\x85\x1d^\xf1\x7f\x9e\x95\x9f\x9d#>\xc2\xaf\x12|\xb7\xce\xd1\xa0\xac\xa3\xc0G\xc4\xc5&\\\xf8?\xe0O\xda\x12-3<eU\x95v\xd8\xe4H`\xea\x83O\x1c\x95\xf5\x9cV\x1dO\xc1 \xaa\xbc\xb3\xdb\xd0M7\xe4R\x06\x03b\x84i\xa5\x10\xab\xac\xeb\x98\xfeJ\xa2z\xcbj(+\xd0\xd0\x95>_\x15\xad~*7#Q\xd9\x0dk\x1a\xfa\xd0\xbc\xa2\x987\xb5\xec\xd4V\x0bYj\x8e3Z)\xe0\x0d\xd9!/\xd2z0y\x04\xad\x847\xad\xdc\xed\xc8)?\xcej\x04Mn\x1a\xd3\x0f\xd7J\xf9\xc6!\xaa\x81\x11\xb7;\x9c'\xa3\x18\xff\xbd\xc3w\xdc\xda3\xbe\xd9\xb9\xb7\x00\xfe\x1e\x00PK\x07\x08\"\x95\xe1\xed\x0c\x02\x00\x00)\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x000\xb4P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00sql/triggers/threads.sqlUT\x05\x00\x01<\xa6\xd2j\xacT\xcbn\xdb0\x10<\x93_\xb1\x05\x0c\xc8\x06\xe4\xa2\xa7\x1eb\xe4\xd0coE\x7f@\xa0\xc5\x95\xc2\x84\"\xd5\xe52m\x80||\xc1\x87l9M\n\xf7q\xb2wV\x1c.g\x86\xec	\x15#x\x02\xc2\xd9\xaa\x1ea\x88\xaeg\xe3\x1d\xa8\x81\x91:\xbe#T:t\xc6\x05$\xde\xee$!Gr\x01\x98\xcc8\"\x81\n\xb0\xd9\xc8#\x8e\xc6I1#\x0d\x9e&8\xc6i\xaeK\xb7\x0e\xbf\xbf7zw\x90R\xec\xf7\xf0\xd9\x19\x86\xaf1\x04\xa3\x1c\x90\x8f\x16\x99Q\x8aB\x0f\xc6\xb1?\xa1\xb05\xba\x85\xd0\xfb\xe8\xb8\x05\xca\xbf;xT6b\x80J\xdb\xc2\xc7\x16>d\xf2e\xefy\xec\x9cg3<m\x9b\xa3W\xa4\xbb\x01Q7-\xdc\x07\xef\xbac4Vw\xfex\x8f=o\xa5\x10\x0d?\xcd\xd8\xb4\xd0\x14!\x9a6a&}^\xf9S\x9di*\x94\xff\x174\x9f\xd1L\xb8t\x96:w\x03\x9b\xfe\xe1\xa9\xb6J\x91q\xeb\xfb\x07\\\xc8J!\xc5\xee\xe6\x86\xf1\x07\xe7c\x14}\xc1Ek\x0f\x12\x9d>\xc8\xcd\x06\xacrcT#\xc2l\xe71|\xb3\x07)\xafv.\xceZ1\xbe\xe9\x9c\xc6\xde*B)\x92L\x1d>\xa2cH\xc3\x1c\x16O\xf7{\xf8B\x057n0\xce0\x02a\x1f)\xa4\x94x\x07I\x83\xc0j\x9a\xa1l\x15\xa40C>_\xa9\xb3(\xf0\xee\x16\xbc\xd5\x17\x10\xdf\xa1\x93\xe2\xf7\xa1\x11\xe84\x98\xa1\xa6\xe7\x13\xf5w\xe6\x115\xd4X\x82\xa24\xcc\xe4\x136\x90\x9f\x12'd\x8fNC\xa8e\x8dr\x1a\x9c\xe7<\xc6	|1\xc3\x1f\x85\xe7\x9c\x1e\x8d\x16kz~\x89\xcf+\xf9\x91be\xb9\xb8\xb4|u`3\\\x06k\xd1\xf0\x0c\xd4\xe9W\xd6\xddB\x93\xdaM\"\xb2\xa12\x94\xfc-\xcbk\xe5)\x0fT2\xb8\xf4j\xf5*o\xb1\xaeY\x8d\x98&\\\xedmB\xd67E\xf7\xa5\xb7\x7f\xa7\xeb\x99\xfbZe+\xbc\x08T??\xd5\xa5\xfd\xc6\xdd|\xe3r\xae\xad:{\xf3\xdf\xafi\x89\xd0\xb5\x0f\xecJ\xcfr\x15\xea\xfa$F\xcaw\xbe\x02\xf0\xfc\x0cM\xdb\xa4\x9f\x84\xe5G\xf8\x1f\x9f\xc9U\xd0K\xce\x0b\xf1\xc53y\xda\x7f\xfd\xb0]+\xd8\xcf\x01\x00PK\x07\x08H;\x1e\xf8(\x02\x00\x00\x91\x06\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x96\x0d\xdbX!/\xf6\xd0\xba\x95\x00\x00\xb3\x95\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00assets/loading.gifUT\x05\x00\x01\x0d\xc4|fPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]sDe\xa42\x05\x00\x00\xfe\x0c\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x03\x96\x00\x00lang/en_GB/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\xb5\x10\xe9\xb1(\x14\x00\x00W9\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x82\x9b\x00\x00lang/en_GB/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]\xa3\x91\xb1\x86h\x05\x00\x00F\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xf7\xaf\x00\x00lang/es_ES/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\xb8\xef>\x02a\x15\x00\x00W;\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xac\xb5\x00\x00lang/es_ES/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]O(N`\x02\x06\x00\x00\xa9\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81Z\xcb\x00\x00lang/fr_FR/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP] \xa7\xd9\xa3\xe6\x14\x00\x00\xbc;\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa9\xd1\x00\x00lang/fr_FR/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]TXO,\xfa\x05\x00\x00\xb5\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xdc\xe6\x00\x00lang/nl_NL/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\x0e\xaa	]\x8e\x16\x00\x000>\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81#\xed\x00\x00lang/nl_NL/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]\xa5\xdc\xef~C\x06\x00\x00\x1e\x0e\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfe\x03\x01\x00lang/pl_PL/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\x8e\xaf\xc1\xeb\xe3\x15\x00\x00c:\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8e\n\x01\x00lang/pl_PL/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]\x0f&\xb0\xf8w\x05\x00\x00T\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xbe \x01\x00lang/pt_BR/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]5\x9b\xa9yg\x15\x00\x00\xb6:\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x82&\x01\x00lang/pt_BR/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]\x1a\x07T\x0fF\x07\x00\x00D\x10\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x816<\x01\x00lang/ru_RU/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\x18k\xc8\xfa\x9d\x19\x00\x00-K\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc9C\x01\x00lang/ru_RU/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]$\x1fI.S\x06\x00\x00\xf6\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xb3]\x01\x00lang/sk_SK/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\x86\x93P3\n\x17\x00\x00|;\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Sd\x01\x00lang/sk_SK/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]\x0e\xdf\xee}\xa7\x05\x00\x00N\x0d\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xaa{\x01\x00lang/tr_TR/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]\x12T\x02\x91\xbf\x14\x00\x00\x199\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9e\x81\x01\x00lang/tr_TR/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00!\xbcP]\xaav\xed\xa3	\x07\x00\x00\xcf\x0f\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xaa\x96\x01\x00lang/uk_UA/common.jsonUT\x05\x00\x01.\xb4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce\xbaP]5lsC%\x19\x00\x00fE\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x9e\x01\x00lang/uk_UA/server.jsonUT\x05\x00\x01\xb5\xb1\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3XA\xbd\xd5h\x02\x01\x00\x00\xd2\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81r\xb7\x01\x00sql/functions/assert_can_perform.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3Xs\xdcw}\xe0\x00\x00\x00\x8b\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xcf\xb8\x01\x00sql/functions/bump_thread.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xee%\xd00\x83\x01\x00\x00\xcf\x02\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x03\xba\x01\x00sql/functions/delete_images.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00f\xb9\xd4X\x89\xdd\xb4\"\x9e\x01\x00\x00\x07\x03\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xdc\xbb\x01\x00sql/functions/delete_posts.sqlUT\x05\x00\x01\x10\xb7tfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xcc\xaa}Oa\x02\x00\x00Q\x05\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xcf\xbd\x01\x00sql/functions/delete_posts_by_ip.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb8\xb8P]=a\xc5\xaaz\x01\x00\x00\xac\x02\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8b\xc0\x01\x00sql/functions/insert_image.sqlUT\x05\x00\x01\xcd\xad\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X)\x9e\xae\xd8\xb0\x00\x00\x00\x0f\x01\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81Z\xc2\x01\x00sql/functions/is_deleted.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xad\x9f\xd7\x04\x9f\x00\x00\x00\xe7\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81]\xc3\x01\x00sql/functions/post_board.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xbaki\xf9\x88\x00\x00\x00\xc0\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81O\xc4\x01\x00sql/functions/post_count.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3XQ\xa7\x96\xff\x83\x00\x00\x00\xb1\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81*\xc5\x01\x00sql/functions/post_op.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xfd\x08\xb4\x18\x86\x01\x00\x00\xd3\x02\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfd\xc5\x01\x00sql/functions/spoiler_images.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3Xw\x97\xe0l\xe6\x00\x00\x00\x8b\x01\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xda\xc7\x01\x00sql/functions/use_image_token.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\xae\x07\x84\xd3\xc0\x00\x00\x00/\x02\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x18\xc9\x01\x00sql/triggers/boards.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\x99\xbd\x0b\x86\x18\x01\x00\x00\xd8\x01\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81&\xca\x01\x00sql/triggers/mod_log.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x83\x06\xd3X\"\x95\xe1\xed\x0c\x02\x00\x00)\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8d\xcb\x01\x00sql/triggers/posts.sqlUT\x05\x00\x01\xb6+rfPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x000\xb4P]H;\x1e\xf8(\x02\x00\x00\x91\x06\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe6\xcd\x01\x00sql/triggers/threads.sqlUT\x05\x00\x01<\xa6\xd2jPK\x05\x06\x00\x00\x00\x00%\x00%\x00\x88\x0b\x00\x00]\xd0\x01\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
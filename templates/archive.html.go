@@ -0,0 +1,158 @@
+// Code generated by qtc from "archive.html". DO NOT EDIT.
+// See https://github.com/valyala/quicktemplate for details.
+
+//line archive.html:1
+package templates
+
+//line archive.html:1
+import "strconv"
+
+//line archive.html:2
+import "time"
+
+//line archive.html:3
+import "github.com/bakape/meguca/config"
+
+//line archive.html:4
+import "github.com/bakape/meguca/common"
+
+//line archive.html:5
+import "github.com/bakape/meguca/lang"
+
+//line archive.html:7
+import (
+	qtio422016 "io"
+
+	qt422016 "github.com/valyala/quicktemplate"
+)
+
+//line archive.html:7
+var (
+	_ = qtio422016.Copy
+	_ = qt422016.AcquireByteBuffer
+)
+
+//line archive.html:7
+func streamrenderArchive(qw422016 *qt422016.Writer, board string, threads []common.Thread) {
+//line archive.html:8
+	ln := lang.Get()
+
+//line archive.html:8
+	qw422016.N().S(`<h1 id="page-title">/`)
+//line archive.html:10
+	qw422016.N().S(board)
+//line archive.html:10
+	qw422016.N().S(`/ -`)
+//line archive.html:10
+	qw422016.N().S(` `)
+//line archive.html:10
+	qw422016.N().S(ln.UI["archive"])
+//line archive.html:10
+	qw422016.N().S(`</h1><span class="aside-container"><aside class="act glass"><a href=".">`)
+//line archive.html:15
+	qw422016.N().S(ln.Common.UI["return"])
+//line archive.html:15
+	qw422016.N().S(`</a></aside><aside class="act glass"><a href="catalog">`)
+//line archive.html:20
+	qw422016.N().S(ln.Common.UI["catalog"])
+//line archive.html:20
+	qw422016.N().S(`</a></aside></span><hr>`)
+//line archive.html:25
+	if len(threads) == 0 {
+//line archive.html:25
+		qw422016.N().S(`<div>`)
+//line archive.html:27
+		qw422016.N().S(ln.UI["noArchivedThreads"])
+//line archive.html:27
+		qw422016.N().S(`</div>`)
+//line archive.html:29
+	} else {
+//line archive.html:30
+		streamtableStyle(qw422016)
+//line archive.html:30
+		qw422016.N().S(`<table id="archive">`)
+//line archive.html:32
+		streamtableHeaders(qw422016, "id", "subject", "replies", "time")
+//line archive.html:33
+		for _, t := range threads {
+//line archive.html:34
+			if t.Post.IsDeleted() {
+//line archive.html:35
+				continue
+//line archive.html:36
+			}
+//line archive.html:37
+			id := strconv.FormatUint(t.ID, 10)
+
+//line archive.html:37
+			qw422016.N().S(`<tr><td><a href="/`)
+//line archive.html:40
+			qw422016.N().S(t.Board)
+//line archive.html:40
+			qw422016.N().S(`/`)
+//line archive.html:40
+			qw422016.N().S(id)
+//line archive.html:40
+			qw422016.N().S(`">/`)
+//line archive.html:41
+			qw422016.N().S(t.Board)
+//line archive.html:41
+			qw422016.N().S(`/`)
+//line archive.html:41
+			qw422016.N().S(id)
+//line archive.html:41
+			qw422016.N().S(`</a></td><td>`)
+//line archive.html:44
+			qw422016.E().S(t.Subject)
+//line archive.html:44
+			qw422016.N().S(`</td><td>`)
+//line archive.html:45
+			qw422016.N().D(int(t.PostCount) - 1)
+//line archive.html:45
+			qw422016.N().S(`</td><td>`)
+//line archive.html:46
+			qw422016.E().S(time.Unix(t.Time, 0).UTC().Format(time.UnixDate))
+//line archive.html:46
+			qw422016.N().S(`</td></tr>`)
+//line archive.html:48
+		}
+//line archive.html:48
+		qw422016.N().S(`</table>`)
+//line archive.html:50
+	}
+//line archive.html:50
+	qw422016.N().S(`<hr><script id="board-configs" type="application/json">`)
+//line archive.html:53
+	qw422016.N().Z(config.GetBoardConfigs(board).JSON)
+//line archive.html:53
+	qw422016.N().S(`</script>`)
+//line archive.html:55
+	streamloadingImage(qw422016, board)
+//line archive.html:56
+}
+
+//line archive.html:56
+func writerenderArchive(qq422016 qtio422016.Writer, board string, threads []common.Thread) {
+//line archive.html:56
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line archive.html:56
+	streamrenderArchive(qw422016, board, threads)
+//line archive.html:56
+	qt422016.ReleaseWriter(qw422016)
+//line archive.html:56
+}
+
+//line archive.html:56
+func renderArchive(board string, threads []common.Thread) string {
+//line archive.html:56
+	qb422016 := qt422016.AcquireByteBuffer()
+//line archive.html:56
+	writerenderArchive(qb422016, board, threads)
+//line archive.html:56
+	qs422016 := string(qb422016.B)
+//line archive.html:56
+	qt422016.ReleaseByteBuffer(qb422016)
+//line archive.html:56
+	return qs422016
+//line archive.html:56
+}
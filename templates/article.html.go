@@ -343,243 +343,304 @@ func streamrenderArticle(qw422016 *qt422016.Writer, p common.Post, c articleCont
 //line article.html:138
 		qw422016.N().S(`">`)
 //line article.html:139
-		switch {
-//line article.html:140
-		case img.ThumbType == common.NoFile:
-//line article.html:141
-			var file string
-
+		streamthumbnail(qw422016, img)
+//line article.html:139
+		qw422016.N().S(`</a></figure>`)
 //line article.html:142
-			switch img.FileType {
+	}
 //line article.html:143
-			case common.MP4, common.MP3, common.OGG, common.FLAC:
-//line article.html:144
-				file = "audio"
-
+	if len(p.Attachments) != 0 {
+//line article.html:143
+		qw422016.N().S(`<div class="post-attachments">`)
 //line article.html:145
-			default:
-//line article.html:146
-				file = "file"
-
+		for _, img := range p.Attachments {
+//line article.html:145
+			qw422016.N().S(`<figure><a target="_blank" href="`)
 //line article.html:147
-			}
+			qw422016.N().S(assets.SourcePath(img.FileType, img.SHA1))
+//line article.html:147
+			qw422016.N().S(`" title="`)
+//line article.html:147
+			qw422016.N().S(imageName(img.FileType, img.Name))
 //line article.html:147
-			qw422016.N().S(`<img src="/assets/`)
+			qw422016.N().S(`">`)
 //line article.html:148
-			qw422016.N().S(file)
+			streamthumbnail(qw422016, img)
 //line article.html:148
-			qw422016.N().S(`.png" width="150" height="150">`)
-//line article.html:149
-		case img.Spoiler:
-//line article.html:152
-			qw422016.N().S(`<img src="/assets/spoil/default.jpg" width="150" height="150">`)
-//line article.html:154
-		default:
-//line article.html:154
-			qw422016.N().S(`<img src="`)
-//line article.html:155
-			qw422016.N().S(assets.ThumbPath(img.ThumbType, img.SHA1))
-//line article.html:155
-			qw422016.N().S(`" width="`)
-//line article.html:155
-			qw422016.N().D(int(img.Dims[2]))
-//line article.html:155
-			qw422016.N().S(`" height="`)
-//line article.html:155
-			qw422016.N().D(int(img.Dims[3]))
-//line article.html:155
-			qw422016.N().S(`">`)
-//line article.html:156
+			qw422016.N().S(`</a></figure>`)
+//line article.html:151
 		}
-//line article.html:156
-		qw422016.N().S(`</a></figure>`)
-//line article.html:159
+//line article.html:151
+		qw422016.N().S(`</div>`)
+//line article.html:153
 	}
-//line article.html:159
+//line article.html:153
 	qw422016.N().S(`<blockquote>`)
-//line article.html:161
+//line article.html:155
 	streambody(qw422016, p, c.op, c.board, c.index, c.rbText, c.pyu)
-//line article.html:161
+//line article.html:155
 	qw422016.N().S(`</blockquote>`)
-//line article.html:163
+//line article.html:157
 	for _, e := range p.Moderation {
-//line article.html:163
+//line article.html:157
 		qw422016.N().S(`<b class="admin post-moderation">`)
-//line article.html:165
+//line article.html:159
 		streampostModeration(qw422016, e)
-//line article.html:165
+//line article.html:159
 		qw422016.N().S(`<br></b>`)
-//line article.html:168
+//line article.html:162
 	}
-//line article.html:168
+//line article.html:162
 	qw422016.N().S(`</div>`)
-//line article.html:170
+//line article.html:164
 	if c.omit != 0 {
-//line article.html:170
+//line article.html:164
 		qw422016.N().S(`<span class="omit spaced" data-omit="`)
-//line article.html:171
+//line article.html:165
 		qw422016.N().D(c.omit)
-//line article.html:171
+//line article.html:165
 		qw422016.N().S(`" data-image-omit="`)
-//line article.html:171
+//line article.html:165
 		qw422016.N().D(c.imageOmit)
-//line article.html:171
+//line article.html:165
 		qw422016.N().S(`">`)
-//line article.html:172
+//line article.html:166
 		if c.imageOmit == 0 {
-//line article.html:173
+//line article.html:167
 			qw422016.N().S(fmt.Sprintf(ln.Common.Format["postsOmitted"], c.omit))
-//line article.html:174
+//line article.html:168
 		} else {
-//line article.html:175
+//line article.html:169
 			qw422016.N().S(fmt.Sprintf(ln.Common.Format["postsAndImagesOmitted"], c.omit, c.imageOmit))
-//line article.html:176
+//line article.html:170
 		}
-//line article.html:176
+//line article.html:170
 		qw422016.N().S(`<span class="act"><a href="`)
-//line article.html:178
+//line article.html:172
 		qw422016.N().S(strconv.FormatUint(c.op, 10))
-//line article.html:178
+//line article.html:172
 		qw422016.N().S(`">`)
-//line article.html:179
+//line article.html:173
 		qw422016.N().S(ln.Common.Posts["seeAll"])
-//line article.html:179
+//line article.html:173
 		qw422016.N().S(`</a></span></span>`)
-//line article.html:183
+//line article.html:177
 	}
-//line article.html:184
+//line article.html:178
 	if bls := c.backlinks[p.ID]; len(bls) != 0 {
-//line article.html:184
+//line article.html:178
 		qw422016.N().S(`<span class="backlinks spaced">`)
-//line article.html:186
+//line article.html:180
 		for _, l := range bls {
-//line article.html:186
+//line article.html:180
 			qw422016.N().S(`<em>`)
-//line article.html:188
+//line article.html:182
 			streampostLink(qw422016, l, c.index || l.OP != c.op, c.index)
-//line article.html:188
+//line article.html:182
 			qw422016.N().S(`</em>`)
-//line article.html:190
+//line article.html:184
 		}
-//line article.html:190
+//line article.html:184
 		qw422016.N().S(`</span>`)
-//line article.html:192
+//line article.html:186
 	}
-//line article.html:192
+//line article.html:186
 	qw422016.N().S(`</article>`)
-//line article.html:194
+//line article.html:188
 }
 
-//line article.html:194
+//line article.html:188
 func writerenderArticle(qq422016 qtio422016.Writer, p common.Post, c articleContext) {
-//line article.html:194
+//line article.html:188
 	qw422016 := qt422016.AcquireWriter(qq422016)
-//line article.html:194
+//line article.html:188
 	streamrenderArticle(qw422016, p, c)
-//line article.html:194
+//line article.html:188
 	qt422016.ReleaseWriter(qw422016)
-//line article.html:194
+//line article.html:188
 }
 
-//line article.html:194
+//line article.html:188
 func renderArticle(p common.Post, c articleContext) string {
-//line article.html:194
+//line article.html:188
 	qb422016 := qt422016.AcquireByteBuffer()
-//line article.html:194
+//line article.html:188
 	writerenderArticle(qb422016, p, c)
-//line article.html:194
+//line article.html:188
 	qs422016 := string(qb422016.B)
-//line article.html:194
+//line article.html:188
 	qt422016.ReleaseByteBuffer(qb422016)
-//line article.html:194
+//line article.html:188
 	return qs422016
-//line article.html:194
+//line article.html:188
 }
 
 // Render image search links according to file type
 
-//line article.html:197
+//line article.html:191
 func streamimageSearch(qw422016 *qt422016.Writer, root string, img common.Image) {
-//line article.html:198
+//line article.html:192
 	if img.ThumbType == common.NoFile || img.FileType == common.PDF {
-//line article.html:199
+//line article.html:193
 		return
-//line article.html:200
+//line article.html:194
 	}
-//line article.html:202
+//line article.html:196
 	url := root + assets.ImageSearchPath(img.ImageCommon)
 
-//line article.html:202
+//line article.html:196
 	qw422016.N().S(`<a class="image-search google" target="_blank" rel="nofollow" href="https://www.google.com/searchbyimage?image_url=`)
-//line article.html:203
+//line article.html:197
 	qw422016.N().S(url)
-//line article.html:203
+//line article.html:197
 	qw422016.N().S(`">G</a><a class="image-search yandex" target="_blank" rel="nofollow" href="https://yandex.com/images/search?source=collections&rpt=imageview&url=`)
-//line article.html:206
+//line article.html:200
 	qw422016.N().S(url)
-//line article.html:206
+//line article.html:200
 	qw422016.N().S(`">Yd</a><a class="image-search iqdb" target="_blank" rel="nofollow" href="http://iqdb.org/?url=`)
-//line article.html:209
+//line article.html:203
 	qw422016.N().S(url)
-//line article.html:209
+//line article.html:203
 	qw422016.N().S(`">Iq</a><a class="image-search saucenao" target="_blank" rel="nofollow" href="http://saucenao.com/search.php?db=999&url=`)
-//line article.html:212
+//line article.html:206
 	qw422016.N().S(url)
-//line article.html:212
+//line article.html:206
 	qw422016.N().S(`">Sn</a><a class="image-search whatAnime" target="_blank" rel="nofollow" href="https://trace.moe/?url=`)
-//line article.html:215
+//line article.html:209
 	qw422016.N().S(url)
-//line article.html:215
+//line article.html:209
 	qw422016.N().S(`">Wa</a>`)
-//line article.html:218
+//line article.html:212
 	switch img.FileType {
-//line article.html:219
+//line article.html:213
 	case common.JPEG, common.PNG, common.GIF, common.WEBM:
-//line article.html:219
+//line article.html:213
 		qw422016.N().S(`<a class="image-search desustorage" target="_blank" rel="nofollow" href="https://desuarchive.org/_/search/image/`)
-//line article.html:220
+//line article.html:214
 		qw422016.N().S(img.MD5)
-//line article.html:220
+//line article.html:214
 		qw422016.N().S(`">Ds</a>`)
-//line article.html:223
+//line article.html:217
 	}
-//line article.html:224
+//line article.html:218
 	switch img.FileType {
-//line article.html:225
+//line article.html:219
 	case common.JPEG, common.PNG:
-//line article.html:225
+//line article.html:219
 		qw422016.N().S(`<a class="image-search exhentai" target="_blank" rel="nofollow" href="http://exhentai.org/?fs_similar=1&fs_exp=1&f_shash=`)
-//line article.html:226
+//line article.html:220
 		qw422016.N().S(img.SHA1)
-//line article.html:226
+//line article.html:220
 		qw422016.N().S(`">Ex</a>`)
-//line article.html:229
+//line article.html:223
 	}
-//line article.html:230
+//line article.html:224
 }
 
-//line article.html:230
+//line article.html:224
 func writeimageSearch(qq422016 qtio422016.Writer, root string, img common.Image) {
-//line article.html:230
+//line article.html:224
 	qw422016 := qt422016.AcquireWriter(qq422016)
-//line article.html:230
+//line article.html:224
 	streamimageSearch(qw422016, root, img)
-//line article.html:230
+//line article.html:224
 	qt422016.ReleaseWriter(qw422016)
-//line article.html:230
+//line article.html:224
 }
 
-//line article.html:230
+//line article.html:224
 func imageSearch(root string, img common.Image) string {
-//line article.html:230
+//line article.html:224
 	qb422016 := qt422016.AcquireByteBuffer()
-//line article.html:230
+//line article.html:224
 	writeimageSearch(qb422016, root, img)
-//line article.html:230
+//line article.html:224
 	qs422016 := string(qb422016.B)
-//line article.html:230
+//line article.html:224
 	qt422016.ReleaseByteBuffer(qb422016)
-//line article.html:230
+//line article.html:224
 	return qs422016
+//line article.html:224
+}
+
+// Render the thumbnail of an attached file
+
+//line article.html:227
+func streamthumbnail(qw422016 *qt422016.Writer, img common.Image) {
+//line article.html:228
+	switch {
+//line article.html:229
+	case img.ThumbType == common.NoFile:
 //line article.html:230
+		var file string
+
+//line article.html:231
+		switch img.FileType {
+//line article.html:232
+		case common.MP4, common.MP3, common.OGG, common.FLAC:
+//line article.html:233
+			file = "audio"
+
+//line article.html:234
+		default:
+//line article.html:235
+			file = "file"
+
+//line article.html:236
+		}
+//line article.html:236
+		qw422016.N().S(`<img src="/assets/`)
+//line article.html:237
+		qw422016.N().S(file)
+//line article.html:237
+		qw422016.N().S(`.png" width="150" height="150">`)
+//line article.html:238
+	case img.Spoiler:
+//line article.html:241
+		qw422016.N().S(`<img src="/assets/spoil/default.jpg" width="150" height="150">`)
+//line article.html:243
+	default:
+//line article.html:243
+		qw422016.N().S(`<img src="`)
+//line article.html:244
+		qw422016.N().S(assets.ThumbPath(img.ThumbType, img.SHA1))
+//line article.html:244
+		qw422016.N().S(`" width="`)
+//line article.html:244
+		qw422016.N().D(int(img.Dims[2]))
+//line article.html:244
+		qw422016.N().S(`" height="`)
+//line article.html:244
+		qw422016.N().D(int(img.Dims[3]))
+//line article.html:244
+		qw422016.N().S(`">`)
+//line article.html:245
+	}
+//line article.html:246
+}
+
+//line article.html:246
+func writethumbnail(qq422016 qtio422016.Writer, img common.Image) {
+//line article.html:246
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line article.html:246
+	streamthumbnail(qw422016, img)
+//line article.html:246
+	qt422016.ReleaseWriter(qw422016)
+//line article.html:246
+}
+
+//line article.html:246
+func thumbnail(img common.Image) string {
+//line article.html:246
+	qb422016 := qt422016.AcquireByteBuffer()
+//line article.html:246
+	writethumbnail(qb422016, img)
+//line article.html:246
+	qs422016 := string(qb422016.B)
+//line article.html:246
+	qt422016.ReleaseByteBuffer(qb422016)
+//line article.html:246
+	return qs422016
+//line article.html:246
 }
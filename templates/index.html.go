@@ -460,114 +460,120 @@ func streamrenderIndex(qw422016 *qt422016.Writer, pos common.ModerationLevel) {
 			qw422016.N().S(`</div>`)
 //line index.html:381
 		}
-//line index.html:382
+//line index.html:381
+		qw422016.N().S(`<div id="deleteImage-form" class="hidden"><label><input type="checkbox" name="blocklist">`)
+//line index.html:385
+		qw422016.N().S(ln.UI["blocklistImage"])
+//line index.html:385
+		qw422016.N().S(`</label></div>`)
+//line index.html:388
 		if pos == common.Admin {
-//line index.html:382
+//line index.html:388
 			qw422016.N().S(`<div id="purgePost-form" class="hidden"><input type="text" name="purge-reason" required class="full-width" placeholder="`)
-//line index.html:384
+//line index.html:390
 			qw422016.N().S(ln.Common.UI["reason"])
-//line index.html:384
+//line index.html:390
 			qw422016.N().S(`" disabled><br></div><div id="notification-form" class="hidden"><input type="text" name="notification" required class="full-width" placeholder="`)
-//line index.html:388
+//line index.html:394
 			qw422016.N().S(ln.UI["text"])
-//line index.html:388
+//line index.html:394
 			qw422016.N().S(`" style="min-width: 20em;" disabled><br></div>`)
-//line index.html:391
+//line index.html:397
 		}
-//line index.html:391
+//line index.html:397
 		qw422016.N().S(`<input type="checkbox" name="showCheckboxes"><select name="action">`)
-//line index.html:394
+//line index.html:400
 		ids := append(make([]string, 0, 5), "deletePost", "deleteImage", "spoilerImage")
 
-//line index.html:395
+//line index.html:401
 		if pos >= common.Moderator {
-//line index.html:396
+//line index.html:402
 			ids = append(ids, "ban")
 
-//line index.html:397
+//line index.html:403
 		}
-//line index.html:398
+//line index.html:404
 		if pos == common.Admin {
-//line index.html:399
+//line index.html:405
 			ids = append(ids, "purgePost", "notification")
 
-//line index.html:400
+//line index.html:406
 		}
-//line index.html:401
+//line index.html:407
 		for _, id := range ids {
-//line index.html:401
+//line index.html:407
 			qw422016.N().S(`<option value="`)
-//line index.html:402
+//line index.html:408
 			qw422016.N().S(id)
-//line index.html:402
+//line index.html:408
 			qw422016.N().S(`">`)
-//line index.html:403
+//line index.html:409
 			qw422016.N().S(ln.UI[id])
-//line index.html:403
+//line index.html:409
 			qw422016.N().S(`</option>`)
-//line index.html:405
+//line index.html:411
 		}
-//line index.html:405
+//line index.html:411
 		qw422016.N().S(`</select><input type="button" value="`)
-//line index.html:407
+//line index.html:413
 		qw422016.N().S(ln.UI["clear"])
-//line index.html:407
+//line index.html:413
 		qw422016.N().S(`" name="clear">`)
-//line index.html:408
+//line index.html:414
 		streamsubmit(qw422016, false)
-//line index.html:408
+//line index.html:414
 		qw422016.N().S(`</form></div>`)
-//line index.html:411
+//line index.html:417
 	}
-//line index.html:411
+//line index.html:417
 	qw422016.N().S(`</div></div>`)
-//line index.html:416
-	qw422016.N().S(`<div class="overlay top-overlay" id="hover-overlay"></div><div id="captcha-overlay" class="overlay top-overlay"></div>`)
 //line index.html:422
+	qw422016.N().S(`<div class="overlay top-overlay" id="hover-overlay"></div><div id="captcha-overlay" class="overlay top-overlay"></div>`)
+//line index.html:428
 	qw422016.N().S(`<section id="threads">`)
-//line index.html:426
+//line index.html:432
 	qw422016.N().S(`$$$</section>`)
-//line index.html:431
+//line index.html:437
 	qw422016.N().S(`<script src="/assets/js/vendor/almond.js"></script><script id="lang-data" type="application/json">`)
-//line index.html:434
+//line index.html:440
 	buf, _ := json.Marshal(ln.Common)
 
-//line index.html:435
+//line index.html:441
 	qw422016.N().Z(buf)
-//line index.html:435
+//line index.html:441
 	qw422016.N().S(`</script><script id="board-title-data" type="application/json">`)
-//line index.html:438
+//line index.html:444
 	buf, _ = json.Marshal(config.GetBoardTitles())
 
-//line index.html:439
+//line index.html:445
 	qw422016.N().Z(buf)
-//line index.html:439
+//line index.html:445
 	qw422016.N().S(`</script><script type="text/javascript">let bugForm = document.getElementById("bug-report-form");let status = document.getElementById("report-status");bugForm.addEventListener("submit", (e) => {e.preventDefault();status.innerHTML = "";bugForm.classList.add("loading");const form = e.currentTarget;const url = form.action;try {const formData = new FormData(form);fetch(url, {method: 'POST',body: formData}).then((res) => {if(res.status == 200) {bugForm.reset();status.innerHTML = "Reported Successfully"} else {status.innerHTML = "Reporting Failed. Try again later."}}).finally(() => {bugForm.classList.remove("loading");})} catch (error) {console.error(error);}});</script><script src="/assets/js/scripts/loader.js"></script></body>`)
-//line index.html:475
+//line index.html:481
 }
 
-//line index.html:475
+//line index.html:481
 func writerenderIndex(qq422016 qtio422016.Writer, pos common.ModerationLevel) {
-//line index.html:475
+//line index.html:481
 	qw422016 := qt422016.AcquireWriter(qq422016)
-//line index.html:475
+//line index.html:481
 	streamrenderIndex(qw422016, pos)
-//line index.html:475
+//line index.html:481
 	qt422016.ReleaseWriter(qw422016)
-//line index.html:475
+//line index.html:481
 }
 
-//line index.html:475
+//line index.html:481
 func renderIndex(pos common.ModerationLevel) string {
-//line index.html:475
+//line index.html:481
 	qb422016 := qt422016.AcquireByteBuffer()
-//line index.html:475
+//line index.html:481
 	writerenderIndex(qb422016, pos)
-//line index.html:475
+//line index.html:481
 	qs422016 := string(qb422016.B)
-//line index.html:475
+//line index.html:481
 	qt422016.ReleaseByteBuffer(qb422016)
-//line index.html:475
+//line index.html:481
 	return qs422016
-//line index.html:475
+//line index.html:481
 }
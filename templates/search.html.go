@@ -0,0 +1,219 @@
+// Code generated by qtc from "search.html". DO NOT EDIT.
+// See https://github.com/valyala/quicktemplate for details.
+
+//line search.html:1
+package templates
+
+//line search.html:1
+import "net/url"
+
+//line search.html:2
+import "github.com/bakape/meguca/config"
+
+//line search.html:3
+import "github.com/bakape/meguca/common"
+
+//line search.html:4
+import "github.com/bakape/meguca/lang"
+
+//line search.html:5
+import "encoding/json"
+
+//line search.html:7
+import (
+	qtio422016 "io"
+
+	qt422016 "github.com/valyala/quicktemplate"
+)
+
+//line search.html:7
+var (
+	_ = qtio422016.Copy
+	_ = qt422016.AcquireByteBuffer
+)
+
+//line search.html:7
+func streamrenderSearch(qw422016 *qt422016.Writer, board string, query url.Values, posts []common.StandalonePost) {
+//line search.html:8
+	ln := lang.Get()
+
+//line search.html:9
+	conf := config.GetBoardConfigs(board)
+
+//line search.html:9
+	qw422016.N().S(`<span class="aside-container"><aside class="glass"><form id="search-form" action="search" method="get"><input type="text" name="q" value="`)
+//line search.html:13
+	qw422016.E().S(query.Get("q"))
+//line search.html:13
+	qw422016.N().S(`" placeholder="`)
+//line search.html:13
+	qw422016.N().S(ln.UI["searchQuery"])
+//line search.html:13
+	qw422016.N().S(`" maxlength="`)
+//line search.html:13
+	qw422016.N().D(common.MaxLenSearch)
+//line search.html:13
+	qw422016.N().S(`" required><br><label>`)
+//line search.html:16
+	qw422016.N().S(ln.UI["searchFrom"])
+//line search.html:16
+	qw422016.N().S(`<input type="date" name="from" value="`)
+//line search.html:17
+	qw422016.E().S(query.Get("from"))
+//line search.html:17
+	qw422016.N().S(`"></label><label>`)
+//line search.html:20
+	qw422016.N().S(ln.UI["searchTo"])
+//line search.html:20
+	qw422016.N().S(`<input type="date" name="to" value="`)
+//line search.html:21
+	qw422016.E().S(query.Get("to"))
+//line search.html:21
+	qw422016.N().S(`"></label><br><label><input type="checkbox" name="image"`)
+//line search.html:25
+	if query.Get("image") != "" {
+//line search.html:25
+		qw422016.N().S(`checked`)
+//line search.html:25
+	}
+//line search.html:25
+	qw422016.N().S(`>`)
+//line search.html:26
+	qw422016.N().S(ln.UI["hasImage"])
+//line search.html:26
+	qw422016.N().S(`</label><select name="fileType"><option value="">`)
+//line search.html:30
+	qw422016.N().S(ln.UI["anyFileType"])
+//line search.html:30
+	qw422016.N().S(`</option>`)
+//line search.html:32
+	for _, ext := range searchFileTypes() {
+//line search.html:32
+		qw422016.N().S(`<option value="`)
+//line search.html:33
+		qw422016.N().S(ext)
+//line search.html:33
+		qw422016.N().S(`"`)
+//line search.html:33
+		if query.Get("fileType") == ext {
+//line search.html:33
+			qw422016.N().S(`selected`)
+//line search.html:33
+		}
+//line search.html:33
+		qw422016.N().S(`>`)
+//line search.html:34
+		qw422016.N().S(ext)
+//line search.html:34
+		qw422016.N().S(`</option>`)
+//line search.html:36
+	}
+//line search.html:36
+	qw422016.N().S(`</select><br><input type="submit" value="`)
+//line search.html:39
+	qw422016.N().S(ln.Common.UI["search"])
+//line search.html:39
+	qw422016.N().S(`"></form></aside><aside class="act glass"><a href=".">`)
+//line search.html:44
+	qw422016.N().S(ln.Common.UI["return"])
+//line search.html:44
+	qw422016.N().S(`</a></aside></span><hr>`)
+//line search.html:49
+	if query.Get("q") != "" {
+//line search.html:50
+		if len(posts) == 0 {
+//line search.html:50
+			qw422016.N().S(`<div class="search-results">`)
+//line search.html:52
+			qw422016.N().S(ln.UI["noResults"])
+//line search.html:52
+			qw422016.N().S(`</div>`)
+//line search.html:54
+		} else {
+//line search.html:55
+			c := articleContext{
+				index:  true,
+				rbText: conf.RbText,
+				pyu:    conf.Pyu,
+				root:   config.Get().RootURL,
+			}
+
+//line search.html:60
+			qw422016.N().S(`<section class="search-results">`)
+//line search.html:62
+			for _, p := range posts {
+//line search.html:63
+				c.op = p.OP
+
+//line search.html:64
+				c.board = p.Board
+
+//line search.html:65
+				streamrenderArticle(qw422016, p.Post, c)
+//line search.html:66
+			}
+//line search.html:66
+			qw422016.N().S(`</section><script id="post-data" type="application/json">`)
+//line search.html:69
+			buf, _ := json.Marshal(posts)
+
+//line search.html:70
+			qw422016.N().Z(buf)
+//line search.html:70
+			qw422016.N().S(`</script>`)
+//line search.html:72
+			if len(posts) == common.MaxSearchResults {
+//line search.html:72
+				qw422016.N().S(`<hr><span class="aside-container"><aside class="act glass"><a href="`)
+//line search.html:76
+				qw422016.E().S(olderSearchLink(query, posts[len(posts)-1].ID))
+//line search.html:76
+				qw422016.N().S(`">`)
+//line search.html:77
+				qw422016.N().S(ln.UI["olderResults"])
+//line search.html:77
+				qw422016.N().S(`</a></aside></span>`)
+//line search.html:81
+			}
+//line search.html:82
+		}
+//line search.html:82
+		qw422016.N().S(`<hr>`)
+//line search.html:84
+	}
+//line search.html:84
+	qw422016.N().S(`<script id="board-configs" type="application/json">`)
+//line search.html:86
+	qw422016.N().Z(conf.JSON)
+//line search.html:86
+	qw422016.N().S(`</script>`)
+//line search.html:88
+	streamloadingImage(qw422016, board)
+//line search.html:89
+}
+
+//line search.html:89
+func writerenderSearch(qq422016 qtio422016.Writer, board string, query url.Values, posts []common.StandalonePost) {
+//line search.html:89
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line search.html:89
+	streamrenderSearch(qw422016, board, query, posts)
+//line search.html:89
+	qt422016.ReleaseWriter(qw422016)
+//line search.html:89
+}
+
+//line search.html:89
+func renderSearch(board string, query url.Values, posts []common.StandalonePost) string {
+//line search.html:89
+	qb422016 := qt422016.AcquireByteBuffer()
+//line search.html:89
+	writerenderSearch(qb422016, board, query, posts)
+//line search.html:89
+	qs422016 := string(qb422016.B)
+//line search.html:89
+	qt422016.ReleaseByteBuffer(qb422016)
+//line search.html:89
+	return qs422016
+//line search.html:89
+}
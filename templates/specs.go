@@ -119,6 +119,31 @@ var specs = map[string][]inputSpec{
 		{ID: "textOnly"},
 		{ID: "forcedAnon"},
 		{ID: "disableRobots"},
+		{ID: "editLog"},
+		{ID: "archive"},
+		{ID: "archiveDropSources"},
+		{ID: "stripMetadata"},
+		{
+			ID:       "maxOpenTime",
+			Type:     _number,
+			Min:      0,
+			Max:      common.MaxOpenPostTime,
+			Required: true,
+		},
+		{
+			ID:       "maxIdleTime",
+			Type:     _number,
+			Min:      0,
+			Max:      common.MaxOpenPostTime,
+			Required: true,
+		},
+		{
+			ID:       "maxAttachments",
+			Type:     _number,
+			Min:      1,
+			Max:      common.MaxAttachments,
+			Required: true,
+		},
 		{ID: "flags"},
 		{ID: "NSFW"},
 		{ID: "rbText"},
@@ -229,6 +254,13 @@ var specs = map[string][]inputSpec{
 			Min:      0,
 			Required: true,
 		},
+		{
+			ID:       "phashThreshold",
+			Type:     _number,
+			Min:      0,
+			Max:      64,
+			Required: true,
+		},
 		{
 			ID:       "sessionExpiry",
 			Type:     _number,
@@ -236,6 +268,31 @@ var specs = map[string][]inputSpec{
 			Required: true,
 		},
 		{Type: _hr},
+		{
+			ID:       "ipRetention",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
+		{
+			ID:       "modLogRetention",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
+		{
+			ID:       "reportRetention",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
+		{
+			ID:       "engagementRetention",
+			Type:     _number,
+			Min:      0,
+			Required: true,
+		},
+		{Type: _hr},
 		{ID: "emailErr"},
 		{
 			ID:           "emailErrMail",
@@ -292,6 +349,7 @@ var specs = map[string][]inputSpec{
 			Min:      1,
 			Required: true,
 		},
+		{ID: "correctOrientation"},
 		{Type: _hr},
 		{
 			ID:   "FAQ",
@@ -0,0 +1,129 @@
+// Code generated by qtc from "stats.html". DO NOT EDIT.
+// See https://github.com/valyala/quicktemplate for details.
+
+//line stats.html:1
+package templates
+
+//line stats.html:1
+import "time"
+
+//line stats.html:2
+import "github.com/bakape/meguca/common"
+
+//line stats.html:3
+import "github.com/bakape/meguca/lang"
+
+// Renders hourly activity statistics of a board
+
+//line stats.html:6
+import (
+	qtio422016 "io"
+
+	qt422016 "github.com/valyala/quicktemplate"
+)
+
+//line stats.html:6
+var (
+	_ = qtio422016.Copy
+	_ = qt422016.AcquireByteBuffer
+)
+
+//line stats.html:6
+func StreamBoardStats(qw422016 *qt422016.Writer, board string, stats []common.BoardStats) {
+//line stats.html:7
+	streamhtmlHeader(qw422016)
+//line stats.html:8
+	ln := lang.Get()
+
+//line stats.html:8
+	qw422016.N().S(`<h1>/`)
+//line stats.html:10
+	qw422016.N().S(board)
+//line stats.html:10
+	qw422016.N().S(`/ -`)
+//line stats.html:10
+	qw422016.N().S(` `)
+//line stats.html:10
+	qw422016.N().S(ln.UI["stats"])
+//line stats.html:10
+	qw422016.N().S(`</h1>`)
+//line stats.html:12
+	if len(stats) == 0 {
+//line stats.html:12
+		qw422016.N().S(`<div>`)
+//line stats.html:14
+		qw422016.N().S(ln.UI["noStats"])
+//line stats.html:14
+		qw422016.N().S(`</div>`)
+//line stats.html:16
+	} else {
+//line stats.html:17
+		streamtableStyle(qw422016)
+//line stats.html:17
+		qw422016.N().S(`<table>`)
+//line stats.html:19
+		streamtableHeaders(qw422016, "hour", "posts", "threads", "images", "uniquePosters", "peakViewers")
+//line stats.html:20
+		for _, s := range stats {
+//line stats.html:20
+			qw422016.N().S(`<tr><td>`)
+//line stats.html:22
+			qw422016.E().S(time.Unix(s.Hour, 0).UTC().Format("2006-01-02 15:04 MST"))
+//line stats.html:22
+			qw422016.N().S(`</td><td>`)
+//line stats.html:23
+			qw422016.N().D(int(s.Posts))
+//line stats.html:23
+			qw422016.N().S(`</td><td>`)
+//line stats.html:24
+			qw422016.N().D(int(s.Threads))
+//line stats.html:24
+			qw422016.N().S(`</td><td>`)
+//line stats.html:25
+			qw422016.N().D(int(s.Images))
+//line stats.html:25
+			qw422016.N().S(`</td><td>`)
+//line stats.html:26
+			qw422016.N().D(int(s.UniquePosters))
+//line stats.html:26
+			qw422016.N().S(`</td><td>`)
+//line stats.html:27
+			qw422016.N().D(int(s.PeakViewers))
+//line stats.html:27
+			qw422016.N().S(`</td></tr>`)
+//line stats.html:29
+		}
+//line stats.html:29
+		qw422016.N().S(`</table>`)
+//line stats.html:31
+	}
+//line stats.html:32
+	streamhtmlEnd(qw422016)
+//line stats.html:33
+}
+
+//line stats.html:33
+func WriteBoardStats(qq422016 qtio422016.Writer, board string, stats []common.BoardStats) {
+//line stats.html:33
+	qw422016 := qt422016.AcquireWriter(qq422016)
+//line stats.html:33
+	StreamBoardStats(qw422016, board, stats)
+//line stats.html:33
+	qt422016.ReleaseWriter(qw422016)
+//line stats.html:33
+}
+
+//line stats.html:33
+func BoardStats(board string, stats []common.BoardStats) string {
+//line stats.html:33
+	qb422016 := qt422016.AcquireByteBuffer()
+//line stats.html:33
+	WriteBoardStats(qb422016, board, stats)
+//line stats.html:33
+	qs422016 := string(qb422016.B)
+//line stats.html:33
+	qt422016.ReleaseByteBuffer(qb422016)
+//line stats.html:33
+	return qs422016
+//line stats.html:33
+}
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"html"
 	"io"
+	"net/url"
 	"sync"
 
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/lang"
 	"github.com/bakape/meguca/util"
 )
 
@@ -83,6 +85,28 @@ func Thread(w io.Writer, id uint64, board, title, theme string, abbrev,
 	})
 }
 
+// Search writes a search form and full-text search results page HTML
+func Search(w io.Writer, board, theme string, pos common.ModerationLevel,
+	query url.Values, posts []common.StandalonePost,
+) {
+	title := html.EscapeString(fmt.Sprintf("/%s/ - %s", board,
+		lang.Get().Common.UI["search"]))
+	execIndex(w, title, theme, pos, func(w io.Writer) {
+		writerenderSearch(w, board, query, posts)
+	})
+}
+
+// Archive writes a board's archived thread list page HTML
+func Archive(w io.Writer, board, theme string, pos common.ModerationLevel,
+	threads []common.Thread,
+) {
+	title := html.EscapeString(fmt.Sprintf("/%s/ - %s", board,
+		lang.Get().UI["archive"]))
+	execIndex(w, title, theme, pos, func(w io.Writer) {
+		writerenderArchive(w, board, threads)
+	})
+}
+
 // Execute and index template in the second pass
 func execIndex(w io.Writer, title, theme string, pos common.ModerationLevel,
 	fn func(w io.Writer),
@@ -2,6 +2,9 @@ package templates
 
 import (
 	"html"
+	"net/url"
+	"sort"
+	"strconv"
 
 	"github.com/bakape/meguca/common"
 )
@@ -20,14 +23,9 @@ func CalculateOmit(t common.Thread) (int, int) {
 		imgOmit uint32
 	)
 	if omit != 0 {
-		imgOmit = t.ImageCount
-		if t.Image != nil {
-			imgOmit--
-		}
+		imgOmit = t.ImageCount - uint32(len(t.Files()))
 		for _, p := range t.Posts {
-			if p.Image != nil {
-				imgOmit--
-			}
+			imgOmit -= uint32(len(p.Files()))
 		}
 	}
 	return omit, int(imgOmit)
@@ -41,3 +39,25 @@ func bold(s string) string {
 	b = append(b, "</b>"...)
 	return string(b)
 }
+
+// Returns the sorted canonical extensions of all file types, that can be
+// searched for
+func searchFileTypes() []string {
+	exts := make([]string, 0, len(common.Extensions))
+	for _, ext := range common.Extensions {
+		exts = append(exts, ext)
+	}
+	sort.Strings(exts)
+	return exts
+}
+
+// Returns a link to the next page of search results with posts older than
+// before
+func olderSearchLink(query url.Values, before uint64) string {
+	q := make(url.Values, len(query)+1)
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("before", strconv.FormatUint(before, 10))
+	return "?" + q.Encode()
+}
@@ -0,0 +1,157 @@
+package transfer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+// Export writes a gzipped tarball of all threads of a board or a single
+// thread and their images to w. target is either "board" or "board/thread".
+// Archived threads are included.
+func Export(w io.Writer, target string) (err error) {
+	board, id, err := parseTarget(target)
+	if err != nil {
+		return
+	}
+	var ids []uint64
+	if id != 0 {
+		var valid bool
+		valid, err = db.ValidateOP(id, board)
+		if err != nil {
+			return
+		}
+		if !valid {
+			return fmt.Errorf("thread not found: %s", target)
+		}
+		ids = []uint64{id}
+	} else {
+		if config.GetBoardConfigs(board).ID == "" {
+			return fmt.Errorf("board not found: %s", board)
+		}
+		ids, err = db.GetExportThreadIDs(board)
+		if err != nil {
+			return
+		}
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err = writeJSON(tw, metaPath, meta{
+		Version: formatVersion,
+		Created: time.Now().Unix(),
+		Board:   board,
+	})
+	if err != nil {
+		return
+	}
+
+	images := make(map[string]common.ImageCommon)
+	addImage := func(p common.Post) {
+		for _, f := range p.Files() {
+			images[f.SHA1] = f.ImageCommon
+		}
+	}
+	for _, id := range ids {
+		var t common.Thread
+		t, err = db.GetThread(id, 0)
+		if err != nil {
+			return
+		}
+		addImage(t.Post)
+		for _, p := range t.Posts {
+			addImage(p)
+		}
+		err = writeJSON(tw, threadsDir+strconv.FormatUint(id, 10)+".json", t)
+		if err != nil {
+			return
+		}
+	}
+
+	// Sorted for deterministic output
+	hashes := make([]string, 0, len(images))
+	for sha1 := range images {
+		hashes = append(hashes, sha1)
+	}
+	sort.Strings(hashes)
+	for _, sha1 := range hashes {
+		for _, k := range imageKeys(images[sha1]) {
+			if k == "" {
+				continue
+			}
+			err = writeFile(tw, k)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return
+	}
+	return gz.Close()
+}
+
+// Write a value as a JSON file to the archive
+func writeJSON(tw *tar.Writer, name string, v interface{}) (err error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	err = tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(buf)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return
+	}
+	_, err = tw.Write(buf)
+	return
+}
+
+// Copy a file from the image store into the archive. Missing files, like
+// sources dropped on thread archival, are skipped.
+func writeFile(tw *tar.Writer, key string) (err error) {
+	f, err := assets.GetStore().Read(key)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return
+	}
+	defer f.Close()
+
+	// Size must be known before writing the header
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(f)
+	if err != nil {
+		return
+	}
+	err = tw.WriteHeader(&tar.Header{
+		Name:    imagesDir + key,
+		Mode:    0600,
+		Size:    int64(buf.Len()),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return
+	}
+	_, err = tw.Write(buf.Bytes())
+	return
+}
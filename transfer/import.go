@@ -0,0 +1,226 @@
+package transfer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+var (
+	errNoMeta = errors.New("archive metadata missing")
+
+	// Matches post links in post bodies
+	linkRegexp = regexp.MustCompile(`>>(>*)(\d+)`)
+)
+
+// Import reads a gzipped tarball created by Export and writes its threads
+// and images under newly allocated post IDs. If board is empty, threads are
+// imported into the board they were exported from. The board must already
+// exist. Returns the number of imported threads.
+func Import(r io.Reader, board string) (n int, err error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return
+	}
+	defer gz.Close()
+
+	// Remove any written image files, if the import fails
+	var written []string
+	defer func() {
+		if err != nil {
+			for _, k := range written {
+				assets.GetStore().Delete(k)
+			}
+		}
+	}()
+
+	var (
+		m        *meta
+		threads  []common.Thread
+		expected = make(map[string]bool)
+		tr       = tar.NewReader(gz)
+	)
+	for {
+		var h *tar.Header
+		h, err = tr.Next()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			return
+		}
+
+		switch {
+		case h.Name == metaPath:
+			m = new(meta)
+			err = json.NewDecoder(tr).Decode(m)
+			if err != nil {
+				return
+			}
+			if m.Version != formatVersion {
+				err = fmt.Errorf("unsupported archive version: %d", m.Version)
+				return
+			}
+		case strings.HasPrefix(h.Name, threadsDir):
+			var t common.Thread
+			err = json.NewDecoder(tr).Decode(&t)
+			if err != nil {
+				return
+			}
+			for _, p := range append([]common.Post{t.Post}, t.Posts...) {
+				for _, f := range p.Files() {
+					for _, k := range imageKeys(f.ImageCommon) {
+						if k != "" {
+							expected[imagesDir+k] = true
+						}
+					}
+				}
+			}
+			threads = append(threads, t)
+		case expected[h.Name]:
+			// Image files are only accepted, if referenced by a preceding
+			// thread. This also guards against path traversal.
+			key := strings.TrimPrefix(h.Name, imagesDir)
+			var ok bool
+			ok, err = copyFile(key, tr)
+			if err != nil {
+				return
+			}
+			if ok {
+				written = append(written, key)
+			}
+		}
+	}
+	if m == nil {
+		err = errNoMeta
+		return
+	}
+
+	if board == "" {
+		board = m.Board
+	}
+	if config.GetBoardConfigs(board).ID == "" {
+		err = fmt.Errorf("board not found: %s", board)
+		return
+	}
+
+	// Allocate new IDs in the same relative order as the old ones
+	old := make([]uint64, 0, len(threads)*16)
+	for _, t := range threads {
+		old = append(old, t.ID)
+		for _, p := range t.Posts {
+			old = append(old, p.ID)
+		}
+	}
+	sort.Slice(old, func(i, j int) bool {
+		return old[i] < old[j]
+	})
+	allocated, err := db.AllocatePostIDs(len(old))
+	if err != nil {
+		return
+	}
+	ids := make(map[uint64]uint64, len(old))
+	for i, id := range old {
+		ids[id] = allocated[i]
+	}
+
+	remap(threads, board, ids)
+	err = db.ImportThreads(threads)
+	if err != nil {
+		return
+	}
+	n = len(threads)
+	return
+}
+
+// Write a file to the image store, unless it already exists. Returns, if the
+// file was written.
+func copyFile(key string, r io.Reader) (written bool, err error) {
+	s := assets.GetStore()
+	f, err := s.Read(key)
+	switch {
+	case err == nil:
+		f.Close()
+		return false, nil
+	case !os.IsNotExist(err):
+		return
+	}
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(r)
+	if err != nil {
+		return
+	}
+	err = s.Write(key, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return
+	}
+	return true, nil
+}
+
+// Move threads to board and replace their post IDs with the newly allocated
+// ones in ids. Links and their quotes in post bodies are rewritten. Links to
+// posts not contained in threads are dropped.
+func remap(threads []common.Thread, board string, ids map[uint64]uint64) {
+	for i := range threads {
+		t := &threads[i]
+		t.Board = board
+		remapPost(&t.Post, board, ids)
+		for j := range t.Posts {
+			remapPost(&t.Posts[j], board, ids)
+		}
+	}
+}
+
+func remapPost(p *common.Post, board string, ids map[uint64]uint64) {
+	p.ID = ids[p.ID]
+	if len(p.Links) == 0 {
+		return
+	}
+
+	var (
+		targets = make(map[uint64]uint64, len(p.Links))
+		links   = make([]common.Link, 0, len(p.Links))
+	)
+	for _, l := range p.Links {
+		id, ok := ids[l.ID]
+		if !ok {
+			continue
+		}
+		targets[l.ID] = id
+		links = append(links, common.Link{
+			ID:    id,
+			OP:    ids[l.OP],
+			Board: board,
+		})
+	}
+	p.Links = links
+
+	p.Body = linkRegexp.ReplaceAllStringFunc(p.Body, func(s string) string {
+		m := linkRegexp.FindStringSubmatch(s)
+		old, err := strconv.ParseUint(m[2], 10, 64)
+		if err != nil {
+			return s
+		}
+		id, ok := targets[old]
+		if !ok {
+			return s
+		}
+		return ">>" + m[1] + strconv.FormatUint(id, 10)
+	})
+}
@@ -0,0 +1,64 @@
+// Package transfer exports boards and threads to and imports them from
+// portable gzipped tarballs
+package transfer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/imager/assets"
+)
+
+// Version of the archive format. Incremented on incompatible changes.
+const formatVersion = 1
+
+// Archive layout:
+//
+//	meta.json                 archive metadata
+//	threads/<id>.json         common.Thread records with all posts
+//	images/src/<SHA1>.<ext>   image source files, if still present
+//	images/thumb/<SHA1>.<ext> image thumbnails
+const (
+	metaPath   = "meta.json"
+	threadsDir = "threads/"
+	imagesDir  = "images/"
+)
+
+// Metadata of an exported archive
+type meta struct {
+	Version int    `json:"version"`
+	Created int64  `json:"created"`
+	Board   string `json:"board"`
+}
+
+// Parse an export target of either "board" or "board/thread" format
+func parseTarget(s string) (board string, thread uint64, err error) {
+	i := strings.IndexByte(s, '/')
+	if i == -1 {
+		board = s
+	} else {
+		board = s[:i]
+		thread, err = strconv.ParseUint(s[i+1:], 10, 64)
+		if err != nil {
+			err = fmt.Errorf("invalid thread ID: %s", s[i+1:])
+			return
+		}
+	}
+	if board == "" || board == "all" {
+		err = fmt.Errorf("invalid board: %s", board)
+	}
+	return
+}
+
+// Returns the image store keys of the source file and thumbnail of an image.
+// The archive path of a file is imagesDir + key. The thumbnail key is empty,
+// if the image has no thumbnail.
+func imageKeys(img common.ImageCommon) (keys [2]string) {
+	keys = assets.GetKeys(img.SHA1, img.FileType, img.ThumbType)
+	if img.ThumbType == common.NoFile {
+		keys[1] = ""
+	}
+	return
+}
@@ -0,0 +1,120 @@
+package transfer
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+func TestParseTarget(t *testing.T) {
+	t.Parallel()
+
+	cases := [...]struct {
+		name, in string
+		board    string
+		thread   uint64
+		err      bool
+	}{
+		{"board", "a", "a", 0, false},
+		{"thread", "a/12", "a", 12, false},
+		{"no board", "", "", 0, true},
+		{"all", "all", "", 0, true},
+		{"invalid thread", "a/b", "", 0, true},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			board, thread, err := parseTarget(c.in)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEquals(t, board, c.board)
+			AssertEquals(t, thread, c.thread)
+		})
+	}
+}
+
+func TestRemap(t *testing.T) {
+	t.Parallel()
+
+	threads := []common.Thread{
+		{
+			Board: "a",
+			Post: common.Post{
+				ID:   1,
+				Body: ">>99 outside",
+				Links: []common.Link{
+					{ID: 99, OP: 99, Board: "b"},
+				},
+			},
+			Posts: []common.Post{
+				{
+					ID:   3,
+					Body: ">>1 >>>1\n>>99",
+					Links: []common.Link{
+						{ID: 1, OP: 1, Board: "a"},
+						{ID: 99, OP: 99, Board: "b"},
+					},
+				},
+			},
+		},
+		{
+			Board: "a",
+			Post: common.Post{
+				ID:   5,
+				Body: ">>3 cross-thread",
+				Links: []common.Link{
+					{ID: 3, OP: 1, Board: "a"},
+				},
+			},
+		},
+	}
+	ids := map[uint64]uint64{
+		1: 100,
+		3: 101,
+		5: 102,
+	}
+
+	remap(threads, "c", ids)
+
+	std := []common.Thread{
+		{
+			Board: "c",
+			Post: common.Post{
+				ID:    100,
+				Body:  ">>99 outside",
+				Links: []common.Link{},
+			},
+			Posts: []common.Post{
+				{
+					ID:   101,
+					Body: ">>100 >>>100\n>>99",
+					Links: []common.Link{
+						{ID: 100, OP: 100, Board: "c"},
+					},
+				},
+			},
+		},
+		{
+			Board: "c",
+			Post: common.Post{
+				ID:   102,
+				Body: ">>101 cross-thread",
+				Links: []common.Link{
+					{ID: 101, OP: 100, Board: "c"},
+				},
+			},
+		},
+	}
+	AssertEquals(t, threads, std)
+}
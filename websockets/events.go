@@ -0,0 +1,186 @@
+package websockets
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+// Interval of keep-alive comments sent to event stream listeners, so proxies
+// do not close idle connections
+var eventKeepAlive = time.Second * 30
+
+// eventListener is a read-only thread feed listener, that streams received
+// messages as Server-Sent Events
+type eventListener struct {
+	ip    string
+	w     http.ResponseWriter
+	flush http.Flusher
+	// Messages to write to the stream
+	send chan []byte
+	// Close the stream
+	close chan error
+	// Accounting of messages queued in send
+	queue sendQueue
+}
+
+// StreamEvents subscribes to the update feed of a thread and streams its
+// messages to the client as Server-Sent Events, until the client disconnects.
+// Each event contains one message in the same format as websocket text frames.
+// Errors are only returned, if the stream could not be started. Any later
+// errors are sent to the client as a common.MessageInvalid event.
+func StreamEvents(w http.ResponseWriter, r *http.Request, board string,
+	thread uint64,
+) (err error) {
+	flush, ok := w.(http.Flusher)
+	if !ok {
+		return errors.New("streaming not supported")
+	}
+	ip, err := auth.GetIP(r)
+	if err != nil {
+		return
+	}
+	err = feeds.RegisterIP(ip)
+	if err != nil {
+		return
+	}
+	defer feeds.UnregisterIP(ip)
+
+	l := &eventListener{
+		ip:    ip,
+		w:     w,
+		flush: flush,
+		send:  make(chan []byte, SendQueueLength),
+		close: make(chan error, 1),
+	}
+	l.queue.close = l.Close
+
+	_, err = feeds.SyncClient(l, thread, board)
+	if err != nil {
+		return
+	}
+	defer func() {
+		feeds.RemoveClient(l)
+		l.queue.releaseAll()
+	}()
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(200)
+	flush.Flush()
+
+	if err := l.listen(r.Context().Done()); err != nil {
+		// Client might have already disconnected. Ignore any errors.
+		msg, _ := common.EncodeMessage(common.MessageInvalid, err.Error())
+		l.write(msg)
+	}
+	return nil
+}
+
+// Write messages to the stream, until the listener is closed or the client
+// disconnects
+func (l *eventListener) listen(done <-chan struct{}) error {
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-done:
+			return nil
+		case err := <-l.close:
+			if err == errSlowConsumer {
+				return err
+			}
+
+			// Write any messages sent right before closing
+			for {
+				select {
+				case msg := <-l.send:
+					l.queue.release(msg)
+					if werr := l.write(msg); werr != nil {
+						return werr
+					}
+				default:
+					return err
+				}
+			}
+		case msg := <-l.send:
+			l.queue.release(msg)
+			if err := l.write(msg); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := l.w.Write([]byte(": keep-alive\n\n")); err != nil {
+				return err
+			}
+			l.flush.Flush()
+		}
+	}
+}
+
+// Write a message as a single event
+func (l *eventListener) write(msg []byte) (err error) {
+	buf := make([]byte, 0, len(msg)+8)
+	buf = append(buf, "data: "...)
+	buf = append(buf, msg...)
+	buf = append(buf, "\n\n"...)
+	_, err = l.w.Write(buf)
+	if err != nil {
+		return
+	}
+	l.flush.Flush()
+	return
+}
+
+// Send a message to the client. Can be used concurrently.
+func (l *eventListener) Send(msg []byte) {
+	if !l.queue.reserve(msg) {
+		return
+	}
+	select {
+	case l.send <- msg:
+	default:
+		l.queue.release(msg)
+		l.queue.evict()
+	}
+}
+
+// Redirect notifies the client of the redirect and closes the stream. The
+// client has to resubscribe to the feed of the new page itself.
+func (l *eventListener) Redirect(board string) {
+	msg, err := common.EncodeMessage(common.MessageRedirect, "/"+board+"/")
+	if err != nil {
+		l.Close(err)
+		return
+	}
+	l.Send(msg)
+	l.Close(nil)
+}
+
+// IP returns the IP of the client
+func (l *eventListener) IP() string {
+	return l.ip
+}
+
+// LastTime always returns 0, as listeners can not post
+func (l *eventListener) LastTime() int64 {
+	return 0
+}
+
+// Close closes the stream. Can be used concurrently.
+func (l *eventListener) Close(err error) {
+	// Messages queued before closing are still written, but no new ones are
+	// accepted
+	l.queue.releaseAll()
+
+	select {
+	case l.close <- err:
+	default:
+	}
+}
@@ -0,0 +1,46 @@
+package websockets
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func newTestEventListener() (*eventListener, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	l := &eventListener{
+		ip:    "::1",
+		w:     w,
+		flush: w,
+		send:  make(chan []byte, 4),
+		close: make(chan error, 1),
+	}
+	l.queue.close = l.Close
+	return l, w
+}
+
+func TestEventListenerRedirect(t *testing.T) {
+	t.Parallel()
+
+	l, w := newTestEventListener()
+	l.Send([]byte("foo"))
+	l.Redirect("a")
+
+	if err := l.listen(nil); err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, w.Body.String(), "data: foo\n\ndata: 37\"/a/\"\n\n")
+}
+
+func TestEventListenerOverflow(t *testing.T) {
+	t.Parallel()
+
+	l, _ := newTestEventListener()
+	for i := 0; i < cap(l.send)+1; i++ {
+		l.Send([]byte("foo"))
+	}
+
+	err := l.listen(nil)
+	AssertEquals(t, err, errSlowConsumer)
+}
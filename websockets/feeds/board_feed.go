@@ -0,0 +1,99 @@
+// Live catalog updates of board pages
+
+package feeds
+
+import (
+	"encoding/json"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
+)
+
+// Feed of thread creation, bump, sticky, lock and deletion events of a board
+// or the /all/ metaboard. Clients synced to a board page subscribe to it.
+type boardFeed struct {
+	// Message flushing ticker
+	ticker
+	// Common functionality
+	baseFeed
+	// Buffer of unsent messages
+	messageBuffer
+	board string
+	// Propagates mesages to all listeners
+	send chan []byte
+}
+
+// Thread event on a board, as sent by the database
+type boardFeedEvent struct {
+	Type  string `json:"type"`
+	ID    uint64 `json:"id"`
+	Board string `json:"board"`
+}
+
+func newBoardFeed(board string) *boardFeed {
+	f := &boardFeed{
+		board: board,
+		send:  make(chan []byte),
+		messageBuffer: messageBuffer{
+			text:   make([]string, 0, 16),
+			binary: make([][]byte, 0, 16),
+		},
+	}
+	f.baseFeed.init()
+	return f
+}
+
+func (f *boardFeed) start() {
+	go func() {
+		// Stop the timer, if there are no messages and resume on new ones.
+		// Keeping the goroutine asleep reduces CPU usage.
+		f.ticker.start()
+		defer f.pause()
+
+		for {
+			select {
+			case c := <-f.add:
+				f.addClient(c)
+			case c := <-f.remove:
+				if f.removeClient(c) {
+					return
+				}
+			case msg := <-f.send:
+				f.startIfPaused()
+				f.write(msg)
+			case <-f.C:
+				if text, bin := f.flush(f.hasBinaryClients()); text == nil {
+					f.pause()
+				} else {
+					f.sendToAllEncoded(text, bin)
+				}
+			}
+		}
+	}()
+}
+
+// Propagate a thread event from the database to the feeds of the thread's
+// board and the /all/ metaboard
+func handleBoardFeedEvent(msg string) (err error) {
+	var e boardFeedEvent
+	err = json.Unmarshal([]byte(msg), &e)
+	if err != nil {
+		return
+	}
+	buf := common.PrependMessageType(common.MessageBoardFeed, []byte(msg))
+
+	feeds.mu.RLock()
+	defer feeds.mu.RUnlock()
+
+	if f := feeds.boardFeeds[e.Board]; f != nil {
+		f.send <- buf
+	}
+	// Hide threads from NSFW boards, if enabled
+	if config.Get().HideNSFW && config.GetBoardConfigs(e.Board).NSFW {
+		return
+	}
+	if f := feeds.boardFeeds["all"]; f != nil {
+		f.send <- buf
+	}
+	return
+}
@@ -0,0 +1,179 @@
+package feeds
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/bakape/meguca/auth"
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
+)
+
+// Postgres notification channel used by the default Bus
+const busChannel = "feed_bus"
+
+var (
+	// EnablePGBus enables relaying feed updates between processes through
+	// Postgres LISTEN/NOTIFY, if no other Bus is set. Enabled by default, so
+	// multiple processes serving the same database stay in sync. Can be
+	// disabled, when running only a single process.
+	EnablePGBus = true
+
+	// Relays feed updates between processes. Nil, if feed updates are only
+	// delivered in-process.
+	bus Bus
+
+	// Identifies this process on the bus
+	nodeID string
+)
+
+func init() {
+	var err error
+	nodeID, err = auth.RandomID(16)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Bus relays thread feed updates between multiple meguca processes serving
+// the same database, so clients connected to different processes receive each
+// other's updates
+type Bus interface {
+	// Publish an event to all processes. Events must be delivered in the order
+	// they were published.
+	Publish(BusEvent) error
+
+	// Start passing events published by any process to fn
+	Subscribe(fn func(BusEvent)) error
+}
+
+// BusEventType identifies the feed update relayed by a BusEvent
+type BusEventType uint8
+
+// Feed updates relayed between processes
+const (
+	BusInsertPost BusEventType = iota
+	BusSetOpenBody
+	BusInsertImage
+	BusClosePost
+	BusSpoilerImage
+	BusInsertAttachment
+)
+
+// BusEvent is a thread feed update relayed through a Bus
+type BusEvent struct {
+	Type BusEventType `json:"type"`
+	// Process, that published the event
+	Node string `json:"node"`
+	// Thread and post the event applies to
+	OP uint64 `json:"op"`
+	ID uint64 `json:"id"`
+	// Encoded websocket message and its binary encoding, if any
+	Msg    []byte `json:"msg,omitempty"`
+	Binary []byte `json:"binary,omitempty"`
+	// Event type specific data
+	Body      string       `json:"body,omitempty"`
+	Spoilered bool         `json:"spoilered,omitempty"`
+	Post      *common.Post `json:"post,omitempty"`
+}
+
+// SetBus sets the Bus used for relaying feed updates between processes.
+// Must be called before Init. Defaults to Postgres LISTEN/NOTIFY or, if
+// EnablePGBus is unset, none.
+func SetBus(b Bus) {
+	bus = b
+}
+
+// Publish a feed update to other processes, if a bus is set
+func publish(e BusEvent) {
+	if bus == nil {
+		return
+	}
+	e.Node = nodeID
+	if err := bus.Publish(e); err != nil {
+		log.Errorf("feed bus: publishing: %s", err)
+	}
+}
+
+// Apply a feed update published by another process to the local feed of the
+// thread, if any
+func handleBusEvent(e BusEvent) {
+	if e.Node == nodeID {
+		return
+	}
+	sendIfExists(e.OP, func(f *Feed) error {
+		switch e.Type {
+		case BusInsertPost:
+			if e.Post != nil {
+				f._insertPost(*e.Post, e.Msg)
+			}
+		case BusSetOpenBody:
+			f._setOpenBody(e.ID, e.Body, e.Msg, e.Binary)
+		case BusInsertImage:
+			f._insertImage(e.ID, e.Spoilered, e.Msg)
+		case BusClosePost:
+			f._closePost(e.ID, e.Msg)
+		case BusSpoilerImage:
+			f._spoilerImage(e.ID, e.Msg)
+		case BusInsertAttachment:
+			f._insertAttachment(e.ID, e.Msg)
+		}
+		return nil
+	})
+}
+
+var errBusQueueFull = errors.New("send queue full")
+
+// Bus implementation using Postgres LISTEN/NOTIFY
+type pgBus struct {
+	queue chan string
+}
+
+func newPGBus() *pgBus {
+	b := &pgBus{
+		queue: make(chan string, 1024),
+	}
+	go b.sendLoop()
+	return b
+}
+
+// Publish queues an event for sending. A single sender preserves event order.
+// Events are dropped, if the queue is full, so a slow database does not block
+// the feeds.
+func (b *pgBus) Publish(e BusEvent) error {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	select {
+	case b.queue <- string(buf):
+		return nil
+	default:
+		return errBusQueueFull
+	}
+}
+
+func (b *pgBus) sendLoop() {
+	for msg := range b.queue {
+		if err := db.Notify(busChannel, msg); err != nil {
+			log.Errorf("feed bus: sending: %s", err)
+		}
+	}
+}
+
+func (b *pgBus) Subscribe(fn func(BusEvent)) error {
+	return db.Listen(busChannel, func(msg string) (err error) {
+		msg, err = db.ReadNotifyPayload(msg)
+		if err != nil {
+			return
+		}
+		var e BusEvent
+		err = json.Unmarshal([]byte(msg), &e)
+		if err != nil {
+			return
+		}
+		fn(e)
+		return
+	})
+}
@@ -0,0 +1,79 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
+)
+
+type mockBus struct {
+	published []BusEvent
+}
+
+func (b *mockBus) Publish(e BusEvent) error {
+	b.published = append(b.published, e)
+	return nil
+}
+
+func (b *mockBus) Subscribe(fn func(BusEvent)) error {
+	return nil
+}
+
+func TestPublishToBus(t *testing.T) {
+	var b mockBus
+	SetBus(&b)
+	defer SetBus(nil)
+
+	publish(BusEvent{
+		Type: BusClosePost,
+		OP:   1,
+		ID:   2,
+	})
+	AssertEquals(t, b.published, []BusEvent{
+		{
+			Type: BusClosePost,
+			Node: nodeID,
+			OP:   1,
+			ID:   2,
+		},
+	})
+}
+
+func TestPublishWithoutLocalFeed(t *testing.T) {
+	Clear()
+	var b mockBus
+	SetBus(&b)
+	defer SetBus(nil)
+
+	err := ClosePost(2, 1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	InsertPostInto(common.StandalonePost{
+		Post: common.Post{
+			ID: 3,
+		},
+		OP: 1,
+	}, nil)
+
+	AssertEquals(t, len(b.published), 2)
+	AssertEquals(t, b.published[0].Type, BusClosePost)
+	AssertEquals(t, b.published[0].ID, uint64(2))
+	AssertEquals(t, b.published[1].Type, BusInsertPost)
+	AssertEquals(t, b.published[1].ID, uint64(3))
+}
+
+func TestPGBusQueueFull(t *testing.T) {
+	t.Parallel()
+
+	// No sender, so the queue is never drained
+	b := pgBus{
+		queue: make(chan string, 1),
+	}
+	err := b.Publish(BusEvent{Type: BusClosePost})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEquals(t, b.Publish(BusEvent{Type: BusClosePost}), errBusQueueFull)
+}
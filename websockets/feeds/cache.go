@@ -35,11 +35,12 @@ func newThreadCache(id uint64) (c threadCache, err error) {
 	for _, p := range thread.Posts {
 		if p.Time > threshold {
 			c.Recent[p.ID] = cachedPost{
-				HasImage:  p.Image != nil,
-				Spoilered: p.Image != nil && p.Image.Spoiler,
-				Closed:    !p.Editing,
-				Time:      p.Time,
-				Body:      p.Body,
+				HasImage:    p.Image != nil,
+				Spoilered:   p.Image != nil && p.Image.Spoiler,
+				Attachments: uint8(len(p.Attachments)),
+				Closed:      !p.Editing,
+				Time:        p.Time,
+				Body:        p.Body,
 			}
 		}
 		if p.Moderated {
@@ -64,16 +65,21 @@ func (c *threadCache) evict() {
 
 // Message used for synchronizing clients to the feed state.
 type syncMessage struct {
+	// Identifies the feed instance, the sequence number belongs to
+	Epoch string `json:"epoch"`
+	// Sequence number of the last flushed message batch
+	Seq        uint64                              `json:"seq"`
 	Recent     map[uint64]cachedPost               `json:"recent"`
 	Moderation map[uint64][]common.ModerationEntry `json:"moderation"`
 }
 
 type cachedPost struct {
-	HasImage  bool   `json:"has_image"`
-	Spoilered bool   `json:"spoilered"`
-	Closed    bool   `json:"closed"`
-	Time      int64  `json:"-"`
-	Body      string `json:"body"`
+	HasImage    bool   `json:"has_image"`
+	Spoilered   bool   `json:"spoilered"`
+	Attachments uint8  `json:"attachments"`
+	Closed      bool   `json:"closed"`
+	Time        int64  `json:"-"`
+	Body        string `json:"body"`
 }
 
 // Generate a message for synchronizing to the current status of the update
@@ -1,10 +1,17 @@
 package feeds
 
 import (
-	"github.com/bakape/meguca/common"
 	"sync"
+	"time"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/db"
+	"github.com/go-playground/log"
 )
 
+// Interval of sampling the number of viewers of each board for statistics
+const viewerSampleInterval = time.Minute
+
 var (
 	// Clients stores all synchronized websocket clients in a thread-safe map
 	clients = struct {
@@ -70,6 +77,18 @@ func IPCount() int {
 // update feed, if any. If the client was already synced to another feed, it is
 // automatically unsubscribed.
 func SyncClient(cl common.Client, op uint64, board string) (*Feed, error) {
+	return ResumeClient(cl, op, board, "", 0)
+}
+
+// ResumeClient is like SyncClient, but only replays messages flushed by the
+// thread feed after the batch with sequence number seq. If those are no longer
+// retained, seq is zero or epoch does not match the current instance of the
+// feed, the client receives the full feed state instead.
+func ResumeClient(cl common.Client, op uint64, board, epoch string,
+	seq uint64,
+) (
+	*Feed, error,
+) {
 	clients.Lock()
 	old, ok := clients.clients[cl]
 	clients.clients[cl] = syncID{op, board}
@@ -78,7 +97,7 @@ func SyncClient(cl common.Client, op uint64, board string) (*Feed, error) {
 	if ok {
 		removeFromFeed(old.op, old.board, cl)
 	}
-	return addToFeed(op, board, cl)
+	return addToFeed(op, board, cl, epoch, seq)
 }
 
 // RemoveClient removes a client from the global client map and any subscribed
@@ -94,6 +113,7 @@ func RemoveClient(cl common.Client) {
 	if ok {
 		removeFromFeed(old.op, old.board, cl)
 	}
+	removeUploadWatcher(cl)
 }
 
 // GetSync returns if the client is synced and the thread and board it is
@@ -161,3 +181,35 @@ func All() []common.Client {
 	}
 	return cls
 }
+
+// Count unique IPs of clients synced to each board or its threads
+func countBoardViewers() map[string]int {
+	clients.RLock()
+	defer clients.RUnlock()
+
+	ips := make(map[string]map[string]struct{})
+	for cl, sync := range clients.clients {
+		set := ips[sync.board]
+		if set == nil {
+			set = make(map[string]struct{})
+			ips[sync.board] = set
+		}
+		set[cl.IP()] = struct{}{}
+	}
+
+	counts := make(map[string]int, len(ips))
+	for board, set := range ips {
+		counts[board] = len(set)
+	}
+	return counts
+}
+
+// Periodically record the number of viewers of each board for the peak viewer
+// statistics. Must be launched in a separate goroutine.
+func recordViewers() {
+	for range time.Tick(viewerSampleInterval) {
+		if err := db.RecordViewers(nodeID, countBoardViewers()); err != nil {
+			log.Errorf("recording board viewers: %s", err)
+		}
+	}
+}
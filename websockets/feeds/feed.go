@@ -3,13 +3,19 @@ package feeds
 import (
 	"time"
 
+	"github.com/bakape/meguca/auth"
 	"github.com/bakape/meguca/common"
 	"github.com/go-playground/log"
 )
 
+// Interval of recounting clients typing a post in a thread
+const presenceInterval = time.Second * 2
+
 type message struct {
 	id  uint64
 	msg []byte
+	// Binary encoding of msg, if any
+	binary []byte
 }
 
 type postCreationMessage struct {
@@ -32,6 +38,13 @@ type moderationMessage struct {
 	entry common.ModerationEntry
 }
 
+// Request to resume synchronisation from the last received message batch
+type resumeRequest struct {
+	client common.Client
+	epoch  string
+	seq    uint64
+}
+
 type syncCount struct {
 	Active int `json:"active"`
 	Total  int `json:"total"`
@@ -43,18 +56,32 @@ type Feed struct {
 	id uint64
 	// Message flushing ticker
 	ticker
+	// Periodically recounts clients typing a post. Paused together with the
+	// flushing ticker, unless clients are still typing.
+	presence ticker
 	// Common functionality
 	baseFeed
 	// Buffer of unsent messages
 	messageBuffer
 	// Entire thread cached into memory
 	cache threadCache
+	// Identifies this instance of the thread's feed. Sequence numbers are
+	// only meaningful within the same epoch.
+	epoch string
+	// Sequence number of the last flushed message batch
+	seq uint64
+	// Recently flushed message batches for replaying to resuming clients
+	replay replayBuffer
+	// Add a client resuming from a previously received message batch
+	resume chan resumeRequest
 	// Propagates mesages to all listeners
 	send chan []byte
 	// Insert a new post into the thread and propagate to listeners
 	insertPost chan postCreationMessage
 	// Insert an image into an already allocated post
 	insertImage chan imageInsertionMessage
+	// Attach a file after the image of an already allocated post
+	insertAttachment chan message
 	// Send message to close a post along with parsed post data
 	closePost chan message
 	// Send message to spoiler image of a specific post
@@ -65,6 +92,8 @@ type Feed struct {
 	moderatePost chan moderationMessage
 	// Let sent sync counter
 	lastSyncCount syncCount
+	// Last sent count of unique IPs typing a post
+	lastTypingCount int
 }
 
 // Start read existing posts into cache and start main loop
@@ -73,13 +102,25 @@ func (f *Feed) Start() (err error) {
 	if err != nil {
 		return
 	}
+	f.epoch, err = auth.RandomID(8)
+	if err != nil {
+		return
+	}
+	f.seq = initialSeq()
+	f.cache.Epoch = f.epoch
+	f.cache.Seq = f.seq
 
 	go func() {
 		// Stop the timer, if there are no messages and resume on new ones.
 		// Keeping the goroutine asleep reduces CPU usage.
 		f.start()
 		defer f.pause()
+		f.presence.interval = presenceInterval
+		f.presence.start()
+		defer f.presence.pause()
 
+		// Clients going idle do not produce any messages, so also recount
+		// active clients of idle feeds periodically
 		evictionTimer := time.NewTicker(time.Minute)
 		defer evictionTimer.Stop()
 
@@ -88,18 +129,39 @@ func (f *Feed) Start() (err error) {
 
 			case <-evictionTimer.C:
 				f.cache.evict()
+				f.sendIPCount()
+
+			// Typing clients stop typing without producing any messages
+			case <-f.presence.C:
+				f.sendIPCount()
+				if f.C == nil && f.lastTypingCount == 0 {
+					f.presence.pause()
+				}
 
 			// Add client
 			case c := <-f.add:
 				f.addClient(c)
-
-				msg, err := f.cache.getSyncMessage()
-				if err != nil {
-					log.Errorf("sync message: %s", err)
+				f.sendSyncMessage(c)
+				f.sendIPCount()
+				f.sendTypingCount(c)
+
+			// Add client and replay any missed message batches. Fall back to a
+			// full resync, if these are no longer retained or were flushed by
+			// a different feed instance, possibly on a different process.
+			case req := <-f.resume:
+				f.addClient(req.client)
+				batches, ok := f.replay.since(req.seq, f.seq)
+				if ok && req.epoch == f.epoch {
+					for _, b := range batches {
+						sendEncoded(req.client, b.text, b.binary)
+					}
+					msg, _ := common.EncodeMessage(common.MessageResume, f.seq)
+					req.client.Send(msg)
+				} else {
+					f.sendSyncMessage(req.client)
 				}
-				c.Send(msg)
-
 				f.sendIPCount()
+				f.sendTypingCount(req.client)
 
 			// Remove client and close feed, if no clients left
 			case c := <-f.remove:
@@ -115,10 +177,11 @@ func (f *Feed) Start() (err error) {
 
 			// Send any buffered messages to any listening clients
 			case <-f.C:
-				if buf := f.flush(); buf == nil {
+				if !f.flushBatch() {
 					f.pause()
-				} else {
-					f.sendToAll(buf)
+					if f.lastTypingCount == 0 {
+						f.presence.pause()
+					}
 				}
 
 			// Insert a new post, cache and propagate
@@ -126,11 +189,12 @@ func (f *Feed) Start() (err error) {
 				src := msg.post
 				f.modifyPost(msg.message, func(p *cachedPost) {
 					*p = cachedPost{
-						HasImage:  src.Image != nil,
-						Spoilered: src.Image != nil && src.Image.Spoiler,
-						Time:      src.Time,
-						Closed:    !src.Editing,
-						Body:      src.Body,
+						HasImage:    src.Image != nil,
+						Spoilered:   src.Image != nil && src.Image.Spoiler,
+						Attachments: uint8(len(src.Attachments)),
+						Time:        src.Time,
+						Closed:      !src.Editing,
+						Body:        src.Body,
 					}
 				})
 				// Post can be automatically deleted on insertion
@@ -151,6 +215,11 @@ func (f *Feed) Start() (err error) {
 					p.Spoilered = msg.spoilered
 				})
 
+			case msg := <-f.insertAttachment:
+				f.modifyPost(msg, func(p *cachedPost) {
+					p.Attachments++
+				})
+
 			case msg := <-f.spoilerImage:
 				f.modifyPost(msg, func(p *cachedPost) {
 					p.Spoilered = true
@@ -171,6 +240,7 @@ func (f *Feed) Start() (err error) {
 					case common.DeleteImage:
 						p.HasImage = false
 						p.Spoilered = false
+						p.Attachments = 0
 					case common.SpoilerImage:
 						p.Spoilered = true
 					}
@@ -184,6 +254,12 @@ func (f *Feed) Start() (err error) {
 	return
 }
 
+// Resume flushing messages and recounting typing clients, if paused
+func (f *Feed) startIfPaused() {
+	f.ticker.startIfPaused()
+	f.presence.startIfPaused()
+}
+
 func (f *Feed) modifyPost(msg message, fn func(*cachedPost)) {
 	f.startIfPaused()
 
@@ -192,11 +268,40 @@ func (f *Feed) modifyPost(msg message, fn func(*cachedPost)) {
 	f.cache.Recent[msg.id] = p
 
 	if msg.msg != nil {
-		f.write(msg.msg)
+		f.writeBinary(msg.msg, msg.binary)
 	}
 	f.cache.clearMemoized()
 }
 
+// Send the current feed state for synchronisation to a client
+func (f *Feed) sendSyncMessage(c common.Client) {
+	msg, err := f.cache.getSyncMessage()
+	if err != nil {
+		log.Errorf("sync message: %s", err)
+	}
+	c.Send(msg)
+}
+
+// Flush any buffered messages to all clients as a new sequence-numbered batch
+// and retain it for replaying. Returns false, if there was nothing to flush.
+func (f *Feed) flushBatch() bool {
+	if len(f.text) == 0 {
+		return false
+	}
+
+	f.seq++
+	msg, _ := common.EncodeMessage(common.MessageFeedSeq, f.seq)
+	f.write(msg)
+	b := flushedBatch{seq: f.seq}
+	b.text, b.binary = f.flush(f.hasBinaryClients())
+	f.replay.push(b)
+
+	f.cache.Seq = f.seq
+	f.cache.clearMemoized()
+	f.sendToAllEncoded(b.text, b.binary)
+	return true
+}
+
 // Send a message to all listening clients
 func (f *Feed) Send(msg []byte) {
 	f.send <- msg
@@ -208,10 +313,11 @@ func (f *Feed) bufferMessage(msg []byte) {
 	f.write(msg)
 }
 
-// Send unique IP count to all connected clients
+// Send unique IP and typing counts to all connected clients, if changed
 func (f *Feed) sendIPCount() {
 	var active int
 	ips := make(map[string]struct{}, len(f.clients))
+	typing := make(map[string]struct{})
 	pastHour := time.Now().Add(-time.Hour).Unix()
 
 	for c := range f.clients {
@@ -220,6 +326,16 @@ func (f *Feed) sendIPCount() {
 			active++
 		}
 		ips[ip] = struct{}{}
+		if p, ok := c.(common.PresenceClient); ok && p.Typing() {
+			typing[ip] = struct{}{}
+		}
+	}
+
+	if len(typing) != f.lastTypingCount {
+		f.lastTypingCount = len(typing)
+		msg, _ := common.EncodeMessage(common.MessageTypingCount,
+			f.lastTypingCount)
+		f.bufferMessage(msg)
 	}
 
 	new := syncCount{
@@ -233,9 +349,31 @@ func (f *Feed) sendIPCount() {
 	}
 }
 
+// Send the current typing count to a newly added client. Other clients only
+// receive changes.
+func (f *Feed) sendTypingCount(c common.Client) {
+	if f.lastTypingCount == 0 {
+		return
+	}
+	msg, _ := common.EncodeMessage(common.MessageTypingCount,
+		f.lastTypingCount)
+	c.Send(msg)
+}
+
 // InsertPost inserts a new post into the thread or reclaim an open post after disconnect
 // and propagate to listeners
 func (f *Feed) InsertPost(p common.Post, msg []byte) {
+	f._insertPost(p, msg)
+	publish(BusEvent{
+		Type: BusInsertPost,
+		OP:   f.id,
+		ID:   p.ID,
+		Msg:  msg,
+		Post: &p,
+	})
+}
+
+func (f *Feed) _insertPost(p common.Post, msg []byte) {
 	f.insertPost <- postCreationMessage{
 		message: message{
 			id:  p.ID,
@@ -247,6 +385,17 @@ func (f *Feed) InsertPost(p common.Post, msg []byte) {
 
 // InsertImage inserts an image into an already allocated post
 func (f *Feed) InsertImage(id uint64, spoilered bool, msg []byte) {
+	f._insertImage(id, spoilered, msg)
+	publish(BusEvent{
+		Type:      BusInsertImage,
+		OP:        f.id,
+		ID:        id,
+		Msg:       msg,
+		Spoilered: spoilered,
+	})
+}
+
+func (f *Feed) _insertImage(id uint64, spoilered bool, msg []byte) {
 	f.insertImage <- imageInsertionMessage{
 		message: message{
 			id:  id,
@@ -256,8 +405,37 @@ func (f *Feed) InsertImage(id uint64, spoilered bool, msg []byte) {
 	}
 }
 
+// InsertAttachment attaches a file after the image of an already allocated
+// post
+func (f *Feed) InsertAttachment(id uint64, msg []byte) {
+	f._insertAttachment(id, msg)
+	publish(BusEvent{
+		Type: BusInsertAttachment,
+		OP:   f.id,
+		ID:   id,
+		Msg:  msg,
+	})
+}
+
+func (f *Feed) _insertAttachment(id uint64, msg []byte) {
+	f.insertAttachment <- message{
+		id:  id,
+		msg: msg,
+	}
+}
+
 // ClosePost closes a feed's post
 func (f *Feed) ClosePost(id uint64, msg []byte) {
+	f._closePost(id, msg)
+	publish(BusEvent{
+		Type: BusClosePost,
+		OP:   f.id,
+		ID:   id,
+		Msg:  msg,
+	})
+}
+
+func (f *Feed) _closePost(id uint64, msg []byte) {
 	f.closePost <- message{
 		id:  id,
 		msg: msg,
@@ -266,7 +444,20 @@ func (f *Feed) ClosePost(id uint64, msg []byte) {
 
 // SpoilerImage spoilers a feed's image
 func (f *Feed) SpoilerImage(id uint64, msg []byte) {
-	f.spoilerImage <- message{id, msg}
+	f._spoilerImage(id, msg)
+	publish(BusEvent{
+		Type: BusSpoilerImage,
+		OP:   f.id,
+		ID:   id,
+		Msg:  msg,
+	})
+}
+
+func (f *Feed) _spoilerImage(id uint64, msg []byte) {
+	f.spoilerImage <- message{
+		id:  id,
+		msg: msg,
+	}
 }
 
 func (f *Feed) _moderatePost(id uint64, msg []byte,
@@ -281,12 +472,26 @@ func (f *Feed) _moderatePost(id uint64, msg []byte,
 	}
 }
 
-// SetOpenBody sets the body of an open post and send update message to clients
-func (f *Feed) SetOpenBody(id uint64, body string, msg []byte) {
+// SetOpenBody sets the body of an open post and send update message to clients.
+// bin is the optional binary encoding of msg.
+func (f *Feed) SetOpenBody(id uint64, body string, msg, bin []byte) {
+	f._setOpenBody(id, body, msg, bin)
+	publish(BusEvent{
+		Type:   BusSetOpenBody,
+		OP:     f.id,
+		ID:     id,
+		Msg:    msg,
+		Binary: bin,
+		Body:   body,
+	})
+}
+
+func (f *Feed) _setOpenBody(id uint64, body string, msg, bin []byte) {
 	f.setOpenBody <- postBodyModMessage{
 		message: message{
-			id:  id,
-			msg: msg,
+			id:     id,
+			msg:    msg,
+			binary: bin,
 		},
 		body: body,
 	}
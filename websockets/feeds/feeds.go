@@ -4,18 +4,30 @@
 package feeds
 
 import (
+	"database/sql"
 	"errors"
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/db"
 	"sync"
 )
 
-// Contains and manages all active update feeds
-var feeds = feedMap{
-	// 64 len map to avoid some possible reallocation as the server starts
-	feeds:   make(map[uint64]*Feed, 64),
-	tvFeeds: make(map[string]*tvFeed, 64),
-}
+var (
+	// Contains and manages all active update feeds
+	feeds = feedMap{
+		// 64 len map to avoid some possible reallocation as the server starts
+		feeds:      make(map[uint64]*Feed, 64),
+		tvFeeds:    make(map[string]*tvFeed, 64),
+		boardFeeds: make(map[string]*boardFeed, 64),
+	}
+
+	// Clients to push the status of finished upload jobs to by job ID
+	uploadWatchers = struct {
+		sync.Mutex
+		watchers map[string]common.Client
+	}{
+		watchers: make(map[string]common.Client),
+	}
+)
 
 // Export to avoid circular dependency
 func init() {
@@ -25,14 +37,20 @@ func init() {
 
 // Container for managing client<->update-feed assignment and interaction
 type feedMap struct {
-	feeds   map[uint64]*Feed
-	tvFeeds map[string]*tvFeed
-	mu      sync.RWMutex
+	feeds      map[uint64]*Feed
+	tvFeeds    map[string]*tvFeed
+	boardFeeds map[string]*boardFeed
+	mu         sync.RWMutex
 }
 
 // Add client to feed and send it the current status of the feed for
-// synchronization to the feed's internal state
-func addToFeed(id uint64, board string, c common.Client) (
+// synchronization to the feed's internal state. Clients synced to a board page
+// are added to the board's feed.
+// If seq is not zero, the client resumes from the message batch with this
+// sequence number of the feed epoch.
+func addToFeed(id uint64, board string, c common.Client, epoch string,
+	seq uint64,
+) (
 	feed *Feed, err error,
 ) {
 	feeds.mu.Lock()
@@ -44,15 +62,20 @@ func addToFeed(id uint64, board string, c common.Client) (
 		feed, ok = feeds.feeds[id]
 		if !ok {
 			feed = &Feed{
-				id:            id,
-				send:          make(chan []byte),
-				insertPost:    make(chan postCreationMessage),
-				closePost:     make(chan message),
-				spoilerImage:  make(chan message),
-				moderatePost:  make(chan moderationMessage),
-				setOpenBody:   make(chan postBodyModMessage),
-				insertImage:   make(chan imageInsertionMessage),
-				messageBuffer: make([]string, 0, 64),
+				id:               id,
+				send:             make(chan []byte),
+				insertPost:       make(chan postCreationMessage),
+				closePost:        make(chan message),
+				spoilerImage:     make(chan message),
+				moderatePost:     make(chan moderationMessage),
+				setOpenBody:      make(chan postBodyModMessage),
+				insertImage:      make(chan imageInsertionMessage),
+				insertAttachment: make(chan message),
+				resume:           make(chan resumeRequest),
+				messageBuffer: messageBuffer{
+					text:   make([]string, 0, 64),
+					binary: make([][]byte, 0, 64),
+				},
 			}
 
 			feed.baseFeed.init()
@@ -62,7 +85,23 @@ func addToFeed(id uint64, board string, c common.Client) (
 				return
 			}
 		}
-		feed.add <- c
+		if seq != 0 {
+			feed.resume <- resumeRequest{
+				client: c,
+				epoch:  epoch,
+				seq:    seq,
+			}
+		} else {
+			feed.add <- c
+		}
+	} else {
+		bf, ok := feeds.boardFeeds[board]
+		if !ok {
+			bf = newBoardFeed(board)
+			feeds.boardFeeds[board] = bf
+			bf.start()
+		}
+		bf.add <- c
 	}
 
 	return
@@ -106,6 +145,15 @@ func removeFromFeed(id uint64, board string, c common.Client) {
 		}
 	}
 
+	if id == 0 {
+		if feed := feeds.boardFeeds[board]; feed != nil {
+			feed.remove <- c
+			if nil != <-feed.remove {
+				delete(feeds.boardFeeds, feed.board)
+			}
+		}
+	}
+
 	if feed := feeds.tvFeeds[board]; feed != nil {
 		feed.remove <- c
 		if nil != <-feed.remove {
@@ -134,15 +182,24 @@ func sendIfExists(id uint64, fn func(*Feed) error) error {
 }
 
 // InsertPostInto inserts a post into a tread feed, if it exists. Only use for
-// already closed posts.
+// already closed posts. The post is relayed to other processes regardless, as
+// they may have a feed of the thread.
 func InsertPostInto(post common.StandalonePost, msg []byte) {
 	sendIfExists(post.OP, func(f *Feed) error {
-		f.InsertPost(post.Post, msg)
+		f._insertPost(post.Post, msg)
 		return nil
 	})
+	publish(BusEvent{
+		Type: BusInsertPost,
+		OP:   post.OP,
+		ID:   post.ID,
+		Msg:  msg,
+		Post: &post.Post,
+	})
 }
 
-// ClosePost closes a post in a feed, if it exists
+// ClosePost closes a post in a feed, if it exists, and relays it to other
+// processes
 func ClosePost(id, op uint64, links []common.Link, commands []common.Command,
 ) (err error) {
 	msg, err := common.EncodeMessage(common.MessageClosePost, struct {
@@ -159,15 +216,42 @@ func ClosePost(id, op uint64, links []common.Link, commands []common.Command,
 	}
 
 	sendIfExists(op, func(f *Feed) error {
-		f.ClosePost(id, msg)
+		f._closePost(id, msg)
 		return nil
 	})
+	publish(BusEvent{
+		Type: BusClosePost,
+		OP:   op,
+		ID:   id,
+		Msg:  msg,
+	})
 
 	return
 }
 
 // Initialize internal runtime
 func Init() (err error) {
+	if bus == nil && EnablePGBus {
+		bus = newPGBus()
+	}
+	if bus != nil {
+		err = bus.Subscribe(handleBusEvent)
+		if err != nil {
+			return
+		}
+	}
+	err = db.Listen("board_feed", handleBoardFeedEvent)
+	if err != nil {
+		return
+	}
+	err = db.Listen("upload_job_finished", handleUploadJobFinished)
+	if err != nil {
+		return
+	}
+	if !common.IsTest {
+		go recordViewers()
+	}
+
 	return db.Listen("post_moderated", func(msg string) (err error) {
 		return handlePostModeration(msg)
 	})
@@ -199,9 +283,82 @@ func handlePostModeration(msg string) (err error) {
 	})
 }
 
+// WatchUploadJob pushes the status of an upload job to the client, once the
+// job finishes. Only the uploader receives the job ID, so knowing it proves
+// the client created the job.
+func WatchUploadJob(id string, cl common.Client) (err error) {
+	uploadWatchers.Lock()
+	uploadWatchers.watchers[id] = cl
+	uploadWatchers.Unlock()
+
+	// The job might have finished before the client started watching it
+	j, err := db.GetUploadJob(id)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		popUploadWatcher(id)
+		return nil
+	default:
+		return
+	}
+	switch j.State {
+	case db.UploadDone, db.UploadFailed:
+		return handleUploadJobFinished(id)
+	}
+	return
+}
+
+// Remove and return the client watching an upload job, if any
+func popUploadWatcher(id string) common.Client {
+	uploadWatchers.Lock()
+	defer uploadWatchers.Unlock()
+
+	cl := uploadWatchers.watchers[id]
+	delete(uploadWatchers.watchers, id)
+	return cl
+}
+
+// Stop pushing the status of any upload jobs to a removed client
+func removeUploadWatcher(cl common.Client) {
+	uploadWatchers.Lock()
+	defer uploadWatchers.Unlock()
+
+	for id, w := range uploadWatchers.watchers {
+		if w == cl {
+			delete(uploadWatchers.watchers, id)
+		}
+	}
+}
+
+// Push the status of a finished upload job to the client watching it, if any.
+// Separate function for testing.
+func handleUploadJobFinished(id string) (err error) {
+	cl := popUploadWatcher(id)
+	if cl == nil {
+		return
+	}
+
+	j, err := db.GetUploadJob(id)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil
+	default:
+		return
+	}
+
+	msg, err := common.EncodeMessage(common.MessageUploadStatus, j.Status())
+	if err != nil {
+		return
+	}
+	cl.Send(msg)
+	return
+}
+
 // Clear removes all existing feeds and clients. Used only in tests.
 func Clear() {
 	feeds.mu.Lock()
 	defer feeds.mu.Unlock()
 	feeds.feeds = make(map[uint64]*Feed, 32)
+	feeds.boardFeeds = make(map[string]*boardFeed, 32)
 }
@@ -1,6 +1,8 @@
 package feeds
 
 import (
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
 	"github.com/bakape/meguca/test"
 	"github.com/bakape/meguca/test/test_db"
@@ -30,9 +32,25 @@ func TestWriteMultipleToBuffer(t *testing.T) {
 	f.write([]byte("b"))
 
 	const std = "33[\"a\",\"b\"]"
-	if s := string(f.flush()); s != std {
+	text, bin := f.flush(true)
+	if s := string(text); s != std {
 		test.LogUnexpected(t, std, s)
 	}
+	test.AssertBufferEquals(t, bin, []byte{
+		byte(common.MessageConcat),
+		1, 'a',
+		1, 'b',
+	})
+
+	// No binary encoding without any binary clients
+	f.write([]byte("a"))
+	text, bin = f.flush(false)
+	if s := string(text); s != "33[\"a\"]" {
+		test.LogUnexpected(t, "33[\"a\"]", s)
+	}
+	if bin != nil {
+		t.Fatalf("unexpected binary encoding: %v", bin)
+	}
 }
 
 func TestHandleModeration(t *testing.T) {
@@ -41,3 +59,169 @@ func TestHandleModeration(t *testing.T) {
 	test_db.WriteSampleBoard(t)
 	test_db.WriteSampleThread(t)
 }
+
+type mockClient struct {
+	sent [][]byte
+}
+
+func (c *mockClient) Send(msg []byte) {
+	c.sent = append(c.sent, msg)
+}
+
+func (c *mockClient) Redirect(board string) {}
+
+func (c *mockClient) IP() string {
+	return "::1"
+}
+
+func (c *mockClient) LastTime() int64 {
+	return 0
+}
+
+func (c *mockClient) Close(error) {}
+
+func TestUploadJobWatching(t *testing.T) {
+	test_db.ClearTables(t, "upload_jobs")
+
+	var ids [3]string
+	for i := range ids {
+		var err error
+		ids[i], err = db.InsertUploadJob("::1", 1, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	finish := func(id string) {
+		t.Helper()
+		err := db.FinishUploadJob(id, "foo", "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = handleUploadJobFinished(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	watch := func(id string, cl common.Client) {
+		t.Helper()
+		err := WatchUploadJob(id, cl)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertStatus := func(cl *mockClient, id string) {
+		t.Helper()
+		test.AssertEquals(t, len(cl.sent), 1)
+		msg, err := common.EncodeMessage(common.MessageUploadStatus,
+			common.UploadStatus{
+				ID:    id,
+				State: db.UploadDone,
+				Token: "foo",
+			})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertBufferEquals(t, cl.sent[0], msg)
+	}
+
+	t.Run("finished before watching", func(t *testing.T) {
+		var cl mockClient
+		finish(ids[0])
+		watch(ids[0], &cl)
+		assertStatus(&cl, ids[0])
+	})
+
+	t.Run("finished after watching", func(t *testing.T) {
+		var cl mockClient
+		watch(ids[1], &cl)
+		test.AssertEquals(t, len(cl.sent), 0)
+		finish(ids[1])
+		assertStatus(&cl, ids[1])
+	})
+
+	t.Run("removed client", func(t *testing.T) {
+		var cl mockClient
+		watch(ids[2], &cl)
+		RemoveClient(&cl)
+		finish(ids[2])
+		test.AssertEquals(t, len(cl.sent), 0)
+	})
+}
+
+func TestHandleBoardFeedEvent(t *testing.T) {
+	Clear()
+	defer Clear()
+
+	old := *config.Get()
+	t.Cleanup(func() {
+		config.Set(old)
+	})
+	config.Set(config.Configs{
+		HideNSFW: true,
+	})
+	boards := [...]config.BoardConfigs{
+		{
+			ID: "a",
+		},
+		{
+			ID: "c",
+			BoardPublic: config.BoardPublic{
+				NSFW: true,
+			},
+		},
+	}
+	for _, b := range boards {
+		_, err := config.SetBoardConfigs(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Unstarted feeds with buffered channels, so sent messages can be read
+	// back
+	subscribed := make(map[string]*boardFeed)
+	feeds.mu.Lock()
+	for _, b := range [...]string{"a", "c", "all"} {
+		f := &boardFeed{
+			board: b,
+			send:  make(chan []byte, 1),
+		}
+		feeds.boardFeeds[b] = f
+		subscribed[b] = f
+	}
+	feeds.mu.Unlock()
+
+	cases := [...]struct {
+		name, board string
+		// Boards, whose feeds receive the event
+		received []string
+	}{
+		{"board and all", "a", []string{"a", "all"}},
+		{"hide NSFW from all", "c", []string{"c"}},
+		{"board without feed", "b", []string{"all"}},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			msg := `{"type":"bump","id":1,"board":"` + c.board + `"}`
+			err := handleBoardFeedEvent(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			std := common.PrependMessageType(common.MessageBoardFeed,
+				[]byte(msg))
+			received := make([]string, 0, len(c.received))
+			for _, b := range [...]string{"a", "c", "all"} {
+				select {
+				case buf := <-subscribed[b].send:
+					test.AssertBufferEquals(t, buf, std)
+					received = append(received, b)
+				default:
+				}
+			}
+			test.AssertEquals(t, received, c.received)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package feeds
+
+import (
+	"time"
+)
+
+// Number of flushed message batches retained for replaying to resuming
+// clients. At most one batch is flushed per TickerInterval, so this covers at
+// least a minute of missed messages.
+const replayBufferSize = int(time.Minute / TickerInterval)
+
+// Message batch flushed to all clients of a feed
+type flushedBatch struct {
+	seq          uint64
+	text, binary []byte
+}
+
+// Bounded ring of recently flushed message batches with consecutive sequence
+// numbers
+type replayBuffer struct {
+	batches []flushedBatch
+	// Position of the oldest batch, once the ring is full
+	start int
+}
+
+// Initial sequence number of a feed. Derived from the current time, so
+// sequence numbers of a recreated feed never overlap with the ones sent by a
+// previous feed of the same thread. Stays within the integer precision of
+// JS numbers.
+func initialSeq() uint64 {
+	return uint64(time.Now().UnixNano() / int64(time.Millisecond))
+}
+
+// Retain a batch, overwriting the oldest one, if full
+func (r *replayBuffer) push(b flushedBatch) {
+	if len(r.batches) < replayBufferSize {
+		r.batches = append(r.batches, b)
+		return
+	}
+	r.batches[r.start] = b
+	r.start = (r.start + 1) % len(r.batches)
+}
+
+// Returns the batch at position i counting from the oldest one
+func (r *replayBuffer) at(i int) flushedBatch {
+	return r.batches[(r.start+i)%len(r.batches)]
+}
+
+// Returns all batches flushed after last in order. current is the sequence
+// number of the last flushed batch. ok is false, if any batch following last
+// is no longer retained and the client must do a full resync.
+func (r *replayBuffer) since(last, current uint64) (
+	batches []flushedBatch, ok bool,
+) {
+	switch {
+	case last == current:
+		return nil, true
+	case last > current, len(r.batches) == 0:
+		return nil, false
+	}
+
+	oldest := r.at(0).seq
+	if last+1 < oldest {
+		return nil, false
+	}
+
+	i := int(last + 1 - oldest)
+	batches = make([]flushedBatch, 0, len(r.batches)-i)
+	for ; i < len(r.batches); i++ {
+		batches = append(batches, r.at(i))
+	}
+	return batches, true
+}
@@ -0,0 +1,57 @@
+package feeds
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestReplayBuffer(t *testing.T) {
+	t.Parallel()
+
+	var r replayBuffer
+	const first = 1000
+	for i := 0; i < replayBufferSize+10; i++ {
+		r.push(flushedBatch{seq: first + uint64(i)})
+	}
+	last := uint64(first + replayBufferSize + 9)
+	oldest := last - uint64(replayBufferSize) + 1
+
+	cases := [...]struct {
+		name string
+		seq  uint64
+		ok   bool
+		n    int
+	}{
+		{"up to date", last, true, 0},
+		{"one missed", last - 1, true, 1},
+		{"oldest retained", oldest, true, replayBufferSize - 1},
+		{"all retained", oldest - 1, true, replayBufferSize},
+		{"too old", oldest - 2, false, 0},
+		{"from the future", last + 1, false, 0},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			batches, ok := r.since(c.seq, last)
+			AssertEquals(t, ok, c.ok)
+			AssertEquals(t, len(batches), c.n)
+			for j, b := range batches {
+				AssertEquals(t, b.seq, c.seq+1+uint64(j))
+			}
+		})
+	}
+}
+
+func TestReplayBufferEmpty(t *testing.T) {
+	t.Parallel()
+
+	var r replayBuffer
+	_, ok := r.since(1, 2)
+	AssertEquals(t, ok, false)
+	_, ok = r.since(2, 2)
+	AssertEquals(t, ok, true)
+}
@@ -1,8 +1,9 @@
 package feeds
 
 import (
-	"github.com/bakape/meguca/common"
 	"time"
+
+	"github.com/bakape/meguca/common"
 )
 
 // TickerInterval sets the interval of ticker flushes
@@ -11,12 +12,18 @@ const TickerInterval = time.Millisecond * 100
 // A time.Ticker that can be "paused". Pausing a ticker can save a considerable
 // amount of CPU cycles, if you have an external wakeup source.
 type ticker struct {
-	t *time.Ticker
-	C <-chan time.Time
+	// Defaults to TickerInterval, if zero
+	interval time.Duration
+	t        *time.Ticker
+	C        <-chan time.Time
 }
 
 func (t *ticker) start() {
-	t.t = time.NewTicker(TickerInterval)
+	interval := t.interval
+	if interval == 0 {
+		interval = TickerInterval
+	}
+	t.t = time.NewTicker(interval)
 	t.C = t.t.C
 }
 
@@ -32,22 +39,41 @@ func (t *ticker) startIfPaused() {
 }
 
 // messageBuffer provides bufferring and concatenation for post update messages
-type messageBuffer []string
+type messageBuffer struct {
+	text []string
+	// Binary encoded counterparts of text. Messages without a binary encoding
+	// are stored in their text form.
+	binary [][]byte
+}
 
 // Write writes a message to b
 func (b *messageBuffer) write(data []byte) {
-	*b = append(*b, string(data))
+	b.writeBinary(data, nil)
 }
 
-// Flush flushes b into into a []byte and returns it.
-// If no messages are stored, the returned buffer is nil.
-func (b *messageBuffer) flush() []byte {
-	if len(*b) == 0 {
-		return nil
+// Write writes a message and its binary encoding, if any, to b
+func (b *messageBuffer) writeBinary(data, bin []byte) {
+	b.text = append(b.text, string(data))
+	if bin == nil {
+		bin = data
 	}
-	buf, _ := common.EncodeMessage(common.MessageConcat, *b)
-	*b = (*b)[:0]
-	return buf
+	b.binary = append(b.binary, bin)
+}
+
+// Flush flushes b into into a text and, if binary is true, a binary encoded
+// []byte and returns them. If no messages are stored, the returned buffers
+// are nil.
+func (b *messageBuffer) flush(binary bool) (text, bin []byte) {
+	if len(b.text) == 0 {
+		return
+	}
+	text, _ = common.EncodeMessage(common.MessageConcat, b.text)
+	if binary {
+		bin = common.EncodeBinaryConcat(b.binary)
+	}
+	b.text = b.text[:0]
+	b.binary = b.binary[:0]
+	return
 }
 
 // Embed for basic client event dispatching functionality
@@ -89,3 +115,32 @@ func (b *baseFeed) sendToAll(msg []byte) {
 		c.Send(msg)
 	}
 }
+
+// Returns, if any connected client has negotiated the binary protocol
+func (b *baseFeed) hasBinaryClients() bool {
+	for c := range b.clients {
+		if bc, ok := c.(common.BinaryClient); ok && bc.BinaryProtocol() {
+			return true
+		}
+	}
+	return false
+}
+
+// Send a message to all connected clients in the encoding negotiated by each
+// client
+func (b *baseFeed) sendToAllEncoded(text, bin []byte) {
+	for c := range b.clients {
+		sendEncoded(c, text, bin)
+	}
+}
+
+// Send a message to a client in the encoding negotiated by the client. Falls
+// back to text, if bin is nil.
+func sendEncoded(c common.Client, text, bin []byte) {
+	if bc, ok := c.(common.BinaryClient); ok && bin != nil &&
+		bc.BinaryProtocol() {
+		bc.SendBinary(bin)
+	} else {
+		c.Send(text)
+	}
+}
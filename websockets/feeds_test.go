@@ -1,8 +1,14 @@
 package websockets
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bakape/meguca/common"
+	. "github.com/bakape/meguca/test"
 	"github.com/bakape/meguca/test/test_db"
 	"github.com/bakape/meguca/websockets/feeds"
+	"github.com/gorilla/websocket"
 	"testing"
 )
 
@@ -19,12 +25,101 @@ func TestStreamUpdates(t *testing.T) {
 	registerClient(t, cl, 1, "a")
 	go readListenErrors(t, cl, sv)
 
-	assertMessage(t, wcl, `30{"recent":{},"moderation":{}}`)
-	assertMessage(t, wcl, "33[\"35{\\\"active\\\":0,\\\"total\\\":1}\"]")
+	// Epochs are random and sequence numbers are derived from the feed
+	// creation time
+	sync := readSyncMessage(t, wcl)
+	std := fmt.Sprintf(`30{"epoch":"%s","seq":%d,"recent":{},"moderation":{}}`,
+		sync.Epoch, sync.Seq)
+	msg := sync.msg
+	if s := string(msg); s != std {
+		LogUnexpected(t, std, s)
+	}
+	assertMessage(t, wcl, fmt.Sprintf(
+		"33[\"35{\\\"active\\\":0,\\\"total\\\":1}\",\"42%d\"]",
+		sync.Seq+1,
+	))
 
 	// Send message
 	feeds.SendTo(1, []byte("foo"))
-	assertMessage(t, wcl, "33[\"foo\"]")
+	assertMessage(t, wcl, fmt.Sprintf("33[\"foo\",\"42%d\"]", sync.Seq+2))
+
+	cl.Close(nil)
+	sv.Wait()
+}
+
+type feedSyncMessage struct {
+	Epoch string
+	Seq   uint64
+	msg   []byte
+}
+
+// Read and decode a thread feed synchronisation message
+func readSyncMessage(t *testing.T, conn *websocket.Conn) (sync feedSyncMessage) {
+	t.Helper()
+
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = json.Unmarshal(msg[2:], &sync)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sync.msg = msg
+	return
+}
+
+func TestResumeFeed(t *testing.T) {
+	feeds.Clear()
+	test_db.ClearTables(t, "boards")
+	test_db.WriteSampleBoard(t)
+	test_db.WriteSampleThread(t)
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	sv.Add(1)
+	cl, wcl := sv.NewClient()
+	registerClient(t, cl, 1, "a")
+	go readListenErrors(t, cl, sv)
+	sync := readSyncMessage(t, wcl)
+
+	// Wait for the first batch to be flushed, so the sequence number does not
+	// change during the test
+	seq := sync.Seq + 1
+	assertMessage(t, wcl, fmt.Sprintf(
+		"33[\"35{\\\"active\\\":0,\\\"total\\\":1}\",\"42%d\"]",
+		seq,
+	))
+
+	cases := [...]struct {
+		name, epoch string
+		resumed     bool
+	}{
+		{"same epoch", sync.Epoch, true},
+		{"different epoch", "foo", false},
+	}
+
+	for i := range cases {
+		c := cases[i]
+		t.Run(c.name, func(t *testing.T) {
+			sv.Add(1)
+			cl, wcl := sv.NewClient()
+			var err error
+			cl.feed, err = feeds.ResumeClient(cl, 1, "a", c.epoch, seq)
+			if err != nil {
+				t.Fatal(err)
+			}
+			go readListenErrors(t, cl, sv)
+
+			if c.resumed {
+				assertMessage(t, wcl, fmt.Sprintf("%s%d",
+					encodeMessageType(common.MessageResume), seq))
+			} else {
+				AssertEquals(t, readSyncMessage(t, wcl).Epoch, sync.Epoch)
+			}
+			cl.Close(nil)
+		})
+	}
 
 	cl.Close(nil)
 	sv.Wait()
@@ -9,8 +9,8 @@ import (
 	"github.com/bakape/meguca/websockets/feeds"
 )
 
-// Decode message JSON into the supplied type. Will augment, once we switch to
-// a binary message protocol.
+// Decode message JSON into the supplied type. Binary messages are decoded
+// per type in runBinaryHandler.
 func decodeMessage(data []byte, dest interface{}) error {
 	return json.Unmarshal(data, dest)
 }
@@ -42,7 +42,52 @@ func (c *Client) runHandler(typ common.MessageType, msg []byte) error {
 		return c.spoilerImage()
 	case common.MessageMeguTV:
 		return feeds.SubscribeToMeguTV(c)
+	case common.MessageUploadStatus:
+		return c.watchUploadJob(data)
 	default:
 		return errInvalidPayload(msg)
 	}
 }
+
+// Run the appropriate handler for a binary encoded websocket message. Only the
+// high frequency post body update messages have a binary encoding.
+func (c *Client) runBinaryHandler(typ common.MessageType, data []byte) (
+	err error,
+) {
+	switch typ {
+	case common.MessageAppend:
+		var char rune
+		char, err = common.DecodeBinaryAppend(data)
+		if err != nil {
+			return
+		}
+		return c.appendChar(char)
+	case common.MessageBackspace:
+		if len(data) != 0 {
+			return common.ErrInvalidBinaryMessage
+		}
+		return c.backspace()
+	case common.MessageSplice:
+		var req spliceRequestString
+		req.Start, req.Len, req.Text, err = common.DecodeBinarySplice(data)
+		if err != nil {
+			return
+		}
+		return c.splice(spliceRequest{
+			spliceCoords: req.spliceCoords,
+			Text:         []rune(req.Text),
+		})
+	default:
+		return errInvalidPayload(append([]byte{byte(typ)}, data...))
+	}
+}
+
+// Receive the status of an upload job created by the client, once it finishes
+func (c *Client) watchUploadJob(data []byte) (err error) {
+	var id string
+	err = decodeMessage(data, &id)
+	if err != nil {
+		return
+	}
+	return feeds.WatchUploadJob(id, c)
+}
@@ -1,35 +1,69 @@
 package websockets
 
 import (
-	"github.com/bakape/meguca/common"
+	"time"
 	"unicode/utf8"
+
+	"github.com/bakape/meguca/common"
+	"github.com/bakape/meguca/config"
 )
 
 // Data of a post currently being written to by a Client
 type openPost struct {
 	hasImage, isSpoilered bool
-	len, lines            int
-	id, op                uint64
-	time                  int64
-	body                  []byte
-	board                 string
+	// Number of files attached after the image
+	attachments int
+	// Client has been warned about the post being closed automatically
+	warned     bool
+	len, lines int
+	id, op     uint64
+	time       int64
+	// Unix time of the last edit to the post body
+	lastEdit int64
+	// Unix time until which the post can be kept open past the board's
+	// maximum open and idle times, if it has been recovered after a server
+	// restart
+	recoverUntil int64
+	body         []byte
+	board        string
 }
 
 // Initialize a new open post from a post struct
 func (o *openPost) init(p common.StandalonePost) {
 	*o = openPost{
-		id:    p.ID,
-		op:    p.OP,
-		time:  p.Time,
-		board: p.Board,
-		len:   utf8.RuneCountInString(p.Body),
-		body:  append(make([]byte, 0, 1<<10), p.Body...),
+		id:       p.ID,
+		op:       p.OP,
+		time:     p.Time,
+		lastEdit: p.Time,
+		board:    p.Board,
+		len:      utf8.RuneCountInString(p.Body),
+		body:     append(make([]byte, 0, 1<<10), p.Body...),
 	}
 	o.countLines()
 	if p.Image != nil {
 		o.hasImage = true
 		o.isSpoilered = p.Image.Spoiler
 	}
+	o.attachments = len(p.Attachments)
+}
+
+// Returns, if another file can be attached to the post after its image
+func (o *openPost) canAttach() bool {
+	return o.attachments+1 < config.GetBoardConfigs(o.board).AttachmentLimit()
+}
+
+// Returns the Unix time, at which the post is closed automatically
+func (o *openPost) deadline() int64 {
+	d := config.GetBoardConfigs(o.board).OpenPostDeadline(o.time, o.lastEdit)
+	if o.recoverUntil > d {
+		d = o.recoverUntil
+	}
+	return d
+}
+
+// Returns, if the post has exceeded the maximum time it can be kept open
+func (o *openPost) expired() bool {
+	return time.Now().Unix() >= o.deadline()
 }
 
 // Count amount of lines in the post body
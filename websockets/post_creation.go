@@ -32,8 +32,9 @@ type ThreadCreationRequest struct {
 // ReplyCreationRequest contains common fields for both thread and reply
 // creation
 type ReplyCreationRequest struct {
-	Sage, Open bool
-	Image      ImageRequest
+	Sage, Open  bool
+	Image       ImageRequest
+	Attachments []ImageRequest
 	auth.SessionCreds
 	Name, Password, Body string
 }
@@ -84,6 +85,10 @@ func CreateThread(req ThreadCreationRequest, ip string) (
 			if err != nil {
 				return
 			}
+			err = insertAttachments(tx, req.Attachments, conf, &post)
+			if err != nil {
+				return
+			}
 		}
 		return
 	})
@@ -114,6 +119,37 @@ func insertImage(tx *sql.Tx, req ImageRequest, p *db.Post) (err error) {
 	return
 }
 
+// Insert files attached after the image on post creation. Files over the
+// board's attachment limit are ignored.
+func insertAttachments(tx *sql.Tx, reqs []ImageRequest,
+	conf config.BoardConfigs, p *db.Post,
+) (err error) {
+	if max := conf.AttachmentLimit() - 1; len(reqs) > max {
+		reqs = reqs[:max]
+	}
+	for _, req := range reqs {
+		if req.Token == "" || req.Name == "" {
+			continue
+		}
+		err = formatImageName(&req.Name)
+		if err != nil {
+			return
+		}
+		buf, err := db.InsertAttachment(tx, p.ID, req.Token, req.Name,
+			req.Spoiler)
+		if err != nil {
+			return err
+		}
+		var img common.Image
+		err = json.Unmarshal(buf, &img)
+		if err != nil {
+			return err
+		}
+		p.Attachments = append(p.Attachments, img)
+	}
+	return
+}
+
 // CreatePost creates a new post and writes it to the database.
 // open specifies, if the post should stay open after creation.
 func CreatePost(
@@ -170,6 +206,10 @@ func CreatePost(
 			if err != nil {
 				return
 			}
+			err = insertAttachments(tx, req.Attachments, conf, &post)
+			if err != nil {
+				return
+			}
 		}
 
 		return
@@ -221,6 +261,7 @@ func (c *Client) insertPost(data []byte) (err error) {
 			return
 		}
 		c.post.init(post.StandalonePost)
+		c.setTyping()
 	}
 
 	c.feed.InsertPost(post.StandalonePost.Post, msg)
@@ -286,19 +327,8 @@ func constructPost(
 		post.Flag = geoip.LookUp(ip)
 	}
 
-	if utf8.RuneCountInString(req.Body) > common.MaxLenBody {
-		err = common.ErrBodyTooLong
-		return
-	}
-
-	lines := 0
-	for _, r := range req.Body {
-		if r == '\n' {
-			lines++
-		}
-	}
-	if lines > common.MaxLinesBody {
-		err = errTooManyLines
+	err = validateBody(req.Body)
+	if err != nil {
 		return
 	}
 
@@ -382,3 +412,20 @@ func trackPostEngagement(c *Client) {
 	db.TrackEngagement(engagement)
 	return
 }
+
+// Validate the length and line count of a post body
+func validateBody(body string) error {
+	if utf8.RuneCountInString(body) > common.MaxLenBody {
+		return common.ErrBodyTooLong
+	}
+	lines := 0
+	for _, r := range body {
+		if r == '\n' {
+			lines++
+		}
+	}
+	if lines > common.MaxLinesBody {
+		return errTooManyLines
+	}
+	return nil
+}
@@ -84,6 +84,16 @@ func (s spliceRequest) MarshalJSON() ([]byte, error) {
 
 // Append a rune to the body of the open post
 func (c *Client) appendRune(data []byte) (err error) {
+	var char rune
+	err = decodeMessage(data, &char)
+	if err != nil {
+		return
+	}
+	return c.appendChar(char)
+}
+
+// Append a decoded rune to the body of the open post
+func (c *Client) appendChar(char rune) (err error) {
 	has, err := c.hasPost()
 	switch {
 	case err != nil:
@@ -94,11 +104,7 @@ func (c *Client) appendRune(data []byte) (err error) {
 		return common.ErrBodyTooLong
 	}
 
-	var char rune
-	err = decodeMessage(data, &char)
 	switch {
-	case err != nil:
-		return
 	case char == 0:
 		return common.ErrContainsNull
 	case char == '\n':
@@ -122,15 +128,31 @@ func (c *Client) appendRune(data []byte) (err error) {
 
 	c.post.body = append(c.post.body, string(char)...)
 	c.post.len++
-	return c.updateBody(msg, 1)
+	return c.updateBody(msg, common.EncodeBinaryAppend(c.post.id, char), 1,
+		db.EditOp{
+			Type: db.EditAppend,
+			Text: string(char),
+		})
 }
 
 // Send message to thread update feed and writes the open post's buffer to the
 // embedded database. Requires locking of c.openPost.
+// bin is the binary encoding of msg.
 // n specifies the number of characters updated.
-func (c *Client) updateBody(msg []byte, n int) error {
-	c.feed.SetOpenBody(c.post.id, string(c.post.body), msg)
+// op is recorded in the post's edit log, if enabled on the board.
+func (c *Client) updateBody(msg, bin []byte, n int, op db.EditOp) error {
+	c.setTyping()
+	c.post.lastEdit = time.Now().Unix()
+	c.post.warned = false
+	c.feed.SetOpenBody(c.post.id, string(c.post.body), msg, bin)
 	c.incrementSpamScore(uint(n) * config.Get().CharScore)
+	if config.GetBoardConfigs(c.post.board).EditLog {
+		op.Time = time.Now().UnixNano() / int64(time.Millisecond)
+		err := db.AppendEditOp(c.post.id, op)
+		if err != nil {
+			return err
+		}
+	}
 	return db.SetOpenBody(c.post.id, c.post.body)
 }
 
@@ -164,7 +186,8 @@ func (c *Client) backspace() error {
 	}
 	c.post.len--
 
-	return c.updateBody(msg, 1)
+	return c.updateBody(msg, common.EncodeBinaryBackspace(c.post.id), 1,
+		db.EditOp{Type: db.EditBackspace})
 }
 
 // Close an open post and parse the last line, if needed.
@@ -192,6 +215,7 @@ func (c *Client) closePost() (err error) {
 
 	err = CheckRouletteBan(com, c.post.board, c.post.op, c.post.id)
 	c.post = openPost{}
+	c.clearTyping()
 	return
 }
 
@@ -217,18 +241,23 @@ func CheckRouletteBan(commands []common.Command, board string, thread uint64, id
 
 // Splice the text in the open post
 func (c *Client) spliceText(data []byte) error {
+	var req spliceRequest
+	err := decodeMessage(data, &req)
+	if err != nil {
+		return err
+	}
+	return c.splice(req)
+}
+
+// Splice the text in the open post with a decoded request
+func (c *Client) splice(req spliceRequest) error {
 	if has, err := c.hasPost(); err != nil {
 		return err
 	} else if !has {
 		return nil
 	}
 
-	var req spliceRequest
-	err := decodeMessage(data, &req)
-	if err != nil {
-		return err
-	}
-	err = parser.IsPrintableRunes(req.Text, true)
+	err := parser.IsPrintableRunes(req.Text, true)
 	if err != nil {
 		return err
 	}
@@ -304,11 +333,19 @@ func (c *Client) spliceText(data []byte) error {
 		return errTooManyLines
 	}
 
+	bin := common.EncodeBinarySplice(res.ID, res.Start, res.Len, res.Text)
+
 	// +1, so you can't spam zero insert splices to infinity
-	return c.updateBody(msg, len(res.Text)+1)
+	return c.updateBody(msg, bin, len(res.Text)+1, db.EditOp{
+		Type:  db.EditSplice,
+		Start: res.Start,
+		Len:   res.Len,
+		Text:  res.Text,
+	})
 }
 
-// Insert and image into an existing open post
+// Insert and image into an existing open post. If the post already has an
+// image, the file is attached after it, up to the board's attachment limit.
 // Note: Spam score is now incremented on image thumbnailing, not assignment to
 // post.
 func (c *Client) insertImage(data []byte) (err error) {
@@ -318,7 +355,7 @@ func (c *Client) insertImage(data []byte) (err error) {
 		return
 	case !has:
 		return errNoPostOpen
-	case c.post.hasImage:
+	case c.post.hasImage && !c.post.canAttach():
 		return errHasImage
 	}
 
@@ -338,17 +375,28 @@ func (c *Client) insertImage(data []byte) (err error) {
 	}
 	var msg []byte
 	err = db.InTransaction(false, func(tx *sql.Tx) (err error) {
-		msg, err = db.InsertImage(tx, c.post.id, req.Token, req.Name,
-			req.Spoiler)
+		if c.post.hasImage {
+			msg, err = db.InsertAttachment(tx, c.post.id, req.Token, req.Name,
+				req.Spoiler)
+		} else {
+			msg, err = db.InsertImage(tx, c.post.id, req.Token, req.Name,
+				req.Spoiler)
+		}
 		return
 	})
 	if err != nil {
 		return
 	}
-	c.post.hasImage = true
-	c.post.isSpoilered = req.Spoiler
-	c.feed.InsertImage(c.post.id, req.Spoiler,
-		common.PrependMessageType(common.MessageInsertImage, msg))
+	if c.post.hasImage {
+		msg = common.PrependMessageType(common.MessageInsertAttachment, msg)
+		c.post.attachments++
+		c.feed.InsertAttachment(c.post.id, msg)
+	} else {
+		msg = common.PrependMessageType(common.MessageInsertImage, msg)
+		c.post.hasImage = true
+		c.post.isSpoilered = req.Spoiler
+		c.feed.InsertImage(c.post.id, req.Spoiler, msg)
+	}
 
 	return
 }
@@ -0,0 +1,134 @@
+// Outbound message queueing and slow consumer eviction
+
+package websockets
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bakape/meguca/websockets/feeds"
+)
+
+var (
+	// SendQueueLength is the maximum number of outbound messages queued per
+	// client. Allows for ~60 seconds of feed messages by default. A larger gap
+	// is more acceptable to shitty connections and mobile phones, especially
+	// while uploading.
+	SendQueueLength = uint(time.Second * 60 / feeds.TickerInterval)
+
+	// SendQueueSize is the maximum total size in bytes of outbound messages
+	// queued per client
+	SendQueueSize uint = 4 << 20
+
+	// Sent to clients evicted for not keeping up with outbound messages. The
+	// client must reconnect and synchronise again.
+	errSlowConsumer = errors.New("send queue overflow: resync required")
+
+	// Global outbound queueing counters
+	queueStats struct {
+		evicted, messages, bytes int64
+	}
+)
+
+// SendQueueStats contains counters of outbound websocket message queueing
+type SendQueueStats struct {
+	// Total clients evicted for not keeping up since server start
+	Evicted int64 `json:"evicted"`
+	// Messages and bytes currently queued for all clients
+	QueuedMessages int64 `json:"queuedMessages"`
+	QueuedBytes    int64 `json:"queuedBytes"`
+	// Configured per client limits
+	MaxMessages uint `json:"maxMessages"`
+	MaxBytes    uint `json:"maxBytes"`
+}
+
+// GetSendQueueStats returns the current outbound message queueing counters
+func GetSendQueueStats() SendQueueStats {
+	return SendQueueStats{
+		Evicted:        atomic.LoadInt64(&queueStats.evicted),
+		QueuedMessages: atomic.LoadInt64(&queueStats.messages),
+		QueuedBytes:    atomic.LoadInt64(&queueStats.bytes),
+		MaxMessages:    SendQueueLength,
+		MaxBytes:       SendQueueSize,
+	}
+}
+
+// Bounded queue of outbound messages for a single client
+type sendQueue struct {
+	mu sync.Mutex
+	// Number and size in bytes of queued messages
+	messages, size int64
+	// Set, once the client has been closed. No more messages are accepted.
+	closed bool
+	// Set, once the client has been evicted
+	evicted int32
+	// Close the client with the passed error
+	close func(error)
+}
+
+// Account for a message about to be queued. Returns false and evicts the
+// client, if this would exceed the size limit. Also returns false, if the
+// client has already been closed.
+func (q *sendQueue) reserve(msg []byte) bool {
+	n := int64(len(msg))
+	q.mu.Lock()
+	switch {
+	case q.closed:
+		q.mu.Unlock()
+		return false
+	case q.size+n > int64(SendQueueSize):
+		q.mu.Unlock()
+		q.evict()
+		return false
+	}
+	q.messages++
+	q.size += n
+	atomic.AddInt64(&queueStats.messages, 1)
+	atomic.AddInt64(&queueStats.bytes, n)
+	q.mu.Unlock()
+	return true
+}
+
+// Account for a message that has left the queue or was never added to it
+func (q *sendQueue) release(msg []byte) {
+	n := int64(len(msg))
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// Already released by releaseAll()
+	if q.closed {
+		return
+	}
+	q.messages--
+	q.size -= n
+	atomic.AddInt64(&queueStats.messages, -1)
+	atomic.AddInt64(&queueStats.bytes, -n)
+}
+
+// Release all messages left in the queue from the global counters and stop
+// accepting new ones. Must be called, when the client is closed, as messages
+// can still be queued concurrently after the client has stopped reading the
+// queue.
+func (q *sendQueue) releaseAll() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	atomic.AddInt64(&queueStats.messages, -q.messages)
+	atomic.AddInt64(&queueStats.bytes, -q.size)
+	q.messages = 0
+	q.size = 0
+}
+
+// Close the client for not keeping up. Only counted once per client.
+func (q *sendQueue) evict() {
+	if atomic.CompareAndSwapInt32(&q.evicted, 0, 1) {
+		atomic.AddInt64(&queueStats.evicted, 1)
+	}
+	q.close(errSlowConsumer)
+}
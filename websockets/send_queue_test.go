@@ -0,0 +1,77 @@
+package websockets
+
+import (
+	"testing"
+
+	. "github.com/bakape/meguca/test"
+)
+
+func TestSendQueueSizeLimit(t *testing.T) {
+	old := SendQueueSize
+	SendQueueSize = 8
+	defer func() {
+		SendQueueSize = old
+	}()
+
+	var closed []error
+	q := sendQueue{
+		close: func(err error) {
+			closed = append(closed, err)
+		},
+	}
+
+	msg := []byte("abcdef")
+	if !q.reserve(msg) {
+		t.Fatal("message not queued")
+	}
+	if q.reserve(msg) {
+		t.Fatal("queue size exceeded")
+	}
+	AssertEquals(t, closed, []error{errSlowConsumer})
+	AssertEquals(t, q.evicted, int32(1))
+
+	q.release(msg)
+	AssertEquals(t, q.size, int64(0))
+	if !q.reserve(msg) {
+		t.Fatal("message not queued after release")
+	}
+	q.release(msg)
+}
+
+func TestSendQueueEvictOnce(t *testing.T) {
+	q := sendQueue{
+		close: func(error) {},
+	}
+	before := GetSendQueueStats().Evicted
+	q.evict()
+	q.evict()
+	AssertEquals(t, GetSendQueueStats().Evicted-before, int64(1))
+}
+
+func TestSendQueueReleaseAll(t *testing.T) {
+	q := sendQueue{
+		close: func(error) {},
+	}
+	before := GetSendQueueStats()
+
+	msg := []byte("abcdef")
+	for i := 0; i < 2; i++ {
+		if !q.reserve(msg) {
+			t.Fatal("message not queued")
+		}
+	}
+	q.release(msg)
+
+	q.releaseAll()
+	after := GetSendQueueStats()
+	AssertEquals(t, after.QueuedMessages, before.QueuedMessages)
+	AssertEquals(t, after.QueuedBytes, before.QueuedBytes)
+
+	// Messages are neither accepted nor released twice after closing
+	if q.reserve(msg) {
+		t.Fatal("message queued after close")
+	}
+	q.release(msg)
+	q.releaseAll()
+	AssertEquals(t, GetSendQueueStats(), after)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/bakape/meguca/common"
 	"github.com/bakape/meguca/config"
 	"github.com/bakape/meguca/db"
+	"github.com/bakape/meguca/parser"
 	"github.com/bakape/meguca/websockets/feeds"
 
 	"golang.org/x/crypto/bcrypt"
@@ -16,13 +17,20 @@ import (
 type syncRequest struct {
 	Last100, Catalog      bool
 	Page, ProtocolVersion uint
-	Thread                uint64
-	Board                 string
+	// Epoch and sequence number of the last thread feed message batch
+	// received before reconnecting, if any
+	Epoch   string
+	LastSeq uint64
+	Thread  uint64
+	Board   string
 }
 
 type reclaimRequest struct {
 	ID       uint64
 	Password string
+	// Body of the post on the client, including any text typed while
+	// disconnected. Optional.
+	Body *string
 }
 
 // Synchronise the client to a certain thread, assign it's ID and prepare to
@@ -50,7 +58,11 @@ func (c *Client) synchronise(data []byte) error {
 		return err
 	}
 
-	if msg.ProtocolVersion == common.ProtocolVersion {
+	// Clients supporting binary frames also negotiate them here. Older clients
+	// keep receiving only JSON text frames.
+	c.setBinaryProtocol(msg.ProtocolVersion >= common.BinaryProtocolVersion)
+
+	if msg.ProtocolVersion >= common.ProtocolVersion {
 		buf, err := common.EncodeMessage(common.MessageConfigs,
 			config.GetBoardConfigs(msg.Board).BoardConfigs)
 		if err != nil {
@@ -74,11 +86,12 @@ func (c *Client) registerSync(req syncRequest) (err error) {
 		}
 	}
 
-	c.feed, err = feeds.SyncClient(c, req.Thread, req.Board)
+	c.feed, err = feeds.ResumeClient(c, req.Thread, req.Board, req.Epoch,
+		req.LastSeq)
 	if err != nil || req.Thread != 0 {
 		return
 	}
-	if req.ProtocolVersion != common.ProtocolVersion {
+	if req.ProtocolVersion < common.ProtocolVersion {
 		return c.sendMessage(common.MessageSynchronise, nil)
 	}
 
@@ -94,7 +107,9 @@ func (c *Client) registerSync(req syncRequest) (err error) {
 	return c.send(common.PrependMessageType(common.MessageSynchronise, json))
 }
 
-// Reclaim an open post after connection loss or navigating away.
+// Reclaim an open post after connection loss, navigating away or a server
+// restart. Any text typed by the client while disconnected is applied to the
+// post.
 //
 // TODO: Technically there is no locking performed so a single post may be open
 // by multiple clients. This opens us up to some exploits, but nothing severe.
@@ -134,7 +149,43 @@ func (c *Client) reclaimPost(data []byte) error {
 	}
 
 	c.post.init(post)
+	c.post.recoverUntil, err = db.GetRecoveryDeadline(post.ID)
+	if err != nil {
+		return err
+	}
+	lastEdit, err := db.GetLastEdit(post.ID)
+	if err != nil {
+		return err
+	}
+	if lastEdit != 0 {
+		c.post.lastEdit = lastEdit
+	}
+	if c.post.expired() {
+		if err := c.closePost(); err != nil {
+			return err
+		}
+		return c.sendMessage(common.MessageReclaim, 1)
+	}
+	c.setTyping()
 	c.feed.InsertPost(post.Post, nil)
 
+	// Apply text typed while disconnected before confirming the reclaim. If
+	// the text is invalid, the body stored on the server is kept and the
+	// client is told to discard its own.
+	if req.Body != nil && *req.Body != post.Body {
+		if parser.IsPrintableString(*req.Body, true) != nil ||
+			validateBody(*req.Body) != nil {
+			return c.sendMessage(common.MessageReclaim, 2)
+		}
+		err = c.splice(spliceRequest{
+			spliceCoords: spliceCoords{
+				Len: uint(c.post.len),
+			},
+			Text: []rune(*req.Body),
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return c.sendMessage(common.MessageReclaim, 0)
 }
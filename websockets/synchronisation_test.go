@@ -3,6 +3,7 @@ package websockets
 import (
 	"database/sql"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/bakape/meguca/auth"
@@ -240,23 +241,61 @@ func TestReclaimPost(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	var (
+		edited  = "abc\ndef ghi"
+		invalid = strings.Repeat("\n", common.MaxLinesBody+1)
+	)
 	cases := [...]struct {
-		name     string
-		id       uint64
-		password string
-		code     int
+		name, password string
+		id             uint64
+		body           *string
+		code           int
+		// Body of the reclaimed post
+		postBody string
 	}{
-		{"no post", 99, "", 1},
-		{"already closed", 3, "", 1},
-		{"wrong password", 2, "aaaaaaaa", 1},
-		{"valid", 2, pw, 0},
+		{
+			name: "no post",
+			id:   99,
+			code: 1,
+		},
+		{
+			name: "already closed",
+			id:   3,
+			code: 1,
+		},
+		{
+			name:     "wrong password",
+			id:       2,
+			password: "aaaaaaaa",
+			code:     1,
+		},
+		{
+			name:     "valid",
+			id:       2,
+			password: pw,
+			postBody: "abc\ndef",
+		},
+		{
+			name:     "invalid body",
+			id:       2,
+			password: pw,
+			body:     &invalid,
+			code:     2,
+			postBody: "abc\ndef",
+		},
+		{
+			name:     "with body",
+			id:       2,
+			password: pw,
+			body:     &edited,
+			postBody: edited,
+		},
 	}
 
+	// Sequential, as cases modify the same post
 	for i := range cases {
 		c := cases[i]
 		t.Run(c.name, func(t *testing.T) {
-			t.Parallel()
-
 			sv := newWSServer(t)
 			defer sv.Close()
 			cl, wcl := sv.NewClient()
@@ -264,12 +303,16 @@ func TestReclaimPost(t *testing.T) {
 			req := reclaimRequest{
 				ID:       c.id,
 				Password: c.password,
+				Body:     c.body,
 			}
 			if err := cl.reclaimPost(marshalJSON(t, req)); err != nil {
 				t.Fatal(err)
 			}
 
 			assertMessage(t, wcl, `31`+strconv.Itoa(c.code))
+			if c.code != 1 {
+				AssertEquals(t, string(cl.post.body), c.postBody)
+			}
 		})
 	}
 }
@@ -3,7 +3,6 @@
 package websockets
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -19,11 +18,21 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-const pingWriteTimeout = time.Second * 30
+const (
+	pingWriteTimeout = time.Second * 30
+
+	// Clients with an open post, that has not been edited for this long, are
+	// considered idle and not counted as typing
+	typingTimeout = time.Second * 15
+
+	// Time before the automatic closure of an open post to warn the client at
+	closeWarningTime = time.Minute
+)
 
 var (
 	// Overrideable for faster tests
-	pingTimer = time.Minute
+	pingTimer     = time.Minute
+	openPostTimer = time.Second * 10
 
 	upgrader = websocket.Upgrader{
 		HandshakeTimeout: 5 * time.Second,
@@ -72,10 +81,16 @@ type Client struct {
 	jwt string
 	// Client last post time
 	lastTime int64
+	// Last time the client edited its open post. Zero, if no post is open.
+	lastEdit int64
+	// Client has negotiated the binary message protocol
+	binary bool
 	// Internal message receiver channel
 	receive chan receivedMessage
-	// Only used to pass messages from the Send method.
-	sendExternal chan []byte
+	// Only used to pass messages from the Send and SendBinary methods.
+	sendExternal chan sentMessage
+	// Accounting of messages queued in sendExternal
+	queue sendQueue
 	// Redirect client to target board
 	redirect chan string
 	// Close the client and free all used resources
@@ -87,6 +102,11 @@ type receivedMessage struct {
 	msg []byte
 }
 
+type sentMessage struct {
+	binary bool
+	msg    []byte
+}
+
 // Handler is an http.HandleFunc that responds to new websocket connection
 // requests.
 func Handler(w http.ResponseWriter, r *http.Request) (err error) {
@@ -124,19 +144,18 @@ func newClient(conn *websocket.Conn, req *http.Request, ip string,
 	*Client, error,
 ) {
 	w, j := util.ConnectedWalletAddress(req)
-	return &Client{
-		ip:       ip,
-		close:    make(chan error, 2),
-		receive:  make(chan receivedMessage),
-		redirect: make(chan string),
-		wallet:   w,
-		jwt:      j,
-		// Allows for ~60 seconds of messages, until the buffer overflows.
-		// A larger gap is more acceptable to shitty connections and mobile
-		// phones, especially while uploading.
-		sendExternal: make(chan []byte, time.Second*60/feeds.TickerInterval),
+	c := &Client{
+		ip:           ip,
+		close:        make(chan error, 2),
+		receive:      make(chan receivedMessage),
+		redirect:     make(chan string),
+		wallet:       w,
+		jwt:          j,
+		sendExternal: make(chan sentMessage, SendQueueLength),
 		conn:         conn,
-	}, nil
+	}
+	c.queue.close = c.Close
+	return c, nil
 }
 
 // Listen listens for incoming messages on the channels and processes them
@@ -146,6 +165,7 @@ func (c *Client) listen() error {
 	// Clean up, when loop exits
 	err := c.listenerLoop()
 	feeds.RemoveClient(c)
+	c.queue.releaseAll()
 	return c.closeConnections(err)
 }
 
@@ -157,12 +177,23 @@ func (c *Client) listenerLoop() error {
 	ping := time.NewTicker(pingTimer)
 	defer ping.Stop()
 
+	// Enforce the board's open post time limits, even if the client is idle
+	openPostCheck := time.NewTicker(openPostTimer)
+	defer openPostCheck.Stop()
+
 	for {
 		select {
 		case err := <-c.close:
 			return err
 		case msg := <-c.sendExternal:
-			if err := c.send(msg); err != nil {
+			c.queue.release(msg.msg)
+			var err error
+			if msg.binary {
+				err = c.sendBinary(msg.msg)
+			} else {
+				err = c.send(msg.msg)
+			}
+			if err != nil {
 				return err
 			}
 		case <-ping.C:
@@ -171,6 +202,10 @@ func (c *Client) listenerLoop() error {
 			if err != nil {
 				return err
 			}
+		case <-openPostCheck.C:
+			if err := c.checkOpenPost(); err != nil {
+				return err
+			}
 		case msg := <-c.receive:
 			if err := c.handleMessage(msg.typ, msg.msg); err != nil {
 				return err
@@ -229,10 +264,28 @@ func (c *Client) closeConnections(err error) error {
 
 // Send a message to the client. Can be used concurrently.
 func (c *Client) Send(msg []byte) {
+	c.sendAsync(sentMessage{msg: msg})
+}
+
+// SendBinary sends a binary encoded message to the client. Can be used
+// concurrently.
+func (c *Client) SendBinary(msg []byte) {
+	c.sendAsync(sentMessage{
+		binary: true,
+		msg:    msg,
+	})
+}
+
+// Queue a message for sending. Evicts the client, if the queue is full.
+func (c *Client) sendAsync(msg sentMessage) {
+	if !c.queue.reserve(msg.msg) {
+		return
+	}
 	select {
 	case c.sendExternal <- msg:
 	default:
-		c.Close(errors.New("send buffer overflow"))
+		c.queue.release(msg.msg)
+		c.queue.evict()
 	}
 }
 
@@ -241,6 +294,11 @@ func (c *Client) send(msg []byte) error {
 	return c.conn.WriteMessage(websocket.TextMessage, msg)
 }
 
+// Sends a binary encoded message to the client. Not safe for concurrent use.
+func (c *Client) sendBinary(msg []byte) error {
+	return c.conn.WriteMessage(websocket.BinaryMessage, msg)
+}
+
 // Format a message type as JSON and send it to the client. Not safe for
 // concurrent use.
 func (c *Client) sendMessage(typ common.MessageType, msg interface{}) error {
@@ -275,8 +333,19 @@ func (c *Client) receiverLoop() {
 
 // handleMessage parses a message received from the client through websockets
 func (c *Client) handleMessage(msgType int, msg []byte) error {
-	if msgType != websocket.TextMessage {
-		return errInvalidFrame("only text frames allowed")
+	switch msgType {
+	case websocket.TextMessage:
+	case websocket.BinaryMessage:
+		if !c.BinaryProtocol() {
+			return errInvalidFrame("only text frames allowed")
+		}
+		if len(msg) < 1 {
+			return errInvalidPayload(msg)
+		}
+		// First byte of a binary message defines its type
+		return c.runBinaryHandler(common.MessageType(msg[0]), msg[1:])
+	default:
+		return errInvalidFrame("only text and binary frames allowed")
 	}
 	if len(msg) < 2 {
 		return errInvalidPayload(msg)
@@ -315,6 +384,9 @@ func (c *Client) logError(err error) {
 // Close closes a websocket connection with the provided status code and
 // optional reason
 func (c *Client) Close(err error) {
+	// Any messages still queued will not be sent
+	c.queue.releaseAll()
+
 	select {
 	case <-c.close:
 	default:
@@ -328,19 +400,37 @@ func (c *Client) Close(err error) {
 	}
 }
 
-// Helper for determining, if the client currently has an open post not older
-// than 29 minutes. If the post is older, it is closed automatically.
+// Helper for determining, if the client currently has an open post, that has
+// not exceeded the board's time limits. If it has, it is closed automatically.
 func (c *Client) hasPost() (bool, error) {
 	switch {
 	case c.post.id == 0:
 		return false, errNoPostOpen
-	case c.post.time < time.Now().Add(-time.Minute*29).Unix():
+	case c.post.expired():
 		return false, c.closePost()
 	default:
 		return true, nil
 	}
 }
 
+// Close the open post, if it has exceeded the board's time limits, or warn the
+// client shortly before it is closed automatically
+func (c *Client) checkOpenPost() error {
+	if c.post.id == 0 {
+		return nil
+	}
+	deadline := c.post.deadline()
+	switch now := time.Now().Unix(); {
+	case now >= deadline:
+		return c.closePost()
+	case !c.post.warned && deadline-now <= int64(closeWarningTime/time.Second):
+		c.post.warned = true
+		return c.sendMessage(common.MessageCloseWarning, deadline)
+	default:
+		return nil
+	}
+}
+
 // Redirect closes any open posts and forces the client to sync to the target
 // board
 func (c *Client) Redirect(board string) {
@@ -363,6 +453,43 @@ func (c *Client) LastTime() int64 {
 	return c.lastTime
 }
 
+// BinaryProtocol returns, if the client has negotiated the binary message
+// protocol
+func (c *Client) BinaryProtocol() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.binary
+}
+
+func (c *Client) setBinaryProtocol(binary bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.binary = binary
+}
+
+// Typing returns, if the client has an open post, that it has edited in the
+// last typingTimeout
+func (c *Client) Typing() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastEdit != 0 &&
+		c.lastEdit >= time.Now().Add(-typingTimeout).Unix()
+}
+
+// Mark the client as having just edited its open post
+func (c *Client) setTyping() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastEdit = time.Now().Unix()
+}
+
+// Mark the client as no longer having an open post
+func (c *Client) clearTyping() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastEdit = 0
+}
+
 func (c *Client) setLastTime() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -240,6 +240,32 @@ func TestHandleMessage(t *testing.T) {
 	assertHandlerError(t, cl, msg, invalidCharacter)
 }
 
+func TestHandleBinaryMessage(t *testing.T) {
+	t.Parallel()
+
+	sv := newWSServer(t)
+	defer sv.Close()
+	cl, _ := sv.NewClient()
+	cl.gotFirstMessage = true
+	cl.setBinaryProtocol(true)
+
+	// Empty message
+	err := cl.handleMessage(websocket.BinaryMessage, nil)
+	assertErrorPrefix(t, err, invalidMessage)
+
+	// No binary handler
+	msg := []byte{byte(common.MessageSynchronise)}
+	err = cl.handleMessage(websocket.BinaryMessage, msg)
+	assertErrorPrefix(t, err, invalidMessage)
+
+	// Malformed payload
+	msg = []byte{byte(common.MessageAppend), 0x80}
+	err = cl.handleMessage(websocket.BinaryMessage, msg)
+	if err != common.ErrInvalidBinaryMessage {
+		LogUnexpected(t, common.ErrInvalidBinaryMessage, err)
+	}
+}
+
 func assertHandlerError(t *testing.T, cl *Client, msg []byte, prefix string) {
 	t.Helper()
 	err := cl.handleMessage(websocket.TextMessage, msg)
@@ -360,3 +386,28 @@ func TestPinging(t *testing.T) {
 	sv.Wait()
 	cl.Close(nil)
 }
+
+func TestTyping(t *testing.T) {
+	t.Parallel()
+
+	c := new(Client)
+	if c.Typing() {
+		t.Fatal("typing without open post")
+	}
+
+	c.setTyping()
+	if !c.Typing() {
+		t.Fatal("not typing after edit")
+	}
+
+	c.lastEdit = time.Now().Add(-typingTimeout - time.Second).Unix()
+	if c.Typing() {
+		t.Fatal("typing after going idle")
+	}
+
+	c.setTyping()
+	c.clearTyping()
+	if c.Typing() {
+		t.Fatal("typing after closing post")
+	}
+}